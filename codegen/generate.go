@@ -50,6 +50,7 @@ var (
 				io.SmiNetworkingOutputTypes,
 				io.LocalNetworkingOutputTypes,
 				io.AppMeshNetworkingOutputTypes,
+				io.ConsulNetworkingOutputTypes,
 			},
 		},
 		// certificate issuer component
@@ -75,7 +76,7 @@ var (
 
 	snapshotApiGroups = map[string][]model.Group{
 		"":                                 groups.AllGeneratedGroups,
-		"github.com/solo-io/external-apis": externalapis.Groups,
+		"github.com/solo-io/external-apis": externalApiGroups(),
 		"github.com/solo-io/skv2":          {skv1alpha1.Group},
 	}
 
@@ -87,6 +88,18 @@ var (
 	anyvendorImports = anyvendor.AnyVendorImports()
 )
 
+// the external-apis groups imported by snapshots, excluding those generated by gloo mesh
+func externalApiGroups() []model.Group {
+	var externalGroups []model.Group
+	for _, group := range externalapis.Groups {
+		if group.GroupVersion == groups.IstioSecurityGroup.GroupVersion {
+			continue
+		}
+		externalGroups = append(externalGroups, group)
+	}
+	return externalGroups
+}
+
 func run() error {
 	log.Printf("generating gloo mesh code with version %v", version.Version)
 	chartOnly := flag.Bool("chart", false, "only generate the helm chart")
@@ -153,12 +166,19 @@ func makeGlooMeshCrdsCommand() codegen.Command {
 		AppName:         appName,
 		AnyVendorConfig: anyvendorImports,
 		ManifestRoot:    glooMeshCrdsManifestRoot,
-		Groups:          groups.GlooMeshGroups,
+		Groups:          glooMeshCrdGroups(),
 		RenderProtos:    true,
 		Chart:           helm.CrdsChart,
 	}
 }
 
+// the Gloo Mesh groups followed by the external groups, copied to avoid appending to the backing array of GlooMeshGroups
+func glooMeshCrdGroups() []model.Group {
+	crdGroups := make([]model.Group, 0, len(groups.GlooMeshGroups)+len(groups.ExternalGroups))
+	crdGroups = append(crdGroups, groups.GlooMeshGroups...)
+	return append(crdGroups, groups.ExternalGroups...)
+}
+
 func makeCertAgentCommand(chartOnly bool) codegen.Command {
 	if chartOnly {
 		return codegen.Command{
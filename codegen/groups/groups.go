@@ -10,6 +10,7 @@ import (
 	"github.com/solo-io/gloo-mesh/codegen/constants"
 	"github.com/solo-io/skv2/codegen/model"
 	"github.com/solo-io/skv2/contrib"
+	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -33,6 +34,7 @@ var GlooMeshNetworkingGroup = makeGroup("networking", "v1", []ResourceToGenerate
 	{Kind: "TrafficPolicy"},
 	{Kind: "AccessPolicy"},
 	{Kind: "VirtualMesh"},
+	{Kind: "EgressGatewayPolicy"},
 })
 
 var GlooMeshEnterpriseNetworkingGroup = makeGroup("networking.enterprise", "v1beta1", []ResourceToGenerate{
@@ -75,14 +77,72 @@ var XdsAgentGroup = makeGroup("xds.agent.enterprise", "v1beta1", []ResourceToGen
 	{Kind: "XdsConfig"},
 })
 
+// Consul config entries are owned by consul-k8s. The group is generated alongside the Gloo Mesh CRDs
+// for the Go types and clients needed to write the config entries, but does not set RenderManifests,
+// as consul-k8s installs the CRDs itself.
+var ConsulGroup = model.Group{
+	GroupVersion: schema.GroupVersion{
+		Group:   "consul.hashicorp.com",
+		Version: "v1alpha1",
+	},
+	Module: glooMeshModule,
+	Resources: []model.Resource{
+		makeExternalResource("ServiceSplitter"),
+		makeExternalResource("ServiceRouter"),
+		makeExternalResource("ServiceIntentions"),
+	},
+	RenderTypes:      true,
+	RenderClients:    true,
+	MockgenDirective: true,
+	CustomTemplates:  contrib.AllGroupCustomTemplates,
+	ApiRoot:          glooMeshApiRoot + "/external",
+}
+
+// external-apis does not generate clients for Istio RequestAuthentications, so we generate the clients for
+// the Istio security types ourselves. The snapshot templates import each GroupVersion from a single module,
+// so AuthorizationPolicies are generated here as well.
+var IstioSecurityGroup = model.Group{
+	GroupVersion: istiosecurityv1beta1.SchemeGroupVersion,
+	Module:       glooMeshModule,
+	Resources: []model.Resource{
+		{Kind: "AuthorizationPolicy"},
+		{Kind: "RequestAuthentication"},
+	},
+	RenderClients:         true,
+	RenderController:      true,
+	MockgenDirective:      true,
+	CustomTemplates:       contrib.AllGroupCustomTemplates,
+	CustomTypesImportPath: "istio.io/client-go/pkg/apis/security/v1beta1",
+	ApiRoot:               glooMeshApiRoot + "/external",
+}
+
+var ExternalGroups = []model.Group{
+	ConsulGroup,
+	IstioSecurityGroup,
+}
+
 var AllGeneratedGroups = append(
 	append(
-		GlooMeshGroups,
-		CertAgentGroups...,
+		append(
+			GlooMeshGroups,
+			CertAgentGroups...,
+		),
+		XdsAgentGroup,
 	),
-	XdsAgentGroup,
+	ExternalGroups...,
 )
 
+func makeExternalResource(kind string) model.Resource {
+	return model.Resource{
+		Kind: kind,
+		Spec: model.Field{
+			Type: model.Type{
+				Name: kind + "Spec",
+			},
+		},
+	}
+}
+
 type ResourceToGenerate struct {
 	Kind       string
 	ShortNames []string
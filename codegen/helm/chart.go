@@ -164,6 +164,8 @@ func NetworkingOperator(name string) model.Operator {
 	rbacPolicies = append(rbacPolicies, io.LocalNetworkingOutputTypes.Snapshot.RbacPoliciesWrite()...)
 	rbacPolicies = append(rbacPolicies, io.IstioNetworkingOutputTypes.Snapshot.RbacPoliciesWrite()...)
 	rbacPolicies = append(rbacPolicies, io.SmiNetworkingOutputTypes.Snapshot.RbacPoliciesWrite()...)
+	rbacPolicies = append(rbacPolicies, io.ConsulNetworkingOutputTypes.Snapshot.RbacPoliciesWrite()...)
+	rbacPolicies = append(rbacPolicies, io.AppMeshNetworkingOutputTypes.Snapshot.RbacPoliciesWrite()...)
 	rbacPolicies = append(rbacPolicies, io.CertificateIssuerInputTypes.RbacPoliciesWatch()...)
 	rbacPolicies = append(rbacPolicies, io.CertificateIssuerInputTypes.RbacPoliciesUpdateStatus()...)
 	return model.Operator{
@@ -214,6 +216,22 @@ func certAgentOperator() model.Operator {
 		APIGroups: []string{""},
 		Resources: []string{"pods"},
 	})
+	// ability to restart the controllers of pods during a certificate rotation
+	rbacPolicies = append(rbacPolicies, rbacv1.PolicyRule{
+		Verbs:     []string{"get", "list", "watch", "patch"},
+		APIGroups: []string{"apps"},
+		Resources: []string{"deployments", "statefulsets", "daemonsets"},
+	}, rbacv1.PolicyRule{
+		Verbs:     []string{"get", "list", "watch"},
+		APIGroups: []string{"apps"},
+		Resources: []string{"replicasets"},
+	})
+	// ability to request certificates from cert-manager issuers
+	rbacPolicies = append(rbacPolicies, rbacv1.PolicyRule{
+		Verbs:     []string{"get", "create", "delete"},
+		APIGroups: []string{"cert-manager.io"},
+		Resources: []string{"certificaterequests"},
+	})
 	return model.Operator{
 		Name: "cert-agent",
 		Deployment: model.Deployment{
@@ -3,6 +3,7 @@ package io
 import (
 	appmeshv1beta2 "github.com/aws/aws-app-mesh-controller-for-k8s/apis/appmesh/v1beta2"
 	"github.com/solo-io/gloo-mesh/codegen/constants"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -26,6 +27,9 @@ var (
 		appmeshv1beta2.GroupVersion: {
 			"Mesh",
 		},
+		istionetworkingv1alpha3.SchemeGroupVersion: {
+			"ServiceEntry",
+		},
 	}
 
 	DiscoveryLocalInputTypes = Snapshot{
@@ -30,6 +30,7 @@ var (
 			"TrafficPolicy",
 			"AccessPolicy",
 			"VirtualMesh",
+			"EgressGatewayPolicy",
 		},
 		schema.GroupVersion{
 			Group:   "settings." + constants.GlooMeshApiGroupSuffix,
@@ -76,6 +77,7 @@ var (
 			},
 			istiosecurityv1beta1.SchemeGroupVersion: {
 				"AuthorizationPolicy",
+				"RequestAuthentication",
 			},
 			schema.GroupVersion{
 				Group:   "certificates." + constants.GlooMeshApiGroupSuffix,
@@ -117,6 +119,20 @@ var (
 		},
 	}
 
+	ConsulNetworkingOutputTypes = OutputSnapshot{
+		Name: "consul",
+		Snapshot: Snapshot{
+			schema.GroupVersion{
+				Group:   "consul.hashicorp.com",
+				Version: "v1alpha1",
+			}: {
+				"ServiceSplitter",
+				"ServiceRouter",
+				"ServiceIntentions",
+			},
+		},
+	}
+
 	AppMeshNetworkingOutputTypes = OutputSnapshot{
 		Name: "appmesh",
 		Snapshot: Snapshot{
@@ -62,6 +62,10 @@ func (m *CommonCertOptions) Equal(that interface{}) bool {
 		return false
 	}
 
+	if m.GetKeyAlgorithm() != target.GetKeyAlgorithm() {
+		return false
+	}
+
 	return true
 }
 
@@ -103,6 +107,21 @@ func (m *IntermediateCertificateAuthority) Equal(that interface{}) bool {
 			}
 		}
 
+	case *IntermediateCertificateAuthority_CertManager:
+		if _, ok := target.CaSource.(*IntermediateCertificateAuthority_CertManager); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetCertManager()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetCertManager()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetCertManager(), target.GetCertManager()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.CaSource != target.CaSource {
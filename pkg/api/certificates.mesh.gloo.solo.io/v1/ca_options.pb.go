@@ -27,6 +27,63 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// Supported private key algorithms.
+type CommonCertOptions_KeyAlgorithm int32
+
+const (
+	// RSA, with a key size of `rsaKeySizeBytes`.
+	CommonCertOptions_RSA CommonCertOptions_KeyAlgorithm = 0
+	// ECDSA using the P-256 curve.
+	CommonCertOptions_ECDSA_P256 CommonCertOptions_KeyAlgorithm = 1
+	// ECDSA using the P-384 curve.
+	CommonCertOptions_ECDSA_P384 CommonCertOptions_KeyAlgorithm = 2
+	// Ed25519.
+	CommonCertOptions_ED25519 CommonCertOptions_KeyAlgorithm = 3
+)
+
+// Enum value maps for CommonCertOptions_KeyAlgorithm.
+var (
+	CommonCertOptions_KeyAlgorithm_name = map[int32]string{
+		0: "RSA",
+		1: "ECDSA_P256",
+		2: "ECDSA_P384",
+		3: "ED25519",
+	}
+	CommonCertOptions_KeyAlgorithm_value = map[string]int32{
+		"RSA":        0,
+		"ECDSA_P256": 1,
+		"ECDSA_P384": 2,
+		"ED25519":    3,
+	}
+)
+
+func (x CommonCertOptions_KeyAlgorithm) Enum() *CommonCertOptions_KeyAlgorithm {
+	p := new(CommonCertOptions_KeyAlgorithm)
+	*p = x
+	return p
+}
+
+func (x CommonCertOptions_KeyAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommonCertOptions_KeyAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_enumTypes[0].Descriptor()
+}
+
+func (CommonCertOptions_KeyAlgorithm) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_enumTypes[0]
+}
+
+func (x CommonCertOptions_KeyAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommonCertOptions_KeyAlgorithm.Descriptor instead.
+func (CommonCertOptions_KeyAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // Configuration for generating a self-signed root certificate.
 // Uses the X.509 format, RFC5280.
 type CommonCertOptions struct {
@@ -43,6 +100,9 @@ type CommonCertOptions struct {
 	// The ratio of cert lifetime to refresh a cert. For example, at 0.10 and 1 hour TTL,
 	// we would refresh 6 minutes before expiration
 	SecretRotationGracePeriodRatio float32 `protobuf:"fixed32,4,opt,name=secret_rotation_grace_period_ratio,json=secretRotationGracePeriodRatio,proto3" json:"secret_rotation_grace_period_ratio,omitempty"`
+	// The algorithm of the cert's private key. Defaults to RSA.
+	// A root cert and the intermediate certs it signs must use the same algorithm.
+	KeyAlgorithm CommonCertOptions_KeyAlgorithm `protobuf:"varint,5,opt,name=key_algorithm,json=keyAlgorithm,proto3,enum=certificates.mesh.gloo.solo.io.CommonCertOptions_KeyAlgorithm" json:"key_algorithm,omitempty"`
 }
 
 func (x *CommonCertOptions) Reset() {
@@ -105,6 +165,13 @@ func (x *CommonCertOptions) GetSecretRotationGracePeriodRatio() float32 {
 	return 0
 }
 
+func (x *CommonCertOptions) GetKeyAlgorithm() CommonCertOptions_KeyAlgorithm {
+	if x != nil {
+		return x.KeyAlgorithm
+	}
+	return CommonCertOptions_RSA
+}
+
 // Specify parameters for configuring the root certificate authority for a VirtualMesh.
 type IntermediateCertificateAuthority struct {
 	state         protoimpl.MessageState
@@ -115,6 +182,7 @@ type IntermediateCertificateAuthority struct {
 	//
 	// Types that are assignable to CaSource:
 	//	*IntermediateCertificateAuthority_Vault
+	//	*IntermediateCertificateAuthority_CertManager
 	CaSource isIntermediateCertificateAuthority_CaSource `protobuf_oneof:"ca_source"`
 }
 
@@ -164,6 +232,13 @@ func (x *IntermediateCertificateAuthority) GetVault() *VaultCA {
 	return nil
 }
 
+func (x *IntermediateCertificateAuthority) GetCertManager() *CertManagerCA {
+	if x, ok := x.GetCaSource().(*IntermediateCertificateAuthority_CertManager); ok {
+		return x.CertManager
+	}
+	return nil
+}
+
 type isIntermediateCertificateAuthority_CaSource interface {
 	isIntermediateCertificateAuthority_CaSource()
 }
@@ -173,8 +248,15 @@ type IntermediateCertificateAuthority_Vault struct {
 	Vault *VaultCA `protobuf:"bytes,1,opt,name=vault,proto3,oneof"`
 }
 
+type IntermediateCertificateAuthority_CertManager struct {
+	// Use a cert-manager Issuer or ClusterIssuer as the intermediate CA source
+	CertManager *CertManagerCA `protobuf:"bytes,2,opt,name=cert_manager,json=certManager,proto3,oneof"`
+}
+
 func (*IntermediateCertificateAuthority_Vault) isIntermediateCertificateAuthority_CaSource() {}
 
+func (*IntermediateCertificateAuthority_CertManager) isIntermediateCertificateAuthority_CaSource() {}
+
 var File_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDesc = []byte{
@@ -189,32 +271,52 @@ var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDe
 	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f,
 	0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
 	0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f,
-	0x63, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19,
-	0x0a, 0x08, 0x74, 0x74, 0x6c, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x07, 0x74, 0x74, 0x6c, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x72, 0x73, 0x61,
-	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x73, 0x61, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x7a,
-	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x67, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x4a, 0x0a, 0x22, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1e, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61,
-	0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22, 0x70, 0x0a,
-	0x20, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x3f, 0x0a, 0x05, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
-	0x6f, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x41, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x75,
-	0x6c, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x63, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42,
-	0x4c, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f,
-	0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xed, 0x02, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x74, 0x6c, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x74, 0x6c, 0x44, 0x61, 0x79,
+	0x73, 0x12, 0x2b, 0x0a, 0x12, 0x72, 0x73, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72,
+	0x73, 0x61, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x22, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x63, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x61, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x4b, 0x65, 0x79, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x0c, 0x6b, 0x65,
+	0x79, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x44, 0x0a, 0x0c, 0x4b, 0x65,
+	0x79, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x53,
+	0x41, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x50, 0x32, 0x35,
+	0x36, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x43, 0x44, 0x53, 0x41, 0x5f, 0x50, 0x33, 0x38,
+	0x34, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x44, 0x32, 0x35, 0x35, 0x31, 0x39, 0x10, 0x03,
+	0x22, 0xc4, 0x01, 0x0a, 0x20, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74,
+	0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x05, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x41, 0x48, 0x00, 0x52,
+	0x05, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x52, 0x0a, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x65,
+	0x72, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x41, 0x48, 0x00, 0x52, 0x0b, 0x63,
+	0x65, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x63, 0x61,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x4c, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
+	0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -229,19 +331,24 @@ func file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawD
 	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDescData
 }
 
+var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_goTypes = []interface{}{
-	(*CommonCertOptions)(nil),                // 0: certificates.mesh.gloo.solo.io.CommonCertOptions
-	(*IntermediateCertificateAuthority)(nil), // 1: certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority
-	(*VaultCA)(nil),                          // 2: certificates.mesh.gloo.solo.io.VaultCA
+	(CommonCertOptions_KeyAlgorithm)(0),      // 0: certificates.mesh.gloo.solo.io.CommonCertOptions.KeyAlgorithm
+	(*CommonCertOptions)(nil),                // 1: certificates.mesh.gloo.solo.io.CommonCertOptions
+	(*IntermediateCertificateAuthority)(nil), // 2: certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority
+	(*VaultCA)(nil),                          // 3: certificates.mesh.gloo.solo.io.VaultCA
+	(*CertManagerCA)(nil),                    // 4: certificates.mesh.gloo.solo.io.CertManagerCA
 }
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_depIdxs = []int32{
-	2, // 0: certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority.vault:type_name -> certificates.mesh.gloo.solo.io.VaultCA
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0, // 0: certificates.mesh.gloo.solo.io.CommonCertOptions.key_algorithm:type_name -> certificates.mesh.gloo.solo.io.CommonCertOptions.KeyAlgorithm
+	3, // 1: certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority.vault:type_name -> certificates.mesh.gloo.solo.io.VaultCA
+	4, // 2: certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority.cert_manager:type_name -> certificates.mesh.gloo.solo.io.CertManagerCA
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_init() }
@@ -250,6 +357,7 @@ func file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_init
 		return
 	}
 	file_github_com_solo_io_gloo_mesh_api_certificates_v1_vault_ca_proto_init()
+	file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CommonCertOptions); i {
@@ -278,19 +386,21 @@ func file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_init
 	}
 	file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*IntermediateCertificateAuthority_Vault)(nil),
+		(*IntermediateCertificateAuthority_CertManager)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_goTypes,
 		DependencyIndexes: file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_enumTypes,
 		MessageInfos:      file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes,
 	}.Build()
 	File_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto = out.File
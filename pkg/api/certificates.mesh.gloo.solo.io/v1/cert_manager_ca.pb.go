@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.21.0
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo-mesh/api/certificates/v1/cert_manager_ca.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// Sign intermediate certificates with a cert-manager Issuer or ClusterIssuer.
+// The Gloo Mesh agent submits its CSR as a cert-manager CertificateRequest in the agent namespace
+// and waits for the issuer to sign it.
+type CertManagerCA struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the Issuer or ClusterIssuer.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Kind of the issuer, either "Issuer" or "ClusterIssuer". Defaults to "Issuer".
+	// An Issuer must exist in the namespace of the Gloo Mesh agent.
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// API group of the issuer. Defaults to "cert-manager.io".
+	// Set this to use an external issuer, e.g. "awspca.cert-manager.io".
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (x *CertManagerCA) Reset() {
+	*x = CertManagerCA{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertManagerCA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertManagerCA) ProtoMessage() {}
+
+func (x *CertManagerCA) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertManagerCA.ProtoReflect.Descriptor instead.
+func (*CertManagerCA) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CertManagerCA) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CertManagerCA) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CertManagerCA) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+var File_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_rawDesc = []byte{
+	0x0a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f,
+	0x63, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x0d,
+	0x43, 0x65, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x41, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x4c, 0x5a, 0x46, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69,
+	0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_rawDescData = file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_goTypes = []interface{}{
+	(*CertManagerCA)(nil), // 0: certificates.mesh.gloo.solo.io.CertManagerCA
+}
+var file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_init() }
+func file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_init() {
+	if File_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertManagerCA); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_depIdxs,
+		MessageInfos:      file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto = out.File
+	file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_goTypes = nil
+	file_github_com_solo_io_gloo_mesh_api_certificates_v1_cert_manager_ca_proto_depIdxs = nil
+}
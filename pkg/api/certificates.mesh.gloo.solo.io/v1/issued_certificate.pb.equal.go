@@ -101,6 +101,16 @@ func (m *IssuedCertificateSpec) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetTrustBundle()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTrustBundle()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTrustBundle(), target.GetTrustBundle()) {
+			return false
+		}
+	}
+
 	switch m.CertificateAuthority.(type) {
 
 	case *IssuedCertificateSpec_GlooMeshCa:
@@ -224,5 +234,179 @@ func (m *IssuedCertificateStatus) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetRotation()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRotation()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRotation(), target.GetRotation()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetExternalRequest()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetExternalRequest()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetExternalRequest(), target.GetExternalRequest()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *IssuedCertificateSpec_TrustBundle) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*IssuedCertificateSpec_TrustBundle)
+	if !ok {
+		that2, ok := that.(IssuedCertificateSpec_TrustBundle)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetSecret()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSecret()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSecret(), target.GetSecret()) {
+			return false
+		}
+	}
+
+	if len(m.GetRootCertificates()) != len(target.GetRootCertificates()) {
+		return false
+	}
+	for idx, v := range m.GetRootCertificates() {
+
+		if strings.Compare(v, target.GetRootCertificates()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetHosts()) != len(target.GetHosts()) {
+		return false
+	}
+	for idx, v := range m.GetHosts() {
+
+		if strings.Compare(v, target.GetHosts()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *IssuedCertificateStatus_Rotation) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*IssuedCertificateStatus_Rotation)
+	if !ok {
+		that2, ok := that.(IssuedCertificateStatus_Rotation)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetState() != target.GetState() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetNotAfter()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetNotAfter()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetNotAfter(), target.GetNotAfter()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetGracePeriodStart()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetGracePeriodStart()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetGracePeriodStart(), target.GetGracePeriodStart()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetPreviousNotAfter()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPreviousNotAfter()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPreviousNotAfter(), target.GetPreviousNotAfter()) {
+			return false
+		}
+	}
+
+	if m.GetStateCompleted() != target.GetStateCompleted() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *IssuedCertificateStatus_ExternalRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*IssuedCertificateStatus_ExternalRequest)
+	if !ok {
+		that2, ok := that.(IssuedCertificateStatus_ExternalRequest)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetReason(), target.GetReason()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetMessage(), target.GetMessage()) != 0 {
+		return false
+	}
+
 	return true
 }
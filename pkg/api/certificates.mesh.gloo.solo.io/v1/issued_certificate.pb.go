@@ -12,7 +12,7 @@ import (
 
 	proto "github.com/golang/protobuf/proto"
 	_ "github.com/golang/protobuf/ptypes/duration"
-	_ "github.com/golang/protobuf/ptypes/timestamp"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	v1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -96,6 +96,75 @@ func (IssuedCertificateStatus_State) EnumDescriptor() ([]byte, []int) {
 	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_rawDescGZIP(), []int{2, 0}
 }
 
+// Possible states of the rotation of an issued certificate.
+type IssuedCertificateStatus_Rotation_State int32
+
+const (
+	// The issued certificate is outside of its rotation grace period.
+	IssuedCertificateStatus_Rotation_NOT_ROTATING IssuedCertificateStatus_Rotation_State = 0
+	// The issued certificate has entered its rotation grace period, and a replacement certificate is being requested.
+	IssuedCertificateStatus_Rotation_ROTATING IssuedCertificateStatus_Rotation_State = 1
+	// The replacement certificate has been issued. The root certificates of the previous and current certificates
+	// are distributed together until the previous certificate expires.
+	IssuedCertificateStatus_Rotation_OVERLAPPING IssuedCertificateStatus_Rotation_State = 2
+	// The root CA of the certificate has changed. The new root certificate is distributed alongside
+	// the previous root certificates, while workloads keep using the previously issued certificate.
+	IssuedCertificateStatus_Rotation_ADDING_ROOT IssuedCertificateStatus_Rotation_State = 3
+	// All workloads trust the new root certificate. The certificate issued by the new root CA
+	// is being distributed, and workloads are restarted to pick it up.
+	IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE IssuedCertificateStatus_Rotation_State = 4
+	// All workloads use the certificate issued by the new root CA. The previous root certificates
+	// are being removed from the trust bundle.
+	IssuedCertificateStatus_Rotation_REMOVING_PREVIOUS_ROOT IssuedCertificateStatus_Rotation_State = 5
+)
+
+// Enum value maps for IssuedCertificateStatus_Rotation_State.
+var (
+	IssuedCertificateStatus_Rotation_State_name = map[int32]string{
+		0: "NOT_ROTATING",
+		1: "ROTATING",
+		2: "OVERLAPPING",
+		3: "ADDING_ROOT",
+		4: "PROPAGATING_CERTIFICATE",
+		5: "REMOVING_PREVIOUS_ROOT",
+	}
+	IssuedCertificateStatus_Rotation_State_value = map[string]int32{
+		"NOT_ROTATING":            0,
+		"ROTATING":                1,
+		"OVERLAPPING":             2,
+		"ADDING_ROOT":             3,
+		"PROPAGATING_CERTIFICATE": 4,
+		"REMOVING_PREVIOUS_ROOT":  5,
+	}
+)
+
+func (x IssuedCertificateStatus_Rotation_State) Enum() *IssuedCertificateStatus_Rotation_State {
+	p := new(IssuedCertificateStatus_Rotation_State)
+	*p = x
+	return p
+}
+
+func (x IssuedCertificateStatus_Rotation_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IssuedCertificateStatus_Rotation_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_enumTypes[1].Descriptor()
+}
+
+func (IssuedCertificateStatus_Rotation_State) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_enumTypes[1]
+}
+
+func (x IssuedCertificateStatus_Rotation_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IssuedCertificateStatus_Rotation_State.Descriptor instead.
+func (IssuedCertificateStatus_Rotation_State) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_rawDescGZIP(), []int{2, 0, 0}
+}
+
 //
 //IssuedCertificates are used to issue SSL certificates
 //to remote Kubernetes clusters from a central (out-of-cluster) Certificate Authority.
@@ -150,6 +219,10 @@ type IssuedCertificateSpec struct {
 	//	*IssuedCertificateSpec_GlooMeshCa
 	//	*IssuedCertificateSpec_AgentCa
 	CertificateAuthority isIssuedCertificateSpec_CertificateAuthority `protobuf_oneof:"certificate_authority"`
+	// If set, the Gloo Mesh agent writes the issued certificate along with the given trust bundle to a secret
+	// which is used by the mesh's gateways to authenticate traffic from other meshes.
+	// Only set for VirtualMeshes using limited trust.
+	TrustBundle *IssuedCertificateSpec_TrustBundle `protobuf:"bytes,9,opt,name=trust_bundle,json=trustBundle,proto3" json:"trust_bundle,omitempty"`
 }
 
 func (x *IssuedCertificateSpec) Reset() {
@@ -247,6 +320,13 @@ func (x *IssuedCertificateSpec) GetAgentCa() *IntermediateCertificateAuthority {
 	return nil
 }
 
+func (x *IssuedCertificateSpec) GetTrustBundle() *IssuedCertificateSpec_TrustBundle {
+	if x != nil {
+		return x.TrustBundle
+	}
+	return nil
+}
+
 type isIssuedCertificateSpec_CertificateAuthority interface {
 	isIssuedCertificateSpec_CertificateAuthority()
 }
@@ -351,6 +431,13 @@ type IssuedCertificateStatus struct {
 	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	// The current state of the IssuedCertificate workflow, reported by the agent.
 	State IssuedCertificateStatus_State `protobuf:"varint,3,opt,name=state,proto3,enum=certificates.mesh.gloo.solo.io.IssuedCertificateStatus_State" json:"state,omitempty"`
+	// The rotation of the issued certificate, reported by the agent.
+	// The agent re-issues the certificate once it enters the grace period configured by
+	// `certOptions.secretRotationGracePeriodRatio`.
+	Rotation *IssuedCertificateStatus_Rotation `protobuf:"bytes,4,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	// The status of the request submitted by the agent to an external CA to sign the certificate,
+	// e.g. a cert-manager CertificateRequest. Only set for IssuedCertificates signed by such a CA.
+	ExternalRequest *IssuedCertificateStatus_ExternalRequest `protobuf:"bytes,5,opt,name=external_request,json=externalRequest,proto3" json:"external_request,omitempty"`
 }
 
 func (x *IssuedCertificateStatus) Reset() {
@@ -406,6 +493,245 @@ func (x *IssuedCertificateStatus) GetState() IssuedCertificateStatus_State {
 	return IssuedCertificateStatus_PENDING
 }
 
+func (x *IssuedCertificateStatus) GetRotation() *IssuedCertificateStatus_Rotation {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+func (x *IssuedCertificateStatus) GetExternalRequest() *IssuedCertificateStatus_ExternalRequest {
+	if x != nil {
+		return x.ExternalRequest
+	}
+	return nil
+}
+
+// The root certificates of the meshes trusted by the mesh receiving this IssuedCertificate.
+type IssuedCertificateSpec_TrustBundle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The secret to write the gateway credentials to (located in the Gloo Mesh agent's cluster).
+	// The secret uses the keys expected by Istio for gateway mTLS (`cert`, `key`, and `cacert`).
+	// The gateways are issued their own leaf certificate, signed by the issued intermediate certificate.
+	Secret *v1.ObjectRef `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// PEM-encoded root certificates of the trusted meshes.
+	RootCertificates []string `protobuf:"bytes,2,rep,name=root_certificates,json=rootCertificates,proto3" json:"root_certificates,omitempty"`
+	// The SANs of the leaf certificate issued to the gateways.
+	Hosts []string `protobuf:"bytes,3,rep,name=hosts,proto3" json:"hosts,omitempty"`
+}
+
+func (x *IssuedCertificateSpec_TrustBundle) Reset() {
+	*x = IssuedCertificateSpec_TrustBundle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssuedCertificateSpec_TrustBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuedCertificateSpec_TrustBundle) ProtoMessage() {}
+
+func (x *IssuedCertificateSpec_TrustBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuedCertificateSpec_TrustBundle.ProtoReflect.Descriptor instead.
+func (*IssuedCertificateSpec_TrustBundle) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *IssuedCertificateSpec_TrustBundle) GetSecret() *v1.ObjectRef {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *IssuedCertificateSpec_TrustBundle) GetRootCertificates() []string {
+	if x != nil {
+		return x.RootCertificates
+	}
+	return nil
+}
+
+func (x *IssuedCertificateSpec_TrustBundle) GetHosts() []string {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+// The rotation of an issued certificate.
+type IssuedCertificateStatus_Rotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current state of the rotation.
+	State IssuedCertificateStatus_Rotation_State `protobuf:"varint,1,opt,name=state,proto3,enum=certificates.mesh.gloo.solo.io.IssuedCertificateStatus_Rotation_State" json:"state,omitempty"`
+	// The expiry of the currently issued certificate.
+	NotAfter *timestamp.Timestamp `protobuf:"bytes,2,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	// The time at which the currently issued certificate enters its rotation grace period.
+	GracePeriodStart *timestamp.Timestamp `protobuf:"bytes,3,opt,name=grace_period_start,json=gracePeriodStart,proto3" json:"grace_period_start,omitempty"`
+	// The expiry of the previously issued certificate.
+	// Until then, the root certificates of the previous certificate are distributed alongside the current ones.
+	PreviousNotAfter *timestamp.Timestamp `protobuf:"bytes,4,opt,name=previous_not_after,json=previousNotAfter,proto3" json:"previous_not_after,omitempty"`
+	// Set once the pods have been restarted for the current state of a root CA rotation.
+	// The certificates issued to the Meshes of a VirtualMesh proceed to the next state of a root CA rotation
+	// once every Mesh has completed the current state.
+	StateCompleted bool `protobuf:"varint,5,opt,name=state_completed,json=stateCompleted,proto3" json:"state_completed,omitempty"`
+}
+
+func (x *IssuedCertificateStatus_Rotation) Reset() {
+	*x = IssuedCertificateStatus_Rotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssuedCertificateStatus_Rotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuedCertificateStatus_Rotation) ProtoMessage() {}
+
+func (x *IssuedCertificateStatus_Rotation) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuedCertificateStatus_Rotation.ProtoReflect.Descriptor instead.
+func (*IssuedCertificateStatus_Rotation) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *IssuedCertificateStatus_Rotation) GetState() IssuedCertificateStatus_Rotation_State {
+	if x != nil {
+		return x.State
+	}
+	return IssuedCertificateStatus_Rotation_NOT_ROTATING
+}
+
+func (x *IssuedCertificateStatus_Rotation) GetNotAfter() *timestamp.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *IssuedCertificateStatus_Rotation) GetGracePeriodStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.GracePeriodStart
+	}
+	return nil
+}
+
+func (x *IssuedCertificateStatus_Rotation) GetPreviousNotAfter() *timestamp.Timestamp {
+	if x != nil {
+		return x.PreviousNotAfter
+	}
+	return nil
+}
+
+func (x *IssuedCertificateStatus_Rotation) GetStateCompleted() bool {
+	if x != nil {
+		return x.StateCompleted
+	}
+	return false
+}
+
+// A request submitted to an external CA.
+type IssuedCertificateStatus_ExternalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the request.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The reason reported by the external CA for the current state of the request,
+	// e.g. "Pending", "Issued", "Denied" or "Failed" for a cert-manager CertificateRequest.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// A human readable message reported by the external CA.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *IssuedCertificateStatus_ExternalRequest) Reset() {
+	*x = IssuedCertificateStatus_ExternalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssuedCertificateStatus_ExternalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuedCertificateStatus_ExternalRequest) ProtoMessage() {}
+
+func (x *IssuedCertificateStatus_ExternalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuedCertificateStatus_ExternalRequest.ProtoReflect.Descriptor instead.
+func (*IssuedCertificateStatus_ExternalRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *IssuedCertificateStatus_ExternalRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IssuedCertificateStatus_ExternalRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *IssuedCertificateStatus_ExternalRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_rawDesc = []byte{
@@ -428,7 +754,7 @@ var file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_pro
 	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xf1, 0x04, 0x0a, 0x15, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x43, 0x65,
+	0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x06, 0x0a, 0x15, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x43, 0x65,
 	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a,
 	0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f,
 	0x73, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x72, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
@@ -465,30 +791,94 @@ var file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_pro
 	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
 	0x69, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43,
 	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x42, 0x17,
-	0x0a, 0x15, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x52, 0x6f, 0x6f, 0x74,
-	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x12, 0x5c, 0x0a, 0x1a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
-	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x18, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e,
-	0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x80, 0x02, 0x0a, 0x17,
-	0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x53,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3d, 0x2e,
-	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x49,
+	0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x12, 0x64,
+	0x0a, 0x0c, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x1a, 0x86, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x66, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x6f,
+	0x6f, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x17, 0x0a,
+	0x15, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x52, 0x6f, 0x6f, 0x74, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x5c, 0x0a, 0x1a, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x18, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x42, 0x17, 0x0a, 0x15, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x91, 0x08, 0x0a, 0x17, 0x49,
 	0x73, 0x73, 0x75, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x22, 0x49, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x53, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3d, 0x2e, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x5c, 0x0a, 0x08, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x72, 0x0a, 0x10, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0xe3, 0x03, 0x0a, 0x08, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x5c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x46, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x37, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x12, 0x67, 0x72, 0x61, 0x63,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x10, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x48, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x4e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10,
+	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x02,
+	0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x44, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10,
+	0x03, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x4f, 0x50, 0x41, 0x47, 0x41, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x43, 0x45, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x1a,
+	0x0a, 0x16, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x49,
+	0x4f, 0x55, 0x53, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x05, 0x1a, 0x57, 0x0a, 0x0f, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07,
 	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x51,
 	0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x53, 0x53, 0x55,
 	0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44,
@@ -513,31 +903,44 @@ func file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_pr
 	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_goTypes = []interface{}{
-	(IssuedCertificateStatus_State)(0),       // 0: certificates.mesh.gloo.solo.io.IssuedCertificateStatus.State
-	(*IssuedCertificateSpec)(nil),            // 1: certificates.mesh.gloo.solo.io.IssuedCertificateSpec
-	(*RootCertificateAuthority)(nil),         // 2: certificates.mesh.gloo.solo.io.RootCertificateAuthority
-	(*IssuedCertificateStatus)(nil),          // 3: certificates.mesh.gloo.solo.io.IssuedCertificateStatus
-	(*v1.ObjectRef)(nil),                     // 4: core.skv2.solo.io.ObjectRef
-	(*CommonCertOptions)(nil),                // 5: certificates.mesh.gloo.solo.io.CommonCertOptions
-	(*IntermediateCertificateAuthority)(nil), // 6: certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority
+	(IssuedCertificateStatus_State)(0),              // 0: certificates.mesh.gloo.solo.io.IssuedCertificateStatus.State
+	(IssuedCertificateStatus_Rotation_State)(0),     // 1: certificates.mesh.gloo.solo.io.IssuedCertificateStatus.Rotation.State
+	(*IssuedCertificateSpec)(nil),                   // 2: certificates.mesh.gloo.solo.io.IssuedCertificateSpec
+	(*RootCertificateAuthority)(nil),                // 3: certificates.mesh.gloo.solo.io.RootCertificateAuthority
+	(*IssuedCertificateStatus)(nil),                 // 4: certificates.mesh.gloo.solo.io.IssuedCertificateStatus
+	(*IssuedCertificateSpec_TrustBundle)(nil),       // 5: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.TrustBundle
+	(*IssuedCertificateStatus_Rotation)(nil),        // 6: certificates.mesh.gloo.solo.io.IssuedCertificateStatus.Rotation
+	(*IssuedCertificateStatus_ExternalRequest)(nil), // 7: certificates.mesh.gloo.solo.io.IssuedCertificateStatus.ExternalRequest
+	(*v1.ObjectRef)(nil),                            // 8: core.skv2.solo.io.ObjectRef
+	(*CommonCertOptions)(nil),                       // 9: certificates.mesh.gloo.solo.io.CommonCertOptions
+	(*IntermediateCertificateAuthority)(nil),        // 10: certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority
+	(*timestamp.Timestamp)(nil),                     // 11: google.protobuf.Timestamp
 }
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_depIdxs = []int32{
-	4, // 0: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.signing_certificate_secret:type_name -> core.skv2.solo.io.ObjectRef
-	4, // 1: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.issued_certificate_secret:type_name -> core.skv2.solo.io.ObjectRef
-	4, // 2: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.pod_bounce_directive:type_name -> core.skv2.solo.io.ObjectRef
-	5, // 3: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.cert_options:type_name -> certificates.mesh.gloo.solo.io.CommonCertOptions
-	2, // 4: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.gloo_mesh_ca:type_name -> certificates.mesh.gloo.solo.io.RootCertificateAuthority
-	6, // 5: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.agent_ca:type_name -> certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority
-	4, // 6: certificates.mesh.gloo.solo.io.RootCertificateAuthority.signing_certificate_secret:type_name -> core.skv2.solo.io.ObjectRef
-	0, // 7: certificates.mesh.gloo.solo.io.IssuedCertificateStatus.state:type_name -> certificates.mesh.gloo.solo.io.IssuedCertificateStatus.State
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	8,  // 0: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.signing_certificate_secret:type_name -> core.skv2.solo.io.ObjectRef
+	8,  // 1: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.issued_certificate_secret:type_name -> core.skv2.solo.io.ObjectRef
+	8,  // 2: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.pod_bounce_directive:type_name -> core.skv2.solo.io.ObjectRef
+	9,  // 3: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.cert_options:type_name -> certificates.mesh.gloo.solo.io.CommonCertOptions
+	3,  // 4: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.gloo_mesh_ca:type_name -> certificates.mesh.gloo.solo.io.RootCertificateAuthority
+	10, // 5: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.agent_ca:type_name -> certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority
+	5,  // 6: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.trust_bundle:type_name -> certificates.mesh.gloo.solo.io.IssuedCertificateSpec.TrustBundle
+	8,  // 7: certificates.mesh.gloo.solo.io.RootCertificateAuthority.signing_certificate_secret:type_name -> core.skv2.solo.io.ObjectRef
+	0,  // 8: certificates.mesh.gloo.solo.io.IssuedCertificateStatus.state:type_name -> certificates.mesh.gloo.solo.io.IssuedCertificateStatus.State
+	6,  // 9: certificates.mesh.gloo.solo.io.IssuedCertificateStatus.rotation:type_name -> certificates.mesh.gloo.solo.io.IssuedCertificateStatus.Rotation
+	7,  // 10: certificates.mesh.gloo.solo.io.IssuedCertificateStatus.external_request:type_name -> certificates.mesh.gloo.solo.io.IssuedCertificateStatus.ExternalRequest
+	8,  // 11: certificates.mesh.gloo.solo.io.IssuedCertificateSpec.TrustBundle.secret:type_name -> core.skv2.solo.io.ObjectRef
+	1,  // 12: certificates.mesh.gloo.solo.io.IssuedCertificateStatus.Rotation.state:type_name -> certificates.mesh.gloo.solo.io.IssuedCertificateStatus.Rotation.State
+	11, // 13: certificates.mesh.gloo.solo.io.IssuedCertificateStatus.Rotation.not_after:type_name -> google.protobuf.Timestamp
+	11, // 14: certificates.mesh.gloo.solo.io.IssuedCertificateStatus.Rotation.grace_period_start:type_name -> google.protobuf.Timestamp
+	11, // 15: certificates.mesh.gloo.solo.io.IssuedCertificateStatus.Rotation.previous_not_after:type_name -> google.protobuf.Timestamp
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_init() }
@@ -583,6 +986,42 @@ func file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_pr
 				return nil
 			}
 		}
+		file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssuedCertificateSpec_TrustBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssuedCertificateStatus_Rotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssuedCertificateStatus_ExternalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*IssuedCertificateSpec_GlooMeshCa)(nil),
@@ -596,8 +1035,8 @@ func file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_pr
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_certificates_v1_issued_certificate_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
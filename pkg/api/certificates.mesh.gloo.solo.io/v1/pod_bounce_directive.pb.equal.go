@@ -244,5 +244,32 @@ func (m *PodBounceDirectiveStatus_BouncedPodSet) Equal(that interface{}) bool {
 
 	}
 
+	if h, ok := interface{}(m.GetRolloutTime()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRolloutTime()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRolloutTime(), target.GetRolloutTime()) {
+			return false
+		}
+	}
+
+	if len(m.GetRestartedControllers()) != len(target.GetRestartedControllers()) {
+		return false
+	}
+	for idx, v := range m.GetRestartedControllers() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRestartedControllers()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRestartedControllers()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
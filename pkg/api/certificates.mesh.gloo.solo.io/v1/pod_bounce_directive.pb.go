@@ -12,7 +12,7 @@ import (
 
 	proto "github.com/golang/protobuf/proto"
 	_ "github.com/golang/protobuf/ptypes/duration"
-	_ "github.com/golang/protobuf/ptypes/timestamp"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	v1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -299,6 +299,13 @@ type PodBounceDirectiveStatus_BouncedPodSet struct {
 
 	// The names of the pods that were bounced for the corresponding selector specified in `PodBounceDirectiveSpec.PodSelector.labels`.
 	BouncedPods []string `protobuf:"bytes,1,rep,name=bounced_pods,json=bouncedPods,proto3" json:"bounced_pods,omitempty"`
+	// Set if the pods were restarted by a rollout of their controllers rather than deleted,
+	// which is the case while the certificate is being rotated.
+	// The rollout is complete once all selected pods of the restarted controllers have been restarted after this time.
+	RolloutTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=rollout_time,json=rolloutTime,proto3" json:"rollout_time,omitempty"`
+	// The controllers of the selected pods which were restarted by the rollout.
+	// Selected pods whose controller cannot be restarted by a rollout are deleted instead.
+	RestartedControllers []*v1.TypedObjectRef `protobuf:"bytes,3,rep,name=restarted_controllers,json=restartedControllers,proto3" json:"restarted_controllers,omitempty"`
 }
 
 func (x *PodBounceDirectiveStatus_BouncedPodSet) Reset() {
@@ -340,6 +347,20 @@ func (x *PodBounceDirectiveStatus_BouncedPodSet) GetBouncedPods() []string {
 	return nil
 }
 
+func (x *PodBounceDirectiveStatus_BouncedPodSet) GetRolloutTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.RolloutTime
+	}
+	return nil
+}
+
+func (x *PodBounceDirectiveStatus_BouncedPodSet) GetRestartedControllers() []*v1.TypedObjectRef {
+	if x != nil {
+		return x.RestartedControllers
+	}
+	return nil
+}
+
 var File_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_rawDesc = []byte{
@@ -403,7 +424,7 @@ var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_p
 	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x66, 0x12, 0x24, 0x0a, 0x0e,
 	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b,
-	0x65, 0x79, 0x22, 0xb9, 0x01, 0x0a, 0x18, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x65, 0x79, 0x22, 0xd1, 0x02, 0x0a, 0x18, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
 	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
 	0x69, 0x0a, 0x0c, 0x70, 0x6f, 0x64, 0x73, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x18,
 	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
@@ -411,16 +432,25 @@ var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_p
 	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
 	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
 	0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x53, 0x65, 0x74, 0x52, 0x0b, 0x70,
-	0x6f, 0x64, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x1a, 0x32, 0x0a, 0x0d, 0x42, 0x6f,
-	0x75, 0x6e, 0x63, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x53, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62,
-	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0b, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x73, 0x42, 0x4c,
-	0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70,
-	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
-	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x64, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x1a, 0xc9, 0x01, 0x0a, 0x0d, 0x42,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x53, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x73, 0x12,
+	0x3d, 0x0a, 0x0c, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x56,
+	0x0a, 0x15, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
+	0x52, 0x14, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x42, 0x4c, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31,
+	0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -443,7 +473,9 @@ var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_p
 	nil, // 3: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.LabelsEntry
 	(*PodBounceDirectiveSpec_PodSelector_RootCertSync)(nil), // 4: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.RootCertSync
 	(*PodBounceDirectiveStatus_BouncedPodSet)(nil),          // 5: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.BouncedPodSet
-	(*v1.ObjectRef)(nil), // 6: core.skv2.solo.io.ObjectRef
+	(*v1.ObjectRef)(nil),        // 6: core.skv2.solo.io.ObjectRef
+	(*timestamp.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*v1.TypedObjectRef)(nil),   // 8: core.skv2.solo.io.TypedObjectRef
 }
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_depIdxs = []int32{
 	2, // 0: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.pods_to_bounce:type_name -> certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector
@@ -452,11 +484,13 @@ var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_p
 	4, // 3: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.root_cert_sync:type_name -> certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.RootCertSync
 	6, // 4: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.RootCertSync.secret_ref:type_name -> core.skv2.solo.io.ObjectRef
 	6, // 5: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.RootCertSync.config_map_ref:type_name -> core.skv2.solo.io.ObjectRef
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	7, // 6: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.BouncedPodSet.rollout_time:type_name -> google.protobuf.Timestamp
+	8, // 7: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.BouncedPodSet.restarted_controllers:type_name -> core.skv2.solo.io.TypedObjectRef
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_init() }
@@ -0,0 +1,159 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo-mesh/api/common/v1/locality.proto
+
+package v1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *LocalityLoadBalancing) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LocalityLoadBalancing)
+	if !ok {
+		that2, ok := that.(LocalityLoadBalancing)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetFailover()) != len(target.GetFailover()) {
+		return false
+	}
+	for idx, v := range m.GetFailover() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetFailover()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetFailover()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetDistribute()) != len(target.GetDistribute()) {
+		return false
+	}
+	for idx, v := range m.GetDistribute() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDistribute()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetDistribute()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if m.GetDisabled() != target.GetDisabled() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *LocalityLoadBalancing_Failover) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LocalityLoadBalancing_Failover)
+	if !ok {
+		that2, ok := that.(LocalityLoadBalancing_Failover)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetFrom(), target.GetFrom()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetTo(), target.GetTo()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *LocalityLoadBalancing_Distribute) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*LocalityLoadBalancing_Distribute)
+	if !ok {
+		that2, ok := that.(LocalityLoadBalancing_Distribute)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetFrom(), target.GetFrom()) != 0 {
+		return false
+	}
+
+	if len(m.GetTo()) != len(target.GetTo()) {
+		return false
+	}
+	for k, v := range m.GetTo() {
+
+		if v != target.GetTo()[k] {
+			return false
+		}
+
+	}
+
+	return true
+}
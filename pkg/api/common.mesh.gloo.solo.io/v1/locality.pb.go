@@ -0,0 +1,358 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.21.0
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo-mesh/api/common/v1/locality.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// Configure locality-aware load balancing, which prefers sending requests to the endpoints closest to the client.
+// By default, endpoints in the client's zone are preferred, followed by endpoints in the client's region, followed by endpoints in any other region.
+// A locality is specified as `region/zone/sub-zone`, where the zone and sub-zone may be omitted or set to `*` to match any zone or sub-zone.
+// Localities are discovered from the `topology.kubernetes.io/region`, `topology.kubernetes.io/zone` and `topology.istio.io/subzone`
+// labels of the Kubernetes nodes on which the endpoints are running.
+// For Istio, locality-aware load balancing only takes effect if outlier detection is configured for the Destination,
+// otherwise all healthy endpoints are considered equally.
+type LocalityLoadBalancing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Specify the region to which requests fail over when no healthy endpoints exist in the client's region.
+	// If omitted, requests fail over to the endpoints of any other region. Cannot be set with `distribute`.
+	Failover []*LocalityLoadBalancing_Failover `protobuf:"bytes,1,rep,name=failover,proto3" json:"failover,omitempty"`
+	// Explicitly specify the proportion of requests originating from each locality that are sent to each other locality,
+	// rather than preferring the endpoints closest to the client. Cannot be set with `failover`.
+	Distribute []*LocalityLoadBalancing_Distribute `protobuf:"bytes,2,rep,name=distribute,proto3" json:"distribute,omitempty"`
+	// Set to true to disable locality-aware load balancing, e.g. to override a default set on the VirtualMesh.
+	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *LocalityLoadBalancing) Reset() {
+	*x = LocalityLoadBalancing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalityLoadBalancing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalityLoadBalancing) ProtoMessage() {}
+
+func (x *LocalityLoadBalancing) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalityLoadBalancing.ProtoReflect.Descriptor instead.
+func (*LocalityLoadBalancing) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LocalityLoadBalancing) GetFailover() []*LocalityLoadBalancing_Failover {
+	if x != nil {
+		return x.Failover
+	}
+	return nil
+}
+
+func (x *LocalityLoadBalancing) GetDistribute() []*LocalityLoadBalancing_Distribute {
+	if x != nil {
+		return x.Distribute
+	}
+	return nil
+}
+
+func (x *LocalityLoadBalancing) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+// Describes the region to which requests originating from a region fail over.
+type LocalityLoadBalancing_Failover struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The region of the clients. Required.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// The region to which requests fail over. Required, and must differ from `from`.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *LocalityLoadBalancing_Failover) Reset() {
+	*x = LocalityLoadBalancing_Failover{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalityLoadBalancing_Failover) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalityLoadBalancing_Failover) ProtoMessage() {}
+
+func (x *LocalityLoadBalancing_Failover) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalityLoadBalancing_Failover.ProtoReflect.Descriptor instead.
+func (*LocalityLoadBalancing_Failover) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *LocalityLoadBalancing_Failover) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *LocalityLoadBalancing_Failover) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// Describes how requests originating from a locality are distributed across localities.
+type LocalityLoadBalancing_Distribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The locality of the clients, e.g. `us-west/zone1/*`. Required.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// The weight of requests sent to each locality, e.g. `{"us-west/zone1/*": 80, "us-west/zone2/*": 20}`.
+	// Weights must sum to 100.
+	To map[string]uint32 `protobuf:"bytes,2,rep,name=to,proto3" json:"to,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *LocalityLoadBalancing_Distribute) Reset() {
+	*x = LocalityLoadBalancing_Distribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalityLoadBalancing_Distribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalityLoadBalancing_Distribute) ProtoMessage() {}
+
+func (x *LocalityLoadBalancing_Distribute) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalityLoadBalancing_Distribute.ProtoReflect.Descriptor instead.
+func (*LocalityLoadBalancing_Distribute) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *LocalityLoadBalancing_Distribute) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *LocalityLoadBalancing_Distribute) GetTo() map[string]uint32 {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+var File_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDesc = []byte{
+	0x0a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc3, 0x03, 0x0a, 0x15, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x69, 0x6e, 0x67, 0x12, 0x54, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52,
+	0x08, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x0a, 0x64, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x1a, 0x2e, 0x0a, 0x08, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74,
+	0x6f, 0x1a, 0xab, 0x01, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x52, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x42, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x69, 0x6e,
+	0x67, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x54, 0x6f, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x02, 0x74, 0x6f, 0x1a, 0x35, 0x0a, 0x07, 0x54, 0x6f, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x46, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDescData = file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_goTypes = []interface{}{
+	(*LocalityLoadBalancing)(nil),            // 0: common.mesh.gloo.solo.io.LocalityLoadBalancing
+	(*LocalityLoadBalancing_Failover)(nil),   // 1: common.mesh.gloo.solo.io.LocalityLoadBalancing.Failover
+	(*LocalityLoadBalancing_Distribute)(nil), // 2: common.mesh.gloo.solo.io.LocalityLoadBalancing.Distribute
+	nil,                                      // 3: common.mesh.gloo.solo.io.LocalityLoadBalancing.Distribute.ToEntry
+}
+var file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_depIdxs = []int32{
+	1, // 0: common.mesh.gloo.solo.io.LocalityLoadBalancing.failover:type_name -> common.mesh.gloo.solo.io.LocalityLoadBalancing.Failover
+	2, // 1: common.mesh.gloo.solo.io.LocalityLoadBalancing.distribute:type_name -> common.mesh.gloo.solo.io.LocalityLoadBalancing.Distribute
+	3, // 2: common.mesh.gloo.solo.io.LocalityLoadBalancing.Distribute.to:type_name -> common.mesh.gloo.solo.io.LocalityLoadBalancing.Distribute.ToEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_init() }
+func file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_init() {
+	if File_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalityLoadBalancing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalityLoadBalancing_Failover); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalityLoadBalancing_Distribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_depIdxs,
+		MessageInfos:      file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto = out.File
+	file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_goTypes = nil
+	file_github_com_solo_io_gloo_mesh_api_common_v1_locality_proto_depIdxs = nil
+}
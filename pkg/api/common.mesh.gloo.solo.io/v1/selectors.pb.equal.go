@@ -66,6 +66,16 @@ func (m *DestinationSelector) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetExternalServiceRefs()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetExternalServiceRefs()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetExternalServiceRefs(), target.GetExternalServiceRefs()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -290,6 +300,47 @@ func (m *DestinationSelector_KubeServiceRefs) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *DestinationSelector_ExternalServiceRefs) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*DestinationSelector_ExternalServiceRefs)
+	if !ok {
+		that2, ok := that.(DestinationSelector_ExternalServiceRefs)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetServiceEntries()) != len(target.GetServiceEntries()) {
+		return false
+	}
+	for idx, v := range m.GetServiceEntries() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetServiceEntries()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetServiceEntries()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
 // Equal function
 func (m *WorkloadSelector_KubeWorkloadMatcher) Equal(that interface{}) bool {
 	if that == nil {
@@ -6,6 +6,7 @@
 // storage event is received for any of:
 // * Settings
 // * Meshes
+// * ServiceEntries
 // * ConfigMaps
 // * Services
 // * Pods
@@ -39,6 +40,9 @@ import (
 	appmesh_k8s_aws_v1beta2 "github.com/aws/aws-app-mesh-controller-for-k8s/apis/appmesh/v1beta2"
 	appmesh_k8s_aws_v1beta2_controllers "github.com/solo-io/external-apis/pkg/api/appmesh/appmesh.k8s.aws/v1beta2/controller"
 
+	networking_istio_io_v1alpha3_controllers "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/controller"
+	networking_istio_io_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
 	v1_controllers "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/controller"
 	v1 "k8s.io/api/core/v1"
 
@@ -53,6 +57,8 @@ type multiClusterAgentReconciler interface {
 
 	appmesh_k8s_aws_v1beta2_controllers.MulticlusterMeshReconciler
 
+	networking_istio_io_v1alpha3_controllers.MulticlusterServiceEntryReconciler
+
 	v1_controllers.MulticlusterConfigMapReconciler
 	v1_controllers.MulticlusterServiceReconciler
 	v1_controllers.MulticlusterPodReconciler
@@ -80,6 +86,9 @@ type AgentReconcileOptions struct {
 	// Options for reconciling Meshes
 	Meshes reconcile.Options
 
+	// Options for reconciling ServiceEntries
+	ServiceEntries reconcile.Options
+
 	// Options for reconciling ConfigMaps
 	ConfigMaps reconcile.Options
 	// Options for reconciling Services
@@ -130,6 +139,8 @@ func RegisterMultiClusterAgentReconciler(
 
 	appmesh_k8s_aws_v1beta2_controllers.NewMulticlusterMeshReconcileLoop("Mesh", clusters, options.Meshes).AddMulticlusterMeshReconciler(ctx, r, predicates...)
 
+	networking_istio_io_v1alpha3_controllers.NewMulticlusterServiceEntryReconcileLoop("ServiceEntry", clusters, options.ServiceEntries).AddMulticlusterServiceEntryReconciler(ctx, r, predicates...)
+
 	v1_controllers.NewMulticlusterConfigMapReconcileLoop("ConfigMap", clusters, options.ConfigMaps).AddMulticlusterConfigMapReconciler(ctx, r, predicates...)
 
 	v1_controllers.NewMulticlusterServiceReconcileLoop("Service", clusters, options.Services).AddMulticlusterServiceReconciler(ctx, r, predicates...)
@@ -180,6 +191,21 @@ func (r *multiClusterAgentReconcilerImpl) ReconcileMeshDeletion(clusterName stri
 	return err
 }
 
+func (r *multiClusterAgentReconcilerImpl) ReconcileServiceEntry(clusterName string, obj *networking_istio_io_v1alpha3.ServiceEntry) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileServiceEntryDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
 func (r *multiClusterAgentReconcilerImpl) ReconcileConfigMap(clusterName string, obj *v1.ConfigMap) (reconcile.Result, error) {
 	obj.ClusterName = clusterName
 	return r.base.ReconcileRemoteGeneric(obj)
@@ -322,6 +348,8 @@ type singleClusterAgentReconciler interface {
 
 	appmesh_k8s_aws_v1beta2_controllers.MeshReconciler
 
+	networking_istio_io_v1alpha3_controllers.ServiceEntryReconciler
+
 	v1_controllers.ConfigMapReconciler
 	v1_controllers.ServiceReconciler
 	v1_controllers.PodReconciler
@@ -373,6 +401,10 @@ func RegisterSingleClusterAgentReconciler(
 		return nil, err
 	}
 
+	if err := networking_istio_io_v1alpha3_controllers.NewServiceEntryReconcileLoop("ServiceEntry", mgr, options).RunServiceEntryReconciler(ctx, r, predicates...); err != nil {
+		return nil, err
+	}
+
 	if err := v1_controllers.NewConfigMapReconcileLoop("ConfigMap", mgr, options).RunConfigMapReconciler(ctx, r, predicates...); err != nil {
 		return nil, err
 	}
@@ -431,6 +463,19 @@ func (r *singleClusterAgentReconcilerImpl) ReconcileMeshDeletion(obj reconcile.R
 	return err
 }
 
+func (r *singleClusterAgentReconcilerImpl) ReconcileServiceEntry(obj *networking_istio_io_v1alpha3.ServiceEntry) (reconcile.Result, error) {
+	return r.base.ReconcileLocalGeneric(obj)
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileServiceEntryDeletion(obj reconcile.Request) error {
+	ref := &sk_core_v1.ObjectRef{
+		Name:      obj.Name,
+		Namespace: obj.Namespace,
+	}
+	_, err := r.base.ReconcileLocalGeneric(ref)
+	return err
+}
+
 func (r *singleClusterAgentReconcilerImpl) ReconcileConfigMap(obj *v1.ConfigMap) (reconcile.Result, error) {
 	return r.base.ReconcileLocalGeneric(obj)
 }
@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
 	reconcile "github.com/solo-io/skv2/pkg/reconcile"
+	v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	v10 "k8s.io/api/apps/v1"
 	v11 "k8s.io/api/core/v1"
 )
@@ -173,6 +174,21 @@ func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileService(clusterN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileService", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileService), clusterName, obj)
 }
 
+// ReconcileServiceEntry mocks base method.
+func (m *MockmultiClusterAgentReconciler) ReconcileServiceEntry(clusterName string, obj *v1alpha3.ServiceEntry) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileServiceEntry", clusterName, obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileServiceEntry indicates an expected call of ReconcileServiceEntry.
+func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileServiceEntry(clusterName, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileServiceEntry", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileServiceEntry), clusterName, obj)
+}
+
 // ReconcileSettings mocks base method.
 func (m *MockmultiClusterAgentReconciler) ReconcileSettings(clusterName string, obj *v1.Settings) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
@@ -361,6 +377,21 @@ func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileService(obj int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileService", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileService), obj)
 }
 
+// ReconcileServiceEntry mocks base method.
+func (m *MocksingleClusterAgentReconciler) ReconcileServiceEntry(obj *v1alpha3.ServiceEntry) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileServiceEntry", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileServiceEntry indicates an expected call of ReconcileServiceEntry.
+func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileServiceEntry(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileServiceEntry", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileServiceEntry), obj)
+}
+
 // ReconcileSettings mocks base method.
 func (m *MocksingleClusterAgentReconciler) ReconcileSettings(obj *v1.Settings) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
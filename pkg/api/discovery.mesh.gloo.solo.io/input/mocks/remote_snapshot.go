@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	v1beta2sets "github.com/solo-io/external-apis/pkg/api/appmesh/appmesh.k8s.aws/v1beta2/sets"
+	v1alpha3sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
 	v1sets "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1/sets"
 	v1sets0 "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	input "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/input"
@@ -179,6 +180,20 @@ func (mr *MockDiscoveryInputSnapshotMockRecorder) ReplicaSets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicaSets", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).ReplicaSets))
 }
 
+// ServiceEntries mocks base method.
+func (m *MockDiscoveryInputSnapshot) ServiceEntries() v1alpha3sets.ServiceEntrySet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceEntries")
+	ret0, _ := ret[0].(v1alpha3sets.ServiceEntrySet)
+	return ret0
+}
+
+// ServiceEntries indicates an expected call of ServiceEntries.
+func (mr *MockDiscoveryInputSnapshotMockRecorder) ServiceEntries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceEntries", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).ServiceEntries))
+}
+
 // Services mocks base method.
 func (m *MockDiscoveryInputSnapshot) Services() v1sets0.ServiceSet {
 	m.ctrl.T.Helper()
@@ -13,10 +13,12 @@ import (
 
 	appmesh_k8s_aws_v1beta2 "github.com/aws/aws-app-mesh-controller-for-k8s/apis/appmesh/v1beta2"
 	appmesh_k8s_aws_v1beta2_controllers "github.com/solo-io/external-apis/pkg/api/appmesh/appmesh.k8s.aws/v1beta2/controller"
+	networking_istio_io_v1alpha3_controllers "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/controller"
 	apps_v1_controllers "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1/controller"
 	v1_controllers "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/controller"
 	settings_mesh_gloo_solo_io_v1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
 	settings_mesh_gloo_solo_io_v1_controllers "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1/controller"
+	networking_istio_io_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	apps_v1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -26,6 +28,7 @@ import (
 // The Input Reconciler calls a simple func(id) error whenever a
 // storage event is received for any of:
 // * Meshes
+// * ServiceEntries
 // * ConfigMaps
 // * Services
 // * Pods
@@ -58,7 +61,7 @@ func RegisterInputReconciler(
 	singleClusterReconcileFunc input.SingleClusterReconcileFunc,
 	options ReconcileOptions,
 ) (input.InputReconciler, error) {
-	// [appmesh.k8s.aws/v1beta2 v1 apps/v1] false 3
+	// [appmesh.k8s.aws/v1beta2 networking.istio.io/v1alpha3 v1 apps/v1] false 4
 	// [settings.mesh.gloo.solo.io/v1]
 
 	base := input.NewInputReconciler(
@@ -73,6 +76,9 @@ func RegisterInputReconciler(
 	// initialize Meshes reconcile loop for remote clusters
 	appmesh_k8s_aws_v1beta2_controllers.NewMulticlusterMeshReconcileLoop("Mesh", clusters, options.Remote.Meshes).AddMulticlusterMeshReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
 
+	// initialize ServiceEntries reconcile loop for remote clusters
+	networking_istio_io_v1alpha3_controllers.NewMulticlusterServiceEntryReconcileLoop("ServiceEntry", clusters, options.Remote.ServiceEntries).AddMulticlusterServiceEntryReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
+
 	// initialize ConfigMaps reconcile loop for remote clusters
 	v1_controllers.NewMulticlusterConfigMapReconcileLoop("ConfigMap", clusters, options.Remote.ConfigMaps).AddMulticlusterConfigMapReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
 	// initialize Services reconcile loop for remote clusters
@@ -107,6 +113,9 @@ type RemoteReconcileOptions struct {
 	// Options for reconciling Meshes
 	Meshes reconcile.Options
 
+	// Options for reconciling ServiceEntries
+	ServiceEntries reconcile.Options
+
 	// Options for reconciling ConfigMaps
 	ConfigMaps reconcile.Options
 	// Options for reconciling Services
@@ -150,6 +159,21 @@ func (r *remoteInputReconciler) ReconcileMeshDeletion(clusterName string, obj re
 	return err
 }
 
+func (r *remoteInputReconciler) ReconcileServiceEntry(clusterName string, obj *networking_istio_io_v1alpha3.ServiceEntry) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *remoteInputReconciler) ReconcileServiceEntryDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
 func (r *remoteInputReconciler) ReconcileConfigMap(clusterName string, obj *v1.ConfigMap) (reconcile.Result, error) {
 	obj.ClusterName = clusterName
 	return r.base.ReconcileRemoteGeneric(obj)
@@ -4,6 +4,7 @@
 
 // The Input DiscoveryInputSnapshot contains the set of all:
 // * Meshes
+// * ServiceEntries
 // * ConfigMaps
 // * Services
 // * Pods
@@ -43,6 +44,10 @@ import (
 	appmesh_k8s_aws_v1beta2 "github.com/solo-io/external-apis/pkg/api/appmesh/appmesh.k8s.aws/v1beta2"
 	appmesh_k8s_aws_v1beta2_sets "github.com/solo-io/external-apis/pkg/api/appmesh/appmesh.k8s.aws/v1beta2/sets"
 
+	networking_istio_io_v1alpha3 "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3"
+	networking_istio_io_v1alpha3_sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
+	networking_istio_io_v1alpha3_types "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
 	v1 "github.com/solo-io/external-apis/pkg/api/k8s/core/v1"
 	v1_sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	v1_types "k8s.io/api/core/v1"
@@ -61,6 +66,12 @@ var DiscoveryInputSnapshotGVKs = []schema.GroupVersionKind{
 		Kind:    "Mesh",
 	},
 
+	schema.GroupVersionKind{
+		Group:   "networking.istio.io",
+		Version: "v1alpha3",
+		Kind:    "ServiceEntry",
+	},
+
 	schema.GroupVersionKind{
 		Group:   "",
 		Version: "v1",
@@ -115,6 +126,9 @@ type DiscoveryInputSnapshot interface {
 	// return the set of input Meshes
 	Meshes() appmesh_k8s_aws_v1beta2_sets.MeshSet
 
+	// return the set of input ServiceEntries
+	ServiceEntries() networking_istio_io_v1alpha3_sets.ServiceEntrySet
+
 	// return the set of input ConfigMaps
 	ConfigMaps() v1_sets.ConfigMapSet
 	// return the set of input Services
@@ -147,6 +161,9 @@ type DiscoveryInputSyncStatusOptions struct {
 	// sync status of Mesh objects
 	Mesh bool
 
+	// sync status of ServiceEntry objects
+	ServiceEntry bool
+
 	// sync status of ConfigMap objects
 	ConfigMap bool
 	// sync status of Service objects
@@ -173,6 +190,8 @@ type snapshotDiscoveryInput struct {
 
 	meshes appmesh_k8s_aws_v1beta2_sets.MeshSet
 
+	serviceEntries networking_istio_io_v1alpha3_sets.ServiceEntrySet
+
 	configMaps v1_sets.ConfigMapSet
 	services   v1_sets.ServiceSet
 	pods       v1_sets.PodSet
@@ -190,6 +209,8 @@ func NewDiscoveryInputSnapshot(
 
 	meshes appmesh_k8s_aws_v1beta2_sets.MeshSet,
 
+	serviceEntries networking_istio_io_v1alpha3_sets.ServiceEntrySet,
+
 	configMaps v1_sets.ConfigMapSet,
 	services v1_sets.ServiceSet,
 	pods v1_sets.PodSet,
@@ -205,16 +226,17 @@ func NewDiscoveryInputSnapshot(
 	return &snapshotDiscoveryInput{
 		name: name,
 
-		meshes:       meshes,
-		configMaps:   configMaps,
-		services:     services,
-		pods:         pods,
-		endpoints:    endpoints,
-		nodes:        nodes,
-		deployments:  deployments,
-		replicaSets:  replicaSets,
-		daemonSets:   daemonSets,
-		statefulSets: statefulSets,
+		meshes:         meshes,
+		serviceEntries: serviceEntries,
+		configMaps:     configMaps,
+		services:       services,
+		pods:           pods,
+		endpoints:      endpoints,
+		nodes:          nodes,
+		deployments:    deployments,
+		replicaSets:    replicaSets,
+		daemonSets:     daemonSets,
+		statefulSets:   statefulSets,
 	}
 }
 
@@ -225,6 +247,8 @@ func NewDiscoveryInputSnapshotFromGeneric(
 
 	meshSet := appmesh_k8s_aws_v1beta2_sets.NewMeshSet()
 
+	serviceEntrySet := networking_istio_io_v1alpha3_sets.NewServiceEntrySet()
+
 	configMapSet := v1_sets.NewConfigMapSet()
 	serviceSet := v1_sets.NewServiceSet()
 	podSet := v1_sets.NewPodSet()
@@ -248,6 +272,16 @@ func NewDiscoveryInputSnapshotFromGeneric(
 			meshSet.Insert(mesh.(*appmesh_k8s_aws_v1beta2_types.Mesh))
 		}
 
+		serviceEntries := snapshot[schema.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "ServiceEntry",
+		}]
+
+		for _, serviceEntry := range serviceEntries {
+			serviceEntrySet.Insert(serviceEntry.(*networking_istio_io_v1alpha3_types.ServiceEntry))
+		}
+
 		configMaps := snapshot[schema.GroupVersionKind{
 			Group:   "",
 			Version: "v1",
@@ -335,6 +369,7 @@ func NewDiscoveryInputSnapshotFromGeneric(
 	return NewDiscoveryInputSnapshot(
 		name,
 		meshSet,
+		serviceEntrySet,
 		configMapSet,
 		serviceSet,
 		podSet,
@@ -351,6 +386,10 @@ func (s snapshotDiscoveryInput) Meshes() appmesh_k8s_aws_v1beta2_sets.MeshSet {
 	return s.meshes
 }
 
+func (s snapshotDiscoveryInput) ServiceEntries() networking_istio_io_v1alpha3_sets.ServiceEntrySet {
+	return s.serviceEntries
+}
+
 func (s snapshotDiscoveryInput) ConfigMaps() v1_sets.ConfigMapSet {
 	return s.configMaps
 }
@@ -391,6 +430,7 @@ func (s snapshotDiscoveryInput) MarshalJSON() ([]byte, error) {
 	snapshotMap := map[string]interface{}{"name": s.name}
 
 	snapshotMap["meshes"] = s.meshes.List()
+	snapshotMap["serviceEntries"] = s.serviceEntries.List()
 	snapshotMap["configMaps"] = s.configMaps.List()
 	snapshotMap["services"] = s.services.List()
 	snapshotMap["pods"] = s.pods.List()
@@ -407,16 +447,17 @@ func (s snapshotDiscoveryInput) Clone() DiscoveryInputSnapshot {
 	return &snapshotDiscoveryInput{
 		name: s.name,
 
-		meshes:       s.meshes.Clone(),
-		configMaps:   s.configMaps.Clone(),
-		services:     s.services.Clone(),
-		pods:         s.pods.Clone(),
-		endpoints:    s.endpoints.Clone(),
-		nodes:        s.nodes.Clone(),
-		deployments:  s.deployments.Clone(),
-		replicaSets:  s.replicaSets.Clone(),
-		daemonSets:   s.daemonSets.Clone(),
-		statefulSets: s.statefulSets.Clone(),
+		meshes:         s.meshes.Clone(),
+		serviceEntries: s.serviceEntries.Clone(),
+		configMaps:     s.configMaps.Clone(),
+		services:       s.services.Clone(),
+		pods:           s.pods.Clone(),
+		endpoints:      s.endpoints.Clone(),
+		nodes:          s.nodes.Clone(),
+		deployments:    s.deployments.Clone(),
+		replicaSets:    s.replicaSets.Clone(),
+		daemonSets:     s.daemonSets.Clone(),
+		statefulSets:   s.statefulSets.Clone(),
 	}
 }
 
@@ -431,6 +472,9 @@ type DiscoveryInputBuildOptions struct {
 	// List options for composing a snapshot from Meshes
 	Meshes ResourceDiscoveryInputBuildOptions
 
+	// List options for composing a snapshot from ServiceEntries
+	ServiceEntries ResourceDiscoveryInputBuildOptions
+
 	// List options for composing a snapshot from ConfigMaps
 	ConfigMaps ResourceDiscoveryInputBuildOptions
 	// List options for composing a snapshot from Services
@@ -483,6 +527,8 @@ func (b *multiClusterDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, n
 
 	meshes := appmesh_k8s_aws_v1beta2_sets.NewMeshSet()
 
+	serviceEntries := networking_istio_io_v1alpha3_sets.NewServiceEntrySet()
+
 	configMaps := v1_sets.NewConfigMapSet()
 	services := v1_sets.NewServiceSet()
 	pods := v1_sets.NewPodSet()
@@ -501,6 +547,9 @@ func (b *multiClusterDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, n
 		if err := b.insertMeshesFromCluster(ctx, cluster, meshes, opts.Meshes); err != nil {
 			errs = multierror.Append(errs, err)
 		}
+		if err := b.insertServiceEntriesFromCluster(ctx, cluster, serviceEntries, opts.ServiceEntries); err != nil {
+			errs = multierror.Append(errs, err)
+		}
 		if err := b.insertConfigMapsFromCluster(ctx, cluster, configMaps, opts.ConfigMaps); err != nil {
 			errs = multierror.Append(errs, err)
 		}
@@ -535,6 +584,7 @@ func (b *multiClusterDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, n
 		name,
 
 		meshes,
+		serviceEntries,
 		configMaps,
 		services,
 		pods,
@@ -592,6 +642,49 @@ func (b *multiClusterDiscoveryInputBuilder) insertMeshesFromCluster(ctx context.
 	return nil
 }
 
+func (b *multiClusterDiscoveryInputBuilder) insertServiceEntriesFromCluster(ctx context.Context, cluster string, serviceEntries networking_istio_io_v1alpha3_sets.ServiceEntrySet, opts ResourceDiscoveryInputBuildOptions) error {
+	serviceEntryClient, err := networking_istio_io_v1alpha3.NewMulticlusterServiceEntryClient(b.client).Cluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	if opts.Verifier != nil {
+		mgr, err := b.clusters.Cluster(cluster)
+		if err != nil {
+			return err
+		}
+
+		gvk := schema.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "ServiceEntry",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			cluster,
+			mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	serviceEntryList, err := serviceEntryClient.ListServiceEntry(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range serviceEntryList.Items {
+		item := item.DeepCopy()    // pike + own
+		item.ClusterName = cluster // set cluster for in-memory processing
+		serviceEntries.Insert(item)
+	}
+
+	return nil
+}
+
 func (b *multiClusterDiscoveryInputBuilder) insertConfigMapsFromCluster(ctx context.Context, cluster string, configMaps v1_sets.ConfigMapSet, opts ResourceDiscoveryInputBuildOptions) error {
 	configMapClient, err := v1.NewMulticlusterConfigMapClient(b.client).Cluster(cluster)
 	if err != nil {
@@ -1001,6 +1094,8 @@ func (b *singleClusterDiscoveryInputBuilder) BuildSnapshot(ctx context.Context,
 
 	meshes := appmesh_k8s_aws_v1beta2_sets.NewMeshSet()
 
+	serviceEntries := networking_istio_io_v1alpha3_sets.NewServiceEntrySet()
+
 	configMaps := v1_sets.NewConfigMapSet()
 	services := v1_sets.NewServiceSet()
 	pods := v1_sets.NewPodSet()
@@ -1017,6 +1112,9 @@ func (b *singleClusterDiscoveryInputBuilder) BuildSnapshot(ctx context.Context,
 	if err := b.insertMeshes(ctx, meshes, opts.Meshes); err != nil {
 		errs = multierror.Append(errs, err)
 	}
+	if err := b.insertServiceEntries(ctx, serviceEntries, opts.ServiceEntries); err != nil {
+		errs = multierror.Append(errs, err)
+	}
 	if err := b.insertConfigMaps(ctx, configMaps, opts.ConfigMaps); err != nil {
 		errs = multierror.Append(errs, err)
 	}
@@ -1049,6 +1147,7 @@ func (b *singleClusterDiscoveryInputBuilder) BuildSnapshot(ctx context.Context,
 		name,
 
 		meshes,
+		serviceEntries,
 		configMaps,
 		services,
 		pods,
@@ -1097,6 +1196,40 @@ func (b *singleClusterDiscoveryInputBuilder) insertMeshes(ctx context.Context, m
 	return nil
 }
 
+func (b *singleClusterDiscoveryInputBuilder) insertServiceEntries(ctx context.Context, serviceEntries networking_istio_io_v1alpha3_sets.ServiceEntrySet, opts ResourceDiscoveryInputBuildOptions) error {
+
+	if opts.Verifier != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "ServiceEntry",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			"", // verify in the local cluster
+			b.mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	serviceEntryList, err := networking_istio_io_v1alpha3.NewServiceEntryClient(b.mgr.GetClient()).ListServiceEntry(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range serviceEntryList.Items {
+		item := item.DeepCopy() // pike + own the item.
+		item.ClusterName = b.clusterName
+		serviceEntries.Insert(item)
+	}
+
+	return nil
+}
+
 func (b *singleClusterDiscoveryInputBuilder) insertConfigMaps(ctx context.Context, configMaps v1_sets.ConfigMapSet, opts ResourceDiscoveryInputBuildOptions) error {
 
 	if opts.Verifier != nil {
@@ -1418,6 +1551,8 @@ func (i *inMemoryDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, name
 
 	meshes := appmesh_k8s_aws_v1beta2_sets.NewMeshSet()
 
+	serviceEntries := networking_istio_io_v1alpha3_sets.NewServiceEntrySet()
+
 	configMaps := v1_sets.NewConfigMapSet()
 	services := v1_sets.NewServiceSet()
 	pods := v1_sets.NewPodSet()
@@ -1434,6 +1569,9 @@ func (i *inMemoryDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, name
 		// insert Meshes
 		case *appmesh_k8s_aws_v1beta2_types.Mesh:
 			i.insertMesh(ctx, obj, meshes, opts)
+		// insert ServiceEntries
+		case *networking_istio_io_v1alpha3_types.ServiceEntry:
+			i.insertServiceEntry(ctx, obj, serviceEntries, opts)
 		// insert ConfigMaps
 		case *v1_types.ConfigMap:
 			i.insertConfigMap(ctx, obj, configMaps, opts)
@@ -1468,6 +1606,7 @@ func (i *inMemoryDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, name
 		name,
 
 		meshes,
+		serviceEntries,
 		configMaps,
 		services,
 		pods,
@@ -1510,6 +1649,36 @@ func (i *inMemoryDiscoveryInputBuilder) insertMesh(
 	}
 }
 
+func (i *inMemoryDiscoveryInputBuilder) insertServiceEntry(
+	ctx context.Context,
+	serviceEntry *networking_istio_io_v1alpha3_types.ServiceEntry,
+	serviceEntrySet networking_istio_io_v1alpha3_sets.ServiceEntrySet,
+	buildOpts DiscoveryInputBuildOptions,
+) {
+
+	opts := buildOpts.ServiceEntries.ListOptions
+
+	listOpts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listOpts)
+	}
+
+	filteredOut := false
+	if listOpts.Namespace != "" {
+		filteredOut = serviceEntry.Namespace != listOpts.Namespace
+	}
+	if listOpts.LabelSelector != nil {
+		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(serviceEntry.Labels))
+	}
+	if listOpts.FieldSelector != nil {
+		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
+	}
+
+	if !filteredOut {
+		serviceEntrySet.Insert(serviceEntry)
+	}
+}
+
 func (i *inMemoryDiscoveryInputBuilder) insertConfigMap(
 	ctx context.Context,
 	configMap *v1_types.ConfigMap,
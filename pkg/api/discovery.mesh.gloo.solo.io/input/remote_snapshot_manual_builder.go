@@ -9,6 +9,9 @@ import (
 	appmesh_k8s_aws_v1beta2 "github.com/aws/aws-app-mesh-controller-for-k8s/apis/appmesh/v1beta2"
 	appmesh_k8s_aws_v1beta2_sets "github.com/solo-io/external-apis/pkg/api/appmesh/appmesh.k8s.aws/v1beta2/sets"
 
+	networking_istio_io_v1alpha3_sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
+	networking_istio_io_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
 	v1_sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	v1 "k8s.io/api/core/v1"
 
@@ -21,6 +24,8 @@ type InputDiscoveryInputSnapshotManualBuilder struct {
 
 	meshes appmesh_k8s_aws_v1beta2_sets.MeshSet
 
+	serviceEntries networking_istio_io_v1alpha3_sets.ServiceEntrySet
+
 	configMaps v1_sets.ConfigMapSet
 	services   v1_sets.ServiceSet
 	pods       v1_sets.PodSet
@@ -39,6 +44,8 @@ func NewInputDiscoveryInputSnapshotManualBuilder(name string) *InputDiscoveryInp
 
 		meshes: appmesh_k8s_aws_v1beta2_sets.NewMeshSet(),
 
+		serviceEntries: networking_istio_io_v1alpha3_sets.NewServiceEntrySet(),
+
 		configMaps: v1_sets.NewConfigMapSet(),
 		services:   v1_sets.NewServiceSet(),
 		pods:       v1_sets.NewPodSet(),
@@ -58,6 +65,8 @@ func (i *InputDiscoveryInputSnapshotManualBuilder) Build() DiscoveryInputSnapsho
 
 		i.meshes,
 
+		i.serviceEntries,
+
 		i.configMaps,
 		i.services,
 		i.pods,
@@ -74,6 +83,10 @@ func (i *InputDiscoveryInputSnapshotManualBuilder) AddMeshes(meshes []*appmesh_k
 	i.meshes.Insert(meshes...)
 	return i
 }
+func (i *InputDiscoveryInputSnapshotManualBuilder) AddServiceEntries(serviceEntries []*networking_istio_io_v1alpha3.ServiceEntry) *InputDiscoveryInputSnapshotManualBuilder {
+	i.serviceEntries.Insert(serviceEntries...)
+	return i
+}
 func (i *InputDiscoveryInputSnapshotManualBuilder) AddConfigMaps(configMaps []*v1.ConfigMap) *InputDiscoveryInputSnapshotManualBuilder {
 	i.configMaps.Insert(configMaps...)
 	return i
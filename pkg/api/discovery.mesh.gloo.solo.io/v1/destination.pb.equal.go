@@ -452,6 +452,16 @@ func (m *DestinationSpec_ExternalService) Equal(that interface{}) bool {
 
 	}
 
+	if h, ok := interface{}(m.GetRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRef(), target.GetRef()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -911,6 +921,23 @@ func (m *DestinationStatus_AppliedTrafficPolicy) Equal(that interface{}) bool {
 		}
 	}
 
+	if len(m.GetMergedFields()) != len(target.GetMergedFields()) {
+		return false
+	}
+	for idx, v := range m.GetMergedFields() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetMergedFields()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetMergedFields()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -1028,5 +1055,64 @@ func (m *DestinationStatus_AppliedFederation) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocalityLoadBalancing()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocalityLoadBalancing()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocalityLoadBalancing(), target.GetLocalityLoadBalancing()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *DestinationStatus_AppliedTrafficPolicy_MergedField) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*DestinationStatus_AppliedTrafficPolicy_MergedField)
+	if !ok {
+		that2, ok := that.(DestinationStatus_AppliedTrafficPolicy_MergedField)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetField(), target.GetField()) != 0 {
+		return false
+	}
+
+	if m.GetStrategy() != target.GetStrategy() {
+		return false
+	}
+
+	if len(m.GetPolicies()) != len(target.GetPolicies()) {
+		return false
+	}
+	for idx, v := range m.GetPolicies() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetPolicies()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetPolicies()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
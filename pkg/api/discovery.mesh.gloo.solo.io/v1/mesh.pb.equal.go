@@ -326,6 +326,23 @@ func (m *MeshSpec_Istio) Equal(that interface{}) bool {
 		return false
 	}
 
+	if len(m.GetEgressGateways()) != len(target.GetEgressGateways()) {
+		return false
+	}
+	for idx, v := range m.GetEgressGateways() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetEgressGateways()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetEgressGateways()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -514,6 +531,66 @@ func (m *MeshSpec_AgentInfo) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *MeshSpec_Istio_EgressGatewayInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*MeshSpec_Istio_EgressGatewayInfo)
+	if !ok {
+		that2, ok := that.(MeshSpec_Istio_EgressGatewayInfo)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetNamespace(), target.GetNamespace()) != 0 {
+		return false
+	}
+
+	if len(m.GetWorkloadLabels()) != len(target.GetWorkloadLabels()) {
+		return false
+	}
+	for k, v := range m.GetWorkloadLabels() {
+
+		if strings.Compare(v, target.GetWorkloadLabels()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetPorts()) != len(target.GetPorts()) {
+		return false
+	}
+	for idx, v := range m.GetPorts() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetPorts()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetPorts()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
 // Equal function
 func (m *MeshSpec_Istio_IngressGatewayInfo) Equal(that interface{}) bool {
 	if that == nil {
@@ -596,6 +673,38 @@ func (m *MeshSpec_Istio_IngressGatewayInfo) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *MeshSpec_Istio_EgressGatewayInfo_ServicePort) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*MeshSpec_Istio_EgressGatewayInfo_ServicePort)
+	if !ok {
+		that2, ok := that.(MeshSpec_Istio_EgressGatewayInfo_ServicePort)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetPort() != target.GetPort() {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	return true
+}
+
 // Equal function
 func (m *MeshStatus_AppliedIngressGateway) Equal(that interface{}) bool {
 	if that == nil {
@@ -0,0 +1,511 @@
+// Code generated by skv2. DO NOT EDIT.
+
+//go:generate mockgen -source ./clients.go -destination mocks/clients.go
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/solo-io/skv2/pkg/controllerutils"
+	"github.com/solo-io/skv2/pkg/multicluster"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MulticlusterClientset for the consul.hashicorp.com/v1alpha1 APIs
+type MulticlusterClientset interface {
+	// Cluster returns a Clientset for the given cluster
+	Cluster(cluster string) (Clientset, error)
+}
+
+type multiclusterClientset struct {
+	client multicluster.Client
+}
+
+func NewMulticlusterClientset(client multicluster.Client) MulticlusterClientset {
+	return &multiclusterClientset{client: client}
+}
+
+func (m *multiclusterClientset) Cluster(cluster string) (Clientset, error) {
+	client, err := m.client.Cluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return NewClientset(client), nil
+}
+
+// clienset for the consul.hashicorp.com/v1alpha1 APIs
+type Clientset interface {
+	// clienset for the consul.hashicorp.com/v1alpha1/v1alpha1 APIs
+	ServiceSplitters() ServiceSplitterClient
+	// clienset for the consul.hashicorp.com/v1alpha1/v1alpha1 APIs
+	ServiceRouters() ServiceRouterClient
+	// clienset for the consul.hashicorp.com/v1alpha1/v1alpha1 APIs
+	ServiceIntentions() ServiceIntentionsClient
+}
+
+type clientSet struct {
+	client client.Client
+}
+
+func NewClientsetFromConfig(cfg *rest.Config) (Clientset, error) {
+	scheme := scheme.Scheme
+	if err := AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	client, err := client.New(cfg, client.Options{
+		Scheme: scheme,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return NewClientset(client), nil
+}
+
+func NewClientset(client client.Client) Clientset {
+	return &clientSet{client: client}
+}
+
+// clienset for the consul.hashicorp.com/v1alpha1/v1alpha1 APIs
+func (c *clientSet) ServiceSplitters() ServiceSplitterClient {
+	return NewServiceSplitterClient(c.client)
+}
+
+// clienset for the consul.hashicorp.com/v1alpha1/v1alpha1 APIs
+func (c *clientSet) ServiceRouters() ServiceRouterClient {
+	return NewServiceRouterClient(c.client)
+}
+
+// clienset for the consul.hashicorp.com/v1alpha1/v1alpha1 APIs
+func (c *clientSet) ServiceIntentions() ServiceIntentionsClient {
+	return NewServiceIntentionsClient(c.client)
+}
+
+// Reader knows how to read and list ServiceSplitters.
+type ServiceSplitterReader interface {
+	// Get retrieves a ServiceSplitter for the given object key
+	GetServiceSplitter(ctx context.Context, key client.ObjectKey) (*ServiceSplitter, error)
+
+	// List retrieves list of ServiceSplitters for a given namespace and list options.
+	ListServiceSplitter(ctx context.Context, opts ...client.ListOption) (*ServiceSplitterList, error)
+}
+
+// ServiceSplitterTransitionFunction instructs the ServiceSplitterWriter how to transition between an existing
+// ServiceSplitter object and a desired on an Upsert
+type ServiceSplitterTransitionFunction func(existing, desired *ServiceSplitter) error
+
+// Writer knows how to create, delete, and update ServiceSplitters.
+type ServiceSplitterWriter interface {
+	// Create saves the ServiceSplitter object.
+	CreateServiceSplitter(ctx context.Context, obj *ServiceSplitter, opts ...client.CreateOption) error
+
+	// Delete deletes the ServiceSplitter object.
+	DeleteServiceSplitter(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error
+
+	// Update updates the given ServiceSplitter object.
+	UpdateServiceSplitter(ctx context.Context, obj *ServiceSplitter, opts ...client.UpdateOption) error
+
+	// Patch patches the given ServiceSplitter object.
+	PatchServiceSplitter(ctx context.Context, obj *ServiceSplitter, patch client.Patch, opts ...client.PatchOption) error
+
+	// DeleteAllOf deletes all ServiceSplitter objects matching the given options.
+	DeleteAllOfServiceSplitter(ctx context.Context, opts ...client.DeleteAllOfOption) error
+
+	// Create or Update the ServiceSplitter object.
+	UpsertServiceSplitter(ctx context.Context, obj *ServiceSplitter, transitionFuncs ...ServiceSplitterTransitionFunction) error
+}
+
+// StatusWriter knows how to update status subresource of a ServiceSplitter object.
+type ServiceSplitterStatusWriter interface {
+	// Update updates the fields corresponding to the status subresource for the
+	// given ServiceSplitter object.
+	UpdateServiceSplitterStatus(ctx context.Context, obj *ServiceSplitter, opts ...client.UpdateOption) error
+
+	// Patch patches the given ServiceSplitter object's subresource.
+	PatchServiceSplitterStatus(ctx context.Context, obj *ServiceSplitter, patch client.Patch, opts ...client.PatchOption) error
+}
+
+// Client knows how to perform CRUD operations on ServiceSplitters.
+type ServiceSplitterClient interface {
+	ServiceSplitterReader
+	ServiceSplitterWriter
+	ServiceSplitterStatusWriter
+}
+
+type serviceSplitterClient struct {
+	client client.Client
+}
+
+func NewServiceSplitterClient(client client.Client) *serviceSplitterClient {
+	return &serviceSplitterClient{client: client}
+}
+
+func (c *serviceSplitterClient) GetServiceSplitter(ctx context.Context, key client.ObjectKey) (*ServiceSplitter, error) {
+	obj := &ServiceSplitter{}
+	if err := c.client.Get(ctx, key, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (c *serviceSplitterClient) ListServiceSplitter(ctx context.Context, opts ...client.ListOption) (*ServiceSplitterList, error) {
+	list := &ServiceSplitterList{}
+	if err := c.client.List(ctx, list, opts...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (c *serviceSplitterClient) CreateServiceSplitter(ctx context.Context, obj *ServiceSplitter, opts ...client.CreateOption) error {
+	return c.client.Create(ctx, obj, opts...)
+}
+
+func (c *serviceSplitterClient) DeleteServiceSplitter(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	obj := &ServiceSplitter{}
+	obj.SetName(key.Name)
+	obj.SetNamespace(key.Namespace)
+	return c.client.Delete(ctx, obj, opts...)
+}
+
+func (c *serviceSplitterClient) UpdateServiceSplitter(ctx context.Context, obj *ServiceSplitter, opts ...client.UpdateOption) error {
+	return c.client.Update(ctx, obj, opts...)
+}
+
+func (c *serviceSplitterClient) PatchServiceSplitter(ctx context.Context, obj *ServiceSplitter, patch client.Patch, opts ...client.PatchOption) error {
+	return c.client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *serviceSplitterClient) DeleteAllOfServiceSplitter(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	obj := &ServiceSplitter{}
+	return c.client.DeleteAllOf(ctx, obj, opts...)
+}
+
+func (c *serviceSplitterClient) UpsertServiceSplitter(ctx context.Context, obj *ServiceSplitter, transitionFuncs ...ServiceSplitterTransitionFunction) error {
+	genericTxFunc := func(existing, desired runtime.Object) error {
+		for _, txFunc := range transitionFuncs {
+			if err := txFunc(existing.(*ServiceSplitter), desired.(*ServiceSplitter)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	_, err := controllerutils.Upsert(ctx, c.client, obj, genericTxFunc)
+	return err
+}
+
+func (c *serviceSplitterClient) UpdateServiceSplitterStatus(ctx context.Context, obj *ServiceSplitter, opts ...client.UpdateOption) error {
+	return c.client.Status().Update(ctx, obj, opts...)
+}
+
+func (c *serviceSplitterClient) PatchServiceSplitterStatus(ctx context.Context, obj *ServiceSplitter, patch client.Patch, opts ...client.PatchOption) error {
+	return c.client.Status().Patch(ctx, obj, patch, opts...)
+}
+
+// Provides ServiceSplitterClients for multiple clusters.
+type MulticlusterServiceSplitterClient interface {
+	// Cluster returns a ServiceSplitterClient for the given cluster
+	Cluster(cluster string) (ServiceSplitterClient, error)
+}
+
+type multiclusterServiceSplitterClient struct {
+	client multicluster.Client
+}
+
+func NewMulticlusterServiceSplitterClient(client multicluster.Client) MulticlusterServiceSplitterClient {
+	return &multiclusterServiceSplitterClient{client: client}
+}
+
+func (m *multiclusterServiceSplitterClient) Cluster(cluster string) (ServiceSplitterClient, error) {
+	client, err := m.client.Cluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return NewServiceSplitterClient(client), nil
+}
+
+// Reader knows how to read and list ServiceRouters.
+type ServiceRouterReader interface {
+	// Get retrieves a ServiceRouter for the given object key
+	GetServiceRouter(ctx context.Context, key client.ObjectKey) (*ServiceRouter, error)
+
+	// List retrieves list of ServiceRouters for a given namespace and list options.
+	ListServiceRouter(ctx context.Context, opts ...client.ListOption) (*ServiceRouterList, error)
+}
+
+// ServiceRouterTransitionFunction instructs the ServiceRouterWriter how to transition between an existing
+// ServiceRouter object and a desired on an Upsert
+type ServiceRouterTransitionFunction func(existing, desired *ServiceRouter) error
+
+// Writer knows how to create, delete, and update ServiceRouters.
+type ServiceRouterWriter interface {
+	// Create saves the ServiceRouter object.
+	CreateServiceRouter(ctx context.Context, obj *ServiceRouter, opts ...client.CreateOption) error
+
+	// Delete deletes the ServiceRouter object.
+	DeleteServiceRouter(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error
+
+	// Update updates the given ServiceRouter object.
+	UpdateServiceRouter(ctx context.Context, obj *ServiceRouter, opts ...client.UpdateOption) error
+
+	// Patch patches the given ServiceRouter object.
+	PatchServiceRouter(ctx context.Context, obj *ServiceRouter, patch client.Patch, opts ...client.PatchOption) error
+
+	// DeleteAllOf deletes all ServiceRouter objects matching the given options.
+	DeleteAllOfServiceRouter(ctx context.Context, opts ...client.DeleteAllOfOption) error
+
+	// Create or Update the ServiceRouter object.
+	UpsertServiceRouter(ctx context.Context, obj *ServiceRouter, transitionFuncs ...ServiceRouterTransitionFunction) error
+}
+
+// StatusWriter knows how to update status subresource of a ServiceRouter object.
+type ServiceRouterStatusWriter interface {
+	// Update updates the fields corresponding to the status subresource for the
+	// given ServiceRouter object.
+	UpdateServiceRouterStatus(ctx context.Context, obj *ServiceRouter, opts ...client.UpdateOption) error
+
+	// Patch patches the given ServiceRouter object's subresource.
+	PatchServiceRouterStatus(ctx context.Context, obj *ServiceRouter, patch client.Patch, opts ...client.PatchOption) error
+}
+
+// Client knows how to perform CRUD operations on ServiceRouters.
+type ServiceRouterClient interface {
+	ServiceRouterReader
+	ServiceRouterWriter
+	ServiceRouterStatusWriter
+}
+
+type serviceRouterClient struct {
+	client client.Client
+}
+
+func NewServiceRouterClient(client client.Client) *serviceRouterClient {
+	return &serviceRouterClient{client: client}
+}
+
+func (c *serviceRouterClient) GetServiceRouter(ctx context.Context, key client.ObjectKey) (*ServiceRouter, error) {
+	obj := &ServiceRouter{}
+	if err := c.client.Get(ctx, key, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (c *serviceRouterClient) ListServiceRouter(ctx context.Context, opts ...client.ListOption) (*ServiceRouterList, error) {
+	list := &ServiceRouterList{}
+	if err := c.client.List(ctx, list, opts...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (c *serviceRouterClient) CreateServiceRouter(ctx context.Context, obj *ServiceRouter, opts ...client.CreateOption) error {
+	return c.client.Create(ctx, obj, opts...)
+}
+
+func (c *serviceRouterClient) DeleteServiceRouter(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	obj := &ServiceRouter{}
+	obj.SetName(key.Name)
+	obj.SetNamespace(key.Namespace)
+	return c.client.Delete(ctx, obj, opts...)
+}
+
+func (c *serviceRouterClient) UpdateServiceRouter(ctx context.Context, obj *ServiceRouter, opts ...client.UpdateOption) error {
+	return c.client.Update(ctx, obj, opts...)
+}
+
+func (c *serviceRouterClient) PatchServiceRouter(ctx context.Context, obj *ServiceRouter, patch client.Patch, opts ...client.PatchOption) error {
+	return c.client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *serviceRouterClient) DeleteAllOfServiceRouter(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	obj := &ServiceRouter{}
+	return c.client.DeleteAllOf(ctx, obj, opts...)
+}
+
+func (c *serviceRouterClient) UpsertServiceRouter(ctx context.Context, obj *ServiceRouter, transitionFuncs ...ServiceRouterTransitionFunction) error {
+	genericTxFunc := func(existing, desired runtime.Object) error {
+		for _, txFunc := range transitionFuncs {
+			if err := txFunc(existing.(*ServiceRouter), desired.(*ServiceRouter)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	_, err := controllerutils.Upsert(ctx, c.client, obj, genericTxFunc)
+	return err
+}
+
+func (c *serviceRouterClient) UpdateServiceRouterStatus(ctx context.Context, obj *ServiceRouter, opts ...client.UpdateOption) error {
+	return c.client.Status().Update(ctx, obj, opts...)
+}
+
+func (c *serviceRouterClient) PatchServiceRouterStatus(ctx context.Context, obj *ServiceRouter, patch client.Patch, opts ...client.PatchOption) error {
+	return c.client.Status().Patch(ctx, obj, patch, opts...)
+}
+
+// Provides ServiceRouterClients for multiple clusters.
+type MulticlusterServiceRouterClient interface {
+	// Cluster returns a ServiceRouterClient for the given cluster
+	Cluster(cluster string) (ServiceRouterClient, error)
+}
+
+type multiclusterServiceRouterClient struct {
+	client multicluster.Client
+}
+
+func NewMulticlusterServiceRouterClient(client multicluster.Client) MulticlusterServiceRouterClient {
+	return &multiclusterServiceRouterClient{client: client}
+}
+
+func (m *multiclusterServiceRouterClient) Cluster(cluster string) (ServiceRouterClient, error) {
+	client, err := m.client.Cluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return NewServiceRouterClient(client), nil
+}
+
+// Reader knows how to read and list ServiceIntentionss.
+type ServiceIntentionsReader interface {
+	// Get retrieves a ServiceIntentions for the given object key
+	GetServiceIntentions(ctx context.Context, key client.ObjectKey) (*ServiceIntentions, error)
+
+	// List retrieves list of ServiceIntentionss for a given namespace and list options.
+	ListServiceIntentions(ctx context.Context, opts ...client.ListOption) (*ServiceIntentionsList, error)
+}
+
+// ServiceIntentionsTransitionFunction instructs the ServiceIntentionsWriter how to transition between an existing
+// ServiceIntentions object and a desired on an Upsert
+type ServiceIntentionsTransitionFunction func(existing, desired *ServiceIntentions) error
+
+// Writer knows how to create, delete, and update ServiceIntentionss.
+type ServiceIntentionsWriter interface {
+	// Create saves the ServiceIntentions object.
+	CreateServiceIntentions(ctx context.Context, obj *ServiceIntentions, opts ...client.CreateOption) error
+
+	// Delete deletes the ServiceIntentions object.
+	DeleteServiceIntentions(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error
+
+	// Update updates the given ServiceIntentions object.
+	UpdateServiceIntentions(ctx context.Context, obj *ServiceIntentions, opts ...client.UpdateOption) error
+
+	// Patch patches the given ServiceIntentions object.
+	PatchServiceIntentions(ctx context.Context, obj *ServiceIntentions, patch client.Patch, opts ...client.PatchOption) error
+
+	// DeleteAllOf deletes all ServiceIntentions objects matching the given options.
+	DeleteAllOfServiceIntentions(ctx context.Context, opts ...client.DeleteAllOfOption) error
+
+	// Create or Update the ServiceIntentions object.
+	UpsertServiceIntentions(ctx context.Context, obj *ServiceIntentions, transitionFuncs ...ServiceIntentionsTransitionFunction) error
+}
+
+// StatusWriter knows how to update status subresource of a ServiceIntentions object.
+type ServiceIntentionsStatusWriter interface {
+	// Update updates the fields corresponding to the status subresource for the
+	// given ServiceIntentions object.
+	UpdateServiceIntentionsStatus(ctx context.Context, obj *ServiceIntentions, opts ...client.UpdateOption) error
+
+	// Patch patches the given ServiceIntentions object's subresource.
+	PatchServiceIntentionsStatus(ctx context.Context, obj *ServiceIntentions, patch client.Patch, opts ...client.PatchOption) error
+}
+
+// Client knows how to perform CRUD operations on ServiceIntentionss.
+type ServiceIntentionsClient interface {
+	ServiceIntentionsReader
+	ServiceIntentionsWriter
+	ServiceIntentionsStatusWriter
+}
+
+type serviceIntentionsClient struct {
+	client client.Client
+}
+
+func NewServiceIntentionsClient(client client.Client) *serviceIntentionsClient {
+	return &serviceIntentionsClient{client: client}
+}
+
+func (c *serviceIntentionsClient) GetServiceIntentions(ctx context.Context, key client.ObjectKey) (*ServiceIntentions, error) {
+	obj := &ServiceIntentions{}
+	if err := c.client.Get(ctx, key, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (c *serviceIntentionsClient) ListServiceIntentions(ctx context.Context, opts ...client.ListOption) (*ServiceIntentionsList, error) {
+	list := &ServiceIntentionsList{}
+	if err := c.client.List(ctx, list, opts...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (c *serviceIntentionsClient) CreateServiceIntentions(ctx context.Context, obj *ServiceIntentions, opts ...client.CreateOption) error {
+	return c.client.Create(ctx, obj, opts...)
+}
+
+func (c *serviceIntentionsClient) DeleteServiceIntentions(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	obj := &ServiceIntentions{}
+	obj.SetName(key.Name)
+	obj.SetNamespace(key.Namespace)
+	return c.client.Delete(ctx, obj, opts...)
+}
+
+func (c *serviceIntentionsClient) UpdateServiceIntentions(ctx context.Context, obj *ServiceIntentions, opts ...client.UpdateOption) error {
+	return c.client.Update(ctx, obj, opts...)
+}
+
+func (c *serviceIntentionsClient) PatchServiceIntentions(ctx context.Context, obj *ServiceIntentions, patch client.Patch, opts ...client.PatchOption) error {
+	return c.client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *serviceIntentionsClient) DeleteAllOfServiceIntentions(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	obj := &ServiceIntentions{}
+	return c.client.DeleteAllOf(ctx, obj, opts...)
+}
+
+func (c *serviceIntentionsClient) UpsertServiceIntentions(ctx context.Context, obj *ServiceIntentions, transitionFuncs ...ServiceIntentionsTransitionFunction) error {
+	genericTxFunc := func(existing, desired runtime.Object) error {
+		for _, txFunc := range transitionFuncs {
+			if err := txFunc(existing.(*ServiceIntentions), desired.(*ServiceIntentions)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	_, err := controllerutils.Upsert(ctx, c.client, obj, genericTxFunc)
+	return err
+}
+
+func (c *serviceIntentionsClient) UpdateServiceIntentionsStatus(ctx context.Context, obj *ServiceIntentions, opts ...client.UpdateOption) error {
+	return c.client.Status().Update(ctx, obj, opts...)
+}
+
+func (c *serviceIntentionsClient) PatchServiceIntentionsStatus(ctx context.Context, obj *ServiceIntentions, patch client.Patch, opts ...client.PatchOption) error {
+	return c.client.Status().Patch(ctx, obj, patch, opts...)
+}
+
+// Provides ServiceIntentionsClients for multiple clusters.
+type MulticlusterServiceIntentionsClient interface {
+	// Cluster returns a ServiceIntentionsClient for the given cluster
+	Cluster(cluster string) (ServiceIntentionsClient, error)
+}
+
+type multiclusterServiceIntentionsClient struct {
+	client multicluster.Client
+}
+
+func NewMulticlusterServiceIntentionsClient(client multicluster.Client) MulticlusterServiceIntentionsClient {
+	return &multiclusterServiceIntentionsClient{client: client}
+}
+
+func (m *multiclusterServiceIntentionsClient) Cluster(cluster string) (ServiceIntentionsClient, error) {
+	client, err := m.client.Cluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return NewServiceIntentionsClient(client), nil
+}
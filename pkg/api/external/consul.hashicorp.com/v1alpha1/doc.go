@@ -0,0 +1,6 @@
+// Code generated by skv2. DO NOT EDIT.
+
+// Package v1alpha1 contains API Schema definitions for the consul.hashicorp.com v1alpha1 API group
+// +k8s:deepcopy-gen=package,register
+// +groupName=consul.hashicorp.com
+package v1alpha1
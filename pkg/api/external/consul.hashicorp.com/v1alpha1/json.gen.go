@@ -0,0 +1,58 @@
+// Code generated by skv2. DO NOT EDIT.
+
+// Generated json marshal and unmarshal functions
+
+package v1alpha1
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	math "math"
+
+	jsonpb "github.com/golang/protobuf/jsonpb"
+	proto "github.com/golang/protobuf/proto"
+	skv2jsonpb "github.com/solo-io/skv2/pkg/kube_jsonpb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+var (
+	marshaller   = &skv2jsonpb.Marshaler{}
+	unmarshaller = &jsonpb.Unmarshaler{}
+)
+
+// MarshalJSON is a custom marshaler for ServiceSplitterSpec
+func (this *ServiceSplitterSpec) MarshalJSON() ([]byte, error) {
+	str, err := marshaller.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for ServiceSplitterSpec
+func (this *ServiceSplitterSpec) UnmarshalJSON(b []byte) error {
+	return unmarshaller.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for ServiceRouterSpec
+func (this *ServiceRouterSpec) MarshalJSON() ([]byte, error) {
+	str, err := marshaller.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for ServiceRouterSpec
+func (this *ServiceRouterSpec) UnmarshalJSON(b []byte) error {
+	return unmarshaller.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for ServiceIntentionsSpec
+func (this *ServiceIntentionsSpec) MarshalJSON() ([]byte, error) {
+	str, err := marshaller.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for ServiceIntentionsSpec
+func (this *ServiceIntentionsSpec) UnmarshalJSON(b []byte) error {
+	return unmarshaller.Unmarshal(bytes.NewReader(b), this)
+}
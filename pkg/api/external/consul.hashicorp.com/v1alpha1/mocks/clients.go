@@ -0,0 +1,1629 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./clients.go
+
+// Package mock_v1alpha1 is a generated GoMock package.
+package mock_v1alpha1
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MockMulticlusterClientset is a mock of MulticlusterClientset interface.
+type MockMulticlusterClientset struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterClientsetMockRecorder
+}
+
+// MockMulticlusterClientsetMockRecorder is the mock recorder for MockMulticlusterClientset.
+type MockMulticlusterClientsetMockRecorder struct {
+	mock *MockMulticlusterClientset
+}
+
+// NewMockMulticlusterClientset creates a new mock instance.
+func NewMockMulticlusterClientset(ctrl *gomock.Controller) *MockMulticlusterClientset {
+	mock := &MockMulticlusterClientset{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterClientsetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterClientset) EXPECT() *MockMulticlusterClientsetMockRecorder {
+	return m.recorder
+}
+
+// Cluster mocks base method.
+func (m *MockMulticlusterClientset) Cluster(cluster string) (v1alpha1.Clientset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cluster", cluster)
+	ret0, _ := ret[0].(v1alpha1.Clientset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cluster indicates an expected call of Cluster.
+func (mr *MockMulticlusterClientsetMockRecorder) Cluster(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cluster", reflect.TypeOf((*MockMulticlusterClientset)(nil).Cluster), cluster)
+}
+
+// MockClientset is a mock of Clientset interface.
+type MockClientset struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientsetMockRecorder
+}
+
+// MockClientsetMockRecorder is the mock recorder for MockClientset.
+type MockClientsetMockRecorder struct {
+	mock *MockClientset
+}
+
+// NewMockClientset creates a new mock instance.
+func NewMockClientset(ctrl *gomock.Controller) *MockClientset {
+	mock := &MockClientset{ctrl: ctrl}
+	mock.recorder = &MockClientsetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientset) EXPECT() *MockClientsetMockRecorder {
+	return m.recorder
+}
+
+// ServiceIntentions mocks base method.
+func (m *MockClientset) ServiceIntentions() v1alpha1.ServiceIntentionsClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceIntentions")
+	ret0, _ := ret[0].(v1alpha1.ServiceIntentionsClient)
+	return ret0
+}
+
+// ServiceIntentions indicates an expected call of ServiceIntentions.
+func (mr *MockClientsetMockRecorder) ServiceIntentions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceIntentions", reflect.TypeOf((*MockClientset)(nil).ServiceIntentions))
+}
+
+// ServiceRouters mocks base method.
+func (m *MockClientset) ServiceRouters() v1alpha1.ServiceRouterClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceRouters")
+	ret0, _ := ret[0].(v1alpha1.ServiceRouterClient)
+	return ret0
+}
+
+// ServiceRouters indicates an expected call of ServiceRouters.
+func (mr *MockClientsetMockRecorder) ServiceRouters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceRouters", reflect.TypeOf((*MockClientset)(nil).ServiceRouters))
+}
+
+// ServiceSplitters mocks base method.
+func (m *MockClientset) ServiceSplitters() v1alpha1.ServiceSplitterClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceSplitters")
+	ret0, _ := ret[0].(v1alpha1.ServiceSplitterClient)
+	return ret0
+}
+
+// ServiceSplitters indicates an expected call of ServiceSplitters.
+func (mr *MockClientsetMockRecorder) ServiceSplitters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceSplitters", reflect.TypeOf((*MockClientset)(nil).ServiceSplitters))
+}
+
+// MockServiceSplitterReader is a mock of ServiceSplitterReader interface.
+type MockServiceSplitterReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceSplitterReaderMockRecorder
+}
+
+// MockServiceSplitterReaderMockRecorder is the mock recorder for MockServiceSplitterReader.
+type MockServiceSplitterReaderMockRecorder struct {
+	mock *MockServiceSplitterReader
+}
+
+// NewMockServiceSplitterReader creates a new mock instance.
+func NewMockServiceSplitterReader(ctrl *gomock.Controller) *MockServiceSplitterReader {
+	mock := &MockServiceSplitterReader{ctrl: ctrl}
+	mock.recorder = &MockServiceSplitterReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceSplitterReader) EXPECT() *MockServiceSplitterReaderMockRecorder {
+	return m.recorder
+}
+
+// GetServiceSplitter mocks base method.
+func (m *MockServiceSplitterReader) GetServiceSplitter(ctx context.Context, key client.ObjectKey) (*v1alpha1.ServiceSplitter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceSplitter", ctx, key)
+	ret0, _ := ret[0].(*v1alpha1.ServiceSplitter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceSplitter indicates an expected call of GetServiceSplitter.
+func (mr *MockServiceSplitterReaderMockRecorder) GetServiceSplitter(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceSplitter", reflect.TypeOf((*MockServiceSplitterReader)(nil).GetServiceSplitter), ctx, key)
+}
+
+// ListServiceSplitter mocks base method.
+func (m *MockServiceSplitterReader) ListServiceSplitter(ctx context.Context, opts ...client.ListOption) (*v1alpha1.ServiceSplitterList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServiceSplitter", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.ServiceSplitterList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceSplitter indicates an expected call of ListServiceSplitter.
+func (mr *MockServiceSplitterReaderMockRecorder) ListServiceSplitter(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceSplitter", reflect.TypeOf((*MockServiceSplitterReader)(nil).ListServiceSplitter), varargs...)
+}
+
+// MockServiceSplitterWriter is a mock of ServiceSplitterWriter interface.
+type MockServiceSplitterWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceSplitterWriterMockRecorder
+}
+
+// MockServiceSplitterWriterMockRecorder is the mock recorder for MockServiceSplitterWriter.
+type MockServiceSplitterWriterMockRecorder struct {
+	mock *MockServiceSplitterWriter
+}
+
+// NewMockServiceSplitterWriter creates a new mock instance.
+func NewMockServiceSplitterWriter(ctrl *gomock.Controller) *MockServiceSplitterWriter {
+	mock := &MockServiceSplitterWriter{ctrl: ctrl}
+	mock.recorder = &MockServiceSplitterWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceSplitterWriter) EXPECT() *MockServiceSplitterWriterMockRecorder {
+	return m.recorder
+}
+
+// CreateServiceSplitter mocks base method.
+func (m *MockServiceSplitterWriter) CreateServiceSplitter(ctx context.Context, obj *v1alpha1.ServiceSplitter, opts ...client.CreateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceSplitter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateServiceSplitter indicates an expected call of CreateServiceSplitter.
+func (mr *MockServiceSplitterWriterMockRecorder) CreateServiceSplitter(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceSplitter", reflect.TypeOf((*MockServiceSplitterWriter)(nil).CreateServiceSplitter), varargs...)
+}
+
+// DeleteAllOfServiceSplitter mocks base method.
+func (m *MockServiceSplitterWriter) DeleteAllOfServiceSplitter(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAllOfServiceSplitter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllOfServiceSplitter indicates an expected call of DeleteAllOfServiceSplitter.
+func (mr *MockServiceSplitterWriterMockRecorder) DeleteAllOfServiceSplitter(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOfServiceSplitter", reflect.TypeOf((*MockServiceSplitterWriter)(nil).DeleteAllOfServiceSplitter), varargs...)
+}
+
+// DeleteServiceSplitter mocks base method.
+func (m *MockServiceSplitterWriter) DeleteServiceSplitter(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteServiceSplitter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceSplitter indicates an expected call of DeleteServiceSplitter.
+func (mr *MockServiceSplitterWriterMockRecorder) DeleteServiceSplitter(ctx, key interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceSplitter", reflect.TypeOf((*MockServiceSplitterWriter)(nil).DeleteServiceSplitter), varargs...)
+}
+
+// PatchServiceSplitter mocks base method.
+func (m *MockServiceSplitterWriter) PatchServiceSplitter(ctx context.Context, obj *v1alpha1.ServiceSplitter, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchServiceSplitter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchServiceSplitter indicates an expected call of PatchServiceSplitter.
+func (mr *MockServiceSplitterWriterMockRecorder) PatchServiceSplitter(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceSplitter", reflect.TypeOf((*MockServiceSplitterWriter)(nil).PatchServiceSplitter), varargs...)
+}
+
+// UpdateServiceSplitter mocks base method.
+func (m *MockServiceSplitterWriter) UpdateServiceSplitter(ctx context.Context, obj *v1alpha1.ServiceSplitter, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceSplitter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceSplitter indicates an expected call of UpdateServiceSplitter.
+func (mr *MockServiceSplitterWriterMockRecorder) UpdateServiceSplitter(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceSplitter", reflect.TypeOf((*MockServiceSplitterWriter)(nil).UpdateServiceSplitter), varargs...)
+}
+
+// UpsertServiceSplitter mocks base method.
+func (m *MockServiceSplitterWriter) UpsertServiceSplitter(ctx context.Context, obj *v1alpha1.ServiceSplitter, transitionFuncs ...v1alpha1.ServiceSplitterTransitionFunction) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range transitionFuncs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertServiceSplitter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertServiceSplitter indicates an expected call of UpsertServiceSplitter.
+func (mr *MockServiceSplitterWriterMockRecorder) UpsertServiceSplitter(ctx, obj interface{}, transitionFuncs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, transitionFuncs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertServiceSplitter", reflect.TypeOf((*MockServiceSplitterWriter)(nil).UpsertServiceSplitter), varargs...)
+}
+
+// MockServiceSplitterStatusWriter is a mock of ServiceSplitterStatusWriter interface.
+type MockServiceSplitterStatusWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceSplitterStatusWriterMockRecorder
+}
+
+// MockServiceSplitterStatusWriterMockRecorder is the mock recorder for MockServiceSplitterStatusWriter.
+type MockServiceSplitterStatusWriterMockRecorder struct {
+	mock *MockServiceSplitterStatusWriter
+}
+
+// NewMockServiceSplitterStatusWriter creates a new mock instance.
+func NewMockServiceSplitterStatusWriter(ctrl *gomock.Controller) *MockServiceSplitterStatusWriter {
+	mock := &MockServiceSplitterStatusWriter{ctrl: ctrl}
+	mock.recorder = &MockServiceSplitterStatusWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceSplitterStatusWriter) EXPECT() *MockServiceSplitterStatusWriterMockRecorder {
+	return m.recorder
+}
+
+// PatchServiceSplitterStatus mocks base method.
+func (m *MockServiceSplitterStatusWriter) PatchServiceSplitterStatus(ctx context.Context, obj *v1alpha1.ServiceSplitter, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchServiceSplitterStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchServiceSplitterStatus indicates an expected call of PatchServiceSplitterStatus.
+func (mr *MockServiceSplitterStatusWriterMockRecorder) PatchServiceSplitterStatus(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceSplitterStatus", reflect.TypeOf((*MockServiceSplitterStatusWriter)(nil).PatchServiceSplitterStatus), varargs...)
+}
+
+// UpdateServiceSplitterStatus mocks base method.
+func (m *MockServiceSplitterStatusWriter) UpdateServiceSplitterStatus(ctx context.Context, obj *v1alpha1.ServiceSplitter, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceSplitterStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceSplitterStatus indicates an expected call of UpdateServiceSplitterStatus.
+func (mr *MockServiceSplitterStatusWriterMockRecorder) UpdateServiceSplitterStatus(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceSplitterStatus", reflect.TypeOf((*MockServiceSplitterStatusWriter)(nil).UpdateServiceSplitterStatus), varargs...)
+}
+
+// MockServiceSplitterClient is a mock of ServiceSplitterClient interface.
+type MockServiceSplitterClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceSplitterClientMockRecorder
+}
+
+// MockServiceSplitterClientMockRecorder is the mock recorder for MockServiceSplitterClient.
+type MockServiceSplitterClientMockRecorder struct {
+	mock *MockServiceSplitterClient
+}
+
+// NewMockServiceSplitterClient creates a new mock instance.
+func NewMockServiceSplitterClient(ctrl *gomock.Controller) *MockServiceSplitterClient {
+	mock := &MockServiceSplitterClient{ctrl: ctrl}
+	mock.recorder = &MockServiceSplitterClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceSplitterClient) EXPECT() *MockServiceSplitterClientMockRecorder {
+	return m.recorder
+}
+
+// CreateServiceSplitter mocks base method.
+func (m *MockServiceSplitterClient) CreateServiceSplitter(ctx context.Context, obj *v1alpha1.ServiceSplitter, opts ...client.CreateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceSplitter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateServiceSplitter indicates an expected call of CreateServiceSplitter.
+func (mr *MockServiceSplitterClientMockRecorder) CreateServiceSplitter(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceSplitter", reflect.TypeOf((*MockServiceSplitterClient)(nil).CreateServiceSplitter), varargs...)
+}
+
+// DeleteAllOfServiceSplitter mocks base method.
+func (m *MockServiceSplitterClient) DeleteAllOfServiceSplitter(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAllOfServiceSplitter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllOfServiceSplitter indicates an expected call of DeleteAllOfServiceSplitter.
+func (mr *MockServiceSplitterClientMockRecorder) DeleteAllOfServiceSplitter(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOfServiceSplitter", reflect.TypeOf((*MockServiceSplitterClient)(nil).DeleteAllOfServiceSplitter), varargs...)
+}
+
+// DeleteServiceSplitter mocks base method.
+func (m *MockServiceSplitterClient) DeleteServiceSplitter(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteServiceSplitter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceSplitter indicates an expected call of DeleteServiceSplitter.
+func (mr *MockServiceSplitterClientMockRecorder) DeleteServiceSplitter(ctx, key interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceSplitter", reflect.TypeOf((*MockServiceSplitterClient)(nil).DeleteServiceSplitter), varargs...)
+}
+
+// GetServiceSplitter mocks base method.
+func (m *MockServiceSplitterClient) GetServiceSplitter(ctx context.Context, key client.ObjectKey) (*v1alpha1.ServiceSplitter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceSplitter", ctx, key)
+	ret0, _ := ret[0].(*v1alpha1.ServiceSplitter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceSplitter indicates an expected call of GetServiceSplitter.
+func (mr *MockServiceSplitterClientMockRecorder) GetServiceSplitter(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceSplitter", reflect.TypeOf((*MockServiceSplitterClient)(nil).GetServiceSplitter), ctx, key)
+}
+
+// ListServiceSplitter mocks base method.
+func (m *MockServiceSplitterClient) ListServiceSplitter(ctx context.Context, opts ...client.ListOption) (*v1alpha1.ServiceSplitterList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServiceSplitter", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.ServiceSplitterList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceSplitter indicates an expected call of ListServiceSplitter.
+func (mr *MockServiceSplitterClientMockRecorder) ListServiceSplitter(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceSplitter", reflect.TypeOf((*MockServiceSplitterClient)(nil).ListServiceSplitter), varargs...)
+}
+
+// PatchServiceSplitter mocks base method.
+func (m *MockServiceSplitterClient) PatchServiceSplitter(ctx context.Context, obj *v1alpha1.ServiceSplitter, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchServiceSplitter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchServiceSplitter indicates an expected call of PatchServiceSplitter.
+func (mr *MockServiceSplitterClientMockRecorder) PatchServiceSplitter(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceSplitter", reflect.TypeOf((*MockServiceSplitterClient)(nil).PatchServiceSplitter), varargs...)
+}
+
+// PatchServiceSplitterStatus mocks base method.
+func (m *MockServiceSplitterClient) PatchServiceSplitterStatus(ctx context.Context, obj *v1alpha1.ServiceSplitter, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchServiceSplitterStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchServiceSplitterStatus indicates an expected call of PatchServiceSplitterStatus.
+func (mr *MockServiceSplitterClientMockRecorder) PatchServiceSplitterStatus(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceSplitterStatus", reflect.TypeOf((*MockServiceSplitterClient)(nil).PatchServiceSplitterStatus), varargs...)
+}
+
+// UpdateServiceSplitter mocks base method.
+func (m *MockServiceSplitterClient) UpdateServiceSplitter(ctx context.Context, obj *v1alpha1.ServiceSplitter, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceSplitter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceSplitter indicates an expected call of UpdateServiceSplitter.
+func (mr *MockServiceSplitterClientMockRecorder) UpdateServiceSplitter(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceSplitter", reflect.TypeOf((*MockServiceSplitterClient)(nil).UpdateServiceSplitter), varargs...)
+}
+
+// UpdateServiceSplitterStatus mocks base method.
+func (m *MockServiceSplitterClient) UpdateServiceSplitterStatus(ctx context.Context, obj *v1alpha1.ServiceSplitter, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceSplitterStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceSplitterStatus indicates an expected call of UpdateServiceSplitterStatus.
+func (mr *MockServiceSplitterClientMockRecorder) UpdateServiceSplitterStatus(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceSplitterStatus", reflect.TypeOf((*MockServiceSplitterClient)(nil).UpdateServiceSplitterStatus), varargs...)
+}
+
+// UpsertServiceSplitter mocks base method.
+func (m *MockServiceSplitterClient) UpsertServiceSplitter(ctx context.Context, obj *v1alpha1.ServiceSplitter, transitionFuncs ...v1alpha1.ServiceSplitterTransitionFunction) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range transitionFuncs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertServiceSplitter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertServiceSplitter indicates an expected call of UpsertServiceSplitter.
+func (mr *MockServiceSplitterClientMockRecorder) UpsertServiceSplitter(ctx, obj interface{}, transitionFuncs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, transitionFuncs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertServiceSplitter", reflect.TypeOf((*MockServiceSplitterClient)(nil).UpsertServiceSplitter), varargs...)
+}
+
+// MockMulticlusterServiceSplitterClient is a mock of MulticlusterServiceSplitterClient interface.
+type MockMulticlusterServiceSplitterClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterServiceSplitterClientMockRecorder
+}
+
+// MockMulticlusterServiceSplitterClientMockRecorder is the mock recorder for MockMulticlusterServiceSplitterClient.
+type MockMulticlusterServiceSplitterClientMockRecorder struct {
+	mock *MockMulticlusterServiceSplitterClient
+}
+
+// NewMockMulticlusterServiceSplitterClient creates a new mock instance.
+func NewMockMulticlusterServiceSplitterClient(ctrl *gomock.Controller) *MockMulticlusterServiceSplitterClient {
+	mock := &MockMulticlusterServiceSplitterClient{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterServiceSplitterClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterServiceSplitterClient) EXPECT() *MockMulticlusterServiceSplitterClientMockRecorder {
+	return m.recorder
+}
+
+// Cluster mocks base method.
+func (m *MockMulticlusterServiceSplitterClient) Cluster(cluster string) (v1alpha1.ServiceSplitterClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cluster", cluster)
+	ret0, _ := ret[0].(v1alpha1.ServiceSplitterClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cluster indicates an expected call of Cluster.
+func (mr *MockMulticlusterServiceSplitterClientMockRecorder) Cluster(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cluster", reflect.TypeOf((*MockMulticlusterServiceSplitterClient)(nil).Cluster), cluster)
+}
+
+// MockServiceRouterReader is a mock of ServiceRouterReader interface.
+type MockServiceRouterReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceRouterReaderMockRecorder
+}
+
+// MockServiceRouterReaderMockRecorder is the mock recorder for MockServiceRouterReader.
+type MockServiceRouterReaderMockRecorder struct {
+	mock *MockServiceRouterReader
+}
+
+// NewMockServiceRouterReader creates a new mock instance.
+func NewMockServiceRouterReader(ctrl *gomock.Controller) *MockServiceRouterReader {
+	mock := &MockServiceRouterReader{ctrl: ctrl}
+	mock.recorder = &MockServiceRouterReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceRouterReader) EXPECT() *MockServiceRouterReaderMockRecorder {
+	return m.recorder
+}
+
+// GetServiceRouter mocks base method.
+func (m *MockServiceRouterReader) GetServiceRouter(ctx context.Context, key client.ObjectKey) (*v1alpha1.ServiceRouter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceRouter", ctx, key)
+	ret0, _ := ret[0].(*v1alpha1.ServiceRouter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceRouter indicates an expected call of GetServiceRouter.
+func (mr *MockServiceRouterReaderMockRecorder) GetServiceRouter(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceRouter", reflect.TypeOf((*MockServiceRouterReader)(nil).GetServiceRouter), ctx, key)
+}
+
+// ListServiceRouter mocks base method.
+func (m *MockServiceRouterReader) ListServiceRouter(ctx context.Context, opts ...client.ListOption) (*v1alpha1.ServiceRouterList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServiceRouter", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.ServiceRouterList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceRouter indicates an expected call of ListServiceRouter.
+func (mr *MockServiceRouterReaderMockRecorder) ListServiceRouter(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceRouter", reflect.TypeOf((*MockServiceRouterReader)(nil).ListServiceRouter), varargs...)
+}
+
+// MockServiceRouterWriter is a mock of ServiceRouterWriter interface.
+type MockServiceRouterWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceRouterWriterMockRecorder
+}
+
+// MockServiceRouterWriterMockRecorder is the mock recorder for MockServiceRouterWriter.
+type MockServiceRouterWriterMockRecorder struct {
+	mock *MockServiceRouterWriter
+}
+
+// NewMockServiceRouterWriter creates a new mock instance.
+func NewMockServiceRouterWriter(ctrl *gomock.Controller) *MockServiceRouterWriter {
+	mock := &MockServiceRouterWriter{ctrl: ctrl}
+	mock.recorder = &MockServiceRouterWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceRouterWriter) EXPECT() *MockServiceRouterWriterMockRecorder {
+	return m.recorder
+}
+
+// CreateServiceRouter mocks base method.
+func (m *MockServiceRouterWriter) CreateServiceRouter(ctx context.Context, obj *v1alpha1.ServiceRouter, opts ...client.CreateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceRouter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateServiceRouter indicates an expected call of CreateServiceRouter.
+func (mr *MockServiceRouterWriterMockRecorder) CreateServiceRouter(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceRouter", reflect.TypeOf((*MockServiceRouterWriter)(nil).CreateServiceRouter), varargs...)
+}
+
+// DeleteAllOfServiceRouter mocks base method.
+func (m *MockServiceRouterWriter) DeleteAllOfServiceRouter(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAllOfServiceRouter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllOfServiceRouter indicates an expected call of DeleteAllOfServiceRouter.
+func (mr *MockServiceRouterWriterMockRecorder) DeleteAllOfServiceRouter(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOfServiceRouter", reflect.TypeOf((*MockServiceRouterWriter)(nil).DeleteAllOfServiceRouter), varargs...)
+}
+
+// DeleteServiceRouter mocks base method.
+func (m *MockServiceRouterWriter) DeleteServiceRouter(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteServiceRouter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceRouter indicates an expected call of DeleteServiceRouter.
+func (mr *MockServiceRouterWriterMockRecorder) DeleteServiceRouter(ctx, key interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceRouter", reflect.TypeOf((*MockServiceRouterWriter)(nil).DeleteServiceRouter), varargs...)
+}
+
+// PatchServiceRouter mocks base method.
+func (m *MockServiceRouterWriter) PatchServiceRouter(ctx context.Context, obj *v1alpha1.ServiceRouter, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchServiceRouter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchServiceRouter indicates an expected call of PatchServiceRouter.
+func (mr *MockServiceRouterWriterMockRecorder) PatchServiceRouter(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceRouter", reflect.TypeOf((*MockServiceRouterWriter)(nil).PatchServiceRouter), varargs...)
+}
+
+// UpdateServiceRouter mocks base method.
+func (m *MockServiceRouterWriter) UpdateServiceRouter(ctx context.Context, obj *v1alpha1.ServiceRouter, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceRouter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceRouter indicates an expected call of UpdateServiceRouter.
+func (mr *MockServiceRouterWriterMockRecorder) UpdateServiceRouter(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceRouter", reflect.TypeOf((*MockServiceRouterWriter)(nil).UpdateServiceRouter), varargs...)
+}
+
+// UpsertServiceRouter mocks base method.
+func (m *MockServiceRouterWriter) UpsertServiceRouter(ctx context.Context, obj *v1alpha1.ServiceRouter, transitionFuncs ...v1alpha1.ServiceRouterTransitionFunction) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range transitionFuncs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertServiceRouter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertServiceRouter indicates an expected call of UpsertServiceRouter.
+func (mr *MockServiceRouterWriterMockRecorder) UpsertServiceRouter(ctx, obj interface{}, transitionFuncs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, transitionFuncs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertServiceRouter", reflect.TypeOf((*MockServiceRouterWriter)(nil).UpsertServiceRouter), varargs...)
+}
+
+// MockServiceRouterStatusWriter is a mock of ServiceRouterStatusWriter interface.
+type MockServiceRouterStatusWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceRouterStatusWriterMockRecorder
+}
+
+// MockServiceRouterStatusWriterMockRecorder is the mock recorder for MockServiceRouterStatusWriter.
+type MockServiceRouterStatusWriterMockRecorder struct {
+	mock *MockServiceRouterStatusWriter
+}
+
+// NewMockServiceRouterStatusWriter creates a new mock instance.
+func NewMockServiceRouterStatusWriter(ctrl *gomock.Controller) *MockServiceRouterStatusWriter {
+	mock := &MockServiceRouterStatusWriter{ctrl: ctrl}
+	mock.recorder = &MockServiceRouterStatusWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceRouterStatusWriter) EXPECT() *MockServiceRouterStatusWriterMockRecorder {
+	return m.recorder
+}
+
+// PatchServiceRouterStatus mocks base method.
+func (m *MockServiceRouterStatusWriter) PatchServiceRouterStatus(ctx context.Context, obj *v1alpha1.ServiceRouter, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchServiceRouterStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchServiceRouterStatus indicates an expected call of PatchServiceRouterStatus.
+func (mr *MockServiceRouterStatusWriterMockRecorder) PatchServiceRouterStatus(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceRouterStatus", reflect.TypeOf((*MockServiceRouterStatusWriter)(nil).PatchServiceRouterStatus), varargs...)
+}
+
+// UpdateServiceRouterStatus mocks base method.
+func (m *MockServiceRouterStatusWriter) UpdateServiceRouterStatus(ctx context.Context, obj *v1alpha1.ServiceRouter, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceRouterStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceRouterStatus indicates an expected call of UpdateServiceRouterStatus.
+func (mr *MockServiceRouterStatusWriterMockRecorder) UpdateServiceRouterStatus(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceRouterStatus", reflect.TypeOf((*MockServiceRouterStatusWriter)(nil).UpdateServiceRouterStatus), varargs...)
+}
+
+// MockServiceRouterClient is a mock of ServiceRouterClient interface.
+type MockServiceRouterClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceRouterClientMockRecorder
+}
+
+// MockServiceRouterClientMockRecorder is the mock recorder for MockServiceRouterClient.
+type MockServiceRouterClientMockRecorder struct {
+	mock *MockServiceRouterClient
+}
+
+// NewMockServiceRouterClient creates a new mock instance.
+func NewMockServiceRouterClient(ctrl *gomock.Controller) *MockServiceRouterClient {
+	mock := &MockServiceRouterClient{ctrl: ctrl}
+	mock.recorder = &MockServiceRouterClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceRouterClient) EXPECT() *MockServiceRouterClientMockRecorder {
+	return m.recorder
+}
+
+// CreateServiceRouter mocks base method.
+func (m *MockServiceRouterClient) CreateServiceRouter(ctx context.Context, obj *v1alpha1.ServiceRouter, opts ...client.CreateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceRouter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateServiceRouter indicates an expected call of CreateServiceRouter.
+func (mr *MockServiceRouterClientMockRecorder) CreateServiceRouter(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceRouter", reflect.TypeOf((*MockServiceRouterClient)(nil).CreateServiceRouter), varargs...)
+}
+
+// DeleteAllOfServiceRouter mocks base method.
+func (m *MockServiceRouterClient) DeleteAllOfServiceRouter(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAllOfServiceRouter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllOfServiceRouter indicates an expected call of DeleteAllOfServiceRouter.
+func (mr *MockServiceRouterClientMockRecorder) DeleteAllOfServiceRouter(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOfServiceRouter", reflect.TypeOf((*MockServiceRouterClient)(nil).DeleteAllOfServiceRouter), varargs...)
+}
+
+// DeleteServiceRouter mocks base method.
+func (m *MockServiceRouterClient) DeleteServiceRouter(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteServiceRouter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceRouter indicates an expected call of DeleteServiceRouter.
+func (mr *MockServiceRouterClientMockRecorder) DeleteServiceRouter(ctx, key interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceRouter", reflect.TypeOf((*MockServiceRouterClient)(nil).DeleteServiceRouter), varargs...)
+}
+
+// GetServiceRouter mocks base method.
+func (m *MockServiceRouterClient) GetServiceRouter(ctx context.Context, key client.ObjectKey) (*v1alpha1.ServiceRouter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceRouter", ctx, key)
+	ret0, _ := ret[0].(*v1alpha1.ServiceRouter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceRouter indicates an expected call of GetServiceRouter.
+func (mr *MockServiceRouterClientMockRecorder) GetServiceRouter(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceRouter", reflect.TypeOf((*MockServiceRouterClient)(nil).GetServiceRouter), ctx, key)
+}
+
+// ListServiceRouter mocks base method.
+func (m *MockServiceRouterClient) ListServiceRouter(ctx context.Context, opts ...client.ListOption) (*v1alpha1.ServiceRouterList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServiceRouter", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.ServiceRouterList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceRouter indicates an expected call of ListServiceRouter.
+func (mr *MockServiceRouterClientMockRecorder) ListServiceRouter(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceRouter", reflect.TypeOf((*MockServiceRouterClient)(nil).ListServiceRouter), varargs...)
+}
+
+// PatchServiceRouter mocks base method.
+func (m *MockServiceRouterClient) PatchServiceRouter(ctx context.Context, obj *v1alpha1.ServiceRouter, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchServiceRouter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchServiceRouter indicates an expected call of PatchServiceRouter.
+func (mr *MockServiceRouterClientMockRecorder) PatchServiceRouter(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceRouter", reflect.TypeOf((*MockServiceRouterClient)(nil).PatchServiceRouter), varargs...)
+}
+
+// PatchServiceRouterStatus mocks base method.
+func (m *MockServiceRouterClient) PatchServiceRouterStatus(ctx context.Context, obj *v1alpha1.ServiceRouter, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchServiceRouterStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchServiceRouterStatus indicates an expected call of PatchServiceRouterStatus.
+func (mr *MockServiceRouterClientMockRecorder) PatchServiceRouterStatus(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceRouterStatus", reflect.TypeOf((*MockServiceRouterClient)(nil).PatchServiceRouterStatus), varargs...)
+}
+
+// UpdateServiceRouter mocks base method.
+func (m *MockServiceRouterClient) UpdateServiceRouter(ctx context.Context, obj *v1alpha1.ServiceRouter, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceRouter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceRouter indicates an expected call of UpdateServiceRouter.
+func (mr *MockServiceRouterClientMockRecorder) UpdateServiceRouter(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceRouter", reflect.TypeOf((*MockServiceRouterClient)(nil).UpdateServiceRouter), varargs...)
+}
+
+// UpdateServiceRouterStatus mocks base method.
+func (m *MockServiceRouterClient) UpdateServiceRouterStatus(ctx context.Context, obj *v1alpha1.ServiceRouter, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceRouterStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceRouterStatus indicates an expected call of UpdateServiceRouterStatus.
+func (mr *MockServiceRouterClientMockRecorder) UpdateServiceRouterStatus(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceRouterStatus", reflect.TypeOf((*MockServiceRouterClient)(nil).UpdateServiceRouterStatus), varargs...)
+}
+
+// UpsertServiceRouter mocks base method.
+func (m *MockServiceRouterClient) UpsertServiceRouter(ctx context.Context, obj *v1alpha1.ServiceRouter, transitionFuncs ...v1alpha1.ServiceRouterTransitionFunction) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range transitionFuncs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertServiceRouter", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertServiceRouter indicates an expected call of UpsertServiceRouter.
+func (mr *MockServiceRouterClientMockRecorder) UpsertServiceRouter(ctx, obj interface{}, transitionFuncs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, transitionFuncs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertServiceRouter", reflect.TypeOf((*MockServiceRouterClient)(nil).UpsertServiceRouter), varargs...)
+}
+
+// MockMulticlusterServiceRouterClient is a mock of MulticlusterServiceRouterClient interface.
+type MockMulticlusterServiceRouterClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterServiceRouterClientMockRecorder
+}
+
+// MockMulticlusterServiceRouterClientMockRecorder is the mock recorder for MockMulticlusterServiceRouterClient.
+type MockMulticlusterServiceRouterClientMockRecorder struct {
+	mock *MockMulticlusterServiceRouterClient
+}
+
+// NewMockMulticlusterServiceRouterClient creates a new mock instance.
+func NewMockMulticlusterServiceRouterClient(ctrl *gomock.Controller) *MockMulticlusterServiceRouterClient {
+	mock := &MockMulticlusterServiceRouterClient{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterServiceRouterClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterServiceRouterClient) EXPECT() *MockMulticlusterServiceRouterClientMockRecorder {
+	return m.recorder
+}
+
+// Cluster mocks base method.
+func (m *MockMulticlusterServiceRouterClient) Cluster(cluster string) (v1alpha1.ServiceRouterClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cluster", cluster)
+	ret0, _ := ret[0].(v1alpha1.ServiceRouterClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cluster indicates an expected call of Cluster.
+func (mr *MockMulticlusterServiceRouterClientMockRecorder) Cluster(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cluster", reflect.TypeOf((*MockMulticlusterServiceRouterClient)(nil).Cluster), cluster)
+}
+
+// MockServiceIntentionsReader is a mock of ServiceIntentionsReader interface.
+type MockServiceIntentionsReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceIntentionsReaderMockRecorder
+}
+
+// MockServiceIntentionsReaderMockRecorder is the mock recorder for MockServiceIntentionsReader.
+type MockServiceIntentionsReaderMockRecorder struct {
+	mock *MockServiceIntentionsReader
+}
+
+// NewMockServiceIntentionsReader creates a new mock instance.
+func NewMockServiceIntentionsReader(ctrl *gomock.Controller) *MockServiceIntentionsReader {
+	mock := &MockServiceIntentionsReader{ctrl: ctrl}
+	mock.recorder = &MockServiceIntentionsReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceIntentionsReader) EXPECT() *MockServiceIntentionsReaderMockRecorder {
+	return m.recorder
+}
+
+// GetServiceIntentions mocks base method.
+func (m *MockServiceIntentionsReader) GetServiceIntentions(ctx context.Context, key client.ObjectKey) (*v1alpha1.ServiceIntentions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceIntentions", ctx, key)
+	ret0, _ := ret[0].(*v1alpha1.ServiceIntentions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceIntentions indicates an expected call of GetServiceIntentions.
+func (mr *MockServiceIntentionsReaderMockRecorder) GetServiceIntentions(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceIntentions", reflect.TypeOf((*MockServiceIntentionsReader)(nil).GetServiceIntentions), ctx, key)
+}
+
+// ListServiceIntentions mocks base method.
+func (m *MockServiceIntentionsReader) ListServiceIntentions(ctx context.Context, opts ...client.ListOption) (*v1alpha1.ServiceIntentionsList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServiceIntentions", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.ServiceIntentionsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceIntentions indicates an expected call of ListServiceIntentions.
+func (mr *MockServiceIntentionsReaderMockRecorder) ListServiceIntentions(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceIntentions", reflect.TypeOf((*MockServiceIntentionsReader)(nil).ListServiceIntentions), varargs...)
+}
+
+// MockServiceIntentionsWriter is a mock of ServiceIntentionsWriter interface.
+type MockServiceIntentionsWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceIntentionsWriterMockRecorder
+}
+
+// MockServiceIntentionsWriterMockRecorder is the mock recorder for MockServiceIntentionsWriter.
+type MockServiceIntentionsWriterMockRecorder struct {
+	mock *MockServiceIntentionsWriter
+}
+
+// NewMockServiceIntentionsWriter creates a new mock instance.
+func NewMockServiceIntentionsWriter(ctrl *gomock.Controller) *MockServiceIntentionsWriter {
+	mock := &MockServiceIntentionsWriter{ctrl: ctrl}
+	mock.recorder = &MockServiceIntentionsWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceIntentionsWriter) EXPECT() *MockServiceIntentionsWriterMockRecorder {
+	return m.recorder
+}
+
+// CreateServiceIntentions mocks base method.
+func (m *MockServiceIntentionsWriter) CreateServiceIntentions(ctx context.Context, obj *v1alpha1.ServiceIntentions, opts ...client.CreateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceIntentions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateServiceIntentions indicates an expected call of CreateServiceIntentions.
+func (mr *MockServiceIntentionsWriterMockRecorder) CreateServiceIntentions(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceIntentions", reflect.TypeOf((*MockServiceIntentionsWriter)(nil).CreateServiceIntentions), varargs...)
+}
+
+// DeleteAllOfServiceIntentions mocks base method.
+func (m *MockServiceIntentionsWriter) DeleteAllOfServiceIntentions(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAllOfServiceIntentions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllOfServiceIntentions indicates an expected call of DeleteAllOfServiceIntentions.
+func (mr *MockServiceIntentionsWriterMockRecorder) DeleteAllOfServiceIntentions(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOfServiceIntentions", reflect.TypeOf((*MockServiceIntentionsWriter)(nil).DeleteAllOfServiceIntentions), varargs...)
+}
+
+// DeleteServiceIntentions mocks base method.
+func (m *MockServiceIntentionsWriter) DeleteServiceIntentions(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteServiceIntentions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceIntentions indicates an expected call of DeleteServiceIntentions.
+func (mr *MockServiceIntentionsWriterMockRecorder) DeleteServiceIntentions(ctx, key interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceIntentions", reflect.TypeOf((*MockServiceIntentionsWriter)(nil).DeleteServiceIntentions), varargs...)
+}
+
+// PatchServiceIntentions mocks base method.
+func (m *MockServiceIntentionsWriter) PatchServiceIntentions(ctx context.Context, obj *v1alpha1.ServiceIntentions, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchServiceIntentions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchServiceIntentions indicates an expected call of PatchServiceIntentions.
+func (mr *MockServiceIntentionsWriterMockRecorder) PatchServiceIntentions(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceIntentions", reflect.TypeOf((*MockServiceIntentionsWriter)(nil).PatchServiceIntentions), varargs...)
+}
+
+// UpdateServiceIntentions mocks base method.
+func (m *MockServiceIntentionsWriter) UpdateServiceIntentions(ctx context.Context, obj *v1alpha1.ServiceIntentions, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceIntentions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceIntentions indicates an expected call of UpdateServiceIntentions.
+func (mr *MockServiceIntentionsWriterMockRecorder) UpdateServiceIntentions(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceIntentions", reflect.TypeOf((*MockServiceIntentionsWriter)(nil).UpdateServiceIntentions), varargs...)
+}
+
+// UpsertServiceIntentions mocks base method.
+func (m *MockServiceIntentionsWriter) UpsertServiceIntentions(ctx context.Context, obj *v1alpha1.ServiceIntentions, transitionFuncs ...v1alpha1.ServiceIntentionsTransitionFunction) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range transitionFuncs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertServiceIntentions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertServiceIntentions indicates an expected call of UpsertServiceIntentions.
+func (mr *MockServiceIntentionsWriterMockRecorder) UpsertServiceIntentions(ctx, obj interface{}, transitionFuncs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, transitionFuncs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertServiceIntentions", reflect.TypeOf((*MockServiceIntentionsWriter)(nil).UpsertServiceIntentions), varargs...)
+}
+
+// MockServiceIntentionsStatusWriter is a mock of ServiceIntentionsStatusWriter interface.
+type MockServiceIntentionsStatusWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceIntentionsStatusWriterMockRecorder
+}
+
+// MockServiceIntentionsStatusWriterMockRecorder is the mock recorder for MockServiceIntentionsStatusWriter.
+type MockServiceIntentionsStatusWriterMockRecorder struct {
+	mock *MockServiceIntentionsStatusWriter
+}
+
+// NewMockServiceIntentionsStatusWriter creates a new mock instance.
+func NewMockServiceIntentionsStatusWriter(ctrl *gomock.Controller) *MockServiceIntentionsStatusWriter {
+	mock := &MockServiceIntentionsStatusWriter{ctrl: ctrl}
+	mock.recorder = &MockServiceIntentionsStatusWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceIntentionsStatusWriter) EXPECT() *MockServiceIntentionsStatusWriterMockRecorder {
+	return m.recorder
+}
+
+// PatchServiceIntentionsStatus mocks base method.
+func (m *MockServiceIntentionsStatusWriter) PatchServiceIntentionsStatus(ctx context.Context, obj *v1alpha1.ServiceIntentions, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchServiceIntentionsStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchServiceIntentionsStatus indicates an expected call of PatchServiceIntentionsStatus.
+func (mr *MockServiceIntentionsStatusWriterMockRecorder) PatchServiceIntentionsStatus(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceIntentionsStatus", reflect.TypeOf((*MockServiceIntentionsStatusWriter)(nil).PatchServiceIntentionsStatus), varargs...)
+}
+
+// UpdateServiceIntentionsStatus mocks base method.
+func (m *MockServiceIntentionsStatusWriter) UpdateServiceIntentionsStatus(ctx context.Context, obj *v1alpha1.ServiceIntentions, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceIntentionsStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceIntentionsStatus indicates an expected call of UpdateServiceIntentionsStatus.
+func (mr *MockServiceIntentionsStatusWriterMockRecorder) UpdateServiceIntentionsStatus(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceIntentionsStatus", reflect.TypeOf((*MockServiceIntentionsStatusWriter)(nil).UpdateServiceIntentionsStatus), varargs...)
+}
+
+// MockServiceIntentionsClient is a mock of ServiceIntentionsClient interface.
+type MockServiceIntentionsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceIntentionsClientMockRecorder
+}
+
+// MockServiceIntentionsClientMockRecorder is the mock recorder for MockServiceIntentionsClient.
+type MockServiceIntentionsClientMockRecorder struct {
+	mock *MockServiceIntentionsClient
+}
+
+// NewMockServiceIntentionsClient creates a new mock instance.
+func NewMockServiceIntentionsClient(ctrl *gomock.Controller) *MockServiceIntentionsClient {
+	mock := &MockServiceIntentionsClient{ctrl: ctrl}
+	mock.recorder = &MockServiceIntentionsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceIntentionsClient) EXPECT() *MockServiceIntentionsClientMockRecorder {
+	return m.recorder
+}
+
+// CreateServiceIntentions mocks base method.
+func (m *MockServiceIntentionsClient) CreateServiceIntentions(ctx context.Context, obj *v1alpha1.ServiceIntentions, opts ...client.CreateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceIntentions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateServiceIntentions indicates an expected call of CreateServiceIntentions.
+func (mr *MockServiceIntentionsClientMockRecorder) CreateServiceIntentions(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceIntentions", reflect.TypeOf((*MockServiceIntentionsClient)(nil).CreateServiceIntentions), varargs...)
+}
+
+// DeleteAllOfServiceIntentions mocks base method.
+func (m *MockServiceIntentionsClient) DeleteAllOfServiceIntentions(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAllOfServiceIntentions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllOfServiceIntentions indicates an expected call of DeleteAllOfServiceIntentions.
+func (mr *MockServiceIntentionsClientMockRecorder) DeleteAllOfServiceIntentions(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOfServiceIntentions", reflect.TypeOf((*MockServiceIntentionsClient)(nil).DeleteAllOfServiceIntentions), varargs...)
+}
+
+// DeleteServiceIntentions mocks base method.
+func (m *MockServiceIntentionsClient) DeleteServiceIntentions(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteServiceIntentions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteServiceIntentions indicates an expected call of DeleteServiceIntentions.
+func (mr *MockServiceIntentionsClientMockRecorder) DeleteServiceIntentions(ctx, key interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceIntentions", reflect.TypeOf((*MockServiceIntentionsClient)(nil).DeleteServiceIntentions), varargs...)
+}
+
+// GetServiceIntentions mocks base method.
+func (m *MockServiceIntentionsClient) GetServiceIntentions(ctx context.Context, key client.ObjectKey) (*v1alpha1.ServiceIntentions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceIntentions", ctx, key)
+	ret0, _ := ret[0].(*v1alpha1.ServiceIntentions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceIntentions indicates an expected call of GetServiceIntentions.
+func (mr *MockServiceIntentionsClientMockRecorder) GetServiceIntentions(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceIntentions", reflect.TypeOf((*MockServiceIntentionsClient)(nil).GetServiceIntentions), ctx, key)
+}
+
+// ListServiceIntentions mocks base method.
+func (m *MockServiceIntentionsClient) ListServiceIntentions(ctx context.Context, opts ...client.ListOption) (*v1alpha1.ServiceIntentionsList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServiceIntentions", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.ServiceIntentionsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceIntentions indicates an expected call of ListServiceIntentions.
+func (mr *MockServiceIntentionsClientMockRecorder) ListServiceIntentions(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceIntentions", reflect.TypeOf((*MockServiceIntentionsClient)(nil).ListServiceIntentions), varargs...)
+}
+
+// PatchServiceIntentions mocks base method.
+func (m *MockServiceIntentionsClient) PatchServiceIntentions(ctx context.Context, obj *v1alpha1.ServiceIntentions, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchServiceIntentions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchServiceIntentions indicates an expected call of PatchServiceIntentions.
+func (mr *MockServiceIntentionsClientMockRecorder) PatchServiceIntentions(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceIntentions", reflect.TypeOf((*MockServiceIntentionsClient)(nil).PatchServiceIntentions), varargs...)
+}
+
+// PatchServiceIntentionsStatus mocks base method.
+func (m *MockServiceIntentionsClient) PatchServiceIntentionsStatus(ctx context.Context, obj *v1alpha1.ServiceIntentions, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchServiceIntentionsStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchServiceIntentionsStatus indicates an expected call of PatchServiceIntentionsStatus.
+func (mr *MockServiceIntentionsClientMockRecorder) PatchServiceIntentionsStatus(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchServiceIntentionsStatus", reflect.TypeOf((*MockServiceIntentionsClient)(nil).PatchServiceIntentionsStatus), varargs...)
+}
+
+// UpdateServiceIntentions mocks base method.
+func (m *MockServiceIntentionsClient) UpdateServiceIntentions(ctx context.Context, obj *v1alpha1.ServiceIntentions, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceIntentions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceIntentions indicates an expected call of UpdateServiceIntentions.
+func (mr *MockServiceIntentionsClientMockRecorder) UpdateServiceIntentions(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceIntentions", reflect.TypeOf((*MockServiceIntentionsClient)(nil).UpdateServiceIntentions), varargs...)
+}
+
+// UpdateServiceIntentionsStatus mocks base method.
+func (m *MockServiceIntentionsClient) UpdateServiceIntentionsStatus(ctx context.Context, obj *v1alpha1.ServiceIntentions, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateServiceIntentionsStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceIntentionsStatus indicates an expected call of UpdateServiceIntentionsStatus.
+func (mr *MockServiceIntentionsClientMockRecorder) UpdateServiceIntentionsStatus(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceIntentionsStatus", reflect.TypeOf((*MockServiceIntentionsClient)(nil).UpdateServiceIntentionsStatus), varargs...)
+}
+
+// UpsertServiceIntentions mocks base method.
+func (m *MockServiceIntentionsClient) UpsertServiceIntentions(ctx context.Context, obj *v1alpha1.ServiceIntentions, transitionFuncs ...v1alpha1.ServiceIntentionsTransitionFunction) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range transitionFuncs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertServiceIntentions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertServiceIntentions indicates an expected call of UpsertServiceIntentions.
+func (mr *MockServiceIntentionsClientMockRecorder) UpsertServiceIntentions(ctx, obj interface{}, transitionFuncs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, transitionFuncs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertServiceIntentions", reflect.TypeOf((*MockServiceIntentionsClient)(nil).UpsertServiceIntentions), varargs...)
+}
+
+// MockMulticlusterServiceIntentionsClient is a mock of MulticlusterServiceIntentionsClient interface.
+type MockMulticlusterServiceIntentionsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterServiceIntentionsClientMockRecorder
+}
+
+// MockMulticlusterServiceIntentionsClientMockRecorder is the mock recorder for MockMulticlusterServiceIntentionsClient.
+type MockMulticlusterServiceIntentionsClientMockRecorder struct {
+	mock *MockMulticlusterServiceIntentionsClient
+}
+
+// NewMockMulticlusterServiceIntentionsClient creates a new mock instance.
+func NewMockMulticlusterServiceIntentionsClient(ctrl *gomock.Controller) *MockMulticlusterServiceIntentionsClient {
+	mock := &MockMulticlusterServiceIntentionsClient{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterServiceIntentionsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterServiceIntentionsClient) EXPECT() *MockMulticlusterServiceIntentionsClientMockRecorder {
+	return m.recorder
+}
+
+// Cluster mocks base method.
+func (m *MockMulticlusterServiceIntentionsClient) Cluster(cluster string) (v1alpha1.ServiceIntentionsClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cluster", cluster)
+	ret0, _ := ret[0].(v1alpha1.ServiceIntentionsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cluster indicates an expected call of Cluster.
+func (mr *MockMulticlusterServiceIntentionsClientMockRecorder) Cluster(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cluster", reflect.TypeOf((*MockMulticlusterServiceIntentionsClient)(nil).Cluster), cluster)
+}
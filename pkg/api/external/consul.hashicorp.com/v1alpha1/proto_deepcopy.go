@@ -0,0 +1,27 @@
+// Code generated by skv2. DO NOT EDIT.
+
+// This file contains generated Deepcopy methods for proto-based Spec and Status fields
+
+package v1alpha1
+
+import (
+	proto "github.com/golang/protobuf/proto"
+)
+
+// DeepCopyInto for the ServiceSplitter.Spec
+func (in *ServiceSplitterSpec) DeepCopyInto(out *ServiceSplitterSpec) {
+	p := proto.Clone(in).(*ServiceSplitterSpec)
+	*out = *p
+}
+
+// DeepCopyInto for the ServiceRouter.Spec
+func (in *ServiceRouterSpec) DeepCopyInto(out *ServiceRouterSpec) {
+	p := proto.Clone(in).(*ServiceRouterSpec)
+	*out = *p
+}
+
+// DeepCopyInto for the ServiceIntentions.Spec
+func (in *ServiceIntentionsSpec) DeepCopyInto(out *ServiceIntentionsSpec) {
+	p := proto.Clone(in).(*ServiceIntentionsSpec)
+	*out = *p
+}
@@ -0,0 +1,102 @@
+// Code generated by skv2. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	consul_hashicorp_com_v1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
+
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+/*
+  The intention of these providers are to be used for Mocking.
+  They expose the Clients as interfaces, as well as factories to provide mocked versions
+  of the clients when they require building within a component.
+
+  See package `github.com/solo-io/skv2/pkg/multicluster/register` for example
+*/
+
+// Provider for ServiceSplitterClient from Clientset
+func ServiceSplitterClientFromClientsetProvider(clients consul_hashicorp_com_v1alpha1.Clientset) consul_hashicorp_com_v1alpha1.ServiceSplitterClient {
+	return clients.ServiceSplitters()
+}
+
+// Provider for ServiceSplitter Client from Client
+func ServiceSplitterClientProvider(client client.Client) consul_hashicorp_com_v1alpha1.ServiceSplitterClient {
+	return consul_hashicorp_com_v1alpha1.NewServiceSplitterClient(client)
+}
+
+type ServiceSplitterClientFactory func(client client.Client) consul_hashicorp_com_v1alpha1.ServiceSplitterClient
+
+func ServiceSplitterClientFactoryProvider() ServiceSplitterClientFactory {
+	return ServiceSplitterClientProvider
+}
+
+type ServiceSplitterClientFromConfigFactory func(cfg *rest.Config) (consul_hashicorp_com_v1alpha1.ServiceSplitterClient, error)
+
+func ServiceSplitterClientFromConfigFactoryProvider() ServiceSplitterClientFromConfigFactory {
+	return func(cfg *rest.Config) (consul_hashicorp_com_v1alpha1.ServiceSplitterClient, error) {
+		clients, err := consul_hashicorp_com_v1alpha1.NewClientsetFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return clients.ServiceSplitters(), nil
+	}
+}
+
+// Provider for ServiceRouterClient from Clientset
+func ServiceRouterClientFromClientsetProvider(clients consul_hashicorp_com_v1alpha1.Clientset) consul_hashicorp_com_v1alpha1.ServiceRouterClient {
+	return clients.ServiceRouters()
+}
+
+// Provider for ServiceRouter Client from Client
+func ServiceRouterClientProvider(client client.Client) consul_hashicorp_com_v1alpha1.ServiceRouterClient {
+	return consul_hashicorp_com_v1alpha1.NewServiceRouterClient(client)
+}
+
+type ServiceRouterClientFactory func(client client.Client) consul_hashicorp_com_v1alpha1.ServiceRouterClient
+
+func ServiceRouterClientFactoryProvider() ServiceRouterClientFactory {
+	return ServiceRouterClientProvider
+}
+
+type ServiceRouterClientFromConfigFactory func(cfg *rest.Config) (consul_hashicorp_com_v1alpha1.ServiceRouterClient, error)
+
+func ServiceRouterClientFromConfigFactoryProvider() ServiceRouterClientFromConfigFactory {
+	return func(cfg *rest.Config) (consul_hashicorp_com_v1alpha1.ServiceRouterClient, error) {
+		clients, err := consul_hashicorp_com_v1alpha1.NewClientsetFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return clients.ServiceRouters(), nil
+	}
+}
+
+// Provider for ServiceIntentionsClient from Clientset
+func ServiceIntentionsClientFromClientsetProvider(clients consul_hashicorp_com_v1alpha1.Clientset) consul_hashicorp_com_v1alpha1.ServiceIntentionsClient {
+	return clients.ServiceIntentions()
+}
+
+// Provider for ServiceIntentions Client from Client
+func ServiceIntentionsClientProvider(client client.Client) consul_hashicorp_com_v1alpha1.ServiceIntentionsClient {
+	return consul_hashicorp_com_v1alpha1.NewServiceIntentionsClient(client)
+}
+
+type ServiceIntentionsClientFactory func(client client.Client) consul_hashicorp_com_v1alpha1.ServiceIntentionsClient
+
+func ServiceIntentionsClientFactoryProvider() ServiceIntentionsClientFactory {
+	return ServiceIntentionsClientProvider
+}
+
+type ServiceIntentionsClientFromConfigFactory func(cfg *rest.Config) (consul_hashicorp_com_v1alpha1.ServiceIntentionsClient, error)
+
+func ServiceIntentionsClientFromConfigFactoryProvider() ServiceIntentionsClientFromConfigFactory {
+	return func(cfg *rest.Config) (consul_hashicorp_com_v1alpha1.ServiceIntentionsClient, error) {
+		clients, err := consul_hashicorp_com_v1alpha1.NewClientsetFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return clients.ServiceIntentions(), nil
+	}
+}
@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo-mesh/api/external/consul/v1alpha1/service_intentions.proto
+
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *ServiceIntentionsSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceIntentionsSpec)
+	if !ok {
+		that2, ok := that.(ServiceIntentionsSpec)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetDestination()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDestination()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDestination(), target.GetDestination()) {
+			return false
+		}
+	}
+
+	if len(m.GetSources()) != len(target.GetSources()) {
+		return false
+	}
+	for idx, v := range m.GetSources() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetSources()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetSources()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ServiceIntentionsSpec_IntentionDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceIntentionsSpec_IntentionDestination)
+	if !ok {
+		that2, ok := that.(ServiceIntentionsSpec_IntentionDestination)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetNamespace(), target.GetNamespace()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ServiceIntentionsSpec_SourceIntention) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceIntentionsSpec_SourceIntention)
+	if !ok {
+		that2, ok := that.(ServiceIntentionsSpec_SourceIntention)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetNamespace(), target.GetNamespace()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetAction(), target.GetAction()) != 0 {
+		return false
+	}
+
+	if len(m.GetPermissions()) != len(target.GetPermissions()) {
+		return false
+	}
+	for idx, v := range m.GetPermissions() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetPermissions()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetPermissions()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if strings.Compare(m.GetDescription(), target.GetDescription()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ServiceIntentionsSpec_IntentionPermission) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceIntentionsSpec_IntentionPermission)
+	if !ok {
+		that2, ok := that.(ServiceIntentionsSpec_IntentionPermission)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetAction(), target.GetAction()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetHttp()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHttp()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHttp(), target.GetHttp()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ServiceIntentionsSpec_IntentionHTTPPermission) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceIntentionsSpec_IntentionHTTPPermission)
+	if !ok {
+		that2, ok := that.(ServiceIntentionsSpec_IntentionHTTPPermission)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetPathExact(), target.GetPathExact()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetPathPrefix(), target.GetPathPrefix()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetPathRegex(), target.GetPathRegex()) != 0 {
+		return false
+	}
+
+	if len(m.GetMethods()) != len(target.GetMethods()) {
+		return false
+	}
+	for idx, v := range m.GetMethods() {
+
+		if strings.Compare(v, target.GetMethods()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
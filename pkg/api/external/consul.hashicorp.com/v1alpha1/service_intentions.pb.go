@@ -0,0 +1,555 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.21.0
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo-mesh/api/external/consul/v1alpha1/service_intentions.proto
+
+package v1alpha1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+//
+//Mirrors the Consul [ServiceIntentions](https://www.consul.io/docs/connect/config-entries/service-intentions) config entry CRD
+//managed by consul-k8s. Gloo Mesh only writes this resource, it is never installed by Gloo Mesh.
+//Only one ServiceIntentions resource may exist for a given destination service.
+type ServiceIntentionsSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The destination service for which the intentions apply.
+	Destination *ServiceIntentionsSpec_IntentionDestination `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// The list of all intention sources and the authorization granted to those sources.
+	// The order of this list does not matter, but out of convenience Consul will always store it sorted.
+	Sources []*ServiceIntentionsSpec_SourceIntention `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+}
+
+func (x *ServiceIntentionsSpec) Reset() {
+	*x = ServiceIntentionsSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceIntentionsSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceIntentionsSpec) ProtoMessage() {}
+
+func (x *ServiceIntentionsSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceIntentionsSpec.ProtoReflect.Descriptor instead.
+func (*ServiceIntentionsSpec) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServiceIntentionsSpec) GetDestination() *ServiceIntentionsSpec_IntentionDestination {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *ServiceIntentionsSpec) GetSources() []*ServiceIntentionsSpec_SourceIntention {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type ServiceIntentionsSpec_IntentionDestination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The destination service name, or "*" to match all services.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The Consul namespace of the destination service.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *ServiceIntentionsSpec_IntentionDestination) Reset() {
+	*x = ServiceIntentionsSpec_IntentionDestination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceIntentionsSpec_IntentionDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceIntentionsSpec_IntentionDestination) ProtoMessage() {}
+
+func (x *ServiceIntentionsSpec_IntentionDestination) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceIntentionsSpec_IntentionDestination.ProtoReflect.Descriptor instead.
+func (*ServiceIntentionsSpec_IntentionDestination) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ServiceIntentionsSpec_IntentionDestination) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceIntentionsSpec_IntentionDestination) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ServiceIntentionsSpec_SourceIntention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The source service name, or "*" to match all services.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The Consul namespace of the source service.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The intended authorization action for the source, either "allow" or "deny".
+	// Exactly one of action or permissions must be set.
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// The list of all additional L7 attributes that extend the intention match criteria.
+	Permissions []*ServiceIntentionsSpec_IntentionPermission `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Description for the intention.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *ServiceIntentionsSpec_SourceIntention) Reset() {
+	*x = ServiceIntentionsSpec_SourceIntention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceIntentionsSpec_SourceIntention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceIntentionsSpec_SourceIntention) ProtoMessage() {}
+
+func (x *ServiceIntentionsSpec_SourceIntention) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceIntentionsSpec_SourceIntention.ProtoReflect.Descriptor instead.
+func (*ServiceIntentionsSpec_SourceIntention) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *ServiceIntentionsSpec_SourceIntention) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceIntentionsSpec_SourceIntention) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ServiceIntentionsSpec_SourceIntention) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ServiceIntentionsSpec_SourceIntention) GetPermissions() []*ServiceIntentionsSpec_IntentionPermission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ServiceIntentionsSpec_SourceIntention) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ServiceIntentionsSpec_IntentionPermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The intended authorization action for requests matching this permission, either "allow" or "deny".
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// A set of HTTP-specific authorization criteria.
+	Http *ServiceIntentionsSpec_IntentionHTTPPermission `protobuf:"bytes,2,opt,name=http,proto3" json:"http,omitempty"`
+}
+
+func (x *ServiceIntentionsSpec_IntentionPermission) Reset() {
+	*x = ServiceIntentionsSpec_IntentionPermission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceIntentionsSpec_IntentionPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceIntentionsSpec_IntentionPermission) ProtoMessage() {}
+
+func (x *ServiceIntentionsSpec_IntentionPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceIntentionsSpec_IntentionPermission.ProtoReflect.Descriptor instead.
+func (*ServiceIntentionsSpec_IntentionPermission) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *ServiceIntentionsSpec_IntentionPermission) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ServiceIntentionsSpec_IntentionPermission) GetHttp() *ServiceIntentionsSpec_IntentionHTTPPermission {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+type ServiceIntentionsSpec_IntentionHTTPPermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Exact path to match on the HTTP request path.
+	PathExact string `protobuf:"bytes,1,opt,name=path_exact,json=pathExact,proto3" json:"path_exact,omitempty"`
+	// Path prefix to match on the HTTP request path.
+	PathPrefix string `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	// Regular expression to match on the HTTP request path.
+	PathRegex string `protobuf:"bytes,3,opt,name=path_regex,json=pathRegex,proto3" json:"path_regex,omitempty"`
+	// A list of HTTP methods for which this match applies.
+	// If unspecified all HTTP methods are matched.
+	Methods []string `protobuf:"bytes,4,rep,name=methods,proto3" json:"methods,omitempty"`
+}
+
+func (x *ServiceIntentionsSpec_IntentionHTTPPermission) Reset() {
+	*x = ServiceIntentionsSpec_IntentionHTTPPermission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceIntentionsSpec_IntentionHTTPPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceIntentionsSpec_IntentionHTTPPermission) ProtoMessage() {}
+
+func (x *ServiceIntentionsSpec_IntentionHTTPPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceIntentionsSpec_IntentionHTTPPermission.ProtoReflect.Descriptor instead.
+func (*ServiceIntentionsSpec_IntentionHTTPPermission) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *ServiceIntentionsSpec_IntentionHTTPPermission) GetPathExact() string {
+	if x != nil {
+		return x.PathExact
+	}
+	return ""
+}
+
+func (x *ServiceIntentionsSpec_IntentionHTTPPermission) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *ServiceIntentionsSpec_IntentionHTTPPermission) GetPathRegex() string {
+	if x != nil {
+		return x.PathRegex
+	}
+	return ""
+}
+
+func (x *ServiceIntentionsSpec_IntentionHTTPPermission) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+var File_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDesc = []byte{
+	0x0a, 0x52, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6c, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68, 0x61, 0x73,
+	0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9d,
+	0x06, 0x0a, 0x15, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x53, 0x70, 0x65, 0x63, 0x12, 0x62, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x07,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x1a, 0x48, 0x0a, 0x14, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x1a, 0xe0, 0x01,
+	0x0a, 0x0f, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x86, 0x01, 0x0a, 0x13, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x57, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x54, 0x54, 0x50, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x1a, 0x92, 0x01, 0x0a, 0x17, 0x49, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x54, 0x54, 0x50, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x65, 0x78,
+	0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x74, 0x68, 0x45,
+	0x78, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65,
+	0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x67, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x42, 0x51,
+	0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xc0, 0xf5, 0x04,
+	0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDescData = file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_goTypes = []interface{}{
+	(*ServiceIntentionsSpec)(nil),                         // 0: consul.hashicorp.com.ServiceIntentionsSpec
+	(*ServiceIntentionsSpec_IntentionDestination)(nil),    // 1: consul.hashicorp.com.ServiceIntentionsSpec.IntentionDestination
+	(*ServiceIntentionsSpec_SourceIntention)(nil),         // 2: consul.hashicorp.com.ServiceIntentionsSpec.SourceIntention
+	(*ServiceIntentionsSpec_IntentionPermission)(nil),     // 3: consul.hashicorp.com.ServiceIntentionsSpec.IntentionPermission
+	(*ServiceIntentionsSpec_IntentionHTTPPermission)(nil), // 4: consul.hashicorp.com.ServiceIntentionsSpec.IntentionHTTPPermission
+}
+var file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_depIdxs = []int32{
+	1, // 0: consul.hashicorp.com.ServiceIntentionsSpec.destination:type_name -> consul.hashicorp.com.ServiceIntentionsSpec.IntentionDestination
+	2, // 1: consul.hashicorp.com.ServiceIntentionsSpec.sources:type_name -> consul.hashicorp.com.ServiceIntentionsSpec.SourceIntention
+	3, // 2: consul.hashicorp.com.ServiceIntentionsSpec.SourceIntention.permissions:type_name -> consul.hashicorp.com.ServiceIntentionsSpec.IntentionPermission
+	4, // 3: consul.hashicorp.com.ServiceIntentionsSpec.IntentionPermission.http:type_name -> consul.hashicorp.com.ServiceIntentionsSpec.IntentionHTTPPermission
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() {
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_init()
+}
+func file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_init() {
+	if File_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceIntentionsSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceIntentionsSpec_IntentionDestination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceIntentionsSpec_SourceIntention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceIntentionsSpec_IntentionPermission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceIntentionsSpec_IntentionHTTPPermission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_depIdxs,
+		MessageInfos:      file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto = out.File
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_goTypes = nil
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_intentions_proto_depIdxs = nil
+}
@@ -0,0 +1,387 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo-mesh/api/external/consul/v1alpha1/service_router.proto
+
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *ServiceRouterSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceRouterSpec)
+	if !ok {
+		that2, ok := that.(ServiceRouterSpec)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetRoutes()) != len(target.GetRoutes()) {
+		return false
+	}
+	for idx, v := range m.GetRoutes() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRoutes()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetRoutes()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ServiceRouterSpec_ServiceRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceRouterSpec_ServiceRoute)
+	if !ok {
+		that2, ok := that.(ServiceRouterSpec_ServiceRoute)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMatch()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMatch()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMatch(), target.GetMatch()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetDestination()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDestination()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDestination(), target.GetDestination()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ServiceRouterSpec_ServiceRouteMatch) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceRouterSpec_ServiceRouteMatch)
+	if !ok {
+		that2, ok := that.(ServiceRouterSpec_ServiceRouteMatch)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetHttp()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHttp()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHttp(), target.GetHttp()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ServiceRouterSpec_ServiceRouteHTTPMatch) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceRouterSpec_ServiceRouteHTTPMatch)
+	if !ok {
+		that2, ok := that.(ServiceRouterSpec_ServiceRouteHTTPMatch)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetPathExact(), target.GetPathExact()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetPathPrefix(), target.GetPathPrefix()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetPathRegex(), target.GetPathRegex()) != 0 {
+		return false
+	}
+
+	if len(m.GetHeader()) != len(target.GetHeader()) {
+		return false
+	}
+	for idx, v := range m.GetHeader() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHeader()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetHeader()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetQueryParam()) != len(target.GetQueryParam()) {
+		return false
+	}
+	for idx, v := range m.GetQueryParam() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetQueryParam()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetQueryParam()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetMethods()) != len(target.GetMethods()) {
+		return false
+	}
+	for idx, v := range m.GetMethods() {
+
+		if strings.Compare(v, target.GetMethods()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ServiceRouterSpec_ServiceRouteHTTPMatchHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceRouterSpec_ServiceRouteHTTPMatchHeader)
+	if !ok {
+		that2, ok := that.(ServiceRouterSpec_ServiceRouteHTTPMatchHeader)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if m.GetPresent() != target.GetPresent() {
+		return false
+	}
+
+	if strings.Compare(m.GetExact(), target.GetExact()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetPrefix(), target.GetPrefix()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetSuffix(), target.GetSuffix()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetRegex(), target.GetRegex()) != 0 {
+		return false
+	}
+
+	if m.GetInvert() != target.GetInvert() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam)
+	if !ok {
+		that2, ok := that.(ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if m.GetPresent() != target.GetPresent() {
+		return false
+	}
+
+	if strings.Compare(m.GetExact(), target.GetExact()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetRegex(), target.GetRegex()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ServiceRouterSpec_ServiceRouteDestination) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceRouterSpec_ServiceRouteDestination)
+	if !ok {
+		that2, ok := that.(ServiceRouterSpec_ServiceRouteDestination)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetService(), target.GetService()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetServiceSubset(), target.GetServiceSubset()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetNamespace(), target.GetNamespace()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetPrefixRewrite(), target.GetPrefixRewrite()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetRequestTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRequestTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRequestTimeout(), target.GetRequestTimeout()) {
+			return false
+		}
+	}
+
+	if m.GetNumRetries() != target.GetNumRetries() {
+		return false
+	}
+
+	if m.GetRetryOnConnectFailure() != target.GetRetryOnConnectFailure() {
+		return false
+	}
+
+	if len(m.GetRetryOnStatusCodes()) != len(target.GetRetryOnStatusCodes()) {
+		return false
+	}
+	for idx, v := range m.GetRetryOnStatusCodes() {
+
+		if v != target.GetRetryOnStatusCodes()[idx] {
+			return false
+		}
+
+	}
+
+	return true
+}
@@ -0,0 +1,834 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.21.0
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo-mesh/api/external/consul/v1alpha1/service_router.proto
+
+package v1alpha1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+//
+//Mirrors the Consul [ServiceRouter](https://www.consul.io/docs/connect/config-entries/service-router) config entry CRD
+//managed by consul-k8s. Gloo Mesh only writes this resource, it is never installed by Gloo Mesh.
+//The name of the ServiceRouter must match the name of the Consul service it applies to.
+type ServiceRouterSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The list of routes to consider when processing L7 requests.
+	// The first route to match in the list is terminal and stops further evaluation.
+	Routes []*ServiceRouterSpec_ServiceRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *ServiceRouterSpec) Reset() {
+	*x = ServiceRouterSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceRouterSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRouterSpec) ProtoMessage() {}
+
+func (x *ServiceRouterSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRouterSpec.ProtoReflect.Descriptor instead.
+func (*ServiceRouterSpec) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServiceRouterSpec) GetRoutes() []*ServiceRouterSpec_ServiceRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type ServiceRouterSpec_ServiceRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A set of criteria that can match incoming L7 requests.
+	// If empty or omitted it acts as a catch-all.
+	Match *ServiceRouterSpec_ServiceRouteMatch `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// Controls how to proxy the matching request(s) to a service.
+	Destination *ServiceRouterSpec_ServiceRouteDestination `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (x *ServiceRouterSpec_ServiceRoute) Reset() {
+	*x = ServiceRouterSpec_ServiceRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceRouterSpec_ServiceRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRouterSpec_ServiceRoute) ProtoMessage() {}
+
+func (x *ServiceRouterSpec_ServiceRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRouterSpec_ServiceRoute.ProtoReflect.Descriptor instead.
+func (*ServiceRouterSpec_ServiceRoute) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ServiceRouterSpec_ServiceRoute) GetMatch() *ServiceRouterSpec_ServiceRouteMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *ServiceRouterSpec_ServiceRoute) GetDestination() *ServiceRouterSpec_ServiceRouteDestination {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+type ServiceRouterSpec_ServiceRouteMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A set of HTTP-specific match criteria.
+	Http *ServiceRouterSpec_ServiceRouteHTTPMatch `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+}
+
+func (x *ServiceRouterSpec_ServiceRouteMatch) Reset() {
+	*x = ServiceRouterSpec_ServiceRouteMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceRouterSpec_ServiceRouteMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRouterSpec_ServiceRouteMatch) ProtoMessage() {}
+
+func (x *ServiceRouterSpec_ServiceRouteMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRouterSpec_ServiceRouteMatch.ProtoReflect.Descriptor instead.
+func (*ServiceRouterSpec_ServiceRouteMatch) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *ServiceRouterSpec_ServiceRouteMatch) GetHttp() *ServiceRouterSpec_ServiceRouteHTTPMatch {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+type ServiceRouterSpec_ServiceRouteHTTPMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Exact path to match on the HTTP request path.
+	PathExact string `protobuf:"bytes,1,opt,name=path_exact,json=pathExact,proto3" json:"path_exact,omitempty"`
+	// Path prefix to match on the HTTP request path.
+	PathPrefix string `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	// Regular expression to match on the HTTP request path.
+	PathRegex string `protobuf:"bytes,3,opt,name=path_regex,json=pathRegex,proto3" json:"path_regex,omitempty"`
+	// A set of criteria that can match on HTTP request headers.
+	// If more than one is configured all must match for the overall match to apply.
+	Header []*ServiceRouterSpec_ServiceRouteHTTPMatchHeader `protobuf:"bytes,4,rep,name=header,proto3" json:"header,omitempty"`
+	// A set of criteria that can match on HTTP query parameters.
+	// If more than one is configured all must match for the overall match to apply.
+	QueryParam []*ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam `protobuf:"bytes,5,rep,name=query_param,json=queryParam,proto3" json:"query_param,omitempty"`
+	// A list of HTTP methods for which this match applies.
+	// If unspecified all HTTP methods are matched.
+	Methods []string `protobuf:"bytes,6,rep,name=methods,proto3" json:"methods,omitempty"`
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatch) Reset() {
+	*x = ServiceRouterSpec_ServiceRouteHTTPMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRouterSpec_ServiceRouteHTTPMatch) ProtoMessage() {}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRouterSpec_ServiceRouteHTTPMatch.ProtoReflect.Descriptor instead.
+func (*ServiceRouterSpec_ServiceRouteHTTPMatch) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatch) GetPathExact() string {
+	if x != nil {
+		return x.PathExact
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatch) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatch) GetPathRegex() string {
+	if x != nil {
+		return x.PathRegex
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatch) GetHeader() []*ServiceRouterSpec_ServiceRouteHTTPMatchHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatch) GetQueryParam() []*ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam {
+	if x != nil {
+		return x.QueryParam
+	}
+	return nil
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatch) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type ServiceRouterSpec_ServiceRouteHTTPMatchHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the header to match.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Match if the header with the given name is present with any value.
+	Present bool `protobuf:"varint,2,opt,name=present,proto3" json:"present,omitempty"`
+	// Match if the header with the given name is this value.
+	Exact string `protobuf:"bytes,3,opt,name=exact,proto3" json:"exact,omitempty"`
+	// Match if the header with the given name has this prefix.
+	Prefix string `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Match if the header with the given name has this suffix.
+	Suffix string `protobuf:"bytes,5,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	// Match if the header with the given name matches this pattern.
+	Regex string `protobuf:"bytes,6,opt,name=regex,proto3" json:"regex,omitempty"`
+	// Inverts the logic of the match.
+	Invert bool `protobuf:"varint,7,opt,name=invert,proto3" json:"invert,omitempty"`
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchHeader) Reset() {
+	*x = ServiceRouterSpec_ServiceRouteHTTPMatchHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRouterSpec_ServiceRouteHTTPMatchHeader) ProtoMessage() {}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRouterSpec_ServiceRouteHTTPMatchHeader.ProtoReflect.Descriptor instead.
+func (*ServiceRouterSpec_ServiceRouteHTTPMatchHeader) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchHeader) GetPresent() bool {
+	if x != nil {
+		return x.Present
+	}
+	return false
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchHeader) GetExact() string {
+	if x != nil {
+		return x.Exact
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchHeader) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchHeader) GetSuffix() string {
+	if x != nil {
+		return x.Suffix
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchHeader) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchHeader) GetInvert() bool {
+	if x != nil {
+		return x.Invert
+	}
+	return false
+}
+
+type ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the query parameter to match on.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Match if the query parameter with the given name is present with any value.
+	Present bool `protobuf:"varint,2,opt,name=present,proto3" json:"present,omitempty"`
+	// Match if the query parameter with the given name is this value.
+	Exact string `protobuf:"bytes,3,opt,name=exact,proto3" json:"exact,omitempty"`
+	// Match if the query parameter with the given name matches this pattern.
+	Regex string `protobuf:"bytes,4,opt,name=regex,proto3" json:"regex,omitempty"`
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam) Reset() {
+	*x = ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam) ProtoMessage() {}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam.ProtoReflect.Descriptor instead.
+func (*ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam) GetPresent() bool {
+	if x != nil {
+		return x.Present
+	}
+	return false
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam) GetExact() string {
+	if x != nil {
+		return x.Exact
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+type ServiceRouterSpec_ServiceRouteDestination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The service to resolve instead of the default service.
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// A named subset of the given service to resolve instead of the one defined as that service's DefaultSubset.
+	ServiceSubset string `protobuf:"bytes,2,opt,name=service_subset,json=serviceSubset,proto3" json:"service_subset,omitempty"`
+	// The Consul namespace to resolve the service from instead of the current namespace.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Defines how to rewrite the HTTP request path before proxying it to its final destination.
+	PrefixRewrite string `protobuf:"bytes,4,opt,name=prefix_rewrite,json=prefixRewrite,proto3" json:"prefix_rewrite,omitempty"`
+	// The total amount of time permitted for the entire downstream request (and retries) to be processed.
+	RequestTimeout *duration.Duration `protobuf:"bytes,5,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	// The number of times to retry the request when a retryable result occurs.
+	NumRetries uint32 `protobuf:"varint,6,opt,name=num_retries,json=numRetries,proto3" json:"num_retries,omitempty"`
+	// Allows for connection failure errors to trigger a retry.
+	RetryOnConnectFailure bool `protobuf:"varint,7,opt,name=retry_on_connect_failure,json=retryOnConnectFailure,proto3" json:"retry_on_connect_failure,omitempty"`
+	// A flat list of http response status codes that are eligible for retry.
+	RetryOnStatusCodes []uint32 `protobuf:"varint,8,rep,packed,name=retry_on_status_codes,json=retryOnStatusCodes,proto3" json:"retry_on_status_codes,omitempty"`
+}
+
+func (x *ServiceRouterSpec_ServiceRouteDestination) Reset() {
+	*x = ServiceRouterSpec_ServiceRouteDestination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceRouterSpec_ServiceRouteDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceRouterSpec_ServiceRouteDestination) ProtoMessage() {}
+
+func (x *ServiceRouterSpec_ServiceRouteDestination) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceRouterSpec_ServiceRouteDestination.ProtoReflect.Descriptor instead.
+func (*ServiceRouterSpec_ServiceRouteDestination) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *ServiceRouterSpec_ServiceRouteDestination) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteDestination) GetServiceSubset() string {
+	if x != nil {
+		return x.ServiceSubset
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteDestination) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteDestination) GetPrefixRewrite() string {
+	if x != nil {
+		return x.PrefixRewrite
+	}
+	return ""
+}
+
+func (x *ServiceRouterSpec_ServiceRouteDestination) GetRequestTimeout() *duration.Duration {
+	if x != nil {
+		return x.RequestTimeout
+	}
+	return nil
+}
+
+func (x *ServiceRouterSpec_ServiceRouteDestination) GetNumRetries() uint32 {
+	if x != nil {
+		return x.NumRetries
+	}
+	return 0
+}
+
+func (x *ServiceRouterSpec_ServiceRouteDestination) GetRetryOnConnectFailure() bool {
+	if x != nil {
+		return x.RetryOnConnectFailure
+	}
+	return false
+}
+
+func (x *ServiceRouterSpec_ServiceRouteDestination) GetRetryOnStatusCodes() []uint32 {
+	if x != nil {
+		return x.RetryOnStatusCodes
+	}
+	return nil
+}
+
+var File_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDesc = []byte{
+	0x0a, 0x4e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6c, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x0b, 0x0a, 0x11, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x4c, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x34, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63,
+	0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0xc2,
+	0x01, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
+	0x4f, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39,
+	0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72,
+	0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x61, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68,
+	0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x66, 0x0a, 0x11, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x51, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x1a, 0xd7, 0x02, 0x0a, 0x15,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x65, 0x78,
+	0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x74, 0x68, 0x45,
+	0x78, 0x61, 0x63, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65,
+	0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x67, 0x65, 0x78, 0x12, 0x5b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x68, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52,
+	0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x1a, 0xbf, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x1a, 0x7b, 0x0a, 0x1f, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x54, 0x54, 0x50, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x65, 0x67, 0x65, 0x78, 0x1a, 0xf0, 0x02, 0x0a, 0x17, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x75, 0x62, 0x73, 0x65,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52,
+	0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75,
+	0x6d, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0d, 0x52, 0x12, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x51, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e,
+	0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescData = file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_goTypes = []interface{}{
+	(*ServiceRouterSpec)(nil),                                 // 0: consul.hashicorp.com.ServiceRouterSpec
+	(*ServiceRouterSpec_ServiceRoute)(nil),                    // 1: consul.hashicorp.com.ServiceRouterSpec.ServiceRoute
+	(*ServiceRouterSpec_ServiceRouteMatch)(nil),               // 2: consul.hashicorp.com.ServiceRouterSpec.ServiceRouteMatch
+	(*ServiceRouterSpec_ServiceRouteHTTPMatch)(nil),           // 3: consul.hashicorp.com.ServiceRouterSpec.ServiceRouteHTTPMatch
+	(*ServiceRouterSpec_ServiceRouteHTTPMatchHeader)(nil),     // 4: consul.hashicorp.com.ServiceRouterSpec.ServiceRouteHTTPMatchHeader
+	(*ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam)(nil), // 5: consul.hashicorp.com.ServiceRouterSpec.ServiceRouteHTTPMatchQueryParam
+	(*ServiceRouterSpec_ServiceRouteDestination)(nil),         // 6: consul.hashicorp.com.ServiceRouterSpec.ServiceRouteDestination
+	(*duration.Duration)(nil),                                 // 7: google.protobuf.Duration
+}
+var file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_depIdxs = []int32{
+	1, // 0: consul.hashicorp.com.ServiceRouterSpec.routes:type_name -> consul.hashicorp.com.ServiceRouterSpec.ServiceRoute
+	2, // 1: consul.hashicorp.com.ServiceRouterSpec.ServiceRoute.match:type_name -> consul.hashicorp.com.ServiceRouterSpec.ServiceRouteMatch
+	6, // 2: consul.hashicorp.com.ServiceRouterSpec.ServiceRoute.destination:type_name -> consul.hashicorp.com.ServiceRouterSpec.ServiceRouteDestination
+	3, // 3: consul.hashicorp.com.ServiceRouterSpec.ServiceRouteMatch.http:type_name -> consul.hashicorp.com.ServiceRouterSpec.ServiceRouteHTTPMatch
+	4, // 4: consul.hashicorp.com.ServiceRouterSpec.ServiceRouteHTTPMatch.header:type_name -> consul.hashicorp.com.ServiceRouterSpec.ServiceRouteHTTPMatchHeader
+	5, // 5: consul.hashicorp.com.ServiceRouterSpec.ServiceRouteHTTPMatch.query_param:type_name -> consul.hashicorp.com.ServiceRouterSpec.ServiceRouteHTTPMatchQueryParam
+	7, // 6: consul.hashicorp.com.ServiceRouterSpec.ServiceRouteDestination.request_timeout:type_name -> google.protobuf.Duration
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() {
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_init()
+}
+func file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_init() {
+	if File_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRouterSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRouterSpec_ServiceRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRouterSpec_ServiceRouteMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRouterSpec_ServiceRouteHTTPMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRouterSpec_ServiceRouteHTTPMatchHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceRouterSpec_ServiceRouteDestination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_depIdxs,
+		MessageInfos:      file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto = out.File
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_goTypes = nil
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_router_proto_depIdxs = nil
+}
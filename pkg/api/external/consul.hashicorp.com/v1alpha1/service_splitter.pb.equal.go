@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo-mesh/api/external/consul/v1alpha1/service_splitter.proto
+
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *ServiceSplitterSpec) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceSplitterSpec)
+	if !ok {
+		that2, ok := that.(ServiceSplitterSpec)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetSplits()) != len(target.GetSplits()) {
+		return false
+	}
+	for idx, v := range m.GetSplits() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetSplits()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetSplits()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *ServiceSplitterSpec_ServiceSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*ServiceSplitterSpec_ServiceSplit)
+	if !ok {
+		that2, ok := that.(ServiceSplitterSpec_ServiceSplit)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetWeight() != target.GetWeight() {
+		return false
+	}
+
+	if strings.Compare(m.GetService(), target.GetService()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetServiceSubset(), target.GetServiceSubset()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetNamespace(), target.GetNamespace()) != 0 {
+		return false
+	}
+
+	return true
+}
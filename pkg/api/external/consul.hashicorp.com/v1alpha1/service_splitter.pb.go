@@ -0,0 +1,269 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.21.0
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo-mesh/api/external/consul/v1alpha1/service_splitter.proto
+
+package v1alpha1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+//
+//Mirrors the Consul [ServiceSplitter](https://www.consul.io/docs/connect/config-entries/service-splitter) config entry CRD
+//managed by consul-k8s. Gloo Mesh only writes this resource, it is never installed by Gloo Mesh.
+//The name of the ServiceSplitter must match the name of the Consul service it applies to.
+type ServiceSplitterSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Defines how much traffic to send to which set of service instances during a traffic split.
+	// The sum of weights across all splits must add up to 100.
+	Splits []*ServiceSplitterSpec_ServiceSplit `protobuf:"bytes,1,rep,name=splits,proto3" json:"splits,omitempty"`
+}
+
+func (x *ServiceSplitterSpec) Reset() {
+	*x = ServiceSplitterSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceSplitterSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceSplitterSpec) ProtoMessage() {}
+
+func (x *ServiceSplitterSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceSplitterSpec.ProtoReflect.Descriptor instead.
+func (*ServiceSplitterSpec) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServiceSplitterSpec) GetSplits() []*ServiceSplitterSpec_ServiceSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+type ServiceSplitterSpec_ServiceSplit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A value between 0 and 100 reflecting what portion of traffic should be directed to this split.
+	Weight float32 `protobuf:"fixed32,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	// The service to resolve instead of the default.
+	Service string `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	// A named subset of the given service to resolve instead of one defined as that service's DefaultSubset.
+	ServiceSubset string `protobuf:"bytes,3,opt,name=service_subset,json=serviceSubset,proto3" json:"service_subset,omitempty"`
+	// The Consul namespace to resolve the service from instead of the current namespace.
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *ServiceSplitterSpec_ServiceSplit) Reset() {
+	*x = ServiceSplitterSpec_ServiceSplit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceSplitterSpec_ServiceSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceSplitterSpec_ServiceSplit) ProtoMessage() {}
+
+func (x *ServiceSplitterSpec_ServiceSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceSplitterSpec_ServiceSplit.ProtoReflect.Descriptor instead.
+func (*ServiceSplitterSpec_ServiceSplit) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ServiceSplitterSpec_ServiceSplit) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ServiceSplitterSpec_ServiceSplit) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ServiceSplitterSpec_ServiceSplit) GetServiceSubset() string {
+	if x != nil {
+		return x.ServiceSubset
+	}
+	return ""
+}
+
+func (x *ServiceSplitterSpec_ServiceSplit) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+var File_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_rawDesc = []byte{
+	0x0a, 0x50, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6c, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69,
+	0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xed, 0x01, 0x0a,
+	0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68, 0x61,
+	0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63, 0x6f, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x06, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x73, 0x1a, 0x85, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x51, 0x5a, 0x4b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d,
+	0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6c, 0x2e, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_rawDescData = file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_goTypes = []interface{}{
+	(*ServiceSplitterSpec)(nil),              // 0: consul.hashicorp.com.ServiceSplitterSpec
+	(*ServiceSplitterSpec_ServiceSplit)(nil), // 1: consul.hashicorp.com.ServiceSplitterSpec.ServiceSplit
+}
+var file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_depIdxs = []int32{
+	1, // 0: consul.hashicorp.com.ServiceSplitterSpec.splits:type_name -> consul.hashicorp.com.ServiceSplitterSpec.ServiceSplit
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() {
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_init()
+}
+func file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_init() {
+	if File_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceSplitterSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceSplitterSpec_ServiceSplit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_depIdxs,
+		MessageInfos:      file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto = out.File
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_goTypes = nil
+	file_github_com_solo_io_gloo_mesh_api_external_consul_v1alpha1_service_splitter_proto_depIdxs = nil
+}
@@ -0,0 +1,784 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./sets.go
+
+// Package mock_v1alpha1sets is a generated GoMock package.
+package mock_v1alpha1sets
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
+	v1alpha1sets "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1/sets"
+	sets "github.com/solo-io/skv2/contrib/pkg/sets"
+	ezkube "github.com/solo-io/skv2/pkg/ezkube"
+	sets0 "k8s.io/apimachinery/pkg/util/sets"
+)
+
+// MockServiceSplitterSet is a mock of ServiceSplitterSet interface.
+type MockServiceSplitterSet struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceSplitterSetMockRecorder
+}
+
+// MockServiceSplitterSetMockRecorder is the mock recorder for MockServiceSplitterSet.
+type MockServiceSplitterSetMockRecorder struct {
+	mock *MockServiceSplitterSet
+}
+
+// NewMockServiceSplitterSet creates a new mock instance.
+func NewMockServiceSplitterSet(ctrl *gomock.Controller) *MockServiceSplitterSet {
+	mock := &MockServiceSplitterSet{ctrl: ctrl}
+	mock.recorder = &MockServiceSplitterSetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceSplitterSet) EXPECT() *MockServiceSplitterSetMockRecorder {
+	return m.recorder
+}
+
+// Clone mocks base method.
+func (m *MockServiceSplitterSet) Clone() v1alpha1sets.ServiceSplitterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone")
+	ret0, _ := ret[0].(v1alpha1sets.ServiceSplitterSet)
+	return ret0
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockServiceSplitterSetMockRecorder) Clone() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockServiceSplitterSet)(nil).Clone))
+}
+
+// Delete mocks base method.
+func (m *MockServiceSplitterSet) Delete(serviceSplitter ezkube.ResourceId) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", serviceSplitter)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceSplitterSetMockRecorder) Delete(serviceSplitter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceSplitterSet)(nil).Delete), serviceSplitter)
+}
+
+// Delta mocks base method.
+func (m *MockServiceSplitterSet) Delta(newSet v1alpha1sets.ServiceSplitterSet) sets.ResourceDelta {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delta", newSet)
+	ret0, _ := ret[0].(sets.ResourceDelta)
+	return ret0
+}
+
+// Delta indicates an expected call of Delta.
+func (mr *MockServiceSplitterSetMockRecorder) Delta(newSet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delta", reflect.TypeOf((*MockServiceSplitterSet)(nil).Delta), newSet)
+}
+
+// Difference mocks base method.
+func (m *MockServiceSplitterSet) Difference(set v1alpha1sets.ServiceSplitterSet) v1alpha1sets.ServiceSplitterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Difference", set)
+	ret0, _ := ret[0].(v1alpha1sets.ServiceSplitterSet)
+	return ret0
+}
+
+// Difference indicates an expected call of Difference.
+func (mr *MockServiceSplitterSetMockRecorder) Difference(set interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Difference", reflect.TypeOf((*MockServiceSplitterSet)(nil).Difference), set)
+}
+
+// Equal mocks base method.
+func (m *MockServiceSplitterSet) Equal(serviceSplitterSet v1alpha1sets.ServiceSplitterSet) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Equal", serviceSplitterSet)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Equal indicates an expected call of Equal.
+func (mr *MockServiceSplitterSetMockRecorder) Equal(serviceSplitterSet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Equal", reflect.TypeOf((*MockServiceSplitterSet)(nil).Equal), serviceSplitterSet)
+}
+
+// Find mocks base method.
+func (m *MockServiceSplitterSet) Find(id ezkube.ResourceId) (*v1alpha1.ServiceSplitter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", id)
+	ret0, _ := ret[0].(*v1alpha1.ServiceSplitter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockServiceSplitterSetMockRecorder) Find(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockServiceSplitterSet)(nil).Find), id)
+}
+
+// Generic mocks base method.
+func (m *MockServiceSplitterSet) Generic() sets.ResourceSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generic")
+	ret0, _ := ret[0].(sets.ResourceSet)
+	return ret0
+}
+
+// Generic indicates an expected call of Generic.
+func (mr *MockServiceSplitterSetMockRecorder) Generic() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generic", reflect.TypeOf((*MockServiceSplitterSet)(nil).Generic))
+}
+
+// Has mocks base method.
+func (m *MockServiceSplitterSet) Has(serviceSplitter ezkube.ResourceId) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Has", serviceSplitter)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Has indicates an expected call of Has.
+func (mr *MockServiceSplitterSetMockRecorder) Has(serviceSplitter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Has", reflect.TypeOf((*MockServiceSplitterSet)(nil).Has), serviceSplitter)
+}
+
+// Insert mocks base method.
+func (m *MockServiceSplitterSet) Insert(serviceSplitter ...*v1alpha1.ServiceSplitter) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range serviceSplitter {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Insert", varargs...)
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockServiceSplitterSetMockRecorder) Insert(serviceSplitter ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockServiceSplitterSet)(nil).Insert), serviceSplitter...)
+}
+
+// Intersection mocks base method.
+func (m *MockServiceSplitterSet) Intersection(set v1alpha1sets.ServiceSplitterSet) v1alpha1sets.ServiceSplitterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Intersection", set)
+	ret0, _ := ret[0].(v1alpha1sets.ServiceSplitterSet)
+	return ret0
+}
+
+// Intersection indicates an expected call of Intersection.
+func (mr *MockServiceSplitterSetMockRecorder) Intersection(set interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Intersection", reflect.TypeOf((*MockServiceSplitterSet)(nil).Intersection), set)
+}
+
+// Keys mocks base method.
+func (m *MockServiceSplitterSet) Keys() sets0.String {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Keys")
+	ret0, _ := ret[0].(sets0.String)
+	return ret0
+}
+
+// Keys indicates an expected call of Keys.
+func (mr *MockServiceSplitterSetMockRecorder) Keys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockServiceSplitterSet)(nil).Keys))
+}
+
+// Length mocks base method.
+func (m *MockServiceSplitterSet) Length() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Length")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Length indicates an expected call of Length.
+func (mr *MockServiceSplitterSetMockRecorder) Length() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Length", reflect.TypeOf((*MockServiceSplitterSet)(nil).Length))
+}
+
+// List mocks base method.
+func (m *MockServiceSplitterSet) List(filterResource ...func(*v1alpha1.ServiceSplitter) bool) []*v1alpha1.ServiceSplitter {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filterResource {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].([]*v1alpha1.ServiceSplitter)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockServiceSplitterSetMockRecorder) List(filterResource ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockServiceSplitterSet)(nil).List), filterResource...)
+}
+
+// Map mocks base method.
+func (m *MockServiceSplitterSet) Map() map[string]*v1alpha1.ServiceSplitter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Map")
+	ret0, _ := ret[0].(map[string]*v1alpha1.ServiceSplitter)
+	return ret0
+}
+
+// Map indicates an expected call of Map.
+func (mr *MockServiceSplitterSetMockRecorder) Map() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Map", reflect.TypeOf((*MockServiceSplitterSet)(nil).Map))
+}
+
+// Union mocks base method.
+func (m *MockServiceSplitterSet) Union(set v1alpha1sets.ServiceSplitterSet) v1alpha1sets.ServiceSplitterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Union", set)
+	ret0, _ := ret[0].(v1alpha1sets.ServiceSplitterSet)
+	return ret0
+}
+
+// Union indicates an expected call of Union.
+func (mr *MockServiceSplitterSetMockRecorder) Union(set interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Union", reflect.TypeOf((*MockServiceSplitterSet)(nil).Union), set)
+}
+
+// UnsortedList mocks base method.
+func (m *MockServiceSplitterSet) UnsortedList(filterResource ...func(*v1alpha1.ServiceSplitter) bool) []*v1alpha1.ServiceSplitter {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filterResource {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnsortedList", varargs...)
+	ret0, _ := ret[0].([]*v1alpha1.ServiceSplitter)
+	return ret0
+}
+
+// UnsortedList indicates an expected call of UnsortedList.
+func (mr *MockServiceSplitterSetMockRecorder) UnsortedList(filterResource ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsortedList", reflect.TypeOf((*MockServiceSplitterSet)(nil).UnsortedList), filterResource...)
+}
+
+// MockServiceRouterSet is a mock of ServiceRouterSet interface.
+type MockServiceRouterSet struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceRouterSetMockRecorder
+}
+
+// MockServiceRouterSetMockRecorder is the mock recorder for MockServiceRouterSet.
+type MockServiceRouterSetMockRecorder struct {
+	mock *MockServiceRouterSet
+}
+
+// NewMockServiceRouterSet creates a new mock instance.
+func NewMockServiceRouterSet(ctrl *gomock.Controller) *MockServiceRouterSet {
+	mock := &MockServiceRouterSet{ctrl: ctrl}
+	mock.recorder = &MockServiceRouterSetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceRouterSet) EXPECT() *MockServiceRouterSetMockRecorder {
+	return m.recorder
+}
+
+// Clone mocks base method.
+func (m *MockServiceRouterSet) Clone() v1alpha1sets.ServiceRouterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone")
+	ret0, _ := ret[0].(v1alpha1sets.ServiceRouterSet)
+	return ret0
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockServiceRouterSetMockRecorder) Clone() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockServiceRouterSet)(nil).Clone))
+}
+
+// Delete mocks base method.
+func (m *MockServiceRouterSet) Delete(serviceRouter ezkube.ResourceId) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", serviceRouter)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceRouterSetMockRecorder) Delete(serviceRouter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceRouterSet)(nil).Delete), serviceRouter)
+}
+
+// Delta mocks base method.
+func (m *MockServiceRouterSet) Delta(newSet v1alpha1sets.ServiceRouterSet) sets.ResourceDelta {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delta", newSet)
+	ret0, _ := ret[0].(sets.ResourceDelta)
+	return ret0
+}
+
+// Delta indicates an expected call of Delta.
+func (mr *MockServiceRouterSetMockRecorder) Delta(newSet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delta", reflect.TypeOf((*MockServiceRouterSet)(nil).Delta), newSet)
+}
+
+// Difference mocks base method.
+func (m *MockServiceRouterSet) Difference(set v1alpha1sets.ServiceRouterSet) v1alpha1sets.ServiceRouterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Difference", set)
+	ret0, _ := ret[0].(v1alpha1sets.ServiceRouterSet)
+	return ret0
+}
+
+// Difference indicates an expected call of Difference.
+func (mr *MockServiceRouterSetMockRecorder) Difference(set interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Difference", reflect.TypeOf((*MockServiceRouterSet)(nil).Difference), set)
+}
+
+// Equal mocks base method.
+func (m *MockServiceRouterSet) Equal(serviceRouterSet v1alpha1sets.ServiceRouterSet) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Equal", serviceRouterSet)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Equal indicates an expected call of Equal.
+func (mr *MockServiceRouterSetMockRecorder) Equal(serviceRouterSet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Equal", reflect.TypeOf((*MockServiceRouterSet)(nil).Equal), serviceRouterSet)
+}
+
+// Find mocks base method.
+func (m *MockServiceRouterSet) Find(id ezkube.ResourceId) (*v1alpha1.ServiceRouter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", id)
+	ret0, _ := ret[0].(*v1alpha1.ServiceRouter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockServiceRouterSetMockRecorder) Find(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockServiceRouterSet)(nil).Find), id)
+}
+
+// Generic mocks base method.
+func (m *MockServiceRouterSet) Generic() sets.ResourceSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generic")
+	ret0, _ := ret[0].(sets.ResourceSet)
+	return ret0
+}
+
+// Generic indicates an expected call of Generic.
+func (mr *MockServiceRouterSetMockRecorder) Generic() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generic", reflect.TypeOf((*MockServiceRouterSet)(nil).Generic))
+}
+
+// Has mocks base method.
+func (m *MockServiceRouterSet) Has(serviceRouter ezkube.ResourceId) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Has", serviceRouter)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Has indicates an expected call of Has.
+func (mr *MockServiceRouterSetMockRecorder) Has(serviceRouter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Has", reflect.TypeOf((*MockServiceRouterSet)(nil).Has), serviceRouter)
+}
+
+// Insert mocks base method.
+func (m *MockServiceRouterSet) Insert(serviceRouter ...*v1alpha1.ServiceRouter) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range serviceRouter {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Insert", varargs...)
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockServiceRouterSetMockRecorder) Insert(serviceRouter ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockServiceRouterSet)(nil).Insert), serviceRouter...)
+}
+
+// Intersection mocks base method.
+func (m *MockServiceRouterSet) Intersection(set v1alpha1sets.ServiceRouterSet) v1alpha1sets.ServiceRouterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Intersection", set)
+	ret0, _ := ret[0].(v1alpha1sets.ServiceRouterSet)
+	return ret0
+}
+
+// Intersection indicates an expected call of Intersection.
+func (mr *MockServiceRouterSetMockRecorder) Intersection(set interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Intersection", reflect.TypeOf((*MockServiceRouterSet)(nil).Intersection), set)
+}
+
+// Keys mocks base method.
+func (m *MockServiceRouterSet) Keys() sets0.String {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Keys")
+	ret0, _ := ret[0].(sets0.String)
+	return ret0
+}
+
+// Keys indicates an expected call of Keys.
+func (mr *MockServiceRouterSetMockRecorder) Keys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockServiceRouterSet)(nil).Keys))
+}
+
+// Length mocks base method.
+func (m *MockServiceRouterSet) Length() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Length")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Length indicates an expected call of Length.
+func (mr *MockServiceRouterSetMockRecorder) Length() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Length", reflect.TypeOf((*MockServiceRouterSet)(nil).Length))
+}
+
+// List mocks base method.
+func (m *MockServiceRouterSet) List(filterResource ...func(*v1alpha1.ServiceRouter) bool) []*v1alpha1.ServiceRouter {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filterResource {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].([]*v1alpha1.ServiceRouter)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockServiceRouterSetMockRecorder) List(filterResource ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockServiceRouterSet)(nil).List), filterResource...)
+}
+
+// Map mocks base method.
+func (m *MockServiceRouterSet) Map() map[string]*v1alpha1.ServiceRouter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Map")
+	ret0, _ := ret[0].(map[string]*v1alpha1.ServiceRouter)
+	return ret0
+}
+
+// Map indicates an expected call of Map.
+func (mr *MockServiceRouterSetMockRecorder) Map() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Map", reflect.TypeOf((*MockServiceRouterSet)(nil).Map))
+}
+
+// Union mocks base method.
+func (m *MockServiceRouterSet) Union(set v1alpha1sets.ServiceRouterSet) v1alpha1sets.ServiceRouterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Union", set)
+	ret0, _ := ret[0].(v1alpha1sets.ServiceRouterSet)
+	return ret0
+}
+
+// Union indicates an expected call of Union.
+func (mr *MockServiceRouterSetMockRecorder) Union(set interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Union", reflect.TypeOf((*MockServiceRouterSet)(nil).Union), set)
+}
+
+// UnsortedList mocks base method.
+func (m *MockServiceRouterSet) UnsortedList(filterResource ...func(*v1alpha1.ServiceRouter) bool) []*v1alpha1.ServiceRouter {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filterResource {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnsortedList", varargs...)
+	ret0, _ := ret[0].([]*v1alpha1.ServiceRouter)
+	return ret0
+}
+
+// UnsortedList indicates an expected call of UnsortedList.
+func (mr *MockServiceRouterSetMockRecorder) UnsortedList(filterResource ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsortedList", reflect.TypeOf((*MockServiceRouterSet)(nil).UnsortedList), filterResource...)
+}
+
+// MockServiceIntentionsSet is a mock of ServiceIntentionsSet interface.
+type MockServiceIntentionsSet struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceIntentionsSetMockRecorder
+}
+
+// MockServiceIntentionsSetMockRecorder is the mock recorder for MockServiceIntentionsSet.
+type MockServiceIntentionsSetMockRecorder struct {
+	mock *MockServiceIntentionsSet
+}
+
+// NewMockServiceIntentionsSet creates a new mock instance.
+func NewMockServiceIntentionsSet(ctrl *gomock.Controller) *MockServiceIntentionsSet {
+	mock := &MockServiceIntentionsSet{ctrl: ctrl}
+	mock.recorder = &MockServiceIntentionsSetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockServiceIntentionsSet) EXPECT() *MockServiceIntentionsSetMockRecorder {
+	return m.recorder
+}
+
+// Clone mocks base method.
+func (m *MockServiceIntentionsSet) Clone() v1alpha1sets.ServiceIntentionsSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone")
+	ret0, _ := ret[0].(v1alpha1sets.ServiceIntentionsSet)
+	return ret0
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockServiceIntentionsSetMockRecorder) Clone() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Clone))
+}
+
+// Delete mocks base method.
+func (m *MockServiceIntentionsSet) Delete(serviceIntentions ezkube.ResourceId) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", serviceIntentions)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceIntentionsSetMockRecorder) Delete(serviceIntentions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Delete), serviceIntentions)
+}
+
+// Delta mocks base method.
+func (m *MockServiceIntentionsSet) Delta(newSet v1alpha1sets.ServiceIntentionsSet) sets.ResourceDelta {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delta", newSet)
+	ret0, _ := ret[0].(sets.ResourceDelta)
+	return ret0
+}
+
+// Delta indicates an expected call of Delta.
+func (mr *MockServiceIntentionsSetMockRecorder) Delta(newSet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delta", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Delta), newSet)
+}
+
+// Difference mocks base method.
+func (m *MockServiceIntentionsSet) Difference(set v1alpha1sets.ServiceIntentionsSet) v1alpha1sets.ServiceIntentionsSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Difference", set)
+	ret0, _ := ret[0].(v1alpha1sets.ServiceIntentionsSet)
+	return ret0
+}
+
+// Difference indicates an expected call of Difference.
+func (mr *MockServiceIntentionsSetMockRecorder) Difference(set interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Difference", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Difference), set)
+}
+
+// Equal mocks base method.
+func (m *MockServiceIntentionsSet) Equal(serviceIntentionsSet v1alpha1sets.ServiceIntentionsSet) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Equal", serviceIntentionsSet)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Equal indicates an expected call of Equal.
+func (mr *MockServiceIntentionsSetMockRecorder) Equal(serviceIntentionsSet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Equal", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Equal), serviceIntentionsSet)
+}
+
+// Find mocks base method.
+func (m *MockServiceIntentionsSet) Find(id ezkube.ResourceId) (*v1alpha1.ServiceIntentions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", id)
+	ret0, _ := ret[0].(*v1alpha1.ServiceIntentions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockServiceIntentionsSetMockRecorder) Find(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Find), id)
+}
+
+// Generic mocks base method.
+func (m *MockServiceIntentionsSet) Generic() sets.ResourceSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generic")
+	ret0, _ := ret[0].(sets.ResourceSet)
+	return ret0
+}
+
+// Generic indicates an expected call of Generic.
+func (mr *MockServiceIntentionsSetMockRecorder) Generic() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generic", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Generic))
+}
+
+// Has mocks base method.
+func (m *MockServiceIntentionsSet) Has(serviceIntentions ezkube.ResourceId) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Has", serviceIntentions)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Has indicates an expected call of Has.
+func (mr *MockServiceIntentionsSetMockRecorder) Has(serviceIntentions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Has", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Has), serviceIntentions)
+}
+
+// Insert mocks base method.
+func (m *MockServiceIntentionsSet) Insert(serviceIntentions ...*v1alpha1.ServiceIntentions) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range serviceIntentions {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Insert", varargs...)
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockServiceIntentionsSetMockRecorder) Insert(serviceIntentions ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Insert), serviceIntentions...)
+}
+
+// Intersection mocks base method.
+func (m *MockServiceIntentionsSet) Intersection(set v1alpha1sets.ServiceIntentionsSet) v1alpha1sets.ServiceIntentionsSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Intersection", set)
+	ret0, _ := ret[0].(v1alpha1sets.ServiceIntentionsSet)
+	return ret0
+}
+
+// Intersection indicates an expected call of Intersection.
+func (mr *MockServiceIntentionsSetMockRecorder) Intersection(set interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Intersection", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Intersection), set)
+}
+
+// Keys mocks base method.
+func (m *MockServiceIntentionsSet) Keys() sets0.String {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Keys")
+	ret0, _ := ret[0].(sets0.String)
+	return ret0
+}
+
+// Keys indicates an expected call of Keys.
+func (mr *MockServiceIntentionsSetMockRecorder) Keys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Keys))
+}
+
+// Length mocks base method.
+func (m *MockServiceIntentionsSet) Length() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Length")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Length indicates an expected call of Length.
+func (mr *MockServiceIntentionsSetMockRecorder) Length() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Length", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Length))
+}
+
+// List mocks base method.
+func (m *MockServiceIntentionsSet) List(filterResource ...func(*v1alpha1.ServiceIntentions) bool) []*v1alpha1.ServiceIntentions {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filterResource {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].([]*v1alpha1.ServiceIntentions)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockServiceIntentionsSetMockRecorder) List(filterResource ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockServiceIntentionsSet)(nil).List), filterResource...)
+}
+
+// Map mocks base method.
+func (m *MockServiceIntentionsSet) Map() map[string]*v1alpha1.ServiceIntentions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Map")
+	ret0, _ := ret[0].(map[string]*v1alpha1.ServiceIntentions)
+	return ret0
+}
+
+// Map indicates an expected call of Map.
+func (mr *MockServiceIntentionsSetMockRecorder) Map() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Map", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Map))
+}
+
+// Union mocks base method.
+func (m *MockServiceIntentionsSet) Union(set v1alpha1sets.ServiceIntentionsSet) v1alpha1sets.ServiceIntentionsSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Union", set)
+	ret0, _ := ret[0].(v1alpha1sets.ServiceIntentionsSet)
+	return ret0
+}
+
+// Union indicates an expected call of Union.
+func (mr *MockServiceIntentionsSetMockRecorder) Union(set interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Union", reflect.TypeOf((*MockServiceIntentionsSet)(nil).Union), set)
+}
+
+// UnsortedList mocks base method.
+func (m *MockServiceIntentionsSet) UnsortedList(filterResource ...func(*v1alpha1.ServiceIntentions) bool) []*v1alpha1.ServiceIntentions {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filterResource {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnsortedList", varargs...)
+	ret0, _ := ret[0].([]*v1alpha1.ServiceIntentions)
+	return ret0
+}
+
+// UnsortedList indicates an expected call of UnsortedList.
+func (mr *MockServiceIntentionsSetMockRecorder) UnsortedList(filterResource ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsortedList", reflect.TypeOf((*MockServiceIntentionsSet)(nil).UnsortedList), filterResource...)
+}
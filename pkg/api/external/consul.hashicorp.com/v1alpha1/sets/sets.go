@@ -0,0 +1,671 @@
+// Code generated by skv2. DO NOT EDIT.
+
+//go:generate mockgen -source ./sets.go -destination mocks/sets.go
+
+package v1alpha1sets
+
+import (
+	consul_hashicorp_com_v1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
+
+	"github.com/rotisserie/eris"
+	sksets "github.com/solo-io/skv2/contrib/pkg/sets"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+type ServiceSplitterSet interface {
+	// Get the set stored keys
+	Keys() sets.String
+	// List of resources stored in the set. Pass an optional filter function to filter on the list.
+	List(filterResource ...func(*consul_hashicorp_com_v1alpha1.ServiceSplitter) bool) []*consul_hashicorp_com_v1alpha1.ServiceSplitter
+	// Unsorted list of resources stored in the set. Pass an optional filter function to filter on the list.
+	UnsortedList(filterResource ...func(*consul_hashicorp_com_v1alpha1.ServiceSplitter) bool) []*consul_hashicorp_com_v1alpha1.ServiceSplitter
+	// Return the Set as a map of key to resource.
+	Map() map[string]*consul_hashicorp_com_v1alpha1.ServiceSplitter
+	// Insert a resource into the set.
+	Insert(serviceSplitter ...*consul_hashicorp_com_v1alpha1.ServiceSplitter)
+	// Compare the equality of the keys in two sets (not the resources themselves)
+	Equal(serviceSplitterSet ServiceSplitterSet) bool
+	// Check if the set contains a key matching the resource (not the resource itself)
+	Has(serviceSplitter ezkube.ResourceId) bool
+	// Delete the key matching the resource
+	Delete(serviceSplitter ezkube.ResourceId)
+	// Return the union with the provided set
+	Union(set ServiceSplitterSet) ServiceSplitterSet
+	// Return the difference with the provided set
+	Difference(set ServiceSplitterSet) ServiceSplitterSet
+	// Return the intersection with the provided set
+	Intersection(set ServiceSplitterSet) ServiceSplitterSet
+	// Find the resource with the given ID
+	Find(id ezkube.ResourceId) (*consul_hashicorp_com_v1alpha1.ServiceSplitter, error)
+	// Get the length of the set
+	Length() int
+	// returns the generic implementation of the set
+	Generic() sksets.ResourceSet
+	// returns the delta between this and and another ServiceSplitterSet
+	Delta(newSet ServiceSplitterSet) sksets.ResourceDelta
+	// Create a deep copy of the current ServiceSplitterSet
+	Clone() ServiceSplitterSet
+}
+
+func makeGenericServiceSplitterSet(serviceSplitterList []*consul_hashicorp_com_v1alpha1.ServiceSplitter) sksets.ResourceSet {
+	var genericResources []ezkube.ResourceId
+	for _, obj := range serviceSplitterList {
+		genericResources = append(genericResources, obj)
+	}
+	return sksets.NewResourceSet(genericResources...)
+}
+
+type serviceSplitterSet struct {
+	set sksets.ResourceSet
+}
+
+func NewServiceSplitterSet(serviceSplitterList ...*consul_hashicorp_com_v1alpha1.ServiceSplitter) ServiceSplitterSet {
+	return &serviceSplitterSet{set: makeGenericServiceSplitterSet(serviceSplitterList)}
+}
+
+func NewServiceSplitterSetFromList(serviceSplitterList *consul_hashicorp_com_v1alpha1.ServiceSplitterList) ServiceSplitterSet {
+	list := make([]*consul_hashicorp_com_v1alpha1.ServiceSplitter, 0, len(serviceSplitterList.Items))
+	for idx := range serviceSplitterList.Items {
+		list = append(list, &serviceSplitterList.Items[idx])
+	}
+	return &serviceSplitterSet{set: makeGenericServiceSplitterSet(list)}
+}
+
+func (s *serviceSplitterSet) Keys() sets.String {
+	if s == nil {
+		return sets.String{}
+	}
+	return s.Generic().Keys()
+}
+
+func (s *serviceSplitterSet) List(filterResource ...func(*consul_hashicorp_com_v1alpha1.ServiceSplitter) bool) []*consul_hashicorp_com_v1alpha1.ServiceSplitter {
+	if s == nil {
+		return nil
+	}
+	var genericFilters []func(ezkube.ResourceId) bool
+	for _, filter := range filterResource {
+		genericFilters = append(genericFilters, func(obj ezkube.ResourceId) bool {
+			return filter(obj.(*consul_hashicorp_com_v1alpha1.ServiceSplitter))
+		})
+	}
+
+	objs := s.Generic().List(genericFilters...)
+	serviceSplitterList := make([]*consul_hashicorp_com_v1alpha1.ServiceSplitter, 0, len(objs))
+	for _, obj := range objs {
+		serviceSplitterList = append(serviceSplitterList, obj.(*consul_hashicorp_com_v1alpha1.ServiceSplitter))
+	}
+	return serviceSplitterList
+}
+
+func (s *serviceSplitterSet) UnsortedList(filterResource ...func(*consul_hashicorp_com_v1alpha1.ServiceSplitter) bool) []*consul_hashicorp_com_v1alpha1.ServiceSplitter {
+	if s == nil {
+		return nil
+	}
+	var genericFilters []func(ezkube.ResourceId) bool
+	for _, filter := range filterResource {
+		genericFilters = append(genericFilters, func(obj ezkube.ResourceId) bool {
+			return filter(obj.(*consul_hashicorp_com_v1alpha1.ServiceSplitter))
+		})
+	}
+
+	var serviceSplitterList []*consul_hashicorp_com_v1alpha1.ServiceSplitter
+	for _, obj := range s.Generic().UnsortedList(genericFilters...) {
+		serviceSplitterList = append(serviceSplitterList, obj.(*consul_hashicorp_com_v1alpha1.ServiceSplitter))
+	}
+	return serviceSplitterList
+}
+
+func (s *serviceSplitterSet) Map() map[string]*consul_hashicorp_com_v1alpha1.ServiceSplitter {
+	if s == nil {
+		return nil
+	}
+
+	newMap := map[string]*consul_hashicorp_com_v1alpha1.ServiceSplitter{}
+	for k, v := range s.Generic().Map() {
+		newMap[k] = v.(*consul_hashicorp_com_v1alpha1.ServiceSplitter)
+	}
+	return newMap
+}
+
+func (s *serviceSplitterSet) Insert(
+	serviceSplitterList ...*consul_hashicorp_com_v1alpha1.ServiceSplitter,
+) {
+	if s == nil {
+		panic("cannot insert into nil set")
+	}
+
+	for _, obj := range serviceSplitterList {
+		s.Generic().Insert(obj)
+	}
+}
+
+func (s *serviceSplitterSet) Has(serviceSplitter ezkube.ResourceId) bool {
+	if s == nil {
+		return false
+	}
+	return s.Generic().Has(serviceSplitter)
+}
+
+func (s *serviceSplitterSet) Equal(
+	serviceSplitterSet ServiceSplitterSet,
+) bool {
+	if s == nil {
+		return serviceSplitterSet == nil
+	}
+	return s.Generic().Equal(serviceSplitterSet.Generic())
+}
+
+func (s *serviceSplitterSet) Delete(ServiceSplitter ezkube.ResourceId) {
+	if s == nil {
+		return
+	}
+	s.Generic().Delete(ServiceSplitter)
+}
+
+func (s *serviceSplitterSet) Union(set ServiceSplitterSet) ServiceSplitterSet {
+	if s == nil {
+		return set
+	}
+	return NewServiceSplitterSet(append(s.List(), set.List()...)...)
+}
+
+func (s *serviceSplitterSet) Difference(set ServiceSplitterSet) ServiceSplitterSet {
+	if s == nil {
+		return set
+	}
+	newSet := s.Generic().Difference(set.Generic())
+	return &serviceSplitterSet{set: newSet}
+}
+
+func (s *serviceSplitterSet) Intersection(set ServiceSplitterSet) ServiceSplitterSet {
+	if s == nil {
+		return nil
+	}
+	newSet := s.Generic().Intersection(set.Generic())
+	var serviceSplitterList []*consul_hashicorp_com_v1alpha1.ServiceSplitter
+	for _, obj := range newSet.List() {
+		serviceSplitterList = append(serviceSplitterList, obj.(*consul_hashicorp_com_v1alpha1.ServiceSplitter))
+	}
+	return NewServiceSplitterSet(serviceSplitterList...)
+}
+
+func (s *serviceSplitterSet) Find(id ezkube.ResourceId) (*consul_hashicorp_com_v1alpha1.ServiceSplitter, error) {
+	if s == nil {
+		return nil, eris.Errorf("empty set, cannot find ServiceSplitter %v", sksets.Key(id))
+	}
+	obj, err := s.Generic().Find(&consul_hashicorp_com_v1alpha1.ServiceSplitter{}, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.(*consul_hashicorp_com_v1alpha1.ServiceSplitter), nil
+}
+
+func (s *serviceSplitterSet) Length() int {
+	if s == nil {
+		return 0
+	}
+	return s.Generic().Length()
+}
+
+func (s *serviceSplitterSet) Generic() sksets.ResourceSet {
+	if s == nil {
+		return nil
+	}
+	return s.set
+}
+
+func (s *serviceSplitterSet) Delta(newSet ServiceSplitterSet) sksets.ResourceDelta {
+	if s == nil {
+		return sksets.ResourceDelta{
+			Inserted: newSet.Generic(),
+		}
+	}
+	return s.Generic().Delta(newSet.Generic())
+}
+
+func (s *serviceSplitterSet) Clone() ServiceSplitterSet {
+	if s == nil {
+		return nil
+	}
+	return &serviceSplitterSet{set: sksets.NewResourceSet(s.Generic().Clone().List()...)}
+}
+
+type ServiceRouterSet interface {
+	// Get the set stored keys
+	Keys() sets.String
+	// List of resources stored in the set. Pass an optional filter function to filter on the list.
+	List(filterResource ...func(*consul_hashicorp_com_v1alpha1.ServiceRouter) bool) []*consul_hashicorp_com_v1alpha1.ServiceRouter
+	// Unsorted list of resources stored in the set. Pass an optional filter function to filter on the list.
+	UnsortedList(filterResource ...func(*consul_hashicorp_com_v1alpha1.ServiceRouter) bool) []*consul_hashicorp_com_v1alpha1.ServiceRouter
+	// Return the Set as a map of key to resource.
+	Map() map[string]*consul_hashicorp_com_v1alpha1.ServiceRouter
+	// Insert a resource into the set.
+	Insert(serviceRouter ...*consul_hashicorp_com_v1alpha1.ServiceRouter)
+	// Compare the equality of the keys in two sets (not the resources themselves)
+	Equal(serviceRouterSet ServiceRouterSet) bool
+	// Check if the set contains a key matching the resource (not the resource itself)
+	Has(serviceRouter ezkube.ResourceId) bool
+	// Delete the key matching the resource
+	Delete(serviceRouter ezkube.ResourceId)
+	// Return the union with the provided set
+	Union(set ServiceRouterSet) ServiceRouterSet
+	// Return the difference with the provided set
+	Difference(set ServiceRouterSet) ServiceRouterSet
+	// Return the intersection with the provided set
+	Intersection(set ServiceRouterSet) ServiceRouterSet
+	// Find the resource with the given ID
+	Find(id ezkube.ResourceId) (*consul_hashicorp_com_v1alpha1.ServiceRouter, error)
+	// Get the length of the set
+	Length() int
+	// returns the generic implementation of the set
+	Generic() sksets.ResourceSet
+	// returns the delta between this and and another ServiceRouterSet
+	Delta(newSet ServiceRouterSet) sksets.ResourceDelta
+	// Create a deep copy of the current ServiceRouterSet
+	Clone() ServiceRouterSet
+}
+
+func makeGenericServiceRouterSet(serviceRouterList []*consul_hashicorp_com_v1alpha1.ServiceRouter) sksets.ResourceSet {
+	var genericResources []ezkube.ResourceId
+	for _, obj := range serviceRouterList {
+		genericResources = append(genericResources, obj)
+	}
+	return sksets.NewResourceSet(genericResources...)
+}
+
+type serviceRouterSet struct {
+	set sksets.ResourceSet
+}
+
+func NewServiceRouterSet(serviceRouterList ...*consul_hashicorp_com_v1alpha1.ServiceRouter) ServiceRouterSet {
+	return &serviceRouterSet{set: makeGenericServiceRouterSet(serviceRouterList)}
+}
+
+func NewServiceRouterSetFromList(serviceRouterList *consul_hashicorp_com_v1alpha1.ServiceRouterList) ServiceRouterSet {
+	list := make([]*consul_hashicorp_com_v1alpha1.ServiceRouter, 0, len(serviceRouterList.Items))
+	for idx := range serviceRouterList.Items {
+		list = append(list, &serviceRouterList.Items[idx])
+	}
+	return &serviceRouterSet{set: makeGenericServiceRouterSet(list)}
+}
+
+func (s *serviceRouterSet) Keys() sets.String {
+	if s == nil {
+		return sets.String{}
+	}
+	return s.Generic().Keys()
+}
+
+func (s *serviceRouterSet) List(filterResource ...func(*consul_hashicorp_com_v1alpha1.ServiceRouter) bool) []*consul_hashicorp_com_v1alpha1.ServiceRouter {
+	if s == nil {
+		return nil
+	}
+	var genericFilters []func(ezkube.ResourceId) bool
+	for _, filter := range filterResource {
+		genericFilters = append(genericFilters, func(obj ezkube.ResourceId) bool {
+			return filter(obj.(*consul_hashicorp_com_v1alpha1.ServiceRouter))
+		})
+	}
+
+	objs := s.Generic().List(genericFilters...)
+	serviceRouterList := make([]*consul_hashicorp_com_v1alpha1.ServiceRouter, 0, len(objs))
+	for _, obj := range objs {
+		serviceRouterList = append(serviceRouterList, obj.(*consul_hashicorp_com_v1alpha1.ServiceRouter))
+	}
+	return serviceRouterList
+}
+
+func (s *serviceRouterSet) UnsortedList(filterResource ...func(*consul_hashicorp_com_v1alpha1.ServiceRouter) bool) []*consul_hashicorp_com_v1alpha1.ServiceRouter {
+	if s == nil {
+		return nil
+	}
+	var genericFilters []func(ezkube.ResourceId) bool
+	for _, filter := range filterResource {
+		genericFilters = append(genericFilters, func(obj ezkube.ResourceId) bool {
+			return filter(obj.(*consul_hashicorp_com_v1alpha1.ServiceRouter))
+		})
+	}
+
+	var serviceRouterList []*consul_hashicorp_com_v1alpha1.ServiceRouter
+	for _, obj := range s.Generic().UnsortedList(genericFilters...) {
+		serviceRouterList = append(serviceRouterList, obj.(*consul_hashicorp_com_v1alpha1.ServiceRouter))
+	}
+	return serviceRouterList
+}
+
+func (s *serviceRouterSet) Map() map[string]*consul_hashicorp_com_v1alpha1.ServiceRouter {
+	if s == nil {
+		return nil
+	}
+
+	newMap := map[string]*consul_hashicorp_com_v1alpha1.ServiceRouter{}
+	for k, v := range s.Generic().Map() {
+		newMap[k] = v.(*consul_hashicorp_com_v1alpha1.ServiceRouter)
+	}
+	return newMap
+}
+
+func (s *serviceRouterSet) Insert(
+	serviceRouterList ...*consul_hashicorp_com_v1alpha1.ServiceRouter,
+) {
+	if s == nil {
+		panic("cannot insert into nil set")
+	}
+
+	for _, obj := range serviceRouterList {
+		s.Generic().Insert(obj)
+	}
+}
+
+func (s *serviceRouterSet) Has(serviceRouter ezkube.ResourceId) bool {
+	if s == nil {
+		return false
+	}
+	return s.Generic().Has(serviceRouter)
+}
+
+func (s *serviceRouterSet) Equal(
+	serviceRouterSet ServiceRouterSet,
+) bool {
+	if s == nil {
+		return serviceRouterSet == nil
+	}
+	return s.Generic().Equal(serviceRouterSet.Generic())
+}
+
+func (s *serviceRouterSet) Delete(ServiceRouter ezkube.ResourceId) {
+	if s == nil {
+		return
+	}
+	s.Generic().Delete(ServiceRouter)
+}
+
+func (s *serviceRouterSet) Union(set ServiceRouterSet) ServiceRouterSet {
+	if s == nil {
+		return set
+	}
+	return NewServiceRouterSet(append(s.List(), set.List()...)...)
+}
+
+func (s *serviceRouterSet) Difference(set ServiceRouterSet) ServiceRouterSet {
+	if s == nil {
+		return set
+	}
+	newSet := s.Generic().Difference(set.Generic())
+	return &serviceRouterSet{set: newSet}
+}
+
+func (s *serviceRouterSet) Intersection(set ServiceRouterSet) ServiceRouterSet {
+	if s == nil {
+		return nil
+	}
+	newSet := s.Generic().Intersection(set.Generic())
+	var serviceRouterList []*consul_hashicorp_com_v1alpha1.ServiceRouter
+	for _, obj := range newSet.List() {
+		serviceRouterList = append(serviceRouterList, obj.(*consul_hashicorp_com_v1alpha1.ServiceRouter))
+	}
+	return NewServiceRouterSet(serviceRouterList...)
+}
+
+func (s *serviceRouterSet) Find(id ezkube.ResourceId) (*consul_hashicorp_com_v1alpha1.ServiceRouter, error) {
+	if s == nil {
+		return nil, eris.Errorf("empty set, cannot find ServiceRouter %v", sksets.Key(id))
+	}
+	obj, err := s.Generic().Find(&consul_hashicorp_com_v1alpha1.ServiceRouter{}, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.(*consul_hashicorp_com_v1alpha1.ServiceRouter), nil
+}
+
+func (s *serviceRouterSet) Length() int {
+	if s == nil {
+		return 0
+	}
+	return s.Generic().Length()
+}
+
+func (s *serviceRouterSet) Generic() sksets.ResourceSet {
+	if s == nil {
+		return nil
+	}
+	return s.set
+}
+
+func (s *serviceRouterSet) Delta(newSet ServiceRouterSet) sksets.ResourceDelta {
+	if s == nil {
+		return sksets.ResourceDelta{
+			Inserted: newSet.Generic(),
+		}
+	}
+	return s.Generic().Delta(newSet.Generic())
+}
+
+func (s *serviceRouterSet) Clone() ServiceRouterSet {
+	if s == nil {
+		return nil
+	}
+	return &serviceRouterSet{set: sksets.NewResourceSet(s.Generic().Clone().List()...)}
+}
+
+type ServiceIntentionsSet interface {
+	// Get the set stored keys
+	Keys() sets.String
+	// List of resources stored in the set. Pass an optional filter function to filter on the list.
+	List(filterResource ...func(*consul_hashicorp_com_v1alpha1.ServiceIntentions) bool) []*consul_hashicorp_com_v1alpha1.ServiceIntentions
+	// Unsorted list of resources stored in the set. Pass an optional filter function to filter on the list.
+	UnsortedList(filterResource ...func(*consul_hashicorp_com_v1alpha1.ServiceIntentions) bool) []*consul_hashicorp_com_v1alpha1.ServiceIntentions
+	// Return the Set as a map of key to resource.
+	Map() map[string]*consul_hashicorp_com_v1alpha1.ServiceIntentions
+	// Insert a resource into the set.
+	Insert(serviceIntentions ...*consul_hashicorp_com_v1alpha1.ServiceIntentions)
+	// Compare the equality of the keys in two sets (not the resources themselves)
+	Equal(serviceIntentionsSet ServiceIntentionsSet) bool
+	// Check if the set contains a key matching the resource (not the resource itself)
+	Has(serviceIntentions ezkube.ResourceId) bool
+	// Delete the key matching the resource
+	Delete(serviceIntentions ezkube.ResourceId)
+	// Return the union with the provided set
+	Union(set ServiceIntentionsSet) ServiceIntentionsSet
+	// Return the difference with the provided set
+	Difference(set ServiceIntentionsSet) ServiceIntentionsSet
+	// Return the intersection with the provided set
+	Intersection(set ServiceIntentionsSet) ServiceIntentionsSet
+	// Find the resource with the given ID
+	Find(id ezkube.ResourceId) (*consul_hashicorp_com_v1alpha1.ServiceIntentions, error)
+	// Get the length of the set
+	Length() int
+	// returns the generic implementation of the set
+	Generic() sksets.ResourceSet
+	// returns the delta between this and and another ServiceIntentionsSet
+	Delta(newSet ServiceIntentionsSet) sksets.ResourceDelta
+	// Create a deep copy of the current ServiceIntentionsSet
+	Clone() ServiceIntentionsSet
+}
+
+func makeGenericServiceIntentionsSet(serviceIntentionsList []*consul_hashicorp_com_v1alpha1.ServiceIntentions) sksets.ResourceSet {
+	var genericResources []ezkube.ResourceId
+	for _, obj := range serviceIntentionsList {
+		genericResources = append(genericResources, obj)
+	}
+	return sksets.NewResourceSet(genericResources...)
+}
+
+type serviceIntentionsSet struct {
+	set sksets.ResourceSet
+}
+
+func NewServiceIntentionsSet(serviceIntentionsList ...*consul_hashicorp_com_v1alpha1.ServiceIntentions) ServiceIntentionsSet {
+	return &serviceIntentionsSet{set: makeGenericServiceIntentionsSet(serviceIntentionsList)}
+}
+
+func NewServiceIntentionsSetFromList(serviceIntentionsList *consul_hashicorp_com_v1alpha1.ServiceIntentionsList) ServiceIntentionsSet {
+	list := make([]*consul_hashicorp_com_v1alpha1.ServiceIntentions, 0, len(serviceIntentionsList.Items))
+	for idx := range serviceIntentionsList.Items {
+		list = append(list, &serviceIntentionsList.Items[idx])
+	}
+	return &serviceIntentionsSet{set: makeGenericServiceIntentionsSet(list)}
+}
+
+func (s *serviceIntentionsSet) Keys() sets.String {
+	if s == nil {
+		return sets.String{}
+	}
+	return s.Generic().Keys()
+}
+
+func (s *serviceIntentionsSet) List(filterResource ...func(*consul_hashicorp_com_v1alpha1.ServiceIntentions) bool) []*consul_hashicorp_com_v1alpha1.ServiceIntentions {
+	if s == nil {
+		return nil
+	}
+	var genericFilters []func(ezkube.ResourceId) bool
+	for _, filter := range filterResource {
+		genericFilters = append(genericFilters, func(obj ezkube.ResourceId) bool {
+			return filter(obj.(*consul_hashicorp_com_v1alpha1.ServiceIntentions))
+		})
+	}
+
+	objs := s.Generic().List(genericFilters...)
+	serviceIntentionsList := make([]*consul_hashicorp_com_v1alpha1.ServiceIntentions, 0, len(objs))
+	for _, obj := range objs {
+		serviceIntentionsList = append(serviceIntentionsList, obj.(*consul_hashicorp_com_v1alpha1.ServiceIntentions))
+	}
+	return serviceIntentionsList
+}
+
+func (s *serviceIntentionsSet) UnsortedList(filterResource ...func(*consul_hashicorp_com_v1alpha1.ServiceIntentions) bool) []*consul_hashicorp_com_v1alpha1.ServiceIntentions {
+	if s == nil {
+		return nil
+	}
+	var genericFilters []func(ezkube.ResourceId) bool
+	for _, filter := range filterResource {
+		genericFilters = append(genericFilters, func(obj ezkube.ResourceId) bool {
+			return filter(obj.(*consul_hashicorp_com_v1alpha1.ServiceIntentions))
+		})
+	}
+
+	var serviceIntentionsList []*consul_hashicorp_com_v1alpha1.ServiceIntentions
+	for _, obj := range s.Generic().UnsortedList(genericFilters...) {
+		serviceIntentionsList = append(serviceIntentionsList, obj.(*consul_hashicorp_com_v1alpha1.ServiceIntentions))
+	}
+	return serviceIntentionsList
+}
+
+func (s *serviceIntentionsSet) Map() map[string]*consul_hashicorp_com_v1alpha1.ServiceIntentions {
+	if s == nil {
+		return nil
+	}
+
+	newMap := map[string]*consul_hashicorp_com_v1alpha1.ServiceIntentions{}
+	for k, v := range s.Generic().Map() {
+		newMap[k] = v.(*consul_hashicorp_com_v1alpha1.ServiceIntentions)
+	}
+	return newMap
+}
+
+func (s *serviceIntentionsSet) Insert(
+	serviceIntentionsList ...*consul_hashicorp_com_v1alpha1.ServiceIntentions,
+) {
+	if s == nil {
+		panic("cannot insert into nil set")
+	}
+
+	for _, obj := range serviceIntentionsList {
+		s.Generic().Insert(obj)
+	}
+}
+
+func (s *serviceIntentionsSet) Has(serviceIntentions ezkube.ResourceId) bool {
+	if s == nil {
+		return false
+	}
+	return s.Generic().Has(serviceIntentions)
+}
+
+func (s *serviceIntentionsSet) Equal(
+	serviceIntentionsSet ServiceIntentionsSet,
+) bool {
+	if s == nil {
+		return serviceIntentionsSet == nil
+	}
+	return s.Generic().Equal(serviceIntentionsSet.Generic())
+}
+
+func (s *serviceIntentionsSet) Delete(ServiceIntentions ezkube.ResourceId) {
+	if s == nil {
+		return
+	}
+	s.Generic().Delete(ServiceIntentions)
+}
+
+func (s *serviceIntentionsSet) Union(set ServiceIntentionsSet) ServiceIntentionsSet {
+	if s == nil {
+		return set
+	}
+	return NewServiceIntentionsSet(append(s.List(), set.List()...)...)
+}
+
+func (s *serviceIntentionsSet) Difference(set ServiceIntentionsSet) ServiceIntentionsSet {
+	if s == nil {
+		return set
+	}
+	newSet := s.Generic().Difference(set.Generic())
+	return &serviceIntentionsSet{set: newSet}
+}
+
+func (s *serviceIntentionsSet) Intersection(set ServiceIntentionsSet) ServiceIntentionsSet {
+	if s == nil {
+		return nil
+	}
+	newSet := s.Generic().Intersection(set.Generic())
+	var serviceIntentionsList []*consul_hashicorp_com_v1alpha1.ServiceIntentions
+	for _, obj := range newSet.List() {
+		serviceIntentionsList = append(serviceIntentionsList, obj.(*consul_hashicorp_com_v1alpha1.ServiceIntentions))
+	}
+	return NewServiceIntentionsSet(serviceIntentionsList...)
+}
+
+func (s *serviceIntentionsSet) Find(id ezkube.ResourceId) (*consul_hashicorp_com_v1alpha1.ServiceIntentions, error) {
+	if s == nil {
+		return nil, eris.Errorf("empty set, cannot find ServiceIntentions %v", sksets.Key(id))
+	}
+	obj, err := s.Generic().Find(&consul_hashicorp_com_v1alpha1.ServiceIntentions{}, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.(*consul_hashicorp_com_v1alpha1.ServiceIntentions), nil
+}
+
+func (s *serviceIntentionsSet) Length() int {
+	if s == nil {
+		return 0
+	}
+	return s.Generic().Length()
+}
+
+func (s *serviceIntentionsSet) Generic() sksets.ResourceSet {
+	if s == nil {
+		return nil
+	}
+	return s.set
+}
+
+func (s *serviceIntentionsSet) Delta(newSet ServiceIntentionsSet) sksets.ResourceDelta {
+	if s == nil {
+		return sksets.ResourceDelta{
+			Inserted: newSet.Generic(),
+		}
+	}
+	return s.Generic().Delta(newSet.Generic())
+}
+
+func (s *serviceIntentionsSet) Clone() ServiceIntentionsSet {
+	if s == nil {
+		return nil
+	}
+	return &serviceIntentionsSet{set: sksets.NewResourceSet(s.Generic().Clone().List()...)}
+}
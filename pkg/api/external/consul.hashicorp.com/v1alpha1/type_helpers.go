@@ -0,0 +1,13 @@
+// Code generated by skv2. DO NOT EDIT.
+
+// Definitions for the Kubernetes types
+package v1alpha1
+
+// ServiceSplitterSlice represents a slice of *ServiceSplitter
+type ServiceSplitterSlice []*ServiceSplitter
+
+// ServiceRouterSlice represents a slice of *ServiceRouter
+type ServiceRouterSlice []*ServiceRouter
+
+// ServiceIntentionsSlice represents a slice of *ServiceIntentions
+type ServiceIntentionsSlice []*ServiceIntentions
@@ -0,0 +1,117 @@
+// Code generated by skv2. DO NOT EDIT.
+
+// Definitions for the Kubernetes types
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient:noStatus
+
+// GroupVersionKind for ServiceSplitter
+var ServiceSplitterGVK = schema.GroupVersionKind{
+	Group:   "consul.hashicorp.com",
+	Version: "v1alpha1",
+	Kind:    "ServiceSplitter",
+}
+
+// ServiceSplitter is the Schema for the serviceSplitter API
+type ServiceSplitter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceSplitterSpec `json:"spec,omitempty"`
+}
+
+// GVK returns the GroupVersionKind associated with the resource type.
+func (ServiceSplitter) GVK() schema.GroupVersionKind {
+	return ServiceSplitterGVK
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceSplitterList contains a list of ServiceSplitter
+type ServiceSplitterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceSplitter `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient:noStatus
+
+// GroupVersionKind for ServiceRouter
+var ServiceRouterGVK = schema.GroupVersionKind{
+	Group:   "consul.hashicorp.com",
+	Version: "v1alpha1",
+	Kind:    "ServiceRouter",
+}
+
+// ServiceRouter is the Schema for the serviceRouter API
+type ServiceRouter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceRouterSpec `json:"spec,omitempty"`
+}
+
+// GVK returns the GroupVersionKind associated with the resource type.
+func (ServiceRouter) GVK() schema.GroupVersionKind {
+	return ServiceRouterGVK
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceRouterList contains a list of ServiceRouter
+type ServiceRouterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceRouter `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient:noStatus
+
+// GroupVersionKind for ServiceIntentions
+var ServiceIntentionsGVK = schema.GroupVersionKind{
+	Group:   "consul.hashicorp.com",
+	Version: "v1alpha1",
+	Kind:    "ServiceIntentions",
+}
+
+// ServiceIntentions is the Schema for the serviceIntentions API
+type ServiceIntentions struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceIntentionsSpec `json:"spec,omitempty"`
+}
+
+// GVK returns the GroupVersionKind associated with the resource type.
+func (ServiceIntentions) GVK() schema.GroupVersionKind {
+	return ServiceIntentionsGVK
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceIntentionsList contains a list of ServiceIntentions
+type ServiceIntentionsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceIntentions `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceSplitter{}, &ServiceSplitterList{})
+	SchemeBuilder.Register(&ServiceRouter{}, &ServiceRouterList{})
+	SchemeBuilder.Register(&ServiceIntentions{}, &ServiceIntentionsList{})
+}
@@ -0,0 +1,183 @@
+// Code generated by skv2. DO NOT EDIT.
+
+// This file contains generated Deepcopy methods for consul.hashicorp.com/v1alpha1 resources
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// Generated Deepcopy methods for ServiceSplitter
+
+func (in *ServiceSplitter) DeepCopyInto(out *ServiceSplitter) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	// deepcopy spec
+	in.Spec.DeepCopyInto(&out.Spec)
+
+	return
+}
+
+func (in *ServiceSplitter) DeepCopy() *ServiceSplitter {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSplitter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ServiceSplitter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *ServiceSplitterList) DeepCopyInto(out *ServiceSplitterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceSplitter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+func (in *ServiceSplitterList) DeepCopy() *ServiceSplitterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSplitterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ServiceSplitterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// Generated Deepcopy methods for ServiceRouter
+
+func (in *ServiceRouter) DeepCopyInto(out *ServiceRouter) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	// deepcopy spec
+	in.Spec.DeepCopyInto(&out.Spec)
+
+	return
+}
+
+func (in *ServiceRouter) DeepCopy() *ServiceRouter {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRouter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ServiceRouter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *ServiceRouterList) DeepCopyInto(out *ServiceRouterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceRouter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+func (in *ServiceRouterList) DeepCopy() *ServiceRouterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRouterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ServiceRouterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// Generated Deepcopy methods for ServiceIntentions
+
+func (in *ServiceIntentions) DeepCopyInto(out *ServiceIntentions) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	// deepcopy spec
+	in.Spec.DeepCopyInto(&out.Spec)
+
+	return
+}
+
+func (in *ServiceIntentions) DeepCopy() *ServiceIntentions {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIntentions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ServiceIntentions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *ServiceIntentionsList) DeepCopyInto(out *ServiceIntentionsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceIntentions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+func (in *ServiceIntentionsList) DeepCopy() *ServiceIntentionsList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIntentionsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *ServiceIntentionsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -0,0 +1,363 @@
+// Code generated by skv2. DO NOT EDIT.
+
+//go:generate mockgen -source ./clients.go -destination mocks/clients.go
+
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/solo-io/skv2/pkg/controllerutils"
+	"github.com/solo-io/skv2/pkg/multicluster"
+	security_istio_io_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MulticlusterClientset for the security.istio.io/v1beta1 APIs
+type MulticlusterClientset interface {
+	// Cluster returns a Clientset for the given cluster
+	Cluster(cluster string) (Clientset, error)
+}
+
+type multiclusterClientset struct {
+	client multicluster.Client
+}
+
+func NewMulticlusterClientset(client multicluster.Client) MulticlusterClientset {
+	return &multiclusterClientset{client: client}
+}
+
+func (m *multiclusterClientset) Cluster(cluster string) (Clientset, error) {
+	client, err := m.client.Cluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return NewClientset(client), nil
+}
+
+// clienset for the security.istio.io/v1beta1 APIs
+type Clientset interface {
+	// clienset for the security.istio.io/v1beta1/v1beta1 APIs
+	AuthorizationPolicies() AuthorizationPolicyClient
+	// clienset for the security.istio.io/v1beta1/v1beta1 APIs
+	RequestAuthentications() RequestAuthenticationClient
+}
+
+type clientSet struct {
+	client client.Client
+}
+
+func NewClientsetFromConfig(cfg *rest.Config) (Clientset, error) {
+	scheme := scheme.Scheme
+	if err := security_istio_io_v1beta1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	client, err := client.New(cfg, client.Options{
+		Scheme: scheme,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return NewClientset(client), nil
+}
+
+func NewClientset(client client.Client) Clientset {
+	return &clientSet{client: client}
+}
+
+// clienset for the security.istio.io/v1beta1/v1beta1 APIs
+func (c *clientSet) AuthorizationPolicies() AuthorizationPolicyClient {
+	return NewAuthorizationPolicyClient(c.client)
+}
+
+// clienset for the security.istio.io/v1beta1/v1beta1 APIs
+func (c *clientSet) RequestAuthentications() RequestAuthenticationClient {
+	return NewRequestAuthenticationClient(c.client)
+}
+
+// Reader knows how to read and list AuthorizationPolicys.
+type AuthorizationPolicyReader interface {
+	// Get retrieves a AuthorizationPolicy for the given object key
+	GetAuthorizationPolicy(ctx context.Context, key client.ObjectKey) (*security_istio_io_v1beta1.AuthorizationPolicy, error)
+
+	// List retrieves list of AuthorizationPolicys for a given namespace and list options.
+	ListAuthorizationPolicy(ctx context.Context, opts ...client.ListOption) (*security_istio_io_v1beta1.AuthorizationPolicyList, error)
+}
+
+// AuthorizationPolicyTransitionFunction instructs the AuthorizationPolicyWriter how to transition between an existing
+// AuthorizationPolicy object and a desired on an Upsert
+type AuthorizationPolicyTransitionFunction func(existing, desired *security_istio_io_v1beta1.AuthorizationPolicy) error
+
+// Writer knows how to create, delete, and update AuthorizationPolicys.
+type AuthorizationPolicyWriter interface {
+	// Create saves the AuthorizationPolicy object.
+	CreateAuthorizationPolicy(ctx context.Context, obj *security_istio_io_v1beta1.AuthorizationPolicy, opts ...client.CreateOption) error
+
+	// Delete deletes the AuthorizationPolicy object.
+	DeleteAuthorizationPolicy(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error
+
+	// Update updates the given AuthorizationPolicy object.
+	UpdateAuthorizationPolicy(ctx context.Context, obj *security_istio_io_v1beta1.AuthorizationPolicy, opts ...client.UpdateOption) error
+
+	// Patch patches the given AuthorizationPolicy object.
+	PatchAuthorizationPolicy(ctx context.Context, obj *security_istio_io_v1beta1.AuthorizationPolicy, patch client.Patch, opts ...client.PatchOption) error
+
+	// DeleteAllOf deletes all AuthorizationPolicy objects matching the given options.
+	DeleteAllOfAuthorizationPolicy(ctx context.Context, opts ...client.DeleteAllOfOption) error
+
+	// Create or Update the AuthorizationPolicy object.
+	UpsertAuthorizationPolicy(ctx context.Context, obj *security_istio_io_v1beta1.AuthorizationPolicy, transitionFuncs ...AuthorizationPolicyTransitionFunction) error
+}
+
+// StatusWriter knows how to update status subresource of a AuthorizationPolicy object.
+type AuthorizationPolicyStatusWriter interface {
+	// Update updates the fields corresponding to the status subresource for the
+	// given AuthorizationPolicy object.
+	UpdateAuthorizationPolicyStatus(ctx context.Context, obj *security_istio_io_v1beta1.AuthorizationPolicy, opts ...client.UpdateOption) error
+
+	// Patch patches the given AuthorizationPolicy object's subresource.
+	PatchAuthorizationPolicyStatus(ctx context.Context, obj *security_istio_io_v1beta1.AuthorizationPolicy, patch client.Patch, opts ...client.PatchOption) error
+}
+
+// Client knows how to perform CRUD operations on AuthorizationPolicys.
+type AuthorizationPolicyClient interface {
+	AuthorizationPolicyReader
+	AuthorizationPolicyWriter
+	AuthorizationPolicyStatusWriter
+}
+
+type authorizationPolicyClient struct {
+	client client.Client
+}
+
+func NewAuthorizationPolicyClient(client client.Client) *authorizationPolicyClient {
+	return &authorizationPolicyClient{client: client}
+}
+
+func (c *authorizationPolicyClient) GetAuthorizationPolicy(ctx context.Context, key client.ObjectKey) (*security_istio_io_v1beta1.AuthorizationPolicy, error) {
+	obj := &security_istio_io_v1beta1.AuthorizationPolicy{}
+	if err := c.client.Get(ctx, key, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (c *authorizationPolicyClient) ListAuthorizationPolicy(ctx context.Context, opts ...client.ListOption) (*security_istio_io_v1beta1.AuthorizationPolicyList, error) {
+	list := &security_istio_io_v1beta1.AuthorizationPolicyList{}
+	if err := c.client.List(ctx, list, opts...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (c *authorizationPolicyClient) CreateAuthorizationPolicy(ctx context.Context, obj *security_istio_io_v1beta1.AuthorizationPolicy, opts ...client.CreateOption) error {
+	return c.client.Create(ctx, obj, opts...)
+}
+
+func (c *authorizationPolicyClient) DeleteAuthorizationPolicy(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	obj := &security_istio_io_v1beta1.AuthorizationPolicy{}
+	obj.SetName(key.Name)
+	obj.SetNamespace(key.Namespace)
+	return c.client.Delete(ctx, obj, opts...)
+}
+
+func (c *authorizationPolicyClient) UpdateAuthorizationPolicy(ctx context.Context, obj *security_istio_io_v1beta1.AuthorizationPolicy, opts ...client.UpdateOption) error {
+	return c.client.Update(ctx, obj, opts...)
+}
+
+func (c *authorizationPolicyClient) PatchAuthorizationPolicy(ctx context.Context, obj *security_istio_io_v1beta1.AuthorizationPolicy, patch client.Patch, opts ...client.PatchOption) error {
+	return c.client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *authorizationPolicyClient) DeleteAllOfAuthorizationPolicy(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	obj := &security_istio_io_v1beta1.AuthorizationPolicy{}
+	return c.client.DeleteAllOf(ctx, obj, opts...)
+}
+
+func (c *authorizationPolicyClient) UpsertAuthorizationPolicy(ctx context.Context, obj *security_istio_io_v1beta1.AuthorizationPolicy, transitionFuncs ...AuthorizationPolicyTransitionFunction) error {
+	genericTxFunc := func(existing, desired runtime.Object) error {
+		for _, txFunc := range transitionFuncs {
+			if err := txFunc(existing.(*security_istio_io_v1beta1.AuthorizationPolicy), desired.(*security_istio_io_v1beta1.AuthorizationPolicy)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	_, err := controllerutils.Upsert(ctx, c.client, obj, genericTxFunc)
+	return err
+}
+
+func (c *authorizationPolicyClient) UpdateAuthorizationPolicyStatus(ctx context.Context, obj *security_istio_io_v1beta1.AuthorizationPolicy, opts ...client.UpdateOption) error {
+	return c.client.Status().Update(ctx, obj, opts...)
+}
+
+func (c *authorizationPolicyClient) PatchAuthorizationPolicyStatus(ctx context.Context, obj *security_istio_io_v1beta1.AuthorizationPolicy, patch client.Patch, opts ...client.PatchOption) error {
+	return c.client.Status().Patch(ctx, obj, patch, opts...)
+}
+
+// Provides AuthorizationPolicyClients for multiple clusters.
+type MulticlusterAuthorizationPolicyClient interface {
+	// Cluster returns a AuthorizationPolicyClient for the given cluster
+	Cluster(cluster string) (AuthorizationPolicyClient, error)
+}
+
+type multiclusterAuthorizationPolicyClient struct {
+	client multicluster.Client
+}
+
+func NewMulticlusterAuthorizationPolicyClient(client multicluster.Client) MulticlusterAuthorizationPolicyClient {
+	return &multiclusterAuthorizationPolicyClient{client: client}
+}
+
+func (m *multiclusterAuthorizationPolicyClient) Cluster(cluster string) (AuthorizationPolicyClient, error) {
+	client, err := m.client.Cluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return NewAuthorizationPolicyClient(client), nil
+}
+
+// Reader knows how to read and list RequestAuthentications.
+type RequestAuthenticationReader interface {
+	// Get retrieves a RequestAuthentication for the given object key
+	GetRequestAuthentication(ctx context.Context, key client.ObjectKey) (*security_istio_io_v1beta1.RequestAuthentication, error)
+
+	// List retrieves list of RequestAuthentications for a given namespace and list options.
+	ListRequestAuthentication(ctx context.Context, opts ...client.ListOption) (*security_istio_io_v1beta1.RequestAuthenticationList, error)
+}
+
+// RequestAuthenticationTransitionFunction instructs the RequestAuthenticationWriter how to transition between an existing
+// RequestAuthentication object and a desired on an Upsert
+type RequestAuthenticationTransitionFunction func(existing, desired *security_istio_io_v1beta1.RequestAuthentication) error
+
+// Writer knows how to create, delete, and update RequestAuthentications.
+type RequestAuthenticationWriter interface {
+	// Create saves the RequestAuthentication object.
+	CreateRequestAuthentication(ctx context.Context, obj *security_istio_io_v1beta1.RequestAuthentication, opts ...client.CreateOption) error
+
+	// Delete deletes the RequestAuthentication object.
+	DeleteRequestAuthentication(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error
+
+	// Update updates the given RequestAuthentication object.
+	UpdateRequestAuthentication(ctx context.Context, obj *security_istio_io_v1beta1.RequestAuthentication, opts ...client.UpdateOption) error
+
+	// Patch patches the given RequestAuthentication object.
+	PatchRequestAuthentication(ctx context.Context, obj *security_istio_io_v1beta1.RequestAuthentication, patch client.Patch, opts ...client.PatchOption) error
+
+	// DeleteAllOf deletes all RequestAuthentication objects matching the given options.
+	DeleteAllOfRequestAuthentication(ctx context.Context, opts ...client.DeleteAllOfOption) error
+
+	// Create or Update the RequestAuthentication object.
+	UpsertRequestAuthentication(ctx context.Context, obj *security_istio_io_v1beta1.RequestAuthentication, transitionFuncs ...RequestAuthenticationTransitionFunction) error
+}
+
+// StatusWriter knows how to update status subresource of a RequestAuthentication object.
+type RequestAuthenticationStatusWriter interface {
+	// Update updates the fields corresponding to the status subresource for the
+	// given RequestAuthentication object.
+	UpdateRequestAuthenticationStatus(ctx context.Context, obj *security_istio_io_v1beta1.RequestAuthentication, opts ...client.UpdateOption) error
+
+	// Patch patches the given RequestAuthentication object's subresource.
+	PatchRequestAuthenticationStatus(ctx context.Context, obj *security_istio_io_v1beta1.RequestAuthentication, patch client.Patch, opts ...client.PatchOption) error
+}
+
+// Client knows how to perform CRUD operations on RequestAuthentications.
+type RequestAuthenticationClient interface {
+	RequestAuthenticationReader
+	RequestAuthenticationWriter
+	RequestAuthenticationStatusWriter
+}
+
+type requestAuthenticationClient struct {
+	client client.Client
+}
+
+func NewRequestAuthenticationClient(client client.Client) *requestAuthenticationClient {
+	return &requestAuthenticationClient{client: client}
+}
+
+func (c *requestAuthenticationClient) GetRequestAuthentication(ctx context.Context, key client.ObjectKey) (*security_istio_io_v1beta1.RequestAuthentication, error) {
+	obj := &security_istio_io_v1beta1.RequestAuthentication{}
+	if err := c.client.Get(ctx, key, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (c *requestAuthenticationClient) ListRequestAuthentication(ctx context.Context, opts ...client.ListOption) (*security_istio_io_v1beta1.RequestAuthenticationList, error) {
+	list := &security_istio_io_v1beta1.RequestAuthenticationList{}
+	if err := c.client.List(ctx, list, opts...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (c *requestAuthenticationClient) CreateRequestAuthentication(ctx context.Context, obj *security_istio_io_v1beta1.RequestAuthentication, opts ...client.CreateOption) error {
+	return c.client.Create(ctx, obj, opts...)
+}
+
+func (c *requestAuthenticationClient) DeleteRequestAuthentication(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	obj := &security_istio_io_v1beta1.RequestAuthentication{}
+	obj.SetName(key.Name)
+	obj.SetNamespace(key.Namespace)
+	return c.client.Delete(ctx, obj, opts...)
+}
+
+func (c *requestAuthenticationClient) UpdateRequestAuthentication(ctx context.Context, obj *security_istio_io_v1beta1.RequestAuthentication, opts ...client.UpdateOption) error {
+	return c.client.Update(ctx, obj, opts...)
+}
+
+func (c *requestAuthenticationClient) PatchRequestAuthentication(ctx context.Context, obj *security_istio_io_v1beta1.RequestAuthentication, patch client.Patch, opts ...client.PatchOption) error {
+	return c.client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *requestAuthenticationClient) DeleteAllOfRequestAuthentication(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	obj := &security_istio_io_v1beta1.RequestAuthentication{}
+	return c.client.DeleteAllOf(ctx, obj, opts...)
+}
+
+func (c *requestAuthenticationClient) UpsertRequestAuthentication(ctx context.Context, obj *security_istio_io_v1beta1.RequestAuthentication, transitionFuncs ...RequestAuthenticationTransitionFunction) error {
+	genericTxFunc := func(existing, desired runtime.Object) error {
+		for _, txFunc := range transitionFuncs {
+			if err := txFunc(existing.(*security_istio_io_v1beta1.RequestAuthentication), desired.(*security_istio_io_v1beta1.RequestAuthentication)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	_, err := controllerutils.Upsert(ctx, c.client, obj, genericTxFunc)
+	return err
+}
+
+func (c *requestAuthenticationClient) UpdateRequestAuthenticationStatus(ctx context.Context, obj *security_istio_io_v1beta1.RequestAuthentication, opts ...client.UpdateOption) error {
+	return c.client.Status().Update(ctx, obj, opts...)
+}
+
+func (c *requestAuthenticationClient) PatchRequestAuthenticationStatus(ctx context.Context, obj *security_istio_io_v1beta1.RequestAuthentication, patch client.Patch, opts ...client.PatchOption) error {
+	return c.client.Status().Patch(ctx, obj, patch, opts...)
+}
+
+// Provides RequestAuthenticationClients for multiple clusters.
+type MulticlusterRequestAuthenticationClient interface {
+	// Cluster returns a RequestAuthenticationClient for the given cluster
+	Cluster(cluster string) (RequestAuthenticationClient, error)
+}
+
+type multiclusterRequestAuthenticationClient struct {
+	client multicluster.Client
+}
+
+func NewMulticlusterRequestAuthenticationClient(client multicluster.Client) MulticlusterRequestAuthenticationClient {
+	return &multiclusterRequestAuthenticationClient{client: client}
+}
+
+func (m *multiclusterRequestAuthenticationClient) Cluster(cluster string) (RequestAuthenticationClient, error) {
+	client, err := m.client.Cluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return NewRequestAuthenticationClient(client), nil
+}
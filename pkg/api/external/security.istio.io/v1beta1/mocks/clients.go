@@ -0,0 +1,1112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./clients.go
+
+// Package mock_v1beta1 is a generated GoMock package.
+package mock_v1beta1
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1beta1 "github.com/solo-io/gloo-mesh/pkg/api/external/security.istio.io/v1beta1"
+	v1beta10 "istio.io/client-go/pkg/apis/security/v1beta1"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MockMulticlusterClientset is a mock of MulticlusterClientset interface.
+type MockMulticlusterClientset struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterClientsetMockRecorder
+}
+
+// MockMulticlusterClientsetMockRecorder is the mock recorder for MockMulticlusterClientset.
+type MockMulticlusterClientsetMockRecorder struct {
+	mock *MockMulticlusterClientset
+}
+
+// NewMockMulticlusterClientset creates a new mock instance.
+func NewMockMulticlusterClientset(ctrl *gomock.Controller) *MockMulticlusterClientset {
+	mock := &MockMulticlusterClientset{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterClientsetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterClientset) EXPECT() *MockMulticlusterClientsetMockRecorder {
+	return m.recorder
+}
+
+// Cluster mocks base method.
+func (m *MockMulticlusterClientset) Cluster(cluster string) (v1beta1.Clientset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cluster", cluster)
+	ret0, _ := ret[0].(v1beta1.Clientset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cluster indicates an expected call of Cluster.
+func (mr *MockMulticlusterClientsetMockRecorder) Cluster(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cluster", reflect.TypeOf((*MockMulticlusterClientset)(nil).Cluster), cluster)
+}
+
+// MockClientset is a mock of Clientset interface.
+type MockClientset struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientsetMockRecorder
+}
+
+// MockClientsetMockRecorder is the mock recorder for MockClientset.
+type MockClientsetMockRecorder struct {
+	mock *MockClientset
+}
+
+// NewMockClientset creates a new mock instance.
+func NewMockClientset(ctrl *gomock.Controller) *MockClientset {
+	mock := &MockClientset{ctrl: ctrl}
+	mock.recorder = &MockClientsetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClientset) EXPECT() *MockClientsetMockRecorder {
+	return m.recorder
+}
+
+// AuthorizationPolicies mocks base method.
+func (m *MockClientset) AuthorizationPolicies() v1beta1.AuthorizationPolicyClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizationPolicies")
+	ret0, _ := ret[0].(v1beta1.AuthorizationPolicyClient)
+	return ret0
+}
+
+// AuthorizationPolicies indicates an expected call of AuthorizationPolicies.
+func (mr *MockClientsetMockRecorder) AuthorizationPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizationPolicies", reflect.TypeOf((*MockClientset)(nil).AuthorizationPolicies))
+}
+
+// RequestAuthentications mocks base method.
+func (m *MockClientset) RequestAuthentications() v1beta1.RequestAuthenticationClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestAuthentications")
+	ret0, _ := ret[0].(v1beta1.RequestAuthenticationClient)
+	return ret0
+}
+
+// RequestAuthentications indicates an expected call of RequestAuthentications.
+func (mr *MockClientsetMockRecorder) RequestAuthentications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestAuthentications", reflect.TypeOf((*MockClientset)(nil).RequestAuthentications))
+}
+
+// MockAuthorizationPolicyReader is a mock of AuthorizationPolicyReader interface.
+type MockAuthorizationPolicyReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationPolicyReaderMockRecorder
+}
+
+// MockAuthorizationPolicyReaderMockRecorder is the mock recorder for MockAuthorizationPolicyReader.
+type MockAuthorizationPolicyReaderMockRecorder struct {
+	mock *MockAuthorizationPolicyReader
+}
+
+// NewMockAuthorizationPolicyReader creates a new mock instance.
+func NewMockAuthorizationPolicyReader(ctrl *gomock.Controller) *MockAuthorizationPolicyReader {
+	mock := &MockAuthorizationPolicyReader{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationPolicyReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationPolicyReader) EXPECT() *MockAuthorizationPolicyReaderMockRecorder {
+	return m.recorder
+}
+
+// GetAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyReader) GetAuthorizationPolicy(ctx context.Context, key client.ObjectKey) (*v1beta10.AuthorizationPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorizationPolicy", ctx, key)
+	ret0, _ := ret[0].(*v1beta10.AuthorizationPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorizationPolicy indicates an expected call of GetAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyReaderMockRecorder) GetAuthorizationPolicy(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyReader)(nil).GetAuthorizationPolicy), ctx, key)
+}
+
+// ListAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyReader) ListAuthorizationPolicy(ctx context.Context, opts ...client.ListOption) (*v1beta10.AuthorizationPolicyList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(*v1beta10.AuthorizationPolicyList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuthorizationPolicy indicates an expected call of ListAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyReaderMockRecorder) ListAuthorizationPolicy(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyReader)(nil).ListAuthorizationPolicy), varargs...)
+}
+
+// MockAuthorizationPolicyWriter is a mock of AuthorizationPolicyWriter interface.
+type MockAuthorizationPolicyWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationPolicyWriterMockRecorder
+}
+
+// MockAuthorizationPolicyWriterMockRecorder is the mock recorder for MockAuthorizationPolicyWriter.
+type MockAuthorizationPolicyWriterMockRecorder struct {
+	mock *MockAuthorizationPolicyWriter
+}
+
+// NewMockAuthorizationPolicyWriter creates a new mock instance.
+func NewMockAuthorizationPolicyWriter(ctrl *gomock.Controller) *MockAuthorizationPolicyWriter {
+	mock := &MockAuthorizationPolicyWriter{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationPolicyWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationPolicyWriter) EXPECT() *MockAuthorizationPolicyWriterMockRecorder {
+	return m.recorder
+}
+
+// CreateAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyWriter) CreateAuthorizationPolicy(ctx context.Context, obj *v1beta10.AuthorizationPolicy, opts ...client.CreateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuthorizationPolicy indicates an expected call of CreateAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyWriterMockRecorder) CreateAuthorizationPolicy(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyWriter)(nil).CreateAuthorizationPolicy), varargs...)
+}
+
+// DeleteAllOfAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyWriter) DeleteAllOfAuthorizationPolicy(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAllOfAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllOfAuthorizationPolicy indicates an expected call of DeleteAllOfAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyWriterMockRecorder) DeleteAllOfAuthorizationPolicy(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOfAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyWriter)(nil).DeleteAllOfAuthorizationPolicy), varargs...)
+}
+
+// DeleteAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyWriter) DeleteAuthorizationPolicy(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAuthorizationPolicy indicates an expected call of DeleteAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyWriterMockRecorder) DeleteAuthorizationPolicy(ctx, key interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyWriter)(nil).DeleteAuthorizationPolicy), varargs...)
+}
+
+// PatchAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyWriter) PatchAuthorizationPolicy(ctx context.Context, obj *v1beta10.AuthorizationPolicy, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchAuthorizationPolicy indicates an expected call of PatchAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyWriterMockRecorder) PatchAuthorizationPolicy(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyWriter)(nil).PatchAuthorizationPolicy), varargs...)
+}
+
+// UpdateAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyWriter) UpdateAuthorizationPolicy(ctx context.Context, obj *v1beta10.AuthorizationPolicy, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAuthorizationPolicy indicates an expected call of UpdateAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyWriterMockRecorder) UpdateAuthorizationPolicy(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyWriter)(nil).UpdateAuthorizationPolicy), varargs...)
+}
+
+// UpsertAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyWriter) UpsertAuthorizationPolicy(ctx context.Context, obj *v1beta10.AuthorizationPolicy, transitionFuncs ...v1beta1.AuthorizationPolicyTransitionFunction) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range transitionFuncs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertAuthorizationPolicy indicates an expected call of UpsertAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyWriterMockRecorder) UpsertAuthorizationPolicy(ctx, obj interface{}, transitionFuncs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, transitionFuncs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyWriter)(nil).UpsertAuthorizationPolicy), varargs...)
+}
+
+// MockAuthorizationPolicyStatusWriter is a mock of AuthorizationPolicyStatusWriter interface.
+type MockAuthorizationPolicyStatusWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationPolicyStatusWriterMockRecorder
+}
+
+// MockAuthorizationPolicyStatusWriterMockRecorder is the mock recorder for MockAuthorizationPolicyStatusWriter.
+type MockAuthorizationPolicyStatusWriterMockRecorder struct {
+	mock *MockAuthorizationPolicyStatusWriter
+}
+
+// NewMockAuthorizationPolicyStatusWriter creates a new mock instance.
+func NewMockAuthorizationPolicyStatusWriter(ctrl *gomock.Controller) *MockAuthorizationPolicyStatusWriter {
+	mock := &MockAuthorizationPolicyStatusWriter{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationPolicyStatusWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationPolicyStatusWriter) EXPECT() *MockAuthorizationPolicyStatusWriterMockRecorder {
+	return m.recorder
+}
+
+// PatchAuthorizationPolicyStatus mocks base method.
+func (m *MockAuthorizationPolicyStatusWriter) PatchAuthorizationPolicyStatus(ctx context.Context, obj *v1beta10.AuthorizationPolicy, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchAuthorizationPolicyStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchAuthorizationPolicyStatus indicates an expected call of PatchAuthorizationPolicyStatus.
+func (mr *MockAuthorizationPolicyStatusWriterMockRecorder) PatchAuthorizationPolicyStatus(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchAuthorizationPolicyStatus", reflect.TypeOf((*MockAuthorizationPolicyStatusWriter)(nil).PatchAuthorizationPolicyStatus), varargs...)
+}
+
+// UpdateAuthorizationPolicyStatus mocks base method.
+func (m *MockAuthorizationPolicyStatusWriter) UpdateAuthorizationPolicyStatus(ctx context.Context, obj *v1beta10.AuthorizationPolicy, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAuthorizationPolicyStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAuthorizationPolicyStatus indicates an expected call of UpdateAuthorizationPolicyStatus.
+func (mr *MockAuthorizationPolicyStatusWriterMockRecorder) UpdateAuthorizationPolicyStatus(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuthorizationPolicyStatus", reflect.TypeOf((*MockAuthorizationPolicyStatusWriter)(nil).UpdateAuthorizationPolicyStatus), varargs...)
+}
+
+// MockAuthorizationPolicyClient is a mock of AuthorizationPolicyClient interface.
+type MockAuthorizationPolicyClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationPolicyClientMockRecorder
+}
+
+// MockAuthorizationPolicyClientMockRecorder is the mock recorder for MockAuthorizationPolicyClient.
+type MockAuthorizationPolicyClientMockRecorder struct {
+	mock *MockAuthorizationPolicyClient
+}
+
+// NewMockAuthorizationPolicyClient creates a new mock instance.
+func NewMockAuthorizationPolicyClient(ctrl *gomock.Controller) *MockAuthorizationPolicyClient {
+	mock := &MockAuthorizationPolicyClient{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationPolicyClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationPolicyClient) EXPECT() *MockAuthorizationPolicyClientMockRecorder {
+	return m.recorder
+}
+
+// CreateAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyClient) CreateAuthorizationPolicy(ctx context.Context, obj *v1beta10.AuthorizationPolicy, opts ...client.CreateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuthorizationPolicy indicates an expected call of CreateAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyClientMockRecorder) CreateAuthorizationPolicy(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyClient)(nil).CreateAuthorizationPolicy), varargs...)
+}
+
+// DeleteAllOfAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyClient) DeleteAllOfAuthorizationPolicy(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAllOfAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllOfAuthorizationPolicy indicates an expected call of DeleteAllOfAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyClientMockRecorder) DeleteAllOfAuthorizationPolicy(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOfAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyClient)(nil).DeleteAllOfAuthorizationPolicy), varargs...)
+}
+
+// DeleteAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyClient) DeleteAuthorizationPolicy(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAuthorizationPolicy indicates an expected call of DeleteAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyClientMockRecorder) DeleteAuthorizationPolicy(ctx, key interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyClient)(nil).DeleteAuthorizationPolicy), varargs...)
+}
+
+// GetAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyClient) GetAuthorizationPolicy(ctx context.Context, key client.ObjectKey) (*v1beta10.AuthorizationPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorizationPolicy", ctx, key)
+	ret0, _ := ret[0].(*v1beta10.AuthorizationPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorizationPolicy indicates an expected call of GetAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyClientMockRecorder) GetAuthorizationPolicy(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyClient)(nil).GetAuthorizationPolicy), ctx, key)
+}
+
+// ListAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyClient) ListAuthorizationPolicy(ctx context.Context, opts ...client.ListOption) (*v1beta10.AuthorizationPolicyList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(*v1beta10.AuthorizationPolicyList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuthorizationPolicy indicates an expected call of ListAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyClientMockRecorder) ListAuthorizationPolicy(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyClient)(nil).ListAuthorizationPolicy), varargs...)
+}
+
+// PatchAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyClient) PatchAuthorizationPolicy(ctx context.Context, obj *v1beta10.AuthorizationPolicy, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchAuthorizationPolicy indicates an expected call of PatchAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyClientMockRecorder) PatchAuthorizationPolicy(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyClient)(nil).PatchAuthorizationPolicy), varargs...)
+}
+
+// PatchAuthorizationPolicyStatus mocks base method.
+func (m *MockAuthorizationPolicyClient) PatchAuthorizationPolicyStatus(ctx context.Context, obj *v1beta10.AuthorizationPolicy, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchAuthorizationPolicyStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchAuthorizationPolicyStatus indicates an expected call of PatchAuthorizationPolicyStatus.
+func (mr *MockAuthorizationPolicyClientMockRecorder) PatchAuthorizationPolicyStatus(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchAuthorizationPolicyStatus", reflect.TypeOf((*MockAuthorizationPolicyClient)(nil).PatchAuthorizationPolicyStatus), varargs...)
+}
+
+// UpdateAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyClient) UpdateAuthorizationPolicy(ctx context.Context, obj *v1beta10.AuthorizationPolicy, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAuthorizationPolicy indicates an expected call of UpdateAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyClientMockRecorder) UpdateAuthorizationPolicy(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyClient)(nil).UpdateAuthorizationPolicy), varargs...)
+}
+
+// UpdateAuthorizationPolicyStatus mocks base method.
+func (m *MockAuthorizationPolicyClient) UpdateAuthorizationPolicyStatus(ctx context.Context, obj *v1beta10.AuthorizationPolicy, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAuthorizationPolicyStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAuthorizationPolicyStatus indicates an expected call of UpdateAuthorizationPolicyStatus.
+func (mr *MockAuthorizationPolicyClientMockRecorder) UpdateAuthorizationPolicyStatus(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuthorizationPolicyStatus", reflect.TypeOf((*MockAuthorizationPolicyClient)(nil).UpdateAuthorizationPolicyStatus), varargs...)
+}
+
+// UpsertAuthorizationPolicy mocks base method.
+func (m *MockAuthorizationPolicyClient) UpsertAuthorizationPolicy(ctx context.Context, obj *v1beta10.AuthorizationPolicy, transitionFuncs ...v1beta1.AuthorizationPolicyTransitionFunction) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range transitionFuncs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertAuthorizationPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertAuthorizationPolicy indicates an expected call of UpsertAuthorizationPolicy.
+func (mr *MockAuthorizationPolicyClientMockRecorder) UpsertAuthorizationPolicy(ctx, obj interface{}, transitionFuncs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, transitionFuncs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAuthorizationPolicy", reflect.TypeOf((*MockAuthorizationPolicyClient)(nil).UpsertAuthorizationPolicy), varargs...)
+}
+
+// MockMulticlusterAuthorizationPolicyClient is a mock of MulticlusterAuthorizationPolicyClient interface.
+type MockMulticlusterAuthorizationPolicyClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterAuthorizationPolicyClientMockRecorder
+}
+
+// MockMulticlusterAuthorizationPolicyClientMockRecorder is the mock recorder for MockMulticlusterAuthorizationPolicyClient.
+type MockMulticlusterAuthorizationPolicyClientMockRecorder struct {
+	mock *MockMulticlusterAuthorizationPolicyClient
+}
+
+// NewMockMulticlusterAuthorizationPolicyClient creates a new mock instance.
+func NewMockMulticlusterAuthorizationPolicyClient(ctrl *gomock.Controller) *MockMulticlusterAuthorizationPolicyClient {
+	mock := &MockMulticlusterAuthorizationPolicyClient{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterAuthorizationPolicyClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterAuthorizationPolicyClient) EXPECT() *MockMulticlusterAuthorizationPolicyClientMockRecorder {
+	return m.recorder
+}
+
+// Cluster mocks base method.
+func (m *MockMulticlusterAuthorizationPolicyClient) Cluster(cluster string) (v1beta1.AuthorizationPolicyClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cluster", cluster)
+	ret0, _ := ret[0].(v1beta1.AuthorizationPolicyClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cluster indicates an expected call of Cluster.
+func (mr *MockMulticlusterAuthorizationPolicyClientMockRecorder) Cluster(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cluster", reflect.TypeOf((*MockMulticlusterAuthorizationPolicyClient)(nil).Cluster), cluster)
+}
+
+// MockRequestAuthenticationReader is a mock of RequestAuthenticationReader interface.
+type MockRequestAuthenticationReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestAuthenticationReaderMockRecorder
+}
+
+// MockRequestAuthenticationReaderMockRecorder is the mock recorder for MockRequestAuthenticationReader.
+type MockRequestAuthenticationReaderMockRecorder struct {
+	mock *MockRequestAuthenticationReader
+}
+
+// NewMockRequestAuthenticationReader creates a new mock instance.
+func NewMockRequestAuthenticationReader(ctrl *gomock.Controller) *MockRequestAuthenticationReader {
+	mock := &MockRequestAuthenticationReader{ctrl: ctrl}
+	mock.recorder = &MockRequestAuthenticationReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestAuthenticationReader) EXPECT() *MockRequestAuthenticationReaderMockRecorder {
+	return m.recorder
+}
+
+// GetRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationReader) GetRequestAuthentication(ctx context.Context, key client.ObjectKey) (*v1beta10.RequestAuthentication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestAuthentication", ctx, key)
+	ret0, _ := ret[0].(*v1beta10.RequestAuthentication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequestAuthentication indicates an expected call of GetRequestAuthentication.
+func (mr *MockRequestAuthenticationReaderMockRecorder) GetRequestAuthentication(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationReader)(nil).GetRequestAuthentication), ctx, key)
+}
+
+// ListRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationReader) ListRequestAuthentication(ctx context.Context, opts ...client.ListOption) (*v1beta10.RequestAuthenticationList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(*v1beta10.RequestAuthenticationList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRequestAuthentication indicates an expected call of ListRequestAuthentication.
+func (mr *MockRequestAuthenticationReaderMockRecorder) ListRequestAuthentication(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationReader)(nil).ListRequestAuthentication), varargs...)
+}
+
+// MockRequestAuthenticationWriter is a mock of RequestAuthenticationWriter interface.
+type MockRequestAuthenticationWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestAuthenticationWriterMockRecorder
+}
+
+// MockRequestAuthenticationWriterMockRecorder is the mock recorder for MockRequestAuthenticationWriter.
+type MockRequestAuthenticationWriterMockRecorder struct {
+	mock *MockRequestAuthenticationWriter
+}
+
+// NewMockRequestAuthenticationWriter creates a new mock instance.
+func NewMockRequestAuthenticationWriter(ctrl *gomock.Controller) *MockRequestAuthenticationWriter {
+	mock := &MockRequestAuthenticationWriter{ctrl: ctrl}
+	mock.recorder = &MockRequestAuthenticationWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestAuthenticationWriter) EXPECT() *MockRequestAuthenticationWriterMockRecorder {
+	return m.recorder
+}
+
+// CreateRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationWriter) CreateRequestAuthentication(ctx context.Context, obj *v1beta10.RequestAuthentication, opts ...client.CreateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRequestAuthentication indicates an expected call of CreateRequestAuthentication.
+func (mr *MockRequestAuthenticationWriterMockRecorder) CreateRequestAuthentication(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationWriter)(nil).CreateRequestAuthentication), varargs...)
+}
+
+// DeleteAllOfRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationWriter) DeleteAllOfRequestAuthentication(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAllOfRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllOfRequestAuthentication indicates an expected call of DeleteAllOfRequestAuthentication.
+func (mr *MockRequestAuthenticationWriterMockRecorder) DeleteAllOfRequestAuthentication(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOfRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationWriter)(nil).DeleteAllOfRequestAuthentication), varargs...)
+}
+
+// DeleteRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationWriter) DeleteRequestAuthentication(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRequestAuthentication indicates an expected call of DeleteRequestAuthentication.
+func (mr *MockRequestAuthenticationWriterMockRecorder) DeleteRequestAuthentication(ctx, key interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationWriter)(nil).DeleteRequestAuthentication), varargs...)
+}
+
+// PatchRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationWriter) PatchRequestAuthentication(ctx context.Context, obj *v1beta10.RequestAuthentication, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchRequestAuthentication indicates an expected call of PatchRequestAuthentication.
+func (mr *MockRequestAuthenticationWriterMockRecorder) PatchRequestAuthentication(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationWriter)(nil).PatchRequestAuthentication), varargs...)
+}
+
+// UpdateRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationWriter) UpdateRequestAuthentication(ctx context.Context, obj *v1beta10.RequestAuthentication, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRequestAuthentication indicates an expected call of UpdateRequestAuthentication.
+func (mr *MockRequestAuthenticationWriterMockRecorder) UpdateRequestAuthentication(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationWriter)(nil).UpdateRequestAuthentication), varargs...)
+}
+
+// UpsertRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationWriter) UpsertRequestAuthentication(ctx context.Context, obj *v1beta10.RequestAuthentication, transitionFuncs ...v1beta1.RequestAuthenticationTransitionFunction) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range transitionFuncs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRequestAuthentication indicates an expected call of UpsertRequestAuthentication.
+func (mr *MockRequestAuthenticationWriterMockRecorder) UpsertRequestAuthentication(ctx, obj interface{}, transitionFuncs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, transitionFuncs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationWriter)(nil).UpsertRequestAuthentication), varargs...)
+}
+
+// MockRequestAuthenticationStatusWriter is a mock of RequestAuthenticationStatusWriter interface.
+type MockRequestAuthenticationStatusWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestAuthenticationStatusWriterMockRecorder
+}
+
+// MockRequestAuthenticationStatusWriterMockRecorder is the mock recorder for MockRequestAuthenticationStatusWriter.
+type MockRequestAuthenticationStatusWriterMockRecorder struct {
+	mock *MockRequestAuthenticationStatusWriter
+}
+
+// NewMockRequestAuthenticationStatusWriter creates a new mock instance.
+func NewMockRequestAuthenticationStatusWriter(ctrl *gomock.Controller) *MockRequestAuthenticationStatusWriter {
+	mock := &MockRequestAuthenticationStatusWriter{ctrl: ctrl}
+	mock.recorder = &MockRequestAuthenticationStatusWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestAuthenticationStatusWriter) EXPECT() *MockRequestAuthenticationStatusWriterMockRecorder {
+	return m.recorder
+}
+
+// PatchRequestAuthenticationStatus mocks base method.
+func (m *MockRequestAuthenticationStatusWriter) PatchRequestAuthenticationStatus(ctx context.Context, obj *v1beta10.RequestAuthentication, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchRequestAuthenticationStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchRequestAuthenticationStatus indicates an expected call of PatchRequestAuthenticationStatus.
+func (mr *MockRequestAuthenticationStatusWriterMockRecorder) PatchRequestAuthenticationStatus(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchRequestAuthenticationStatus", reflect.TypeOf((*MockRequestAuthenticationStatusWriter)(nil).PatchRequestAuthenticationStatus), varargs...)
+}
+
+// UpdateRequestAuthenticationStatus mocks base method.
+func (m *MockRequestAuthenticationStatusWriter) UpdateRequestAuthenticationStatus(ctx context.Context, obj *v1beta10.RequestAuthentication, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRequestAuthenticationStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRequestAuthenticationStatus indicates an expected call of UpdateRequestAuthenticationStatus.
+func (mr *MockRequestAuthenticationStatusWriterMockRecorder) UpdateRequestAuthenticationStatus(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRequestAuthenticationStatus", reflect.TypeOf((*MockRequestAuthenticationStatusWriter)(nil).UpdateRequestAuthenticationStatus), varargs...)
+}
+
+// MockRequestAuthenticationClient is a mock of RequestAuthenticationClient interface.
+type MockRequestAuthenticationClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestAuthenticationClientMockRecorder
+}
+
+// MockRequestAuthenticationClientMockRecorder is the mock recorder for MockRequestAuthenticationClient.
+type MockRequestAuthenticationClientMockRecorder struct {
+	mock *MockRequestAuthenticationClient
+}
+
+// NewMockRequestAuthenticationClient creates a new mock instance.
+func NewMockRequestAuthenticationClient(ctrl *gomock.Controller) *MockRequestAuthenticationClient {
+	mock := &MockRequestAuthenticationClient{ctrl: ctrl}
+	mock.recorder = &MockRequestAuthenticationClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestAuthenticationClient) EXPECT() *MockRequestAuthenticationClientMockRecorder {
+	return m.recorder
+}
+
+// CreateRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationClient) CreateRequestAuthentication(ctx context.Context, obj *v1beta10.RequestAuthentication, opts ...client.CreateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRequestAuthentication indicates an expected call of CreateRequestAuthentication.
+func (mr *MockRequestAuthenticationClientMockRecorder) CreateRequestAuthentication(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationClient)(nil).CreateRequestAuthentication), varargs...)
+}
+
+// DeleteAllOfRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationClient) DeleteAllOfRequestAuthentication(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAllOfRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllOfRequestAuthentication indicates an expected call of DeleteAllOfRequestAuthentication.
+func (mr *MockRequestAuthenticationClientMockRecorder) DeleteAllOfRequestAuthentication(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOfRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationClient)(nil).DeleteAllOfRequestAuthentication), varargs...)
+}
+
+// DeleteRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationClient) DeleteRequestAuthentication(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRequestAuthentication indicates an expected call of DeleteRequestAuthentication.
+func (mr *MockRequestAuthenticationClientMockRecorder) DeleteRequestAuthentication(ctx, key interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationClient)(nil).DeleteRequestAuthentication), varargs...)
+}
+
+// GetRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationClient) GetRequestAuthentication(ctx context.Context, key client.ObjectKey) (*v1beta10.RequestAuthentication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestAuthentication", ctx, key)
+	ret0, _ := ret[0].(*v1beta10.RequestAuthentication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequestAuthentication indicates an expected call of GetRequestAuthentication.
+func (mr *MockRequestAuthenticationClientMockRecorder) GetRequestAuthentication(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationClient)(nil).GetRequestAuthentication), ctx, key)
+}
+
+// ListRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationClient) ListRequestAuthentication(ctx context.Context, opts ...client.ListOption) (*v1beta10.RequestAuthenticationList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(*v1beta10.RequestAuthenticationList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRequestAuthentication indicates an expected call of ListRequestAuthentication.
+func (mr *MockRequestAuthenticationClientMockRecorder) ListRequestAuthentication(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationClient)(nil).ListRequestAuthentication), varargs...)
+}
+
+// PatchRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationClient) PatchRequestAuthentication(ctx context.Context, obj *v1beta10.RequestAuthentication, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchRequestAuthentication indicates an expected call of PatchRequestAuthentication.
+func (mr *MockRequestAuthenticationClientMockRecorder) PatchRequestAuthentication(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationClient)(nil).PatchRequestAuthentication), varargs...)
+}
+
+// PatchRequestAuthenticationStatus mocks base method.
+func (m *MockRequestAuthenticationClient) PatchRequestAuthenticationStatus(ctx context.Context, obj *v1beta10.RequestAuthentication, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchRequestAuthenticationStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchRequestAuthenticationStatus indicates an expected call of PatchRequestAuthenticationStatus.
+func (mr *MockRequestAuthenticationClientMockRecorder) PatchRequestAuthenticationStatus(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchRequestAuthenticationStatus", reflect.TypeOf((*MockRequestAuthenticationClient)(nil).PatchRequestAuthenticationStatus), varargs...)
+}
+
+// UpdateRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationClient) UpdateRequestAuthentication(ctx context.Context, obj *v1beta10.RequestAuthentication, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRequestAuthentication indicates an expected call of UpdateRequestAuthentication.
+func (mr *MockRequestAuthenticationClientMockRecorder) UpdateRequestAuthentication(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationClient)(nil).UpdateRequestAuthentication), varargs...)
+}
+
+// UpdateRequestAuthenticationStatus mocks base method.
+func (m *MockRequestAuthenticationClient) UpdateRequestAuthenticationStatus(ctx context.Context, obj *v1beta10.RequestAuthentication, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRequestAuthenticationStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRequestAuthenticationStatus indicates an expected call of UpdateRequestAuthenticationStatus.
+func (mr *MockRequestAuthenticationClientMockRecorder) UpdateRequestAuthenticationStatus(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRequestAuthenticationStatus", reflect.TypeOf((*MockRequestAuthenticationClient)(nil).UpdateRequestAuthenticationStatus), varargs...)
+}
+
+// UpsertRequestAuthentication mocks base method.
+func (m *MockRequestAuthenticationClient) UpsertRequestAuthentication(ctx context.Context, obj *v1beta10.RequestAuthentication, transitionFuncs ...v1beta1.RequestAuthenticationTransitionFunction) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range transitionFuncs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertRequestAuthentication", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertRequestAuthentication indicates an expected call of UpsertRequestAuthentication.
+func (mr *MockRequestAuthenticationClientMockRecorder) UpsertRequestAuthentication(ctx, obj interface{}, transitionFuncs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, transitionFuncs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRequestAuthentication", reflect.TypeOf((*MockRequestAuthenticationClient)(nil).UpsertRequestAuthentication), varargs...)
+}
+
+// MockMulticlusterRequestAuthenticationClient is a mock of MulticlusterRequestAuthenticationClient interface.
+type MockMulticlusterRequestAuthenticationClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterRequestAuthenticationClientMockRecorder
+}
+
+// MockMulticlusterRequestAuthenticationClientMockRecorder is the mock recorder for MockMulticlusterRequestAuthenticationClient.
+type MockMulticlusterRequestAuthenticationClientMockRecorder struct {
+	mock *MockMulticlusterRequestAuthenticationClient
+}
+
+// NewMockMulticlusterRequestAuthenticationClient creates a new mock instance.
+func NewMockMulticlusterRequestAuthenticationClient(ctrl *gomock.Controller) *MockMulticlusterRequestAuthenticationClient {
+	mock := &MockMulticlusterRequestAuthenticationClient{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterRequestAuthenticationClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterRequestAuthenticationClient) EXPECT() *MockMulticlusterRequestAuthenticationClientMockRecorder {
+	return m.recorder
+}
+
+// Cluster mocks base method.
+func (m *MockMulticlusterRequestAuthenticationClient) Cluster(cluster string) (v1beta1.RequestAuthenticationClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cluster", cluster)
+	ret0, _ := ret[0].(v1beta1.RequestAuthenticationClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cluster indicates an expected call of Cluster.
+func (mr *MockMulticlusterRequestAuthenticationClientMockRecorder) Cluster(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cluster", reflect.TypeOf((*MockMulticlusterRequestAuthenticationClient)(nil).Cluster), cluster)
+}
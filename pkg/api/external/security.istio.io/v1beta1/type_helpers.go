@@ -0,0 +1,14 @@
+// Code generated by skv2. DO NOT EDIT.
+
+// Definitions for the Kubernetes types
+package v1beta1
+
+import (
+	. "istio.io/client-go/pkg/apis/security/v1beta1"
+)
+
+// AuthorizationPolicySlice represents a slice of *AuthorizationPolicy
+type AuthorizationPolicySlice []*AuthorizationPolicy
+
+// RequestAuthenticationSlice represents a slice of *RequestAuthentication
+type RequestAuthenticationSlice []*RequestAuthentication
@@ -13,6 +13,7 @@
 // * TrafficPolicies
 // * AccessPolicies
 // * VirtualMeshes
+// * EgressGatewayPolicies
 // * Settings
 // * Destinations
 // * Workloads
@@ -130,6 +131,11 @@ var LocalSnapshotGVKs = []schema.GroupVersionKind{
 		Version: "v1",
 		Kind:    "VirtualMesh",
 	},
+	schema.GroupVersionKind{
+		Group:   "networking.mesh.gloo.solo.io",
+		Version: "v1",
+		Kind:    "EgressGatewayPolicy",
+	},
 
 	schema.GroupVersionKind{
 		Group:   "settings.mesh.gloo.solo.io",
@@ -196,6 +202,8 @@ type LocalSnapshot interface {
 	AccessPolicies() networking_mesh_gloo_solo_io_v1_sets.AccessPolicySet
 	// return the set of input VirtualMeshes
 	VirtualMeshes() networking_mesh_gloo_solo_io_v1_sets.VirtualMeshSet
+	// return the set of input EgressGatewayPolicies
+	EgressGatewayPolicies() networking_mesh_gloo_solo_io_v1_sets.EgressGatewayPolicySet
 
 	// return the set of input Settings
 	Settings() settings_mesh_gloo_solo_io_v1_sets.SettingsSet
@@ -252,6 +260,8 @@ type LocalSyncStatusOptions struct {
 	AccessPolicy bool
 	// sync status of VirtualMesh objects
 	VirtualMesh bool
+	// sync status of EgressGatewayPolicy objects
+	EgressGatewayPolicy bool
 
 	// sync status of Settings objects
 	Settings bool
@@ -284,9 +294,10 @@ type snapshotLocal struct {
 	routeTables              networking_enterprise_mesh_gloo_solo_io_v1beta1_sets.RouteTableSet
 	serviceDependencies      networking_enterprise_mesh_gloo_solo_io_v1beta1_sets.ServiceDependencySet
 
-	trafficPolicies networking_mesh_gloo_solo_io_v1_sets.TrafficPolicySet
-	accessPolicies  networking_mesh_gloo_solo_io_v1_sets.AccessPolicySet
-	virtualMeshes   networking_mesh_gloo_solo_io_v1_sets.VirtualMeshSet
+	trafficPolicies       networking_mesh_gloo_solo_io_v1_sets.TrafficPolicySet
+	accessPolicies        networking_mesh_gloo_solo_io_v1_sets.AccessPolicySet
+	virtualMeshes         networking_mesh_gloo_solo_io_v1_sets.VirtualMeshSet
+	egressGatewayPolicies networking_mesh_gloo_solo_io_v1_sets.EgressGatewayPolicySet
 
 	settings settings_mesh_gloo_solo_io_v1_sets.SettingsSet
 
@@ -315,6 +326,7 @@ func NewLocalSnapshot(
 	trafficPolicies networking_mesh_gloo_solo_io_v1_sets.TrafficPolicySet,
 	accessPolicies networking_mesh_gloo_solo_io_v1_sets.AccessPolicySet,
 	virtualMeshes networking_mesh_gloo_solo_io_v1_sets.VirtualMeshSet,
+	egressGatewayPolicies networking_mesh_gloo_solo_io_v1_sets.EgressGatewayPolicySet,
 
 	settings settings_mesh_gloo_solo_io_v1_sets.SettingsSet,
 
@@ -342,6 +354,7 @@ func NewLocalSnapshot(
 		trafficPolicies:          trafficPolicies,
 		accessPolicies:           accessPolicies,
 		virtualMeshes:            virtualMeshes,
+		egressGatewayPolicies:    egressGatewayPolicies,
 		settings:                 settings,
 		destinations:             destinations,
 		workloads:                workloads,
@@ -368,6 +381,7 @@ func NewLocalSnapshotFromGeneric(
 	trafficPolicySet := networking_mesh_gloo_solo_io_v1_sets.NewTrafficPolicySet()
 	accessPolicySet := networking_mesh_gloo_solo_io_v1_sets.NewAccessPolicySet()
 	virtualMeshSet := networking_mesh_gloo_solo_io_v1_sets.NewVirtualMeshSet()
+	egressGatewayPolicySet := networking_mesh_gloo_solo_io_v1_sets.NewEgressGatewayPolicySet()
 
 	settingsSet := settings_mesh_gloo_solo_io_v1_sets.NewSettingsSet()
 
@@ -474,6 +488,15 @@ func NewLocalSnapshotFromGeneric(
 		for _, virtualMesh := range virtualMeshes {
 			virtualMeshSet.Insert(virtualMesh.(*networking_mesh_gloo_solo_io_v1_types.VirtualMesh))
 		}
+		egressGatewayPolicies := snapshot[schema.GroupVersionKind{
+			Group:   "networking.mesh.gloo.solo.io",
+			Version: "v1",
+			Kind:    "EgressGatewayPolicy",
+		}]
+
+		for _, egressGatewayPolicy := range egressGatewayPolicies {
+			egressGatewayPolicySet.Insert(egressGatewayPolicy.(*networking_mesh_gloo_solo_io_v1_types.EgressGatewayPolicy))
+		}
 
 		settings := snapshot[schema.GroupVersionKind{
 			Group:   "settings.mesh.gloo.solo.io",
@@ -556,6 +579,7 @@ func NewLocalSnapshotFromGeneric(
 		trafficPolicySet,
 		accessPolicySet,
 		virtualMeshSet,
+		egressGatewayPolicySet,
 		settingsSet,
 		destinationSet,
 		workloadSet,
@@ -606,6 +630,10 @@ func (s snapshotLocal) VirtualMeshes() networking_mesh_gloo_solo_io_v1_sets.Virt
 	return s.virtualMeshes
 }
 
+func (s snapshotLocal) EgressGatewayPolicies() networking_mesh_gloo_solo_io_v1_sets.EgressGatewayPolicySet {
+	return s.egressGatewayPolicies
+}
+
 func (s snapshotLocal) Settings() settings_mesh_gloo_solo_io_v1_sets.SettingsSet {
 	return s.settings
 }
@@ -758,6 +786,18 @@ func (s snapshotLocal) SyncStatusesMultiCluster(ctx context.Context, mcClient mu
 			}
 		}
 	}
+	if opts.EgressGatewayPolicy {
+		for _, obj := range s.EgressGatewayPolicies().List() {
+			clusterClient, err := mcClient.Cluster(obj.ClusterName)
+			if err != nil {
+				errs = multierror.Append(errs, err)
+				continue
+			}
+			if _, err := controllerutils.UpdateStatusImmutable(ctx, clusterClient, obj); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+	}
 
 	if opts.Settings {
 		for _, obj := range s.Settings().List() {
@@ -911,6 +951,13 @@ func (s snapshotLocal) SyncStatuses(ctx context.Context, c client.Client, opts L
 			}
 		}
 	}
+	if opts.EgressGatewayPolicy {
+		for _, obj := range s.EgressGatewayPolicies().List() {
+			if _, err := controllerutils.UpdateStatusImmutable(ctx, c, obj); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+	}
 
 	if opts.Settings {
 		for _, obj := range s.Settings().List() {
@@ -973,6 +1020,7 @@ func (s snapshotLocal) MarshalJSON() ([]byte, error) {
 	snapshotMap["trafficPolicies"] = s.trafficPolicies.List()
 	snapshotMap["accessPolicies"] = s.accessPolicies.List()
 	snapshotMap["virtualMeshes"] = s.virtualMeshes.List()
+	snapshotMap["egressGatewayPolicies"] = s.egressGatewayPolicies.List()
 	snapshotMap["settings"] = s.settings.List()
 	snapshotMap["destinations"] = s.destinations.List()
 	snapshotMap["workloads"] = s.workloads.List()
@@ -997,6 +1045,7 @@ func (s snapshotLocal) Clone() LocalSnapshot {
 		trafficPolicies:          s.trafficPolicies.Clone(),
 		accessPolicies:           s.accessPolicies.Clone(),
 		virtualMeshes:            s.virtualMeshes.Clone(),
+		egressGatewayPolicies:    s.egressGatewayPolicies.Clone(),
 		settings:                 s.settings.Clone(),
 		destinations:             s.destinations.Clone(),
 		workloads:                s.workloads.Clone(),
@@ -1036,6 +1085,8 @@ type LocalBuildOptions struct {
 	AccessPolicies ResourceLocalBuildOptions
 	// List options for composing a snapshot from VirtualMeshes
 	VirtualMeshes ResourceLocalBuildOptions
+	// List options for composing a snapshot from EgressGatewayPolicies
+	EgressGatewayPolicies ResourceLocalBuildOptions
 
 	// List options for composing a snapshot from Settings
 	Settings ResourceLocalBuildOptions
@@ -1097,6 +1148,7 @@ func (b *multiClusterLocalBuilder) BuildSnapshot(ctx context.Context, name strin
 	trafficPolicies := networking_mesh_gloo_solo_io_v1_sets.NewTrafficPolicySet()
 	accessPolicies := networking_mesh_gloo_solo_io_v1_sets.NewAccessPolicySet()
 	virtualMeshes := networking_mesh_gloo_solo_io_v1_sets.NewVirtualMeshSet()
+	egressGatewayPolicies := networking_mesh_gloo_solo_io_v1_sets.NewEgressGatewayPolicySet()
 
 	settings := settings_mesh_gloo_solo_io_v1_sets.NewSettingsSet()
 
@@ -1144,6 +1196,9 @@ func (b *multiClusterLocalBuilder) BuildSnapshot(ctx context.Context, name strin
 		if err := b.insertVirtualMeshesFromCluster(ctx, cluster, virtualMeshes, opts.VirtualMeshes); err != nil {
 			errs = multierror.Append(errs, err)
 		}
+		if err := b.insertEgressGatewayPoliciesFromCluster(ctx, cluster, egressGatewayPolicies, opts.EgressGatewayPolicies); err != nil {
+			errs = multierror.Append(errs, err)
+		}
 		if err := b.insertSettingsFromCluster(ctx, cluster, settings, opts.Settings); err != nil {
 			errs = multierror.Append(errs, err)
 		}
@@ -1181,6 +1236,7 @@ func (b *multiClusterLocalBuilder) BuildSnapshot(ctx context.Context, name strin
 		trafficPolicies,
 		accessPolicies,
 		virtualMeshes,
+		egressGatewayPolicies,
 		settings,
 		destinations,
 		workloads,
@@ -1614,6 +1670,48 @@ func (b *multiClusterLocalBuilder) insertVirtualMeshesFromCluster(ctx context.Co
 
 	return nil
 }
+func (b *multiClusterLocalBuilder) insertEgressGatewayPoliciesFromCluster(ctx context.Context, cluster string, egressGatewayPolicies networking_mesh_gloo_solo_io_v1_sets.EgressGatewayPolicySet, opts ResourceLocalBuildOptions) error {
+	egressGatewayPolicyClient, err := networking_mesh_gloo_solo_io_v1.NewMulticlusterEgressGatewayPolicyClient(b.client).Cluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	if opts.Verifier != nil {
+		mgr, err := b.clusters.Cluster(cluster)
+		if err != nil {
+			return err
+		}
+
+		gvk := schema.GroupVersionKind{
+			Group:   "networking.mesh.gloo.solo.io",
+			Version: "v1",
+			Kind:    "EgressGatewayPolicy",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			cluster,
+			mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	egressGatewayPolicyList, err := egressGatewayPolicyClient.ListEgressGatewayPolicy(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range egressGatewayPolicyList.Items {
+		item := item.DeepCopy()    // pike + own
+		item.ClusterName = cluster // set cluster for in-memory processing
+		egressGatewayPolicies.Insert(item)
+	}
+
+	return nil
+}
 
 func (b *multiClusterLocalBuilder) insertSettingsFromCluster(ctx context.Context, cluster string, settings settings_mesh_gloo_solo_io_v1_sets.SettingsSet, opts ResourceLocalBuildOptions) error {
 	settingsClient, err := settings_mesh_gloo_solo_io_v1.NewMulticlusterSettingsClient(b.client).Cluster(cluster)
@@ -1952,6 +2050,7 @@ func (b *singleClusterLocalBuilder) BuildSnapshot(ctx context.Context, name stri
 	trafficPolicies := networking_mesh_gloo_solo_io_v1_sets.NewTrafficPolicySet()
 	accessPolicies := networking_mesh_gloo_solo_io_v1_sets.NewAccessPolicySet()
 	virtualMeshes := networking_mesh_gloo_solo_io_v1_sets.NewVirtualMeshSet()
+	egressGatewayPolicies := networking_mesh_gloo_solo_io_v1_sets.NewEgressGatewayPolicySet()
 
 	settings := settings_mesh_gloo_solo_io_v1_sets.NewSettingsSet()
 
@@ -1997,6 +2096,9 @@ func (b *singleClusterLocalBuilder) BuildSnapshot(ctx context.Context, name stri
 	if err := b.insertVirtualMeshes(ctx, virtualMeshes, opts.VirtualMeshes); err != nil {
 		errs = multierror.Append(errs, err)
 	}
+	if err := b.insertEgressGatewayPolicies(ctx, egressGatewayPolicies, opts.EgressGatewayPolicies); err != nil {
+		errs = multierror.Append(errs, err)
+	}
 	if err := b.insertSettings(ctx, settings, opts.Settings); err != nil {
 		errs = multierror.Append(errs, err)
 	}
@@ -2032,6 +2134,7 @@ func (b *singleClusterLocalBuilder) BuildSnapshot(ctx context.Context, name stri
 		trafficPolicies,
 		accessPolicies,
 		virtualMeshes,
+		egressGatewayPolicies,
 		settings,
 		destinations,
 		workloads,
@@ -2375,6 +2478,39 @@ func (b *singleClusterLocalBuilder) insertVirtualMeshes(ctx context.Context, vir
 
 	return nil
 }
+func (b *singleClusterLocalBuilder) insertEgressGatewayPolicies(ctx context.Context, egressGatewayPolicies networking_mesh_gloo_solo_io_v1_sets.EgressGatewayPolicySet, opts ResourceLocalBuildOptions) error {
+
+	if opts.Verifier != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   "networking.mesh.gloo.solo.io",
+			Version: "v1",
+			Kind:    "EgressGatewayPolicy",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			"", // verify in the local cluster
+			b.mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	egressGatewayPolicyList, err := networking_mesh_gloo_solo_io_v1.NewEgressGatewayPolicyClient(b.mgr.GetClient()).ListEgressGatewayPolicy(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range egressGatewayPolicyList.Items {
+		item := item.DeepCopy() // pike + own the item.
+		item.ClusterName = b.clusterName
+		egressGatewayPolicies.Insert(item)
+	}
+
+	return nil
+}
 
 func (b *singleClusterLocalBuilder) insertSettings(ctx context.Context, settings settings_mesh_gloo_solo_io_v1_sets.SettingsSet, opts ResourceLocalBuildOptions) error {
 
@@ -2643,6 +2779,7 @@ func (i *inMemoryLocalBuilder) BuildSnapshot(ctx context.Context, name string, o
 	trafficPolicies := networking_mesh_gloo_solo_io_v1_sets.NewTrafficPolicySet()
 	accessPolicies := networking_mesh_gloo_solo_io_v1_sets.NewAccessPolicySet()
 	virtualMeshes := networking_mesh_gloo_solo_io_v1_sets.NewVirtualMeshSet()
+	egressGatewayPolicies := networking_mesh_gloo_solo_io_v1_sets.NewEgressGatewayPolicySet()
 
 	settings := settings_mesh_gloo_solo_io_v1_sets.NewSettingsSet()
 
@@ -2688,6 +2825,9 @@ func (i *inMemoryLocalBuilder) BuildSnapshot(ctx context.Context, name string, o
 		// insert VirtualMeshes
 		case *networking_mesh_gloo_solo_io_v1_types.VirtualMesh:
 			i.insertVirtualMesh(ctx, obj, virtualMeshes, opts)
+		// insert EgressGatewayPolicies
+		case *networking_mesh_gloo_solo_io_v1_types.EgressGatewayPolicy:
+			i.insertEgressGatewayPolicy(ctx, obj, egressGatewayPolicies, opts)
 		// insert Settings
 		case *settings_mesh_gloo_solo_io_v1_types.Settings:
 			i.insertSettings(ctx, obj, settings, opts)
@@ -2725,6 +2865,7 @@ func (i *inMemoryLocalBuilder) BuildSnapshot(ctx context.Context, name string, o
 		trafficPolicies,
 		accessPolicies,
 		virtualMeshes,
+		egressGatewayPolicies,
 		settings,
 		destinations,
 		workloads,
@@ -3026,6 +3167,35 @@ func (i *inMemoryLocalBuilder) insertVirtualMesh(
 		virtualMeshSet.Insert(virtualMesh)
 	}
 }
+func (i *inMemoryLocalBuilder) insertEgressGatewayPolicy(
+	ctx context.Context,
+	egressGatewayPolicy *networking_mesh_gloo_solo_io_v1_types.EgressGatewayPolicy,
+	egressGatewayPolicySet networking_mesh_gloo_solo_io_v1_sets.EgressGatewayPolicySet,
+	buildOpts LocalBuildOptions,
+) {
+
+	opts := buildOpts.EgressGatewayPolicies.ListOptions
+
+	listOpts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listOpts)
+	}
+
+	filteredOut := false
+	if listOpts.Namespace != "" {
+		filteredOut = egressGatewayPolicy.Namespace != listOpts.Namespace
+	}
+	if listOpts.LabelSelector != nil {
+		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(egressGatewayPolicy.Labels))
+	}
+	if listOpts.FieldSelector != nil {
+		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
+	}
+
+	if !filteredOut {
+		egressGatewayPolicySet.Insert(egressGatewayPolicy)
+	}
+}
 
 func (i *inMemoryLocalBuilder) insertSettings(
 	ctx context.Context,
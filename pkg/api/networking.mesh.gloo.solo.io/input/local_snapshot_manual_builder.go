@@ -39,9 +39,10 @@ type InputLocalSnapshotManualBuilder struct {
 	routeTables              networking_enterprise_mesh_gloo_solo_io_v1beta1_sets.RouteTableSet
 	serviceDependencies      networking_enterprise_mesh_gloo_solo_io_v1beta1_sets.ServiceDependencySet
 
-	trafficPolicies networking_mesh_gloo_solo_io_v1_sets.TrafficPolicySet
-	accessPolicies  networking_mesh_gloo_solo_io_v1_sets.AccessPolicySet
-	virtualMeshes   networking_mesh_gloo_solo_io_v1_sets.VirtualMeshSet
+	trafficPolicies       networking_mesh_gloo_solo_io_v1_sets.TrafficPolicySet
+	accessPolicies        networking_mesh_gloo_solo_io_v1_sets.AccessPolicySet
+	virtualMeshes         networking_mesh_gloo_solo_io_v1_sets.VirtualMeshSet
+	egressGatewayPolicies networking_mesh_gloo_solo_io_v1_sets.EgressGatewayPolicySet
 
 	settings settings_mesh_gloo_solo_io_v1_sets.SettingsSet
 
@@ -68,9 +69,10 @@ func NewInputLocalSnapshotManualBuilder(name string) *InputLocalSnapshotManualBu
 		routeTables:              networking_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewRouteTableSet(),
 		serviceDependencies:      networking_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewServiceDependencySet(),
 
-		trafficPolicies: networking_mesh_gloo_solo_io_v1_sets.NewTrafficPolicySet(),
-		accessPolicies:  networking_mesh_gloo_solo_io_v1_sets.NewAccessPolicySet(),
-		virtualMeshes:   networking_mesh_gloo_solo_io_v1_sets.NewVirtualMeshSet(),
+		trafficPolicies:       networking_mesh_gloo_solo_io_v1_sets.NewTrafficPolicySet(),
+		accessPolicies:        networking_mesh_gloo_solo_io_v1_sets.NewAccessPolicySet(),
+		virtualMeshes:         networking_mesh_gloo_solo_io_v1_sets.NewVirtualMeshSet(),
+		egressGatewayPolicies: networking_mesh_gloo_solo_io_v1_sets.NewEgressGatewayPolicySet(),
 
 		settings: settings_mesh_gloo_solo_io_v1_sets.NewSettingsSet(),
 
@@ -101,6 +103,7 @@ func (i *InputLocalSnapshotManualBuilder) Build() LocalSnapshot {
 		i.trafficPolicies,
 		i.accessPolicies,
 		i.virtualMeshes,
+		i.egressGatewayPolicies,
 
 		i.settings,
 
@@ -155,6 +158,10 @@ func (i *InputLocalSnapshotManualBuilder) AddVirtualMeshes(virtualMeshes []*netw
 	i.virtualMeshes.Insert(virtualMeshes...)
 	return i
 }
+func (i *InputLocalSnapshotManualBuilder) AddEgressGatewayPolicies(egressGatewayPolicies []*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) *InputLocalSnapshotManualBuilder {
+	i.egressGatewayPolicies.Insert(egressGatewayPolicies...)
+	return i
+}
 func (i *InputLocalSnapshotManualBuilder) AddSettings(settings []*settings_mesh_gloo_solo_io_v1.Settings) *InputLocalSnapshotManualBuilder {
 	i.settings.Insert(settings...)
 	return i
@@ -100,6 +100,20 @@ func (mr *MockLocalSnapshotMockRecorder) Destinations() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destinations", reflect.TypeOf((*MockLocalSnapshot)(nil).Destinations))
 }
 
+// EgressGatewayPolicies mocks base method.
+func (m *MockLocalSnapshot) EgressGatewayPolicies() v1sets1.EgressGatewayPolicySet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EgressGatewayPolicies")
+	ret0, _ := ret[0].(v1sets1.EgressGatewayPolicySet)
+	return ret0
+}
+
+// EgressGatewayPolicies indicates an expected call of EgressGatewayPolicies.
+func (mr *MockLocalSnapshotMockRecorder) EgressGatewayPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EgressGatewayPolicies", reflect.TypeOf((*MockLocalSnapshot)(nil).EgressGatewayPolicies))
+}
+
 // KubernetesClusters mocks base method.
 func (m *MockLocalSnapshot) KubernetesClusters() v1alpha1sets.KubernetesClusterSet {
 	m.ctrl.T.Helper()
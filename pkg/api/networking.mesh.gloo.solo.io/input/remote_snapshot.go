@@ -6,13 +6,14 @@
 // * IssuedCertificates
 // * PodBounceDirectives
 // * XdsConfigs
+// * AuthorizationPolicies
+// * RequestAuthentications
 // * DestinationRules
 // * EnvoyFilters
 // * Gateways
 // * ServiceEntries
 // * VirtualServices
 // * Sidecars
-// * AuthorizationPolicies
 // read from a given cluster or set of clusters, across all namespaces.
 //
 // A snapshot can be constructed from either a single Manager (for a single cluster)
@@ -48,13 +49,13 @@ import (
 	xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_types "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1"
 	xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1/sets"
 
+	security_istio_io_v1beta1 "github.com/solo-io/gloo-mesh/pkg/api/external/security.istio.io/v1beta1"
+	security_istio_io_v1beta1_sets "github.com/solo-io/gloo-mesh/pkg/api/external/security.istio.io/v1beta1/sets"
+	security_istio_io_v1beta1_types "istio.io/client-go/pkg/apis/security/v1beta1"
+
 	networking_istio_io_v1alpha3 "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3"
 	networking_istio_io_v1alpha3_sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
 	networking_istio_io_v1alpha3_types "istio.io/client-go/pkg/apis/networking/v1alpha3"
-
-	security_istio_io_v1beta1 "github.com/solo-io/external-apis/pkg/api/istio/security.istio.io/v1beta1"
-	security_istio_io_v1beta1_sets "github.com/solo-io/external-apis/pkg/api/istio/security.istio.io/v1beta1/sets"
-	security_istio_io_v1beta1_types "istio.io/client-go/pkg/apis/security/v1beta1"
 )
 
 // SnapshotGVKs is a list of the GVKs included in this snapshot
@@ -77,6 +78,17 @@ var RemoteSnapshotGVKs = []schema.GroupVersionKind{
 		Kind:    "XdsConfig",
 	},
 
+	schema.GroupVersionKind{
+		Group:   "security.istio.io",
+		Version: "v1beta1",
+		Kind:    "AuthorizationPolicy",
+	},
+	schema.GroupVersionKind{
+		Group:   "security.istio.io",
+		Version: "v1beta1",
+		Kind:    "RequestAuthentication",
+	},
+
 	schema.GroupVersionKind{
 		Group:   "networking.istio.io",
 		Version: "v1alpha3",
@@ -107,12 +119,6 @@ var RemoteSnapshotGVKs = []schema.GroupVersionKind{
 		Version: "v1alpha3",
 		Kind:    "Sidecar",
 	},
-
-	schema.GroupVersionKind{
-		Group:   "security.istio.io",
-		Version: "v1beta1",
-		Kind:    "AuthorizationPolicy",
-	},
 }
 
 // the snapshot of input resources consumed by translation
@@ -126,6 +132,11 @@ type RemoteSnapshot interface {
 	// return the set of input XdsConfigs
 	XdsConfigs() xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
 
+	// return the set of input AuthorizationPolicies
+	AuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet
+	// return the set of input RequestAuthentications
+	RequestAuthentications() security_istio_io_v1beta1_sets.RequestAuthenticationSet
+
 	// return the set of input DestinationRules
 	DestinationRules() networking_istio_io_v1alpha3_sets.DestinationRuleSet
 	// return the set of input EnvoyFilters
@@ -138,9 +149,6 @@ type RemoteSnapshot interface {
 	VirtualServices() networking_istio_io_v1alpha3_sets.VirtualServiceSet
 	// return the set of input Sidecars
 	Sidecars() networking_istio_io_v1alpha3_sets.SidecarSet
-
-	// return the set of input AuthorizationPolicies
-	AuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet
 	// update the status of all input objects which support
 	// the Status subresource (across multiple clusters)
 	SyncStatusesMultiCluster(ctx context.Context, mcClient multicluster.Client, opts RemoteSyncStatusOptions) error
@@ -165,6 +173,11 @@ type RemoteSyncStatusOptions struct {
 	// sync status of XdsConfig objects
 	XdsConfig bool
 
+	// sync status of AuthorizationPolicy objects
+	AuthorizationPolicy bool
+	// sync status of RequestAuthentication objects
+	RequestAuthentication bool
+
 	// sync status of DestinationRule objects
 	DestinationRule bool
 	// sync status of EnvoyFilter objects
@@ -177,9 +190,6 @@ type RemoteSyncStatusOptions struct {
 	VirtualService bool
 	// sync status of Sidecar objects
 	Sidecar bool
-
-	// sync status of AuthorizationPolicy objects
-	AuthorizationPolicy bool
 }
 
 type snapshotRemote struct {
@@ -190,14 +200,15 @@ type snapshotRemote struct {
 
 	xdsConfigs xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
 
+	authorizationPolicies  security_istio_io_v1beta1_sets.AuthorizationPolicySet
+	requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet
+
 	destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet
 	envoyFilters     networking_istio_io_v1alpha3_sets.EnvoyFilterSet
 	gateways         networking_istio_io_v1alpha3_sets.GatewaySet
 	serviceEntries   networking_istio_io_v1alpha3_sets.ServiceEntrySet
 	virtualServices  networking_istio_io_v1alpha3_sets.VirtualServiceSet
 	sidecars         networking_istio_io_v1alpha3_sets.SidecarSet
-
-	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet
 }
 
 func NewRemoteSnapshot(
@@ -208,6 +219,9 @@ func NewRemoteSnapshot(
 
 	xdsConfigs xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet,
 
+	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet,
+	requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet,
+
 	destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet,
 	envoyFilters networking_istio_io_v1alpha3_sets.EnvoyFilterSet,
 	gateways networking_istio_io_v1alpha3_sets.GatewaySet,
@@ -215,22 +229,21 @@ func NewRemoteSnapshot(
 	virtualServices networking_istio_io_v1alpha3_sets.VirtualServiceSet,
 	sidecars networking_istio_io_v1alpha3_sets.SidecarSet,
 
-	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet,
-
 ) RemoteSnapshot {
 	return &snapshotRemote{
 		name: name,
 
-		issuedCertificates:    issuedCertificates,
-		podBounceDirectives:   podBounceDirectives,
-		xdsConfigs:            xdsConfigs,
-		destinationRules:      destinationRules,
-		envoyFilters:          envoyFilters,
-		gateways:              gateways,
-		serviceEntries:        serviceEntries,
-		virtualServices:       virtualServices,
-		sidecars:              sidecars,
-		authorizationPolicies: authorizationPolicies,
+		issuedCertificates:     issuedCertificates,
+		podBounceDirectives:    podBounceDirectives,
+		xdsConfigs:             xdsConfigs,
+		authorizationPolicies:  authorizationPolicies,
+		requestAuthentications: requestAuthentications,
+		destinationRules:       destinationRules,
+		envoyFilters:           envoyFilters,
+		gateways:               gateways,
+		serviceEntries:         serviceEntries,
+		virtualServices:        virtualServices,
+		sidecars:               sidecars,
 	}
 }
 
@@ -244,6 +257,9 @@ func NewRemoteSnapshotFromGeneric(
 
 	xdsConfigSet := xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewXdsConfigSet()
 
+	authorizationPolicySet := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
+	requestAuthenticationSet := security_istio_io_v1beta1_sets.NewRequestAuthenticationSet()
+
 	destinationRuleSet := networking_istio_io_v1alpha3_sets.NewDestinationRuleSet()
 	envoyFilterSet := networking_istio_io_v1alpha3_sets.NewEnvoyFilterSet()
 	gatewaySet := networking_istio_io_v1alpha3_sets.NewGatewaySet()
@@ -251,8 +267,6 @@ func NewRemoteSnapshotFromGeneric(
 	virtualServiceSet := networking_istio_io_v1alpha3_sets.NewVirtualServiceSet()
 	sidecarSet := networking_istio_io_v1alpha3_sets.NewSidecarSet()
 
-	authorizationPolicySet := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
-
 	for _, snapshot := range genericSnapshot {
 
 		issuedCertificates := snapshot[schema.GroupVersionKind{
@@ -284,6 +298,25 @@ func NewRemoteSnapshotFromGeneric(
 			xdsConfigSet.Insert(xdsConfig.(*xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_types.XdsConfig))
 		}
 
+		authorizationPolicies := snapshot[schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "AuthorizationPolicy",
+		}]
+
+		for _, authorizationPolicy := range authorizationPolicies {
+			authorizationPolicySet.Insert(authorizationPolicy.(*security_istio_io_v1beta1_types.AuthorizationPolicy))
+		}
+		requestAuthentications := snapshot[schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "RequestAuthentication",
+		}]
+
+		for _, requestAuthentication := range requestAuthentications {
+			requestAuthenticationSet.Insert(requestAuthentication.(*security_istio_io_v1beta1_types.RequestAuthentication))
+		}
+
 		destinationRules := snapshot[schema.GroupVersionKind{
 			Group:   "networking.istio.io",
 			Version: "v1alpha3",
@@ -339,29 +372,20 @@ func NewRemoteSnapshotFromGeneric(
 			sidecarSet.Insert(sidecar.(*networking_istio_io_v1alpha3_types.Sidecar))
 		}
 
-		authorizationPolicies := snapshot[schema.GroupVersionKind{
-			Group:   "security.istio.io",
-			Version: "v1beta1",
-			Kind:    "AuthorizationPolicy",
-		}]
-
-		for _, authorizationPolicy := range authorizationPolicies {
-			authorizationPolicySet.Insert(authorizationPolicy.(*security_istio_io_v1beta1_types.AuthorizationPolicy))
-		}
-
 	}
 	return NewRemoteSnapshot(
 		name,
 		issuedCertificateSet,
 		podBounceDirectiveSet,
 		xdsConfigSet,
+		authorizationPolicySet,
+		requestAuthenticationSet,
 		destinationRuleSet,
 		envoyFilterSet,
 		gatewaySet,
 		serviceEntrySet,
 		virtualServiceSet,
 		sidecarSet,
-		authorizationPolicySet,
 	)
 }
 
@@ -377,6 +401,14 @@ func (s snapshotRemote) XdsConfigs() xds_agent_enterprise_mesh_gloo_solo_io_v1be
 	return s.xdsConfigs
 }
 
+func (s snapshotRemote) AuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet {
+	return s.authorizationPolicies
+}
+
+func (s snapshotRemote) RequestAuthentications() security_istio_io_v1beta1_sets.RequestAuthenticationSet {
+	return s.requestAuthentications
+}
+
 func (s snapshotRemote) DestinationRules() networking_istio_io_v1alpha3_sets.DestinationRuleSet {
 	return s.destinationRules
 }
@@ -401,10 +433,6 @@ func (s snapshotRemote) Sidecars() networking_istio_io_v1alpha3_sets.SidecarSet
 	return s.sidecars
 }
 
-func (s snapshotRemote) AuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet {
-	return s.authorizationPolicies
-}
-
 func (s snapshotRemote) SyncStatusesMultiCluster(ctx context.Context, mcClient multicluster.Client, opts RemoteSyncStatusOptions) error {
 	var errs error
 
@@ -484,13 +512,14 @@ func (s snapshotRemote) MarshalJSON() ([]byte, error) {
 	snapshotMap["issuedCertificates"] = s.issuedCertificates.List()
 	snapshotMap["podBounceDirectives"] = s.podBounceDirectives.List()
 	snapshotMap["xdsConfigs"] = s.xdsConfigs.List()
+	snapshotMap["authorizationPolicies"] = s.authorizationPolicies.List()
+	snapshotMap["requestAuthentications"] = s.requestAuthentications.List()
 	snapshotMap["destinationRules"] = s.destinationRules.List()
 	snapshotMap["envoyFilters"] = s.envoyFilters.List()
 	snapshotMap["gateways"] = s.gateways.List()
 	snapshotMap["serviceEntries"] = s.serviceEntries.List()
 	snapshotMap["virtualServices"] = s.virtualServices.List()
 	snapshotMap["sidecars"] = s.sidecars.List()
-	snapshotMap["authorizationPolicies"] = s.authorizationPolicies.List()
 	return json.Marshal(snapshotMap)
 }
 
@@ -498,16 +527,17 @@ func (s snapshotRemote) Clone() RemoteSnapshot {
 	return &snapshotRemote{
 		name: s.name,
 
-		issuedCertificates:    s.issuedCertificates.Clone(),
-		podBounceDirectives:   s.podBounceDirectives.Clone(),
-		xdsConfigs:            s.xdsConfigs.Clone(),
-		destinationRules:      s.destinationRules.Clone(),
-		envoyFilters:          s.envoyFilters.Clone(),
-		gateways:              s.gateways.Clone(),
-		serviceEntries:        s.serviceEntries.Clone(),
-		virtualServices:       s.virtualServices.Clone(),
-		sidecars:              s.sidecars.Clone(),
-		authorizationPolicies: s.authorizationPolicies.Clone(),
+		issuedCertificates:     s.issuedCertificates.Clone(),
+		podBounceDirectives:    s.podBounceDirectives.Clone(),
+		xdsConfigs:             s.xdsConfigs.Clone(),
+		authorizationPolicies:  s.authorizationPolicies.Clone(),
+		requestAuthentications: s.requestAuthentications.Clone(),
+		destinationRules:       s.destinationRules.Clone(),
+		envoyFilters:           s.envoyFilters.Clone(),
+		gateways:               s.gateways.Clone(),
+		serviceEntries:         s.serviceEntries.Clone(),
+		virtualServices:        s.virtualServices.Clone(),
+		sidecars:               s.sidecars.Clone(),
 	}
 }
 
@@ -527,6 +557,11 @@ type RemoteBuildOptions struct {
 	// List options for composing a snapshot from XdsConfigs
 	XdsConfigs ResourceRemoteBuildOptions
 
+	// List options for composing a snapshot from AuthorizationPolicies
+	AuthorizationPolicies ResourceRemoteBuildOptions
+	// List options for composing a snapshot from RequestAuthentications
+	RequestAuthentications ResourceRemoteBuildOptions
+
 	// List options for composing a snapshot from DestinationRules
 	DestinationRules ResourceRemoteBuildOptions
 	// List options for composing a snapshot from EnvoyFilters
@@ -539,9 +574,6 @@ type RemoteBuildOptions struct {
 	VirtualServices ResourceRemoteBuildOptions
 	// List options for composing a snapshot from Sidecars
 	Sidecars ResourceRemoteBuildOptions
-
-	// List options for composing a snapshot from AuthorizationPolicies
-	AuthorizationPolicies ResourceRemoteBuildOptions
 }
 
 // Options for reading resources of a given type
@@ -578,6 +610,9 @@ func (b *multiClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name stri
 
 	xdsConfigs := xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewXdsConfigSet()
 
+	authorizationPolicies := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
+	requestAuthentications := security_istio_io_v1beta1_sets.NewRequestAuthenticationSet()
+
 	destinationRules := networking_istio_io_v1alpha3_sets.NewDestinationRuleSet()
 	envoyFilters := networking_istio_io_v1alpha3_sets.NewEnvoyFilterSet()
 	gateways := networking_istio_io_v1alpha3_sets.NewGatewaySet()
@@ -585,8 +620,6 @@ func (b *multiClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name stri
 	virtualServices := networking_istio_io_v1alpha3_sets.NewVirtualServiceSet()
 	sidecars := networking_istio_io_v1alpha3_sets.NewSidecarSet()
 
-	authorizationPolicies := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
-
 	var errs error
 
 	for _, cluster := range b.clusters.ListClusters() {
@@ -600,6 +633,12 @@ func (b *multiClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name stri
 		if err := b.insertXdsConfigsFromCluster(ctx, cluster, xdsConfigs, opts.XdsConfigs); err != nil {
 			errs = multierror.Append(errs, err)
 		}
+		if err := b.insertAuthorizationPoliciesFromCluster(ctx, cluster, authorizationPolicies, opts.AuthorizationPolicies); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if err := b.insertRequestAuthenticationsFromCluster(ctx, cluster, requestAuthentications, opts.RequestAuthentications); err != nil {
+			errs = multierror.Append(errs, err)
+		}
 		if err := b.insertDestinationRulesFromCluster(ctx, cluster, destinationRules, opts.DestinationRules); err != nil {
 			errs = multierror.Append(errs, err)
 		}
@@ -618,9 +657,6 @@ func (b *multiClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name stri
 		if err := b.insertSidecarsFromCluster(ctx, cluster, sidecars, opts.Sidecars); err != nil {
 			errs = multierror.Append(errs, err)
 		}
-		if err := b.insertAuthorizationPoliciesFromCluster(ctx, cluster, authorizationPolicies, opts.AuthorizationPolicies); err != nil {
-			errs = multierror.Append(errs, err)
-		}
 
 	}
 
@@ -630,13 +666,14 @@ func (b *multiClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name stri
 		issuedCertificates,
 		podBounceDirectives,
 		xdsConfigs,
+		authorizationPolicies,
+		requestAuthentications,
 		destinationRules,
 		envoyFilters,
 		gateways,
 		serviceEntries,
 		virtualServices,
 		sidecars,
-		authorizationPolicies,
 	)
 
 	return outputSnap, errs
@@ -770,6 +807,91 @@ func (b *multiClusterRemoteBuilder) insertXdsConfigsFromCluster(ctx context.Cont
 	return nil
 }
 
+func (b *multiClusterRemoteBuilder) insertAuthorizationPoliciesFromCluster(ctx context.Context, cluster string, authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet, opts ResourceRemoteBuildOptions) error {
+	authorizationPolicyClient, err := security_istio_io_v1beta1.NewMulticlusterAuthorizationPolicyClient(b.client).Cluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	if opts.Verifier != nil {
+		mgr, err := b.clusters.Cluster(cluster)
+		if err != nil {
+			return err
+		}
+
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "AuthorizationPolicy",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			cluster,
+			mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	authorizationPolicyList, err := authorizationPolicyClient.ListAuthorizationPolicy(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range authorizationPolicyList.Items {
+		item := item.DeepCopy()    // pike + own
+		item.ClusterName = cluster // set cluster for in-memory processing
+		authorizationPolicies.Insert(item)
+	}
+
+	return nil
+}
+func (b *multiClusterRemoteBuilder) insertRequestAuthenticationsFromCluster(ctx context.Context, cluster string, requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet, opts ResourceRemoteBuildOptions) error {
+	requestAuthenticationClient, err := security_istio_io_v1beta1.NewMulticlusterRequestAuthenticationClient(b.client).Cluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	if opts.Verifier != nil {
+		mgr, err := b.clusters.Cluster(cluster)
+		if err != nil {
+			return err
+		}
+
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "RequestAuthentication",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			cluster,
+			mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	requestAuthenticationList, err := requestAuthenticationClient.ListRequestAuthentication(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range requestAuthenticationList.Items {
+		item := item.DeepCopy()    // pike + own
+		item.ClusterName = cluster // set cluster for in-memory processing
+		requestAuthentications.Insert(item)
+	}
+
+	return nil
+}
+
 func (b *multiClusterRemoteBuilder) insertDestinationRulesFromCluster(ctx context.Context, cluster string, destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet, opts ResourceRemoteBuildOptions) error {
 	destinationRuleClient, err := networking_istio_io_v1alpha3.NewMulticlusterDestinationRuleClient(b.client).Cluster(cluster)
 	if err != nil {
@@ -1023,49 +1145,6 @@ func (b *multiClusterRemoteBuilder) insertSidecarsFromCluster(ctx context.Contex
 	return nil
 }
 
-func (b *multiClusterRemoteBuilder) insertAuthorizationPoliciesFromCluster(ctx context.Context, cluster string, authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet, opts ResourceRemoteBuildOptions) error {
-	authorizationPolicyClient, err := security_istio_io_v1beta1.NewMulticlusterAuthorizationPolicyClient(b.client).Cluster(cluster)
-	if err != nil {
-		return err
-	}
-
-	if opts.Verifier != nil {
-		mgr, err := b.clusters.Cluster(cluster)
-		if err != nil {
-			return err
-		}
-
-		gvk := schema.GroupVersionKind{
-			Group:   "security.istio.io",
-			Version: "v1beta1",
-			Kind:    "AuthorizationPolicy",
-		}
-
-		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
-			cluster,
-			mgr.GetConfig(),
-			gvk,
-		); err != nil {
-			return err
-		} else if !resourceRegistered {
-			return nil
-		}
-	}
-
-	authorizationPolicyList, err := authorizationPolicyClient.ListAuthorizationPolicy(ctx, opts.ListOptions...)
-	if err != nil {
-		return err
-	}
-
-	for _, item := range authorizationPolicyList.Items {
-		item := item.DeepCopy()    // pike + own
-		item.ClusterName = cluster // set cluster for in-memory processing
-		authorizationPolicies.Insert(item)
-	}
-
-	return nil
-}
-
 // build a snapshot from resources in a single cluster
 type singleClusterRemoteBuilder struct {
 	mgr         manager.Manager
@@ -1098,6 +1177,9 @@ func (b *singleClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name str
 
 	xdsConfigs := xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewXdsConfigSet()
 
+	authorizationPolicies := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
+	requestAuthentications := security_istio_io_v1beta1_sets.NewRequestAuthenticationSet()
+
 	destinationRules := networking_istio_io_v1alpha3_sets.NewDestinationRuleSet()
 	envoyFilters := networking_istio_io_v1alpha3_sets.NewEnvoyFilterSet()
 	gateways := networking_istio_io_v1alpha3_sets.NewGatewaySet()
@@ -1105,8 +1187,6 @@ func (b *singleClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name str
 	virtualServices := networking_istio_io_v1alpha3_sets.NewVirtualServiceSet()
 	sidecars := networking_istio_io_v1alpha3_sets.NewSidecarSet()
 
-	authorizationPolicies := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
-
 	var errs error
 
 	if err := b.insertIssuedCertificates(ctx, issuedCertificates, opts.IssuedCertificates); err != nil {
@@ -1118,6 +1198,12 @@ func (b *singleClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name str
 	if err := b.insertXdsConfigs(ctx, xdsConfigs, opts.XdsConfigs); err != nil {
 		errs = multierror.Append(errs, err)
 	}
+	if err := b.insertAuthorizationPolicies(ctx, authorizationPolicies, opts.AuthorizationPolicies); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if err := b.insertRequestAuthentications(ctx, requestAuthentications, opts.RequestAuthentications); err != nil {
+		errs = multierror.Append(errs, err)
+	}
 	if err := b.insertDestinationRules(ctx, destinationRules, opts.DestinationRules); err != nil {
 		errs = multierror.Append(errs, err)
 	}
@@ -1136,9 +1222,6 @@ func (b *singleClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name str
 	if err := b.insertSidecars(ctx, sidecars, opts.Sidecars); err != nil {
 		errs = multierror.Append(errs, err)
 	}
-	if err := b.insertAuthorizationPolicies(ctx, authorizationPolicies, opts.AuthorizationPolicies); err != nil {
-		errs = multierror.Append(errs, err)
-	}
 
 	outputSnap := NewRemoteSnapshot(
 		name,
@@ -1146,13 +1229,14 @@ func (b *singleClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name str
 		issuedCertificates,
 		podBounceDirectives,
 		xdsConfigs,
+		authorizationPolicies,
+		requestAuthentications,
 		destinationRules,
 		envoyFilters,
 		gateways,
 		serviceEntries,
 		virtualServices,
 		sidecars,
-		authorizationPolicies,
 	)
 
 	return outputSnap, errs
@@ -1259,6 +1343,73 @@ func (b *singleClusterRemoteBuilder) insertXdsConfigs(ctx context.Context, xdsCo
 	return nil
 }
 
+func (b *singleClusterRemoteBuilder) insertAuthorizationPolicies(ctx context.Context, authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet, opts ResourceRemoteBuildOptions) error {
+
+	if opts.Verifier != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "AuthorizationPolicy",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			"", // verify in the local cluster
+			b.mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	authorizationPolicyList, err := security_istio_io_v1beta1.NewAuthorizationPolicyClient(b.mgr.GetClient()).ListAuthorizationPolicy(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range authorizationPolicyList.Items {
+		item := item.DeepCopy() // pike + own the item.
+		item.ClusterName = b.clusterName
+		authorizationPolicies.Insert(item)
+	}
+
+	return nil
+}
+func (b *singleClusterRemoteBuilder) insertRequestAuthentications(ctx context.Context, requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet, opts ResourceRemoteBuildOptions) error {
+
+	if opts.Verifier != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "RequestAuthentication",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			"", // verify in the local cluster
+			b.mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	requestAuthenticationList, err := security_istio_io_v1beta1.NewRequestAuthenticationClient(b.mgr.GetClient()).ListRequestAuthentication(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range requestAuthenticationList.Items {
+		item := item.DeepCopy() // pike + own the item.
+		item.ClusterName = b.clusterName
+		requestAuthentications.Insert(item)
+	}
+
+	return nil
+}
+
 func (b *singleClusterRemoteBuilder) insertDestinationRules(ctx context.Context, destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet, opts ResourceRemoteBuildOptions) error {
 
 	if opts.Verifier != nil {
@@ -1458,40 +1609,6 @@ func (b *singleClusterRemoteBuilder) insertSidecars(ctx context.Context, sidecar
 	return nil
 }
 
-func (b *singleClusterRemoteBuilder) insertAuthorizationPolicies(ctx context.Context, authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet, opts ResourceRemoteBuildOptions) error {
-
-	if opts.Verifier != nil {
-		gvk := schema.GroupVersionKind{
-			Group:   "security.istio.io",
-			Version: "v1beta1",
-			Kind:    "AuthorizationPolicy",
-		}
-
-		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
-			"", // verify in the local cluster
-			b.mgr.GetConfig(),
-			gvk,
-		); err != nil {
-			return err
-		} else if !resourceRegistered {
-			return nil
-		}
-	}
-
-	authorizationPolicyList, err := security_istio_io_v1beta1.NewAuthorizationPolicyClient(b.mgr.GetClient()).ListAuthorizationPolicy(ctx, opts.ListOptions...)
-	if err != nil {
-		return err
-	}
-
-	for _, item := range authorizationPolicyList.Items {
-		item := item.DeepCopy() // pike + own the item.
-		item.ClusterName = b.clusterName
-		authorizationPolicies.Insert(item)
-	}
-
-	return nil
-}
-
 // build a snapshot from resources in a single cluster
 type inMemoryRemoteBuilder struct {
 	getSnapshot func() (resource.ClusterSnapshot, error)
@@ -1517,6 +1634,9 @@ func (i *inMemoryRemoteBuilder) BuildSnapshot(ctx context.Context, name string,
 
 	xdsConfigs := xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewXdsConfigSet()
 
+	authorizationPolicies := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
+	requestAuthentications := security_istio_io_v1beta1_sets.NewRequestAuthenticationSet()
+
 	destinationRules := networking_istio_io_v1alpha3_sets.NewDestinationRuleSet()
 	envoyFilters := networking_istio_io_v1alpha3_sets.NewEnvoyFilterSet()
 	gateways := networking_istio_io_v1alpha3_sets.NewGatewaySet()
@@ -1524,8 +1644,6 @@ func (i *inMemoryRemoteBuilder) BuildSnapshot(ctx context.Context, name string,
 	virtualServices := networking_istio_io_v1alpha3_sets.NewVirtualServiceSet()
 	sidecars := networking_istio_io_v1alpha3_sets.NewSidecarSet()
 
-	authorizationPolicies := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
-
 	genericSnap.ForEachObject(func(cluster string, gvk schema.GroupVersionKind, obj resource.TypedObject) {
 		switch obj := obj.(type) {
 		// insert IssuedCertificates
@@ -1537,6 +1655,12 @@ func (i *inMemoryRemoteBuilder) BuildSnapshot(ctx context.Context, name string,
 		// insert XdsConfigs
 		case *xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_types.XdsConfig:
 			i.insertXdsConfig(ctx, obj, xdsConfigs, opts)
+		// insert AuthorizationPolicies
+		case *security_istio_io_v1beta1_types.AuthorizationPolicy:
+			i.insertAuthorizationPolicy(ctx, obj, authorizationPolicies, opts)
+		// insert RequestAuthentications
+		case *security_istio_io_v1beta1_types.RequestAuthentication:
+			i.insertRequestAuthentication(ctx, obj, requestAuthentications, opts)
 		// insert DestinationRules
 		case *networking_istio_io_v1alpha3_types.DestinationRule:
 			i.insertDestinationRule(ctx, obj, destinationRules, opts)
@@ -1555,9 +1679,6 @@ func (i *inMemoryRemoteBuilder) BuildSnapshot(ctx context.Context, name string,
 		// insert Sidecars
 		case *networking_istio_io_v1alpha3_types.Sidecar:
 			i.insertSidecar(ctx, obj, sidecars, opts)
-		// insert AuthorizationPolicies
-		case *security_istio_io_v1beta1_types.AuthorizationPolicy:
-			i.insertAuthorizationPolicy(ctx, obj, authorizationPolicies, opts)
 		}
 	})
 
@@ -1567,13 +1688,14 @@ func (i *inMemoryRemoteBuilder) BuildSnapshot(ctx context.Context, name string,
 		issuedCertificates,
 		podBounceDirectives,
 		xdsConfigs,
+		authorizationPolicies,
+		requestAuthentications,
 		destinationRules,
 		envoyFilters,
 		gateways,
 		serviceEntries,
 		virtualServices,
 		sidecars,
-		authorizationPolicies,
 	), nil
 }
 
@@ -1666,6 +1788,65 @@ func (i *inMemoryRemoteBuilder) insertXdsConfig(
 	}
 }
 
+func (i *inMemoryRemoteBuilder) insertAuthorizationPolicy(
+	ctx context.Context,
+	authorizationPolicy *security_istio_io_v1beta1_types.AuthorizationPolicy,
+	authorizationPolicySet security_istio_io_v1beta1_sets.AuthorizationPolicySet,
+	buildOpts RemoteBuildOptions,
+) {
+
+	opts := buildOpts.AuthorizationPolicies.ListOptions
+
+	listOpts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listOpts)
+	}
+
+	filteredOut := false
+	if listOpts.Namespace != "" {
+		filteredOut = authorizationPolicy.Namespace != listOpts.Namespace
+	}
+	if listOpts.LabelSelector != nil {
+		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(authorizationPolicy.Labels))
+	}
+	if listOpts.FieldSelector != nil {
+		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
+	}
+
+	if !filteredOut {
+		authorizationPolicySet.Insert(authorizationPolicy)
+	}
+}
+func (i *inMemoryRemoteBuilder) insertRequestAuthentication(
+	ctx context.Context,
+	requestAuthentication *security_istio_io_v1beta1_types.RequestAuthentication,
+	requestAuthenticationSet security_istio_io_v1beta1_sets.RequestAuthenticationSet,
+	buildOpts RemoteBuildOptions,
+) {
+
+	opts := buildOpts.RequestAuthentications.ListOptions
+
+	listOpts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listOpts)
+	}
+
+	filteredOut := false
+	if listOpts.Namespace != "" {
+		filteredOut = requestAuthentication.Namespace != listOpts.Namespace
+	}
+	if listOpts.LabelSelector != nil {
+		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(requestAuthentication.Labels))
+	}
+	if listOpts.FieldSelector != nil {
+		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
+	}
+
+	if !filteredOut {
+		requestAuthenticationSet.Insert(requestAuthentication)
+	}
+}
+
 func (i *inMemoryRemoteBuilder) insertDestinationRule(
 	ctx context.Context,
 	destinationRule *networking_istio_io_v1alpha3_types.DestinationRule,
@@ -1840,33 +2021,3 @@ func (i *inMemoryRemoteBuilder) insertSidecar(
 		sidecarSet.Insert(sidecar)
 	}
 }
-
-func (i *inMemoryRemoteBuilder) insertAuthorizationPolicy(
-	ctx context.Context,
-	authorizationPolicy *security_istio_io_v1beta1_types.AuthorizationPolicy,
-	authorizationPolicySet security_istio_io_v1beta1_sets.AuthorizationPolicySet,
-	buildOpts RemoteBuildOptions,
-) {
-
-	opts := buildOpts.AuthorizationPolicies.ListOptions
-
-	listOpts := &client.ListOptions{}
-	for _, opt := range opts {
-		opt.ApplyToList(listOpts)
-	}
-
-	filteredOut := false
-	if listOpts.Namespace != "" {
-		filteredOut = authorizationPolicy.Namespace != listOpts.Namespace
-	}
-	if listOpts.LabelSelector != nil {
-		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(authorizationPolicy.Labels))
-	}
-	if listOpts.FieldSelector != nil {
-		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
-	}
-
-	if !filteredOut {
-		authorizationPolicySet.Insert(authorizationPolicy)
-	}
-}
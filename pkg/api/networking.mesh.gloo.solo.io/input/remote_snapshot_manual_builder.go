@@ -12,11 +12,11 @@ import (
 	xds_agent_enterprise_mesh_gloo_solo_io_v1beta1 "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1"
 	xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1/sets"
 
+	security_istio_io_v1beta1_sets "github.com/solo-io/gloo-mesh/pkg/api/external/security.istio.io/v1beta1/sets"
+	security_istio_io_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+
 	networking_istio_io_v1alpha3_sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
 	networking_istio_io_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
-
-	security_istio_io_v1beta1_sets "github.com/solo-io/external-apis/pkg/api/istio/security.istio.io/v1beta1/sets"
-	security_istio_io_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
 )
 
 type InputRemoteSnapshotManualBuilder struct {
@@ -27,14 +27,15 @@ type InputRemoteSnapshotManualBuilder struct {
 
 	xdsConfigs xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
 
+	authorizationPolicies  security_istio_io_v1beta1_sets.AuthorizationPolicySet
+	requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet
+
 	destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet
 	envoyFilters     networking_istio_io_v1alpha3_sets.EnvoyFilterSet
 	gateways         networking_istio_io_v1alpha3_sets.GatewaySet
 	serviceEntries   networking_istio_io_v1alpha3_sets.ServiceEntrySet
 	virtualServices  networking_istio_io_v1alpha3_sets.VirtualServiceSet
 	sidecars         networking_istio_io_v1alpha3_sets.SidecarSet
-
-	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet
 }
 
 func NewInputRemoteSnapshotManualBuilder(name string) *InputRemoteSnapshotManualBuilder {
@@ -46,14 +47,15 @@ func NewInputRemoteSnapshotManualBuilder(name string) *InputRemoteSnapshotManual
 
 		xdsConfigs: xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewXdsConfigSet(),
 
+		authorizationPolicies:  security_istio_io_v1beta1_sets.NewAuthorizationPolicySet(),
+		requestAuthentications: security_istio_io_v1beta1_sets.NewRequestAuthenticationSet(),
+
 		destinationRules: networking_istio_io_v1alpha3_sets.NewDestinationRuleSet(),
 		envoyFilters:     networking_istio_io_v1alpha3_sets.NewEnvoyFilterSet(),
 		gateways:         networking_istio_io_v1alpha3_sets.NewGatewaySet(),
 		serviceEntries:   networking_istio_io_v1alpha3_sets.NewServiceEntrySet(),
 		virtualServices:  networking_istio_io_v1alpha3_sets.NewVirtualServiceSet(),
 		sidecars:         networking_istio_io_v1alpha3_sets.NewSidecarSet(),
-
-		authorizationPolicies: security_istio_io_v1beta1_sets.NewAuthorizationPolicySet(),
 	}
 }
 
@@ -66,14 +68,15 @@ func (i *InputRemoteSnapshotManualBuilder) Build() RemoteSnapshot {
 
 		i.xdsConfigs,
 
+		i.authorizationPolicies,
+		i.requestAuthentications,
+
 		i.destinationRules,
 		i.envoyFilters,
 		i.gateways,
 		i.serviceEntries,
 		i.virtualServices,
 		i.sidecars,
-
-		i.authorizationPolicies,
 	)
 }
 func (i *InputRemoteSnapshotManualBuilder) AddIssuedCertificates(issuedCertificates []*certificates_mesh_gloo_solo_io_v1.IssuedCertificate) *InputRemoteSnapshotManualBuilder {
@@ -88,6 +91,14 @@ func (i *InputRemoteSnapshotManualBuilder) AddXdsConfigs(xdsConfigs []*xds_agent
 	i.xdsConfigs.Insert(xdsConfigs...)
 	return i
 }
+func (i *InputRemoteSnapshotManualBuilder) AddAuthorizationPolicies(authorizationPolicies []*security_istio_io_v1beta1.AuthorizationPolicy) *InputRemoteSnapshotManualBuilder {
+	i.authorizationPolicies.Insert(authorizationPolicies...)
+	return i
+}
+func (i *InputRemoteSnapshotManualBuilder) AddRequestAuthentications(requestAuthentications []*security_istio_io_v1beta1.RequestAuthentication) *InputRemoteSnapshotManualBuilder {
+	i.requestAuthentications.Insert(requestAuthentications...)
+	return i
+}
 func (i *InputRemoteSnapshotManualBuilder) AddDestinationRules(destinationRules []*networking_istio_io_v1alpha3.DestinationRule) *InputRemoteSnapshotManualBuilder {
 	i.destinationRules.Insert(destinationRules...)
 	return i
@@ -112,7 +123,3 @@ func (i *InputRemoteSnapshotManualBuilder) AddSidecars(sidecars []*networking_is
 	i.sidecars.Insert(sidecars...)
 	return i
 }
-func (i *InputRemoteSnapshotManualBuilder) AddAuthorizationPolicies(authorizationPolicies []*security_istio_io_v1beta1.AuthorizationPolicy) *InputRemoteSnapshotManualBuilder {
-	i.authorizationPolicies.Insert(authorizationPolicies...)
-	return i
-}
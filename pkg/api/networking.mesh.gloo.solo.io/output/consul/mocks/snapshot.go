@@ -0,0 +1,527 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./snapshot.go
+
+// Package mock_consul is a generated GoMock package.
+package mock_consul
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
+	v1alpha1sets "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1/sets"
+	consul "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/consul"
+	output "github.com/solo-io/skv2/contrib/pkg/output"
+	multicluster "github.com/solo-io/skv2/pkg/multicluster"
+	resource "github.com/solo-io/skv2/pkg/resource"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MockSnapshot is a mock of Snapshot interface.
+type MockSnapshot struct {
+	ctrl     *gomock.Controller
+	recorder *MockSnapshotMockRecorder
+}
+
+// MockSnapshotMockRecorder is the mock recorder for MockSnapshot.
+type MockSnapshotMockRecorder struct {
+	mock *MockSnapshot
+}
+
+// NewMockSnapshot creates a new mock instance.
+func NewMockSnapshot(ctrl *gomock.Controller) *MockSnapshot {
+	mock := &MockSnapshot{ctrl: ctrl}
+	mock.recorder = &MockSnapshotMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSnapshot) EXPECT() *MockSnapshotMockRecorder {
+	return m.recorder
+}
+
+// ApplyLocalCluster mocks base method.
+func (m *MockSnapshot) ApplyLocalCluster(ctx context.Context, clusterClient client.Client, errHandler output.ErrorHandler) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ApplyLocalCluster", ctx, clusterClient, errHandler)
+}
+
+// ApplyLocalCluster indicates an expected call of ApplyLocalCluster.
+func (mr *MockSnapshotMockRecorder) ApplyLocalCluster(ctx, clusterClient, errHandler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyLocalCluster", reflect.TypeOf((*MockSnapshot)(nil).ApplyLocalCluster), ctx, clusterClient, errHandler)
+}
+
+// ApplyMultiCluster mocks base method.
+func (m *MockSnapshot) ApplyMultiCluster(ctx context.Context, multiClusterClient multicluster.Client, errHandler output.ErrorHandler) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ApplyMultiCluster", ctx, multiClusterClient, errHandler)
+}
+
+// ApplyMultiCluster indicates an expected call of ApplyMultiCluster.
+func (mr *MockSnapshotMockRecorder) ApplyMultiCluster(ctx, multiClusterClient, errHandler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyMultiCluster", reflect.TypeOf((*MockSnapshot)(nil).ApplyMultiCluster), ctx, multiClusterClient, errHandler)
+}
+
+// MarshalJSON mocks base method.
+func (m *MockSnapshot) MarshalJSON() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarshalJSON")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarshalJSON indicates an expected call of MarshalJSON.
+func (mr *MockSnapshotMockRecorder) MarshalJSON() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarshalJSON", reflect.TypeOf((*MockSnapshot)(nil).MarshalJSON))
+}
+
+// ServiceIntentions mocks base method.
+func (m *MockSnapshot) ServiceIntentions() []consul.LabeledServiceIntentionsSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceIntentions")
+	ret0, _ := ret[0].([]consul.LabeledServiceIntentionsSet)
+	return ret0
+}
+
+// ServiceIntentions indicates an expected call of ServiceIntentions.
+func (mr *MockSnapshotMockRecorder) ServiceIntentions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceIntentions", reflect.TypeOf((*MockSnapshot)(nil).ServiceIntentions))
+}
+
+// ServiceRouters mocks base method.
+func (m *MockSnapshot) ServiceRouters() []consul.LabeledServiceRouterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceRouters")
+	ret0, _ := ret[0].([]consul.LabeledServiceRouterSet)
+	return ret0
+}
+
+// ServiceRouters indicates an expected call of ServiceRouters.
+func (mr *MockSnapshotMockRecorder) ServiceRouters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceRouters", reflect.TypeOf((*MockSnapshot)(nil).ServiceRouters))
+}
+
+// ServiceSplitters mocks base method.
+func (m *MockSnapshot) ServiceSplitters() []consul.LabeledServiceSplitterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceSplitters")
+	ret0, _ := ret[0].([]consul.LabeledServiceSplitterSet)
+	return ret0
+}
+
+// ServiceSplitters indicates an expected call of ServiceSplitters.
+func (mr *MockSnapshotMockRecorder) ServiceSplitters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceSplitters", reflect.TypeOf((*MockSnapshot)(nil).ServiceSplitters))
+}
+
+// MockLabeledServiceSplitterSet is a mock of LabeledServiceSplitterSet interface.
+type MockLabeledServiceSplitterSet struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabeledServiceSplitterSetMockRecorder
+}
+
+// MockLabeledServiceSplitterSetMockRecorder is the mock recorder for MockLabeledServiceSplitterSet.
+type MockLabeledServiceSplitterSetMockRecorder struct {
+	mock *MockLabeledServiceSplitterSet
+}
+
+// NewMockLabeledServiceSplitterSet creates a new mock instance.
+func NewMockLabeledServiceSplitterSet(ctrl *gomock.Controller) *MockLabeledServiceSplitterSet {
+	mock := &MockLabeledServiceSplitterSet{ctrl: ctrl}
+	mock.recorder = &MockLabeledServiceSplitterSetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabeledServiceSplitterSet) EXPECT() *MockLabeledServiceSplitterSetMockRecorder {
+	return m.recorder
+}
+
+// Generic mocks base method.
+func (m *MockLabeledServiceSplitterSet) Generic() output.ResourceList {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generic")
+	ret0, _ := ret[0].(output.ResourceList)
+	return ret0
+}
+
+// Generic indicates an expected call of Generic.
+func (mr *MockLabeledServiceSplitterSetMockRecorder) Generic() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generic", reflect.TypeOf((*MockLabeledServiceSplitterSet)(nil).Generic))
+}
+
+// Labels mocks base method.
+func (m *MockLabeledServiceSplitterSet) Labels() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Labels")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// Labels indicates an expected call of Labels.
+func (mr *MockLabeledServiceSplitterSetMockRecorder) Labels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Labels", reflect.TypeOf((*MockLabeledServiceSplitterSet)(nil).Labels))
+}
+
+// Set mocks base method.
+func (m *MockLabeledServiceSplitterSet) Set() v1alpha1sets.ServiceSplitterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set")
+	ret0, _ := ret[0].(v1alpha1sets.ServiceSplitterSet)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockLabeledServiceSplitterSetMockRecorder) Set() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockLabeledServiceSplitterSet)(nil).Set))
+}
+
+// MockLabeledServiceRouterSet is a mock of LabeledServiceRouterSet interface.
+type MockLabeledServiceRouterSet struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabeledServiceRouterSetMockRecorder
+}
+
+// MockLabeledServiceRouterSetMockRecorder is the mock recorder for MockLabeledServiceRouterSet.
+type MockLabeledServiceRouterSetMockRecorder struct {
+	mock *MockLabeledServiceRouterSet
+}
+
+// NewMockLabeledServiceRouterSet creates a new mock instance.
+func NewMockLabeledServiceRouterSet(ctrl *gomock.Controller) *MockLabeledServiceRouterSet {
+	mock := &MockLabeledServiceRouterSet{ctrl: ctrl}
+	mock.recorder = &MockLabeledServiceRouterSetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabeledServiceRouterSet) EXPECT() *MockLabeledServiceRouterSetMockRecorder {
+	return m.recorder
+}
+
+// Generic mocks base method.
+func (m *MockLabeledServiceRouterSet) Generic() output.ResourceList {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generic")
+	ret0, _ := ret[0].(output.ResourceList)
+	return ret0
+}
+
+// Generic indicates an expected call of Generic.
+func (mr *MockLabeledServiceRouterSetMockRecorder) Generic() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generic", reflect.TypeOf((*MockLabeledServiceRouterSet)(nil).Generic))
+}
+
+// Labels mocks base method.
+func (m *MockLabeledServiceRouterSet) Labels() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Labels")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// Labels indicates an expected call of Labels.
+func (mr *MockLabeledServiceRouterSetMockRecorder) Labels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Labels", reflect.TypeOf((*MockLabeledServiceRouterSet)(nil).Labels))
+}
+
+// Set mocks base method.
+func (m *MockLabeledServiceRouterSet) Set() v1alpha1sets.ServiceRouterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set")
+	ret0, _ := ret[0].(v1alpha1sets.ServiceRouterSet)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockLabeledServiceRouterSetMockRecorder) Set() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockLabeledServiceRouterSet)(nil).Set))
+}
+
+// MockLabeledServiceIntentionsSet is a mock of LabeledServiceIntentionsSet interface.
+type MockLabeledServiceIntentionsSet struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabeledServiceIntentionsSetMockRecorder
+}
+
+// MockLabeledServiceIntentionsSetMockRecorder is the mock recorder for MockLabeledServiceIntentionsSet.
+type MockLabeledServiceIntentionsSetMockRecorder struct {
+	mock *MockLabeledServiceIntentionsSet
+}
+
+// NewMockLabeledServiceIntentionsSet creates a new mock instance.
+func NewMockLabeledServiceIntentionsSet(ctrl *gomock.Controller) *MockLabeledServiceIntentionsSet {
+	mock := &MockLabeledServiceIntentionsSet{ctrl: ctrl}
+	mock.recorder = &MockLabeledServiceIntentionsSetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabeledServiceIntentionsSet) EXPECT() *MockLabeledServiceIntentionsSetMockRecorder {
+	return m.recorder
+}
+
+// Generic mocks base method.
+func (m *MockLabeledServiceIntentionsSet) Generic() output.ResourceList {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generic")
+	ret0, _ := ret[0].(output.ResourceList)
+	return ret0
+}
+
+// Generic indicates an expected call of Generic.
+func (mr *MockLabeledServiceIntentionsSetMockRecorder) Generic() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generic", reflect.TypeOf((*MockLabeledServiceIntentionsSet)(nil).Generic))
+}
+
+// Labels mocks base method.
+func (m *MockLabeledServiceIntentionsSet) Labels() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Labels")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// Labels indicates an expected call of Labels.
+func (mr *MockLabeledServiceIntentionsSetMockRecorder) Labels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Labels", reflect.TypeOf((*MockLabeledServiceIntentionsSet)(nil).Labels))
+}
+
+// Set mocks base method.
+func (m *MockLabeledServiceIntentionsSet) Set() v1alpha1sets.ServiceIntentionsSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set")
+	ret0, _ := ret[0].(v1alpha1sets.ServiceIntentionsSet)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockLabeledServiceIntentionsSetMockRecorder) Set() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockLabeledServiceIntentionsSet)(nil).Set))
+}
+
+// MockBuilder is a mock of Builder interface.
+type MockBuilder struct {
+	ctrl     *gomock.Controller
+	recorder *MockBuilderMockRecorder
+}
+
+// MockBuilderMockRecorder is the mock recorder for MockBuilder.
+type MockBuilderMockRecorder struct {
+	mock *MockBuilder
+}
+
+// NewMockBuilder creates a new mock instance.
+func NewMockBuilder(ctrl *gomock.Controller) *MockBuilder {
+	mock := &MockBuilder{ctrl: ctrl}
+	mock.recorder = &MockBuilderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBuilder) EXPECT() *MockBuilderMockRecorder {
+	return m.recorder
+}
+
+// AddCluster mocks base method.
+func (m *MockBuilder) AddCluster(cluster string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddCluster", cluster)
+}
+
+// AddCluster indicates an expected call of AddCluster.
+func (mr *MockBuilderMockRecorder) AddCluster(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCluster", reflect.TypeOf((*MockBuilder)(nil).AddCluster), cluster)
+}
+
+// AddServiceIntentions mocks base method.
+func (m *MockBuilder) AddServiceIntentions(serviceIntentions ...*v1alpha1.ServiceIntentions) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range serviceIntentions {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "AddServiceIntentions", varargs...)
+}
+
+// AddServiceIntentions indicates an expected call of AddServiceIntentions.
+func (mr *MockBuilderMockRecorder) AddServiceIntentions(serviceIntentions ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceIntentions", reflect.TypeOf((*MockBuilder)(nil).AddServiceIntentions), serviceIntentions...)
+}
+
+// AddServiceRouters mocks base method.
+func (m *MockBuilder) AddServiceRouters(serviceRouters ...*v1alpha1.ServiceRouter) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range serviceRouters {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "AddServiceRouters", varargs...)
+}
+
+// AddServiceRouters indicates an expected call of AddServiceRouters.
+func (mr *MockBuilderMockRecorder) AddServiceRouters(serviceRouters ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceRouters", reflect.TypeOf((*MockBuilder)(nil).AddServiceRouters), serviceRouters...)
+}
+
+// AddServiceSplitters mocks base method.
+func (m *MockBuilder) AddServiceSplitters(serviceSplitters ...*v1alpha1.ServiceSplitter) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range serviceSplitters {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "AddServiceSplitters", varargs...)
+}
+
+// AddServiceSplitters indicates an expected call of AddServiceSplitters.
+func (mr *MockBuilderMockRecorder) AddServiceSplitters(serviceSplitters ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceSplitters", reflect.TypeOf((*MockBuilder)(nil).AddServiceSplitters), serviceSplitters...)
+}
+
+// BuildLabelPartitionedSnapshot mocks base method.
+func (m *MockBuilder) BuildLabelPartitionedSnapshot(labelKey string) (consul.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildLabelPartitionedSnapshot", labelKey)
+	ret0, _ := ret[0].(consul.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildLabelPartitionedSnapshot indicates an expected call of BuildLabelPartitionedSnapshot.
+func (mr *MockBuilderMockRecorder) BuildLabelPartitionedSnapshot(labelKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildLabelPartitionedSnapshot", reflect.TypeOf((*MockBuilder)(nil).BuildLabelPartitionedSnapshot), labelKey)
+}
+
+// BuildSinglePartitionedSnapshot mocks base method.
+func (m *MockBuilder) BuildSinglePartitionedSnapshot(snapshotLabels map[string]string) (consul.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildSinglePartitionedSnapshot", snapshotLabels)
+	ret0, _ := ret[0].(consul.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildSinglePartitionedSnapshot indicates an expected call of BuildSinglePartitionedSnapshot.
+func (mr *MockBuilderMockRecorder) BuildSinglePartitionedSnapshot(snapshotLabels interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildSinglePartitionedSnapshot", reflect.TypeOf((*MockBuilder)(nil).BuildSinglePartitionedSnapshot), snapshotLabels)
+}
+
+// Clone mocks base method.
+func (m *MockBuilder) Clone() consul.Builder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone")
+	ret0, _ := ret[0].(consul.Builder)
+	return ret0
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockBuilderMockRecorder) Clone() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockBuilder)(nil).Clone))
+}
+
+// Clusters mocks base method.
+func (m *MockBuilder) Clusters() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clusters")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Clusters indicates an expected call of Clusters.
+func (mr *MockBuilderMockRecorder) Clusters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clusters", reflect.TypeOf((*MockBuilder)(nil).Clusters))
+}
+
+// Generic mocks base method.
+func (m *MockBuilder) Generic() resource.ClusterSnapshot {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generic")
+	ret0, _ := ret[0].(resource.ClusterSnapshot)
+	return ret0
+}
+
+// Generic indicates an expected call of Generic.
+func (mr *MockBuilderMockRecorder) Generic() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generic", reflect.TypeOf((*MockBuilder)(nil).Generic))
+}
+
+// GetServiceIntentions mocks base method.
+func (m *MockBuilder) GetServiceIntentions() v1alpha1sets.ServiceIntentionsSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceIntentions")
+	ret0, _ := ret[0].(v1alpha1sets.ServiceIntentionsSet)
+	return ret0
+}
+
+// GetServiceIntentions indicates an expected call of GetServiceIntentions.
+func (mr *MockBuilderMockRecorder) GetServiceIntentions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceIntentions", reflect.TypeOf((*MockBuilder)(nil).GetServiceIntentions))
+}
+
+// GetServiceRouters mocks base method.
+func (m *MockBuilder) GetServiceRouters() v1alpha1sets.ServiceRouterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceRouters")
+	ret0, _ := ret[0].(v1alpha1sets.ServiceRouterSet)
+	return ret0
+}
+
+// GetServiceRouters indicates an expected call of GetServiceRouters.
+func (mr *MockBuilderMockRecorder) GetServiceRouters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceRouters", reflect.TypeOf((*MockBuilder)(nil).GetServiceRouters))
+}
+
+// GetServiceSplitters mocks base method.
+func (m *MockBuilder) GetServiceSplitters() v1alpha1sets.ServiceSplitterSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceSplitters")
+	ret0, _ := ret[0].(v1alpha1sets.ServiceSplitterSet)
+	return ret0
+}
+
+// GetServiceSplitters indicates an expected call of GetServiceSplitters.
+func (mr *MockBuilderMockRecorder) GetServiceSplitters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceSplitters", reflect.TypeOf((*MockBuilder)(nil).GetServiceSplitters))
+}
+
+// Merge mocks base method.
+func (m *MockBuilder) Merge(other consul.Builder) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Merge", other)
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockBuilderMockRecorder) Merge(other interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockBuilder)(nil).Merge), other)
+}
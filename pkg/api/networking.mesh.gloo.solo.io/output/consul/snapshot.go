@@ -0,0 +1,790 @@
+// Code generated by skv2. DO NOT EDIT.
+
+//go:generate mockgen -source ./snapshot.go -destination mocks/snapshot.go
+
+// Definitions for Output Snapshots
+package consul
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/pkg/multicluster"
+	"github.com/solo-io/skv2/pkg/resource"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/skv2/contrib/pkg/output"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	consul_hashicorp_com_v1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
+	consul_hashicorp_com_v1alpha1_sets "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1/sets"
+)
+
+// this error can occur if constructing a Partitioned Snapshot from a resource
+// that is missing the partition label
+var MissingRequiredLabelError = func(labelKey, resourceKind string, obj ezkube.ResourceId) error {
+	return eris.Errorf("expected label %v not on labels of %v %v", labelKey, resourceKind, sets.Key(obj))
+}
+
+// SnapshotGVKs is a list of the GVKs included in this snapshot
+var SnapshotGVKs = []schema.GroupVersionKind{
+
+	schema.GroupVersionKind{
+		Group:   "consul.hashicorp.com",
+		Version: "v1alpha1",
+		Kind:    "ServiceSplitter",
+	},
+	schema.GroupVersionKind{
+		Group:   "consul.hashicorp.com",
+		Version: "v1alpha1",
+		Kind:    "ServiceRouter",
+	},
+	schema.GroupVersionKind{
+		Group:   "consul.hashicorp.com",
+		Version: "v1alpha1",
+		Kind:    "ServiceIntentions",
+	},
+}
+
+// the snapshot of output resources produced by a translation
+type Snapshot interface {
+
+	// return the set of ServiceSplitters with a given set of labels
+	ServiceSplitters() []LabeledServiceSplitterSet
+	// return the set of ServiceRouters with a given set of labels
+	ServiceRouters() []LabeledServiceRouterSet
+	// return the set of ServiceIntentions with a given set of labels
+	ServiceIntentions() []LabeledServiceIntentionsSet
+
+	// apply the snapshot to the local cluster, garbage collecting stale resources
+	ApplyLocalCluster(ctx context.Context, clusterClient client.Client, errHandler output.ErrorHandler)
+
+	// apply resources from the snapshot across multiple clusters, garbage collecting stale resources
+	ApplyMultiCluster(ctx context.Context, multiClusterClient multicluster.Client, errHandler output.ErrorHandler)
+
+	// serialize the entire snapshot as JSON
+	MarshalJSON() ([]byte, error)
+}
+
+type snapshot struct {
+	name string
+
+	serviceSplitters  []LabeledServiceSplitterSet
+	serviceRouters    []LabeledServiceRouterSet
+	serviceIntentions []LabeledServiceIntentionsSet
+	clusters          []string
+}
+
+func NewSnapshot(
+	name string,
+
+	serviceSplitters []LabeledServiceSplitterSet,
+	serviceRouters []LabeledServiceRouterSet,
+	serviceIntentions []LabeledServiceIntentionsSet,
+	clusters ...string, // the set of clusters to apply the snapshot to. only required for multicluster snapshots.
+) Snapshot {
+	return &snapshot{
+		name: name,
+
+		serviceSplitters:  serviceSplitters,
+		serviceRouters:    serviceRouters,
+		serviceIntentions: serviceIntentions,
+		clusters:          clusters,
+	}
+}
+
+// automatically partitions the input resources
+// by the presence of the provided label.
+func NewLabelPartitionedSnapshot(
+	name,
+	labelKey string, // the key by which to partition the resources
+
+	serviceSplitters consul_hashicorp_com_v1alpha1_sets.ServiceSplitterSet,
+	serviceRouters consul_hashicorp_com_v1alpha1_sets.ServiceRouterSet,
+	serviceIntentions consul_hashicorp_com_v1alpha1_sets.ServiceIntentionsSet,
+	clusters ...string, // the set of clusters to apply the snapshot to. only required for multicluster snapshots.
+) (Snapshot, error) {
+
+	partitionedServiceSplitters, err := partitionServiceSplittersByLabel(labelKey, serviceSplitters)
+	if err != nil {
+		return nil, err
+	}
+	partitionedServiceRouters, err := partitionServiceRoutersByLabel(labelKey, serviceRouters)
+	if err != nil {
+		return nil, err
+	}
+	partitionedServiceIntentions, err := partitionServiceIntentionsByLabel(labelKey, serviceIntentions)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSnapshot(
+		name,
+
+		partitionedServiceSplitters,
+		partitionedServiceRouters,
+		partitionedServiceIntentions,
+		clusters...,
+	), nil
+}
+
+// simplified constructor for a snapshot
+// with a single label partition (i.e. all resources share a single set of labels).
+func NewSinglePartitionedSnapshot(
+	name string,
+	snapshotLabels map[string]string, // a single set of labels shared by all resources
+
+	serviceSplitters consul_hashicorp_com_v1alpha1_sets.ServiceSplitterSet,
+	serviceRouters consul_hashicorp_com_v1alpha1_sets.ServiceRouterSet,
+	serviceIntentions consul_hashicorp_com_v1alpha1_sets.ServiceIntentionsSet,
+	clusters ...string, // the set of clusters to apply the snapshot to. only required for multicluster snapshots.
+) (Snapshot, error) {
+
+	labeledServiceSplitters, err := NewLabeledServiceSplitterSet(serviceSplitters, snapshotLabels)
+	if err != nil {
+		return nil, err
+	}
+	labeledServiceRouters, err := NewLabeledServiceRouterSet(serviceRouters, snapshotLabels)
+	if err != nil {
+		return nil, err
+	}
+	labeledServiceIntentions, err := NewLabeledServiceIntentionsSet(serviceIntentions, snapshotLabels)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSnapshot(
+		name,
+
+		[]LabeledServiceSplitterSet{labeledServiceSplitters},
+		[]LabeledServiceRouterSet{labeledServiceRouters},
+		[]LabeledServiceIntentionsSet{labeledServiceIntentions},
+		clusters...,
+	), nil
+}
+
+// apply the desired resources to the cluster state; remove stale resources where necessary
+func (s *snapshot) ApplyLocalCluster(ctx context.Context, cli client.Client, errHandler output.ErrorHandler) {
+	var genericLists []output.ResourceList
+
+	for _, outputSet := range s.serviceSplitters {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
+	for _, outputSet := range s.serviceRouters {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
+	for _, outputSet := range s.serviceIntentions {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
+
+	output.Snapshot{
+		Name:        s.name,
+		ListsToSync: genericLists,
+	}.SyncLocalCluster(ctx, cli, errHandler)
+}
+
+// apply the desired resources to multiple cluster states; remove stale resources where necessary
+func (s *snapshot) ApplyMultiCluster(ctx context.Context, multiClusterClient multicluster.Client, errHandler output.ErrorHandler) {
+	var genericLists []output.ResourceList
+
+	for _, outputSet := range s.serviceSplitters {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
+	for _, outputSet := range s.serviceRouters {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
+	for _, outputSet := range s.serviceIntentions {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
+
+	output.Snapshot{
+		Name:        s.name,
+		Clusters:    s.clusters,
+		ListsToSync: genericLists,
+	}.SyncMultiCluster(ctx, multiClusterClient, errHandler)
+}
+
+func partitionServiceSplittersByLabel(labelKey string, set consul_hashicorp_com_v1alpha1_sets.ServiceSplitterSet) ([]LabeledServiceSplitterSet, error) {
+	setsByLabel := map[string]consul_hashicorp_com_v1alpha1_sets.ServiceSplitterSet{}
+
+	for _, obj := range set.List() {
+		if obj.Labels == nil {
+			return nil, MissingRequiredLabelError(labelKey, "ServiceSplitter", obj)
+		}
+		labelValue := obj.Labels[labelKey]
+		if labelValue == "" {
+			return nil, MissingRequiredLabelError(labelKey, "ServiceSplitter", obj)
+		}
+
+		setForValue, ok := setsByLabel[labelValue]
+		if !ok {
+			setForValue = consul_hashicorp_com_v1alpha1_sets.NewServiceSplitterSet()
+			setsByLabel[labelValue] = setForValue
+		}
+		setForValue.Insert(obj)
+	}
+
+	// partition by label key
+	var partitionedServiceSplitters []LabeledServiceSplitterSet
+
+	for labelValue, setForValue := range setsByLabel {
+		labels := map[string]string{labelKey: labelValue}
+
+		partitionedSet, err := NewLabeledServiceSplitterSet(setForValue, labels)
+		if err != nil {
+			return nil, err
+		}
+
+		partitionedServiceSplitters = append(partitionedServiceSplitters, partitionedSet)
+	}
+
+	// sort for idempotency
+	sort.SliceStable(partitionedServiceSplitters, func(i, j int) bool {
+		leftLabelValue := partitionedServiceSplitters[i].Labels()[labelKey]
+		rightLabelValue := partitionedServiceSplitters[j].Labels()[labelKey]
+		return leftLabelValue < rightLabelValue
+	})
+
+	return partitionedServiceSplitters, nil
+}
+
+func partitionServiceRoutersByLabel(labelKey string, set consul_hashicorp_com_v1alpha1_sets.ServiceRouterSet) ([]LabeledServiceRouterSet, error) {
+	setsByLabel := map[string]consul_hashicorp_com_v1alpha1_sets.ServiceRouterSet{}
+
+	for _, obj := range set.List() {
+		if obj.Labels == nil {
+			return nil, MissingRequiredLabelError(labelKey, "ServiceRouter", obj)
+		}
+		labelValue := obj.Labels[labelKey]
+		if labelValue == "" {
+			return nil, MissingRequiredLabelError(labelKey, "ServiceRouter", obj)
+		}
+
+		setForValue, ok := setsByLabel[labelValue]
+		if !ok {
+			setForValue = consul_hashicorp_com_v1alpha1_sets.NewServiceRouterSet()
+			setsByLabel[labelValue] = setForValue
+		}
+		setForValue.Insert(obj)
+	}
+
+	// partition by label key
+	var partitionedServiceRouters []LabeledServiceRouterSet
+
+	for labelValue, setForValue := range setsByLabel {
+		labels := map[string]string{labelKey: labelValue}
+
+		partitionedSet, err := NewLabeledServiceRouterSet(setForValue, labels)
+		if err != nil {
+			return nil, err
+		}
+
+		partitionedServiceRouters = append(partitionedServiceRouters, partitionedSet)
+	}
+
+	// sort for idempotency
+	sort.SliceStable(partitionedServiceRouters, func(i, j int) bool {
+		leftLabelValue := partitionedServiceRouters[i].Labels()[labelKey]
+		rightLabelValue := partitionedServiceRouters[j].Labels()[labelKey]
+		return leftLabelValue < rightLabelValue
+	})
+
+	return partitionedServiceRouters, nil
+}
+
+func partitionServiceIntentionsByLabel(labelKey string, set consul_hashicorp_com_v1alpha1_sets.ServiceIntentionsSet) ([]LabeledServiceIntentionsSet, error) {
+	setsByLabel := map[string]consul_hashicorp_com_v1alpha1_sets.ServiceIntentionsSet{}
+
+	for _, obj := range set.List() {
+		if obj.Labels == nil {
+			return nil, MissingRequiredLabelError(labelKey, "ServiceIntentions", obj)
+		}
+		labelValue := obj.Labels[labelKey]
+		if labelValue == "" {
+			return nil, MissingRequiredLabelError(labelKey, "ServiceIntentions", obj)
+		}
+
+		setForValue, ok := setsByLabel[labelValue]
+		if !ok {
+			setForValue = consul_hashicorp_com_v1alpha1_sets.NewServiceIntentionsSet()
+			setsByLabel[labelValue] = setForValue
+		}
+		setForValue.Insert(obj)
+	}
+
+	// partition by label key
+	var partitionedServiceIntentions []LabeledServiceIntentionsSet
+
+	for labelValue, setForValue := range setsByLabel {
+		labels := map[string]string{labelKey: labelValue}
+
+		partitionedSet, err := NewLabeledServiceIntentionsSet(setForValue, labels)
+		if err != nil {
+			return nil, err
+		}
+
+		partitionedServiceIntentions = append(partitionedServiceIntentions, partitionedSet)
+	}
+
+	// sort for idempotency
+	sort.SliceStable(partitionedServiceIntentions, func(i, j int) bool {
+		leftLabelValue := partitionedServiceIntentions[i].Labels()[labelKey]
+		rightLabelValue := partitionedServiceIntentions[j].Labels()[labelKey]
+		return leftLabelValue < rightLabelValue
+	})
+
+	return partitionedServiceIntentions, nil
+}
+
+func (s snapshot) ServiceSplitters() []LabeledServiceSplitterSet {
+	return s.serviceSplitters
+}
+
+func (s snapshot) ServiceRouters() []LabeledServiceRouterSet {
+	return s.serviceRouters
+}
+
+func (s snapshot) ServiceIntentions() []LabeledServiceIntentionsSet {
+	return s.serviceIntentions
+}
+
+func (s snapshot) MarshalJSON() ([]byte, error) {
+	snapshotMap := map[string]interface{}{"name": s.name}
+
+	serviceSplitterSet := consul_hashicorp_com_v1alpha1_sets.NewServiceSplitterSet()
+	for _, set := range s.serviceSplitters {
+		serviceSplitterSet = serviceSplitterSet.Union(set.Set())
+	}
+	snapshotMap["serviceSplitters"] = serviceSplitterSet.List()
+	serviceRouterSet := consul_hashicorp_com_v1alpha1_sets.NewServiceRouterSet()
+	for _, set := range s.serviceRouters {
+		serviceRouterSet = serviceRouterSet.Union(set.Set())
+	}
+	snapshotMap["serviceRouters"] = serviceRouterSet.List()
+	serviceIntentionsSet := consul_hashicorp_com_v1alpha1_sets.NewServiceIntentionsSet()
+	for _, set := range s.serviceIntentions {
+		serviceIntentionsSet = serviceIntentionsSet.Union(set.Set())
+	}
+	snapshotMap["serviceIntentions"] = serviceIntentionsSet.List()
+
+	snapshotMap["clusters"] = s.clusters
+
+	return json.Marshal(snapshotMap)
+}
+
+// LabeledServiceSplitterSet represents a set of serviceSplitters
+// which share a common set of labels.
+// These labels are used to find diffs between ServiceSplitterSets.
+type LabeledServiceSplitterSet interface {
+	// returns the set of Labels shared by this ServiceSplitterSet
+	Labels() map[string]string
+
+	// returns the set of ServiceSplitteres with the given labels
+	Set() consul_hashicorp_com_v1alpha1_sets.ServiceSplitterSet
+
+	// converts the set to a generic format which can be applied by the Snapshot.Apply functions
+	Generic() output.ResourceList
+}
+
+type labeledServiceSplitterSet struct {
+	set    consul_hashicorp_com_v1alpha1_sets.ServiceSplitterSet
+	labels map[string]string
+}
+
+func NewLabeledServiceSplitterSet(set consul_hashicorp_com_v1alpha1_sets.ServiceSplitterSet, labels map[string]string) (LabeledServiceSplitterSet, error) {
+	// validate that each ServiceSplitter contains the labels, else this is not a valid LabeledServiceSplitterSet
+	for _, item := range set.List() {
+		for k, v := range labels {
+			// k=v must be present in the item
+			if item.Labels[k] != v {
+				return nil, eris.Errorf("internal error: %v=%v missing on ServiceSplitter %v", k, v, item.Name)
+			}
+		}
+	}
+
+	return &labeledServiceSplitterSet{set: set, labels: labels}, nil
+}
+
+func (l *labeledServiceSplitterSet) Labels() map[string]string {
+	return l.labels
+}
+
+func (l *labeledServiceSplitterSet) Set() consul_hashicorp_com_v1alpha1_sets.ServiceSplitterSet {
+	return l.set
+}
+
+func (l labeledServiceSplitterSet) Generic() output.ResourceList {
+	var desiredResources []ezkube.Object
+	for _, desired := range l.set.List() {
+		desiredResources = append(desiredResources, desired)
+	}
+
+	// enable list func for garbage collection
+	listFunc := func(ctx context.Context, cli client.Client) ([]ezkube.Object, error) {
+		var list consul_hashicorp_com_v1alpha1.ServiceSplitterList
+		if err := cli.List(ctx, &list, client.MatchingLabels(l.labels)); err != nil {
+			return nil, err
+		}
+		var items []ezkube.Object
+		for _, item := range list.Items {
+			item := item // pike
+			items = append(items, &item)
+		}
+		return items, nil
+	}
+
+	return output.ResourceList{
+		Resources:    desiredResources,
+		ListFunc:     listFunc,
+		ResourceKind: "ServiceSplitter",
+	}
+}
+
+// LabeledServiceRouterSet represents a set of serviceRouters
+// which share a common set of labels.
+// These labels are used to find diffs between ServiceRouterSets.
+type LabeledServiceRouterSet interface {
+	// returns the set of Labels shared by this ServiceRouterSet
+	Labels() map[string]string
+
+	// returns the set of ServiceRouteres with the given labels
+	Set() consul_hashicorp_com_v1alpha1_sets.ServiceRouterSet
+
+	// converts the set to a generic format which can be applied by the Snapshot.Apply functions
+	Generic() output.ResourceList
+}
+
+type labeledServiceRouterSet struct {
+	set    consul_hashicorp_com_v1alpha1_sets.ServiceRouterSet
+	labels map[string]string
+}
+
+func NewLabeledServiceRouterSet(set consul_hashicorp_com_v1alpha1_sets.ServiceRouterSet, labels map[string]string) (LabeledServiceRouterSet, error) {
+	// validate that each ServiceRouter contains the labels, else this is not a valid LabeledServiceRouterSet
+	for _, item := range set.List() {
+		for k, v := range labels {
+			// k=v must be present in the item
+			if item.Labels[k] != v {
+				return nil, eris.Errorf("internal error: %v=%v missing on ServiceRouter %v", k, v, item.Name)
+			}
+		}
+	}
+
+	return &labeledServiceRouterSet{set: set, labels: labels}, nil
+}
+
+func (l *labeledServiceRouterSet) Labels() map[string]string {
+	return l.labels
+}
+
+func (l *labeledServiceRouterSet) Set() consul_hashicorp_com_v1alpha1_sets.ServiceRouterSet {
+	return l.set
+}
+
+func (l labeledServiceRouterSet) Generic() output.ResourceList {
+	var desiredResources []ezkube.Object
+	for _, desired := range l.set.List() {
+		desiredResources = append(desiredResources, desired)
+	}
+
+	// enable list func for garbage collection
+	listFunc := func(ctx context.Context, cli client.Client) ([]ezkube.Object, error) {
+		var list consul_hashicorp_com_v1alpha1.ServiceRouterList
+		if err := cli.List(ctx, &list, client.MatchingLabels(l.labels)); err != nil {
+			return nil, err
+		}
+		var items []ezkube.Object
+		for _, item := range list.Items {
+			item := item // pike
+			items = append(items, &item)
+		}
+		return items, nil
+	}
+
+	return output.ResourceList{
+		Resources:    desiredResources,
+		ListFunc:     listFunc,
+		ResourceKind: "ServiceRouter",
+	}
+}
+
+// LabeledServiceIntentionsSet represents a set of serviceIntentions
+// which share a common set of labels.
+// These labels are used to find diffs between ServiceIntentionsSets.
+type LabeledServiceIntentionsSet interface {
+	// returns the set of Labels shared by this ServiceIntentionsSet
+	Labels() map[string]string
+
+	// returns the set of ServiceIntentionses with the given labels
+	Set() consul_hashicorp_com_v1alpha1_sets.ServiceIntentionsSet
+
+	// converts the set to a generic format which can be applied by the Snapshot.Apply functions
+	Generic() output.ResourceList
+}
+
+type labeledServiceIntentionsSet struct {
+	set    consul_hashicorp_com_v1alpha1_sets.ServiceIntentionsSet
+	labels map[string]string
+}
+
+func NewLabeledServiceIntentionsSet(set consul_hashicorp_com_v1alpha1_sets.ServiceIntentionsSet, labels map[string]string) (LabeledServiceIntentionsSet, error) {
+	// validate that each ServiceIntentions contains the labels, else this is not a valid LabeledServiceIntentionsSet
+	for _, item := range set.List() {
+		for k, v := range labels {
+			// k=v must be present in the item
+			if item.Labels[k] != v {
+				return nil, eris.Errorf("internal error: %v=%v missing on ServiceIntentions %v", k, v, item.Name)
+			}
+		}
+	}
+
+	return &labeledServiceIntentionsSet{set: set, labels: labels}, nil
+}
+
+func (l *labeledServiceIntentionsSet) Labels() map[string]string {
+	return l.labels
+}
+
+func (l *labeledServiceIntentionsSet) Set() consul_hashicorp_com_v1alpha1_sets.ServiceIntentionsSet {
+	return l.set
+}
+
+func (l labeledServiceIntentionsSet) Generic() output.ResourceList {
+	var desiredResources []ezkube.Object
+	for _, desired := range l.set.List() {
+		desiredResources = append(desiredResources, desired)
+	}
+
+	// enable list func for garbage collection
+	listFunc := func(ctx context.Context, cli client.Client) ([]ezkube.Object, error) {
+		var list consul_hashicorp_com_v1alpha1.ServiceIntentionsList
+		if err := cli.List(ctx, &list, client.MatchingLabels(l.labels)); err != nil {
+			return nil, err
+		}
+		var items []ezkube.Object
+		for _, item := range list.Items {
+			item := item // pike
+			items = append(items, &item)
+		}
+		return items, nil
+	}
+
+	return output.ResourceList{
+		Resources:    desiredResources,
+		ListFunc:     listFunc,
+		ResourceKind: "ServiceIntentions",
+	}
+}
+
+type builder struct {
+	ctx      context.Context
+	name     string
+	clusters []string
+
+	serviceSplitters  consul_hashicorp_com_v1alpha1_sets.ServiceSplitterSet
+	serviceRouters    consul_hashicorp_com_v1alpha1_sets.ServiceRouterSet
+	serviceIntentions consul_hashicorp_com_v1alpha1_sets.ServiceIntentionsSet
+}
+
+func NewBuilder(ctx context.Context, name string) *builder {
+	return &builder{
+		ctx:  ctx,
+		name: name,
+
+		serviceSplitters:  consul_hashicorp_com_v1alpha1_sets.NewServiceSplitterSet(),
+		serviceRouters:    consul_hashicorp_com_v1alpha1_sets.NewServiceRouterSet(),
+		serviceIntentions: consul_hashicorp_com_v1alpha1_sets.NewServiceIntentionsSet(),
+	}
+}
+
+// the output Builder uses a builder pattern to allow
+// iteratively collecting outputs before producing a final snapshot
+type Builder interface {
+
+	// add ServiceSplitters to the collected outputs
+	AddServiceSplitters(serviceSplitters ...*consul_hashicorp_com_v1alpha1.ServiceSplitter)
+
+	// get the collected ServiceSplitters
+	GetServiceSplitters() consul_hashicorp_com_v1alpha1_sets.ServiceSplitterSet
+
+	// add ServiceRouters to the collected outputs
+	AddServiceRouters(serviceRouters ...*consul_hashicorp_com_v1alpha1.ServiceRouter)
+
+	// get the collected ServiceRouters
+	GetServiceRouters() consul_hashicorp_com_v1alpha1_sets.ServiceRouterSet
+
+	// add ServiceIntentions to the collected outputs
+	AddServiceIntentions(serviceIntentions ...*consul_hashicorp_com_v1alpha1.ServiceIntentions)
+
+	// get the collected ServiceIntentions
+	GetServiceIntentions() consul_hashicorp_com_v1alpha1_sets.ServiceIntentionsSet
+
+	// build the collected outputs into a label-partitioned snapshot
+	BuildLabelPartitionedSnapshot(labelKey string) (Snapshot, error)
+
+	// build the collected outputs into a snapshot with a single partition
+	BuildSinglePartitionedSnapshot(snapshotLabels map[string]string) (Snapshot, error)
+
+	// add a cluster to the collected clusters.
+	// this can be used to collect clusters for use with MultiCluster snapshots.
+	AddCluster(cluster string)
+
+	// returns the set of clusters currently stored in this builder
+	Clusters() []string
+
+	// merge all the resources from another Builder into this one
+	Merge(other Builder)
+
+	// create a clone of this builder (deepcopying all resources)
+	Clone() Builder
+
+	// convert this snapshot to its generic form
+	Generic() resource.ClusterSnapshot
+}
+
+func (b *builder) AddServiceSplitters(serviceSplitters ...*consul_hashicorp_com_v1alpha1.ServiceSplitter) {
+	for _, obj := range serviceSplitters {
+		if obj == nil {
+			continue
+		}
+		contextutils.LoggerFrom(b.ctx).Debugf("added output ServiceSplitter %v", sets.Key(obj))
+		b.serviceSplitters.Insert(obj)
+	}
+}
+func (b *builder) AddServiceRouters(serviceRouters ...*consul_hashicorp_com_v1alpha1.ServiceRouter) {
+	for _, obj := range serviceRouters {
+		if obj == nil {
+			continue
+		}
+		contextutils.LoggerFrom(b.ctx).Debugf("added output ServiceRouter %v", sets.Key(obj))
+		b.serviceRouters.Insert(obj)
+	}
+}
+func (b *builder) AddServiceIntentions(serviceIntentions ...*consul_hashicorp_com_v1alpha1.ServiceIntentions) {
+	for _, obj := range serviceIntentions {
+		if obj == nil {
+			continue
+		}
+		contextutils.LoggerFrom(b.ctx).Debugf("added output ServiceIntentions %v", sets.Key(obj))
+		b.serviceIntentions.Insert(obj)
+	}
+}
+
+func (b *builder) GetServiceSplitters() consul_hashicorp_com_v1alpha1_sets.ServiceSplitterSet {
+	return b.serviceSplitters
+}
+func (b *builder) GetServiceRouters() consul_hashicorp_com_v1alpha1_sets.ServiceRouterSet {
+	return b.serviceRouters
+}
+func (b *builder) GetServiceIntentions() consul_hashicorp_com_v1alpha1_sets.ServiceIntentionsSet {
+	return b.serviceIntentions
+}
+
+func (b *builder) BuildLabelPartitionedSnapshot(labelKey string) (Snapshot, error) {
+	return NewLabelPartitionedSnapshot(
+		b.name,
+		labelKey,
+
+		b.serviceSplitters,
+		b.serviceRouters,
+		b.serviceIntentions,
+		b.clusters...,
+	)
+}
+
+func (b *builder) BuildSinglePartitionedSnapshot(snapshotLabels map[string]string) (Snapshot, error) {
+	return NewSinglePartitionedSnapshot(
+		b.name,
+		snapshotLabels,
+
+		b.serviceSplitters,
+		b.serviceRouters,
+		b.serviceIntentions,
+		b.clusters...,
+	)
+}
+
+func (b *builder) AddCluster(cluster string) {
+	b.clusters = append(b.clusters, cluster)
+}
+
+func (b *builder) Clusters() []string {
+	return b.clusters
+}
+
+func (b *builder) Merge(other Builder) {
+	if other == nil {
+		return
+	}
+
+	b.AddServiceSplitters(other.GetServiceSplitters().List()...)
+	b.AddServiceRouters(other.GetServiceRouters().List()...)
+	b.AddServiceIntentions(other.GetServiceIntentions().List()...)
+	for _, cluster := range other.Clusters() {
+		b.AddCluster(cluster)
+	}
+}
+
+func (b *builder) Clone() Builder {
+	if b == nil {
+		return nil
+	}
+	clone := NewBuilder(b.ctx, b.name)
+
+	for _, serviceSplitter := range b.GetServiceSplitters().List() {
+		clone.AddServiceSplitters(serviceSplitter.DeepCopy())
+	}
+	for _, serviceRouter := range b.GetServiceRouters().List() {
+		clone.AddServiceRouters(serviceRouter.DeepCopy())
+	}
+	for _, serviceIntentions := range b.GetServiceIntentions().List() {
+		clone.AddServiceIntentions(serviceIntentions.DeepCopy())
+	}
+	for _, cluster := range b.Clusters() {
+		clone.AddCluster(cluster)
+	}
+	return clone
+}
+
+// convert this snapshot to its generic form
+func (b *builder) Generic() resource.ClusterSnapshot {
+	if b == nil {
+		return nil
+	}
+	clusterSnapshots := resource.ClusterSnapshot{}
+
+	for _, obj := range b.GetServiceSplitters().List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "consul.hashicorp.com",
+			Version: "v1alpha1",
+			Kind:    "ServiceSplitter",
+		}
+		clusterSnapshots.Insert(cluster, gvk, obj)
+	}
+	for _, obj := range b.GetServiceRouters().List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "consul.hashicorp.com",
+			Version: "v1alpha1",
+			Kind:    "ServiceRouter",
+		}
+		clusterSnapshots.Insert(cluster, gvk, obj)
+	}
+	for _, obj := range b.GetServiceIntentions().List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "consul.hashicorp.com",
+			Version: "v1alpha1",
+			Kind:    "ServiceIntentions",
+		}
+		clusterSnapshots.Insert(cluster, gvk, obj)
+	}
+
+	return clusterSnapshots
+}
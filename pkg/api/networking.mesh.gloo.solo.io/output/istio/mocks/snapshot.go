@@ -10,9 +10,9 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	v1alpha3sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
-	v1beta1sets "github.com/solo-io/external-apis/pkg/api/istio/security.istio.io/v1beta1/sets"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	v1sets "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1/sets"
+	v1beta1sets "github.com/solo-io/gloo-mesh/pkg/api/external/security.istio.io/v1beta1/sets"
 	istio "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
 	v1beta1 "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1"
 	v1beta1sets0 "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1/sets"
@@ -170,6 +170,20 @@ func (mr *MockSnapshotMockRecorder) PodBounceDirectives() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodBounceDirectives", reflect.TypeOf((*MockSnapshot)(nil).PodBounceDirectives))
 }
 
+// RequestAuthentications mocks base method.
+func (m *MockSnapshot) RequestAuthentications() []istio.LabeledRequestAuthenticationSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestAuthentications")
+	ret0, _ := ret[0].([]istio.LabeledRequestAuthenticationSet)
+	return ret0
+}
+
+// RequestAuthentications indicates an expected call of RequestAuthentications.
+func (mr *MockSnapshotMockRecorder) RequestAuthentications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestAuthentications", reflect.TypeOf((*MockSnapshot)(nil).RequestAuthentications))
+}
+
 // ServiceEntries mocks base method.
 func (m *MockSnapshot) ServiceEntries() []istio.LabeledServiceEntrySet {
 	m.ctrl.T.Helper()
@@ -421,6 +435,136 @@ func (mr *MockLabeledXdsConfigSetMockRecorder) Set() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockLabeledXdsConfigSet)(nil).Set))
 }
 
+// MockLabeledAuthorizationPolicySet is a mock of LabeledAuthorizationPolicySet interface.
+type MockLabeledAuthorizationPolicySet struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabeledAuthorizationPolicySetMockRecorder
+}
+
+// MockLabeledAuthorizationPolicySetMockRecorder is the mock recorder for MockLabeledAuthorizationPolicySet.
+type MockLabeledAuthorizationPolicySetMockRecorder struct {
+	mock *MockLabeledAuthorizationPolicySet
+}
+
+// NewMockLabeledAuthorizationPolicySet creates a new mock instance.
+func NewMockLabeledAuthorizationPolicySet(ctrl *gomock.Controller) *MockLabeledAuthorizationPolicySet {
+	mock := &MockLabeledAuthorizationPolicySet{ctrl: ctrl}
+	mock.recorder = &MockLabeledAuthorizationPolicySetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabeledAuthorizationPolicySet) EXPECT() *MockLabeledAuthorizationPolicySetMockRecorder {
+	return m.recorder
+}
+
+// Generic mocks base method.
+func (m *MockLabeledAuthorizationPolicySet) Generic() output.ResourceList {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generic")
+	ret0, _ := ret[0].(output.ResourceList)
+	return ret0
+}
+
+// Generic indicates an expected call of Generic.
+func (mr *MockLabeledAuthorizationPolicySetMockRecorder) Generic() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generic", reflect.TypeOf((*MockLabeledAuthorizationPolicySet)(nil).Generic))
+}
+
+// Labels mocks base method.
+func (m *MockLabeledAuthorizationPolicySet) Labels() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Labels")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// Labels indicates an expected call of Labels.
+func (mr *MockLabeledAuthorizationPolicySetMockRecorder) Labels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Labels", reflect.TypeOf((*MockLabeledAuthorizationPolicySet)(nil).Labels))
+}
+
+// Set mocks base method.
+func (m *MockLabeledAuthorizationPolicySet) Set() v1beta1sets.AuthorizationPolicySet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set")
+	ret0, _ := ret[0].(v1beta1sets.AuthorizationPolicySet)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockLabeledAuthorizationPolicySetMockRecorder) Set() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockLabeledAuthorizationPolicySet)(nil).Set))
+}
+
+// MockLabeledRequestAuthenticationSet is a mock of LabeledRequestAuthenticationSet interface.
+type MockLabeledRequestAuthenticationSet struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabeledRequestAuthenticationSetMockRecorder
+}
+
+// MockLabeledRequestAuthenticationSetMockRecorder is the mock recorder for MockLabeledRequestAuthenticationSet.
+type MockLabeledRequestAuthenticationSetMockRecorder struct {
+	mock *MockLabeledRequestAuthenticationSet
+}
+
+// NewMockLabeledRequestAuthenticationSet creates a new mock instance.
+func NewMockLabeledRequestAuthenticationSet(ctrl *gomock.Controller) *MockLabeledRequestAuthenticationSet {
+	mock := &MockLabeledRequestAuthenticationSet{ctrl: ctrl}
+	mock.recorder = &MockLabeledRequestAuthenticationSetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabeledRequestAuthenticationSet) EXPECT() *MockLabeledRequestAuthenticationSetMockRecorder {
+	return m.recorder
+}
+
+// Generic mocks base method.
+func (m *MockLabeledRequestAuthenticationSet) Generic() output.ResourceList {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generic")
+	ret0, _ := ret[0].(output.ResourceList)
+	return ret0
+}
+
+// Generic indicates an expected call of Generic.
+func (mr *MockLabeledRequestAuthenticationSetMockRecorder) Generic() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generic", reflect.TypeOf((*MockLabeledRequestAuthenticationSet)(nil).Generic))
+}
+
+// Labels mocks base method.
+func (m *MockLabeledRequestAuthenticationSet) Labels() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Labels")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// Labels indicates an expected call of Labels.
+func (mr *MockLabeledRequestAuthenticationSetMockRecorder) Labels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Labels", reflect.TypeOf((*MockLabeledRequestAuthenticationSet)(nil).Labels))
+}
+
+// Set mocks base method.
+func (m *MockLabeledRequestAuthenticationSet) Set() v1beta1sets.RequestAuthenticationSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set")
+	ret0, _ := ret[0].(v1beta1sets.RequestAuthenticationSet)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockLabeledRequestAuthenticationSetMockRecorder) Set() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockLabeledRequestAuthenticationSet)(nil).Set))
+}
+
 // MockLabeledDestinationRuleSet is a mock of LabeledDestinationRuleSet interface.
 type MockLabeledDestinationRuleSet struct {
 	ctrl     *gomock.Controller
@@ -811,71 +955,6 @@ func (mr *MockLabeledSidecarSetMockRecorder) Set() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockLabeledSidecarSet)(nil).Set))
 }
 
-// MockLabeledAuthorizationPolicySet is a mock of LabeledAuthorizationPolicySet interface.
-type MockLabeledAuthorizationPolicySet struct {
-	ctrl     *gomock.Controller
-	recorder *MockLabeledAuthorizationPolicySetMockRecorder
-}
-
-// MockLabeledAuthorizationPolicySetMockRecorder is the mock recorder for MockLabeledAuthorizationPolicySet.
-type MockLabeledAuthorizationPolicySetMockRecorder struct {
-	mock *MockLabeledAuthorizationPolicySet
-}
-
-// NewMockLabeledAuthorizationPolicySet creates a new mock instance.
-func NewMockLabeledAuthorizationPolicySet(ctrl *gomock.Controller) *MockLabeledAuthorizationPolicySet {
-	mock := &MockLabeledAuthorizationPolicySet{ctrl: ctrl}
-	mock.recorder = &MockLabeledAuthorizationPolicySetMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockLabeledAuthorizationPolicySet) EXPECT() *MockLabeledAuthorizationPolicySetMockRecorder {
-	return m.recorder
-}
-
-// Generic mocks base method.
-func (m *MockLabeledAuthorizationPolicySet) Generic() output.ResourceList {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Generic")
-	ret0, _ := ret[0].(output.ResourceList)
-	return ret0
-}
-
-// Generic indicates an expected call of Generic.
-func (mr *MockLabeledAuthorizationPolicySetMockRecorder) Generic() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generic", reflect.TypeOf((*MockLabeledAuthorizationPolicySet)(nil).Generic))
-}
-
-// Labels mocks base method.
-func (m *MockLabeledAuthorizationPolicySet) Labels() map[string]string {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Labels")
-	ret0, _ := ret[0].(map[string]string)
-	return ret0
-}
-
-// Labels indicates an expected call of Labels.
-func (mr *MockLabeledAuthorizationPolicySetMockRecorder) Labels() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Labels", reflect.TypeOf((*MockLabeledAuthorizationPolicySet)(nil).Labels))
-}
-
-// Set mocks base method.
-func (m *MockLabeledAuthorizationPolicySet) Set() v1beta1sets.AuthorizationPolicySet {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Set")
-	ret0, _ := ret[0].(v1beta1sets.AuthorizationPolicySet)
-	return ret0
-}
-
-// Set indicates an expected call of Set.
-func (mr *MockLabeledAuthorizationPolicySetMockRecorder) Set() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockLabeledAuthorizationPolicySet)(nil).Set))
-}
-
 // MockBuilder is a mock of Builder interface.
 type MockBuilder struct {
 	ctrl     *gomock.Controller
@@ -1007,6 +1086,22 @@ func (mr *MockBuilderMockRecorder) AddPodBounceDirectives(podBounceDirectives ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPodBounceDirectives", reflect.TypeOf((*MockBuilder)(nil).AddPodBounceDirectives), podBounceDirectives...)
 }
 
+// AddRequestAuthentications mocks base method.
+func (m *MockBuilder) AddRequestAuthentications(requestAuthentications ...*v1beta10.RequestAuthentication) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range requestAuthentications {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "AddRequestAuthentications", varargs...)
+}
+
+// AddRequestAuthentications indicates an expected call of AddRequestAuthentications.
+func (mr *MockBuilderMockRecorder) AddRequestAuthentications(requestAuthentications ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRequestAuthentications", reflect.TypeOf((*MockBuilder)(nil).AddRequestAuthentications), requestAuthentications...)
+}
+
 // AddServiceEntries mocks base method.
 func (m *MockBuilder) AddServiceEntries(serviceEntries ...*v1alpha3.ServiceEntry) {
 	m.ctrl.T.Helper()
@@ -1227,6 +1322,20 @@ func (mr *MockBuilderMockRecorder) GetPodBounceDirectives() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodBounceDirectives", reflect.TypeOf((*MockBuilder)(nil).GetPodBounceDirectives))
 }
 
+// GetRequestAuthentications mocks base method.
+func (m *MockBuilder) GetRequestAuthentications() v1beta1sets.RequestAuthenticationSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestAuthentications")
+	ret0, _ := ret[0].(v1beta1sets.RequestAuthenticationSet)
+	return ret0
+}
+
+// GetRequestAuthentications indicates an expected call of GetRequestAuthentications.
+func (mr *MockBuilderMockRecorder) GetRequestAuthentications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestAuthentications", reflect.TypeOf((*MockBuilder)(nil).GetRequestAuthentications))
+}
+
 // GetServiceEntries mocks base method.
 func (m *MockBuilder) GetServiceEntries() v1alpha3sets.ServiceEntrySet {
 	m.ctrl.T.Helper()
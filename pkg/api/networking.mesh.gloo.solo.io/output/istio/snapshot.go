@@ -27,11 +27,11 @@ import (
 	xds_agent_enterprise_mesh_gloo_solo_io_v1beta1 "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1"
 	xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1/sets"
 
+	security_istio_io_v1beta1_sets "github.com/solo-io/gloo-mesh/pkg/api/external/security.istio.io/v1beta1/sets"
+	security_istio_io_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+
 	networking_istio_io_v1alpha3_sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
 	networking_istio_io_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
-
-	security_istio_io_v1beta1_sets "github.com/solo-io/external-apis/pkg/api/istio/security.istio.io/v1beta1/sets"
-	security_istio_io_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
 )
 
 // this error can occur if constructing a Partitioned Snapshot from a resource
@@ -60,6 +60,17 @@ var SnapshotGVKs = []schema.GroupVersionKind{
 		Kind:    "XdsConfig",
 	},
 
+	schema.GroupVersionKind{
+		Group:   "security.istio.io",
+		Version: "v1beta1",
+		Kind:    "AuthorizationPolicy",
+	},
+	schema.GroupVersionKind{
+		Group:   "security.istio.io",
+		Version: "v1beta1",
+		Kind:    "RequestAuthentication",
+	},
+
 	schema.GroupVersionKind{
 		Group:   "networking.istio.io",
 		Version: "v1alpha3",
@@ -90,12 +101,6 @@ var SnapshotGVKs = []schema.GroupVersionKind{
 		Version: "v1alpha3",
 		Kind:    "Sidecar",
 	},
-
-	schema.GroupVersionKind{
-		Group:   "security.istio.io",
-		Version: "v1beta1",
-		Kind:    "AuthorizationPolicy",
-	},
 }
 
 // the snapshot of output resources produced by a translation
@@ -107,6 +112,10 @@ type Snapshot interface {
 	PodBounceDirectives() []LabeledPodBounceDirectiveSet
 	// return the set of XdsConfigs with a given set of labels
 	XdsConfigs() []LabeledXdsConfigSet
+	// return the set of AuthorizationPolicies with a given set of labels
+	AuthorizationPolicies() []LabeledAuthorizationPolicySet
+	// return the set of RequestAuthentications with a given set of labels
+	RequestAuthentications() []LabeledRequestAuthenticationSet
 	// return the set of DestinationRules with a given set of labels
 	DestinationRules() []LabeledDestinationRuleSet
 	// return the set of EnvoyFilters with a given set of labels
@@ -119,8 +128,6 @@ type Snapshot interface {
 	VirtualServices() []LabeledVirtualServiceSet
 	// return the set of Sidecars with a given set of labels
 	Sidecars() []LabeledSidecarSet
-	// return the set of AuthorizationPolicies with a given set of labels
-	AuthorizationPolicies() []LabeledAuthorizationPolicySet
 
 	// apply the snapshot to the local cluster, garbage collecting stale resources
 	ApplyLocalCluster(ctx context.Context, clusterClient client.Client, errHandler output.ErrorHandler)
@@ -135,17 +142,18 @@ type Snapshot interface {
 type snapshot struct {
 	name string
 
-	issuedCertificates    []LabeledIssuedCertificateSet
-	podBounceDirectives   []LabeledPodBounceDirectiveSet
-	xdsConfigs            []LabeledXdsConfigSet
-	destinationRules      []LabeledDestinationRuleSet
-	envoyFilters          []LabeledEnvoyFilterSet
-	gateways              []LabeledGatewaySet
-	serviceEntries        []LabeledServiceEntrySet
-	virtualServices       []LabeledVirtualServiceSet
-	sidecars              []LabeledSidecarSet
-	authorizationPolicies []LabeledAuthorizationPolicySet
-	clusters              []string
+	issuedCertificates     []LabeledIssuedCertificateSet
+	podBounceDirectives    []LabeledPodBounceDirectiveSet
+	xdsConfigs             []LabeledXdsConfigSet
+	authorizationPolicies  []LabeledAuthorizationPolicySet
+	requestAuthentications []LabeledRequestAuthenticationSet
+	destinationRules       []LabeledDestinationRuleSet
+	envoyFilters           []LabeledEnvoyFilterSet
+	gateways               []LabeledGatewaySet
+	serviceEntries         []LabeledServiceEntrySet
+	virtualServices        []LabeledVirtualServiceSet
+	sidecars               []LabeledSidecarSet
+	clusters               []string
 }
 
 func NewSnapshot(
@@ -154,29 +162,31 @@ func NewSnapshot(
 	issuedCertificates []LabeledIssuedCertificateSet,
 	podBounceDirectives []LabeledPodBounceDirectiveSet,
 	xdsConfigs []LabeledXdsConfigSet,
+	authorizationPolicies []LabeledAuthorizationPolicySet,
+	requestAuthentications []LabeledRequestAuthenticationSet,
 	destinationRules []LabeledDestinationRuleSet,
 	envoyFilters []LabeledEnvoyFilterSet,
 	gateways []LabeledGatewaySet,
 	serviceEntries []LabeledServiceEntrySet,
 	virtualServices []LabeledVirtualServiceSet,
 	sidecars []LabeledSidecarSet,
-	authorizationPolicies []LabeledAuthorizationPolicySet,
 	clusters ...string, // the set of clusters to apply the snapshot to. only required for multicluster snapshots.
 ) Snapshot {
 	return &snapshot{
 		name: name,
 
-		issuedCertificates:    issuedCertificates,
-		podBounceDirectives:   podBounceDirectives,
-		xdsConfigs:            xdsConfigs,
-		destinationRules:      destinationRules,
-		envoyFilters:          envoyFilters,
-		gateways:              gateways,
-		serviceEntries:        serviceEntries,
-		virtualServices:       virtualServices,
-		sidecars:              sidecars,
-		authorizationPolicies: authorizationPolicies,
-		clusters:              clusters,
+		issuedCertificates:     issuedCertificates,
+		podBounceDirectives:    podBounceDirectives,
+		xdsConfigs:             xdsConfigs,
+		authorizationPolicies:  authorizationPolicies,
+		requestAuthentications: requestAuthentications,
+		destinationRules:       destinationRules,
+		envoyFilters:           envoyFilters,
+		gateways:               gateways,
+		serviceEntries:         serviceEntries,
+		virtualServices:        virtualServices,
+		sidecars:               sidecars,
+		clusters:               clusters,
 	}
 }
 
@@ -191,14 +201,15 @@ func NewLabelPartitionedSnapshot(
 
 	xdsConfigs xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet,
 
+	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet,
+	requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet,
+
 	destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet,
 	envoyFilters networking_istio_io_v1alpha3_sets.EnvoyFilterSet,
 	gateways networking_istio_io_v1alpha3_sets.GatewaySet,
 	serviceEntries networking_istio_io_v1alpha3_sets.ServiceEntrySet,
 	virtualServices networking_istio_io_v1alpha3_sets.VirtualServiceSet,
 	sidecars networking_istio_io_v1alpha3_sets.SidecarSet,
-
-	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet,
 	clusters ...string, // the set of clusters to apply the snapshot to. only required for multicluster snapshots.
 ) (Snapshot, error) {
 
@@ -214,6 +225,14 @@ func NewLabelPartitionedSnapshot(
 	if err != nil {
 		return nil, err
 	}
+	partitionedAuthorizationPolicies, err := partitionAuthorizationPoliciesByLabel(labelKey, authorizationPolicies)
+	if err != nil {
+		return nil, err
+	}
+	partitionedRequestAuthentications, err := partitionRequestAuthenticationsByLabel(labelKey, requestAuthentications)
+	if err != nil {
+		return nil, err
+	}
 	partitionedDestinationRules, err := partitionDestinationRulesByLabel(labelKey, destinationRules)
 	if err != nil {
 		return nil, err
@@ -238,10 +257,6 @@ func NewLabelPartitionedSnapshot(
 	if err != nil {
 		return nil, err
 	}
-	partitionedAuthorizationPolicies, err := partitionAuthorizationPoliciesByLabel(labelKey, authorizationPolicies)
-	if err != nil {
-		return nil, err
-	}
 
 	return NewSnapshot(
 		name,
@@ -249,13 +264,14 @@ func NewLabelPartitionedSnapshot(
 		partitionedIssuedCertificates,
 		partitionedPodBounceDirectives,
 		partitionedXdsConfigs,
+		partitionedAuthorizationPolicies,
+		partitionedRequestAuthentications,
 		partitionedDestinationRules,
 		partitionedEnvoyFilters,
 		partitionedGateways,
 		partitionedServiceEntries,
 		partitionedVirtualServices,
 		partitionedSidecars,
-		partitionedAuthorizationPolicies,
 		clusters...,
 	), nil
 }
@@ -271,14 +287,15 @@ func NewSinglePartitionedSnapshot(
 
 	xdsConfigs xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet,
 
+	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet,
+	requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet,
+
 	destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet,
 	envoyFilters networking_istio_io_v1alpha3_sets.EnvoyFilterSet,
 	gateways networking_istio_io_v1alpha3_sets.GatewaySet,
 	serviceEntries networking_istio_io_v1alpha3_sets.ServiceEntrySet,
 	virtualServices networking_istio_io_v1alpha3_sets.VirtualServiceSet,
 	sidecars networking_istio_io_v1alpha3_sets.SidecarSet,
-
-	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet,
 	clusters ...string, // the set of clusters to apply the snapshot to. only required for multicluster snapshots.
 ) (Snapshot, error) {
 
@@ -294,6 +311,14 @@ func NewSinglePartitionedSnapshot(
 	if err != nil {
 		return nil, err
 	}
+	labeledAuthorizationPolicies, err := NewLabeledAuthorizationPolicySet(authorizationPolicies, snapshotLabels)
+	if err != nil {
+		return nil, err
+	}
+	labeledRequestAuthentications, err := NewLabeledRequestAuthenticationSet(requestAuthentications, snapshotLabels)
+	if err != nil {
+		return nil, err
+	}
 	labeledDestinationRules, err := NewLabeledDestinationRuleSet(destinationRules, snapshotLabels)
 	if err != nil {
 		return nil, err
@@ -318,10 +343,6 @@ func NewSinglePartitionedSnapshot(
 	if err != nil {
 		return nil, err
 	}
-	labeledAuthorizationPolicies, err := NewLabeledAuthorizationPolicySet(authorizationPolicies, snapshotLabels)
-	if err != nil {
-		return nil, err
-	}
 
 	return NewSnapshot(
 		name,
@@ -329,13 +350,14 @@ func NewSinglePartitionedSnapshot(
 		[]LabeledIssuedCertificateSet{labeledIssuedCertificates},
 		[]LabeledPodBounceDirectiveSet{labeledPodBounceDirectives},
 		[]LabeledXdsConfigSet{labeledXdsConfigs},
+		[]LabeledAuthorizationPolicySet{labeledAuthorizationPolicies},
+		[]LabeledRequestAuthenticationSet{labeledRequestAuthentications},
 		[]LabeledDestinationRuleSet{labeledDestinationRules},
 		[]LabeledEnvoyFilterSet{labeledEnvoyFilters},
 		[]LabeledGatewaySet{labeledGateways},
 		[]LabeledServiceEntrySet{labeledServiceEntries},
 		[]LabeledVirtualServiceSet{labeledVirtualServices},
 		[]LabeledSidecarSet{labeledSidecars},
-		[]LabeledAuthorizationPolicySet{labeledAuthorizationPolicies},
 		clusters...,
 	), nil
 }
@@ -353,6 +375,12 @@ func (s *snapshot) ApplyLocalCluster(ctx context.Context, cli client.Client, err
 	for _, outputSet := range s.xdsConfigs {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
+	for _, outputSet := range s.authorizationPolicies {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
+	for _, outputSet := range s.requestAuthentications {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
 	for _, outputSet := range s.destinationRules {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
@@ -371,9 +399,6 @@ func (s *snapshot) ApplyLocalCluster(ctx context.Context, cli client.Client, err
 	for _, outputSet := range s.sidecars {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
-	for _, outputSet := range s.authorizationPolicies {
-		genericLists = append(genericLists, outputSet.Generic())
-	}
 
 	output.Snapshot{
 		Name:        s.name,
@@ -394,6 +419,12 @@ func (s *snapshot) ApplyMultiCluster(ctx context.Context, multiClusterClient mul
 	for _, outputSet := range s.xdsConfigs {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
+	for _, outputSet := range s.authorizationPolicies {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
+	for _, outputSet := range s.requestAuthentications {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
 	for _, outputSet := range s.destinationRules {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
@@ -412,9 +443,6 @@ func (s *snapshot) ApplyMultiCluster(ctx context.Context, multiClusterClient mul
 	for _, outputSet := range s.sidecars {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
-	for _, outputSet := range s.authorizationPolicies {
-		genericLists = append(genericLists, outputSet.Generic())
-	}
 
 	output.Snapshot{
 		Name:        s.name,
@@ -555,6 +583,94 @@ func partitionXdsConfigsByLabel(labelKey string, set xds_agent_enterprise_mesh_g
 	return partitionedXdsConfigs, nil
 }
 
+func partitionAuthorizationPoliciesByLabel(labelKey string, set security_istio_io_v1beta1_sets.AuthorizationPolicySet) ([]LabeledAuthorizationPolicySet, error) {
+	setsByLabel := map[string]security_istio_io_v1beta1_sets.AuthorizationPolicySet{}
+
+	for _, obj := range set.List() {
+		if obj.Labels == nil {
+			return nil, MissingRequiredLabelError(labelKey, "AuthorizationPolicy", obj)
+		}
+		labelValue := obj.Labels[labelKey]
+		if labelValue == "" {
+			return nil, MissingRequiredLabelError(labelKey, "AuthorizationPolicy", obj)
+		}
+
+		setForValue, ok := setsByLabel[labelValue]
+		if !ok {
+			setForValue = security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
+			setsByLabel[labelValue] = setForValue
+		}
+		setForValue.Insert(obj)
+	}
+
+	// partition by label key
+	var partitionedAuthorizationPolicies []LabeledAuthorizationPolicySet
+
+	for labelValue, setForValue := range setsByLabel {
+		labels := map[string]string{labelKey: labelValue}
+
+		partitionedSet, err := NewLabeledAuthorizationPolicySet(setForValue, labels)
+		if err != nil {
+			return nil, err
+		}
+
+		partitionedAuthorizationPolicies = append(partitionedAuthorizationPolicies, partitionedSet)
+	}
+
+	// sort for idempotency
+	sort.SliceStable(partitionedAuthorizationPolicies, func(i, j int) bool {
+		leftLabelValue := partitionedAuthorizationPolicies[i].Labels()[labelKey]
+		rightLabelValue := partitionedAuthorizationPolicies[j].Labels()[labelKey]
+		return leftLabelValue < rightLabelValue
+	})
+
+	return partitionedAuthorizationPolicies, nil
+}
+
+func partitionRequestAuthenticationsByLabel(labelKey string, set security_istio_io_v1beta1_sets.RequestAuthenticationSet) ([]LabeledRequestAuthenticationSet, error) {
+	setsByLabel := map[string]security_istio_io_v1beta1_sets.RequestAuthenticationSet{}
+
+	for _, obj := range set.List() {
+		if obj.Labels == nil {
+			return nil, MissingRequiredLabelError(labelKey, "RequestAuthentication", obj)
+		}
+		labelValue := obj.Labels[labelKey]
+		if labelValue == "" {
+			return nil, MissingRequiredLabelError(labelKey, "RequestAuthentication", obj)
+		}
+
+		setForValue, ok := setsByLabel[labelValue]
+		if !ok {
+			setForValue = security_istio_io_v1beta1_sets.NewRequestAuthenticationSet()
+			setsByLabel[labelValue] = setForValue
+		}
+		setForValue.Insert(obj)
+	}
+
+	// partition by label key
+	var partitionedRequestAuthentications []LabeledRequestAuthenticationSet
+
+	for labelValue, setForValue := range setsByLabel {
+		labels := map[string]string{labelKey: labelValue}
+
+		partitionedSet, err := NewLabeledRequestAuthenticationSet(setForValue, labels)
+		if err != nil {
+			return nil, err
+		}
+
+		partitionedRequestAuthentications = append(partitionedRequestAuthentications, partitionedSet)
+	}
+
+	// sort for idempotency
+	sort.SliceStable(partitionedRequestAuthentications, func(i, j int) bool {
+		leftLabelValue := partitionedRequestAuthentications[i].Labels()[labelKey]
+		rightLabelValue := partitionedRequestAuthentications[j].Labels()[labelKey]
+		return leftLabelValue < rightLabelValue
+	})
+
+	return partitionedRequestAuthentications, nil
+}
+
 func partitionDestinationRulesByLabel(labelKey string, set networking_istio_io_v1alpha3_sets.DestinationRuleSet) ([]LabeledDestinationRuleSet, error) {
 	setsByLabel := map[string]networking_istio_io_v1alpha3_sets.DestinationRuleSet{}
 
@@ -819,50 +935,6 @@ func partitionSidecarsByLabel(labelKey string, set networking_istio_io_v1alpha3_
 	return partitionedSidecars, nil
 }
 
-func partitionAuthorizationPoliciesByLabel(labelKey string, set security_istio_io_v1beta1_sets.AuthorizationPolicySet) ([]LabeledAuthorizationPolicySet, error) {
-	setsByLabel := map[string]security_istio_io_v1beta1_sets.AuthorizationPolicySet{}
-
-	for _, obj := range set.List() {
-		if obj.Labels == nil {
-			return nil, MissingRequiredLabelError(labelKey, "AuthorizationPolicy", obj)
-		}
-		labelValue := obj.Labels[labelKey]
-		if labelValue == "" {
-			return nil, MissingRequiredLabelError(labelKey, "AuthorizationPolicy", obj)
-		}
-
-		setForValue, ok := setsByLabel[labelValue]
-		if !ok {
-			setForValue = security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
-			setsByLabel[labelValue] = setForValue
-		}
-		setForValue.Insert(obj)
-	}
-
-	// partition by label key
-	var partitionedAuthorizationPolicies []LabeledAuthorizationPolicySet
-
-	for labelValue, setForValue := range setsByLabel {
-		labels := map[string]string{labelKey: labelValue}
-
-		partitionedSet, err := NewLabeledAuthorizationPolicySet(setForValue, labels)
-		if err != nil {
-			return nil, err
-		}
-
-		partitionedAuthorizationPolicies = append(partitionedAuthorizationPolicies, partitionedSet)
-	}
-
-	// sort for idempotency
-	sort.SliceStable(partitionedAuthorizationPolicies, func(i, j int) bool {
-		leftLabelValue := partitionedAuthorizationPolicies[i].Labels()[labelKey]
-		rightLabelValue := partitionedAuthorizationPolicies[j].Labels()[labelKey]
-		return leftLabelValue < rightLabelValue
-	})
-
-	return partitionedAuthorizationPolicies, nil
-}
-
 func (s snapshot) IssuedCertificates() []LabeledIssuedCertificateSet {
 	return s.issuedCertificates
 }
@@ -875,6 +947,14 @@ func (s snapshot) XdsConfigs() []LabeledXdsConfigSet {
 	return s.xdsConfigs
 }
 
+func (s snapshot) AuthorizationPolicies() []LabeledAuthorizationPolicySet {
+	return s.authorizationPolicies
+}
+
+func (s snapshot) RequestAuthentications() []LabeledRequestAuthenticationSet {
+	return s.requestAuthentications
+}
+
 func (s snapshot) DestinationRules() []LabeledDestinationRuleSet {
 	return s.destinationRules
 }
@@ -899,10 +979,6 @@ func (s snapshot) Sidecars() []LabeledSidecarSet {
 	return s.sidecars
 }
 
-func (s snapshot) AuthorizationPolicies() []LabeledAuthorizationPolicySet {
-	return s.authorizationPolicies
-}
-
 func (s snapshot) MarshalJSON() ([]byte, error) {
 	snapshotMap := map[string]interface{}{"name": s.name}
 
@@ -923,6 +999,17 @@ func (s snapshot) MarshalJSON() ([]byte, error) {
 	}
 	snapshotMap["xdsConfigs"] = xdsConfigSet.List()
 
+	authorizationPolicySet := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
+	for _, set := range s.authorizationPolicies {
+		authorizationPolicySet = authorizationPolicySet.Union(set.Set())
+	}
+	snapshotMap["authorizationPolicies"] = authorizationPolicySet.List()
+	requestAuthenticationSet := security_istio_io_v1beta1_sets.NewRequestAuthenticationSet()
+	for _, set := range s.requestAuthentications {
+		requestAuthenticationSet = requestAuthenticationSet.Union(set.Set())
+	}
+	snapshotMap["requestAuthentications"] = requestAuthenticationSet.List()
+
 	destinationRuleSet := networking_istio_io_v1alpha3_sets.NewDestinationRuleSet()
 	for _, set := range s.destinationRules {
 		destinationRuleSet = destinationRuleSet.Union(set.Set())
@@ -954,12 +1041,6 @@ func (s snapshot) MarshalJSON() ([]byte, error) {
 	}
 	snapshotMap["sidecars"] = sidecarSet.List()
 
-	authorizationPolicySet := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
-	for _, set := range s.authorizationPolicies {
-		authorizationPolicySet = authorizationPolicySet.Union(set.Set())
-	}
-	snapshotMap["authorizationPolicies"] = authorizationPolicySet.List()
-
 	snapshotMap["clusters"] = s.clusters
 
 	return json.Marshal(snapshotMap)
@@ -1169,6 +1250,142 @@ func (l labeledXdsConfigSet) Generic() output.ResourceList {
 	}
 }
 
+// LabeledAuthorizationPolicySet represents a set of authorizationPolicies
+// which share a common set of labels.
+// These labels are used to find diffs between AuthorizationPolicySets.
+type LabeledAuthorizationPolicySet interface {
+	// returns the set of Labels shared by this AuthorizationPolicySet
+	Labels() map[string]string
+
+	// returns the set of AuthorizationPolicyes with the given labels
+	Set() security_istio_io_v1beta1_sets.AuthorizationPolicySet
+
+	// converts the set to a generic format which can be applied by the Snapshot.Apply functions
+	Generic() output.ResourceList
+}
+
+type labeledAuthorizationPolicySet struct {
+	set    security_istio_io_v1beta1_sets.AuthorizationPolicySet
+	labels map[string]string
+}
+
+func NewLabeledAuthorizationPolicySet(set security_istio_io_v1beta1_sets.AuthorizationPolicySet, labels map[string]string) (LabeledAuthorizationPolicySet, error) {
+	// validate that each AuthorizationPolicy contains the labels, else this is not a valid LabeledAuthorizationPolicySet
+	for _, item := range set.List() {
+		for k, v := range labels {
+			// k=v must be present in the item
+			if item.Labels[k] != v {
+				return nil, eris.Errorf("internal error: %v=%v missing on AuthorizationPolicy %v", k, v, item.Name)
+			}
+		}
+	}
+
+	return &labeledAuthorizationPolicySet{set: set, labels: labels}, nil
+}
+
+func (l *labeledAuthorizationPolicySet) Labels() map[string]string {
+	return l.labels
+}
+
+func (l *labeledAuthorizationPolicySet) Set() security_istio_io_v1beta1_sets.AuthorizationPolicySet {
+	return l.set
+}
+
+func (l labeledAuthorizationPolicySet) Generic() output.ResourceList {
+	var desiredResources []ezkube.Object
+	for _, desired := range l.set.List() {
+		desiredResources = append(desiredResources, desired)
+	}
+
+	// enable list func for garbage collection
+	listFunc := func(ctx context.Context, cli client.Client) ([]ezkube.Object, error) {
+		var list security_istio_io_v1beta1.AuthorizationPolicyList
+		if err := cli.List(ctx, &list, client.MatchingLabels(l.labels)); err != nil {
+			return nil, err
+		}
+		var items []ezkube.Object
+		for _, item := range list.Items {
+			item := item // pike
+			items = append(items, &item)
+		}
+		return items, nil
+	}
+
+	return output.ResourceList{
+		Resources:    desiredResources,
+		ListFunc:     listFunc,
+		ResourceKind: "AuthorizationPolicy",
+	}
+}
+
+// LabeledRequestAuthenticationSet represents a set of requestAuthentications
+// which share a common set of labels.
+// These labels are used to find diffs between RequestAuthenticationSets.
+type LabeledRequestAuthenticationSet interface {
+	// returns the set of Labels shared by this RequestAuthenticationSet
+	Labels() map[string]string
+
+	// returns the set of RequestAuthenticationes with the given labels
+	Set() security_istio_io_v1beta1_sets.RequestAuthenticationSet
+
+	// converts the set to a generic format which can be applied by the Snapshot.Apply functions
+	Generic() output.ResourceList
+}
+
+type labeledRequestAuthenticationSet struct {
+	set    security_istio_io_v1beta1_sets.RequestAuthenticationSet
+	labels map[string]string
+}
+
+func NewLabeledRequestAuthenticationSet(set security_istio_io_v1beta1_sets.RequestAuthenticationSet, labels map[string]string) (LabeledRequestAuthenticationSet, error) {
+	// validate that each RequestAuthentication contains the labels, else this is not a valid LabeledRequestAuthenticationSet
+	for _, item := range set.List() {
+		for k, v := range labels {
+			// k=v must be present in the item
+			if item.Labels[k] != v {
+				return nil, eris.Errorf("internal error: %v=%v missing on RequestAuthentication %v", k, v, item.Name)
+			}
+		}
+	}
+
+	return &labeledRequestAuthenticationSet{set: set, labels: labels}, nil
+}
+
+func (l *labeledRequestAuthenticationSet) Labels() map[string]string {
+	return l.labels
+}
+
+func (l *labeledRequestAuthenticationSet) Set() security_istio_io_v1beta1_sets.RequestAuthenticationSet {
+	return l.set
+}
+
+func (l labeledRequestAuthenticationSet) Generic() output.ResourceList {
+	var desiredResources []ezkube.Object
+	for _, desired := range l.set.List() {
+		desiredResources = append(desiredResources, desired)
+	}
+
+	// enable list func for garbage collection
+	listFunc := func(ctx context.Context, cli client.Client) ([]ezkube.Object, error) {
+		var list security_istio_io_v1beta1.RequestAuthenticationList
+		if err := cli.List(ctx, &list, client.MatchingLabels(l.labels)); err != nil {
+			return nil, err
+		}
+		var items []ezkube.Object
+		for _, item := range list.Items {
+			item := item // pike
+			items = append(items, &item)
+		}
+		return items, nil
+	}
+
+	return output.ResourceList{
+		Resources:    desiredResources,
+		ListFunc:     listFunc,
+		ResourceKind: "RequestAuthentication",
+	}
+}
+
 // LabeledDestinationRuleSet represents a set of destinationRules
 // which share a common set of labels.
 // These labels are used to find diffs between DestinationRuleSets.
@@ -1577,74 +1794,6 @@ func (l labeledSidecarSet) Generic() output.ResourceList {
 	}
 }
 
-// LabeledAuthorizationPolicySet represents a set of authorizationPolicies
-// which share a common set of labels.
-// These labels are used to find diffs between AuthorizationPolicySets.
-type LabeledAuthorizationPolicySet interface {
-	// returns the set of Labels shared by this AuthorizationPolicySet
-	Labels() map[string]string
-
-	// returns the set of AuthorizationPolicyes with the given labels
-	Set() security_istio_io_v1beta1_sets.AuthorizationPolicySet
-
-	// converts the set to a generic format which can be applied by the Snapshot.Apply functions
-	Generic() output.ResourceList
-}
-
-type labeledAuthorizationPolicySet struct {
-	set    security_istio_io_v1beta1_sets.AuthorizationPolicySet
-	labels map[string]string
-}
-
-func NewLabeledAuthorizationPolicySet(set security_istio_io_v1beta1_sets.AuthorizationPolicySet, labels map[string]string) (LabeledAuthorizationPolicySet, error) {
-	// validate that each AuthorizationPolicy contains the labels, else this is not a valid LabeledAuthorizationPolicySet
-	for _, item := range set.List() {
-		for k, v := range labels {
-			// k=v must be present in the item
-			if item.Labels[k] != v {
-				return nil, eris.Errorf("internal error: %v=%v missing on AuthorizationPolicy %v", k, v, item.Name)
-			}
-		}
-	}
-
-	return &labeledAuthorizationPolicySet{set: set, labels: labels}, nil
-}
-
-func (l *labeledAuthorizationPolicySet) Labels() map[string]string {
-	return l.labels
-}
-
-func (l *labeledAuthorizationPolicySet) Set() security_istio_io_v1beta1_sets.AuthorizationPolicySet {
-	return l.set
-}
-
-func (l labeledAuthorizationPolicySet) Generic() output.ResourceList {
-	var desiredResources []ezkube.Object
-	for _, desired := range l.set.List() {
-		desiredResources = append(desiredResources, desired)
-	}
-
-	// enable list func for garbage collection
-	listFunc := func(ctx context.Context, cli client.Client) ([]ezkube.Object, error) {
-		var list security_istio_io_v1beta1.AuthorizationPolicyList
-		if err := cli.List(ctx, &list, client.MatchingLabels(l.labels)); err != nil {
-			return nil, err
-		}
-		var items []ezkube.Object
-		for _, item := range list.Items {
-			item := item // pike
-			items = append(items, &item)
-		}
-		return items, nil
-	}
-
-	return output.ResourceList{
-		Resources:    desiredResources,
-		ListFunc:     listFunc,
-		ResourceKind: "AuthorizationPolicy",
-	}
-}
-
 type builder struct {
 	ctx      context.Context
 	name     string
@@ -1655,14 +1804,15 @@ type builder struct {
 
 	xdsConfigs xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
 
+	authorizationPolicies  security_istio_io_v1beta1_sets.AuthorizationPolicySet
+	requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet
+
 	destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet
 	envoyFilters     networking_istio_io_v1alpha3_sets.EnvoyFilterSet
 	gateways         networking_istio_io_v1alpha3_sets.GatewaySet
 	serviceEntries   networking_istio_io_v1alpha3_sets.ServiceEntrySet
 	virtualServices  networking_istio_io_v1alpha3_sets.VirtualServiceSet
 	sidecars         networking_istio_io_v1alpha3_sets.SidecarSet
-
-	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet
 }
 
 func NewBuilder(ctx context.Context, name string) *builder {
@@ -1675,14 +1825,15 @@ func NewBuilder(ctx context.Context, name string) *builder {
 
 		xdsConfigs: xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewXdsConfigSet(),
 
+		authorizationPolicies:  security_istio_io_v1beta1_sets.NewAuthorizationPolicySet(),
+		requestAuthentications: security_istio_io_v1beta1_sets.NewRequestAuthenticationSet(),
+
 		destinationRules: networking_istio_io_v1alpha3_sets.NewDestinationRuleSet(),
 		envoyFilters:     networking_istio_io_v1alpha3_sets.NewEnvoyFilterSet(),
 		gateways:         networking_istio_io_v1alpha3_sets.NewGatewaySet(),
 		serviceEntries:   networking_istio_io_v1alpha3_sets.NewServiceEntrySet(),
 		virtualServices:  networking_istio_io_v1alpha3_sets.NewVirtualServiceSet(),
 		sidecars:         networking_istio_io_v1alpha3_sets.NewSidecarSet(),
-
-		authorizationPolicies: security_istio_io_v1beta1_sets.NewAuthorizationPolicySet(),
 	}
 }
 
@@ -1708,6 +1859,18 @@ type Builder interface {
 	// get the collected XdsConfigs
 	GetXdsConfigs() xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
 
+	// add AuthorizationPolicies to the collected outputs
+	AddAuthorizationPolicies(authorizationPolicies ...*security_istio_io_v1beta1.AuthorizationPolicy)
+
+	// get the collected AuthorizationPolicies
+	GetAuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet
+
+	// add RequestAuthentications to the collected outputs
+	AddRequestAuthentications(requestAuthentications ...*security_istio_io_v1beta1.RequestAuthentication)
+
+	// get the collected RequestAuthentications
+	GetRequestAuthentications() security_istio_io_v1beta1_sets.RequestAuthenticationSet
+
 	// add DestinationRules to the collected outputs
 	AddDestinationRules(destinationRules ...*networking_istio_io_v1alpha3.DestinationRule)
 
@@ -1744,12 +1907,6 @@ type Builder interface {
 	// get the collected Sidecars
 	GetSidecars() networking_istio_io_v1alpha3_sets.SidecarSet
 
-	// add AuthorizationPolicies to the collected outputs
-	AddAuthorizationPolicies(authorizationPolicies ...*security_istio_io_v1beta1.AuthorizationPolicy)
-
-	// get the collected AuthorizationPolicies
-	GetAuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet
-
 	// build the collected outputs into a label-partitioned snapshot
 	BuildLabelPartitionedSnapshot(labelKey string) (Snapshot, error)
 
@@ -1800,6 +1957,24 @@ func (b *builder) AddXdsConfigs(xdsConfigs ...*xds_agent_enterprise_mesh_gloo_so
 		b.xdsConfigs.Insert(obj)
 	}
 }
+func (b *builder) AddAuthorizationPolicies(authorizationPolicies ...*security_istio_io_v1beta1.AuthorizationPolicy) {
+	for _, obj := range authorizationPolicies {
+		if obj == nil {
+			continue
+		}
+		contextutils.LoggerFrom(b.ctx).Debugf("added output AuthorizationPolicy %v", sets.Key(obj))
+		b.authorizationPolicies.Insert(obj)
+	}
+}
+func (b *builder) AddRequestAuthentications(requestAuthentications ...*security_istio_io_v1beta1.RequestAuthentication) {
+	for _, obj := range requestAuthentications {
+		if obj == nil {
+			continue
+		}
+		contextutils.LoggerFrom(b.ctx).Debugf("added output RequestAuthentication %v", sets.Key(obj))
+		b.requestAuthentications.Insert(obj)
+	}
+}
 func (b *builder) AddDestinationRules(destinationRules ...*networking_istio_io_v1alpha3.DestinationRule) {
 	for _, obj := range destinationRules {
 		if obj == nil {
@@ -1854,15 +2029,6 @@ func (b *builder) AddSidecars(sidecars ...*networking_istio_io_v1alpha3.Sidecar)
 		b.sidecars.Insert(obj)
 	}
 }
-func (b *builder) AddAuthorizationPolicies(authorizationPolicies ...*security_istio_io_v1beta1.AuthorizationPolicy) {
-	for _, obj := range authorizationPolicies {
-		if obj == nil {
-			continue
-		}
-		contextutils.LoggerFrom(b.ctx).Debugf("added output AuthorizationPolicy %v", sets.Key(obj))
-		b.authorizationPolicies.Insert(obj)
-	}
-}
 
 func (b *builder) GetIssuedCertificates() certificates_mesh_gloo_solo_io_v1_sets.IssuedCertificateSet {
 	return b.issuedCertificates
@@ -1875,6 +2041,13 @@ func (b *builder) GetXdsConfigs() xds_agent_enterprise_mesh_gloo_solo_io_v1beta1
 	return b.xdsConfigs
 }
 
+func (b *builder) GetAuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet {
+	return b.authorizationPolicies
+}
+func (b *builder) GetRequestAuthentications() security_istio_io_v1beta1_sets.RequestAuthenticationSet {
+	return b.requestAuthentications
+}
+
 func (b *builder) GetDestinationRules() networking_istio_io_v1alpha3_sets.DestinationRuleSet {
 	return b.destinationRules
 }
@@ -1894,10 +2067,6 @@ func (b *builder) GetSidecars() networking_istio_io_v1alpha3_sets.SidecarSet {
 	return b.sidecars
 }
 
-func (b *builder) GetAuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet {
-	return b.authorizationPolicies
-}
-
 func (b *builder) BuildLabelPartitionedSnapshot(labelKey string) (Snapshot, error) {
 	return NewLabelPartitionedSnapshot(
 		b.name,
@@ -1908,14 +2077,15 @@ func (b *builder) BuildLabelPartitionedSnapshot(labelKey string) (Snapshot, erro
 
 		b.xdsConfigs,
 
+		b.authorizationPolicies,
+		b.requestAuthentications,
+
 		b.destinationRules,
 		b.envoyFilters,
 		b.gateways,
 		b.serviceEntries,
 		b.virtualServices,
 		b.sidecars,
-
-		b.authorizationPolicies,
 		b.clusters...,
 	)
 }
@@ -1930,14 +2100,15 @@ func (b *builder) BuildSinglePartitionedSnapshot(snapshotLabels map[string]strin
 
 		b.xdsConfigs,
 
+		b.authorizationPolicies,
+		b.requestAuthentications,
+
 		b.destinationRules,
 		b.envoyFilters,
 		b.gateways,
 		b.serviceEntries,
 		b.virtualServices,
 		b.sidecars,
-
-		b.authorizationPolicies,
 		b.clusters...,
 	)
 }
@@ -1960,14 +2131,15 @@ func (b *builder) Merge(other Builder) {
 
 	b.AddXdsConfigs(other.GetXdsConfigs().List()...)
 
+	b.AddAuthorizationPolicies(other.GetAuthorizationPolicies().List()...)
+	b.AddRequestAuthentications(other.GetRequestAuthentications().List()...)
+
 	b.AddDestinationRules(other.GetDestinationRules().List()...)
 	b.AddEnvoyFilters(other.GetEnvoyFilters().List()...)
 	b.AddGateways(other.GetGateways().List()...)
 	b.AddServiceEntries(other.GetServiceEntries().List()...)
 	b.AddVirtualServices(other.GetVirtualServices().List()...)
 	b.AddSidecars(other.GetSidecars().List()...)
-
-	b.AddAuthorizationPolicies(other.GetAuthorizationPolicies().List()...)
 	for _, cluster := range other.Clusters() {
 		b.AddCluster(cluster)
 	}
@@ -1990,6 +2162,13 @@ func (b *builder) Clone() Builder {
 		clone.AddXdsConfigs(xdsConfig.DeepCopy())
 	}
 
+	for _, authorizationPolicy := range b.GetAuthorizationPolicies().List() {
+		clone.AddAuthorizationPolicies(authorizationPolicy.DeepCopy())
+	}
+	for _, requestAuthentication := range b.GetRequestAuthentications().List() {
+		clone.AddRequestAuthentications(requestAuthentication.DeepCopy())
+	}
+
 	for _, destinationRule := range b.GetDestinationRules().List() {
 		clone.AddDestinationRules(destinationRule.DeepCopy())
 	}
@@ -2008,10 +2187,6 @@ func (b *builder) Clone() Builder {
 	for _, sidecar := range b.GetSidecars().List() {
 		clone.AddSidecars(sidecar.DeepCopy())
 	}
-
-	for _, authorizationPolicy := range b.GetAuthorizationPolicies().List() {
-		clone.AddAuthorizationPolicies(authorizationPolicy.DeepCopy())
-	}
 	for _, cluster := range b.Clusters() {
 		clone.AddCluster(cluster)
 	}
@@ -2054,6 +2229,25 @@ func (b *builder) Generic() resource.ClusterSnapshot {
 		clusterSnapshots.Insert(cluster, gvk, obj)
 	}
 
+	for _, obj := range b.GetAuthorizationPolicies().List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "AuthorizationPolicy",
+		}
+		clusterSnapshots.Insert(cluster, gvk, obj)
+	}
+	for _, obj := range b.GetRequestAuthentications().List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "RequestAuthentication",
+		}
+		clusterSnapshots.Insert(cluster, gvk, obj)
+	}
+
 	for _, obj := range b.GetDestinationRules().List() {
 		cluster := obj.GetClusterName()
 		gvk := schema.GroupVersionKind{
@@ -2109,15 +2303,5 @@ func (b *builder) Generic() resource.ClusterSnapshot {
 		clusterSnapshots.Insert(cluster, gvk, obj)
 	}
 
-	for _, obj := range b.GetAuthorizationPolicies().List() {
-		cluster := obj.GetClusterName()
-		gvk := schema.GroupVersionKind{
-			Group:   "security.istio.io",
-			Version: "v1beta1",
-			Kind:    "AuthorizationPolicy",
-		}
-		clusterSnapshots.Insert(cluster, gvk, obj)
-	}
-
 	return clusterSnapshots
 }
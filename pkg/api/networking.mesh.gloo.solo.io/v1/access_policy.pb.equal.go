@@ -113,6 +113,27 @@ func (m *AccessPolicySpec) Equal(that interface{}) bool {
 
 	}
 
+	if m.GetAction() != target.GetAction() {
+		return false
+	}
+
+	if len(m.GetConditions()) != len(target.GetConditions()) {
+		return false
+	}
+	for idx, v := range m.GetConditions() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetConditions()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetConditions()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -186,3 +207,227 @@ func (m *AccessPolicyStatus) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *AccessPolicySpec_Condition) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AccessPolicySpec_Condition)
+	if !ok {
+		that2, ok := that.(AccessPolicySpec_Condition)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	switch m.ConditionType.(type) {
+
+	case *AccessPolicySpec_Condition_RequestHeader:
+		if _, ok := target.ConditionType.(*AccessPolicySpec_Condition_RequestHeader); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetRequestHeader()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetRequestHeader()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetRequestHeader(), target.GetRequestHeader()) {
+				return false
+			}
+		}
+
+	case *AccessPolicySpec_Condition_JwtClaim:
+		if _, ok := target.ConditionType.(*AccessPolicySpec_Condition_JwtClaim); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetJwtClaim()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetJwtClaim()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetJwtClaim(), target.GetJwtClaim()) {
+				return false
+			}
+		}
+
+	case *AccessPolicySpec_Condition_SourceIp:
+		if _, ok := target.ConditionType.(*AccessPolicySpec_Condition_SourceIp); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetSourceIp()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetSourceIp()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetSourceIp(), target.GetSourceIp()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.ConditionType != target.ConditionType {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *AccessPolicySpec_Condition_HeaderCondition) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AccessPolicySpec_Condition_HeaderCondition)
+	if !ok {
+		that2, ok := that.(AccessPolicySpec_Condition_HeaderCondition)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if len(m.GetValues()) != len(target.GetValues()) {
+		return false
+	}
+	for idx, v := range m.GetValues() {
+
+		if strings.Compare(v, target.GetValues()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetNotValues()) != len(target.GetNotValues()) {
+		return false
+	}
+	for idx, v := range m.GetNotValues() {
+
+		if strings.Compare(v, target.GetNotValues()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *AccessPolicySpec_Condition_JwtClaimCondition) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AccessPolicySpec_Condition_JwtClaimCondition)
+	if !ok {
+		that2, ok := that.(AccessPolicySpec_Condition_JwtClaimCondition)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetClaim(), target.GetClaim()) != 0 {
+		return false
+	}
+
+	if len(m.GetValues()) != len(target.GetValues()) {
+		return false
+	}
+	for idx, v := range m.GetValues() {
+
+		if strings.Compare(v, target.GetValues()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetNotValues()) != len(target.GetNotValues()) {
+		return false
+	}
+	for idx, v := range m.GetNotValues() {
+
+		if strings.Compare(v, target.GetNotValues()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *AccessPolicySpec_Condition_IpBlockCondition) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AccessPolicySpec_Condition_IpBlockCondition)
+	if !ok {
+		that2, ok := that.(AccessPolicySpec_Condition_IpBlockCondition)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetIpBlocks()) != len(target.GetIpBlocks()) {
+		return false
+	}
+	for idx, v := range m.GetIpBlocks() {
+
+		if strings.Compare(v, target.GetIpBlocks()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetNotIpBlocks()) != len(target.GetNotIpBlocks()) {
+		return false
+	}
+	for idx, v := range m.GetNotIpBlocks() {
+
+		if strings.Compare(v, target.GetNotIpBlocks()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
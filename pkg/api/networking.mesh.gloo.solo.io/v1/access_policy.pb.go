@@ -28,6 +28,55 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// The action to take for requests selected by an AccessPolicy.
+type AccessPolicySpec_Action int32
+
+const (
+	// Allow requests selected by the AccessPolicy.
+	AccessPolicySpec_ALLOW AccessPolicySpec_Action = 0
+	// Deny requests selected by the AccessPolicy.
+	AccessPolicySpec_DENY AccessPolicySpec_Action = 1
+)
+
+// Enum value maps for AccessPolicySpec_Action.
+var (
+	AccessPolicySpec_Action_name = map[int32]string{
+		0: "ALLOW",
+		1: "DENY",
+	}
+	AccessPolicySpec_Action_value = map[string]int32{
+		"ALLOW": 0,
+		"DENY":  1,
+	}
+)
+
+func (x AccessPolicySpec_Action) Enum() *AccessPolicySpec_Action {
+	p := new(AccessPolicySpec_Action)
+	*p = x
+	return p
+}
+
+func (x AccessPolicySpec_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessPolicySpec_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_enumTypes[0].Descriptor()
+}
+
+func (AccessPolicySpec_Action) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_enumTypes[0]
+}
+
+func (x AccessPolicySpec_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessPolicySpec_Action.Descriptor instead.
+func (AccessPolicySpec_Action) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // Grants communication permission between selected identities (i.e. traffic sources) and Destinations (i.e. destinations).
 // Explicitly granted access permission is required if a
 // [VirtualMesh's GlobalAccessPolicy]({{% versioned_link_path fromRoot="/reference/api/virtual_mesh/#networking.mesh.gloo.solo.io.VirtualMeshSpec.GlobalAccessPolicy" %}})
@@ -41,7 +90,8 @@ type AccessPolicySpec struct {
 	// Leave empty to apply the AccessPolicy to all Workloads colocated in the destination's Mesh.
 	SourceSelector []*v1.IdentitySelector `protobuf:"bytes,1,rep,name=source_selector,json=sourceSelector,proto3" json:"source_selector,omitempty"`
 	// Specify the Destinations for which to apply this AccessPolicy.
-	// Leave empty to apply the AccessPolicy to all Destinations.
+	// Leave empty to apply the AccessPolicy to all Destinations other than ExternalServices,
+	// which are only selected by `externalServiceRefs`.
 	DestinationSelector []*v1.DestinationSelector `protobuf:"bytes,2,rep,name=destination_selector,json=destinationSelector,proto3" json:"destination_selector,omitempty"`
 	//
 	//Optional. A list of HTTP paths or gRPC methods to allow.
@@ -62,6 +112,17 @@ type AccessPolicySpec struct {
 	//Optional. A list of ports which to allow.
 	//If not set any port is allowed.
 	AllowedPorts []uint32 `protobuf:"varint,5,rep,packed,name=allowed_ports,json=allowedPorts,proto3" json:"allowed_ports,omitempty"`
+	//
+	//Optional. The action to take for requests selected by this AccessPolicy. Defaults to ALLOW.
+	//For DENY AccessPolicies, the `allowed_paths`, `allowed_methods` and `allowed_ports` fields select the requests to deny.
+	//DENY AccessPolicies take precedence over ALLOW AccessPolicies, and are enforced regardless of whether the
+	//[VirtualMesh's GlobalAccessPolicy]({{% versioned_link_path fromRoot="/reference/api/virtual_mesh/#networking.mesh.gloo.solo.io.VirtualMeshSpec.GlobalAccessPolicy" %}})
+	//is enabled.
+	Action AccessPolicySpec_Action `protobuf:"varint,6,opt,name=action,proto3,enum=networking.mesh.gloo.solo.io.AccessPolicySpec_Action" json:"action,omitempty"`
+	//
+	//Optional. Conditions on requests, all of which must be satisfied for the AccessPolicy to apply.
+	//If not specified, the AccessPolicy applies to all requests from the selected sources.
+	Conditions []*AccessPolicySpec_Condition `protobuf:"bytes,7,rep,name=conditions,proto3" json:"conditions,omitempty"`
 }
 
 func (x *AccessPolicySpec) Reset() {
@@ -131,6 +192,20 @@ func (x *AccessPolicySpec) GetAllowedPorts() []uint32 {
 	return nil
 }
 
+func (x *AccessPolicySpec) GetAction() AccessPolicySpec_Action {
+	if x != nil {
+		return x.Action
+	}
+	return AccessPolicySpec_ALLOW
+}
+
+func (x *AccessPolicySpec) GetConditions() []*AccessPolicySpec_Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
 type AccessPolicyStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -219,6 +294,302 @@ func (x *AccessPolicyStatus) GetErrors() []string {
 	return nil
 }
 
+// Specify a condition on requests.
+type AccessPolicySpec_Condition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The attribute of the request to match.
+	//
+	// Types that are assignable to ConditionType:
+	//	*AccessPolicySpec_Condition_RequestHeader
+	//	*AccessPolicySpec_Condition_JwtClaim
+	//	*AccessPolicySpec_Condition_SourceIp
+	ConditionType isAccessPolicySpec_Condition_ConditionType `protobuf_oneof:"condition_type"`
+}
+
+func (x *AccessPolicySpec_Condition) Reset() {
+	*x = AccessPolicySpec_Condition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessPolicySpec_Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessPolicySpec_Condition) ProtoMessage() {}
+
+func (x *AccessPolicySpec_Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessPolicySpec_Condition.ProtoReflect.Descriptor instead.
+func (*AccessPolicySpec_Condition) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (m *AccessPolicySpec_Condition) GetConditionType() isAccessPolicySpec_Condition_ConditionType {
+	if m != nil {
+		return m.ConditionType
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Condition) GetRequestHeader() *AccessPolicySpec_Condition_HeaderCondition {
+	if x, ok := x.GetConditionType().(*AccessPolicySpec_Condition_RequestHeader); ok {
+		return x.RequestHeader
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Condition) GetJwtClaim() *AccessPolicySpec_Condition_JwtClaimCondition {
+	if x, ok := x.GetConditionType().(*AccessPolicySpec_Condition_JwtClaim); ok {
+		return x.JwtClaim
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Condition) GetSourceIp() *AccessPolicySpec_Condition_IpBlockCondition {
+	if x, ok := x.GetConditionType().(*AccessPolicySpec_Condition_SourceIp); ok {
+		return x.SourceIp
+	}
+	return nil
+}
+
+type isAccessPolicySpec_Condition_ConditionType interface {
+	isAccessPolicySpec_Condition_ConditionType()
+}
+
+type AccessPolicySpec_Condition_RequestHeader struct {
+	// Match requests by the value of a request header.
+	RequestHeader *AccessPolicySpec_Condition_HeaderCondition `protobuf:"bytes,1,opt,name=request_header,json=requestHeader,proto3,oneof"`
+}
+
+type AccessPolicySpec_Condition_JwtClaim struct {
+	// Match requests by the value of a claim of the request's validated JWT.
+	JwtClaim *AccessPolicySpec_Condition_JwtClaimCondition `protobuf:"bytes,2,opt,name=jwt_claim,json=jwtClaim,proto3,oneof"`
+}
+
+type AccessPolicySpec_Condition_SourceIp struct {
+	// Match requests by the IP address of the traffic source.
+	SourceIp *AccessPolicySpec_Condition_IpBlockCondition `protobuf:"bytes,3,opt,name=source_ip,json=sourceIp,proto3,oneof"`
+}
+
+func (*AccessPolicySpec_Condition_RequestHeader) isAccessPolicySpec_Condition_ConditionType() {}
+
+func (*AccessPolicySpec_Condition_JwtClaim) isAccessPolicySpec_Condition_ConditionType() {}
+
+func (*AccessPolicySpec_Condition_SourceIp) isAccessPolicySpec_Condition_ConditionType() {}
+
+// Match requests by the value of a request header.
+type AccessPolicySpec_Condition_HeaderCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the header, case-insensitive.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//
+	//The values of the header, any of which may match.
+	//Exact match, prefix match ("foo*"), and suffix match ("*foo") are supported.
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	// The values of the header, none of which may match.
+	NotValues []string `protobuf:"bytes,3,rep,name=not_values,json=notValues,proto3" json:"not_values,omitempty"`
+}
+
+func (x *AccessPolicySpec_Condition_HeaderCondition) Reset() {
+	*x = AccessPolicySpec_Condition_HeaderCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessPolicySpec_Condition_HeaderCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessPolicySpec_Condition_HeaderCondition) ProtoMessage() {}
+
+func (x *AccessPolicySpec_Condition_HeaderCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessPolicySpec_Condition_HeaderCondition.ProtoReflect.Descriptor instead.
+func (*AccessPolicySpec_Condition_HeaderCondition) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *AccessPolicySpec_Condition_HeaderCondition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessPolicySpec_Condition_HeaderCondition) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Condition_HeaderCondition) GetNotValues() []string {
+	if x != nil {
+		return x.NotValues
+	}
+	return nil
+}
+
+//
+//Match requests by the value of a claim of the request's JWT, which must be validated
+//by the `jwt` policy of a TrafficPolicy applied to the Destination.
+type AccessPolicySpec_Condition_JwtClaimCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the claim.
+	Claim string `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	// The values of the claim, any of which may match.
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	// The values of the claim, none of which may match.
+	NotValues []string `protobuf:"bytes,3,rep,name=not_values,json=notValues,proto3" json:"not_values,omitempty"`
+}
+
+func (x *AccessPolicySpec_Condition_JwtClaimCondition) Reset() {
+	*x = AccessPolicySpec_Condition_JwtClaimCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessPolicySpec_Condition_JwtClaimCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessPolicySpec_Condition_JwtClaimCondition) ProtoMessage() {}
+
+func (x *AccessPolicySpec_Condition_JwtClaimCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessPolicySpec_Condition_JwtClaimCondition.ProtoReflect.Descriptor instead.
+func (*AccessPolicySpec_Condition_JwtClaimCondition) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *AccessPolicySpec_Condition_JwtClaimCondition) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+func (x *AccessPolicySpec_Condition_JwtClaimCondition) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Condition_JwtClaimCondition) GetNotValues() []string {
+	if x != nil {
+		return x.NotValues
+	}
+	return nil
+}
+
+// Match requests by the IP address of the traffic source.
+type AccessPolicySpec_Condition_IpBlockCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IP addresses or CIDR blocks (e.g. "10.0.0.0/16"), any of which may match.
+	IpBlocks []string `protobuf:"bytes,1,rep,name=ip_blocks,json=ipBlocks,proto3" json:"ip_blocks,omitempty"`
+	// IP addresses or CIDR blocks, none of which may match.
+	NotIpBlocks []string `protobuf:"bytes,2,rep,name=not_ip_blocks,json=notIpBlocks,proto3" json:"not_ip_blocks,omitempty"`
+}
+
+func (x *AccessPolicySpec_Condition_IpBlockCondition) Reset() {
+	*x = AccessPolicySpec_Condition_IpBlockCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessPolicySpec_Condition_IpBlockCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessPolicySpec_Condition_IpBlockCondition) ProtoMessage() {}
+
+func (x *AccessPolicySpec_Condition_IpBlockCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessPolicySpec_Condition_IpBlockCondition.ProtoReflect.Descriptor instead.
+func (*AccessPolicySpec_Condition_IpBlockCondition) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDescGZIP(), []int{0, 0, 2}
+}
+
+func (x *AccessPolicySpec_Condition_IpBlockCondition) GetIpBlocks() []string {
+	if x != nil {
+		return x.IpBlocks
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Condition_IpBlockCondition) GetNotIpBlocks() []string {
+	if x != nil {
+		return x.NotIpBlocks
+	}
+	return nil
+}
+
 var File_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDesc = []byte{
@@ -241,7 +612,7 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawD
 	0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12,
 	0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xbc, 0x02, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c,
+	0x74, 0x6f, 0x22, 0x81, 0x09, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c,
 	0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x53, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
@@ -261,37 +632,89 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawD
 	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d,
 	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20,
 	0x03, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74,
-	0x73, 0x22, 0x91, 0x03, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x66, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42,
+	0x73, 0x12, 0x4d, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x35, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65,
+	0x63, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x58, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x53, 0x70, 0x65, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xfa, 0x04, 0x0a, 0x09, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x71, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x48, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63,
+	0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x69, 0x0a, 0x09, 0x6a,
+	0x77, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4a,
 	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
 	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x6d, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
-	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x4a, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f,
-	0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
-	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04,
-	0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4a, 0x77, 0x74, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x6a, 0x77,
+	0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x68, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x70,
+	0x1a, 0x5c, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x60,
+	0x0a, 0x11, 0x4a, 0x77, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x1a, 0x53, 0x0a, 0x10, 0x49, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x49, 0x70, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x1d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x44, 0x45, 0x4e, 0x59, 0x10, 0x01, 0x22, 0x91, 0x03, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a,
+	0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x66, 0x0a,
+	0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
+	0x61, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x6d, 0x0a, 0x11, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x4a, 0x5a, 0x44, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f,
+	0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f,
+	0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -306,27 +729,38 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_raw
 	return file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_goTypes = []interface{}{
-	(*AccessPolicySpec)(nil),       // 0: networking.mesh.gloo.solo.io.AccessPolicySpec
-	(*AccessPolicyStatus)(nil),     // 1: networking.mesh.gloo.solo.io.AccessPolicyStatus
-	nil,                            // 2: networking.mesh.gloo.solo.io.AccessPolicyStatus.DestinationsEntry
-	(*v1.IdentitySelector)(nil),    // 3: common.mesh.gloo.solo.io.IdentitySelector
-	(*v1.DestinationSelector)(nil), // 4: common.mesh.gloo.solo.io.DestinationSelector
-	(v1.ApprovalState)(0),          // 5: common.mesh.gloo.solo.io.ApprovalState
-	(*ApprovalStatus)(nil),         // 6: networking.mesh.gloo.solo.io.ApprovalStatus
+	(AccessPolicySpec_Action)(0),                         // 0: networking.mesh.gloo.solo.io.AccessPolicySpec.Action
+	(*AccessPolicySpec)(nil),                             // 1: networking.mesh.gloo.solo.io.AccessPolicySpec
+	(*AccessPolicyStatus)(nil),                           // 2: networking.mesh.gloo.solo.io.AccessPolicyStatus
+	(*AccessPolicySpec_Condition)(nil),                   // 3: networking.mesh.gloo.solo.io.AccessPolicySpec.Condition
+	(*AccessPolicySpec_Condition_HeaderCondition)(nil),   // 4: networking.mesh.gloo.solo.io.AccessPolicySpec.Condition.HeaderCondition
+	(*AccessPolicySpec_Condition_JwtClaimCondition)(nil), // 5: networking.mesh.gloo.solo.io.AccessPolicySpec.Condition.JwtClaimCondition
+	(*AccessPolicySpec_Condition_IpBlockCondition)(nil),  // 6: networking.mesh.gloo.solo.io.AccessPolicySpec.Condition.IpBlockCondition
+	nil,                            // 7: networking.mesh.gloo.solo.io.AccessPolicyStatus.DestinationsEntry
+	(*v1.IdentitySelector)(nil),    // 8: common.mesh.gloo.solo.io.IdentitySelector
+	(*v1.DestinationSelector)(nil), // 9: common.mesh.gloo.solo.io.DestinationSelector
+	(v1.ApprovalState)(0),          // 10: common.mesh.gloo.solo.io.ApprovalState
+	(*ApprovalStatus)(nil),         // 11: networking.mesh.gloo.solo.io.ApprovalStatus
 }
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_depIdxs = []int32{
-	3, // 0: networking.mesh.gloo.solo.io.AccessPolicySpec.source_selector:type_name -> common.mesh.gloo.solo.io.IdentitySelector
-	4, // 1: networking.mesh.gloo.solo.io.AccessPolicySpec.destination_selector:type_name -> common.mesh.gloo.solo.io.DestinationSelector
-	5, // 2: networking.mesh.gloo.solo.io.AccessPolicyStatus.state:type_name -> common.mesh.gloo.solo.io.ApprovalState
-	2, // 3: networking.mesh.gloo.solo.io.AccessPolicyStatus.destinations:type_name -> networking.mesh.gloo.solo.io.AccessPolicyStatus.DestinationsEntry
-	6, // 4: networking.mesh.gloo.solo.io.AccessPolicyStatus.DestinationsEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	8,  // 0: networking.mesh.gloo.solo.io.AccessPolicySpec.source_selector:type_name -> common.mesh.gloo.solo.io.IdentitySelector
+	9,  // 1: networking.mesh.gloo.solo.io.AccessPolicySpec.destination_selector:type_name -> common.mesh.gloo.solo.io.DestinationSelector
+	0,  // 2: networking.mesh.gloo.solo.io.AccessPolicySpec.action:type_name -> networking.mesh.gloo.solo.io.AccessPolicySpec.Action
+	3,  // 3: networking.mesh.gloo.solo.io.AccessPolicySpec.conditions:type_name -> networking.mesh.gloo.solo.io.AccessPolicySpec.Condition
+	10, // 4: networking.mesh.gloo.solo.io.AccessPolicyStatus.state:type_name -> common.mesh.gloo.solo.io.ApprovalState
+	7,  // 5: networking.mesh.gloo.solo.io.AccessPolicyStatus.destinations:type_name -> networking.mesh.gloo.solo.io.AccessPolicyStatus.DestinationsEntry
+	4,  // 6: networking.mesh.gloo.solo.io.AccessPolicySpec.Condition.request_header:type_name -> networking.mesh.gloo.solo.io.AccessPolicySpec.Condition.HeaderCondition
+	5,  // 7: networking.mesh.gloo.solo.io.AccessPolicySpec.Condition.jwt_claim:type_name -> networking.mesh.gloo.solo.io.AccessPolicySpec.Condition.JwtClaimCondition
+	6,  // 8: networking.mesh.gloo.solo.io.AccessPolicySpec.Condition.source_ip:type_name -> networking.mesh.gloo.solo.io.AccessPolicySpec.Condition.IpBlockCondition
+	11, // 9: networking.mesh.gloo.solo.io.AccessPolicyStatus.DestinationsEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_init() }
@@ -360,19 +794,73 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_ini
 				return nil
 			}
 		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessPolicySpec_Condition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessPolicySpec_Condition_HeaderCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessPolicySpec_Condition_JwtClaimCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessPolicySpec_Condition_IpBlockCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*AccessPolicySpec_Condition_RequestHeader)(nil),
+		(*AccessPolicySpec_Condition_JwtClaim)(nil),
+		(*AccessPolicySpec_Condition_SourceIp)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_goTypes,
 		DependencyIndexes: file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_enumTypes,
 		MessageInfos:      file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes,
 	}.Build()
 	File_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto = out.File
@@ -45,6 +45,8 @@ type Clientset interface {
 	AccessPolicies() AccessPolicyClient
 	// clienset for the networking.mesh.gloo.solo.io/v1/v1 APIs
 	VirtualMeshes() VirtualMeshClient
+	// clienset for the networking.mesh.gloo.solo.io/v1/v1 APIs
+	EgressGatewayPolicies() EgressGatewayPolicyClient
 }
 
 type clientSet struct {
@@ -84,6 +86,11 @@ func (c *clientSet) VirtualMeshes() VirtualMeshClient {
 	return NewVirtualMeshClient(c.client)
 }
 
+// clienset for the networking.mesh.gloo.solo.io/v1/v1 APIs
+func (c *clientSet) EgressGatewayPolicies() EgressGatewayPolicyClient {
+	return NewEgressGatewayPolicyClient(c.client)
+}
+
 // Reader knows how to read and list TrafficPolicys.
 type TrafficPolicyReader interface {
 	// Get retrieves a TrafficPolicy for the given object key
@@ -509,3 +516,145 @@ func (m *multiclusterVirtualMeshClient) Cluster(cluster string) (VirtualMeshClie
 	}
 	return NewVirtualMeshClient(client), nil
 }
+
+// Reader knows how to read and list EgressGatewayPolicys.
+type EgressGatewayPolicyReader interface {
+	// Get retrieves a EgressGatewayPolicy for the given object key
+	GetEgressGatewayPolicy(ctx context.Context, key client.ObjectKey) (*EgressGatewayPolicy, error)
+
+	// List retrieves list of EgressGatewayPolicys for a given namespace and list options.
+	ListEgressGatewayPolicy(ctx context.Context, opts ...client.ListOption) (*EgressGatewayPolicyList, error)
+}
+
+// EgressGatewayPolicyTransitionFunction instructs the EgressGatewayPolicyWriter how to transition between an existing
+// EgressGatewayPolicy object and a desired on an Upsert
+type EgressGatewayPolicyTransitionFunction func(existing, desired *EgressGatewayPolicy) error
+
+// Writer knows how to create, delete, and update EgressGatewayPolicys.
+type EgressGatewayPolicyWriter interface {
+	// Create saves the EgressGatewayPolicy object.
+	CreateEgressGatewayPolicy(ctx context.Context, obj *EgressGatewayPolicy, opts ...client.CreateOption) error
+
+	// Delete deletes the EgressGatewayPolicy object.
+	DeleteEgressGatewayPolicy(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error
+
+	// Update updates the given EgressGatewayPolicy object.
+	UpdateEgressGatewayPolicy(ctx context.Context, obj *EgressGatewayPolicy, opts ...client.UpdateOption) error
+
+	// Patch patches the given EgressGatewayPolicy object.
+	PatchEgressGatewayPolicy(ctx context.Context, obj *EgressGatewayPolicy, patch client.Patch, opts ...client.PatchOption) error
+
+	// DeleteAllOf deletes all EgressGatewayPolicy objects matching the given options.
+	DeleteAllOfEgressGatewayPolicy(ctx context.Context, opts ...client.DeleteAllOfOption) error
+
+	// Create or Update the EgressGatewayPolicy object.
+	UpsertEgressGatewayPolicy(ctx context.Context, obj *EgressGatewayPolicy, transitionFuncs ...EgressGatewayPolicyTransitionFunction) error
+}
+
+// StatusWriter knows how to update status subresource of a EgressGatewayPolicy object.
+type EgressGatewayPolicyStatusWriter interface {
+	// Update updates the fields corresponding to the status subresource for the
+	// given EgressGatewayPolicy object.
+	UpdateEgressGatewayPolicyStatus(ctx context.Context, obj *EgressGatewayPolicy, opts ...client.UpdateOption) error
+
+	// Patch patches the given EgressGatewayPolicy object's subresource.
+	PatchEgressGatewayPolicyStatus(ctx context.Context, obj *EgressGatewayPolicy, patch client.Patch, opts ...client.PatchOption) error
+}
+
+// Client knows how to perform CRUD operations on EgressGatewayPolicys.
+type EgressGatewayPolicyClient interface {
+	EgressGatewayPolicyReader
+	EgressGatewayPolicyWriter
+	EgressGatewayPolicyStatusWriter
+}
+
+type egressGatewayPolicyClient struct {
+	client client.Client
+}
+
+func NewEgressGatewayPolicyClient(client client.Client) *egressGatewayPolicyClient {
+	return &egressGatewayPolicyClient{client: client}
+}
+
+func (c *egressGatewayPolicyClient) GetEgressGatewayPolicy(ctx context.Context, key client.ObjectKey) (*EgressGatewayPolicy, error) {
+	obj := &EgressGatewayPolicy{}
+	if err := c.client.Get(ctx, key, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (c *egressGatewayPolicyClient) ListEgressGatewayPolicy(ctx context.Context, opts ...client.ListOption) (*EgressGatewayPolicyList, error) {
+	list := &EgressGatewayPolicyList{}
+	if err := c.client.List(ctx, list, opts...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (c *egressGatewayPolicyClient) CreateEgressGatewayPolicy(ctx context.Context, obj *EgressGatewayPolicy, opts ...client.CreateOption) error {
+	return c.client.Create(ctx, obj, opts...)
+}
+
+func (c *egressGatewayPolicyClient) DeleteEgressGatewayPolicy(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	obj := &EgressGatewayPolicy{}
+	obj.SetName(key.Name)
+	obj.SetNamespace(key.Namespace)
+	return c.client.Delete(ctx, obj, opts...)
+}
+
+func (c *egressGatewayPolicyClient) UpdateEgressGatewayPolicy(ctx context.Context, obj *EgressGatewayPolicy, opts ...client.UpdateOption) error {
+	return c.client.Update(ctx, obj, opts...)
+}
+
+func (c *egressGatewayPolicyClient) PatchEgressGatewayPolicy(ctx context.Context, obj *EgressGatewayPolicy, patch client.Patch, opts ...client.PatchOption) error {
+	return c.client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *egressGatewayPolicyClient) DeleteAllOfEgressGatewayPolicy(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	obj := &EgressGatewayPolicy{}
+	return c.client.DeleteAllOf(ctx, obj, opts...)
+}
+
+func (c *egressGatewayPolicyClient) UpsertEgressGatewayPolicy(ctx context.Context, obj *EgressGatewayPolicy, transitionFuncs ...EgressGatewayPolicyTransitionFunction) error {
+	genericTxFunc := func(existing, desired runtime.Object) error {
+		for _, txFunc := range transitionFuncs {
+			if err := txFunc(existing.(*EgressGatewayPolicy), desired.(*EgressGatewayPolicy)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	_, err := controllerutils.Upsert(ctx, c.client, obj, genericTxFunc)
+	return err
+}
+
+func (c *egressGatewayPolicyClient) UpdateEgressGatewayPolicyStatus(ctx context.Context, obj *EgressGatewayPolicy, opts ...client.UpdateOption) error {
+	return c.client.Status().Update(ctx, obj, opts...)
+}
+
+func (c *egressGatewayPolicyClient) PatchEgressGatewayPolicyStatus(ctx context.Context, obj *EgressGatewayPolicy, patch client.Patch, opts ...client.PatchOption) error {
+	return c.client.Status().Patch(ctx, obj, patch, opts...)
+}
+
+// Provides EgressGatewayPolicyClients for multiple clusters.
+type MulticlusterEgressGatewayPolicyClient interface {
+	// Cluster returns a EgressGatewayPolicyClient for the given cluster
+	Cluster(cluster string) (EgressGatewayPolicyClient, error)
+}
+
+type multiclusterEgressGatewayPolicyClient struct {
+	client multicluster.Client
+}
+
+func NewMulticlusterEgressGatewayPolicyClient(client multicluster.Client) MulticlusterEgressGatewayPolicyClient {
+	return &multiclusterEgressGatewayPolicyClient{client: client}
+}
+
+func (m *multiclusterEgressGatewayPolicyClient) Cluster(cluster string) (EgressGatewayPolicyClient, error) {
+	client, err := m.client.Cluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return NewEgressGatewayPolicyClient(client), nil
+}
@@ -337,3 +337,110 @@ func (h genericVirtualMeshHandler) Generic(object client.Object) error {
 	}
 	return h.handler.GenericVirtualMesh(obj)
 }
+
+// Handle events for the EgressGatewayPolicy Resource
+// DEPRECATED: Prefer reconciler pattern.
+type EgressGatewayPolicyEventHandler interface {
+	CreateEgressGatewayPolicy(obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) error
+	UpdateEgressGatewayPolicy(old, new *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) error
+	DeleteEgressGatewayPolicy(obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) error
+	GenericEgressGatewayPolicy(obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) error
+}
+
+type EgressGatewayPolicyEventHandlerFuncs struct {
+	OnCreate  func(obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) error
+	OnUpdate  func(old, new *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) error
+	OnDelete  func(obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) error
+	OnGeneric func(obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) error
+}
+
+func (f *EgressGatewayPolicyEventHandlerFuncs) CreateEgressGatewayPolicy(obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) error {
+	if f.OnCreate == nil {
+		return nil
+	}
+	return f.OnCreate(obj)
+}
+
+func (f *EgressGatewayPolicyEventHandlerFuncs) DeleteEgressGatewayPolicy(obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) error {
+	if f.OnDelete == nil {
+		return nil
+	}
+	return f.OnDelete(obj)
+}
+
+func (f *EgressGatewayPolicyEventHandlerFuncs) UpdateEgressGatewayPolicy(objOld, objNew *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) error {
+	if f.OnUpdate == nil {
+		return nil
+	}
+	return f.OnUpdate(objOld, objNew)
+}
+
+func (f *EgressGatewayPolicyEventHandlerFuncs) GenericEgressGatewayPolicy(obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) error {
+	if f.OnGeneric == nil {
+		return nil
+	}
+	return f.OnGeneric(obj)
+}
+
+type EgressGatewayPolicyEventWatcher interface {
+	AddEventHandler(ctx context.Context, h EgressGatewayPolicyEventHandler, predicates ...predicate.Predicate) error
+}
+
+type egressGatewayPolicyEventWatcher struct {
+	watcher events.EventWatcher
+}
+
+func NewEgressGatewayPolicyEventWatcher(name string, mgr manager.Manager) EgressGatewayPolicyEventWatcher {
+	return &egressGatewayPolicyEventWatcher{
+		watcher: events.NewWatcher(name, mgr, &networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy{}),
+	}
+}
+
+func (c *egressGatewayPolicyEventWatcher) AddEventHandler(ctx context.Context, h EgressGatewayPolicyEventHandler, predicates ...predicate.Predicate) error {
+	handler := genericEgressGatewayPolicyHandler{handler: h}
+	if err := c.watcher.Watch(ctx, handler, predicates...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// genericEgressGatewayPolicyHandler implements a generic events.EventHandler
+type genericEgressGatewayPolicyHandler struct {
+	handler EgressGatewayPolicyEventHandler
+}
+
+func (h genericEgressGatewayPolicyHandler) Create(object client.Object) error {
+	obj, ok := object.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy)
+	if !ok {
+		return errors.Errorf("internal error: EgressGatewayPolicy handler received event for %T", object)
+	}
+	return h.handler.CreateEgressGatewayPolicy(obj)
+}
+
+func (h genericEgressGatewayPolicyHandler) Delete(object client.Object) error {
+	obj, ok := object.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy)
+	if !ok {
+		return errors.Errorf("internal error: EgressGatewayPolicy handler received event for %T", object)
+	}
+	return h.handler.DeleteEgressGatewayPolicy(obj)
+}
+
+func (h genericEgressGatewayPolicyHandler) Update(old, new client.Object) error {
+	objOld, ok := old.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy)
+	if !ok {
+		return errors.Errorf("internal error: EgressGatewayPolicy handler received event for %T", old)
+	}
+	objNew, ok := new.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy)
+	if !ok {
+		return errors.Errorf("internal error: EgressGatewayPolicy handler received event for %T", new)
+	}
+	return h.handler.UpdateEgressGatewayPolicy(objOld, objNew)
+}
+
+func (h genericEgressGatewayPolicyHandler) Generic(object client.Object) error {
+	obj, ok := object.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy)
+	if !ok {
+		return errors.Errorf("internal error: EgressGatewayPolicy handler received event for %T", object)
+	}
+	return h.handler.GenericEgressGatewayPolicy(obj)
+}
@@ -376,3 +376,124 @@ func (mr *MockVirtualMeshEventWatcherMockRecorder) AddEventHandler(ctx, h interf
 	varargs := append([]interface{}{ctx, h}, predicates...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventHandler", reflect.TypeOf((*MockVirtualMeshEventWatcher)(nil).AddEventHandler), varargs...)
 }
+
+// MockEgressGatewayPolicyEventHandler is a mock of EgressGatewayPolicyEventHandler interface.
+type MockEgressGatewayPolicyEventHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockEgressGatewayPolicyEventHandlerMockRecorder
+}
+
+// MockEgressGatewayPolicyEventHandlerMockRecorder is the mock recorder for MockEgressGatewayPolicyEventHandler.
+type MockEgressGatewayPolicyEventHandlerMockRecorder struct {
+	mock *MockEgressGatewayPolicyEventHandler
+}
+
+// NewMockEgressGatewayPolicyEventHandler creates a new mock instance.
+func NewMockEgressGatewayPolicyEventHandler(ctrl *gomock.Controller) *MockEgressGatewayPolicyEventHandler {
+	mock := &MockEgressGatewayPolicyEventHandler{ctrl: ctrl}
+	mock.recorder = &MockEgressGatewayPolicyEventHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEgressGatewayPolicyEventHandler) EXPECT() *MockEgressGatewayPolicyEventHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyEventHandler) CreateEgressGatewayPolicy(obj *v1.EgressGatewayPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEgressGatewayPolicy", obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEgressGatewayPolicy indicates an expected call of CreateEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyEventHandlerMockRecorder) CreateEgressGatewayPolicy(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyEventHandler)(nil).CreateEgressGatewayPolicy), obj)
+}
+
+// DeleteEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyEventHandler) DeleteEgressGatewayPolicy(obj *v1.EgressGatewayPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEgressGatewayPolicy", obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEgressGatewayPolicy indicates an expected call of DeleteEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyEventHandlerMockRecorder) DeleteEgressGatewayPolicy(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyEventHandler)(nil).DeleteEgressGatewayPolicy), obj)
+}
+
+// GenericEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyEventHandler) GenericEgressGatewayPolicy(obj *v1.EgressGatewayPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenericEgressGatewayPolicy", obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenericEgressGatewayPolicy indicates an expected call of GenericEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyEventHandlerMockRecorder) GenericEgressGatewayPolicy(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenericEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyEventHandler)(nil).GenericEgressGatewayPolicy), obj)
+}
+
+// UpdateEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyEventHandler) UpdateEgressGatewayPolicy(old, new *v1.EgressGatewayPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEgressGatewayPolicy", old, new)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEgressGatewayPolicy indicates an expected call of UpdateEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyEventHandlerMockRecorder) UpdateEgressGatewayPolicy(old, new interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyEventHandler)(nil).UpdateEgressGatewayPolicy), old, new)
+}
+
+// MockEgressGatewayPolicyEventWatcher is a mock of EgressGatewayPolicyEventWatcher interface.
+type MockEgressGatewayPolicyEventWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockEgressGatewayPolicyEventWatcherMockRecorder
+}
+
+// MockEgressGatewayPolicyEventWatcherMockRecorder is the mock recorder for MockEgressGatewayPolicyEventWatcher.
+type MockEgressGatewayPolicyEventWatcherMockRecorder struct {
+	mock *MockEgressGatewayPolicyEventWatcher
+}
+
+// NewMockEgressGatewayPolicyEventWatcher creates a new mock instance.
+func NewMockEgressGatewayPolicyEventWatcher(ctrl *gomock.Controller) *MockEgressGatewayPolicyEventWatcher {
+	mock := &MockEgressGatewayPolicyEventWatcher{ctrl: ctrl}
+	mock.recorder = &MockEgressGatewayPolicyEventWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEgressGatewayPolicyEventWatcher) EXPECT() *MockEgressGatewayPolicyEventWatcherMockRecorder {
+	return m.recorder
+}
+
+// AddEventHandler mocks base method.
+func (m *MockEgressGatewayPolicyEventWatcher) AddEventHandler(ctx context.Context, h controller.EgressGatewayPolicyEventHandler, predicates ...predicate.Predicate) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, h}
+	for _, a := range predicates {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddEventHandler", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEventHandler indicates an expected call of AddEventHandler.
+func (mr *MockEgressGatewayPolicyEventWatcherMockRecorder) AddEventHandler(ctx, h interface{}, predicates ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, h}, predicates...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventHandler", reflect.TypeOf((*MockEgressGatewayPolicyEventWatcher)(nil).AddEventHandler), varargs...)
+}
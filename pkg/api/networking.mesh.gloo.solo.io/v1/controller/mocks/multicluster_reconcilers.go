@@ -359,3 +359,118 @@ func (mr *MockMulticlusterVirtualMeshReconcileLoopMockRecorder) AddMulticlusterV
 	varargs := append([]interface{}{ctx, rec}, predicates...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMulticlusterVirtualMeshReconciler", reflect.TypeOf((*MockMulticlusterVirtualMeshReconcileLoop)(nil).AddMulticlusterVirtualMeshReconciler), varargs...)
 }
+
+// MockMulticlusterEgressGatewayPolicyReconciler is a mock of MulticlusterEgressGatewayPolicyReconciler interface.
+type MockMulticlusterEgressGatewayPolicyReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterEgressGatewayPolicyReconcilerMockRecorder
+}
+
+// MockMulticlusterEgressGatewayPolicyReconcilerMockRecorder is the mock recorder for MockMulticlusterEgressGatewayPolicyReconciler.
+type MockMulticlusterEgressGatewayPolicyReconcilerMockRecorder struct {
+	mock *MockMulticlusterEgressGatewayPolicyReconciler
+}
+
+// NewMockMulticlusterEgressGatewayPolicyReconciler creates a new mock instance.
+func NewMockMulticlusterEgressGatewayPolicyReconciler(ctrl *gomock.Controller) *MockMulticlusterEgressGatewayPolicyReconciler {
+	mock := &MockMulticlusterEgressGatewayPolicyReconciler{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterEgressGatewayPolicyReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterEgressGatewayPolicyReconciler) EXPECT() *MockMulticlusterEgressGatewayPolicyReconcilerMockRecorder {
+	return m.recorder
+}
+
+// ReconcileEgressGatewayPolicy mocks base method.
+func (m *MockMulticlusterEgressGatewayPolicyReconciler) ReconcileEgressGatewayPolicy(clusterName string, obj *v1.EgressGatewayPolicy) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileEgressGatewayPolicy", clusterName, obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileEgressGatewayPolicy indicates an expected call of ReconcileEgressGatewayPolicy.
+func (mr *MockMulticlusterEgressGatewayPolicyReconcilerMockRecorder) ReconcileEgressGatewayPolicy(clusterName, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileEgressGatewayPolicy", reflect.TypeOf((*MockMulticlusterEgressGatewayPolicyReconciler)(nil).ReconcileEgressGatewayPolicy), clusterName, obj)
+}
+
+// MockMulticlusterEgressGatewayPolicyDeletionReconciler is a mock of MulticlusterEgressGatewayPolicyDeletionReconciler interface.
+type MockMulticlusterEgressGatewayPolicyDeletionReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterEgressGatewayPolicyDeletionReconcilerMockRecorder
+}
+
+// MockMulticlusterEgressGatewayPolicyDeletionReconcilerMockRecorder is the mock recorder for MockMulticlusterEgressGatewayPolicyDeletionReconciler.
+type MockMulticlusterEgressGatewayPolicyDeletionReconcilerMockRecorder struct {
+	mock *MockMulticlusterEgressGatewayPolicyDeletionReconciler
+}
+
+// NewMockMulticlusterEgressGatewayPolicyDeletionReconciler creates a new mock instance.
+func NewMockMulticlusterEgressGatewayPolicyDeletionReconciler(ctrl *gomock.Controller) *MockMulticlusterEgressGatewayPolicyDeletionReconciler {
+	mock := &MockMulticlusterEgressGatewayPolicyDeletionReconciler{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterEgressGatewayPolicyDeletionReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterEgressGatewayPolicyDeletionReconciler) EXPECT() *MockMulticlusterEgressGatewayPolicyDeletionReconcilerMockRecorder {
+	return m.recorder
+}
+
+// ReconcileEgressGatewayPolicyDeletion mocks base method.
+func (m *MockMulticlusterEgressGatewayPolicyDeletionReconciler) ReconcileEgressGatewayPolicyDeletion(clusterName string, req reconcile.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileEgressGatewayPolicyDeletion", clusterName, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileEgressGatewayPolicyDeletion indicates an expected call of ReconcileEgressGatewayPolicyDeletion.
+func (mr *MockMulticlusterEgressGatewayPolicyDeletionReconcilerMockRecorder) ReconcileEgressGatewayPolicyDeletion(clusterName, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileEgressGatewayPolicyDeletion", reflect.TypeOf((*MockMulticlusterEgressGatewayPolicyDeletionReconciler)(nil).ReconcileEgressGatewayPolicyDeletion), clusterName, req)
+}
+
+// MockMulticlusterEgressGatewayPolicyReconcileLoop is a mock of MulticlusterEgressGatewayPolicyReconcileLoop interface.
+type MockMulticlusterEgressGatewayPolicyReconcileLoop struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterEgressGatewayPolicyReconcileLoopMockRecorder
+}
+
+// MockMulticlusterEgressGatewayPolicyReconcileLoopMockRecorder is the mock recorder for MockMulticlusterEgressGatewayPolicyReconcileLoop.
+type MockMulticlusterEgressGatewayPolicyReconcileLoopMockRecorder struct {
+	mock *MockMulticlusterEgressGatewayPolicyReconcileLoop
+}
+
+// NewMockMulticlusterEgressGatewayPolicyReconcileLoop creates a new mock instance.
+func NewMockMulticlusterEgressGatewayPolicyReconcileLoop(ctrl *gomock.Controller) *MockMulticlusterEgressGatewayPolicyReconcileLoop {
+	mock := &MockMulticlusterEgressGatewayPolicyReconcileLoop{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterEgressGatewayPolicyReconcileLoopMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterEgressGatewayPolicyReconcileLoop) EXPECT() *MockMulticlusterEgressGatewayPolicyReconcileLoopMockRecorder {
+	return m.recorder
+}
+
+// AddMulticlusterEgressGatewayPolicyReconciler mocks base method.
+func (m *MockMulticlusterEgressGatewayPolicyReconcileLoop) AddMulticlusterEgressGatewayPolicyReconciler(ctx context.Context, rec controller.MulticlusterEgressGatewayPolicyReconciler, predicates ...predicate.Predicate) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, rec}
+	for _, a := range predicates {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "AddMulticlusterEgressGatewayPolicyReconciler", varargs...)
+}
+
+// AddMulticlusterEgressGatewayPolicyReconciler indicates an expected call of AddMulticlusterEgressGatewayPolicyReconciler.
+func (mr *MockMulticlusterEgressGatewayPolicyReconcileLoopMockRecorder) AddMulticlusterEgressGatewayPolicyReconciler(ctx, rec interface{}, predicates ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, rec}, predicates...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMulticlusterEgressGatewayPolicyReconciler", reflect.TypeOf((*MockMulticlusterEgressGatewayPolicyReconcileLoop)(nil).AddMulticlusterEgressGatewayPolicyReconciler), varargs...)
+}
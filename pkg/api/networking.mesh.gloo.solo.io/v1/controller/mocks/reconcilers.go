@@ -563,3 +563,186 @@ func (mr *MockVirtualMeshReconcileLoopMockRecorder) RunVirtualMeshReconciler(ctx
 	varargs := append([]interface{}{ctx, rec}, predicates...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunVirtualMeshReconciler", reflect.TypeOf((*MockVirtualMeshReconcileLoop)(nil).RunVirtualMeshReconciler), varargs...)
 }
+
+// MockEgressGatewayPolicyReconciler is a mock of EgressGatewayPolicyReconciler interface.
+type MockEgressGatewayPolicyReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockEgressGatewayPolicyReconcilerMockRecorder
+}
+
+// MockEgressGatewayPolicyReconcilerMockRecorder is the mock recorder for MockEgressGatewayPolicyReconciler.
+type MockEgressGatewayPolicyReconcilerMockRecorder struct {
+	mock *MockEgressGatewayPolicyReconciler
+}
+
+// NewMockEgressGatewayPolicyReconciler creates a new mock instance.
+func NewMockEgressGatewayPolicyReconciler(ctrl *gomock.Controller) *MockEgressGatewayPolicyReconciler {
+	mock := &MockEgressGatewayPolicyReconciler{ctrl: ctrl}
+	mock.recorder = &MockEgressGatewayPolicyReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEgressGatewayPolicyReconciler) EXPECT() *MockEgressGatewayPolicyReconcilerMockRecorder {
+	return m.recorder
+}
+
+// ReconcileEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyReconciler) ReconcileEgressGatewayPolicy(obj *v1.EgressGatewayPolicy) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileEgressGatewayPolicy", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileEgressGatewayPolicy indicates an expected call of ReconcileEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyReconcilerMockRecorder) ReconcileEgressGatewayPolicy(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyReconciler)(nil).ReconcileEgressGatewayPolicy), obj)
+}
+
+// MockEgressGatewayPolicyDeletionReconciler is a mock of EgressGatewayPolicyDeletionReconciler interface.
+type MockEgressGatewayPolicyDeletionReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockEgressGatewayPolicyDeletionReconcilerMockRecorder
+}
+
+// MockEgressGatewayPolicyDeletionReconcilerMockRecorder is the mock recorder for MockEgressGatewayPolicyDeletionReconciler.
+type MockEgressGatewayPolicyDeletionReconcilerMockRecorder struct {
+	mock *MockEgressGatewayPolicyDeletionReconciler
+}
+
+// NewMockEgressGatewayPolicyDeletionReconciler creates a new mock instance.
+func NewMockEgressGatewayPolicyDeletionReconciler(ctrl *gomock.Controller) *MockEgressGatewayPolicyDeletionReconciler {
+	mock := &MockEgressGatewayPolicyDeletionReconciler{ctrl: ctrl}
+	mock.recorder = &MockEgressGatewayPolicyDeletionReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEgressGatewayPolicyDeletionReconciler) EXPECT() *MockEgressGatewayPolicyDeletionReconcilerMockRecorder {
+	return m.recorder
+}
+
+// ReconcileEgressGatewayPolicyDeletion mocks base method.
+func (m *MockEgressGatewayPolicyDeletionReconciler) ReconcileEgressGatewayPolicyDeletion(req reconcile.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileEgressGatewayPolicyDeletion", req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileEgressGatewayPolicyDeletion indicates an expected call of ReconcileEgressGatewayPolicyDeletion.
+func (mr *MockEgressGatewayPolicyDeletionReconcilerMockRecorder) ReconcileEgressGatewayPolicyDeletion(req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileEgressGatewayPolicyDeletion", reflect.TypeOf((*MockEgressGatewayPolicyDeletionReconciler)(nil).ReconcileEgressGatewayPolicyDeletion), req)
+}
+
+// MockEgressGatewayPolicyFinalizer is a mock of EgressGatewayPolicyFinalizer interface.
+type MockEgressGatewayPolicyFinalizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEgressGatewayPolicyFinalizerMockRecorder
+}
+
+// MockEgressGatewayPolicyFinalizerMockRecorder is the mock recorder for MockEgressGatewayPolicyFinalizer.
+type MockEgressGatewayPolicyFinalizerMockRecorder struct {
+	mock *MockEgressGatewayPolicyFinalizer
+}
+
+// NewMockEgressGatewayPolicyFinalizer creates a new mock instance.
+func NewMockEgressGatewayPolicyFinalizer(ctrl *gomock.Controller) *MockEgressGatewayPolicyFinalizer {
+	mock := &MockEgressGatewayPolicyFinalizer{ctrl: ctrl}
+	mock.recorder = &MockEgressGatewayPolicyFinalizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEgressGatewayPolicyFinalizer) EXPECT() *MockEgressGatewayPolicyFinalizerMockRecorder {
+	return m.recorder
+}
+
+// EgressGatewayPolicyFinalizerName mocks base method.
+func (m *MockEgressGatewayPolicyFinalizer) EgressGatewayPolicyFinalizerName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EgressGatewayPolicyFinalizerName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// EgressGatewayPolicyFinalizerName indicates an expected call of EgressGatewayPolicyFinalizerName.
+func (mr *MockEgressGatewayPolicyFinalizerMockRecorder) EgressGatewayPolicyFinalizerName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EgressGatewayPolicyFinalizerName", reflect.TypeOf((*MockEgressGatewayPolicyFinalizer)(nil).EgressGatewayPolicyFinalizerName))
+}
+
+// FinalizeEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyFinalizer) FinalizeEgressGatewayPolicy(obj *v1.EgressGatewayPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinalizeEgressGatewayPolicy", obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinalizeEgressGatewayPolicy indicates an expected call of FinalizeEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyFinalizerMockRecorder) FinalizeEgressGatewayPolicy(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinalizeEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyFinalizer)(nil).FinalizeEgressGatewayPolicy), obj)
+}
+
+// ReconcileEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyFinalizer) ReconcileEgressGatewayPolicy(obj *v1.EgressGatewayPolicy) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileEgressGatewayPolicy", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileEgressGatewayPolicy indicates an expected call of ReconcileEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyFinalizerMockRecorder) ReconcileEgressGatewayPolicy(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyFinalizer)(nil).ReconcileEgressGatewayPolicy), obj)
+}
+
+// MockEgressGatewayPolicyReconcileLoop is a mock of EgressGatewayPolicyReconcileLoop interface.
+type MockEgressGatewayPolicyReconcileLoop struct {
+	ctrl     *gomock.Controller
+	recorder *MockEgressGatewayPolicyReconcileLoopMockRecorder
+}
+
+// MockEgressGatewayPolicyReconcileLoopMockRecorder is the mock recorder for MockEgressGatewayPolicyReconcileLoop.
+type MockEgressGatewayPolicyReconcileLoopMockRecorder struct {
+	mock *MockEgressGatewayPolicyReconcileLoop
+}
+
+// NewMockEgressGatewayPolicyReconcileLoop creates a new mock instance.
+func NewMockEgressGatewayPolicyReconcileLoop(ctrl *gomock.Controller) *MockEgressGatewayPolicyReconcileLoop {
+	mock := &MockEgressGatewayPolicyReconcileLoop{ctrl: ctrl}
+	mock.recorder = &MockEgressGatewayPolicyReconcileLoopMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEgressGatewayPolicyReconcileLoop) EXPECT() *MockEgressGatewayPolicyReconcileLoopMockRecorder {
+	return m.recorder
+}
+
+// RunEgressGatewayPolicyReconciler mocks base method.
+func (m *MockEgressGatewayPolicyReconcileLoop) RunEgressGatewayPolicyReconciler(ctx context.Context, rec controller.EgressGatewayPolicyReconciler, predicates ...predicate.Predicate) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, rec}
+	for _, a := range predicates {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunEgressGatewayPolicyReconciler", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunEgressGatewayPolicyReconciler indicates an expected call of RunEgressGatewayPolicyReconciler.
+func (mr *MockEgressGatewayPolicyReconcileLoopMockRecorder) RunEgressGatewayPolicyReconciler(ctx, rec interface{}, predicates ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, rec}, predicates...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunEgressGatewayPolicyReconciler", reflect.TypeOf((*MockEgressGatewayPolicyReconcileLoop)(nil).RunEgressGatewayPolicyReconciler), varargs...)
+}
@@ -230,3 +230,74 @@ func (g genericVirtualMeshMulticlusterReconciler) Reconcile(cluster string, obje
 	}
 	return g.reconciler.ReconcileVirtualMesh(cluster, obj)
 }
+
+// Reconcile Upsert events for the EgressGatewayPolicy Resource across clusters.
+// implemented by the user
+type MulticlusterEgressGatewayPolicyReconciler interface {
+	ReconcileEgressGatewayPolicy(clusterName string, obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) (reconcile.Result, error)
+}
+
+// Reconcile deletion events for the EgressGatewayPolicy Resource across clusters.
+// Deletion receives a reconcile.Request as we cannot guarantee the last state of the object
+// before being deleted.
+// implemented by the user
+type MulticlusterEgressGatewayPolicyDeletionReconciler interface {
+	ReconcileEgressGatewayPolicyDeletion(clusterName string, req reconcile.Request) error
+}
+
+type MulticlusterEgressGatewayPolicyReconcilerFuncs struct {
+	OnReconcileEgressGatewayPolicy         func(clusterName string, obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) (reconcile.Result, error)
+	OnReconcileEgressGatewayPolicyDeletion func(clusterName string, req reconcile.Request) error
+}
+
+func (f *MulticlusterEgressGatewayPolicyReconcilerFuncs) ReconcileEgressGatewayPolicy(clusterName string, obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) (reconcile.Result, error) {
+	if f.OnReconcileEgressGatewayPolicy == nil {
+		return reconcile.Result{}, nil
+	}
+	return f.OnReconcileEgressGatewayPolicy(clusterName, obj)
+}
+
+func (f *MulticlusterEgressGatewayPolicyReconcilerFuncs) ReconcileEgressGatewayPolicyDeletion(clusterName string, req reconcile.Request) error {
+	if f.OnReconcileEgressGatewayPolicyDeletion == nil {
+		return nil
+	}
+	return f.OnReconcileEgressGatewayPolicyDeletion(clusterName, req)
+}
+
+type MulticlusterEgressGatewayPolicyReconcileLoop interface {
+	// AddMulticlusterEgressGatewayPolicyReconciler adds a MulticlusterEgressGatewayPolicyReconciler to the MulticlusterEgressGatewayPolicyReconcileLoop.
+	AddMulticlusterEgressGatewayPolicyReconciler(ctx context.Context, rec MulticlusterEgressGatewayPolicyReconciler, predicates ...predicate.Predicate)
+}
+
+type multiclusterEgressGatewayPolicyReconcileLoop struct {
+	loop multicluster.Loop
+}
+
+func (m *multiclusterEgressGatewayPolicyReconcileLoop) AddMulticlusterEgressGatewayPolicyReconciler(ctx context.Context, rec MulticlusterEgressGatewayPolicyReconciler, predicates ...predicate.Predicate) {
+	genericReconciler := genericEgressGatewayPolicyMulticlusterReconciler{reconciler: rec}
+
+	m.loop.AddReconciler(ctx, genericReconciler, predicates...)
+}
+
+func NewMulticlusterEgressGatewayPolicyReconcileLoop(name string, cw multicluster.ClusterWatcher, options reconcile.Options) MulticlusterEgressGatewayPolicyReconcileLoop {
+	return &multiclusterEgressGatewayPolicyReconcileLoop{loop: mc_reconcile.NewLoop(name, cw, &networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy{}, options)}
+}
+
+type genericEgressGatewayPolicyMulticlusterReconciler struct {
+	reconciler MulticlusterEgressGatewayPolicyReconciler
+}
+
+func (g genericEgressGatewayPolicyMulticlusterReconciler) ReconcileDeletion(cluster string, req reconcile.Request) error {
+	if deletionReconciler, ok := g.reconciler.(MulticlusterEgressGatewayPolicyDeletionReconciler); ok {
+		return deletionReconciler.ReconcileEgressGatewayPolicyDeletion(cluster, req)
+	}
+	return nil
+}
+
+func (g genericEgressGatewayPolicyMulticlusterReconciler) Reconcile(cluster string, object ezkube.Object) (reconcile.Result, error) {
+	obj, ok := object.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy)
+	if !ok {
+		return reconcile.Result{}, errors.Errorf("internal error: EgressGatewayPolicy handler received event for %T", object)
+	}
+	return g.reconciler.ReconcileEgressGatewayPolicy(cluster, obj)
+}
@@ -367,3 +367,120 @@ func (r genericVirtualMeshFinalizer) Finalize(object ezkube.Object) error {
 	}
 	return r.finalizingReconciler.FinalizeVirtualMesh(obj)
 }
+
+// Reconcile Upsert events for the EgressGatewayPolicy Resource.
+// implemented by the user
+type EgressGatewayPolicyReconciler interface {
+	ReconcileEgressGatewayPolicy(obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) (reconcile.Result, error)
+}
+
+// Reconcile deletion events for the EgressGatewayPolicy Resource.
+// Deletion receives a reconcile.Request as we cannot guarantee the last state of the object
+// before being deleted.
+// implemented by the user
+type EgressGatewayPolicyDeletionReconciler interface {
+	ReconcileEgressGatewayPolicyDeletion(req reconcile.Request) error
+}
+
+type EgressGatewayPolicyReconcilerFuncs struct {
+	OnReconcileEgressGatewayPolicy         func(obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) (reconcile.Result, error)
+	OnReconcileEgressGatewayPolicyDeletion func(req reconcile.Request) error
+}
+
+func (f *EgressGatewayPolicyReconcilerFuncs) ReconcileEgressGatewayPolicy(obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) (reconcile.Result, error) {
+	if f.OnReconcileEgressGatewayPolicy == nil {
+		return reconcile.Result{}, nil
+	}
+	return f.OnReconcileEgressGatewayPolicy(obj)
+}
+
+func (f *EgressGatewayPolicyReconcilerFuncs) ReconcileEgressGatewayPolicyDeletion(req reconcile.Request) error {
+	if f.OnReconcileEgressGatewayPolicyDeletion == nil {
+		return nil
+	}
+	return f.OnReconcileEgressGatewayPolicyDeletion(req)
+}
+
+// Reconcile and finalize the EgressGatewayPolicy Resource
+// implemented by the user
+type EgressGatewayPolicyFinalizer interface {
+	EgressGatewayPolicyReconciler
+
+	// name of the finalizer used by this handler.
+	// finalizer names should be unique for a single task
+	EgressGatewayPolicyFinalizerName() string
+
+	// finalize the object before it is deleted.
+	// Watchers created with a finalizing handler will a
+	FinalizeEgressGatewayPolicy(obj *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) error
+}
+
+type EgressGatewayPolicyReconcileLoop interface {
+	RunEgressGatewayPolicyReconciler(ctx context.Context, rec EgressGatewayPolicyReconciler, predicates ...predicate.Predicate) error
+}
+
+type egressGatewayPolicyReconcileLoop struct {
+	loop reconcile.Loop
+}
+
+func NewEgressGatewayPolicyReconcileLoop(name string, mgr manager.Manager, options reconcile.Options) EgressGatewayPolicyReconcileLoop {
+	return &egressGatewayPolicyReconcileLoop{
+		// empty cluster indicates this reconciler is built for the local cluster
+		loop: reconcile.NewLoop(name, "", mgr, &networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy{}, options),
+	}
+}
+
+func (c *egressGatewayPolicyReconcileLoop) RunEgressGatewayPolicyReconciler(ctx context.Context, reconciler EgressGatewayPolicyReconciler, predicates ...predicate.Predicate) error {
+	genericReconciler := genericEgressGatewayPolicyReconciler{
+		reconciler: reconciler,
+	}
+
+	var reconcilerWrapper reconcile.Reconciler
+	if finalizingReconciler, ok := reconciler.(EgressGatewayPolicyFinalizer); ok {
+		reconcilerWrapper = genericEgressGatewayPolicyFinalizer{
+			genericEgressGatewayPolicyReconciler: genericReconciler,
+			finalizingReconciler:                 finalizingReconciler,
+		}
+	} else {
+		reconcilerWrapper = genericReconciler
+	}
+	return c.loop.RunReconciler(ctx, reconcilerWrapper, predicates...)
+}
+
+// genericEgressGatewayPolicyHandler implements a generic reconcile.Reconciler
+type genericEgressGatewayPolicyReconciler struct {
+	reconciler EgressGatewayPolicyReconciler
+}
+
+func (r genericEgressGatewayPolicyReconciler) Reconcile(object ezkube.Object) (reconcile.Result, error) {
+	obj, ok := object.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy)
+	if !ok {
+		return reconcile.Result{}, errors.Errorf("internal error: EgressGatewayPolicy handler received event for %T", object)
+	}
+	return r.reconciler.ReconcileEgressGatewayPolicy(obj)
+}
+
+func (r genericEgressGatewayPolicyReconciler) ReconcileDeletion(request reconcile.Request) error {
+	if deletionReconciler, ok := r.reconciler.(EgressGatewayPolicyDeletionReconciler); ok {
+		return deletionReconciler.ReconcileEgressGatewayPolicyDeletion(request)
+	}
+	return nil
+}
+
+// genericEgressGatewayPolicyFinalizer implements a generic reconcile.FinalizingReconciler
+type genericEgressGatewayPolicyFinalizer struct {
+	genericEgressGatewayPolicyReconciler
+	finalizingReconciler EgressGatewayPolicyFinalizer
+}
+
+func (r genericEgressGatewayPolicyFinalizer) FinalizerName() string {
+	return r.finalizingReconciler.EgressGatewayPolicyFinalizerName()
+}
+
+func (r genericEgressGatewayPolicyFinalizer) Finalize(object ezkube.Object) error {
+	obj, ok := object.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy)
+	if !ok {
+		return errors.Errorf("internal error: EgressGatewayPolicy handler received event for %T", object)
+	}
+	return r.finalizingReconciler.FinalizeEgressGatewayPolicy(obj)
+}
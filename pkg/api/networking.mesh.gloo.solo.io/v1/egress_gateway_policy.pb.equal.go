@@ -0,0 +1,271 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo-mesh/api/networking/v1/egress_gateway_policy.proto
+
+package v1
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *EgressGatewayPolicySpec) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*EgressGatewayPolicySpec)
+	if !ok {
+		that2, ok := that.(EgressGatewayPolicySpec)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetDestinationSelector()) != len(target.GetDestinationSelector()) {
+		return false
+	}
+	for idx, v := range m.GetDestinationSelector() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDestinationSelector()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetDestinationSelector()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetExternalHosts()) != len(target.GetExternalHosts()) {
+		return false
+	}
+	for idx, v := range m.GetExternalHosts() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetExternalHosts()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetExternalHosts()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetMeshes()) != len(target.GetMeshes()) {
+		return false
+	}
+	for idx, v := range m.GetMeshes() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetMeshes()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetMeshes()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetGatewayWorkloadLabels()) != len(target.GetGatewayWorkloadLabels()) {
+		return false
+	}
+	for k, v := range m.GetGatewayWorkloadLabels() {
+
+		if strings.Compare(v, target.GetGatewayWorkloadLabels()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetTlsOrigination()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTlsOrigination()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTlsOrigination(), target.GetTlsOrigination()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *EgressGatewayPolicyStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*EgressGatewayPolicyStatus)
+	if !ok {
+		that2, ok := that.(EgressGatewayPolicyStatus)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetObservedGeneration() != target.GetObservedGeneration() {
+		return false
+	}
+
+	if m.GetState() != target.GetState() {
+		return false
+	}
+
+	if len(m.GetMeshes()) != len(target.GetMeshes()) {
+		return false
+	}
+	for k, v := range m.GetMeshes() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetMeshes()[k]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetMeshes()[k]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetDestinations()) != len(target.GetDestinations()) {
+		return false
+	}
+	for k, v := range m.GetDestinations() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDestinations()[k]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetDestinations()[k]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetErrors()) != len(target.GetErrors()) {
+		return false
+	}
+	for idx, v := range m.GetErrors() {
+
+		if strings.Compare(v, target.GetErrors()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *EgressGatewayPolicySpec_ExternalHost) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*EgressGatewayPolicySpec_ExternalHost)
+	if !ok {
+		that2, ok := that.(EgressGatewayPolicySpec_ExternalHost)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetHostname(), target.GetHostname()) != 0 {
+		return false
+	}
+
+	if m.GetPort() != target.GetPort() {
+		return false
+	}
+
+	if m.GetProtocol() != target.GetProtocol() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *EgressGatewayPolicySpec_TLSOrigination) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*EgressGatewayPolicySpec_TLSOrigination)
+	if !ok {
+		that2, ok := that.(EgressGatewayPolicySpec_TLSOrigination)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetPort() != target.GetPort() {
+		return false
+	}
+
+	if strings.Compare(m.GetSni(), target.GetSni()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetCredentialName(), target.GetCredentialName()) != 0 {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,656 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.21.0
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo-mesh/api/networking/v1/egress_gateway_policy.proto
+
+package v1
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	v11 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// The protocol of the requests sent to an external host.
+type EgressGatewayPolicySpec_ExternalHost_Protocol int32
+
+const (
+	// Plaintext HTTP requests, which are routed based on their Host header.
+	EgressGatewayPolicySpec_ExternalHost_HTTP EgressGatewayPolicySpec_ExternalHost_Protocol = 0
+	// TLS connections, which are routed based on their SNI and passed through the egress gateway.
+	EgressGatewayPolicySpec_ExternalHost_TLS EgressGatewayPolicySpec_ExternalHost_Protocol = 1
+)
+
+// Enum value maps for EgressGatewayPolicySpec_ExternalHost_Protocol.
+var (
+	EgressGatewayPolicySpec_ExternalHost_Protocol_name = map[int32]string{
+		0: "HTTP",
+		1: "TLS",
+	}
+	EgressGatewayPolicySpec_ExternalHost_Protocol_value = map[string]int32{
+		"HTTP": 0,
+		"TLS":  1,
+	}
+)
+
+func (x EgressGatewayPolicySpec_ExternalHost_Protocol) Enum() *EgressGatewayPolicySpec_ExternalHost_Protocol {
+	p := new(EgressGatewayPolicySpec_ExternalHost_Protocol)
+	*p = x
+	return p
+}
+
+func (x EgressGatewayPolicySpec_ExternalHost_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EgressGatewayPolicySpec_ExternalHost_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_enumTypes[0].Descriptor()
+}
+
+func (EgressGatewayPolicySpec_ExternalHost_Protocol) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_enumTypes[0]
+}
+
+func (x EgressGatewayPolicySpec_ExternalHost_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EgressGatewayPolicySpec_ExternalHost_Protocol.Descriptor instead.
+func (EgressGatewayPolicySpec_ExternalHost_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+//
+//Routes traffic sent to external hosts through the egress gateway of the Mesh, so that requests to the
+//selected hosts leave the Mesh only through the egress gateway workloads.
+//Egress gateways are discovered from the Services in the Istio control plane namespace whose selector
+//matches the labels configured in the `discovery.istio.egressGatewayDetectors` field of the Settings object.
+//
+//For Istio, an EgressGatewayPolicy is translated into a Gateway for the egress gateway, a VirtualService for
+//each external host routing requests from sidecars to the egress gateway and from the egress gateway to the external host,
+//and, if TLS origination is enabled, a DestinationRule which originates TLS to the external host.
+//Note that in order to block traffic to external hosts which bypasses the egress gateway, additional configuration such as
+//Kubernetes NetworkPolicies may be required.
+type EgressGatewayPolicySpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Select the ExternalService Destinations whose traffic is routed through the egress gateway.
+	// ExternalService Destinations are routed through the egress gateway of their own Mesh.
+	// Destinations other than ExternalServices are ignored.
+	DestinationSelector []*v1.DestinationSelector `protobuf:"bytes,1,rep,name=destination_selector,json=destinationSelector,proto3" json:"destination_selector,omitempty"`
+	// External hosts, not represented by a Destination, whose traffic is routed through the egress gateway.
+	// A ServiceEntry is created for each external host.
+	ExternalHosts []*EgressGatewayPolicySpec_ExternalHost `protobuf:"bytes,2,rep,name=external_hosts,json=externalHosts,proto3" json:"external_hosts,omitempty"`
+	// The Istio Meshes whose egress gateways route traffic to the `external_hosts`.
+	// If omitted, the external hosts are routed through the egress gateways of all Istio Meshes.
+	Meshes []*v11.ObjectRef `protobuf:"bytes,3,rep,name=meshes,proto3" json:"meshes,omitempty"`
+	// Select the egress gateway by the labels of its workload.
+	// If omitted, the first egress gateway discovered for the Mesh is used.
+	GatewayWorkloadLabels map[string]string `protobuf:"bytes,4,rep,name=gateway_workload_labels,json=gatewayWorkloadLabels,proto3" json:"gateway_workload_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If set, the egress gateway originates TLS connections to the external hosts,
+	// allowing workloads to send plaintext HTTP requests which are encrypted when leaving the Mesh.
+	// Only applies to hosts with the HTTP protocol.
+	TlsOrigination *EgressGatewayPolicySpec_TLSOrigination `protobuf:"bytes,5,opt,name=tls_origination,json=tlsOrigination,proto3" json:"tls_origination,omitempty"`
+}
+
+func (x *EgressGatewayPolicySpec) Reset() {
+	*x = EgressGatewayPolicySpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EgressGatewayPolicySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressGatewayPolicySpec) ProtoMessage() {}
+
+func (x *EgressGatewayPolicySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressGatewayPolicySpec.ProtoReflect.Descriptor instead.
+func (*EgressGatewayPolicySpec) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EgressGatewayPolicySpec) GetDestinationSelector() []*v1.DestinationSelector {
+	if x != nil {
+		return x.DestinationSelector
+	}
+	return nil
+}
+
+func (x *EgressGatewayPolicySpec) GetExternalHosts() []*EgressGatewayPolicySpec_ExternalHost {
+	if x != nil {
+		return x.ExternalHosts
+	}
+	return nil
+}
+
+func (x *EgressGatewayPolicySpec) GetMeshes() []*v11.ObjectRef {
+	if x != nil {
+		return x.Meshes
+	}
+	return nil
+}
+
+func (x *EgressGatewayPolicySpec) GetGatewayWorkloadLabels() map[string]string {
+	if x != nil {
+		return x.GatewayWorkloadLabels
+	}
+	return nil
+}
+
+func (x *EgressGatewayPolicySpec) GetTlsOrigination() *EgressGatewayPolicySpec_TLSOrigination {
+	if x != nil {
+		return x.TlsOrigination
+	}
+	return nil
+}
+
+type EgressGatewayPolicyStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The most recent generation observed in the the EgressGatewayPolicy metadata.
+	// If the `observedGeneration` does not match `metadata.generation`, Gloo Mesh has not processed the most
+	// recent version of this resource.
+	ObservedGeneration int64 `protobuf:"varint,1,opt,name=observed_generation,json=observedGeneration,proto3" json:"observed_generation,omitempty"`
+	// The state of the overall resource.
+	// It will only show accepted if it has been successfully applied to all selected Meshes and Destinations.
+	State v1.ApprovalState `protobuf:"varint,2,opt,name=state,proto3,enum=common.mesh.gloo.solo.io.ApprovalState" json:"state,omitempty"`
+	// The status of the EgressGatewayPolicy for each Mesh through whose egress gateway traffic is routed.
+	Meshes map[string]*ApprovalStatus `protobuf:"bytes,3,rep,name=meshes,proto3" json:"meshes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The status of the EgressGatewayPolicy for each selected ExternalService Destination.
+	Destinations map[string]*ApprovalStatus `protobuf:"bytes,4,rep,name=destinations,proto3" json:"destinations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Any errors found while processing this generation of the resource.
+	Errors []string `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *EgressGatewayPolicyStatus) Reset() {
+	*x = EgressGatewayPolicyStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EgressGatewayPolicyStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressGatewayPolicyStatus) ProtoMessage() {}
+
+func (x *EgressGatewayPolicyStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressGatewayPolicyStatus.ProtoReflect.Descriptor instead.
+func (*EgressGatewayPolicyStatus) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EgressGatewayPolicyStatus) GetObservedGeneration() int64 {
+	if x != nil {
+		return x.ObservedGeneration
+	}
+	return 0
+}
+
+func (x *EgressGatewayPolicyStatus) GetState() v1.ApprovalState {
+	if x != nil {
+		return x.State
+	}
+	return v1.ApprovalState_PENDING
+}
+
+func (x *EgressGatewayPolicyStatus) GetMeshes() map[string]*ApprovalStatus {
+	if x != nil {
+		return x.Meshes
+	}
+	return nil
+}
+
+func (x *EgressGatewayPolicyStatus) GetDestinations() map[string]*ApprovalStatus {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *EgressGatewayPolicyStatus) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Describes an external host.
+type EgressGatewayPolicySpec_ExternalHost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hostname of the external host. Required, and must not contain wildcards.
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// The port to which workloads send requests for the external host.
+	// Defaults to 80 for the HTTP protocol, and 443 for the TLS protocol.
+	// The egress gateway Service must expose the port.
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// The protocol of the requests sent to the external host.
+	Protocol EgressGatewayPolicySpec_ExternalHost_Protocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=networking.mesh.gloo.solo.io.EgressGatewayPolicySpec_ExternalHost_Protocol" json:"protocol,omitempty"`
+}
+
+func (x *EgressGatewayPolicySpec_ExternalHost) Reset() {
+	*x = EgressGatewayPolicySpec_ExternalHost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EgressGatewayPolicySpec_ExternalHost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressGatewayPolicySpec_ExternalHost) ProtoMessage() {}
+
+func (x *EgressGatewayPolicySpec_ExternalHost) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressGatewayPolicySpec_ExternalHost.ProtoReflect.Descriptor instead.
+func (*EgressGatewayPolicySpec_ExternalHost) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *EgressGatewayPolicySpec_ExternalHost) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *EgressGatewayPolicySpec_ExternalHost) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *EgressGatewayPolicySpec_ExternalHost) GetProtocol() EgressGatewayPolicySpec_ExternalHost_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return EgressGatewayPolicySpec_ExternalHost_HTTP
+}
+
+// Configure the TLS connections originated by the egress gateway.
+type EgressGatewayPolicySpec_TLSOrigination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The port of the external hosts on which they accept TLS connections. Defaults to 443.
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// The SNI presented to the external hosts. Defaults to the hostname of each external host.
+	Sni string `protobuf:"bytes,2,opt,name=sni,proto3" json:"sni,omitempty"`
+	// The name of a secret in the egress gateway's namespace containing the client certificate, key and CA certificates
+	// used to originate mutual TLS connections. If omitted, the egress gateway originates TLS without presenting a client certificate.
+	CredentialName string `protobuf:"bytes,3,opt,name=credential_name,json=credentialName,proto3" json:"credential_name,omitempty"`
+}
+
+func (x *EgressGatewayPolicySpec_TLSOrigination) Reset() {
+	*x = EgressGatewayPolicySpec_TLSOrigination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EgressGatewayPolicySpec_TLSOrigination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EgressGatewayPolicySpec_TLSOrigination) ProtoMessage() {}
+
+func (x *EgressGatewayPolicySpec_TLSOrigination) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EgressGatewayPolicySpec_TLSOrigination.ProtoReflect.Descriptor instead.
+func (*EgressGatewayPolicySpec_TLSOrigination) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *EgressGatewayPolicySpec_TLSOrigination) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *EgressGatewayPolicySpec_TLSOrigination) GetSni() string {
+	if x != nil {
+		return x.Sni
+	}
+	return ""
+}
+
+func (x *EgressGatewayPolicySpec_TLSOrigination) GetCredentialName() string {
+	if x != nil {
+		return x.CredentialName
+	}
+	return ""
+}
+
+var File_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDesc = []byte{
+	0x0a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
+	0x2f, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x73,
+	0x6b, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3a, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d,
+	0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73,
+	0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x07, 0x0a, 0x17, 0x45,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x60, 0x0a, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x69, 0x0a, 0x0e, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x42, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x48, 0x6f, 0x73, 0x74, 0x52, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x66, 0x52, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x17, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x50, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70,
+	0x65, 0x63, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
+	0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x6d, 0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x45, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x53, 0x70, 0x65, 0x63, 0x2e, 0x54, 0x4c, 0x53, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x74, 0x6c, 0x73, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x48, 0x0a, 0x1a, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x57, 0x6f,
+	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xc6, 0x01,
+	0x0a, 0x0c, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x67,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x4b, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x48, 0x6f, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x1d, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x54, 0x4c, 0x53, 0x10, 0x01, 0x1a, 0x5f, 0x0a, 0x0e, 0x54, 0x4c, 0x53, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x6e, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6e, 0x69, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc7, 0x04, 0x0a, 0x19, 0x45, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x5b, 0x0a, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d,
+	0x65, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x65, 0x73, 0x68,
+	0x65, 0x73, 0x12, 0x6d, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x67, 0x0a, 0x0b, 0x4d, 0x65, 0x73,
+	0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x6d, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x4a, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73,
+	0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDescData = file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_goTypes = []interface{}{
+	(EgressGatewayPolicySpec_ExternalHost_Protocol)(0), // 0: networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.ExternalHost.Protocol
+	(*EgressGatewayPolicySpec)(nil),                    // 1: networking.mesh.gloo.solo.io.EgressGatewayPolicySpec
+	(*EgressGatewayPolicyStatus)(nil),                  // 2: networking.mesh.gloo.solo.io.EgressGatewayPolicyStatus
+	nil,                                                // 3: networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.GatewayWorkloadLabelsEntry
+	(*EgressGatewayPolicySpec_ExternalHost)(nil),       // 4: networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.ExternalHost
+	(*EgressGatewayPolicySpec_TLSOrigination)(nil),     // 5: networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.TLSOrigination
+	nil,                            // 6: networking.mesh.gloo.solo.io.EgressGatewayPolicyStatus.MeshesEntry
+	nil,                            // 7: networking.mesh.gloo.solo.io.EgressGatewayPolicyStatus.DestinationsEntry
+	(*v1.DestinationSelector)(nil), // 8: common.mesh.gloo.solo.io.DestinationSelector
+	(*v11.ObjectRef)(nil),          // 9: core.skv2.solo.io.ObjectRef
+	(v1.ApprovalState)(0),          // 10: common.mesh.gloo.solo.io.ApprovalState
+	(*ApprovalStatus)(nil),         // 11: networking.mesh.gloo.solo.io.ApprovalStatus
+}
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_depIdxs = []int32{
+	8,  // 0: networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.destination_selector:type_name -> common.mesh.gloo.solo.io.DestinationSelector
+	4,  // 1: networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.external_hosts:type_name -> networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.ExternalHost
+	9,  // 2: networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.meshes:type_name -> core.skv2.solo.io.ObjectRef
+	3,  // 3: networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.gateway_workload_labels:type_name -> networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.GatewayWorkloadLabelsEntry
+	5,  // 4: networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.tls_origination:type_name -> networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.TLSOrigination
+	10, // 5: networking.mesh.gloo.solo.io.EgressGatewayPolicyStatus.state:type_name -> common.mesh.gloo.solo.io.ApprovalState
+	6,  // 6: networking.mesh.gloo.solo.io.EgressGatewayPolicyStatus.meshes:type_name -> networking.mesh.gloo.solo.io.EgressGatewayPolicyStatus.MeshesEntry
+	7,  // 7: networking.mesh.gloo.solo.io.EgressGatewayPolicyStatus.destinations:type_name -> networking.mesh.gloo.solo.io.EgressGatewayPolicyStatus.DestinationsEntry
+	0,  // 8: networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.ExternalHost.protocol:type_name -> networking.mesh.gloo.solo.io.EgressGatewayPolicySpec.ExternalHost.Protocol
+	11, // 9: networking.mesh.gloo.solo.io.EgressGatewayPolicyStatus.MeshesEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
+	11, // 10: networking.mesh.gloo.solo.io.EgressGatewayPolicyStatus.DestinationsEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_init() }
+func file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_init() {
+	if File_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto != nil {
+		return
+	}
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_status_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressGatewayPolicySpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressGatewayPolicyStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressGatewayPolicySpec_ExternalHost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EgressGatewayPolicySpec_TLSOrigination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_enumTypes,
+		MessageInfos:      file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto = out.File
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_goTypes = nil
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_egress_gateway_policy_proto_depIdxs = nil
+}
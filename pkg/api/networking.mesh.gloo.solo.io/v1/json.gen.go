@@ -89,3 +89,25 @@ func (this *VirtualMeshStatus) MarshalJSON() ([]byte, error) {
 func (this *VirtualMeshStatus) UnmarshalJSON(b []byte) error {
 	return unmarshaller.Unmarshal(bytes.NewReader(b), this)
 }
+
+// MarshalJSON is a custom marshaler for EgressGatewayPolicySpec
+func (this *EgressGatewayPolicySpec) MarshalJSON() ([]byte, error) {
+	str, err := marshaller.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for EgressGatewayPolicySpec
+func (this *EgressGatewayPolicySpec) UnmarshalJSON(b []byte) error {
+	return unmarshaller.Unmarshal(bytes.NewReader(b), this)
+}
+
+// MarshalJSON is a custom marshaler for EgressGatewayPolicyStatus
+func (this *EgressGatewayPolicyStatus) MarshalJSON() ([]byte, error) {
+	str, err := marshaller.MarshalToString(this)
+	return []byte(str), err
+}
+
+// UnmarshalJSON is a custom unmarshaler for EgressGatewayPolicyStatus
+func (this *EgressGatewayPolicyStatus) UnmarshalJSON(b []byte) error {
+	return unmarshaller.Unmarshal(bytes.NewReader(b), this)
+}
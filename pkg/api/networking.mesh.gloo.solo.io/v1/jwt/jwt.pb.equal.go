@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-ext. DO NOT EDIT.
+// source: github.com/solo-io/gloo-mesh/api/networking/v1/jwt/jwt.proto
+
+package jwt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	equality "github.com/solo-io/protoc-gen-ext/pkg/equality"
+)
+
+// ensure the imports are used
+var (
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = binary.LittleEndian
+	_ = bytes.Compare
+	_ = strings.Compare
+	_ = equality.Equalizer(nil)
+	_ = proto.Message(nil)
+)
+
+// Equal function
+func (m *JwtPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*JwtPolicy)
+	if !ok {
+		that2, ok := that.(JwtPolicy)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetProviders()) != len(target.GetProviders()) {
+		return false
+	}
+	for idx, v := range m.GetProviders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetProviders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetProviders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if m.GetAllowMissingJwt() != target.GetAllowMissingJwt() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *JwtProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*JwtProvider)
+	if !ok {
+		that2, ok := that.(JwtProvider)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetIssuer(), target.GetIssuer()) != 0 {
+		return false
+	}
+
+	if len(m.GetAudiences()) != len(target.GetAudiences()) {
+		return false
+	}
+	for idx, v := range m.GetAudiences() {
+
+		if strings.Compare(v, target.GetAudiences()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetClaimsToHeaders()) != len(target.GetClaimsToHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetClaimsToHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetClaimsToHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetClaimsToHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if m.GetForwardOriginalToken() != target.GetForwardOriginalToken() {
+		return false
+	}
+
+	switch m.JwksSource.(type) {
+
+	case *JwtProvider_Jwks:
+		if _, ok := target.JwksSource.(*JwtProvider_Jwks); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetJwks(), target.GetJwks()) != 0 {
+			return false
+		}
+
+	case *JwtProvider_JwksUri:
+		if _, ok := target.JwksSource.(*JwtProvider_JwksUri); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetJwksUri(), target.GetJwksUri()) != 0 {
+			return false
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.JwksSource != target.JwksSource {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *JwtProvider_ClaimToHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*JwtProvider_ClaimToHeader)
+	if !ok {
+		that2, ok := that.(JwtProvider_ClaimToHeader)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetClaim(), target.GetClaim()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetHeader(), target.GetHeader()) != 0 {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,414 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.21.0
+// 	protoc        v3.6.1
+// source: github.com/solo-io/gloo-mesh/api/networking/v1/jwt/jwt.proto
+
+package jwt
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/solo-io/protoc-gen-ext/extproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// Require end-user JSON Web Tokens (JWTs) on requests to the selected Destinations.
+// Requests with a JWT which cannot be validated by any of the providers are rejected.
+type JwtPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The providers whose JWTs are accepted.
+	Providers []*JwtProvider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	// Optional. Allow requests which do not include a JWT. Requests with an invalid JWT are rejected regardless.
+	// Defaults to false, i.e. requests without a valid JWT are rejected.
+	AllowMissingJwt bool `protobuf:"varint,2,opt,name=allow_missing_jwt,json=allowMissingJwt,proto3" json:"allow_missing_jwt,omitempty"`
+}
+
+func (x *JwtPolicy) Reset() {
+	*x = JwtPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JwtPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JwtPolicy) ProtoMessage() {}
+
+func (x *JwtPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JwtPolicy.ProtoReflect.Descriptor instead.
+func (*JwtPolicy) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JwtPolicy) GetProviders() []*JwtProvider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *JwtPolicy) GetAllowMissingJwt() bool {
+	if x != nil {
+		return x.AllowMissingJwt
+	}
+	return false
+}
+
+// A provider of JWTs, identified by its issuer.
+type JwtProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The issuer of the JWTs, which must match their `iss` claim.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// Optional. The audiences accepted by the provider, one of which must match the `aud` claim of the JWTs.
+	// If not specified, any audience is accepted.
+	Audiences []string `protobuf:"bytes,2,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	// The JSON Web Key Set (JWKS) used to validate the signatures of the JWTs.
+	// If not specified, the JWKS is fetched from the issuer's [OpenID discovery document](https://openid.net/specs/openid-connect-discovery-1_0.html).
+	//
+	// Types that are assignable to JwksSource:
+	//	*JwtProvider_Jwks
+	//	*JwtProvider_JwksUri
+	JwksSource isJwtProvider_JwksSource `protobuf_oneof:"jwks_source"`
+	// Optional. Forward claims of validated JWTs to the Destination as request headers.
+	// Any headers of the same name set by the client are removed.
+	ClaimsToHeaders []*JwtProvider_ClaimToHeader `protobuf:"bytes,5,rep,name=claims_to_headers,json=claimsToHeaders,proto3" json:"claims_to_headers,omitempty"`
+	// Optional. Forward the JWT to the Destination. Defaults to false, i.e. the JWT is removed from the request once validated.
+	ForwardOriginalToken bool `protobuf:"varint,6,opt,name=forward_original_token,json=forwardOriginalToken,proto3" json:"forward_original_token,omitempty"`
+}
+
+func (x *JwtProvider) Reset() {
+	*x = JwtProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JwtProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JwtProvider) ProtoMessage() {}
+
+func (x *JwtProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JwtProvider.ProtoReflect.Descriptor instead.
+func (*JwtProvider) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JwtProvider) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *JwtProvider) GetAudiences() []string {
+	if x != nil {
+		return x.Audiences
+	}
+	return nil
+}
+
+func (m *JwtProvider) GetJwksSource() isJwtProvider_JwksSource {
+	if m != nil {
+		return m.JwksSource
+	}
+	return nil
+}
+
+func (x *JwtProvider) GetJwks() string {
+	if x, ok := x.GetJwksSource().(*JwtProvider_Jwks); ok {
+		return x.Jwks
+	}
+	return ""
+}
+
+func (x *JwtProvider) GetJwksUri() string {
+	if x, ok := x.GetJwksSource().(*JwtProvider_JwksUri); ok {
+		return x.JwksUri
+	}
+	return ""
+}
+
+func (x *JwtProvider) GetClaimsToHeaders() []*JwtProvider_ClaimToHeader {
+	if x != nil {
+		return x.ClaimsToHeaders
+	}
+	return nil
+}
+
+func (x *JwtProvider) GetForwardOriginalToken() bool {
+	if x != nil {
+		return x.ForwardOriginalToken
+	}
+	return false
+}
+
+type isJwtProvider_JwksSource interface {
+	isJwtProvider_JwksSource()
+}
+
+type JwtProvider_Jwks struct {
+	// The JWKS, inline.
+	Jwks string `protobuf:"bytes,3,opt,name=jwks,proto3,oneof"`
+}
+
+type JwtProvider_JwksUri struct {
+	// The URI from which to fetch the JWKS.
+	JwksUri string `protobuf:"bytes,4,opt,name=jwks_uri,json=jwksUri,proto3,oneof"`
+}
+
+func (*JwtProvider_Jwks) isJwtProvider_JwksSource() {}
+
+func (*JwtProvider_JwksUri) isJwtProvider_JwksSource() {}
+
+// Forward a claim of a validated JWT to the Destination as a request header.
+type JwtProvider_ClaimToHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The name of the claim, e.g. `sub`.
+	Claim string `protobuf:"bytes,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	// Required. The name of the header to which the value of the claim is written.
+	Header string `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (x *JwtProvider_ClaimToHeader) Reset() {
+	*x = JwtProvider_ClaimToHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JwtProvider_ClaimToHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JwtProvider_ClaimToHeader) ProtoMessage() {}
+
+func (x *JwtProvider_ClaimToHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JwtProvider_ClaimToHeader.ProtoReflect.Descriptor instead.
+func (*JwtProvider_ClaimToHeader) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *JwtProvider_ClaimToHeader) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+func (x *JwtProvider_ClaimToHeader) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+var File_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto protoreflect.FileDescriptor
+
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDesc = []byte{
+	0x0a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
+	0x2f, 0x6a, 0x77, 0x74, 0x2f, 0x6a, 0x77, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20,
+	0x6a, 0x77, 0x74, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x01, 0x0a, 0x09, 0x4a, 0x77, 0x74, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x4b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6a, 0x77, 0x74, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4a, 0x77, 0x74, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x2a, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x5f, 0x6a, 0x77, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4a, 0x77, 0x74, 0x22, 0xe3, 0x02, 0x0a, 0x0b,
+	0x4a, 0x77, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x04, 0x6a, 0x77, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x04, 0x6a, 0x77, 0x6b, 0x73, 0x12, 0x1b, 0x0a, 0x08, 0x6a, 0x77, 0x6b, 0x73, 0x5f,
+	0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6a, 0x77, 0x6b,
+	0x73, 0x55, 0x72, 0x69, 0x12, 0x67, 0x0a, 0x11, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x5f, 0x74,
+	0x6f, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3b, 0x2e, 0x6a, 0x77, 0x74, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x4a, 0x77, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x73, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a,
+	0x16, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x1a, 0x3d, 0x0a, 0x0d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x6f, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x6a, 0x77, 0x6b, 0x73, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x42, 0x4e, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73,
+	0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x77, 0x74, 0xc0, 0xf5, 0x04,
+	0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDescOnce sync.Once
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDescData = file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDesc
+)
+
+func file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDescGZIP() []byte {
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDescOnce.Do(func() {
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDescData)
+	})
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDescData
+}
+
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_goTypes = []interface{}{
+	(*JwtPolicy)(nil),                 // 0: jwt.networking.mesh.gloo.solo.io.JwtPolicy
+	(*JwtProvider)(nil),               // 1: jwt.networking.mesh.gloo.solo.io.JwtProvider
+	(*JwtProvider_ClaimToHeader)(nil), // 2: jwt.networking.mesh.gloo.solo.io.JwtProvider.ClaimToHeader
+}
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_depIdxs = []int32{
+	1, // 0: jwt.networking.mesh.gloo.solo.io.JwtPolicy.providers:type_name -> jwt.networking.mesh.gloo.solo.io.JwtProvider
+	2, // 1: jwt.networking.mesh.gloo.solo.io.JwtProvider.claims_to_headers:type_name -> jwt.networking.mesh.gloo.solo.io.JwtProvider.ClaimToHeader
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_init() }
+func file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_init() {
+	if File_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JwtPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JwtProvider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JwtProvider_ClaimToHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*JwtProvider_Jwks)(nil),
+		(*JwtProvider_JwksUri)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_goTypes,
+		DependencyIndexes: file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_depIdxs,
+		MessageInfos:      file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_msgTypes,
+	}.Build()
+	File_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto = out.File
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_rawDesc = nil
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_goTypes = nil
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_jwt_jwt_proto_depIdxs = nil
+}
@@ -88,6 +88,20 @@ func (mr *MockClientsetMockRecorder) AccessPolicies() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccessPolicies", reflect.TypeOf((*MockClientset)(nil).AccessPolicies))
 }
 
+// EgressGatewayPolicies mocks base method.
+func (m *MockClientset) EgressGatewayPolicies() v1.EgressGatewayPolicyClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EgressGatewayPolicies")
+	ret0, _ := ret[0].(v1.EgressGatewayPolicyClient)
+	return ret0
+}
+
+// EgressGatewayPolicies indicates an expected call of EgressGatewayPolicies.
+func (mr *MockClientsetMockRecorder) EgressGatewayPolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EgressGatewayPolicies", reflect.TypeOf((*MockClientset)(nil).EgressGatewayPolicies))
+}
+
 // TrafficPolicies mocks base method.
 func (m *MockClientset) TrafficPolicies() v1.TrafficPolicyClient {
 	m.ctrl.T.Helper()
@@ -1627,3 +1641,507 @@ func (mr *MockMulticlusterVirtualMeshClientMockRecorder) Cluster(cluster interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cluster", reflect.TypeOf((*MockMulticlusterVirtualMeshClient)(nil).Cluster), cluster)
 }
+
+// MockEgressGatewayPolicyReader is a mock of EgressGatewayPolicyReader interface.
+type MockEgressGatewayPolicyReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockEgressGatewayPolicyReaderMockRecorder
+}
+
+// MockEgressGatewayPolicyReaderMockRecorder is the mock recorder for MockEgressGatewayPolicyReader.
+type MockEgressGatewayPolicyReaderMockRecorder struct {
+	mock *MockEgressGatewayPolicyReader
+}
+
+// NewMockEgressGatewayPolicyReader creates a new mock instance.
+func NewMockEgressGatewayPolicyReader(ctrl *gomock.Controller) *MockEgressGatewayPolicyReader {
+	mock := &MockEgressGatewayPolicyReader{ctrl: ctrl}
+	mock.recorder = &MockEgressGatewayPolicyReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEgressGatewayPolicyReader) EXPECT() *MockEgressGatewayPolicyReaderMockRecorder {
+	return m.recorder
+}
+
+// GetEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyReader) GetEgressGatewayPolicy(ctx context.Context, key client.ObjectKey) (*v1.EgressGatewayPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEgressGatewayPolicy", ctx, key)
+	ret0, _ := ret[0].(*v1.EgressGatewayPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEgressGatewayPolicy indicates an expected call of GetEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyReaderMockRecorder) GetEgressGatewayPolicy(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyReader)(nil).GetEgressGatewayPolicy), ctx, key)
+}
+
+// ListEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyReader) ListEgressGatewayPolicy(ctx context.Context, opts ...client.ListOption) (*v1.EgressGatewayPolicyList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(*v1.EgressGatewayPolicyList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEgressGatewayPolicy indicates an expected call of ListEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyReaderMockRecorder) ListEgressGatewayPolicy(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyReader)(nil).ListEgressGatewayPolicy), varargs...)
+}
+
+// MockEgressGatewayPolicyWriter is a mock of EgressGatewayPolicyWriter interface.
+type MockEgressGatewayPolicyWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockEgressGatewayPolicyWriterMockRecorder
+}
+
+// MockEgressGatewayPolicyWriterMockRecorder is the mock recorder for MockEgressGatewayPolicyWriter.
+type MockEgressGatewayPolicyWriterMockRecorder struct {
+	mock *MockEgressGatewayPolicyWriter
+}
+
+// NewMockEgressGatewayPolicyWriter creates a new mock instance.
+func NewMockEgressGatewayPolicyWriter(ctrl *gomock.Controller) *MockEgressGatewayPolicyWriter {
+	mock := &MockEgressGatewayPolicyWriter{ctrl: ctrl}
+	mock.recorder = &MockEgressGatewayPolicyWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEgressGatewayPolicyWriter) EXPECT() *MockEgressGatewayPolicyWriterMockRecorder {
+	return m.recorder
+}
+
+// CreateEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyWriter) CreateEgressGatewayPolicy(ctx context.Context, obj *v1.EgressGatewayPolicy, opts ...client.CreateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEgressGatewayPolicy indicates an expected call of CreateEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyWriterMockRecorder) CreateEgressGatewayPolicy(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyWriter)(nil).CreateEgressGatewayPolicy), varargs...)
+}
+
+// DeleteAllOfEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyWriter) DeleteAllOfEgressGatewayPolicy(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAllOfEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllOfEgressGatewayPolicy indicates an expected call of DeleteAllOfEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyWriterMockRecorder) DeleteAllOfEgressGatewayPolicy(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOfEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyWriter)(nil).DeleteAllOfEgressGatewayPolicy), varargs...)
+}
+
+// DeleteEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyWriter) DeleteEgressGatewayPolicy(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEgressGatewayPolicy indicates an expected call of DeleteEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyWriterMockRecorder) DeleteEgressGatewayPolicy(ctx, key interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyWriter)(nil).DeleteEgressGatewayPolicy), varargs...)
+}
+
+// PatchEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyWriter) PatchEgressGatewayPolicy(ctx context.Context, obj *v1.EgressGatewayPolicy, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchEgressGatewayPolicy indicates an expected call of PatchEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyWriterMockRecorder) PatchEgressGatewayPolicy(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyWriter)(nil).PatchEgressGatewayPolicy), varargs...)
+}
+
+// UpdateEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyWriter) UpdateEgressGatewayPolicy(ctx context.Context, obj *v1.EgressGatewayPolicy, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEgressGatewayPolicy indicates an expected call of UpdateEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyWriterMockRecorder) UpdateEgressGatewayPolicy(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyWriter)(nil).UpdateEgressGatewayPolicy), varargs...)
+}
+
+// UpsertEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyWriter) UpsertEgressGatewayPolicy(ctx context.Context, obj *v1.EgressGatewayPolicy, transitionFuncs ...v1.EgressGatewayPolicyTransitionFunction) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range transitionFuncs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertEgressGatewayPolicy indicates an expected call of UpsertEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyWriterMockRecorder) UpsertEgressGatewayPolicy(ctx, obj interface{}, transitionFuncs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, transitionFuncs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyWriter)(nil).UpsertEgressGatewayPolicy), varargs...)
+}
+
+// MockEgressGatewayPolicyStatusWriter is a mock of EgressGatewayPolicyStatusWriter interface.
+type MockEgressGatewayPolicyStatusWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockEgressGatewayPolicyStatusWriterMockRecorder
+}
+
+// MockEgressGatewayPolicyStatusWriterMockRecorder is the mock recorder for MockEgressGatewayPolicyStatusWriter.
+type MockEgressGatewayPolicyStatusWriterMockRecorder struct {
+	mock *MockEgressGatewayPolicyStatusWriter
+}
+
+// NewMockEgressGatewayPolicyStatusWriter creates a new mock instance.
+func NewMockEgressGatewayPolicyStatusWriter(ctrl *gomock.Controller) *MockEgressGatewayPolicyStatusWriter {
+	mock := &MockEgressGatewayPolicyStatusWriter{ctrl: ctrl}
+	mock.recorder = &MockEgressGatewayPolicyStatusWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEgressGatewayPolicyStatusWriter) EXPECT() *MockEgressGatewayPolicyStatusWriterMockRecorder {
+	return m.recorder
+}
+
+// PatchEgressGatewayPolicyStatus mocks base method.
+func (m *MockEgressGatewayPolicyStatusWriter) PatchEgressGatewayPolicyStatus(ctx context.Context, obj *v1.EgressGatewayPolicy, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchEgressGatewayPolicyStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchEgressGatewayPolicyStatus indicates an expected call of PatchEgressGatewayPolicyStatus.
+func (mr *MockEgressGatewayPolicyStatusWriterMockRecorder) PatchEgressGatewayPolicyStatus(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchEgressGatewayPolicyStatus", reflect.TypeOf((*MockEgressGatewayPolicyStatusWriter)(nil).PatchEgressGatewayPolicyStatus), varargs...)
+}
+
+// UpdateEgressGatewayPolicyStatus mocks base method.
+func (m *MockEgressGatewayPolicyStatusWriter) UpdateEgressGatewayPolicyStatus(ctx context.Context, obj *v1.EgressGatewayPolicy, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEgressGatewayPolicyStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEgressGatewayPolicyStatus indicates an expected call of UpdateEgressGatewayPolicyStatus.
+func (mr *MockEgressGatewayPolicyStatusWriterMockRecorder) UpdateEgressGatewayPolicyStatus(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressGatewayPolicyStatus", reflect.TypeOf((*MockEgressGatewayPolicyStatusWriter)(nil).UpdateEgressGatewayPolicyStatus), varargs...)
+}
+
+// MockEgressGatewayPolicyClient is a mock of EgressGatewayPolicyClient interface.
+type MockEgressGatewayPolicyClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEgressGatewayPolicyClientMockRecorder
+}
+
+// MockEgressGatewayPolicyClientMockRecorder is the mock recorder for MockEgressGatewayPolicyClient.
+type MockEgressGatewayPolicyClientMockRecorder struct {
+	mock *MockEgressGatewayPolicyClient
+}
+
+// NewMockEgressGatewayPolicyClient creates a new mock instance.
+func NewMockEgressGatewayPolicyClient(ctrl *gomock.Controller) *MockEgressGatewayPolicyClient {
+	mock := &MockEgressGatewayPolicyClient{ctrl: ctrl}
+	mock.recorder = &MockEgressGatewayPolicyClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEgressGatewayPolicyClient) EXPECT() *MockEgressGatewayPolicyClientMockRecorder {
+	return m.recorder
+}
+
+// CreateEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyClient) CreateEgressGatewayPolicy(ctx context.Context, obj *v1.EgressGatewayPolicy, opts ...client.CreateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEgressGatewayPolicy indicates an expected call of CreateEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyClientMockRecorder) CreateEgressGatewayPolicy(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyClient)(nil).CreateEgressGatewayPolicy), varargs...)
+}
+
+// DeleteAllOfEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyClient) DeleteAllOfEgressGatewayPolicy(ctx context.Context, opts ...client.DeleteAllOfOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAllOfEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllOfEgressGatewayPolicy indicates an expected call of DeleteAllOfEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyClientMockRecorder) DeleteAllOfEgressGatewayPolicy(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllOfEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyClient)(nil).DeleteAllOfEgressGatewayPolicy), varargs...)
+}
+
+// DeleteEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyClient) DeleteEgressGatewayPolicy(ctx context.Context, key client.ObjectKey, opts ...client.DeleteOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEgressGatewayPolicy indicates an expected call of DeleteEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyClientMockRecorder) DeleteEgressGatewayPolicy(ctx, key interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyClient)(nil).DeleteEgressGatewayPolicy), varargs...)
+}
+
+// GetEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyClient) GetEgressGatewayPolicy(ctx context.Context, key client.ObjectKey) (*v1.EgressGatewayPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEgressGatewayPolicy", ctx, key)
+	ret0, _ := ret[0].(*v1.EgressGatewayPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEgressGatewayPolicy indicates an expected call of GetEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyClientMockRecorder) GetEgressGatewayPolicy(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyClient)(nil).GetEgressGatewayPolicy), ctx, key)
+}
+
+// ListEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyClient) ListEgressGatewayPolicy(ctx context.Context, opts ...client.ListOption) (*v1.EgressGatewayPolicyList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(*v1.EgressGatewayPolicyList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEgressGatewayPolicy indicates an expected call of ListEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyClientMockRecorder) ListEgressGatewayPolicy(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyClient)(nil).ListEgressGatewayPolicy), varargs...)
+}
+
+// PatchEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyClient) PatchEgressGatewayPolicy(ctx context.Context, obj *v1.EgressGatewayPolicy, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchEgressGatewayPolicy indicates an expected call of PatchEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyClientMockRecorder) PatchEgressGatewayPolicy(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyClient)(nil).PatchEgressGatewayPolicy), varargs...)
+}
+
+// PatchEgressGatewayPolicyStatus mocks base method.
+func (m *MockEgressGatewayPolicyClient) PatchEgressGatewayPolicyStatus(ctx context.Context, obj *v1.EgressGatewayPolicy, patch client.Patch, opts ...client.PatchOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj, patch}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PatchEgressGatewayPolicyStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchEgressGatewayPolicyStatus indicates an expected call of PatchEgressGatewayPolicyStatus.
+func (mr *MockEgressGatewayPolicyClientMockRecorder) PatchEgressGatewayPolicyStatus(ctx, obj, patch interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj, patch}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchEgressGatewayPolicyStatus", reflect.TypeOf((*MockEgressGatewayPolicyClient)(nil).PatchEgressGatewayPolicyStatus), varargs...)
+}
+
+// UpdateEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyClient) UpdateEgressGatewayPolicy(ctx context.Context, obj *v1.EgressGatewayPolicy, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEgressGatewayPolicy indicates an expected call of UpdateEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyClientMockRecorder) UpdateEgressGatewayPolicy(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyClient)(nil).UpdateEgressGatewayPolicy), varargs...)
+}
+
+// UpdateEgressGatewayPolicyStatus mocks base method.
+func (m *MockEgressGatewayPolicyClient) UpdateEgressGatewayPolicyStatus(ctx context.Context, obj *v1.EgressGatewayPolicy, opts ...client.UpdateOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEgressGatewayPolicyStatus", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEgressGatewayPolicyStatus indicates an expected call of UpdateEgressGatewayPolicyStatus.
+func (mr *MockEgressGatewayPolicyClientMockRecorder) UpdateEgressGatewayPolicyStatus(ctx, obj interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressGatewayPolicyStatus", reflect.TypeOf((*MockEgressGatewayPolicyClient)(nil).UpdateEgressGatewayPolicyStatus), varargs...)
+}
+
+// UpsertEgressGatewayPolicy mocks base method.
+func (m *MockEgressGatewayPolicyClient) UpsertEgressGatewayPolicy(ctx context.Context, obj *v1.EgressGatewayPolicy, transitionFuncs ...v1.EgressGatewayPolicyTransitionFunction) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, obj}
+	for _, a := range transitionFuncs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertEgressGatewayPolicy", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertEgressGatewayPolicy indicates an expected call of UpsertEgressGatewayPolicy.
+func (mr *MockEgressGatewayPolicyClientMockRecorder) UpsertEgressGatewayPolicy(ctx, obj interface{}, transitionFuncs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, obj}, transitionFuncs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertEgressGatewayPolicy", reflect.TypeOf((*MockEgressGatewayPolicyClient)(nil).UpsertEgressGatewayPolicy), varargs...)
+}
+
+// MockMulticlusterEgressGatewayPolicyClient is a mock of MulticlusterEgressGatewayPolicyClient interface.
+type MockMulticlusterEgressGatewayPolicyClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterEgressGatewayPolicyClientMockRecorder
+}
+
+// MockMulticlusterEgressGatewayPolicyClientMockRecorder is the mock recorder for MockMulticlusterEgressGatewayPolicyClient.
+type MockMulticlusterEgressGatewayPolicyClientMockRecorder struct {
+	mock *MockMulticlusterEgressGatewayPolicyClient
+}
+
+// NewMockMulticlusterEgressGatewayPolicyClient creates a new mock instance.
+func NewMockMulticlusterEgressGatewayPolicyClient(ctrl *gomock.Controller) *MockMulticlusterEgressGatewayPolicyClient {
+	mock := &MockMulticlusterEgressGatewayPolicyClient{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterEgressGatewayPolicyClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterEgressGatewayPolicyClient) EXPECT() *MockMulticlusterEgressGatewayPolicyClientMockRecorder {
+	return m.recorder
+}
+
+// Cluster mocks base method.
+func (m *MockMulticlusterEgressGatewayPolicyClient) Cluster(cluster string) (v1.EgressGatewayPolicyClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cluster", cluster)
+	ret0, _ := ret[0].(v1.EgressGatewayPolicyClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cluster indicates an expected call of Cluster.
+func (mr *MockMulticlusterEgressGatewayPolicyClientMockRecorder) Cluster(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cluster", reflect.TypeOf((*MockMulticlusterEgressGatewayPolicyClient)(nil).Cluster), cluster)
+}
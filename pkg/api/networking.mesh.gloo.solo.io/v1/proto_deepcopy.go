@@ -43,3 +43,15 @@ func (in *VirtualMeshStatus) DeepCopyInto(out *VirtualMeshStatus) {
 	p := proto.Clone(in).(*VirtualMeshStatus)
 	*out = *p
 }
+
+// DeepCopyInto for the EgressGatewayPolicy.Spec
+func (in *EgressGatewayPolicySpec) DeepCopyInto(out *EgressGatewayPolicySpec) {
+	p := proto.Clone(in).(*EgressGatewayPolicySpec)
+	*out = *p
+}
+
+// DeepCopyInto for the EgressGatewayPolicy.Status
+func (in *EgressGatewayPolicyStatus) DeepCopyInto(out *EgressGatewayPolicyStatus) {
+	p := proto.Clone(in).(*EgressGatewayPolicyStatus)
+	*out = *p
+}
@@ -100,3 +100,31 @@ func VirtualMeshClientFromConfigFactoryProvider() VirtualMeshClientFromConfigFac
 		return clients.VirtualMeshes(), nil
 	}
 }
+
+// Provider for EgressGatewayPolicyClient from Clientset
+func EgressGatewayPolicyClientFromClientsetProvider(clients networking_mesh_gloo_solo_io_v1.Clientset) networking_mesh_gloo_solo_io_v1.EgressGatewayPolicyClient {
+	return clients.EgressGatewayPolicies()
+}
+
+// Provider for EgressGatewayPolicy Client from Client
+func EgressGatewayPolicyClientProvider(client client.Client) networking_mesh_gloo_solo_io_v1.EgressGatewayPolicyClient {
+	return networking_mesh_gloo_solo_io_v1.NewEgressGatewayPolicyClient(client)
+}
+
+type EgressGatewayPolicyClientFactory func(client client.Client) networking_mesh_gloo_solo_io_v1.EgressGatewayPolicyClient
+
+func EgressGatewayPolicyClientFactoryProvider() EgressGatewayPolicyClientFactory {
+	return EgressGatewayPolicyClientProvider
+}
+
+type EgressGatewayPolicyClientFromConfigFactory func(cfg *rest.Config) (networking_mesh_gloo_solo_io_v1.EgressGatewayPolicyClient, error)
+
+func EgressGatewayPolicyClientFromConfigFactoryProvider() EgressGatewayPolicyClientFromConfigFactory {
+	return func(cfg *rest.Config) (networking_mesh_gloo_solo_io_v1.EgressGatewayPolicyClient, error) {
+		clients, err := networking_mesh_gloo_solo_io_v1.NewClientsetFromConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return clients.EgressGatewayPolicies(), nil
+	}
+}
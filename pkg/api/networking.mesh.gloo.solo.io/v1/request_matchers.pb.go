@@ -27,6 +27,98 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// The canonical gRPC status codes, as defined [here](https://github.com/grpc/grpc/blob/master/doc/statuscodes.md).
+type GrpcStatusCode int32
+
+const (
+	GrpcStatusCode_OK                  GrpcStatusCode = 0
+	GrpcStatusCode_CANCELLED           GrpcStatusCode = 1
+	GrpcStatusCode_UNKNOWN             GrpcStatusCode = 2
+	GrpcStatusCode_INVALID_ARGUMENT    GrpcStatusCode = 3
+	GrpcStatusCode_DEADLINE_EXCEEDED   GrpcStatusCode = 4
+	GrpcStatusCode_NOT_FOUND           GrpcStatusCode = 5
+	GrpcStatusCode_ALREADY_EXISTS      GrpcStatusCode = 6
+	GrpcStatusCode_PERMISSION_DENIED   GrpcStatusCode = 7
+	GrpcStatusCode_RESOURCE_EXHAUSTED  GrpcStatusCode = 8
+	GrpcStatusCode_FAILED_PRECONDITION GrpcStatusCode = 9
+	GrpcStatusCode_ABORTED             GrpcStatusCode = 10
+	GrpcStatusCode_OUT_OF_RANGE        GrpcStatusCode = 11
+	GrpcStatusCode_UNIMPLEMENTED       GrpcStatusCode = 12
+	GrpcStatusCode_INTERNAL            GrpcStatusCode = 13
+	GrpcStatusCode_UNAVAILABLE         GrpcStatusCode = 14
+	GrpcStatusCode_DATA_LOSS           GrpcStatusCode = 15
+	GrpcStatusCode_UNAUTHENTICATED     GrpcStatusCode = 16
+)
+
+// Enum value maps for GrpcStatusCode.
+var (
+	GrpcStatusCode_name = map[int32]string{
+		0:  "OK",
+		1:  "CANCELLED",
+		2:  "UNKNOWN",
+		3:  "INVALID_ARGUMENT",
+		4:  "DEADLINE_EXCEEDED",
+		5:  "NOT_FOUND",
+		6:  "ALREADY_EXISTS",
+		7:  "PERMISSION_DENIED",
+		8:  "RESOURCE_EXHAUSTED",
+		9:  "FAILED_PRECONDITION",
+		10: "ABORTED",
+		11: "OUT_OF_RANGE",
+		12: "UNIMPLEMENTED",
+		13: "INTERNAL",
+		14: "UNAVAILABLE",
+		15: "DATA_LOSS",
+		16: "UNAUTHENTICATED",
+	}
+	GrpcStatusCode_value = map[string]int32{
+		"OK":                  0,
+		"CANCELLED":           1,
+		"UNKNOWN":             2,
+		"INVALID_ARGUMENT":    3,
+		"DEADLINE_EXCEEDED":   4,
+		"NOT_FOUND":           5,
+		"ALREADY_EXISTS":      6,
+		"PERMISSION_DENIED":   7,
+		"RESOURCE_EXHAUSTED":  8,
+		"FAILED_PRECONDITION": 9,
+		"ABORTED":             10,
+		"OUT_OF_RANGE":        11,
+		"UNIMPLEMENTED":       12,
+		"INTERNAL":            13,
+		"UNAVAILABLE":         14,
+		"DATA_LOSS":           15,
+		"UNAUTHENTICATED":     16,
+	}
+)
+
+func (x GrpcStatusCode) Enum() *GrpcStatusCode {
+	p := new(GrpcStatusCode)
+	*p = x
+	return p
+}
+
+func (x GrpcStatusCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GrpcStatusCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_enumTypes[0].Descriptor()
+}
+
+func (GrpcStatusCode) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_enumTypes[0]
+}
+
+func (x GrpcStatusCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GrpcStatusCode.Descriptor instead.
+func (GrpcStatusCode) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_rawDescGZIP(), []int{0}
+}
+
 type StatusCodeMatcher_Comparator int32
 
 const (
@@ -63,11 +155,11 @@ func (x StatusCodeMatcher_Comparator) String() string {
 }
 
 func (StatusCodeMatcher_Comparator) Descriptor() protoreflect.EnumDescriptor {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_enumTypes[0].Descriptor()
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_enumTypes[1].Descriptor()
 }
 
 func (StatusCodeMatcher_Comparator) Type() protoreflect.EnumType {
-	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_enumTypes[0]
+	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_enumTypes[1]
 }
 
 func (x StatusCodeMatcher_Comparator) Number() protoreflect.EnumNumber {
@@ -239,6 +331,9 @@ type HttpMatcher struct {
 	QueryParameters []*HttpMatcher_QueryParameterMatcher `protobuf:"bytes,6,rep,name=query_parameters,json=queryParameters,proto3" json:"query_parameters,omitempty"`
 	// Specify an HTTP method to match against.
 	Method string `protobuf:"bytes,7,opt,name=method,proto3" json:"method,omitempty"`
+	// Specify a gRPC service and method to match against. Matches only gRPC requests, as identified by their content type.
+	// Cannot be combined with `uri`, as the path of a gRPC request is derived from its service and method.
+	Grpc *GrpcMatcher `protobuf:"bytes,8,opt,name=grpc,proto3" json:"grpc,omitempty"`
 }
 
 func (x *HttpMatcher) Reset() {
@@ -308,6 +403,181 @@ func (x *HttpMatcher) GetMethod() string {
 	return ""
 }
 
+func (x *HttpMatcher) GetGrpc() *GrpcMatcher {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+// Specify TCP connection level match criteria. All specified conditions must be satisfied for a match to occur.
+type TcpMatcher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Specify the destination port of the connection. If omitted, all ports of the Destination are matched.
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *TcpMatcher) Reset() {
+	*x = TcpMatcher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TcpMatcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TcpMatcher) ProtoMessage() {}
+
+func (x *TcpMatcher) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TcpMatcher.ProtoReflect.Descriptor instead.
+func (*TcpMatcher) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TcpMatcher) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// Specify TLS connection level match criteria for TLS traffic that is not terminated by the mesh (i.e. TLS passthrough).
+// All specified conditions must be satisfied for a match to occur.
+type TlsMatcher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Specify the SNI values of the connection, at least one of which must match.
+	// Wildcard prefixes are supported. If omitted, the hostname of the Destination is matched.
+	SniHosts []string `protobuf:"bytes,1,rep,name=sni_hosts,json=sniHosts,proto3" json:"sni_hosts,omitempty"`
+	// Specify the destination port of the connection. If omitted, all ports of the Destination are matched.
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *TlsMatcher) Reset() {
+	*x = TlsMatcher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TlsMatcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TlsMatcher) ProtoMessage() {}
+
+func (x *TlsMatcher) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TlsMatcher.ProtoReflect.Descriptor instead.
+func (*TlsMatcher) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TlsMatcher) GetSniHosts() []string {
+	if x != nil {
+		return x.SniHosts
+	}
+	return nil
+}
+
+func (x *TlsMatcher) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// Specify gRPC request level match criteria.
+type GrpcMatcher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Specify the fully qualified name of the gRPC service, including its package (e.g. `helloworld.Greeter`).
+	// If omitted, requests to any service match.
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// Specify the name of the gRPC method (e.g. `SayHello`). If omitted, requests to any method of the service match.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (x *GrpcMatcher) Reset() {
+	*x = GrpcMatcher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrpcMatcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrpcMatcher) ProtoMessage() {}
+
+func (x *GrpcMatcher) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrpcMatcher.ProtoReflect.Descriptor instead.
+func (*GrpcMatcher) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GrpcMatcher) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GrpcMatcher) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
 // Specify match criteria against the target URL's query parameters.
 type HttpMatcher_QueryParameterMatcher struct {
 	state         protoimpl.MessageState
@@ -325,7 +595,7 @@ type HttpMatcher_QueryParameterMatcher struct {
 func (x *HttpMatcher_QueryParameterMatcher) Reset() {
 	*x = HttpMatcher_QueryParameterMatcher{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[3]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -338,7 +608,7 @@ func (x *HttpMatcher_QueryParameterMatcher) String() string {
 func (*HttpMatcher_QueryParameterMatcher) ProtoMessage() {}
 
 func (x *HttpMatcher_QueryParameterMatcher) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[3]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,7 +676,7 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_r
 	0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72,
 	0x22, 0x24, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x06,
 	0x0a, 0x02, 0x45, 0x51, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x47, 0x45, 0x10, 0x01, 0x12, 0x06,
-	0x0a, 0x02, 0x4c, 0x45, 0x10, 0x02, 0x22, 0xfe, 0x02, 0x0a, 0x0b, 0x48, 0x74, 0x74, 0x70, 0x4d,
+	0x0a, 0x02, 0x4c, 0x45, 0x10, 0x02, 0x22, 0xbd, 0x03, 0x0a, 0x0b, 0x48, 0x74, 0x74, 0x70, 0x4d,
 	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x03, 0x75, 0x72,
 	0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
@@ -424,18 +694,52 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_r
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x4d, 0x61,
 	0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x1a, 0x57,
-	0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c,
-	0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x3d,
+	0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x72, 0x70, 0x63,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x57, 0x0a,
+	0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x22, 0x20, 0x0a, 0x0a, 0x54, 0x63, 0x70, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x3d, 0x0a, 0x0a, 0x54, 0x6c, 0x73, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6e, 0x69, 0x5f, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6e, 0x69, 0x48, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x3f, 0x0a, 0x0b, 0x47, 0x72, 0x70, 0x63, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2a, 0xc1, 0x02, 0x0a, 0x0e, 0x47, 0x72, 0x70,
+	0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4f,
+	0x4b, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12,
+	0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d,
+	0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e,
+	0x45, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x41,
+	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x06, 0x12,
+	0x15, 0x0a, 0x11, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45,
+	0x4e, 0x49, 0x45, 0x44, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x45, 0x58, 0x48, 0x41, 0x55, 0x53, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x17,
+	0x0a, 0x13, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x44,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x09, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x42, 0x4f, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0x0a, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x52,
+	0x41, 0x4e, 0x47, 0x45, 0x10, 0x0b, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x49, 0x4d, 0x50, 0x4c,
+	0x45, 0x4d, 0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x0d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x41, 0x56, 0x41,
+	0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x0e, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x41, 0x54, 0x41,
+	0x5f, 0x4c, 0x4f, 0x53, 0x53, 0x10, 0x0f, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x4e, 0x41, 0x55, 0x54,
+	0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x10, 0x42, 0x46, 0x5a, 0x44,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d,
+	0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -450,26 +754,31 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_
 	return file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_goTypes = []interface{}{
-	(StatusCodeMatcher_Comparator)(0),         // 0: networking.mesh.gloo.solo.io.StatusCodeMatcher.Comparator
-	(*HeaderMatcher)(nil),                     // 1: networking.mesh.gloo.solo.io.HeaderMatcher
-	(*StatusCodeMatcher)(nil),                 // 2: networking.mesh.gloo.solo.io.StatusCodeMatcher
-	(*HttpMatcher)(nil),                       // 3: networking.mesh.gloo.solo.io.HttpMatcher
-	(*HttpMatcher_QueryParameterMatcher)(nil), // 4: networking.mesh.gloo.solo.io.HttpMatcher.QueryParameterMatcher
-	(*v1.StringMatch)(nil),                    // 5: common.mesh.gloo.solo.io.StringMatch
+	(GrpcStatusCode)(0),                       // 0: networking.mesh.gloo.solo.io.GrpcStatusCode
+	(StatusCodeMatcher_Comparator)(0),         // 1: networking.mesh.gloo.solo.io.StatusCodeMatcher.Comparator
+	(*HeaderMatcher)(nil),                     // 2: networking.mesh.gloo.solo.io.HeaderMatcher
+	(*StatusCodeMatcher)(nil),                 // 3: networking.mesh.gloo.solo.io.StatusCodeMatcher
+	(*HttpMatcher)(nil),                       // 4: networking.mesh.gloo.solo.io.HttpMatcher
+	(*TcpMatcher)(nil),                        // 5: networking.mesh.gloo.solo.io.TcpMatcher
+	(*TlsMatcher)(nil),                        // 6: networking.mesh.gloo.solo.io.TlsMatcher
+	(*GrpcMatcher)(nil),                       // 7: networking.mesh.gloo.solo.io.GrpcMatcher
+	(*HttpMatcher_QueryParameterMatcher)(nil), // 8: networking.mesh.gloo.solo.io.HttpMatcher.QueryParameterMatcher
+	(*v1.StringMatch)(nil),                    // 9: common.mesh.gloo.solo.io.StringMatch
 }
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_depIdxs = []int32{
-	0, // 0: networking.mesh.gloo.solo.io.StatusCodeMatcher.comparator:type_name -> networking.mesh.gloo.solo.io.StatusCodeMatcher.Comparator
-	5, // 1: networking.mesh.gloo.solo.io.HttpMatcher.uri:type_name -> common.mesh.gloo.solo.io.StringMatch
-	1, // 2: networking.mesh.gloo.solo.io.HttpMatcher.headers:type_name -> networking.mesh.gloo.solo.io.HeaderMatcher
-	4, // 3: networking.mesh.gloo.solo.io.HttpMatcher.query_parameters:type_name -> networking.mesh.gloo.solo.io.HttpMatcher.QueryParameterMatcher
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1, // 0: networking.mesh.gloo.solo.io.StatusCodeMatcher.comparator:type_name -> networking.mesh.gloo.solo.io.StatusCodeMatcher.Comparator
+	9, // 1: networking.mesh.gloo.solo.io.HttpMatcher.uri:type_name -> common.mesh.gloo.solo.io.StringMatch
+	2, // 2: networking.mesh.gloo.solo.io.HttpMatcher.headers:type_name -> networking.mesh.gloo.solo.io.HeaderMatcher
+	8, // 3: networking.mesh.gloo.solo.io.HttpMatcher.query_parameters:type_name -> networking.mesh.gloo.solo.io.HttpMatcher.QueryParameterMatcher
+	7, // 4: networking.mesh.gloo.solo.io.HttpMatcher.grpc:type_name -> networking.mesh.gloo.solo.io.GrpcMatcher
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_init() }
@@ -515,6 +824,42 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TcpMatcher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TlsMatcher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcMatcher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HttpMatcher_QueryParameterMatcher); i {
 			case 0:
 				return &v.state
@@ -532,8 +877,8 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   4,
+			NumEnums:      2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
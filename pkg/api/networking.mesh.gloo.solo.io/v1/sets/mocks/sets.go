@@ -782,3 +782,259 @@ func (mr *MockVirtualMeshSetMockRecorder) UnsortedList(filterResource ...interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsortedList", reflect.TypeOf((*MockVirtualMeshSet)(nil).UnsortedList), filterResource...)
 }
+
+// MockEgressGatewayPolicySet is a mock of EgressGatewayPolicySet interface.
+type MockEgressGatewayPolicySet struct {
+	ctrl     *gomock.Controller
+	recorder *MockEgressGatewayPolicySetMockRecorder
+}
+
+// MockEgressGatewayPolicySetMockRecorder is the mock recorder for MockEgressGatewayPolicySet.
+type MockEgressGatewayPolicySetMockRecorder struct {
+	mock *MockEgressGatewayPolicySet
+}
+
+// NewMockEgressGatewayPolicySet creates a new mock instance.
+func NewMockEgressGatewayPolicySet(ctrl *gomock.Controller) *MockEgressGatewayPolicySet {
+	mock := &MockEgressGatewayPolicySet{ctrl: ctrl}
+	mock.recorder = &MockEgressGatewayPolicySetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEgressGatewayPolicySet) EXPECT() *MockEgressGatewayPolicySetMockRecorder {
+	return m.recorder
+}
+
+// Clone mocks base method.
+func (m *MockEgressGatewayPolicySet) Clone() v1sets.EgressGatewayPolicySet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone")
+	ret0, _ := ret[0].(v1sets.EgressGatewayPolicySet)
+	return ret0
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Clone() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Clone))
+}
+
+// Delete mocks base method.
+func (m *MockEgressGatewayPolicySet) Delete(egressGatewayPolicy ezkube.ResourceId) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Delete", egressGatewayPolicy)
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Delete(egressGatewayPolicy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Delete), egressGatewayPolicy)
+}
+
+// Delta mocks base method.
+func (m *MockEgressGatewayPolicySet) Delta(newSet v1sets.EgressGatewayPolicySet) sets.ResourceDelta {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delta", newSet)
+	ret0, _ := ret[0].(sets.ResourceDelta)
+	return ret0
+}
+
+// Delta indicates an expected call of Delta.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Delta(newSet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delta", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Delta), newSet)
+}
+
+// Difference mocks base method.
+func (m *MockEgressGatewayPolicySet) Difference(set v1sets.EgressGatewayPolicySet) v1sets.EgressGatewayPolicySet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Difference", set)
+	ret0, _ := ret[0].(v1sets.EgressGatewayPolicySet)
+	return ret0
+}
+
+// Difference indicates an expected call of Difference.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Difference(set interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Difference", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Difference), set)
+}
+
+// Equal mocks base method.
+func (m *MockEgressGatewayPolicySet) Equal(egressGatewayPolicySet v1sets.EgressGatewayPolicySet) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Equal", egressGatewayPolicySet)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Equal indicates an expected call of Equal.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Equal(egressGatewayPolicySet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Equal", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Equal), egressGatewayPolicySet)
+}
+
+// Find mocks base method.
+func (m *MockEgressGatewayPolicySet) Find(id ezkube.ResourceId) (*v1.EgressGatewayPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", id)
+	ret0, _ := ret[0].(*v1.EgressGatewayPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Find(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Find), id)
+}
+
+// Generic mocks base method.
+func (m *MockEgressGatewayPolicySet) Generic() sets.ResourceSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generic")
+	ret0, _ := ret[0].(sets.ResourceSet)
+	return ret0
+}
+
+// Generic indicates an expected call of Generic.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Generic() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generic", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Generic))
+}
+
+// Has mocks base method.
+func (m *MockEgressGatewayPolicySet) Has(egressGatewayPolicy ezkube.ResourceId) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Has", egressGatewayPolicy)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Has indicates an expected call of Has.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Has(egressGatewayPolicy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Has", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Has), egressGatewayPolicy)
+}
+
+// Insert mocks base method.
+func (m *MockEgressGatewayPolicySet) Insert(egressGatewayPolicy ...*v1.EgressGatewayPolicy) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range egressGatewayPolicy {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Insert", varargs...)
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Insert(egressGatewayPolicy ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Insert), egressGatewayPolicy...)
+}
+
+// Intersection mocks base method.
+func (m *MockEgressGatewayPolicySet) Intersection(set v1sets.EgressGatewayPolicySet) v1sets.EgressGatewayPolicySet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Intersection", set)
+	ret0, _ := ret[0].(v1sets.EgressGatewayPolicySet)
+	return ret0
+}
+
+// Intersection indicates an expected call of Intersection.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Intersection(set interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Intersection", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Intersection), set)
+}
+
+// Keys mocks base method.
+func (m *MockEgressGatewayPolicySet) Keys() sets0.String {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Keys")
+	ret0, _ := ret[0].(sets0.String)
+	return ret0
+}
+
+// Keys indicates an expected call of Keys.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Keys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Keys))
+}
+
+// Length mocks base method.
+func (m *MockEgressGatewayPolicySet) Length() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Length")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Length indicates an expected call of Length.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Length() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Length", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Length))
+}
+
+// List mocks base method.
+func (m *MockEgressGatewayPolicySet) List(filterResource ...func(*v1.EgressGatewayPolicy) bool) []*v1.EgressGatewayPolicy {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filterResource {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].([]*v1.EgressGatewayPolicy)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockEgressGatewayPolicySetMockRecorder) List(filterResource ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).List), filterResource...)
+}
+
+// Map mocks base method.
+func (m *MockEgressGatewayPolicySet) Map() map[string]*v1.EgressGatewayPolicy {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Map")
+	ret0, _ := ret[0].(map[string]*v1.EgressGatewayPolicy)
+	return ret0
+}
+
+// Map indicates an expected call of Map.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Map() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Map", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Map))
+}
+
+// Union mocks base method.
+func (m *MockEgressGatewayPolicySet) Union(set v1sets.EgressGatewayPolicySet) v1sets.EgressGatewayPolicySet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Union", set)
+	ret0, _ := ret[0].(v1sets.EgressGatewayPolicySet)
+	return ret0
+}
+
+// Union indicates an expected call of Union.
+func (mr *MockEgressGatewayPolicySetMockRecorder) Union(set interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Union", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).Union), set)
+}
+
+// UnsortedList mocks base method.
+func (m *MockEgressGatewayPolicySet) UnsortedList(filterResource ...func(*v1.EgressGatewayPolicy) bool) []*v1.EgressGatewayPolicy {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filterResource {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnsortedList", varargs...)
+	ret0, _ := ret[0].([]*v1.EgressGatewayPolicy)
+	return ret0
+}
+
+// UnsortedList indicates an expected call of UnsortedList.
+func (mr *MockEgressGatewayPolicySetMockRecorder) UnsortedList(filterResource ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsortedList", reflect.TypeOf((*MockEgressGatewayPolicySet)(nil).UnsortedList), filterResource...)
+}
@@ -669,3 +669,222 @@ func (s *virtualMeshSet) Clone() VirtualMeshSet {
 	}
 	return &virtualMeshSet{set: sksets.NewResourceSet(s.Generic().Clone().List()...)}
 }
+
+type EgressGatewayPolicySet interface {
+	// Get the set stored keys
+	Keys() sets.String
+	// List of resources stored in the set. Pass an optional filter function to filter on the list.
+	List(filterResource ...func(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) bool) []*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy
+	// Unsorted list of resources stored in the set. Pass an optional filter function to filter on the list.
+	UnsortedList(filterResource ...func(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) bool) []*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy
+	// Return the Set as a map of key to resource.
+	Map() map[string]*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy
+	// Insert a resource into the set.
+	Insert(egressGatewayPolicy ...*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy)
+	// Compare the equality of the keys in two sets (not the resources themselves)
+	Equal(egressGatewayPolicySet EgressGatewayPolicySet) bool
+	// Check if the set contains a key matching the resource (not the resource itself)
+	Has(egressGatewayPolicy ezkube.ResourceId) bool
+	// Delete the key matching the resource
+	Delete(egressGatewayPolicy ezkube.ResourceId)
+	// Return the union with the provided set
+	Union(set EgressGatewayPolicySet) EgressGatewayPolicySet
+	// Return the difference with the provided set
+	Difference(set EgressGatewayPolicySet) EgressGatewayPolicySet
+	// Return the intersection with the provided set
+	Intersection(set EgressGatewayPolicySet) EgressGatewayPolicySet
+	// Find the resource with the given ID
+	Find(id ezkube.ResourceId) (*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy, error)
+	// Get the length of the set
+	Length() int
+	// returns the generic implementation of the set
+	Generic() sksets.ResourceSet
+	// returns the delta between this and and another EgressGatewayPolicySet
+	Delta(newSet EgressGatewayPolicySet) sksets.ResourceDelta
+	// Create a deep copy of the current EgressGatewayPolicySet
+	Clone() EgressGatewayPolicySet
+}
+
+func makeGenericEgressGatewayPolicySet(egressGatewayPolicyList []*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) sksets.ResourceSet {
+	var genericResources []ezkube.ResourceId
+	for _, obj := range egressGatewayPolicyList {
+		genericResources = append(genericResources, obj)
+	}
+	return sksets.NewResourceSet(genericResources...)
+}
+
+type egressGatewayPolicySet struct {
+	set sksets.ResourceSet
+}
+
+func NewEgressGatewayPolicySet(egressGatewayPolicyList ...*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) EgressGatewayPolicySet {
+	return &egressGatewayPolicySet{set: makeGenericEgressGatewayPolicySet(egressGatewayPolicyList)}
+}
+
+func NewEgressGatewayPolicySetFromList(egressGatewayPolicyList *networking_mesh_gloo_solo_io_v1.EgressGatewayPolicyList) EgressGatewayPolicySet {
+	list := make([]*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy, 0, len(egressGatewayPolicyList.Items))
+	for idx := range egressGatewayPolicyList.Items {
+		list = append(list, &egressGatewayPolicyList.Items[idx])
+	}
+	return &egressGatewayPolicySet{set: makeGenericEgressGatewayPolicySet(list)}
+}
+
+func (s *egressGatewayPolicySet) Keys() sets.String {
+	if s == nil {
+		return sets.String{}
+	}
+	return s.Generic().Keys()
+}
+
+func (s *egressGatewayPolicySet) List(filterResource ...func(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) bool) []*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy {
+	if s == nil {
+		return nil
+	}
+	var genericFilters []func(ezkube.ResourceId) bool
+	for _, filter := range filterResource {
+		genericFilters = append(genericFilters, func(obj ezkube.ResourceId) bool {
+			return filter(obj.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy))
+		})
+	}
+
+	objs := s.Generic().List(genericFilters...)
+	egressGatewayPolicyList := make([]*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy, 0, len(objs))
+	for _, obj := range objs {
+		egressGatewayPolicyList = append(egressGatewayPolicyList, obj.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy))
+	}
+	return egressGatewayPolicyList
+}
+
+func (s *egressGatewayPolicySet) UnsortedList(filterResource ...func(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy) bool) []*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy {
+	if s == nil {
+		return nil
+	}
+	var genericFilters []func(ezkube.ResourceId) bool
+	for _, filter := range filterResource {
+		genericFilters = append(genericFilters, func(obj ezkube.ResourceId) bool {
+			return filter(obj.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy))
+		})
+	}
+
+	var egressGatewayPolicyList []*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy
+	for _, obj := range s.Generic().UnsortedList(genericFilters...) {
+		egressGatewayPolicyList = append(egressGatewayPolicyList, obj.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy))
+	}
+	return egressGatewayPolicyList
+}
+
+func (s *egressGatewayPolicySet) Map() map[string]*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy {
+	if s == nil {
+		return nil
+	}
+
+	newMap := map[string]*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy{}
+	for k, v := range s.Generic().Map() {
+		newMap[k] = v.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy)
+	}
+	return newMap
+}
+
+func (s *egressGatewayPolicySet) Insert(
+	egressGatewayPolicyList ...*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy,
+) {
+	if s == nil {
+		panic("cannot insert into nil set")
+	}
+
+	for _, obj := range egressGatewayPolicyList {
+		s.Generic().Insert(obj)
+	}
+}
+
+func (s *egressGatewayPolicySet) Has(egressGatewayPolicy ezkube.ResourceId) bool {
+	if s == nil {
+		return false
+	}
+	return s.Generic().Has(egressGatewayPolicy)
+}
+
+func (s *egressGatewayPolicySet) Equal(
+	egressGatewayPolicySet EgressGatewayPolicySet,
+) bool {
+	if s == nil {
+		return egressGatewayPolicySet == nil
+	}
+	return s.Generic().Equal(egressGatewayPolicySet.Generic())
+}
+
+func (s *egressGatewayPolicySet) Delete(EgressGatewayPolicy ezkube.ResourceId) {
+	if s == nil {
+		return
+	}
+	s.Generic().Delete(EgressGatewayPolicy)
+}
+
+func (s *egressGatewayPolicySet) Union(set EgressGatewayPolicySet) EgressGatewayPolicySet {
+	if s == nil {
+		return set
+	}
+	return NewEgressGatewayPolicySet(append(s.List(), set.List()...)...)
+}
+
+func (s *egressGatewayPolicySet) Difference(set EgressGatewayPolicySet) EgressGatewayPolicySet {
+	if s == nil {
+		return set
+	}
+	newSet := s.Generic().Difference(set.Generic())
+	return &egressGatewayPolicySet{set: newSet}
+}
+
+func (s *egressGatewayPolicySet) Intersection(set EgressGatewayPolicySet) EgressGatewayPolicySet {
+	if s == nil {
+		return nil
+	}
+	newSet := s.Generic().Intersection(set.Generic())
+	var egressGatewayPolicyList []*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy
+	for _, obj := range newSet.List() {
+		egressGatewayPolicyList = append(egressGatewayPolicyList, obj.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy))
+	}
+	return NewEgressGatewayPolicySet(egressGatewayPolicyList...)
+}
+
+func (s *egressGatewayPolicySet) Find(id ezkube.ResourceId) (*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy, error) {
+	if s == nil {
+		return nil, eris.Errorf("empty set, cannot find EgressGatewayPolicy %v", sksets.Key(id))
+	}
+	obj, err := s.Generic().Find(&networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy{}, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.(*networking_mesh_gloo_solo_io_v1.EgressGatewayPolicy), nil
+}
+
+func (s *egressGatewayPolicySet) Length() int {
+	if s == nil {
+		return 0
+	}
+	return s.Generic().Length()
+}
+
+func (s *egressGatewayPolicySet) Generic() sksets.ResourceSet {
+	if s == nil {
+		return nil
+	}
+	return s.set
+}
+
+func (s *egressGatewayPolicySet) Delta(newSet EgressGatewayPolicySet) sksets.ResourceDelta {
+	if s == nil {
+		return sksets.ResourceDelta{
+			Inserted: newSet.Generic(),
+		}
+	}
+	return s.Generic().Delta(newSet.Generic())
+}
+
+func (s *egressGatewayPolicySet) Clone() EgressGatewayPolicySet {
+	if s == nil {
+		return nil
+	}
+	return &egressGatewayPolicySet{set: sksets.NewResourceSet(s.Generic().Clone().List()...)}
+}
@@ -37,7 +37,7 @@ type ApprovalStatus struct {
 	// Represents the order in which the policy
 	// was accepted and applied to a discovery resource. The first accepted policy
 	// will have an acceptance_order of 0, the second 1, etc.
-	// When conflicts are detected in the system,
+	// When conflicts are detected in the system between policies of equal priority,
 	// the Policy with the lowest acceptance_order
 	// will be chosen and all other conflicting policies will be rejected.
 	AcceptanceOrder uint32 `protobuf:"varint,1,opt,name=acceptance_order,json=acceptanceOrder,proto3" json:"acceptance_order,omitempty"`
@@ -97,6 +97,40 @@ func (m *TrafficPolicySpec) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetTcpRequestMatchers()) != len(target.GetTcpRequestMatchers()) {
+		return false
+	}
+	for idx, v := range m.GetTcpRequestMatchers() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetTcpRequestMatchers()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetTcpRequestMatchers()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetTlsRequestMatchers()) != len(target.GetTlsRequestMatchers()) {
+		return false
+	}
+	for idx, v := range m.GetTlsRequestMatchers() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetTlsRequestMatchers()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetTlsRequestMatchers()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	if h, ok := interface{}(m.GetPolicy()).(equality.Equalizer); ok {
 		if !h.Equal(target.GetPolicy()) {
 			return false
@@ -107,6 +141,10 @@ func (m *TrafficPolicySpec) Equal(that interface{}) bool {
 		}
 	}
 
+	if m.GetPriority() != target.GetPriority() {
+		return false
+	}
+
 	return true
 }
 
@@ -178,6 +216,16 @@ func (m *TrafficPolicyStatus) Equal(that interface{}) bool {
 
 	}
 
+	if h, ok := interface{}(m.GetCanary()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCanary()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCanary(), target.GetCanary()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -312,6 +360,46 @@ func (m *TrafficPolicySpec_Policy) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLoadBalancer()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLoadBalancer()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLoadBalancer(), target.GetLoadBalancer()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetConnectionPool()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetConnectionPool()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetConnectionPool(), target.GetConnectionPool()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetJwt()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetJwt()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetJwt(), target.GetJwt()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetCanary()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetCanary()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetCanary(), target.GetCanary()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -350,6 +438,49 @@ func (m *TrafficPolicySpec_Policy_RetryPolicy) Equal(that interface{}) bool {
 		}
 	}
 
+	if len(m.GetRetryOn()) != len(target.GetRetryOn()) {
+		return false
+	}
+	for idx, v := range m.GetRetryOn() {
+
+		if strings.Compare(v, target.GetRetryOn()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetRetriableStatusCodes()) != len(target.GetRetriableStatusCodes()) {
+		return false
+	}
+	for idx, v := range m.GetRetriableStatusCodes() {
+
+		if v != target.GetRetriableStatusCodes()[idx] {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetRetryRemoteLocalities()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRetryRemoteLocalities()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRetryRemoteLocalities(), target.GetRetryRemoteLocalities()) {
+			return false
+		}
+	}
+
+	if len(m.GetRetriableGrpcStatusCodes()) != len(target.GetRetriableGrpcStatusCodes()) {
+		return false
+	}
+	for idx, v := range m.GetRetriableGrpcStatusCodes() {
+
+		if v != target.GetRetriableGrpcStatusCodes()[idx] {
+			return false
+		}
+
+	}
+
 	return true
 }
 
@@ -394,6 +525,113 @@ func (m *TrafficPolicySpec_Policy_MultiDestination) Equal(that interface{}) bool
 	return true
 }
 
+// Equal function
+func (m *TrafficPolicySpec_Policy_Canary) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_Canary)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_Canary)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetCanarySubset()) != len(target.GetCanarySubset()) {
+		return false
+	}
+	for k, v := range m.GetCanarySubset() {
+
+		if strings.Compare(v, target.GetCanarySubset()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetStableSubset()) != len(target.GetStableSubset()) {
+		return false
+	}
+	for k, v := range m.GetStableSubset() {
+
+		if strings.Compare(v, target.GetStableSubset()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetCohortHeaders()) != len(target.GetCohortHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetCohortHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetCohortHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetCohortHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetCohortCookies()) != len(target.GetCohortCookies()) {
+		return false
+	}
+	for idx, v := range m.GetCohortCookies() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetCohortCookies()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetCohortCookies()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetSteps()) != len(target.GetSteps()) {
+		return false
+	}
+	for idx, v := range m.GetSteps() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetSteps()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetSteps()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetAnalysis()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetAnalysis()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetAnalysis(), target.GetAnalysis()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *TrafficPolicySpec_Policy_FaultInjection) Equal(that interface{}) bool {
 	if that == nil {
@@ -664,14 +902,14 @@ func (m *TrafficPolicySpec_Policy_OutlierDetection) Equal(that interface{}) bool
 }
 
 // Equal function
-func (m *TrafficPolicySpec_Policy_MTLS) Equal(that interface{}) bool {
+func (m *TrafficPolicySpec_Policy_LoadBalancer) Equal(that interface{}) bool {
 	if that == nil {
 		return m == nil
 	}
 
-	target, ok := that.(*TrafficPolicySpec_Policy_MTLS)
+	target, ok := that.(*TrafficPolicySpec_Policy_LoadBalancer)
 	if !ok {
-		that2, ok := that.(TrafficPolicySpec_Policy_MTLS)
+		that2, ok := that.(TrafficPolicySpec_Policy_LoadBalancer)
 		if ok {
 			target = &that2
 		} else {
@@ -684,12 +922,45 @@ func (m *TrafficPolicySpec_Policy_MTLS) Equal(that interface{}) bool {
 		return false
 	}
 
-	if h, ok := interface{}(m.GetIstio()).(equality.Equalizer); ok {
-		if !h.Equal(target.GetIstio()) {
+	if h, ok := interface{}(m.GetLocality()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocality()) {
 			return false
 		}
 	} else {
-		if !proto.Equal(m.GetIstio(), target.GetIstio()) {
+		if !proto.Equal(m.GetLocality(), target.GetLocality()) {
+			return false
+		}
+	}
+
+	switch m.LbPolicy.(type) {
+
+	case *TrafficPolicySpec_Policy_LoadBalancer_Simple:
+		if _, ok := target.LbPolicy.(*TrafficPolicySpec_Policy_LoadBalancer_Simple); !ok {
+			return false
+		}
+
+		if m.GetSimple() != target.GetSimple() {
+			return false
+		}
+
+	case *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_:
+		if _, ok := target.LbPolicy.(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetConsistentHash()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetConsistentHash()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetConsistentHash(), target.GetConsistentHash()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.LbPolicy != target.LbPolicy {
 			return false
 		}
 	}
@@ -698,14 +969,14 @@ func (m *TrafficPolicySpec_Policy_MTLS) Equal(that interface{}) bool {
 }
 
 // Equal function
-func (m *TrafficPolicySpec_Policy_Transform) Equal(that interface{}) bool {
+func (m *TrafficPolicySpec_Policy_ConnectionPool) Equal(that interface{}) bool {
 	if that == nil {
 		return m == nil
 	}
 
-	target, ok := that.(*TrafficPolicySpec_Policy_Transform)
+	target, ok := that.(*TrafficPolicySpec_Policy_ConnectionPool)
 	if !ok {
-		that2, ok := that.(TrafficPolicySpec_Policy_Transform)
+		that2, ok := that.(TrafficPolicySpec_Policy_ConnectionPool)
 		if ok {
 			target = &that2
 		} else {
@@ -718,22 +989,38 @@ func (m *TrafficPolicySpec_Policy_Transform) Equal(that interface{}) bool {
 		return false
 	}
 
-	if strings.Compare(m.GetTodo(), target.GetTodo()) != 0 {
-		return false
+	if h, ok := interface{}(m.GetTcp()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTcp()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTcp(), target.GetTcp()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetHttp()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHttp()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHttp(), target.GetHttp()) {
+			return false
+		}
 	}
 
 	return true
 }
 
 // Equal function
-func (m *TrafficPolicySpec_Policy_DLPPolicy) Equal(that interface{}) bool {
+func (m *TrafficPolicySpec_Policy_MTLS) Equal(that interface{}) bool {
 	if that == nil {
 		return m == nil
 	}
 
-	target, ok := that.(*TrafficPolicySpec_Policy_DLPPolicy)
+	target, ok := that.(*TrafficPolicySpec_Policy_MTLS)
 	if !ok {
-		that2, ok := that.(TrafficPolicySpec_Policy_DLPPolicy)
+		that2, ok := that.(TrafficPolicySpec_Policy_MTLS)
 		if ok {
 			target = &that2
 		} else {
@@ -746,22 +1033,28 @@ func (m *TrafficPolicySpec_Policy_DLPPolicy) Equal(that interface{}) bool {
 		return false
 	}
 
-	if strings.Compare(m.GetTodo(), target.GetTodo()) != 0 {
-		return false
+	if h, ok := interface{}(m.GetIstio()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetIstio()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetIstio(), target.GetIstio()) {
+			return false
+		}
 	}
 
 	return true
 }
 
 // Equal function
-func (m *TrafficPolicySpec_Policy_ExtAuth) Equal(that interface{}) bool {
+func (m *TrafficPolicySpec_Policy_Transform) Equal(that interface{}) bool {
 	if that == nil {
 		return m == nil
 	}
 
-	target, ok := that.(*TrafficPolicySpec_Policy_ExtAuth)
+	target, ok := that.(*TrafficPolicySpec_Policy_Transform)
 	if !ok {
-		that2, ok := that.(TrafficPolicySpec_Policy_ExtAuth)
+		that2, ok := that.(TrafficPolicySpec_Policy_Transform)
 		if ok {
 			target = &that2
 		} else {
@@ -782,14 +1075,14 @@ func (m *TrafficPolicySpec_Policy_ExtAuth) Equal(that interface{}) bool {
 }
 
 // Equal function
-func (m *TrafficPolicySpec_Policy_FaultInjection_Abort) Equal(that interface{}) bool {
+func (m *TrafficPolicySpec_Policy_DLPPolicy) Equal(that interface{}) bool {
 	if that == nil {
 		return m == nil
 	}
 
-	target, ok := that.(*TrafficPolicySpec_Policy_FaultInjection_Abort)
+	target, ok := that.(*TrafficPolicySpec_Policy_DLPPolicy)
 	if !ok {
-		that2, ok := that.(TrafficPolicySpec_Policy_FaultInjection_Abort)
+		that2, ok := that.(TrafficPolicySpec_Policy_DLPPolicy)
 		if ok {
 			target = &that2
 		} else {
@@ -802,7 +1095,7 @@ func (m *TrafficPolicySpec_Policy_FaultInjection_Abort) Equal(that interface{})
 		return false
 	}
 
-	if m.GetHttpStatus() != target.GetHttpStatus() {
+	if strings.Compare(m.GetTodo(), target.GetTodo()) != 0 {
 		return false
 	}
 
@@ -810,7 +1103,402 @@ func (m *TrafficPolicySpec_Policy_FaultInjection_Abort) Equal(that interface{})
 }
 
 // Equal function
-func (m *TrafficPolicySpec_Policy_MTLS_Istio) Equal(that interface{}) bool {
+func (m *TrafficPolicySpec_Policy_ExtAuth) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_ExtAuth)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_ExtAuth)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetTodo(), target.GetTodo()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_Canary_CookieMatcher) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_Canary_CookieMatcher)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_Canary_CookieMatcher)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetValue(), target.GetValue()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_Canary_Step) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_Canary_Step)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_Canary_Step)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetWeight() != target.GetWeight() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetDuration()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDuration()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDuration(), target.GetDuration()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_Canary_Analysis) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_Canary_Analysis)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_Canary_Analysis)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetMaxErrorRate() != target.GetMaxErrorRate() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMaxLatency()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxLatency()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxLatency(), target.GetMaxLatency()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetInterval(), target.GetInterval()) {
+			return false
+		}
+	}
+
+	if m.GetMinRequestCount() != target.GetMinRequestCount() {
+		return false
+	}
+
+	if m.GetFailureAction() != target.GetFailureAction() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_FaultInjection_Abort) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_FaultInjection_Abort)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_FaultInjection_Abort)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetHttpStatus() != target.GetHttpStatus() {
+		return false
+	}
+
+	if m.GetGrpcStatus() != target.GetGrpcStatus() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetMinimumRingSize() != target.GetMinimumRingSize() {
+		return false
+	}
+
+	switch m.HashKey.(type) {
+
+	case *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpHeaderName:
+		if _, ok := target.HashKey.(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpHeaderName); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetHttpHeaderName(), target.GetHttpHeaderName()) != 0 {
+			return false
+		}
+
+	case *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie_:
+		if _, ok := target.HashKey.(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie_); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetHttpCookie()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHttpCookie()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetHttpCookie(), target.GetHttpCookie()) {
+				return false
+			}
+		}
+
+	case *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_UseSourceIp:
+		if _, ok := target.HashKey.(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_UseSourceIp); !ok {
+			return false
+		}
+
+		if m.GetUseSourceIp() != target.GetUseSourceIp() {
+			return false
+		}
+
+	case *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpQueryParameterName:
+		if _, ok := target.HashKey.(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpQueryParameterName); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetHttpQueryParameterName(), target.GetHttpQueryParameterName()) != 0 {
+			return false
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.HashKey != target.HashKey {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetPath(), target.GetPath()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetTtl()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTtl()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTtl(), target.GetTtl()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_ConnectionPool_Tcp) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_ConnectionPool_Tcp)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_ConnectionPool_Tcp)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetMaxConnections() != target.GetMaxConnections() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetConnectTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetConnectTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetConnectTimeout(), target.GetConnectTimeout()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_ConnectionPool_Http) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_ConnectionPool_Http)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_ConnectionPool_Http)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetHttp1MaxPendingRequests() != target.GetHttp1MaxPendingRequests() {
+		return false
+	}
+
+	if m.GetHttp2MaxRequests() != target.GetHttp2MaxRequests() {
+		return false
+	}
+
+	if m.GetMaxRequestsPerConnection() != target.GetMaxRequestsPerConnection() {
+		return false
+	}
+
+	if m.GetMaxRetries() != target.GetMaxRetries() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetIdleTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetIdleTimeout(), target.GetIdleTimeout()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_MTLS_Istio) Equal(that interface{}) bool {
 	if that == nil {
 		return m == nil
 	}
@@ -836,3 +1524,67 @@ func (m *TrafficPolicySpec_Policy_MTLS_Istio) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *TrafficPolicyStatus_CanaryStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicyStatus_CanaryStatus)
+	if !ok {
+		that2, ok := that.(TrafficPolicyStatus_CanaryStatus)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetObservedGeneration() != target.GetObservedGeneration() {
+		return false
+	}
+
+	if m.GetPhase() != target.GetPhase() {
+		return false
+	}
+
+	if m.GetCurrentStep() != target.GetCurrentStep() {
+		return false
+	}
+
+	if m.GetWeight() != target.GetWeight() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetStepStartTime()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetStepStartTime()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetStepStartTime(), target.GetStepStartTime()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetMessage(), target.GetMessage()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetLastAnalysisTime()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLastAnalysisTime()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLastAnalysisTime(), target.GetLastAnalysisTime()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -12,10 +12,11 @@ import (
 
 	proto "github.com/golang/protobuf/proto"
 	duration "github.com/golang/protobuf/ptypes/duration"
-	_ "github.com/golang/protobuf/ptypes/timestamp"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
 	csrf "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/csrf"
+	jwt "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/jwt"
 	ratelimit "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/ratelimit"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	v11 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
@@ -34,6 +35,165 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// Describes how a field of a TrafficPolicy is merged with the same field set by TrafficPolicies of lower priority.
+type MergeStrategy int32
+
+const (
+	// The value of the TrafficPolicy with the highest priority is used.
+	MergeStrategy_OVERRIDE MergeStrategy = 0
+	// The values of all TrafficPolicies are combined, with the TrafficPolicy with the highest priority taking precedence.
+	MergeStrategy_APPEND MergeStrategy = 1
+	// The field cannot be merged, a TrafficPolicy which sets a different value than a TrafficPolicy of lower priority is rejected.
+	MergeStrategy_REJECT MergeStrategy = 2
+)
+
+// Enum value maps for MergeStrategy.
+var (
+	MergeStrategy_name = map[int32]string{
+		0: "OVERRIDE",
+		1: "APPEND",
+		2: "REJECT",
+	}
+	MergeStrategy_value = map[string]int32{
+		"OVERRIDE": 0,
+		"APPEND":   1,
+		"REJECT":   2,
+	}
+)
+
+func (x MergeStrategy) Enum() *MergeStrategy {
+	p := new(MergeStrategy)
+	*p = x
+	return p
+}
+
+func (x MergeStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[0].Descriptor()
+}
+
+func (MergeStrategy) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[0]
+}
+
+func (x MergeStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeStrategy.Descriptor instead.
+func (MergeStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0}
+}
+
+// The action taken when a threshold is exceeded.
+type TrafficPolicySpec_Policy_Canary_Analysis_FailureAction int32
+
+const (
+	// Hold the current weight until the metrics are back within the thresholds.
+	TrafficPolicySpec_Policy_Canary_Analysis_PAUSE TrafficPolicySpec_Policy_Canary_Analysis_FailureAction = 0
+	// Route all traffic away from the canary subset. The canary is only restarted when the TrafficPolicy is modified.
+	TrafficPolicySpec_Policy_Canary_Analysis_ROLLBACK TrafficPolicySpec_Policy_Canary_Analysis_FailureAction = 1
+)
+
+// Enum value maps for TrafficPolicySpec_Policy_Canary_Analysis_FailureAction.
+var (
+	TrafficPolicySpec_Policy_Canary_Analysis_FailureAction_name = map[int32]string{
+		0: "PAUSE",
+		1: "ROLLBACK",
+	}
+	TrafficPolicySpec_Policy_Canary_Analysis_FailureAction_value = map[string]int32{
+		"PAUSE":    0,
+		"ROLLBACK": 1,
+	}
+)
+
+func (x TrafficPolicySpec_Policy_Canary_Analysis_FailureAction) Enum() *TrafficPolicySpec_Policy_Canary_Analysis_FailureAction {
+	p := new(TrafficPolicySpec_Policy_Canary_Analysis_FailureAction)
+	*p = x
+	return p
+}
+
+func (x TrafficPolicySpec_Policy_Canary_Analysis_FailureAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrafficPolicySpec_Policy_Canary_Analysis_FailureAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[1].Descriptor()
+}
+
+func (TrafficPolicySpec_Policy_Canary_Analysis_FailureAction) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[1]
+}
+
+func (x TrafficPolicySpec_Policy_Canary_Analysis_FailureAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_Canary_Analysis_FailureAction.Descriptor instead.
+func (TrafficPolicySpec_Policy_Canary_Analysis_FailureAction) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 2, 4, 0}
+}
+
+// Standard load balancing algorithms.
+type TrafficPolicySpec_Policy_LoadBalancer_SimpleLB int32
+
+const (
+	// Select endpoints in round robin order.
+	TrafficPolicySpec_Policy_LoadBalancer_ROUND_ROBIN TrafficPolicySpec_Policy_LoadBalancer_SimpleLB = 0
+	// Select the endpoint with the fewest active requests out of two randomly selected endpoints.
+	TrafficPolicySpec_Policy_LoadBalancer_LEAST_REQUEST TrafficPolicySpec_Policy_LoadBalancer_SimpleLB = 1
+	// Select an endpoint at random.
+	TrafficPolicySpec_Policy_LoadBalancer_RANDOM TrafficPolicySpec_Policy_LoadBalancer_SimpleLB = 2
+	// Forward requests to the original destination address requested by the caller, without load balancing.
+	TrafficPolicySpec_Policy_LoadBalancer_PASSTHROUGH TrafficPolicySpec_Policy_LoadBalancer_SimpleLB = 3
+)
+
+// Enum value maps for TrafficPolicySpec_Policy_LoadBalancer_SimpleLB.
+var (
+	TrafficPolicySpec_Policy_LoadBalancer_SimpleLB_name = map[int32]string{
+		0: "ROUND_ROBIN",
+		1: "LEAST_REQUEST",
+		2: "RANDOM",
+		3: "PASSTHROUGH",
+	}
+	TrafficPolicySpec_Policy_LoadBalancer_SimpleLB_value = map[string]int32{
+		"ROUND_ROBIN":   0,
+		"LEAST_REQUEST": 1,
+		"RANDOM":        2,
+		"PASSTHROUGH":   3,
+	}
+)
+
+func (x TrafficPolicySpec_Policy_LoadBalancer_SimpleLB) Enum() *TrafficPolicySpec_Policy_LoadBalancer_SimpleLB {
+	p := new(TrafficPolicySpec_Policy_LoadBalancer_SimpleLB)
+	*p = x
+	return p
+}
+
+func (x TrafficPolicySpec_Policy_LoadBalancer_SimpleLB) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrafficPolicySpec_Policy_LoadBalancer_SimpleLB) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[2].Descriptor()
+}
+
+func (TrafficPolicySpec_Policy_LoadBalancer_SimpleLB) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[2]
+}
+
+func (x TrafficPolicySpec_Policy_LoadBalancer_SimpleLB) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_LoadBalancer_SimpleLB.Descriptor instead.
+func (TrafficPolicySpec_Policy_LoadBalancer_SimpleLB) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 7, 0}
+}
+
 // TLS connection mode. Enums correspond to those
 // [defined here](https://github.com/istio/api/blob/00636152b9d9254b614828a65723840282a177d3/networking/v1beta1/destination_rule.proto#L886)
 type TrafficPolicySpec_Policy_MTLS_Istio_TLSmode int32
@@ -76,11 +236,11 @@ func (x TrafficPolicySpec_Policy_MTLS_Istio_TLSmode) String() string {
 }
 
 func (TrafficPolicySpec_Policy_MTLS_Istio_TLSmode) Descriptor() protoreflect.EnumDescriptor {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[0].Descriptor()
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[3].Descriptor()
 }
 
 func (TrafficPolicySpec_Policy_MTLS_Istio_TLSmode) Type() protoreflect.EnumType {
-	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[0]
+	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[3]
 }
 
 func (x TrafficPolicySpec_Policy_MTLS_Istio_TLSmode) Number() protoreflect.EnumNumber {
@@ -89,7 +249,64 @@ func (x TrafficPolicySpec_Policy_MTLS_Istio_TLSmode) Number() protoreflect.EnumN
 
 // Deprecated: Use TrafficPolicySpec_Policy_MTLS_Istio_TLSmode.Descriptor instead.
 func (TrafficPolicySpec_Policy_MTLS_Istio_TLSmode) EnumDescriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 6, 0, 0}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 9, 0, 0}
+}
+
+// The phase of a canary.
+type TrafficPolicyStatus_CanaryStatus_Phase int32
+
+const (
+	// The canary is stepping through its weights.
+	TrafficPolicyStatus_CanaryStatus_PROGRESSING TrafficPolicyStatus_CanaryStatus_Phase = 0
+	// The canary is holding its current weight because a threshold was exceeded, or not enough requests were received.
+	TrafficPolicyStatus_CanaryStatus_PAUSED TrafficPolicyStatus_CanaryStatus_Phase = 1
+	// All traffic was routed away from the canary because a threshold was exceeded.
+	TrafficPolicyStatus_CanaryStatus_ROLLED_BACK TrafficPolicyStatus_CanaryStatus_Phase = 2
+	// The canary completed its steps and receives all traffic.
+	TrafficPolicyStatus_CanaryStatus_PROMOTED TrafficPolicyStatus_CanaryStatus_Phase = 3
+)
+
+// Enum value maps for TrafficPolicyStatus_CanaryStatus_Phase.
+var (
+	TrafficPolicyStatus_CanaryStatus_Phase_name = map[int32]string{
+		0: "PROGRESSING",
+		1: "PAUSED",
+		2: "ROLLED_BACK",
+		3: "PROMOTED",
+	}
+	TrafficPolicyStatus_CanaryStatus_Phase_value = map[string]int32{
+		"PROGRESSING": 0,
+		"PAUSED":      1,
+		"ROLLED_BACK": 2,
+		"PROMOTED":    3,
+	}
+)
+
+func (x TrafficPolicyStatus_CanaryStatus_Phase) Enum() *TrafficPolicyStatus_CanaryStatus_Phase {
+	p := new(TrafficPolicyStatus_CanaryStatus_Phase)
+	*p = x
+	return p
+}
+
+func (x TrafficPolicyStatus_CanaryStatus_Phase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrafficPolicyStatus_CanaryStatus_Phase) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[4].Descriptor()
+}
+
+func (TrafficPolicyStatus_CanaryStatus_Phase) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[4]
+}
+
+func (x TrafficPolicyStatus_CanaryStatus_Phase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrafficPolicyStatus_CanaryStatus_Phase.Descriptor instead.
+func (TrafficPolicyStatus_CanaryStatus_Phase) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{1, 1, 0}
 }
 
 // Applies L7 routing and post-routing configuration on selected network edges.
@@ -102,15 +319,42 @@ type TrafficPolicySpec struct {
 	// Omit to apply to all Workloads.
 	SourceSelector []*v1.WorkloadSelector `protobuf:"bytes,1,rep,name=source_selector,json=sourceSelector,proto3" json:"source_selector,omitempty"`
 	// Specify the Destinations (destinations) this TrafficPolicy applies to.
-	// Omit to apply to all Destinations.
+	// Omit to apply to all Destinations other than ExternalServices,
+	// which are only selected by `externalServiceRefs`.
 	DestinationSelector []*v1.DestinationSelector `protobuf:"bytes,2,rep,name=destination_selector,json=destinationSelector,proto3" json:"destination_selector,omitempty"`
 	// Specify criteria that HTTP requests must satisfy for the TrafficPolicy to apply.
 	// Conditions defined within a single matcher are conjunctive, i.e. all conditions must be satisfied for a match to occur.
 	// Conditions defined between different matchers are disjunctive, i.e. at least one matcher must be satisfied for the TrafficPolicy to apply.
 	// Omit to apply to any HTTP request.
 	HttpRequestMatchers []*HttpMatcher `protobuf:"bytes,3,rep,name=http_request_matchers,json=httpRequestMatchers,proto3" json:"http_request_matchers,omitempty"`
+	// Specify criteria that TCP connections must satisfy for the TrafficPolicy to apply to them.
+	// Matchers are disjunctive, i.e. at least one matcher must be satisfied for the TrafficPolicy to apply.
+	// Only `traffic_shift` and the policies that apply to the Destination as a whole, such as `outlier_detection`,
+	// `load_balancer`, `connection_pool` and `mtls`, take effect for TCP connections.
+	// If TCP or TLS matchers are specified without `http_request_matchers`, the TrafficPolicy does not apply to HTTP requests.
+	TcpRequestMatchers []*TcpMatcher `protobuf:"bytes,5,rep,name=tcp_request_matchers,json=tcpRequestMatchers,proto3" json:"tcp_request_matchers,omitempty"`
+	// Specify criteria that TLS connections not terminated by the mesh must satisfy for the TrafficPolicy to apply to them.
+	// Matchers are disjunctive, i.e. at least one matcher must be satisfied for the TrafficPolicy to apply.
+	// The same policies take effect as for `tcp_request_matchers`.
+	TlsRequestMatchers []*TlsMatcher `protobuf:"bytes,6,rep,name=tls_request_matchers,json=tlsRequestMatchers,proto3" json:"tls_request_matchers,omitempty"`
 	// Specify L7 routing and post-routing configuration.
 	Policy *TrafficPolicySpec_Policy `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+	//
+	//Specify the priority of the TrafficPolicy relative to other TrafficPolicies which apply to the same Destination. Defaults to 0.
+	//
+	//TrafficPolicies are applied in order of increasing priority, such that a field set by a TrafficPolicy is merged over the same field
+	//set by TrafficPolicies of lower priority which apply to the same traffic, according to the merge strategy of the field:
+	//
+	//- `header_manipulation` is merged with the APPEND strategy, i.e. the headers manipulated by each TrafficPolicy are combined,
+	//and the values of headers manipulated by multiple TrafficPolicies are those of the TrafficPolicy with the highest priority.
+	//- `mtls`, `csrf`, `rate_limit` and `jwt` are merged with the REJECT strategy, i.e. they cannot be overridden, and a TrafficPolicy
+	//which sets a different value than a TrafficPolicy of lower priority is rejected.
+	//- All other fields are merged with the OVERRIDE strategy, i.e. the value of the TrafficPolicy with the highest priority is used.
+	//
+	//TrafficPolicies of equal priority which set the same field to different values conflict, in which case the most recently accepted
+	//TrafficPolicy is rejected for the Destination.
+	//The fields which are merged for each TrafficPolicy are reported on the status of the Destination.
+	Priority int32 `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (x *TrafficPolicySpec) Reset() {
@@ -166,6 +410,20 @@ func (x *TrafficPolicySpec) GetHttpRequestMatchers() []*HttpMatcher {
 	return nil
 }
 
+func (x *TrafficPolicySpec) GetTcpRequestMatchers() []*TcpMatcher {
+	if x != nil {
+		return x.TcpRequestMatchers
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec) GetTlsRequestMatchers() []*TlsMatcher {
+	if x != nil {
+		return x.TlsRequestMatchers
+	}
+	return nil
+}
+
 func (x *TrafficPolicySpec) GetPolicy() *TrafficPolicySpec_Policy {
 	if x != nil {
 		return x.Policy
@@ -173,6 +431,13 @@ func (x *TrafficPolicySpec) GetPolicy() *TrafficPolicySpec_Policy {
 	return nil
 }
 
+func (x *TrafficPolicySpec) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 type TrafficPolicyStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -192,6 +457,8 @@ type TrafficPolicyStatus struct {
 	Workloads []string `protobuf:"bytes,4,rep,name=workloads,proto3" json:"workloads,omitempty"`
 	// Any errors found while processing this generation of the resource.
 	Errors []string `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	// The progress of the canary, if the TrafficPolicy specifies one.
+	Canary *TrafficPolicyStatus_CanaryStatus `protobuf:"bytes,6,opt,name=canary,proto3" json:"canary,omitempty"`
 }
 
 func (x *TrafficPolicyStatus) Reset() {
@@ -261,6 +528,13 @@ func (x *TrafficPolicyStatus) GetErrors() []string {
 	return nil
 }
 
+func (x *TrafficPolicyStatus) GetCanary() *TrafficPolicyStatus_CanaryStatus {
+	if x != nil {
+		return x.Canary
+	}
+	return nil
+}
+
 // Specify L7 routing and post-routing configuration.
 type TrafficPolicySpec_Policy struct {
 	state         protoimpl.MessageState
@@ -291,6 +565,21 @@ type TrafficPolicySpec_Policy struct {
 	Csrf *csrf.CsrfPolicy `protobuf:"bytes,13,opt,name=csrf,proto3" json:"csrf,omitempty"`
 	// Config the Envoy based Ratelimit filter
 	RateLimit *ratelimit.RouteRateLimit `protobuf:"bytes,14,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// Configure the load balancing algorithm used to select an endpoint of the selected destinations.
+	// Specifying this field requires an empty `source_selector` because it must apply to all traffic.
+	LoadBalancer *TrafficPolicySpec_Policy_LoadBalancer `protobuf:"bytes,15,opt,name=load_balancer,json=loadBalancer,proto3" json:"load_balancer,omitempty"`
+	// Configure limits on the connections and requests to the selected destinations.
+	// Specifying this field requires an empty `source_selector` because it must apply to all traffic.
+	ConnectionPool *TrafficPolicySpec_Policy_ConnectionPool `protobuf:"bytes,16,opt,name=connection_pool,json=connectionPool,proto3" json:"connection_pool,omitempty"`
+	// Require end-user JWTs on requests to the selected destinations.
+	// Specifying this field requires empty `source_selector` and `http_request_matchers` because it must apply to all traffic.
+	// The claims of validated JWTs can be matched by the conditions of AccessPolicies.
+	Jwt *jwt.JwtPolicy `protobuf:"bytes,17,opt,name=jwt,proto3" json:"jwt,omitempty"`
+	// Progressively shift traffic to a canary subset of the selected destinations.
+	// The weight of the canary subset is stepped on a schedule, and the resulting traffic shift is applied as if set by `traffic_shift`,
+	// which cannot be combined with this field. The progress of the canary is reported on the status of the TrafficPolicy.
+	// Only supported for Kubernetes service destinations with a single port in Istio meshes.
+	Canary *TrafficPolicySpec_Policy_Canary `protobuf:"bytes,18,opt,name=canary,proto3" json:"canary,omitempty"`
 }
 
 func (x *TrafficPolicySpec_Policy) Reset() {
@@ -402,6 +691,34 @@ func (x *TrafficPolicySpec_Policy) GetRateLimit() *ratelimit.RouteRateLimit {
 	return nil
 }
 
+func (x *TrafficPolicySpec_Policy) GetLoadBalancer() *TrafficPolicySpec_Policy_LoadBalancer {
+	if x != nil {
+		return x.LoadBalancer
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy) GetConnectionPool() *TrafficPolicySpec_Policy_ConnectionPool {
+	if x != nil {
+		return x.ConnectionPool
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy) GetJwt() *jwt.JwtPolicy {
+	if x != nil {
+		return x.Jwt
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy) GetCanary() *TrafficPolicySpec_Policy_Canary {
+	if x != nil {
+		return x.Canary
+	}
+	return nil
+}
+
 // Specify retries for failed requests.
 type TrafficPolicySpec_Policy_RetryPolicy struct {
 	state         protoimpl.MessageState
@@ -412,6 +729,19 @@ type TrafficPolicySpec_Policy_RetryPolicy struct {
 	Attempts int32 `protobuf:"varint,1,opt,name=attempts,proto3" json:"attempts,omitempty"`
 	// Timeout per retry attempt for a given request. Format: `1h`/`1m`/`1s`/`1ms`. *Must be >= 1ms*.
 	PerTryTimeout *duration.Duration `protobuf:"bytes,2,opt,name=per_try_timeout,json=perTryTimeout,proto3" json:"per_try_timeout,omitempty"`
+	// The conditions under which a request is retried. Defaults to the mesh's default retry conditions if not set.
+	// Valid conditions are the [Envoy HTTP retry conditions](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/router_filter#x-envoy-retry-on)
+	// (e.g. `5xx`, `gateway-error`, `reset`, `connect-failure`, `retriable-4xx`, `refused-stream`)
+	// and the [Envoy gRPC retry conditions](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/router_filter#x-envoy-retry-grpc-on)
+	// (e.g. `cancelled`, `deadline-exceeded`, `internal`, `resource-exhausted`, `unavailable`).
+	RetryOn []string `protobuf:"bytes,3,rep,name=retry_on,json=retryOn,proto3" json:"retry_on,omitempty"`
+	// HTTP status codes for which a request is retried, in addition to the `retry_on` conditions.
+	RetriableStatusCodes []uint32 `protobuf:"varint,4,rep,packed,name=retriable_status_codes,json=retriableStatusCodes,proto3" json:"retriable_status_codes,omitempty"`
+	// If true, retries may be sent to endpoints in other localities than the endpoint of the original request.
+	RetryRemoteLocalities *wrappers.BoolValue `protobuf:"bytes,5,opt,name=retry_remote_localities,json=retryRemoteLocalities,proto3" json:"retry_remote_localities,omitempty"`
+	// gRPC status codes for which a request is retried, in addition to the `retry_on` conditions.
+	// Only `CANCELLED`, `DEADLINE_EXCEEDED`, `INTERNAL`, `RESOURCE_EXHAUSTED` and `UNAVAILABLE` are retriable.
+	RetriableGrpcStatusCodes []GrpcStatusCode `protobuf:"varint,6,rep,packed,name=retriable_grpc_status_codes,json=retriableGrpcStatusCodes,proto3,enum=networking.mesh.gloo.solo.io.GrpcStatusCode" json:"retriable_grpc_status_codes,omitempty"`
 }
 
 func (x *TrafficPolicySpec_Policy_RetryPolicy) Reset() {
@@ -460,6 +790,34 @@ func (x *TrafficPolicySpec_Policy_RetryPolicy) GetPerTryTimeout() *duration.Dura
 	return nil
 }
 
+func (x *TrafficPolicySpec_Policy_RetryPolicy) GetRetryOn() []string {
+	if x != nil {
+		return x.RetryOn
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_RetryPolicy) GetRetriableStatusCodes() []uint32 {
+	if x != nil {
+		return x.RetriableStatusCodes
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_RetryPolicy) GetRetryRemoteLocalities() *wrappers.BoolValue {
+	if x != nil {
+		return x.RetryRemoteLocalities
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_RetryPolicy) GetRetriableGrpcStatusCodes() []GrpcStatusCode {
+	if x != nil {
+		return x.RetriableGrpcStatusCodes
+	}
+	return nil
+}
+
 // Specify a traffic shift destination.
 type TrafficPolicySpec_Policy_MultiDestination struct {
 	state         protoimpl.MessageState
@@ -509,6 +867,105 @@ func (x *TrafficPolicySpec_Policy_MultiDestination) GetDestinations() []*Weighte
 	return nil
 }
 
+// Progressively shift traffic from the selected destinations to their canary subset.
+type TrafficPolicySpec_Policy_Canary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The labels of the canary subset of the selected destinations, e.g. `version: v2`.
+	CanarySubset map[string]string `protobuf:"bytes,1,rep,name=canary_subset,json=canarySubset,proto3" json:"canary_subset,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The labels of the stable subset of the selected destinations, e.g. `version: v1`.
+	// If unset, the traffic which is not shifted to the canary is routed to the destinations without restricting the subset,
+	// i.e. to both the stable and the canary workloads.
+	StableSubset map[string]string `protobuf:"bytes,2,rep,name=stable_subset,json=stableSubset,proto3" json:"stable_subset,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Requests with any of these headers are routed to the canary subset regardless of the current weight,
+	// which allows a cohort of clients to be sent to the canary.
+	CohortHeaders []*HeaderMatcher `protobuf:"bytes,3,rep,name=cohort_headers,json=cohortHeaders,proto3" json:"cohort_headers,omitempty"`
+	// Requests with any of these cookies are routed to the canary subset regardless of the current weight.
+	CohortCookies []*TrafficPolicySpec_Policy_Canary_CookieMatcher `protobuf:"bytes,4,rep,name=cohort_cookies,json=cohortCookies,proto3" json:"cohort_cookies,omitempty"`
+	// Required. The weights through which the canary progresses, in order.
+	// Once the duration of the last step has elapsed, the canary is promoted, i.e. receives all traffic.
+	Steps []*TrafficPolicySpec_Policy_Canary_Step `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	// If set, the canary is analyzed against the request metrics of the canary workloads, read from the
+	// Prometheus server configured in the Settings. The canary only progresses while the metrics are within the thresholds.
+	Analysis *TrafficPolicySpec_Policy_Canary_Analysis `protobuf:"bytes,6,opt,name=analysis,proto3" json:"analysis,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_Canary) Reset() {
+	*x = TrafficPolicySpec_Policy_Canary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_Canary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_Canary) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_Canary) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_Canary.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_Canary) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 2}
+}
+
+func (x *TrafficPolicySpec_Policy_Canary) GetCanarySubset() map[string]string {
+	if x != nil {
+		return x.CanarySubset
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_Canary) GetStableSubset() map[string]string {
+	if x != nil {
+		return x.StableSubset
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_Canary) GetCohortHeaders() []*HeaderMatcher {
+	if x != nil {
+		return x.CohortHeaders
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_Canary) GetCohortCookies() []*TrafficPolicySpec_Policy_Canary_CookieMatcher {
+	if x != nil {
+		return x.CohortCookies
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_Canary) GetSteps() []*TrafficPolicySpec_Policy_Canary_Step {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_Canary) GetAnalysis() *TrafficPolicySpec_Policy_Canary_Analysis {
+	if x != nil {
+		return x.Analysis
+	}
+	return nil
+}
+
 // Specify one or more faults to inject for the selected network edge.
 type TrafficPolicySpec_Policy_FaultInjection struct {
 	state         protoimpl.MessageState
@@ -528,7 +985,7 @@ type TrafficPolicySpec_Policy_FaultInjection struct {
 func (x *TrafficPolicySpec_Policy_FaultInjection) Reset() {
 	*x = TrafficPolicySpec_Policy_FaultInjection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[5]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -541,7 +998,7 @@ func (x *TrafficPolicySpec_Policy_FaultInjection) String() string {
 func (*TrafficPolicySpec_Policy_FaultInjection) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_FaultInjection) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[5]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -554,7 +1011,7 @@ func (x *TrafficPolicySpec_Policy_FaultInjection) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use TrafficPolicySpec_Policy_FaultInjection.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_FaultInjection) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 2}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 3}
 }
 
 func (m *TrafficPolicySpec_Policy_FaultInjection) GetFaultInjectionType() isTrafficPolicySpec_Policy_FaultInjection_FaultInjectionType {
@@ -635,7 +1092,7 @@ type TrafficPolicySpec_Policy_CorsPolicy struct {
 func (x *TrafficPolicySpec_Policy_CorsPolicy) Reset() {
 	*x = TrafficPolicySpec_Policy_CorsPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[6]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -648,7 +1105,7 @@ func (x *TrafficPolicySpec_Policy_CorsPolicy) String() string {
 func (*TrafficPolicySpec_Policy_CorsPolicy) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_CorsPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[6]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -661,7 +1118,7 @@ func (x *TrafficPolicySpec_Policy_CorsPolicy) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use TrafficPolicySpec_Policy_CorsPolicy.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_CorsPolicy) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 3}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 4}
 }
 
 func (x *TrafficPolicySpec_Policy_CorsPolicy) GetAllowOrigins() []*v1.StringMatch {
@@ -726,7 +1183,7 @@ type TrafficPolicySpec_Policy_Mirror struct {
 func (x *TrafficPolicySpec_Policy_Mirror) Reset() {
 	*x = TrafficPolicySpec_Policy_Mirror{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[7]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -739,7 +1196,7 @@ func (x *TrafficPolicySpec_Policy_Mirror) String() string {
 func (*TrafficPolicySpec_Policy_Mirror) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_Mirror) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[7]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -752,7 +1209,7 @@ func (x *TrafficPolicySpec_Policy_Mirror) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrafficPolicySpec_Policy_Mirror.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_Mirror) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 4}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 5}
 }
 
 func (m *TrafficPolicySpec_Policy_Mirror) GetDestinationType() isTrafficPolicySpec_Policy_Mirror_DestinationType {
@@ -816,7 +1273,7 @@ type TrafficPolicySpec_Policy_OutlierDetection struct {
 func (x *TrafficPolicySpec_Policy_OutlierDetection) Reset() {
 	*x = TrafficPolicySpec_Policy_OutlierDetection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[8]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -829,7 +1286,7 @@ func (x *TrafficPolicySpec_Policy_OutlierDetection) String() string {
 func (*TrafficPolicySpec_Policy_OutlierDetection) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_OutlierDetection) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[8]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -842,7 +1299,7 @@ func (x *TrafficPolicySpec_Policy_OutlierDetection) ProtoReflect() protoreflect.
 
 // Deprecated: Use TrafficPolicySpec_Policy_OutlierDetection.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_OutlierDetection) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 5}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 6}
 }
 
 func (x *TrafficPolicySpec_Policy_OutlierDetection) GetConsecutiveErrors() uint32 {
@@ -873,20 +1330,176 @@ func (x *TrafficPolicySpec_Policy_OutlierDetection) GetMaxEjectionPercent() uint
 	return 0
 }
 
-// Configure mTLS settings on destinations. If specified this overrides the global default defined in Settings.
-type TrafficPolicySpec_Policy_MTLS struct {
+// Configure the load balancing algorithm used to select an endpoint of the selected destinations.
+type TrafficPolicySpec_Policy_LoadBalancer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Istio TLS settings.
-	Istio *TrafficPolicySpec_Policy_MTLS_Istio `protobuf:"bytes,1,opt,name=istio,proto3" json:"istio,omitempty"`
+	// The load balancing algorithm. Defaults to round robin if not set.
+	//
+	// Types that are assignable to LbPolicy:
+	//	*TrafficPolicySpec_Policy_LoadBalancer_Simple
+	//	*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_
+	LbPolicy isTrafficPolicySpec_Policy_LoadBalancer_LbPolicy `protobuf_oneof:"lb_policy"`
+	// Prefer endpoints in the same locality as the client, and configure how requests fail over or are distributed across localities.
+	// Overrides the locality load balancing settings of the VirtualMesh federating the selected destinations.
+	Locality *v1.LocalityLoadBalancing `protobuf:"bytes,3,opt,name=locality,proto3" json:"locality,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer) Reset() {
+	*x = TrafficPolicySpec_Policy_LoadBalancer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancer) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_LoadBalancer.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_LoadBalancer) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 7}
+}
+
+func (m *TrafficPolicySpec_Policy_LoadBalancer) GetLbPolicy() isTrafficPolicySpec_Policy_LoadBalancer_LbPolicy {
+	if m != nil {
+		return m.LbPolicy
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer) GetSimple() TrafficPolicySpec_Policy_LoadBalancer_SimpleLB {
+	if x, ok := x.GetLbPolicy().(*TrafficPolicySpec_Policy_LoadBalancer_Simple); ok {
+		return x.Simple
+	}
+	return TrafficPolicySpec_Policy_LoadBalancer_ROUND_ROBIN
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer) GetConsistentHash() *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash {
+	if x, ok := x.GetLbPolicy().(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_); ok {
+		return x.ConsistentHash
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer) GetLocality() *v1.LocalityLoadBalancing {
+	if x != nil {
+		return x.Locality
+	}
+	return nil
+}
+
+type isTrafficPolicySpec_Policy_LoadBalancer_LbPolicy interface {
+	isTrafficPolicySpec_Policy_LoadBalancer_LbPolicy()
+}
+
+type TrafficPolicySpec_Policy_LoadBalancer_Simple struct {
+	// Use a standard load balancing algorithm.
+	Simple TrafficPolicySpec_Policy_LoadBalancer_SimpleLB `protobuf:"varint,1,opt,name=simple,proto3,enum=networking.mesh.gloo.solo.io.TrafficPolicySpec_Policy_LoadBalancer_SimpleLB,oneof"`
+}
+
+type TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_ struct {
+	// Use consistent hashing to provide soft session affinity, based on HTTP headers, cookies or the source IP.
+	ConsistentHash *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash `protobuf:"bytes,2,opt,name=consistent_hash,json=consistentHash,proto3,oneof"`
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancer_Simple) isTrafficPolicySpec_Policy_LoadBalancer_LbPolicy() {
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_) isTrafficPolicySpec_Policy_LoadBalancer_LbPolicy() {
+}
+
+// Configure limits on the connections and requests to the selected destinations.
+// Limits apply to each sidecar sending requests to the destination.
+type TrafficPolicySpec_Policy_ConnectionPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Settings common to HTTP and TCP connections.
+	Tcp *TrafficPolicySpec_Policy_ConnectionPool_Tcp `protobuf:"bytes,1,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	// Settings applicable to HTTP/1.1 and HTTP/2 connections.
+	Http *TrafficPolicySpec_Policy_ConnectionPool_Http `protobuf:"bytes,2,opt,name=http,proto3" json:"http,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool) Reset() {
+	*x = TrafficPolicySpec_Policy_ConnectionPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_ConnectionPool) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_ConnectionPool.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_ConnectionPool) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 8}
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool) GetTcp() *TrafficPolicySpec_Policy_ConnectionPool_Tcp {
+	if x != nil {
+		return x.Tcp
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool) GetHttp() *TrafficPolicySpec_Policy_ConnectionPool_Http {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+// Configure mTLS settings on destinations. If specified this overrides the global default defined in Settings.
+type TrafficPolicySpec_Policy_MTLS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Istio TLS settings.
+	Istio *TrafficPolicySpec_Policy_MTLS_Istio `protobuf:"bytes,1,opt,name=istio,proto3" json:"istio,omitempty"`
 }
 
 func (x *TrafficPolicySpec_Policy_MTLS) Reset() {
 	*x = TrafficPolicySpec_Policy_MTLS{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[9]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -899,7 +1512,7 @@ func (x *TrafficPolicySpec_Policy_MTLS) String() string {
 func (*TrafficPolicySpec_Policy_MTLS) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_MTLS) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[9]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,7 +1525,7 @@ func (x *TrafficPolicySpec_Policy_MTLS) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrafficPolicySpec_Policy_MTLS.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_MTLS) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 6}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 9}
 }
 
 func (x *TrafficPolicySpec_Policy_MTLS) GetIstio() *TrafficPolicySpec_Policy_MTLS_Istio {
@@ -935,7 +1548,7 @@ type TrafficPolicySpec_Policy_Transform struct {
 func (x *TrafficPolicySpec_Policy_Transform) Reset() {
 	*x = TrafficPolicySpec_Policy_Transform{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[10]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -948,7 +1561,7 @@ func (x *TrafficPolicySpec_Policy_Transform) String() string {
 func (*TrafficPolicySpec_Policy_Transform) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_Transform) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[10]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,7 +1574,7 @@ func (x *TrafficPolicySpec_Policy_Transform) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TrafficPolicySpec_Policy_Transform.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_Transform) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 7}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 10}
 }
 
 func (x *TrafficPolicySpec_Policy_Transform) GetTodo() string {
@@ -984,7 +1597,7 @@ type TrafficPolicySpec_Policy_DLPPolicy struct {
 func (x *TrafficPolicySpec_Policy_DLPPolicy) Reset() {
 	*x = TrafficPolicySpec_Policy_DLPPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[11]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -997,7 +1610,7 @@ func (x *TrafficPolicySpec_Policy_DLPPolicy) String() string {
 func (*TrafficPolicySpec_Policy_DLPPolicy) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_DLPPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[11]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,7 +1623,7 @@ func (x *TrafficPolicySpec_Policy_DLPPolicy) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TrafficPolicySpec_Policy_DLPPolicy.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_DLPPolicy) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 8}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 11}
 }
 
 func (x *TrafficPolicySpec_Policy_DLPPolicy) GetTodo() string {
@@ -1033,7 +1646,7 @@ type TrafficPolicySpec_Policy_ExtAuth struct {
 func (x *TrafficPolicySpec_Policy_ExtAuth) Reset() {
 	*x = TrafficPolicySpec_Policy_ExtAuth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[12]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1046,7 +1659,7 @@ func (x *TrafficPolicySpec_Policy_ExtAuth) String() string {
 func (*TrafficPolicySpec_Policy_ExtAuth) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_ExtAuth) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[12]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1059,7 +1672,7 @@ func (x *TrafficPolicySpec_Policy_ExtAuth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrafficPolicySpec_Policy_ExtAuth.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_ExtAuth) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 9}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 12}
 }
 
 func (x *TrafficPolicySpec_Policy_ExtAuth) GetTodo() string {
@@ -1069,20 +1682,226 @@ func (x *TrafficPolicySpec_Policy_ExtAuth) GetTodo() string {
 	return ""
 }
 
+// Match requests by the value of a cookie.
+type TrafficPolicySpec_Policy_Canary_CookieMatcher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the cookie.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The exact value of the cookie. If empty, requests with the cookie match regardless of its value.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_CookieMatcher) Reset() {
+	*x = TrafficPolicySpec_Policy_Canary_CookieMatcher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_CookieMatcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_Canary_CookieMatcher) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_Canary_CookieMatcher) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_Canary_CookieMatcher.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_Canary_CookieMatcher) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 2, 2}
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_CookieMatcher) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_CookieMatcher) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// A step of the canary progression.
+type TrafficPolicySpec_Policy_Canary_Step struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The percentage of traffic routed to the canary subset, between 0 and 100.
+	Weight uint32 `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	// How long to hold the weight before moving to the next step. Format: `1h`/`1m`/`1s`. Must be >= 1s.
+	Duration *duration.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_Step) Reset() {
+	*x = TrafficPolicySpec_Policy_Canary_Step{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_Step) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_Canary_Step) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_Canary_Step) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_Canary_Step.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_Canary_Step) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 2, 3}
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_Step) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_Step) GetDuration() *duration.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// Thresholds on the metrics of the canary subset.
+type TrafficPolicySpec_Policy_Canary_Analysis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum percentage of requests to the canary subset which may fail with a 5xx response code, between 0 and 100.
+	// Unset or 0 to not analyze the error rate.
+	MaxErrorRate float64 `protobuf:"fixed64,1,opt,name=max_error_rate,json=maxErrorRate,proto3" json:"max_error_rate,omitempty"`
+	// The maximum 99th percentile latency of requests to the canary subset. Unset to not analyze the latency.
+	MaxLatency *duration.Duration `protobuf:"bytes,2,opt,name=max_latency,json=maxLatency,proto3" json:"max_latency,omitempty"`
+	// The window over which the metrics are evaluated, which is also how often the canary is analyzed. Defaults to 1m.
+	Interval *duration.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// The minimum number of requests to the canary subset within the interval for the thresholds to be evaluated.
+	// The canary does not progress until enough requests are received.
+	MinRequestCount uint32 `protobuf:"varint,4,opt,name=min_request_count,json=minRequestCount,proto3" json:"min_request_count,omitempty"`
+	// The action taken when a threshold is exceeded.
+	FailureAction TrafficPolicySpec_Policy_Canary_Analysis_FailureAction `protobuf:"varint,5,opt,name=failure_action,json=failureAction,proto3,enum=networking.mesh.gloo.solo.io.TrafficPolicySpec_Policy_Canary_Analysis_FailureAction" json:"failure_action,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_Analysis) Reset() {
+	*x = TrafficPolicySpec_Policy_Canary_Analysis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_Analysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_Canary_Analysis) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_Canary_Analysis) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_Canary_Analysis.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_Canary_Analysis) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 2, 4}
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_Analysis) GetMaxErrorRate() float64 {
+	if x != nil {
+		return x.MaxErrorRate
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_Analysis) GetMaxLatency() *duration.Duration {
+	if x != nil {
+		return x.MaxLatency
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_Analysis) GetInterval() *duration.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_Analysis) GetMinRequestCount() uint32 {
+	if x != nil {
+		return x.MinRequestCount
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_Canary_Analysis) GetFailureAction() TrafficPolicySpec_Policy_Canary_Analysis_FailureAction {
+	if x != nil {
+		return x.FailureAction
+	}
+	return TrafficPolicySpec_Policy_Canary_Analysis_PAUSE
+}
+
 // Abort the request and return the specified error code back to traffic source.
 type TrafficPolicySpec_Policy_FaultInjection_Abort struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Required. HTTP status code to use to abort the request.
+	// HTTP status code to use to abort the request. Required unless `grpc_status` is specified.
 	HttpStatus int32 `protobuf:"varint,1,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
+	// gRPC status code to use to abort gRPC requests. Cannot be combined with `http_status`.
+	// `OK` is not a valid status with which to abort a request, and is treated as unset.
+	GrpcStatus GrpcStatusCode `protobuf:"varint,2,opt,name=grpc_status,json=grpcStatus,proto3,enum=networking.mesh.gloo.solo.io.GrpcStatusCode" json:"grpc_status,omitempty"`
 }
 
 func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) Reset() {
 	*x = TrafficPolicySpec_Policy_FaultInjection_Abort{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[13]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1095,7 +1914,7 @@ func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) String() string {
 func (*TrafficPolicySpec_Policy_FaultInjection_Abort) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[13]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1108,7 +1927,7 @@ func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) ProtoReflect() protorefl
 
 // Deprecated: Use TrafficPolicySpec_Policy_FaultInjection_Abort.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_FaultInjection_Abort) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 2, 0}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 3, 0}
 }
 
 func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) GetHttpStatus() int32 {
@@ -1118,6 +1937,353 @@ func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) GetHttpStatus() int32 {
 	return 0
 }
 
+func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) GetGrpcStatus() GrpcStatusCode {
+	if x != nil {
+		return x.GrpcStatus
+	}
+	return GrpcStatusCode_OK
+}
+
+// Consistent hash based load balancing. Requests with the same hash key are sent to the same endpoint,
+// as long as the set of endpoints does not change.
+type TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The request property to hash. Required.
+	//
+	// Types that are assignable to HashKey:
+	//	*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpHeaderName
+	//	*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie_
+	//	*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_UseSourceIp
+	//	*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpQueryParameterName
+	HashKey isTrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HashKey `protobuf_oneof:"hash_key"`
+	// The minimum number of virtual nodes to use for the hash ring. A default will be used if not set.
+	MinimumRingSize uint64 `protobuf:"varint,5,opt,name=minimum_ring_size,json=minimumRingSize,proto3" json:"minimum_ring_size,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash) Reset() {
+	*x = TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 7, 0}
+}
+
+func (m *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash) GetHashKey() isTrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HashKey {
+	if m != nil {
+		return m.HashKey
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash) GetHttpHeaderName() string {
+	if x, ok := x.GetHashKey().(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpHeaderName); ok {
+		return x.HttpHeaderName
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash) GetHttpCookie() *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie {
+	if x, ok := x.GetHashKey().(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie_); ok {
+		return x.HttpCookie
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash) GetUseSourceIp() bool {
+	if x, ok := x.GetHashKey().(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_UseSourceIp); ok {
+		return x.UseSourceIp
+	}
+	return false
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash) GetHttpQueryParameterName() string {
+	if x, ok := x.GetHashKey().(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpQueryParameterName); ok {
+		return x.HttpQueryParameterName
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash) GetMinimumRingSize() uint64 {
+	if x != nil {
+		return x.MinimumRingSize
+	}
+	return 0
+}
+
+type isTrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HashKey interface {
+	isTrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HashKey()
+}
+
+type TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpHeaderName struct {
+	// Hash based on the value of the given HTTP header.
+	HttpHeaderName string `protobuf:"bytes,1,opt,name=http_header_name,json=httpHeaderName,proto3,oneof"`
+}
+
+type TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie_ struct {
+	// Hash based on the value of the given HTTP cookie. The cookie is generated if it does not exist.
+	HttpCookie *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie `protobuf:"bytes,2,opt,name=http_cookie,json=httpCookie,proto3,oneof"`
+}
+
+type TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_UseSourceIp struct {
+	// Hash based on the source IP address.
+	UseSourceIp bool `protobuf:"varint,3,opt,name=use_source_ip,json=useSourceIp,proto3,oneof"`
+}
+
+type TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpQueryParameterName struct {
+	// Hash based on the value of the given HTTP query parameter.
+	HttpQueryParameterName string `protobuf:"bytes,4,opt,name=http_query_parameter_name,json=httpQueryParameterName,proto3,oneof"`
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpHeaderName) isTrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HashKey() {
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie_) isTrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HashKey() {
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_UseSourceIp) isTrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HashKey() {
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpQueryParameterName) isTrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HashKey() {
+}
+
+// Describes an HTTP cookie used as the hash key.
+type TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the cookie. Required.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Path to set for the cookie.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Lifetime of the cookie. Required. Format: `1h`/`1m`/`1s`/`1ms`.
+	Ttl *duration.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie) Reset() {
+	*x = TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 7, 0, 0}
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie) GetTtl() *duration.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// Settings common to HTTP and TCP connections.
+type TrafficPolicySpec_Policy_ConnectionPool_Tcp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of connections to a destination. Unlimited if not set.
+	MaxConnections uint32 `protobuf:"varint,1,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	// TCP connection timeout. Format: `1h`/`1m`/`1s`/`1ms`. Must be >= `1ms`. A default will be used if not set.
+	ConnectTimeout *duration.Duration `protobuf:"bytes,2,opt,name=connect_timeout,json=connectTimeout,proto3" json:"connect_timeout,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_Tcp) Reset() {
+	*x = TrafficPolicySpec_Policy_ConnectionPool_Tcp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_Tcp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_ConnectionPool_Tcp) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_Tcp) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_ConnectionPool_Tcp.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_ConnectionPool_Tcp) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 8, 0}
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_Tcp) GetMaxConnections() uint32 {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_Tcp) GetConnectTimeout() *duration.Duration {
+	if x != nil {
+		return x.ConnectTimeout
+	}
+	return nil
+}
+
+// Settings applicable to HTTP/1.1 and HTTP/2 connections.
+type TrafficPolicySpec_Policy_ConnectionPool_Http struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of pending HTTP requests to a destination. Unlimited if not set.
+	Http1MaxPendingRequests uint32 `protobuf:"varint,1,opt,name=http1_max_pending_requests,json=http1MaxPendingRequests,proto3" json:"http1_max_pending_requests,omitempty"`
+	// Maximum number of requests to a destination. Unlimited if not set.
+	Http2MaxRequests uint32 `protobuf:"varint,2,opt,name=http2_max_requests,json=http2MaxRequests,proto3" json:"http2_max_requests,omitempty"`
+	// Maximum number of requests per connection to a destination. Unlimited if not set.
+	MaxRequestsPerConnection uint32 `protobuf:"varint,3,opt,name=max_requests_per_connection,json=maxRequestsPerConnection,proto3" json:"max_requests_per_connection,omitempty"`
+	// Maximum number of concurrent retries to all endpoints of a destination. Unlimited if not set.
+	MaxRetries uint32 `protobuf:"varint,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// The amount of time a connection may be idle before it is closed. Format: `1h`/`1m`/`1s`/`1ms`.
+	// Must be >= `1ms`. Connections are not closed due to inactivity if not set.
+	IdleTimeout *duration.Duration `protobuf:"bytes,5,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_Http) Reset() {
+	*x = TrafficPolicySpec_Policy_ConnectionPool_Http{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_Http) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_ConnectionPool_Http) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_Http) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_ConnectionPool_Http.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_ConnectionPool_Http) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 8, 1}
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_Http) GetHttp1MaxPendingRequests() uint32 {
+	if x != nil {
+		return x.Http1MaxPendingRequests
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_Http) GetHttp2MaxRequests() uint32 {
+	if x != nil {
+		return x.Http2MaxRequests
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_Http) GetMaxRequestsPerConnection() uint32 {
+	if x != nil {
+		return x.MaxRequestsPerConnection
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_Http) GetMaxRetries() uint32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_Http) GetIdleTimeout() *duration.Duration {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return nil
+}
+
 // Istio TLS settings.
 type TrafficPolicySpec_Policy_MTLS_Istio struct {
 	state         protoimpl.MessageState
@@ -1128,23 +2294,86 @@ type TrafficPolicySpec_Policy_MTLS_Istio struct {
 	TlsMode TrafficPolicySpec_Policy_MTLS_Istio_TLSmode `protobuf:"varint,1,opt,name=tls_mode,json=tlsMode,proto3,enum=networking.mesh.gloo.solo.io.TrafficPolicySpec_Policy_MTLS_Istio_TLSmode" json:"tls_mode,omitempty"`
 }
 
-func (x *TrafficPolicySpec_Policy_MTLS_Istio) Reset() {
-	*x = TrafficPolicySpec_Policy_MTLS_Istio{}
+func (x *TrafficPolicySpec_Policy_MTLS_Istio) Reset() {
+	*x = TrafficPolicySpec_Policy_MTLS_Istio{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_MTLS_Istio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_MTLS_Istio) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_MTLS_Istio) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_MTLS_Istio.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_MTLS_Istio) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 9, 0}
+}
+
+func (x *TrafficPolicySpec_Policy_MTLS_Istio) GetTlsMode() TrafficPolicySpec_Policy_MTLS_Istio_TLSmode {
+	if x != nil {
+		return x.TlsMode
+	}
+	return TrafficPolicySpec_Policy_MTLS_Istio_DISABLE
+}
+
+// The progress of a canary.
+type TrafficPolicyStatus_CanaryStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The generation of the TrafficPolicy for which the canary is progressing.
+	// The canary restarts from its first step when the TrafficPolicy is modified.
+	ObservedGeneration int64 `protobuf:"varint,1,opt,name=observed_generation,json=observedGeneration,proto3" json:"observed_generation,omitempty"`
+	// The phase of the canary.
+	Phase TrafficPolicyStatus_CanaryStatus_Phase `protobuf:"varint,2,opt,name=phase,proto3,enum=networking.mesh.gloo.solo.io.TrafficPolicyStatus_CanaryStatus_Phase" json:"phase,omitempty"`
+	// The index of the current step.
+	CurrentStep uint32 `protobuf:"varint,3,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"`
+	// The percentage of traffic currently routed to the canary subset.
+	Weight uint32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// The time at which the current step started.
+	StepStartTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=step_start_time,json=stepStartTime,proto3" json:"step_start_time,omitempty"`
+	// A human-readable description of the last transition, e.g. the threshold which was exceeded.
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// The time at which the metrics of the canary were last analyzed.
+	// The metrics are analyzed at most once per analysis interval.
+	LastAnalysisTime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=last_analysis_time,json=lastAnalysisTime,proto3" json:"last_analysis_time,omitempty"`
+}
+
+func (x *TrafficPolicyStatus_CanaryStatus) Reset() {
+	*x = TrafficPolicyStatus_CanaryStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[14]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrafficPolicySpec_Policy_MTLS_Istio) String() string {
+func (x *TrafficPolicyStatus_CanaryStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrafficPolicySpec_Policy_MTLS_Istio) ProtoMessage() {}
+func (*TrafficPolicyStatus_CanaryStatus) ProtoMessage() {}
 
-func (x *TrafficPolicySpec_Policy_MTLS_Istio) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[14]
+func (x *TrafficPolicyStatus_CanaryStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1155,16 +2384,58 @@ func (x *TrafficPolicySpec_Policy_MTLS_Istio) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrafficPolicySpec_Policy_MTLS_Istio.ProtoReflect.Descriptor instead.
-func (*TrafficPolicySpec_Policy_MTLS_Istio) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 6, 0}
+// Deprecated: Use TrafficPolicyStatus_CanaryStatus.ProtoReflect.Descriptor instead.
+func (*TrafficPolicyStatus_CanaryStatus) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{1, 1}
 }
 
-func (x *TrafficPolicySpec_Policy_MTLS_Istio) GetTlsMode() TrafficPolicySpec_Policy_MTLS_Istio_TLSmode {
+func (x *TrafficPolicyStatus_CanaryStatus) GetObservedGeneration() int64 {
 	if x != nil {
-		return x.TlsMode
+		return x.ObservedGeneration
 	}
-	return TrafficPolicySpec_Policy_MTLS_Istio_DISABLE
+	return 0
+}
+
+func (x *TrafficPolicyStatus_CanaryStatus) GetPhase() TrafficPolicyStatus_CanaryStatus_Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return TrafficPolicyStatus_CanaryStatus_PROGRESSING
+}
+
+func (x *TrafficPolicyStatus_CanaryStatus) GetCurrentStep() uint32 {
+	if x != nil {
+		return x.CurrentStep
+	}
+	return 0
+}
+
+func (x *TrafficPolicyStatus_CanaryStatus) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TrafficPolicyStatus_CanaryStatus) GetStepStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StepStartTime
+	}
+	return nil
+}
+
+func (x *TrafficPolicyStatus_CanaryStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TrafficPolicyStatus_CanaryStatus) GetLastAnalysisTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastAnalysisTime
+	}
+	return nil
 }
 
 var File_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto protoreflect.FileDescriptor
@@ -1205,240 +2476,515 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_raw
 	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f,
 	0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
 	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
 	0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d,
-	0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x65, 0x64, 0x5f, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
-	0x2f, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x5f,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3e, 0x67, 0x69, 0x74,
+	0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
+	0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76,
+	0x31, 0x2f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x49, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f,
 	0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x73, 0x72, 0x66,
-	0x2f, 0x63, 0x73, 0x72, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xb9, 0x17, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x53, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
-	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
-	0x61, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x60, 0x0a, 0x14, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x5d, 0x0a, 0x15,
-	0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x61, 0x74, 0x65,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d,
+	0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x73, 0x72, 0x66, 0x2f, 0x63, 0x73, 0x72, 0x66,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d,
+	0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x77, 0x74, 0x2f, 0x6a, 0x77, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65,
+	0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x34, 0x0a, 0x11, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x53,
+	0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x60, 0x0a, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x5d, 0x0a, 0x15, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52,
+	0x13, 0x68, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x72, 0x73, 0x12, 0x5a, 0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x54, 0x63, 0x70, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x12, 0x74, 0x63,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73,
+	0x12, 0x5a, 0x0a, 0x14, 0x74, 0x6c, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x6c,
+	0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x12, 0x74, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0xce, 0x2f, 0x0a, 0x06, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x6c, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x12, 0x6e, 0x0a, 0x0f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x5c, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x62, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x43, 0x6f, 0x72, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x63, 0x6f, 0x72,
+	0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x55, 0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
+	0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x61,
+	0x0a, 0x13, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x69, 0x70, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x4d, 0x61, 0x6e, 0x69, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x69, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x74, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x04, 0x6d, 0x74, 0x6c, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d, 0x54,
+	0x4c, 0x53, 0x52, 0x04, 0x6d, 0x74, 0x6c, 0x73, 0x12, 0x41, 0x0a, 0x04, 0x63, 0x73, 0x72, 0x66,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x73, 0x72, 0x66, 0x2e, 0x6e, 0x65,
 	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
-	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x13, 0x68, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x06, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6e, 0x65,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x73, 0x72, 0x66, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x04, 0x63, 0x73, 0x72, 0x66, 0x12, 0x55, 0x0a, 0x0a, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x68, 0x0a, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x52, 0x0c,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x6e, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x3d, 0x0a, 0x03,
+	0x6a, 0x77, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6a, 0x77, 0x74, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4a, 0x77, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x03, 0x6a, 0x77, 0x74, 0x12, 0x55, 0x0a, 0x06, 0x63,
+	0x61, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6e, 0x65,
 	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
 	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
 	0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0xbd, 0x14, 0x0a, 0x06,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x6c, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x63, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e,
+	0x69, 0x63, 0x79, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x61,
+	0x72, 0x79, 0x1a, 0xfe, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x41,
+	0x0a, 0x0f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x12, 0x34, 0x0a, 0x16,
+	0x72, 0x65, 0x74, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x14, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x52, 0x0a, 0x17, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x15, 0x72, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x6b, 0x0a, 0x1b, 0x72, 0x65, 0x74, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x18, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x47, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x1a, 0x69, 0x0a, 0x10, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
 	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53,
-	0x68, 0x69, 0x66, 0x74, 0x12, 0x6e, 0x0a, 0x0f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x6e,
-	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x87,
+	0x0a, 0x0a, 0x06, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x74, 0x0a, 0x0d, 0x63, 0x61, 0x6e,
+	0x61, 0x72, 0x79, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x4f, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65,
+	0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x2e,
+	0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x12,
+	0x74, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4f, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43,
+	0x61, 0x6e, 0x61, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x65, 0x74, 0x12, 0x52, 0x0a, 0x0e, 0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
 	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x5c, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x0d, 0x63, 0x6f, 0x68, 0x6f,
+	0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x72, 0x0a, 0x0e, 0x63, 0x6f, 0x68,
+	0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x4b, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70,
+	0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79,
+	0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x0d,
+	0x63, 0x6f, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x12, 0x58, 0x0a,
+	0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x65, 0x70,
+	0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x62, 0x0a, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
 	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
 	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x07, 0x72,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x62, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x73, 0x5f, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
-	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
-	0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x72, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a,
-	0x63, 0x6f, 0x72, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x55, 0x0a, 0x06, 0x6d, 0x69,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6e, 0x65, 0x74,
+	0x79, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43,
+	0x61, 0x6e, 0x61, 0x72, 0x79, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11,
+	0x53, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a,
+	0x0d, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x55, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0xf6, 0x02, 0x0a, 0x08, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x35,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x7b, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x54, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x28,
+	0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x09, 0x0a, 0x05, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x4f,
+	0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x01, 0x1a, 0xe4, 0x02, 0x0a, 0x0e, 0x46, 0x61, 0x75,
+	0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0b, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x63, 0x0a, 0x05, 0x61, 0x62, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x41, 0x62, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x1a, 0x77,
+	0x0a, 0x05, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x68, 0x74,
+	0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4d, 0x0a, 0x0b, 0x67, 0x72, 0x70, 0x63,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x72, 0x70,
+	0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x67, 0x72, 0x70,
+	0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x16, 0x0a, 0x14, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x1a,
+	0xc6, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x72, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x4a,
+	0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78,
+	0x70, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x6d,
+	0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12,
+	0x47, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x9a, 0x01, 0x0a, 0x06, 0x4d, 0x69, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x48, 0x0a, 0x0c, 0x6b, 0x75, 0x62, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x48, 0x00,
+	0x52, 0x0b, 0x6b, 0x75, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x42, 0x12, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x1a, 0xf3, 0x01, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65,
+	0x72, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x76, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x47, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78,
+	0x5f, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x45, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x1a, 0xdc, 0x06, 0x0a, 0x0c,
+	0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x66, 0x0a, 0x06,
+	0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x4c, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x72, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x42, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x12, 0x7d, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x52, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x4b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x1a, 0xbd, 0x03, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0e, 0x68, 0x74, 0x74, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x80, 0x01, 0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x5d, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4c, 0x6f,
+	0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x43, 0x6f,
+	0x6f, 0x6b, 0x69, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x43, 0x6f, 0x6f, 0x6b,
+	0x69, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x73, 0x65,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x70, 0x12, 0x3b, 0x0a, 0x19, 0x68, 0x74, 0x74, 0x70,
+	0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x16, 0x68,
+	0x74, 0x74, 0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a,
+	0x65, 0x1a, 0x61, 0x0a, 0x0a, 0x48, 0x74, 0x74, 0x70, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x03, 0x74, 0x74, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79,
+	0x22, 0x4b, 0x0a, 0x08, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x42, 0x12, 0x0f, 0x0a, 0x0b,
+	0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a,
+	0x0d, 0x4c, 0x45, 0x41, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b,
+	0x50, 0x41, 0x53, 0x53, 0x54, 0x48, 0x52, 0x4f, 0x55, 0x47, 0x48, 0x10, 0x03, 0x42, 0x0b, 0x0a,
+	0x09, 0x6c, 0x62, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0xd3, 0x04, 0x0a, 0x0e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x5b, 0x0a,
+	0x03, 0x74, 0x63, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x6e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
 	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
 	0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x61, 0x0a, 0x13, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x69,
-	0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
-	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x69, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x12, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x69, 0x70, 0x75, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x74, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f,
-	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x47, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54,
-	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63,
-	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44,
-	0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65,
-	0x72, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x04, 0x6d, 0x74,
-	0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f,
+	0x6c, 0x2e, 0x54, 0x63, 0x70, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x5e, 0x0a, 0x04, 0x68, 0x74,
+	0x74, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
 	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
 	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50,
 	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x52, 0x04, 0x6d, 0x74, 0x6c, 0x73, 0x12, 0x41, 0x0a, 0x04, 0x63,
-	0x73, 0x72, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x73, 0x72, 0x66,
-	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x73,
-	0x72, 0x66, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x04, 0x63, 0x73, 0x72, 0x66, 0x12, 0x55,
-	0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
-	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x6c, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
-	0x12, 0x41, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x1a, 0x69, 0x0a, 0x10, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x57, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x95,
-	0x02, 0x0a, 0x0e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x3c, 0x0a, 0x0b, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x78, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12,
-	0x63, 0x0a, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4b,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x1a, 0x72, 0x0a, 0x03, 0x54, 0x63,
+	0x70, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x8f,
+	0x02, 0x0a, 0x04, 0x48, 0x74, 0x74, 0x70, 0x12, 0x3b, 0x0a, 0x1a, 0x68, 0x74, 0x74, 0x70, 0x31,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x68, 0x74, 0x74,
+	0x70, 0x31, 0x4d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x74, 0x74, 0x70, 0x32, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x10, 0x68, 0x74, 0x74, 0x70, 0x32, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x50, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x1a, 0x85, 0x02, 0x0a, 0x04, 0x4d, 0x54, 0x4c, 0x53, 0x12, 0x57, 0x0a, 0x05, 0x69, 0x73, 0x74,
+	0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x05, 0x69, 0x73, 0x74,
+	0x69, 0x6f, 0x1a, 0xa3, 0x01, 0x0a, 0x05, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x12, 0x64, 0x0a, 0x08,
+	0x74, 0x6c, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x49,
 	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
 	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72,
 	0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x61,
-	0x62, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x1a, 0x28, 0x0a, 0x05, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x16,
-	0x0a, 0x14, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x1a, 0xc6, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x72, 0x73, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x4a, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65,
-	0x78, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
-	0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
-	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a,
-	0x9a, 0x01, 0x0a, 0x06, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x48, 0x0a, 0x0c, 0x6b, 0x75,
-	0x62, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x0b, 0x6b, 0x75, 0x62, 0x65, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x1a, 0xf3, 0x01, 0x0a,
-	0x10, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65,
-	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x63,
-	0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x47, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10,
-	0x62, 0x61, 0x73, 0x65, 0x45, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12,
-	0x6d, 0x61, 0x78, 0x45, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x1a, 0x85, 0x02, 0x0a, 0x04, 0x4d, 0x54, 0x4c, 0x53, 0x12, 0x57, 0x0a, 0x05, 0x69,
-	0x73, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
-	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x05, 0x69,
-	0x73, 0x74, 0x69, 0x6f, 0x1a, 0xa3, 0x01, 0x0a, 0x05, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x12, 0x64,
-	0x0a, 0x08, 0x74, 0x6c, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x49, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x2e, 0x49, 0x73, 0x74, 0x69,
+	0x6f, 0x2e, 0x54, 0x4c, 0x53, 0x6d, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x74, 0x6c, 0x73, 0x4d, 0x6f,
+	0x64, 0x65, 0x22, 0x34, 0x0a, 0x07, 0x54, 0x4c, 0x53, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49,
+	0x4d, 0x50, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x54, 0x49, 0x4f, 0x5f,
+	0x4d, 0x55, 0x54, 0x55, 0x41, 0x4c, 0x10, 0x02, 0x1a, 0x1f, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x1a, 0x1f, 0x0a, 0x09, 0x44, 0x4c, 0x50,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x1a, 0x1d, 0x0a, 0x07, 0x45, 0x78,
+	0x74, 0x41, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x22, 0xb1, 0x07, 0x0a, 0x13, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x67, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f,
+	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x77,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x12, 0x56, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3e, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
 	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
-	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65,
-	0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x2e, 0x49, 0x73,
-	0x74, 0x69, 0x6f, 0x2e, 0x54, 0x4c, 0x53, 0x6d, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x74, 0x6c, 0x73,
-	0x4d, 0x6f, 0x64, 0x65, 0x22, 0x34, 0x0a, 0x07, 0x54, 0x4c, 0x53, 0x6d, 0x6f, 0x64, 0x65, 0x12,
-	0x0b, 0x0a, 0x07, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x49, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x54, 0x49,
-	0x4f, 0x5f, 0x4d, 0x55, 0x54, 0x55, 0x41, 0x4c, 0x10, 0x02, 0x1a, 0x1f, 0x0a, 0x09, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x1a, 0x1f, 0x0a, 0x09, 0x44,
-	0x4c, 0x50, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x1a, 0x1d, 0x0a, 0x07,
-	0x45, 0x78, 0x74, 0x41, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x22, 0x93, 0x03, 0x0a, 0x13,
 	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x67, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
-	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c,
-	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x73, 0x1a, 0x6d, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x74, 0x75, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x1a, 0x6d, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xc3, 0x03, 0x0a, 0x0c, 0x43, 0x61, 0x6e, 0x61,
+	0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x05, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x44, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
 	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x42, 0x4a, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73,
-	0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x61,
+	0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x65, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x42, 0x0a, 0x0f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x48,
+	0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x43, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73,
+	0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x52, 0x4f, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12,
+	0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x35, 0x0a,
+	0x0d, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x0c,
+	0x0a, 0x08, 0x4f, 0x56, 0x45, 0x52, 0x52, 0x49, 0x44, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x10, 0x02, 0x42, 0x4a, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d,
+	0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1453,76 +2999,132 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_ra
 	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_goTypes = []interface{}{
-	(TrafficPolicySpec_Policy_MTLS_Istio_TLSmode)(0),      // 0: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio.TLSmode
-	(*TrafficPolicySpec)(nil),                             // 1: networking.mesh.gloo.solo.io.TrafficPolicySpec
-	(*TrafficPolicyStatus)(nil),                           // 2: networking.mesh.gloo.solo.io.TrafficPolicyStatus
-	(*TrafficPolicySpec_Policy)(nil),                      // 3: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy
-	(*TrafficPolicySpec_Policy_RetryPolicy)(nil),          // 4: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy
-	(*TrafficPolicySpec_Policy_MultiDestination)(nil),     // 5: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination
-	(*TrafficPolicySpec_Policy_FaultInjection)(nil),       // 6: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection
-	(*TrafficPolicySpec_Policy_CorsPolicy)(nil),           // 7: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy
-	(*TrafficPolicySpec_Policy_Mirror)(nil),               // 8: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Mirror
-	(*TrafficPolicySpec_Policy_OutlierDetection)(nil),     // 9: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection
-	(*TrafficPolicySpec_Policy_MTLS)(nil),                 // 10: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS
-	(*TrafficPolicySpec_Policy_Transform)(nil),            // 11: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Transform
-	(*TrafficPolicySpec_Policy_DLPPolicy)(nil),            // 12: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.DLPPolicy
-	(*TrafficPolicySpec_Policy_ExtAuth)(nil),              // 13: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ExtAuth
-	(*TrafficPolicySpec_Policy_FaultInjection_Abort)(nil), // 14: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Abort
-	(*TrafficPolicySpec_Policy_MTLS_Istio)(nil),           // 15: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio
-	nil,                              // 16: networking.mesh.gloo.solo.io.TrafficPolicyStatus.DestinationsEntry
-	(*v1.WorkloadSelector)(nil),      // 17: common.mesh.gloo.solo.io.WorkloadSelector
-	(*v1.DestinationSelector)(nil),   // 18: common.mesh.gloo.solo.io.DestinationSelector
-	(*HttpMatcher)(nil),              // 19: networking.mesh.gloo.solo.io.HttpMatcher
-	(v1.ApprovalState)(0),            // 20: common.mesh.gloo.solo.io.ApprovalState
-	(*duration.Duration)(nil),        // 21: google.protobuf.Duration
-	(*HeaderManipulation)(nil),       // 22: networking.mesh.gloo.solo.io.HeaderManipulation
-	(*csrf.CsrfPolicy)(nil),          // 23: csrf.networking.mesh.gloo.solo.io.CsrfPolicy
-	(*ratelimit.RouteRateLimit)(nil), // 24: ratelimit.networking.mesh.gloo.solo.io.RouteRateLimit
-	(*WeightedDestination)(nil),      // 25: networking.mesh.gloo.solo.io.WeightedDestination
-	(*v1.StringMatch)(nil),           // 26: common.mesh.gloo.solo.io.StringMatch
-	(*wrappers.BoolValue)(nil),       // 27: google.protobuf.BoolValue
-	(*v11.ClusterObjectRef)(nil),     // 28: core.skv2.solo.io.ClusterObjectRef
-	(*ApprovalStatus)(nil),           // 29: networking.mesh.gloo.solo.io.ApprovalStatus
+	(MergeStrategy)(0), // 0: networking.mesh.gloo.solo.io.MergeStrategy
+	(TrafficPolicySpec_Policy_Canary_Analysis_FailureAction)(0),             // 1: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.Analysis.FailureAction
+	(TrafficPolicySpec_Policy_LoadBalancer_SimpleLB)(0),                     // 2: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancer.SimpleLB
+	(TrafficPolicySpec_Policy_MTLS_Istio_TLSmode)(0),                        // 3: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio.TLSmode
+	(TrafficPolicyStatus_CanaryStatus_Phase)(0),                             // 4: networking.mesh.gloo.solo.io.TrafficPolicyStatus.CanaryStatus.Phase
+	(*TrafficPolicySpec)(nil),                                               // 5: networking.mesh.gloo.solo.io.TrafficPolicySpec
+	(*TrafficPolicyStatus)(nil),                                             // 6: networking.mesh.gloo.solo.io.TrafficPolicyStatus
+	(*TrafficPolicySpec_Policy)(nil),                                        // 7: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy
+	(*TrafficPolicySpec_Policy_RetryPolicy)(nil),                            // 8: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy
+	(*TrafficPolicySpec_Policy_MultiDestination)(nil),                       // 9: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination
+	(*TrafficPolicySpec_Policy_Canary)(nil),                                 // 10: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary
+	(*TrafficPolicySpec_Policy_FaultInjection)(nil),                         // 11: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection
+	(*TrafficPolicySpec_Policy_CorsPolicy)(nil),                             // 12: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy
+	(*TrafficPolicySpec_Policy_Mirror)(nil),                                 // 13: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Mirror
+	(*TrafficPolicySpec_Policy_OutlierDetection)(nil),                       // 14: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection
+	(*TrafficPolicySpec_Policy_LoadBalancer)(nil),                           // 15: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancer
+	(*TrafficPolicySpec_Policy_ConnectionPool)(nil),                         // 16: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool
+	(*TrafficPolicySpec_Policy_MTLS)(nil),                                   // 17: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS
+	(*TrafficPolicySpec_Policy_Transform)(nil),                              // 18: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Transform
+	(*TrafficPolicySpec_Policy_DLPPolicy)(nil),                              // 19: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.DLPPolicy
+	(*TrafficPolicySpec_Policy_ExtAuth)(nil),                                // 20: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ExtAuth
+	nil,                                                                     // 21: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.CanarySubsetEntry
+	nil,                                                                     // 22: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.StableSubsetEntry
+	(*TrafficPolicySpec_Policy_Canary_CookieMatcher)(nil),                   // 23: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.CookieMatcher
+	(*TrafficPolicySpec_Policy_Canary_Step)(nil),                            // 24: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.Step
+	(*TrafficPolicySpec_Policy_Canary_Analysis)(nil),                        // 25: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.Analysis
+	(*TrafficPolicySpec_Policy_FaultInjection_Abort)(nil),                   // 26: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Abort
+	(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash)(nil),            // 27: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancer.ConsistentHash
+	(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie)(nil), // 28: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancer.ConsistentHash.HttpCookie
+	(*TrafficPolicySpec_Policy_ConnectionPool_Tcp)(nil),                     // 29: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.Tcp
+	(*TrafficPolicySpec_Policy_ConnectionPool_Http)(nil),                    // 30: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.Http
+	(*TrafficPolicySpec_Policy_MTLS_Istio)(nil),                             // 31: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio
+	nil,                                      // 32: networking.mesh.gloo.solo.io.TrafficPolicyStatus.DestinationsEntry
+	(*TrafficPolicyStatus_CanaryStatus)(nil), // 33: networking.mesh.gloo.solo.io.TrafficPolicyStatus.CanaryStatus
+	(*v1.WorkloadSelector)(nil),              // 34: common.mesh.gloo.solo.io.WorkloadSelector
+	(*v1.DestinationSelector)(nil),           // 35: common.mesh.gloo.solo.io.DestinationSelector
+	(*HttpMatcher)(nil),                      // 36: networking.mesh.gloo.solo.io.HttpMatcher
+	(*TcpMatcher)(nil),                       // 37: networking.mesh.gloo.solo.io.TcpMatcher
+	(*TlsMatcher)(nil),                       // 38: networking.mesh.gloo.solo.io.TlsMatcher
+	(v1.ApprovalState)(0),                    // 39: common.mesh.gloo.solo.io.ApprovalState
+	(*duration.Duration)(nil),                // 40: google.protobuf.Duration
+	(*HeaderManipulation)(nil),               // 41: networking.mesh.gloo.solo.io.HeaderManipulation
+	(*csrf.CsrfPolicy)(nil),                  // 42: csrf.networking.mesh.gloo.solo.io.CsrfPolicy
+	(*ratelimit.RouteRateLimit)(nil),         // 43: ratelimit.networking.mesh.gloo.solo.io.RouteRateLimit
+	(*jwt.JwtPolicy)(nil),                    // 44: jwt.networking.mesh.gloo.solo.io.JwtPolicy
+	(*wrappers.BoolValue)(nil),               // 45: google.protobuf.BoolValue
+	(GrpcStatusCode)(0),                      // 46: networking.mesh.gloo.solo.io.GrpcStatusCode
+	(*WeightedDestination)(nil),              // 47: networking.mesh.gloo.solo.io.WeightedDestination
+	(*HeaderMatcher)(nil),                    // 48: networking.mesh.gloo.solo.io.HeaderMatcher
+	(*v1.StringMatch)(nil),                   // 49: common.mesh.gloo.solo.io.StringMatch
+	(*v11.ClusterObjectRef)(nil),             // 50: core.skv2.solo.io.ClusterObjectRef
+	(*v1.LocalityLoadBalancing)(nil),         // 51: common.mesh.gloo.solo.io.LocalityLoadBalancing
+	(*ApprovalStatus)(nil),                   // 52: networking.mesh.gloo.solo.io.ApprovalStatus
+	(*timestamp.Timestamp)(nil),              // 53: google.protobuf.Timestamp
 }
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_depIdxs = []int32{
-	17, // 0: networking.mesh.gloo.solo.io.TrafficPolicySpec.source_selector:type_name -> common.mesh.gloo.solo.io.WorkloadSelector
-	18, // 1: networking.mesh.gloo.solo.io.TrafficPolicySpec.destination_selector:type_name -> common.mesh.gloo.solo.io.DestinationSelector
-	19, // 2: networking.mesh.gloo.solo.io.TrafficPolicySpec.http_request_matchers:type_name -> networking.mesh.gloo.solo.io.HttpMatcher
-	3,  // 3: networking.mesh.gloo.solo.io.TrafficPolicySpec.policy:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy
-	20, // 4: networking.mesh.gloo.solo.io.TrafficPolicyStatus.state:type_name -> common.mesh.gloo.solo.io.ApprovalState
-	16, // 5: networking.mesh.gloo.solo.io.TrafficPolicyStatus.destinations:type_name -> networking.mesh.gloo.solo.io.TrafficPolicyStatus.DestinationsEntry
-	5,  // 6: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.traffic_shift:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination
-	6,  // 7: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.fault_injection:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection
-	21, // 8: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.request_timeout:type_name -> google.protobuf.Duration
-	4,  // 9: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.retries:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy
-	7,  // 10: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.cors_policy:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy
-	8,  // 11: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.mirror:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Mirror
-	22, // 12: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.header_manipulation:type_name -> networking.mesh.gloo.solo.io.HeaderManipulation
-	9,  // 13: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.outlier_detection:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection
-	10, // 14: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.mtls:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS
-	23, // 15: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.csrf:type_name -> csrf.networking.mesh.gloo.solo.io.CsrfPolicy
-	24, // 16: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.rate_limit:type_name -> ratelimit.networking.mesh.gloo.solo.io.RouteRateLimit
-	21, // 17: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy.per_try_timeout:type_name -> google.protobuf.Duration
-	25, // 18: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination.destinations:type_name -> networking.mesh.gloo.solo.io.WeightedDestination
-	21, // 19: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.fixed_delay:type_name -> google.protobuf.Duration
-	14, // 20: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.abort:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Abort
-	26, // 21: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy.allow_origins:type_name -> common.mesh.gloo.solo.io.StringMatch
-	21, // 22: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy.max_age:type_name -> google.protobuf.Duration
-	27, // 23: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy.allow_credentials:type_name -> google.protobuf.BoolValue
-	28, // 24: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Mirror.kube_service:type_name -> core.skv2.solo.io.ClusterObjectRef
-	21, // 25: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection.interval:type_name -> google.protobuf.Duration
-	21, // 26: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection.base_ejection_time:type_name -> google.protobuf.Duration
-	15, // 27: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.istio:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio
-	0,  // 28: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio.tls_mode:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio.TLSmode
-	29, // 29: networking.mesh.gloo.solo.io.TrafficPolicyStatus.DestinationsEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	34, // 0: networking.mesh.gloo.solo.io.TrafficPolicySpec.source_selector:type_name -> common.mesh.gloo.solo.io.WorkloadSelector
+	35, // 1: networking.mesh.gloo.solo.io.TrafficPolicySpec.destination_selector:type_name -> common.mesh.gloo.solo.io.DestinationSelector
+	36, // 2: networking.mesh.gloo.solo.io.TrafficPolicySpec.http_request_matchers:type_name -> networking.mesh.gloo.solo.io.HttpMatcher
+	37, // 3: networking.mesh.gloo.solo.io.TrafficPolicySpec.tcp_request_matchers:type_name -> networking.mesh.gloo.solo.io.TcpMatcher
+	38, // 4: networking.mesh.gloo.solo.io.TrafficPolicySpec.tls_request_matchers:type_name -> networking.mesh.gloo.solo.io.TlsMatcher
+	7,  // 5: networking.mesh.gloo.solo.io.TrafficPolicySpec.policy:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy
+	39, // 6: networking.mesh.gloo.solo.io.TrafficPolicyStatus.state:type_name -> common.mesh.gloo.solo.io.ApprovalState
+	32, // 7: networking.mesh.gloo.solo.io.TrafficPolicyStatus.destinations:type_name -> networking.mesh.gloo.solo.io.TrafficPolicyStatus.DestinationsEntry
+	33, // 8: networking.mesh.gloo.solo.io.TrafficPolicyStatus.canary:type_name -> networking.mesh.gloo.solo.io.TrafficPolicyStatus.CanaryStatus
+	9,  // 9: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.traffic_shift:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination
+	11, // 10: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.fault_injection:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection
+	40, // 11: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.request_timeout:type_name -> google.protobuf.Duration
+	8,  // 12: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.retries:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy
+	12, // 13: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.cors_policy:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy
+	13, // 14: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.mirror:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Mirror
+	41, // 15: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.header_manipulation:type_name -> networking.mesh.gloo.solo.io.HeaderManipulation
+	14, // 16: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.outlier_detection:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection
+	17, // 17: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.mtls:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS
+	42, // 18: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.csrf:type_name -> csrf.networking.mesh.gloo.solo.io.CsrfPolicy
+	43, // 19: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.rate_limit:type_name -> ratelimit.networking.mesh.gloo.solo.io.RouteRateLimit
+	15, // 20: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.load_balancer:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancer
+	16, // 21: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.connection_pool:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool
+	44, // 22: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.jwt:type_name -> jwt.networking.mesh.gloo.solo.io.JwtPolicy
+	10, // 23: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.canary:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary
+	40, // 24: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy.per_try_timeout:type_name -> google.protobuf.Duration
+	45, // 25: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy.retry_remote_localities:type_name -> google.protobuf.BoolValue
+	46, // 26: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy.retriable_grpc_status_codes:type_name -> networking.mesh.gloo.solo.io.GrpcStatusCode
+	47, // 27: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination.destinations:type_name -> networking.mesh.gloo.solo.io.WeightedDestination
+	21, // 28: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.canary_subset:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.CanarySubsetEntry
+	22, // 29: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.stable_subset:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.StableSubsetEntry
+	48, // 30: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.cohort_headers:type_name -> networking.mesh.gloo.solo.io.HeaderMatcher
+	23, // 31: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.cohort_cookies:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.CookieMatcher
+	24, // 32: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.steps:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.Step
+	25, // 33: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.analysis:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.Analysis
+	40, // 34: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.fixed_delay:type_name -> google.protobuf.Duration
+	26, // 35: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.abort:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Abort
+	49, // 36: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy.allow_origins:type_name -> common.mesh.gloo.solo.io.StringMatch
+	40, // 37: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy.max_age:type_name -> google.protobuf.Duration
+	45, // 38: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy.allow_credentials:type_name -> google.protobuf.BoolValue
+	50, // 39: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Mirror.kube_service:type_name -> core.skv2.solo.io.ClusterObjectRef
+	40, // 40: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection.interval:type_name -> google.protobuf.Duration
+	40, // 41: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection.base_ejection_time:type_name -> google.protobuf.Duration
+	2,  // 42: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancer.simple:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancer.SimpleLB
+	27, // 43: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancer.consistent_hash:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancer.ConsistentHash
+	51, // 44: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancer.locality:type_name -> common.mesh.gloo.solo.io.LocalityLoadBalancing
+	29, // 45: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.tcp:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.Tcp
+	30, // 46: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.http:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.Http
+	31, // 47: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.istio:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio
+	40, // 48: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.Step.duration:type_name -> google.protobuf.Duration
+	40, // 49: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.Analysis.max_latency:type_name -> google.protobuf.Duration
+	40, // 50: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.Analysis.interval:type_name -> google.protobuf.Duration
+	1,  // 51: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.Analysis.failure_action:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Canary.Analysis.FailureAction
+	46, // 52: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Abort.grpc_status:type_name -> networking.mesh.gloo.solo.io.GrpcStatusCode
+	28, // 53: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancer.ConsistentHash.http_cookie:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancer.ConsistentHash.HttpCookie
+	40, // 54: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancer.ConsistentHash.HttpCookie.ttl:type_name -> google.protobuf.Duration
+	40, // 55: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.Tcp.connect_timeout:type_name -> google.protobuf.Duration
+	40, // 56: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.Http.idle_timeout:type_name -> google.protobuf.Duration
+	3,  // 57: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio.tls_mode:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio.TLSmode
+	52, // 58: networking.mesh.gloo.solo.io.TrafficPolicyStatus.DestinationsEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
+	4,  // 59: networking.mesh.gloo.solo.io.TrafficPolicyStatus.CanaryStatus.phase:type_name -> networking.mesh.gloo.solo.io.TrafficPolicyStatus.CanaryStatus.Phase
+	53, // 60: networking.mesh.gloo.solo.io.TrafficPolicyStatus.CanaryStatus.step_start_time:type_name -> google.protobuf.Timestamp
+	53, // 61: networking.mesh.gloo.solo.io.TrafficPolicyStatus.CanaryStatus.last_analysis_time:type_name -> google.protobuf.Timestamp
+	62, // [62:62] is the sub-list for method output_type
+	62, // [62:62] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_init() }
@@ -1595,7 +3197,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_FaultInjection); i {
+			switch v := v.(*TrafficPolicySpec_Policy_Canary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1607,7 +3209,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_CorsPolicy); i {
+			switch v := v.(*TrafficPolicySpec_Policy_FaultInjection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1619,7 +3221,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_Mirror); i {
+			switch v := v.(*TrafficPolicySpec_Policy_CorsPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1631,7 +3233,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_OutlierDetection); i {
+			switch v := v.(*TrafficPolicySpec_Policy_Mirror); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1643,7 +3245,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_MTLS); i {
+			switch v := v.(*TrafficPolicySpec_Policy_OutlierDetection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1655,7 +3257,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_Transform); i {
+			switch v := v.(*TrafficPolicySpec_Policy_LoadBalancer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1667,7 +3269,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_DLPPolicy); i {
+			switch v := v.(*TrafficPolicySpec_Policy_ConnectionPool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1679,7 +3281,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_ExtAuth); i {
+			switch v := v.(*TrafficPolicySpec_Policy_MTLS); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1691,7 +3293,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_FaultInjection_Abort); i {
+			switch v := v.(*TrafficPolicySpec_Policy_Transform); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1703,6 +3305,126 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_DLPPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_ExtAuth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_Canary_CookieMatcher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_Canary_Step); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_Canary_Analysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_FaultInjection_Abort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_ConnectionPool_Tcp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_ConnectionPool_Http); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficPolicySpec_Policy_MTLS_Istio); i {
 			case 0:
 				return &v.state
@@ -1714,21 +3436,43 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 				return nil
 			}
 		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicyStatus_CanaryStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*TrafficPolicySpec_Policy_FaultInjection_FixedDelay)(nil),
 		(*TrafficPolicySpec_Policy_FaultInjection_Abort_)(nil),
 	}
-	file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*TrafficPolicySpec_Policy_Mirror_KubeService)(nil),
 	}
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*TrafficPolicySpec_Policy_LoadBalancer_Simple)(nil),
+		(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_)(nil),
+	}
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpHeaderName)(nil),
+		(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie_)(nil),
+		(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_UseSourceIp)(nil),
+		(*TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpQueryParameterName)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   16,
+			NumEnums:      5,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
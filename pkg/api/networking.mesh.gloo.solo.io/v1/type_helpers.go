@@ -11,3 +11,6 @@ type AccessPolicySlice []*AccessPolicy
 
 // VirtualMeshSlice represents a slice of *VirtualMesh
 type VirtualMeshSlice []*VirtualMesh
+
+// EgressGatewayPolicySlice represents a slice of *EgressGatewayPolicy
+type EgressGatewayPolicySlice []*EgressGatewayPolicy
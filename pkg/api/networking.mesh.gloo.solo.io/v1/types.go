@@ -113,8 +113,44 @@ type VirtualMeshList struct {
 	Items           []VirtualMesh `json:"items"`
 }
 
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+
+// GroupVersionKind for EgressGatewayPolicy
+var EgressGatewayPolicyGVK = schema.GroupVersionKind{
+	Group:   "networking.mesh.gloo.solo.io",
+	Version: "v1",
+	Kind:    "EgressGatewayPolicy",
+}
+
+// EgressGatewayPolicy is the Schema for the egressGatewayPolicy API
+type EgressGatewayPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EgressGatewayPolicySpec   `json:"spec,omitempty"`
+	Status EgressGatewayPolicyStatus `json:"status,omitempty"`
+}
+
+// GVK returns the GroupVersionKind associated with the resource type.
+func (EgressGatewayPolicy) GVK() schema.GroupVersionKind {
+	return EgressGatewayPolicyGVK
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EgressGatewayPolicyList contains a list of EgressGatewayPolicy
+type EgressGatewayPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EgressGatewayPolicy `json:"items"`
+}
+
 func init() {
 	SchemeBuilder.Register(&TrafficPolicy{}, &TrafficPolicyList{})
 	SchemeBuilder.Register(&AccessPolicy{}, &AccessPolicyList{})
 	SchemeBuilder.Register(&VirtualMesh{}, &VirtualMeshList{})
+	SchemeBuilder.Register(&EgressGatewayPolicy{}, &EgressGatewayPolicyList{})
 }
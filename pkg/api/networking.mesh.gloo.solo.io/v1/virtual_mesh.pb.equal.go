@@ -300,6 +300,27 @@ func (m *VirtualMeshStatus) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetCertificateRotations()) != len(target.GetCertificateRotations()) {
+		return false
+	}
+	for k, v := range m.GetCertificateRotations() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetCertificateRotations()[k]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetCertificateRotations()[k]) {
+				return false
+			}
+		}
+
+	}
+
+	if m.GetRootRotationState() != target.GetRootRotationState() {
+		return false
+	}
+
 	return true
 }
 
@@ -443,6 +464,16 @@ func (m *VirtualMeshSpec_Federation) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLocalityLoadBalancing()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLocalityLoadBalancing()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLocalityLoadBalancing(), target.GetLocalityLoadBalancing()) {
+			return false
+		}
+	}
+
 	switch m.Mode.(type) {
 
 	case *VirtualMeshSpec_Federation_Permissive:
@@ -491,6 +522,26 @@ func (m *VirtualMeshSpec_MTLSConfig_LimitedTrust) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetRootCertOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRootCertOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRootCertOptions(), target.GetRootCertOptions()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetIntermediateCertOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetIntermediateCertOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetIntermediateCertOptions(), target.GetIntermediateCertOptions()) {
+			return false
+		}
+	}
+
 	return true
 }
 
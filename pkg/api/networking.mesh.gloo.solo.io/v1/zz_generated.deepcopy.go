@@ -187,3 +187,63 @@ func (in *VirtualMeshList) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// Generated Deepcopy methods for EgressGatewayPolicy
+
+func (in *EgressGatewayPolicy) DeepCopyInto(out *EgressGatewayPolicy) {
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+
+	// deepcopy spec
+	in.Spec.DeepCopyInto(&out.Spec)
+	// deepcopy status
+	in.Status.DeepCopyInto(&out.Status)
+
+	return
+}
+
+func (in *EgressGatewayPolicy) DeepCopy() *EgressGatewayPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressGatewayPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *EgressGatewayPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *EgressGatewayPolicyList) DeepCopyInto(out *EgressGatewayPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EgressGatewayPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+func (in *EgressGatewayPolicyList) DeepCopy() *EgressGatewayPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressGatewayPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *EgressGatewayPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
@@ -93,6 +93,137 @@ func (m *SettingsSpec) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetUserVirtualServices()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetUserVirtualServices()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetUserVirtualServices(), target.GetUserVirtualServices()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetSidecarScoping()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSidecarScoping()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSidecarScoping(), target.GetSidecarScoping()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMetrics()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMetrics()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMetrics(), target.GetMetrics()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *MetricsSettings) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*MetricsSettings)
+	if !ok {
+		that2, ok := that.(MetricsSettings)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetPrometheusAddress(), target.GetPrometheusAddress()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *SidecarScopingSettings) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*SidecarScopingSettings)
+	if !ok {
+		that2, ok := that.(SidecarScopingSettings)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetMode() != target.GetMode() {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *UserVirtualServiceSettings) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UserVirtualServiceSettings)
+	if !ok {
+		that2, ok := that.(UserVirtualServiceSettings)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetMode() != target.GetMode() {
+		return false
+	}
+
+	if len(m.GetDestinationOverrides()) != len(target.GetDestinationOverrides()) {
+		return false
+	}
+	for idx, v := range m.GetDestinationOverrides() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDestinationOverrides()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetDestinationOverrides()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -247,6 +378,51 @@ func (m *SettingsStatus) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *UserVirtualServiceSettings_DestinationOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*UserVirtualServiceSettings_DestinationOverride)
+	if !ok {
+		that2, ok := that.(UserVirtualServiceSettings_DestinationOverride)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetDestinationSelector()) != len(target.GetDestinationSelector()) {
+		return false
+	}
+	for idx, v := range m.GetDestinationSelector() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetDestinationSelector()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetDestinationSelector()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if m.GetMode() != target.GetMode() {
+		return false
+	}
+
+	return true
+}
+
 // Equal function
 func (m *DiscoverySettings_Istio) Equal(that interface{}) bool {
 	if that == nil {
@@ -285,6 +461,23 @@ func (m *DiscoverySettings_Istio) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetEgressGatewayDetectors()) != len(target.GetEgressGatewayDetectors()) {
+		return false
+	}
+	for k, v := range m.GetEgressGatewayDetectors() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetEgressGatewayDetectors()[k]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetEgressGatewayDetectors()[k]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -326,3 +519,38 @@ func (m *DiscoverySettings_Istio_IngressGatewayDetector) Equal(that interface{})
 
 	return true
 }
+
+// Equal function
+func (m *DiscoverySettings_Istio_EgressGatewayDetector) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*DiscoverySettings_Istio_EgressGatewayDetector)
+	if !ok {
+		that2, ok := that.(DiscoverySettings_Istio_EgressGatewayDetector)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetGatewayWorkloadLabels()) != len(target.GetGatewayWorkloadLabels()) {
+		return false
+	}
+	for k, v := range m.GetGatewayWorkloadLabels() {
+
+		if strings.Compare(v, target.GetGatewayWorkloadLabels()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
@@ -33,6 +33,119 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type SidecarScopingSettings_Mode int32
+
+const (
+	// The Workload depends on the Destinations selected by the ServiceDependencies applied to it.
+	SidecarScopingSettings_SERVICE_DEPENDENCIES SidecarScopingSettings_Mode = 0
+	// The Workload additionally depends on the Destinations selected by the TrafficPolicies and AccessPolicies
+	// which select it as a source, including the Destinations to which those TrafficPolicies shift or mirror traffic.
+	// Policies only add dependencies to Workloads which have ServiceDependencies applied.
+	SidecarScopingSettings_SERVICE_DEPENDENCIES_AND_POLICIES SidecarScopingSettings_Mode = 1
+	// Sidecars are not generated.
+	SidecarScopingSettings_DISABLED SidecarScopingSettings_Mode = 2
+)
+
+// Enum value maps for SidecarScopingSettings_Mode.
+var (
+	SidecarScopingSettings_Mode_name = map[int32]string{
+		0: "SERVICE_DEPENDENCIES",
+		1: "SERVICE_DEPENDENCIES_AND_POLICIES",
+		2: "DISABLED",
+	}
+	SidecarScopingSettings_Mode_value = map[string]int32{
+		"SERVICE_DEPENDENCIES":              0,
+		"SERVICE_DEPENDENCIES_AND_POLICIES": 1,
+		"DISABLED":                          2,
+	}
+)
+
+func (x SidecarScopingSettings_Mode) Enum() *SidecarScopingSettings_Mode {
+	p := new(SidecarScopingSettings_Mode)
+	*p = x
+	return p
+}
+
+func (x SidecarScopingSettings_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SidecarScopingSettings_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_enumTypes[0].Descriptor()
+}
+
+func (SidecarScopingSettings_Mode) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_enumTypes[0]
+}
+
+func (x SidecarScopingSettings_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SidecarScopingSettings_Mode.Descriptor instead.
+func (SidecarScopingSettings_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type UserVirtualServiceSettings_Mode int32
+
+const (
+	// Reject the TrafficPolicies applied to the Destination, reporting the conflicting VirtualService on their status.
+	UserVirtualServiceSettings_REJECT UserVirtualServiceSettings_Mode = 0
+	// Output the routes generated by Gloo Mesh, followed by copies of the user-supplied routes, to a delegate
+	// VirtualService which has no hosts and is named after the Destination's Kubernetes service.
+	// The user-supplied VirtualService is not modified, and must delegate to it in its first route.
+	// Only user-supplied VirtualServices with a single host which apply to the `mesh` gateway can be merged.
+	// User-supplied routes with the same match as a generated route are reported on the status of the conflicting TrafficPolicies.
+	UserVirtualServiceSettings_MERGE UserVirtualServiceSettings_Mode = 1
+	// Output the routes generated by Gloo Mesh to a delegate VirtualService, which has no hosts and
+	// is named after the Destination's Kubernetes service.
+	// The user-supplied VirtualService must contain a route which delegates to it, otherwise an error
+	// is reported on the status of the TrafficPolicies.
+	UserVirtualServiceSettings_DELEGATE UserVirtualServiceSettings_Mode = 2
+)
+
+// Enum value maps for UserVirtualServiceSettings_Mode.
+var (
+	UserVirtualServiceSettings_Mode_name = map[int32]string{
+		0: "REJECT",
+		1: "MERGE",
+		2: "DELEGATE",
+	}
+	UserVirtualServiceSettings_Mode_value = map[string]int32{
+		"REJECT":   0,
+		"MERGE":    1,
+		"DELEGATE": 2,
+	}
+)
+
+func (x UserVirtualServiceSettings_Mode) Enum() *UserVirtualServiceSettings_Mode {
+	p := new(UserVirtualServiceSettings_Mode)
+	*p = x
+	return p
+}
+
+func (x UserVirtualServiceSettings_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserVirtualServiceSettings_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_enumTypes[1].Descriptor()
+}
+
+func (UserVirtualServiceSettings_Mode) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_enumTypes[1]
+}
+
+func (x UserVirtualServiceSettings_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserVirtualServiceSettings_Mode.Descriptor instead.
+func (UserVirtualServiceSettings_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{3, 0}
+}
+
 // Configure system-wide settings and defaults. Settings specified in networking policies take precedence over those specified here.
 type SettingsSpec struct {
 	state         protoimpl.MessageState
@@ -49,6 +162,15 @@ type SettingsSpec struct {
 	Discovery *DiscoverySettings `protobuf:"bytes,3,opt,name=discovery,proto3" json:"discovery,omitempty"`
 	// Enable and configure use of Relay mode to communicate with remote clusters. This is an enterprise-only feature.
 	Relay *RelaySettings `protobuf:"bytes,4,opt,name=relay,proto3" json:"relay,omitempty"`
+	// Configure how Gloo Mesh translates TrafficPolicies for Destinations whose hostnames are already configured
+	// by Istio VirtualServices not managed by Gloo Mesh. Only takes effect if intersecting config detection is enabled
+	// with the `--disallow-intersecting-config` flag, otherwise such VirtualServices are ignored.
+	UserVirtualServices *UserVirtualServiceSettings `protobuf:"bytes,5,opt,name=user_virtual_services,json=userVirtualServices,proto3" json:"user_virtual_services,omitempty"`
+	// Configure how Gloo Mesh generates Istio Sidecars, which limit the outbound configuration sent to the proxy
+	// of each Workload to that of the Destinations it depends on.
+	SidecarScoping *SidecarScopingSettings `protobuf:"bytes,6,opt,name=sidecar_scoping,json=sidecarScoping,proto3" json:"sidecar_scoping,omitempty"`
+	// Configure the metrics source from which TrafficPolicy canaries are analyzed.
+	Metrics *MetricsSettings `protobuf:"bytes,7,opt,name=metrics,proto3" json:"metrics,omitempty"`
 }
 
 func (x *SettingsSpec) Reset() {
@@ -111,6 +233,187 @@ func (x *SettingsSpec) GetRelay() *RelaySettings {
 	return nil
 }
 
+func (x *SettingsSpec) GetUserVirtualServices() *UserVirtualServiceSettings {
+	if x != nil {
+		return x.UserVirtualServices
+	}
+	return nil
+}
+
+func (x *SettingsSpec) GetSidecarScoping() *SidecarScopingSettings {
+	if x != nil {
+		return x.SidecarScoping
+	}
+	return nil
+}
+
+func (x *SettingsSpec) GetMetrics() *MetricsSettings {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// Configure the source of the Istio request metrics collected from the managed clusters.
+type MetricsSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address of the Prometheus server which scrapes the request metrics of the managed clusters,
+	// e.g. `http://prometheus-server.gloo-mesh:80`. If unset, canaries which specify an analysis do not progress.
+	PrometheusAddress string `protobuf:"bytes,1,opt,name=prometheus_address,json=prometheusAddress,proto3" json:"prometheus_address,omitempty"`
+}
+
+func (x *MetricsSettings) Reset() {
+	*x = MetricsSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricsSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsSettings) ProtoMessage() {}
+
+func (x *MetricsSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsSettings.ProtoReflect.Descriptor instead.
+func (*MetricsSettings) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MetricsSettings) GetPrometheusAddress() string {
+	if x != nil {
+		return x.PrometheusAddress
+	}
+	return ""
+}
+
+// Configure how Gloo Mesh generates an Istio Sidecar for each Workload, whose egress hosts are the hostnames of
+// the Destinations the Workload depends on. Workloads without any dependencies keep the default behavior of Istio,
+// i.e. their proxies receive configuration for every service in the mesh.
+type SidecarScopingSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The mode used to determine the dependencies of each Workload. Defaults to SERVICE_DEPENDENCIES.
+	Mode SidecarScopingSettings_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=settings.mesh.gloo.solo.io.SidecarScopingSettings_Mode" json:"mode,omitempty"`
+}
+
+func (x *SidecarScopingSettings) Reset() {
+	*x = SidecarScopingSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SidecarScopingSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarScopingSettings) ProtoMessage() {}
+
+func (x *SidecarScopingSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarScopingSettings.ProtoReflect.Descriptor instead.
+func (*SidecarScopingSettings) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SidecarScopingSettings) GetMode() SidecarScopingSettings_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return SidecarScopingSettings_SERVICE_DEPENDENCIES
+}
+
+// Configure how Gloo Mesh handles TrafficPolicies applied to Destinations whose hostnames are already configured
+// by user-supplied VirtualServices.
+type UserVirtualServiceSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The mode used for all Destinations, unless overridden for the Destination. Defaults to REJECT.
+	Mode UserVirtualServiceSettings_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=settings.mesh.gloo.solo.io.UserVirtualServiceSettings_Mode" json:"mode,omitempty"`
+	// Override the mode for selected Destinations. If multiple overrides select a Destination, the first one is used.
+	DestinationOverrides []*UserVirtualServiceSettings_DestinationOverride `protobuf:"bytes,2,rep,name=destination_overrides,json=destinationOverrides,proto3" json:"destination_overrides,omitempty"`
+}
+
+func (x *UserVirtualServiceSettings) Reset() {
+	*x = UserVirtualServiceSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserVirtualServiceSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserVirtualServiceSettings) ProtoMessage() {}
+
+func (x *UserVirtualServiceSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserVirtualServiceSettings.ProtoReflect.Descriptor instead.
+func (*UserVirtualServiceSettings) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UserVirtualServiceSettings) GetMode() UserVirtualServiceSettings_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return UserVirtualServiceSettings_REJECT
+}
+
+func (x *UserVirtualServiceSettings) GetDestinationOverrides() []*UserVirtualServiceSettings_DestinationOverride {
+	if x != nil {
+		return x.DestinationOverrides
+	}
+	return nil
+}
+
 // RelaySettings contains options for configuring Gloo Mesh to use Relay for cluster management.
 // Relay provides a way for connecting Gloo Mesh to remote Kubernetes Clusters
 // without the need to share credentials and access to remote Kube API Servers
@@ -146,7 +449,7 @@ type RelaySettings struct {
 func (x *RelaySettings) Reset() {
 	*x = RelaySettings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[1]
+		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -159,7 +462,7 @@ func (x *RelaySettings) String() string {
 func (*RelaySettings) ProtoMessage() {}
 
 func (x *RelaySettings) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[1]
+	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -172,7 +475,7 @@ func (x *RelaySettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelaySettings.ProtoReflect.Descriptor instead.
 func (*RelaySettings) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{1}
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RelaySettings) GetEnabled() bool {
@@ -202,7 +505,7 @@ type DiscoverySettings struct {
 func (x *DiscoverySettings) Reset() {
 	*x = DiscoverySettings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[2]
+		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -215,7 +518,7 @@ func (x *DiscoverySettings) String() string {
 func (*DiscoverySettings) ProtoMessage() {}
 
 func (x *DiscoverySettings) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[2]
+	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +531,7 @@ func (x *DiscoverySettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiscoverySettings.ProtoReflect.Descriptor instead.
 func (*DiscoverySettings) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{2}
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DiscoverySettings) GetIstio() *DiscoverySettings_Istio {
@@ -255,7 +558,7 @@ type GrpcServer struct {
 func (x *GrpcServer) Reset() {
 	*x = GrpcServer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[3]
+		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -268,7 +571,7 @@ func (x *GrpcServer) String() string {
 func (*GrpcServer) ProtoMessage() {}
 
 func (x *GrpcServer) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[3]
+	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,7 +584,7 @@ func (x *GrpcServer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GrpcServer.ProtoReflect.Descriptor instead.
 func (*GrpcServer) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{3}
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GrpcServer) GetAddress() string {
@@ -324,7 +627,7 @@ type SettingsStatus struct {
 func (x *SettingsStatus) Reset() {
 	*x = SettingsStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[4]
+		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -337,7 +640,7 @@ func (x *SettingsStatus) String() string {
 func (*SettingsStatus) ProtoMessage() {}
 
 func (x *SettingsStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[4]
+	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +653,7 @@ func (x *SettingsStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SettingsStatus.ProtoReflect.Descriptor instead.
 func (*SettingsStatus) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{4}
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SettingsStatus) GetObservedGeneration() int64 {
@@ -374,6 +677,64 @@ func (x *SettingsStatus) GetErrors() []string {
 	return nil
 }
 
+// Override the mode for a set of Destinations.
+type UserVirtualServiceSettings_DestinationOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Select the Destinations to which the override applies. If omitted, all Destinations are selected.
+	DestinationSelector []*v11.DestinationSelector `protobuf:"bytes,1,rep,name=destination_selector,json=destinationSelector,proto3" json:"destination_selector,omitempty"`
+	// The mode used for the selected Destinations.
+	Mode UserVirtualServiceSettings_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=settings.mesh.gloo.solo.io.UserVirtualServiceSettings_Mode" json:"mode,omitempty"`
+}
+
+func (x *UserVirtualServiceSettings_DestinationOverride) Reset() {
+	*x = UserVirtualServiceSettings_DestinationOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserVirtualServiceSettings_DestinationOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserVirtualServiceSettings_DestinationOverride) ProtoMessage() {}
+
+func (x *UserVirtualServiceSettings_DestinationOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserVirtualServiceSettings_DestinationOverride.ProtoReflect.Descriptor instead.
+func (*UserVirtualServiceSettings_DestinationOverride) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *UserVirtualServiceSettings_DestinationOverride) GetDestinationSelector() []*v11.DestinationSelector {
+	if x != nil {
+		return x.DestinationSelector
+	}
+	return nil
+}
+
+func (x *UserVirtualServiceSettings_DestinationOverride) GetMode() UserVirtualServiceSettings_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return UserVirtualServiceSettings_REJECT
+}
+
 // Istio-specific discovery settings
 type DiscoverySettings_Istio struct {
 	state         protoimpl.MessageState
@@ -387,12 +748,16 @@ type DiscoverySettings_Istio struct {
 	// `*` denoting all clusters. If an entry is found for a given cluster, it will be used. Otherwise, the
 	// wildcard entry will be used if it exists. Lastly, we will fall back to a set of default values.
 	IngressGatewayDetectors map[string]*DiscoverySettings_Istio_IngressGatewayDetector `protobuf:"bytes,1,rep,name=ingress_gateway_detectors,json=ingressGatewayDetectors,proto3" json:"ingress_gateway_detectors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Configure discovery of egress gateways per cluster, through which EgressGatewayPolicies route traffic to external hosts.
+	// The key to the map is either a Gloo Mesh cluster name or `*` denoting all clusters. If an entry is found for a given cluster,
+	// it will be used. Otherwise, the wildcard entry will be used if it exists. Lastly, we will fall back to the default values.
+	EgressGatewayDetectors map[string]*DiscoverySettings_Istio_EgressGatewayDetector `protobuf:"bytes,2,rep,name=egress_gateway_detectors,json=egressGatewayDetectors,proto3" json:"egress_gateway_detectors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *DiscoverySettings_Istio) Reset() {
 	*x = DiscoverySettings_Istio{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[5]
+		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -405,7 +770,7 @@ func (x *DiscoverySettings_Istio) String() string {
 func (*DiscoverySettings_Istio) ProtoMessage() {}
 
 func (x *DiscoverySettings_Istio) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[5]
+	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +783,7 @@ func (x *DiscoverySettings_Istio) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiscoverySettings_Istio.ProtoReflect.Descriptor instead.
 func (*DiscoverySettings_Istio) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{2, 0}
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *DiscoverySettings_Istio) GetIngressGatewayDetectors() map[string]*DiscoverySettings_Istio_IngressGatewayDetector {
@@ -428,6 +793,13 @@ func (x *DiscoverySettings_Istio) GetIngressGatewayDetectors() map[string]*Disco
 	return nil
 }
 
+func (x *DiscoverySettings_Istio) GetEgressGatewayDetectors() map[string]*DiscoverySettings_Istio_EgressGatewayDetector {
+	if x != nil {
+		return x.EgressGatewayDetectors
+	}
+	return nil
+}
+
 // Configure discovery of ingress gateways.
 type DiscoverySettings_Istio_IngressGatewayDetector struct {
 	state         protoimpl.MessageState
@@ -445,7 +817,7 @@ type DiscoverySettings_Istio_IngressGatewayDetector struct {
 func (x *DiscoverySettings_Istio_IngressGatewayDetector) Reset() {
 	*x = DiscoverySettings_Istio_IngressGatewayDetector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[7]
+		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -458,7 +830,7 @@ func (x *DiscoverySettings_Istio_IngressGatewayDetector) String() string {
 func (*DiscoverySettings_Istio_IngressGatewayDetector) ProtoMessage() {}
 
 func (x *DiscoverySettings_Istio_IngressGatewayDetector) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[7]
+	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,7 +843,7 @@ func (x *DiscoverySettings_Istio_IngressGatewayDetector) ProtoReflect() protoref
 
 // Deprecated: Use DiscoverySettings_Istio_IngressGatewayDetector.ProtoReflect.Descriptor instead.
 func (*DiscoverySettings_Istio_IngressGatewayDetector) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{2, 0, 1}
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{5, 0, 1}
 }
 
 func (x *DiscoverySettings_Istio_IngressGatewayDetector) GetGatewayWorkloadLabels() map[string]string {
@@ -488,6 +860,56 @@ func (x *DiscoverySettings_Istio_IngressGatewayDetector) GetGatewayTlsPortName()
 	return ""
 }
 
+// Configure discovery of egress gateways.
+type DiscoverySettings_Istio_EgressGatewayDetector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Workload labels used to detect egress gateways for an Istio deployment.
+	// If not specified, will default to `{"istio": "egressgateway"}`.
+	GatewayWorkloadLabels map[string]string `protobuf:"bytes,1,rep,name=gateway_workload_labels,json=gatewayWorkloadLabels,proto3" json:"gateway_workload_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DiscoverySettings_Istio_EgressGatewayDetector) Reset() {
+	*x = DiscoverySettings_Istio_EgressGatewayDetector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoverySettings_Istio_EgressGatewayDetector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverySettings_Istio_EgressGatewayDetector) ProtoMessage() {}
+
+func (x *DiscoverySettings_Istio_EgressGatewayDetector) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverySettings_Istio_EgressGatewayDetector.ProtoReflect.Descriptor instead.
+func (*DiscoverySettings_Istio_EgressGatewayDetector) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZIP(), []int{5, 0, 3}
+}
+
+func (x *DiscoverySettings_Istio_EgressGatewayDetector) GetGatewayWorkloadLabels() map[string]string {
+	if x != nil {
+		return x.GatewayWorkloadLabels
+	}
+	return nil
+}
+
 var File_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDesc = []byte{
@@ -502,116 +924,218 @@ var file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDesc = [
 	0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f,
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
+	0x1a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
 	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
-	0x2f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6b, 0x76, 0x32, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xd7, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53,
-	0x70, 0x65, 0x63, 0x12, 0x4f, 0x0a, 0x04, 0x6d, 0x74, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x3b, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
-	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70,
-	0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x52, 0x04,
-	0x6d, 0x74, 0x6c, 0x73, 0x12, 0x68, 0x0a, 0x1c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
-	0x6e, 0x67, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x52, 0x1a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x4b,
-	0x0a, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44,
-	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x52, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x3f, 0x0a, 0x05, 0x72,
-	0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x05, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x69, 0x0a, 0x0d,
-	0x52, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x43, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f,
+	0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x73, 0x6b, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7,
+	0x04, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x4f, 0x0a, 0x04, 0x6d, 0x74, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x52, 0x04, 0x6d, 0x74, 0x6c, 0x73,
+	0x12, 0x68, 0x0a, 0x1c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x1a,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x09, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x09, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x3f, 0x0a, 0x05, 0x72, 0x65, 0x6c, 0x61, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x05, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x6a, 0x0a, 0x15, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
 	0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
-	0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0xc8, 0x05, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x49, 0x0a,
-	0x05, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x13, 0x75, 0x73, 0x65, 0x72, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x0f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x5f,
+	0x73, 0x63, 0x6f, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x0e, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x45, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x40, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70,
+	0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68,
+	0x65, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x16, 0x53,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x4b, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x22, 0x55, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45,
+	0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x49,
+	0x45, 0x53, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f,
+	0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x49, 0x45, 0x53, 0x5f, 0x41, 0x4e, 0x44,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x49, 0x45, 0x53, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44,
+	0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0xe6, 0x03, 0x0a, 0x1a, 0x55, 0x73,
+	0x65, 0x72, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x4f, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x7f, 0x0a, 0x15, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x65, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x1a, 0xc8, 0x01, 0x0a, 0x13, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x12, 0x60, 0x0a, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x13, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x4f, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x2b, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a,
+	0x06, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x52,
+	0x47, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x4c, 0x45, 0x47, 0x41, 0x54, 0x45,
+	0x10, 0x02, 0x22, 0x69, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3e, 0x0a,
+	0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0xee, 0x09,
+	0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x49, 0x0a, 0x05, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x05, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x1a, 0x8d,
+	0x09, 0x0a, 0x05, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x12, 0x8c, 0x01, 0x0a, 0x19, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x50, 0x2e, 0x73,
 	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
 	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
 	0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49, 0x73, 0x74, 0x69,
-	0x6f, 0x52, 0x05, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x1a, 0xe7, 0x04, 0x0a, 0x05, 0x49, 0x73, 0x74,
-	0x69, 0x6f, 0x12, 0x8c, 0x01, 0x0a, 0x19, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x50, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x2e, 0x49, 0x6e, 0x67, 0x72,
+	0x6f, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x17,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x18, 0x65, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4f, 0x2e, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x2e,
+	0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x65, 0x67, 0x72,
 	0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x17, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x73, 0x1a, 0x96, 0x01, 0x0a, 0x1c, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x60, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
-	0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xb5, 0x02, 0x0a, 0x16, 0x49,
+	0x6f, 0x72, 0x73, 0x1a, 0x96, 0x01, 0x0a, 0x1c, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x60, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xb5, 0x02, 0x0a,
+	0x16, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x9d, 0x01, 0x0a, 0x17, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x65, 0x2e, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x2e, 0x49,
 	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x9d, 0x01, 0x0a, 0x17, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x57, 0x6f, 0x72,
+	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x15, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61,
+	0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54,
+	0x6c, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x48, 0x0a, 0x1a, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x94, 0x01, 0x0a, 0x1b, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x5f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x2e, 0x45, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x80, 0x02, 0x0a, 0x15,
+	0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x9c, 0x01, 0x0a, 0x17, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
 	0x79, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x65, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x64, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
 	0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
 	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x2e, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c,
-	0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x6c, 0x73,
-	0x50, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x48, 0x0a, 0x1a, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x85, 0x01, 0x0a, 0x0a, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69,
-	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
-	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x72, 0x65, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
-	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a,
-	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4f, 0x6e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x0e, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a,
-	0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
-	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
-	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x48, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f,
-	0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
-	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x2e, 0x45, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
+	0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x1a, 0x48, 0x0a, 0x1a, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x57,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x85,
+	0x01, 0x0a, 0x0a, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x5f, 0x6f, 0x6e, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4f, 0x6e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x42, 0x48, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73,
+	0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -626,36 +1150,58 @@ func file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescGZI
 	return file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_goTypes = []interface{}{
-	(*SettingsSpec)(nil),            // 0: settings.mesh.gloo.solo.io.SettingsSpec
-	(*RelaySettings)(nil),           // 1: settings.mesh.gloo.solo.io.RelaySettings
-	(*DiscoverySettings)(nil),       // 2: settings.mesh.gloo.solo.io.DiscoverySettings
-	(*GrpcServer)(nil),              // 3: settings.mesh.gloo.solo.io.GrpcServer
-	(*SettingsStatus)(nil),          // 4: settings.mesh.gloo.solo.io.SettingsStatus
-	(*DiscoverySettings_Istio)(nil), // 5: settings.mesh.gloo.solo.io.DiscoverySettings.Istio
-	nil,                             // 6: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetectorsEntry
-	(*DiscoverySettings_Istio_IngressGatewayDetector)(nil), // 7: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetector
-	nil,                                      // 8: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetector.GatewayWorkloadLabelsEntry
-	(*v1.TrafficPolicySpec_Policy_MTLS)(nil), // 9: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS
-	(v11.ApprovalState)(0),                   // 10: common.mesh.gloo.solo.io.ApprovalState
+	(SidecarScopingSettings_Mode)(0),                       // 0: settings.mesh.gloo.solo.io.SidecarScopingSettings.Mode
+	(UserVirtualServiceSettings_Mode)(0),                   // 1: settings.mesh.gloo.solo.io.UserVirtualServiceSettings.Mode
+	(*SettingsSpec)(nil),                                   // 2: settings.mesh.gloo.solo.io.SettingsSpec
+	(*MetricsSettings)(nil),                                // 3: settings.mesh.gloo.solo.io.MetricsSettings
+	(*SidecarScopingSettings)(nil),                         // 4: settings.mesh.gloo.solo.io.SidecarScopingSettings
+	(*UserVirtualServiceSettings)(nil),                     // 5: settings.mesh.gloo.solo.io.UserVirtualServiceSettings
+	(*RelaySettings)(nil),                                  // 6: settings.mesh.gloo.solo.io.RelaySettings
+	(*DiscoverySettings)(nil),                              // 7: settings.mesh.gloo.solo.io.DiscoverySettings
+	(*GrpcServer)(nil),                                     // 8: settings.mesh.gloo.solo.io.GrpcServer
+	(*SettingsStatus)(nil),                                 // 9: settings.mesh.gloo.solo.io.SettingsStatus
+	(*UserVirtualServiceSettings_DestinationOverride)(nil), // 10: settings.mesh.gloo.solo.io.UserVirtualServiceSettings.DestinationOverride
+	(*DiscoverySettings_Istio)(nil),                        // 11: settings.mesh.gloo.solo.io.DiscoverySettings.Istio
+	nil,                                                    // 12: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetectorsEntry
+	(*DiscoverySettings_Istio_IngressGatewayDetector)(nil), // 13: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetector
+	nil, // 14: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.EgressGatewayDetectorsEntry
+	(*DiscoverySettings_Istio_EgressGatewayDetector)(nil), // 15: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.EgressGatewayDetector
+	nil,                                      // 16: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetector.GatewayWorkloadLabelsEntry
+	nil,                                      // 17: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.EgressGatewayDetector.GatewayWorkloadLabelsEntry
+	(*v1.TrafficPolicySpec_Policy_MTLS)(nil), // 18: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS
+	(v11.ApprovalState)(0),                   // 19: common.mesh.gloo.solo.io.ApprovalState
+	(*v11.DestinationSelector)(nil),          // 20: common.mesh.gloo.solo.io.DestinationSelector
 }
 var file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_depIdxs = []int32{
-	9,  // 0: settings.mesh.gloo.solo.io.SettingsSpec.mtls:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS
-	3,  // 1: settings.mesh.gloo.solo.io.SettingsSpec.networking_extension_servers:type_name -> settings.mesh.gloo.solo.io.GrpcServer
-	2,  // 2: settings.mesh.gloo.solo.io.SettingsSpec.discovery:type_name -> settings.mesh.gloo.solo.io.DiscoverySettings
-	1,  // 3: settings.mesh.gloo.solo.io.SettingsSpec.relay:type_name -> settings.mesh.gloo.solo.io.RelaySettings
-	3,  // 4: settings.mesh.gloo.solo.io.RelaySettings.server:type_name -> settings.mesh.gloo.solo.io.GrpcServer
-	5,  // 5: settings.mesh.gloo.solo.io.DiscoverySettings.istio:type_name -> settings.mesh.gloo.solo.io.DiscoverySettings.Istio
-	10, // 6: settings.mesh.gloo.solo.io.SettingsStatus.state:type_name -> common.mesh.gloo.solo.io.ApprovalState
-	6,  // 7: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.ingress_gateway_detectors:type_name -> settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetectorsEntry
-	7,  // 8: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetectorsEntry.value:type_name -> settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetector
-	8,  // 9: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetector.gateway_workload_labels:type_name -> settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetector.GatewayWorkloadLabelsEntry
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	18, // 0: settings.mesh.gloo.solo.io.SettingsSpec.mtls:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS
+	8,  // 1: settings.mesh.gloo.solo.io.SettingsSpec.networking_extension_servers:type_name -> settings.mesh.gloo.solo.io.GrpcServer
+	7,  // 2: settings.mesh.gloo.solo.io.SettingsSpec.discovery:type_name -> settings.mesh.gloo.solo.io.DiscoverySettings
+	6,  // 3: settings.mesh.gloo.solo.io.SettingsSpec.relay:type_name -> settings.mesh.gloo.solo.io.RelaySettings
+	5,  // 4: settings.mesh.gloo.solo.io.SettingsSpec.user_virtual_services:type_name -> settings.mesh.gloo.solo.io.UserVirtualServiceSettings
+	4,  // 5: settings.mesh.gloo.solo.io.SettingsSpec.sidecar_scoping:type_name -> settings.mesh.gloo.solo.io.SidecarScopingSettings
+	3,  // 6: settings.mesh.gloo.solo.io.SettingsSpec.metrics:type_name -> settings.mesh.gloo.solo.io.MetricsSettings
+	0,  // 7: settings.mesh.gloo.solo.io.SidecarScopingSettings.mode:type_name -> settings.mesh.gloo.solo.io.SidecarScopingSettings.Mode
+	1,  // 8: settings.mesh.gloo.solo.io.UserVirtualServiceSettings.mode:type_name -> settings.mesh.gloo.solo.io.UserVirtualServiceSettings.Mode
+	10, // 9: settings.mesh.gloo.solo.io.UserVirtualServiceSettings.destination_overrides:type_name -> settings.mesh.gloo.solo.io.UserVirtualServiceSettings.DestinationOverride
+	8,  // 10: settings.mesh.gloo.solo.io.RelaySettings.server:type_name -> settings.mesh.gloo.solo.io.GrpcServer
+	11, // 11: settings.mesh.gloo.solo.io.DiscoverySettings.istio:type_name -> settings.mesh.gloo.solo.io.DiscoverySettings.Istio
+	19, // 12: settings.mesh.gloo.solo.io.SettingsStatus.state:type_name -> common.mesh.gloo.solo.io.ApprovalState
+	20, // 13: settings.mesh.gloo.solo.io.UserVirtualServiceSettings.DestinationOverride.destination_selector:type_name -> common.mesh.gloo.solo.io.DestinationSelector
+	1,  // 14: settings.mesh.gloo.solo.io.UserVirtualServiceSettings.DestinationOverride.mode:type_name -> settings.mesh.gloo.solo.io.UserVirtualServiceSettings.Mode
+	12, // 15: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.ingress_gateway_detectors:type_name -> settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetectorsEntry
+	14, // 16: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.egress_gateway_detectors:type_name -> settings.mesh.gloo.solo.io.DiscoverySettings.Istio.EgressGatewayDetectorsEntry
+	13, // 17: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetectorsEntry.value:type_name -> settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetector
+	16, // 18: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetector.gateway_workload_labels:type_name -> settings.mesh.gloo.solo.io.DiscoverySettings.Istio.IngressGatewayDetector.GatewayWorkloadLabelsEntry
+	15, // 19: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.EgressGatewayDetectorsEntry.value:type_name -> settings.mesh.gloo.solo.io.DiscoverySettings.Istio.EgressGatewayDetector
+	17, // 20: settings.mesh.gloo.solo.io.DiscoverySettings.Istio.EgressGatewayDetector.gateway_workload_labels:type_name -> settings.mesh.gloo.solo.io.DiscoverySettings.Istio.EgressGatewayDetector.GatewayWorkloadLabelsEntry
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_init() }
@@ -677,7 +1223,7 @@ func file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_init() {
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelaySettings); i {
+			switch v := v.(*MetricsSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -689,7 +1235,7 @@ func file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_init() {
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiscoverySettings); i {
+			switch v := v.(*SidecarScopingSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -701,7 +1247,7 @@ func file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_init() {
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GrpcServer); i {
+			switch v := v.(*UserVirtualServiceSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -713,7 +1259,7 @@ func file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_init() {
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SettingsStatus); i {
+			switch v := v.(*RelaySettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -725,7 +1271,19 @@ func file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_init() {
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiscoverySettings_Istio); i {
+			switch v := v.(*DiscoverySettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcServer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -737,6 +1295,42 @@ func file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_init() {
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettingsStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserVirtualServiceSettings_DestinationOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverySettings_Istio); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DiscoverySettings_Istio_IngressGatewayDetector); i {
 			case 0:
 				return &v.state
@@ -748,19 +1342,32 @@ func file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_init() {
 				return nil
 			}
 		}
+		file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverySettings_Istio_EgressGatewayDetector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_goTypes,
 		DependencyIndexes: file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_enumTypes,
 		MessageInfos:      file_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto_msgTypes,
 	}.Build()
 	File_github_com_solo_io_gloo_mesh_api_settings_v1_settings_proto = out.File
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/hashicorp/go-multierror"
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
@@ -12,12 +14,18 @@ import (
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	podbouncer "github.com/solo-io/gloo-mesh/pkg/certificates/agent/reconciliation/pod-bouncer"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/rotation"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	"github.com/solo-io/go-utils/contextutils"
+	skinput "github.com/solo-io/skv2/contrib/pkg/input"
 	"github.com/solo-io/skv2/contrib/pkg/output/errhandlers"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"github.com/solo-io/skv2/pkg/ezkube"
 	"github.com/solo-io/skv2/pkg/reconcile"
+	pkiutil "istio.io/istio/security/pkg/pki/util"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -36,6 +44,11 @@ var (
 		}
 		return labels
 	}
+
+	// rotationEventId is a special identifier for a reconcile event triggered to rotate issued certificates
+	rotationEventId = &skv2corev1.ObjectRef{
+		Name: "certificate-rotation-event",
+	}
 )
 
 type certAgentReconciler struct {
@@ -44,6 +57,9 @@ type certAgentReconciler struct {
 	localClient client.Client
 	podBouncer  podbouncer.PodBouncer
 	translator  translation.Translator
+
+	reconciler    skinput.InputReconciler
+	rotationTimer *time.Timer
 }
 
 func Start(
@@ -61,8 +77,12 @@ func Start(
 		podBouncer:  podBouncer,
 		translator:  translator,
 	}
-	_, err := input.RegisterSingleClusterReconciler(ctx, mgr, d.reconcile, time.Second/2, reconcile.Options{})
-	return err
+	reconciler, err := input.RegisterSingleClusterReconciler(ctx, mgr, d.reconcile, time.Second/2, reconcile.Options{})
+	if err != nil {
+		return err
+	}
+	d.reconciler = reconciler
+	return nil
 }
 
 // reconcile global state
@@ -91,6 +111,11 @@ func (r *certAgentReconciler) reconcile(_ ezkube.ResourceId) (bool, error) {
 		return false, err
 	}
 
+	// reconcile again once the next issued certificate must be rotated
+	if nextRotationEvent, ok := getNextRotationEvent(inputSnap.IssuedCertificates().List()); ok {
+		r.scheduleRotation(time.Until(nextRotationEvent))
+	}
+
 	errHandler := errhandlers.AppendingErrHandler{}
 	outSnap.ApplyLocalCluster(r.ctx, r.localClient, errHandler)
 
@@ -102,7 +127,8 @@ func (r *certAgentReconciler) reconcile(_ ezkube.ResourceId) (bool, error) {
 		errs = multierror.Append(errs, err)
 	}
 
-	return false, errs
+	// requests submitted to external CAs are not watched, so poll them until they are signed
+	return awaitingExternalRequests(inputSnap.IssuedCertificates().List()), errs
 }
 
 // Exposed for testing
@@ -120,6 +146,11 @@ func (r *certAgentReconciler) reconcileIssuedCertificate(
 	// if observed generation is out of sync, treat the issued certificate as Pending (spec has been modified)
 	if issuedCertificate.Status.ObservedGeneration != issuedCertificate.Generation {
 		issuedCertificate.Status.State = certificatesv1.IssuedCertificateStatus_PENDING
+		if rotation.RootRotationInProgress(issuedCertificate.Status.GetRotation()) {
+			// the root CA changed again, restart the root rotation from the currently distributed roots
+			issuedCertificate.Status.Rotation.State = certificatesv1.IssuedCertificateStatus_Rotation_NOT_ROTATING
+			issuedCertificate.Status.Rotation.StateCompleted = false
+		}
 	}
 
 	// reset & update status
@@ -134,6 +165,8 @@ func (r *certAgentReconciler) reconcileIssuedCertificate(
 		if err := r.translator.IssuedCertificateFinished(r.ctx, issuedCertificate, inputSnap, outputs); err != nil {
 			issuedCertificate.Status.State = certificatesv1.IssuedCertificateStatus_FAILED
 			issuedCertificate.Status.Error = err.Error()
+		} else if err := r.updateRotation(issuedCertificate, inputSnap); err != nil {
+			return err
 		}
 
 	case certificatesv1.IssuedCertificateStatus_FAILED:
@@ -146,6 +179,9 @@ func (r *certAgentReconciler) reconcileIssuedCertificate(
 			return err
 		}
 
+		// a new certificate request is submitted to external CAs
+		issuedCertificate.Status.ExternalRequest = nil
+
 		// TODO: Figure out if we want to reuse the certificate request object
 		certificateRequest := &certificatesv1.CertificateRequest{
 			ObjectMeta: metav1.ObjectMeta{
@@ -169,6 +205,10 @@ func (r *certAgentReconciler) reconcileIssuedCertificate(
 			return err
 		}
 
+		if err := r.startRootRotation(issuedCertificate, certificateRequest, inputSnap); err != nil {
+			return err
+		}
+
 		if err := r.translator.IssuedCertificateRequested(
 			r.ctx,
 			issuedCertificate,
@@ -179,6 +219,18 @@ func (r *certAgentReconciler) reconcileIssuedCertificate(
 			return err
 		}
 
+		// wait until the certificate request has been signed, e.g. by the issuer or by an external CA such as Vault.
+		// the previously issued certificate remains in place until then.
+		if !translation.CertificateRequestSigned(certificateRequest) {
+			return nil
+		}
+
+		if rotationStatus := issuedCertificate.Status.GetRotation(); rotationStatus.GetState() == certificatesv1.IssuedCertificateStatus_Rotation_ROTATING {
+			// the translator distributes the roots of both certificates until the previous one expires
+			rotationStatus.State = certificatesv1.IssuedCertificateStatus_Rotation_OVERLAPPING
+			rotationStatus.PreviousNotAfter = rotationStatus.GetNotAfter()
+		}
+
 		issuedCertificate.Status.State = certificatesv1.IssuedCertificateStatus_ISSUED
 	case certificatesv1.IssuedCertificateStatus_ISSUED:
 
@@ -200,6 +252,8 @@ func (r *certAgentReconciler) reconcileIssuedCertificate(
 				inputSnap.Pods(),
 				inputSnap.ConfigMaps(),
 				inputSnap.Secrets(),
+				// restart the pods without downtime when replacing a certificate that is in use
+				issuedCertificate.Status.GetRotation().GetState() != certificatesv1.IssuedCertificateStatus_Rotation_NOT_ROTATING,
 			)
 			if err != nil {
 				return eris.Wrap(err, "bouncing pods")
@@ -212,6 +266,11 @@ func (r *certAgentReconciler) reconcileIssuedCertificate(
 			}
 		}
 
+		// proceed to the next stage of a root rotation once all pods have been restarted
+		if rotationStatus := issuedCertificate.Status.GetRotation(); rotation.RootRotationInProgress(rotationStatus) {
+			return r.progressRootRotation(issuedCertificate, inputSnap)
+		}
+
 		// mark issued certificate as finished
 		issuedCertificate.Status.State = certificatesv1.IssuedCertificateStatus_FINISHED
 	default:
@@ -220,3 +279,189 @@ func (r *certAgentReconciler) reconcileIssuedCertificate(
 
 	return nil
 }
+
+// record the validity of the issued certificate on its status, and restart the workflow
+// to replace the certificate once it enters its rotation grace period
+func (r *certAgentReconciler) updateRotation(
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	inputSnap input.Snapshot,
+) error {
+	now := time.Now()
+
+	if rotation.OverlapEnded(issuedCertificate.Status.GetRotation(), now) {
+		// the translator no longer distributes the roots of the previous certificate
+		issuedCertificate.Status.Rotation.State = certificatesv1.IssuedCertificateStatus_Rotation_NOT_ROTATING
+		issuedCertificate.Status.Rotation.PreviousNotAfter = nil
+	}
+
+	issuedCertificateSecret, err := inputSnap.Secrets().Find(issuedCertificate.Spec.GetIssuedCertificateSecret())
+	if err != nil {
+		return err
+	}
+	caCert := secrets.IntermediateCADataFromSecretData(issuedCertificateSecret.Data).CaCert
+	if len(caCert) == 0 {
+		// the certificate was not written by the agent
+		return nil
+	}
+	cert, err := pkiutil.ParsePemEncodedCertificate(caCert)
+	if err != nil {
+		contextutils.LoggerFrom(r.ctx).Warnf("cannot rotate issued certificate %v, failed to parse the certificate in secret %v: %v",
+			sets.Key(issuedCertificate), sets.Key(issuedCertificateSecret), err)
+		return nil
+	}
+
+	rotationStatus := issuedCertificate.Status.GetRotation()
+	if rotationStatus == nil {
+		rotationStatus = &certificatesv1.IssuedCertificateStatus_Rotation{}
+		issuedCertificate.Status.Rotation = rotationStatus
+	}
+	gracePeriodStart := rotation.GracePeriodStart(cert, issuedCertificate.Spec.GetCertOptions().GetSecretRotationGracePeriodRatio())
+	// the times were parsed from a valid certificate, so conversion cannot fail
+	rotationStatus.NotAfter, _ = ptypes.TimestampProto(cert.NotAfter)
+	rotationStatus.GracePeriodStart, _ = ptypes.TimestampProto(gracePeriodStart)
+
+	if now.Before(gracePeriodStart) {
+		return nil
+	}
+
+	contextutils.LoggerFrom(r.ctx).Infof("issued certificate %v expires at %v, rotating", sets.Key(issuedCertificate), cert.NotAfter)
+	rotationStatus.State = certificatesv1.IssuedCertificateStatus_Rotation_ROTATING
+	issuedCertificate.Status.State = certificatesv1.IssuedCertificateStatus_PENDING
+
+	return r.resetPodBounceDirective(issuedCertificate, inputSnap)
+}
+
+// start a staged rotation if the certificate request was signed by a root CA which is not trusted by the workloads
+// using the previously issued certificate, i.e. the root CA of the issued certificate has been replaced
+func (r *certAgentReconciler) startRootRotation(
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	certificateRequest *certificatesv1.CertificateRequest,
+	inputSnap input.Snapshot,
+) error {
+	if !translation.CertificateRequestSigned(certificateRequest) ||
+		rotation.RootRotationInProgress(issuedCertificate.Status.GetRotation()) {
+		return nil
+	}
+	previousSecret, err := inputSnap.Secrets().Find(issuedCertificate.Spec.GetIssuedCertificateSecret())
+	if err != nil {
+		// the certificate is issued for the first time
+		return nil
+	}
+	previousRootCerts := secrets.IntermediateCADataFromSecretData(previousSecret.Data).RootCert
+	if len(previousRootCerts) == 0 || rotation.ContainsRootCerts(previousRootCerts, certificateRequest.Status.SigningRootCa) {
+		return nil
+	}
+
+	contextutils.LoggerFrom(r.ctx).Infof("root CA of issued certificate %v has changed, rotating", sets.Key(issuedCertificate))
+	if issuedCertificate.Status.Rotation == nil {
+		issuedCertificate.Status.Rotation = &certificatesv1.IssuedCertificateStatus_Rotation{}
+	}
+	issuedCertificate.Status.Rotation.State = certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT
+	issuedCertificate.Status.Rotation.StateCompleted = false
+	return r.resetPodBounceDirective(issuedCertificate, inputSnap)
+}
+
+// advance the root rotation of the issued certificate to its next stage once the pods have been restarted,
+// requesting the translator to write the issued certificate for that stage.
+// The certificates issued to the Meshes of a VirtualMesh enter each stage once every Mesh has completed the previous stage.
+func (r *certAgentReconciler) progressRootRotation(
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	inputSnap input.Snapshot,
+) error {
+	rotationStatus := issuedCertificate.Status.Rotation
+	rotationStatus.StateCompleted = true
+
+	var nextState certificatesv1.IssuedCertificateStatus_Rotation_State
+	switch rotationStatus.GetState() {
+	case certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT:
+		nextState = certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE
+	case certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE:
+		nextState = certificatesv1.IssuedCertificateStatus_Rotation_REMOVING_PREVIOUS_ROOT
+	default:
+		// the previous roots have been removed, the rotation is complete
+		contextutils.LoggerFrom(r.ctx).Infof("root CA of issued certificate %v has been rotated", sets.Key(issuedCertificate))
+		rotationStatus.State = certificatesv1.IssuedCertificateStatus_Rotation_NOT_ROTATING
+		rotationStatus.StateCompleted = false
+		rotationStatus.PreviousNotAfter = nil
+		issuedCertificate.Status.State = certificatesv1.IssuedCertificateStatus_FINISHED
+		return nil
+	}
+
+	if !rotation.RootRotationStateAllowed(issuedCertificate, nextState) {
+		contextutils.LoggerFrom(r.ctx).Debugf("waiting for the other meshes to complete the %v stage of the root rotation of issued certificate %v",
+			rotationStatus.GetState(), sets.Key(issuedCertificate))
+		return nil
+	}
+
+	rotationStatus.State = nextState
+	rotationStatus.StateCompleted = false
+	issuedCertificate.Status.State = certificatesv1.IssuedCertificateStatus_REQUESTED
+	return r.resetPodBounceDirective(issuedCertificate, inputSnap)
+}
+
+// bounce the pods again once the issued certificate has been replaced
+func (r *certAgentReconciler) resetPodBounceDirective(
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	inputSnap input.Snapshot,
+) error {
+	podBounceDirectiveRef := issuedCertificate.Spec.GetPodBounceDirective()
+	if podBounceDirectiveRef == nil {
+		return nil
+	}
+	podBounceDirective, err := inputSnap.PodBounceDirectives().Find(podBounceDirectiveRef)
+	if err != nil {
+		return eris.Wrap(err, "failed to find specified pod bounce directive")
+	}
+	podBounceDirective.Status.PodsBounced = nil
+	return nil
+}
+
+// return the next time at which an issued certificate enters its rotation grace period,
+// or at which the previous certificate of a rotation expires
+func getNextRotationEvent(issuedCertificates certificatesv1.IssuedCertificateSlice) (time.Time, bool) {
+	now := time.Now()
+	var (
+		nextEvent time.Time
+		found     bool
+	)
+	for _, issuedCertificate := range issuedCertificates {
+		rotationStatus := issuedCertificate.Status.GetRotation()
+		for _, event := range []*timestamp.Timestamp{rotationStatus.GetGracePeriodStart(), rotationStatus.GetPreviousNotAfter()} {
+			if event == nil {
+				continue
+			}
+			eventTime, err := ptypes.Timestamp(event)
+			// past events have been handled by the current reconcile
+			if err != nil || !eventTime.After(now) {
+				continue
+			}
+			if !found || eventTime.Before(nextEvent) {
+				nextEvent = eventTime
+				found = true
+			}
+		}
+	}
+	return nextEvent, found
+}
+
+// return true if an issued certificate is waiting for an external CA to sign its certificate request
+func awaitingExternalRequests(issuedCertificates certificatesv1.IssuedCertificateSlice) bool {
+	for _, issuedCertificate := range issuedCertificates {
+		if issuedCertificate.Status.GetState() == certificatesv1.IssuedCertificateStatus_REQUESTED &&
+			issuedCertificate.Status.GetExternalRequest() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// trigger a reconcile after the given duration, replacing any previously scheduled rotation.
+func (r *certAgentReconciler) scheduleRotation(after time.Duration) {
+	if r.rotationTimer != nil {
+		r.rotationTimer.Stop()
+	}
+	r.rotationTimer = time.AfterFunc(after, func() {
+		// ignore error because underlying impl should never error here
+		_, _ = r.reconciler.ReconcileLocalGeneric(rotationEventId)
+	})
+}
@@ -2,8 +2,10 @@ package reconciliation
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/rotisserie/eris"
@@ -13,7 +15,10 @@ import (
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	mock_podbouncer "github.com/solo-io/gloo-mesh/pkg/certificates/agent/reconciliation/pod-bouncer/mocks"
 	mock_translation "github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/rotation"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
 	"github.com/solo-io/skv2/pkg/ezkube"
+	pkiutil "istio.io/istio/security/pkg/pki/util"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -169,6 +174,8 @@ var _ = Describe("CertAgentReconciler", func() {
 				translator: mockTranslator,
 			}
 
+			csr.Status.State = certificatesv1.CertificateRequestStatus_FINISHED
+
 			inputSnap := input.NewInputSnapshotManualBuilder("hello").
 				AddCertificateRequests([]*certificatesv1.CertificateRequest{csr}).
 				Build()
@@ -186,6 +193,99 @@ var _ = Describe("CertAgentReconciler", func() {
 			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_ISSUED))
 		})
 
+		It("Will wait for the replacement certificate to be signed during a rotation", func() {
+
+			reconciler := &certAgentReconciler{
+				ctx:        ctx,
+				podBouncer: mockPodBouncer,
+				translator: mockTranslator,
+			}
+
+			notAfter := ptypes.TimestampNow()
+			issuedCert.Status.Rotation = &certificatesv1.IssuedCertificateStatus_Rotation{
+				State:    certificatesv1.IssuedCertificateStatus_Rotation_ROTATING,
+				NotAfter: notAfter,
+			}
+
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				AddCertificateRequests([]*certificatesv1.CertificateRequest{csr}).
+				Build()
+
+			mockTranslator.EXPECT().
+				ShouldProcess(gomock.Any(), issuedCert).
+				Return(true).
+				Times(2)
+
+			mockTranslator.EXPECT().
+				IssuedCertificateRequested(gomock.Any(), issuedCert, csr, inputSnap, mockOutput).
+				Return(nil).
+				Times(2)
+
+			err := reconciler.reconcileIssuedCertificate(issuedCert, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_REQUESTED))
+			Expect(issuedCert.Status.Rotation.State).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_ROTATING))
+
+			csr.Status.State = certificatesv1.CertificateRequestStatus_FINISHED
+
+			err = reconciler.reconcileIssuedCertificate(issuedCert, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_ISSUED))
+			Expect(issuedCert.Status.Rotation.State).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_OVERLAPPING))
+			Expect(issuedCert.Status.Rotation.PreviousNotAfter).To(Equal(notAfter))
+		})
+
+		It("Will not use a certificate signed for the replaced certificate signing request when a rotation starts", func() {
+
+			reconciler := &certAgentReconciler{
+				ctx:        ctx,
+				podBouncer: mockPodBouncer,
+				translator: mockTranslator,
+			}
+
+			notAfter := ptypes.TimestampNow()
+			issuedCert.Status.Rotation = &certificatesv1.IssuedCertificateStatus_Rotation{
+				State:    certificatesv1.IssuedCertificateStatus_Rotation_ROTATING,
+				NotAfter: notAfter,
+			}
+
+			// the certificate signing request was replaced after the previous certificate had been signed
+			csr.Generation = 2
+			csr.Status = certificatesv1.CertificateRequestStatus{
+				ObservedGeneration: 1,
+				State:              certificatesv1.CertificateRequestStatus_FINISHED,
+				SignedCertificate:  []byte("previous certificate"),
+			}
+
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				AddCertificateRequests([]*certificatesv1.CertificateRequest{csr}).
+				Build()
+
+			mockTranslator.EXPECT().
+				ShouldProcess(gomock.Any(), issuedCert).
+				Return(true).
+				Times(2)
+
+			mockTranslator.EXPECT().
+				IssuedCertificateRequested(gomock.Any(), issuedCert, csr, inputSnap, mockOutput).
+				Return(nil).
+				Times(2)
+
+			err := reconciler.reconcileIssuedCertificate(issuedCert, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_REQUESTED))
+			Expect(issuedCert.Status.Rotation.State).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_ROTATING))
+
+			// the issuer signs the replaced certificate signing request
+			csr.Status.ObservedGeneration = 2
+			csr.Status.SignedCertificate = []byte("replacement certificate")
+
+			err = reconciler.reconcileIssuedCertificate(issuedCert, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_ISSUED))
+			Expect(issuedCert.Status.Rotation.State).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_OVERLAPPING))
+		})
+
 		It("Will not update status when translator.ShouldProcess == false", func() {
 
 			reconciler := &certAgentReconciler{
@@ -206,6 +306,20 @@ var _ = Describe("CertAgentReconciler", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_REQUESTED))
 		})
+
+		It("Will poll while an external CA has not signed the request", func() {
+			issuedCerts := certificatesv1.IssuedCertificateSlice{issuedCert}
+			Expect(awaitingExternalRequests(issuedCerts)).To(BeFalse())
+
+			issuedCert.Status.ExternalRequest = &certificatesv1.IssuedCertificateStatus_ExternalRequest{
+				Name:   issuedCert.Name,
+				Reason: "Pending",
+			}
+			Expect(awaitingExternalRequests(issuedCerts)).To(BeTrue())
+
+			issuedCert.Status.State = certificatesv1.IssuedCertificateStatus_ISSUED
+			Expect(awaitingExternalRequests(issuedCerts)).To(BeFalse())
+		})
 	})
 
 	Context("IssuedCertificateIssued", func() {
@@ -267,7 +381,7 @@ var _ = Describe("CertAgentReconciler", func() {
 				Return(nil)
 
 			mockPodBouncer.EXPECT().
-				BouncePods(gomock.Any(), pbd, pods, configMaps, secrets).
+				BouncePods(gomock.Any(), pbd, pods, configMaps, secrets, false).
 				Return(false, nil)
 
 			err := reconciler.reconcileIssuedCertificate(issuedCert, inputSnap, mockOutput)
@@ -397,5 +511,296 @@ var _ = Describe("CertAgentReconciler", func() {
 			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_FAILED))
 		})
 
+		Context("rotation", func() {
+			var (
+				reconciler         *certAgentReconciler
+				podBounceDirective *certificatesv1.PodBounceDirective
+			)
+
+			// write a certificate issued at the given time with a lifetime of 10 hours to the issued certificate secret
+			issueCertificate := func(notBefore time.Time) {
+				caCert, _, err := pkiutil.GenCertKeyFromOptions(pkiutil.CertOptions{
+					Org:          "org",
+					IsCA:         true,
+					IsSelfSigned: true,
+					NotBefore:    notBefore,
+					TTL:          10 * time.Hour,
+					RSAKeySize:   2048,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				issuedCertSecret.Data = secrets.IntermediateCAData{CaCert: caCert}.ToSecretData()
+			}
+
+			reconcileFinished := func() {
+				inputSnap := input.NewInputSnapshotManualBuilder("hello").
+					AddSecrets([]*corev1.Secret{issuedCertSecret}).
+					AddPodBounceDirectives([]*certificatesv1.PodBounceDirective{podBounceDirective}).
+					Build()
+
+				mockTranslator.EXPECT().
+					ShouldProcess(gomock.Any(), issuedCert).
+					Return(true)
+
+				mockTranslator.EXPECT().
+					IssuedCertificateFinished(gomock.Any(), issuedCert, inputSnap, mockOutput).
+					Return(nil)
+
+				err := reconciler.reconcileIssuedCertificate(issuedCert, inputSnap, mockOutput)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			BeforeEach(func() {
+				reconciler = &certAgentReconciler{
+					ctx:        ctx,
+					podBouncer: mockPodBouncer,
+					translator: mockTranslator,
+				}
+
+				podBounceDirective = &certificatesv1.PodBounceDirective{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "hello",
+						Namespace: "world",
+					},
+					Status: certificatesv1.PodBounceDirectiveStatus{
+						PodsBounced: []*certificatesv1.PodBounceDirectiveStatus_BouncedPodSet{{BouncedPods: []string{"istiod"}}},
+					},
+				}
+
+				issuedCert.Spec.PodBounceDirective = ezkube.MakeObjectRef(podBounceDirective)
+				issuedCert.Spec.CertOptions = &certificatesv1.CommonCertOptions{
+					SecretRotationGracePeriodRatio: 0.2,
+				}
+			})
+
+			It("Will record the expiry of the certificate outside of its grace period", func() {
+				issueCertificate(time.Now().Add(-time.Hour))
+
+				reconcileFinished()
+
+				Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_FINISHED))
+				Expect(issuedCert.Status.Rotation.State).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_NOT_ROTATING))
+				notAfter, err := ptypes.Timestamp(issuedCert.Status.Rotation.NotAfter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(notAfter).To(BeTemporally("~", time.Now().Add(9*time.Hour), time.Minute))
+				gracePeriodStart, err := ptypes.Timestamp(issuedCert.Status.Rotation.GracePeriodStart)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(gracePeriodStart).To(BeTemporally("~", time.Now().Add(7*time.Hour), time.Minute))
+				Expect(podBounceDirective.Status.PodsBounced).To(HaveLen(1))
+			})
+
+			It("Will restart the workflow once the certificate enters its grace period", func() {
+				issueCertificate(time.Now().Add(-9 * time.Hour))
+
+				reconcileFinished()
+
+				Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_PENDING))
+				Expect(issuedCert.Status.Rotation.State).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_ROTATING))
+				Expect(podBounceDirective.Status.PodsBounced).To(BeEmpty())
+			})
+
+			It("Will end the overlap once the previous certificate has expired", func() {
+				issueCertificate(time.Now().Add(-time.Hour))
+				issuedCert.Status.Rotation = &certificatesv1.IssuedCertificateStatus_Rotation{
+					State:            certificatesv1.IssuedCertificateStatus_Rotation_OVERLAPPING,
+					PreviousNotAfter: ptypes.TimestampNow(),
+				}
+
+				reconcileFinished()
+
+				Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_FINISHED))
+				Expect(issuedCert.Status.Rotation.State).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_NOT_ROTATING))
+				Expect(issuedCert.Status.Rotation.PreviousNotAfter).To(BeNil())
+			})
+		})
+	})
+
+	Context("root rotation", func() {
+		var (
+			reconciler         *certAgentReconciler
+			issuedCert         *certificatesv1.IssuedCertificate
+			issuedCertSecret   *corev1.Secret
+			podBounceDirective *certificatesv1.PodBounceDirective
+		)
+
+		genRootCert := func() []byte {
+			rootCert, _, err := pkiutil.GenCertKeyFromOptions(pkiutil.CertOptions{
+				Org:          "org",
+				IsCA:         true,
+				IsSelfSigned: true,
+				TTL:          time.Hour,
+				RSAKeySize:   2048,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return rootCert
+		}
+
+		BeforeEach(func() {
+			reconciler = &certAgentReconciler{
+				ctx:        ctx,
+				podBouncer: mockPodBouncer,
+				translator: mockTranslator,
+			}
+
+			issuedCertSecret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "issued",
+					Namespace: "cert",
+				},
+				Data: secrets.IntermediateCAData{
+					RootCAData: secrets.RootCAData{RootCert: genRootCert()},
+				}.ToSecretData(),
+			}
+
+			podBounceDirective = &certificatesv1.PodBounceDirective{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "hello",
+					Namespace: "world",
+				},
+				Status: certificatesv1.PodBounceDirectiveStatus{
+					PodsBounced: []*certificatesv1.PodBounceDirectiveStatus_BouncedPodSet{{BouncedPods: []string{"istiod"}}},
+				},
+			}
+
+			issuedCert = &certificatesv1.IssuedCertificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "hello",
+					Namespace:  "world",
+					Generation: 2,
+				},
+				Spec: certificatesv1.IssuedCertificateSpec{
+					IssuedCertificateSecret: ezkube.MakeObjectRef(issuedCertSecret),
+					PodBounceDirective:      ezkube.MakeObjectRef(podBounceDirective),
+				},
+				Status: certificatesv1.IssuedCertificateStatus{
+					ObservedGeneration: 2,
+				},
+			}
+		})
+
+		It("Will start a root rotation when the certificate is signed by a new root CA", func() {
+			issuedCert.Status.State = certificatesv1.IssuedCertificateStatus_REQUESTED
+			csr := &certificatesv1.CertificateRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      issuedCert.GetName(),
+					Namespace: issuedCert.GetNamespace(),
+				},
+				Status: certificatesv1.CertificateRequestStatus{
+					State:         certificatesv1.CertificateRequestStatus_FINISHED,
+					SigningRootCa: genRootCert(),
+				},
+			}
+
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				AddCertificateRequests([]*certificatesv1.CertificateRequest{csr}).
+				AddSecrets([]*corev1.Secret{issuedCertSecret}).
+				AddPodBounceDirectives([]*certificatesv1.PodBounceDirective{podBounceDirective}).
+				Build()
+
+			mockTranslator.EXPECT().
+				ShouldProcess(gomock.Any(), issuedCert).
+				Return(true)
+
+			mockTranslator.EXPECT().
+				IssuedCertificateRequested(gomock.Any(), issuedCert, csr, inputSnap, mockOutput).
+				DoAndReturn(func(_ context.Context, issuedCert *certificatesv1.IssuedCertificate, _ *certificatesv1.CertificateRequest, _ input.Snapshot, _ interface{}) error {
+					// the translator must write the certificate for the first stage of the rotation
+					Expect(issuedCert.Status.Rotation.State).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT))
+					return nil
+				})
+
+			err := reconciler.reconcileIssuedCertificate(issuedCert, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_ISSUED))
+			Expect(podBounceDirective.Status.PodsBounced).To(BeEmpty())
+		})
+
+		It("Will proceed to the next stage once the pods have been restarted", func() {
+			issuedCert.Status.State = certificatesv1.IssuedCertificateStatus_ISSUED
+			issuedCert.Status.Rotation = &certificatesv1.IssuedCertificateStatus_Rotation{
+				State: certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT,
+			}
+
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				AddPodBounceDirectives([]*certificatesv1.PodBounceDirective{podBounceDirective}).
+				Build()
+
+			mockTranslator.EXPECT().
+				ShouldProcess(gomock.Any(), issuedCert).
+				Return(true)
+
+			mockTranslator.EXPECT().
+				IssuedCertificateIssued(gomock.Any(), issuedCert, inputSnap, mockOutput).
+				Return(nil)
+
+			mockPodBouncer.EXPECT().
+				BouncePods(gomock.Any(), podBounceDirective, inputSnap.Pods(), inputSnap.ConfigMaps(), inputSnap.Secrets(), true).
+				Return(false, nil)
+
+			err := reconciler.reconcileIssuedCertificate(issuedCert, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_REQUESTED))
+			Expect(issuedCert.Status.Rotation.State).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE))
+			Expect(issuedCert.Status.Rotation.StateCompleted).To(BeFalse())
+			Expect(podBounceDirective.Status.PodsBounced).To(BeEmpty())
+		})
+
+		It("Will wait for the other meshes of the VirtualMesh to complete the stage before proceeding to the next stage", func() {
+			issuedCert.Status.State = certificatesv1.IssuedCertificateStatus_ISSUED
+			issuedCert.Status.Rotation = &certificatesv1.IssuedCertificateStatus_Rotation{
+				State: certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT,
+			}
+			rotation.SetAllowedRootRotationState(issuedCert, certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT)
+
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				AddPodBounceDirectives([]*certificatesv1.PodBounceDirective{podBounceDirective}).
+				Build()
+
+			mockTranslator.EXPECT().
+				ShouldProcess(gomock.Any(), issuedCert).
+				Return(true)
+
+			mockTranslator.EXPECT().
+				IssuedCertificateIssued(gomock.Any(), issuedCert, inputSnap, mockOutput).
+				Return(nil)
+
+			mockPodBouncer.EXPECT().
+				BouncePods(gomock.Any(), podBounceDirective, inputSnap.Pods(), inputSnap.ConfigMaps(), inputSnap.Secrets(), true).
+				Return(false, nil)
+
+			err := reconciler.reconcileIssuedCertificate(issuedCert, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_ISSUED))
+			Expect(issuedCert.Status.Rotation.State).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT))
+			Expect(issuedCert.Status.Rotation.StateCompleted).To(BeTrue())
+		})
+
+		It("Will finish the rotation once the previous root has been removed", func() {
+			issuedCert.Status.State = certificatesv1.IssuedCertificateStatus_ISSUED
+			issuedCert.Status.Rotation = &certificatesv1.IssuedCertificateStatus_Rotation{
+				State: certificatesv1.IssuedCertificateStatus_Rotation_REMOVING_PREVIOUS_ROOT,
+			}
+
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				AddPodBounceDirectives([]*certificatesv1.PodBounceDirective{podBounceDirective}).
+				Build()
+
+			mockTranslator.EXPECT().
+				ShouldProcess(gomock.Any(), issuedCert).
+				Return(true)
+
+			mockTranslator.EXPECT().
+				IssuedCertificateIssued(gomock.Any(), issuedCert, inputSnap, mockOutput).
+				Return(nil)
+
+			mockPodBouncer.EXPECT().
+				BouncePods(gomock.Any(), podBounceDirective, inputSnap.Pods(), inputSnap.ConfigMaps(), inputSnap.Secrets(), true).
+				Return(false, nil)
+
+			err := reconciler.reconcileIssuedCertificate(issuedCert, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_FINISHED))
+			Expect(issuedCert.Status.Rotation.State).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_NOT_ROTATING))
+			Expect(podBounceDirective.Status.PodsBounced).To(HaveLen(1))
+		})
 	})
 })
@@ -3,23 +3,37 @@ package podbouncer
 import (
 	"bytes"
 	"context"
+	"encoding/pem"
+	"strings"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	appsv1client "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1"
 	corev1client "github.com/solo-io/external-apis/pkg/api/k8s/core/v1"
 	corev1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/rotation"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"github.com/solo-io/skv2/pkg/ezkube"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 //go:generate mockgen -source ./pod_bouncer.go -destination mocks/pod_bouncer.go
 
+// the annotation set on pod templates by `kubectl rollout restart`
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
 type RootCertMatcher interface {
 	MatchesRootCert(
 		ctx context.Context,
@@ -29,11 +43,12 @@ type RootCertMatcher interface {
 	) (matches bool, err error)
 }
 
-// bounce (delete) the listed pods
+// bounce (delete) the listed pods, or restart them by a rollout of their controllers if rollout is true
 // returns true if we need to wait before proceeding to process the podBounceDirective.
 // we must wait for the following conditions:
 // 1. istiod control plane has come back online after it has been restarted
 // 2. istio's root cert has been propagated to all istio-controlled namespaces for consumption by the data plane.
+// 3. when rolling out, the controllers of the pods have replaced all of their pods.
 // this should cause the reconcile to end early and persist the IssuedCertificate in the Issued state
 type PodBouncer interface {
 	BouncePods(
@@ -42,31 +57,35 @@ type PodBouncer interface {
 		pods corev1sets.PodSet,
 		configMaps corev1sets.ConfigMapSet,
 		secrets corev1sets.SecretSet,
+		rollout bool,
 	) (bool, error)
 }
 
 func NewPodBouncer(
 	podClient corev1client.PodClient,
+	appsClientset appsv1client.Clientset,
 	rootCertMatcher RootCertMatcher,
 ) PodBouncer {
 	return &podBouncer{
 		podClient:       podClient,
+		appsClientset:   appsClientset,
 		rootCertMatcher: rootCertMatcher,
 	}
 }
 
 type podBouncer struct {
 	podClient       corev1client.PodClient
+	appsClientset   appsv1client.Clientset
 	rootCertMatcher RootCertMatcher
 }
 
-// TODO: Figure out how to bounce pods without any downtime
 func (p *podBouncer) BouncePods(
 	ctx context.Context,
 	podBounceDirective *certificatesv1.PodBounceDirective,
 	pods corev1sets.PodSet,
 	configMaps corev1sets.ConfigMapSet,
 	secrets corev1sets.SecretSet,
+	rollout bool,
 ) (bool, error) {
 
 	var errs error
@@ -78,6 +97,18 @@ func (p *podBouncer) BouncePods(
 			// the minimum number of replacement pods are ready before moving on with the deletion
 			podsBounced := podBounceDirective.Status.PodsBounced[i]
 
+			if podsBounced.GetRolloutTime() != nil {
+				rolloutTime, _ := ptypes.Timestamp(podsBounced.GetRolloutTime())
+				if !rolloutComplete(pods, selector, rolloutTime, podsBounced.GetRestartedControllers()) {
+					contextutils.LoggerFrom(ctx).Debugf("podBounceDirective %v: waiting for rollout of pods for selector %v", sets.Key(podBounceDirective), selector)
+
+					// wait for a future update to the Pods in the input snapshot
+					return true, errs
+				}
+
+				continue
+			}
+
 			// if all required replicas are not ready, return true to indicate we should halt processing
 			// of the directive here in order to wait for a future update to the Pods in the input snapshot.
 			if !replacementsReady(pods, selector, podsBounced.BouncedPods) {
@@ -133,6 +164,31 @@ func (p *podBouncer) BouncePods(
 			return !isPodSelected(pod, selector)
 		})
 
+		if rollout {
+			rolloutTime := time.Now().Truncate(time.Second)
+			restartedPods, restartedControllers, err := p.restartPods(ctx, podsToDelete, rolloutTime)
+			if err != nil {
+				// do not record the rollout, so that the pods of this selector are restarted again on the next reconcile
+				return true, multierror.Append(errs, err)
+			}
+			rolloutTimestamp, _ := ptypes.TimestampProto(rolloutTime)
+			podBounceDirective.Status.PodsBounced = append(
+				podBounceDirective.Status.PodsBounced,
+				&certificatesv1.PodBounceDirectiveStatus_BouncedPodSet{
+					BouncedPods:          restartedPods,
+					RolloutTime:          rolloutTimestamp,
+					RestartedControllers: restartedControllers,
+				},
+			)
+
+			if len(restartedControllers) > 0 {
+				// wait for the rollout to complete before restarting the pods of the next selector,
+				// so that at most one set of pods is being replaced at a time
+				return true, errs
+			}
+			continue
+		}
+
 		var bouncedPods []string
 		for _, pod := range podsToDelete {
 			contextutils.LoggerFrom(ctx).Debugf("deleting pod %v", sets.Key(pod))
@@ -159,6 +215,171 @@ func (p *podBouncer) BouncePods(
 	return false, errs
 }
 
+// restart the given pods by a rollout of their controllers, deleting the pods whose controller cannot be rolled out.
+// returns the names of the restarted pods and the restarted controllers.
+func (p *podBouncer) restartPods(
+	ctx context.Context,
+	pods []*corev1.Pod,
+	rolloutTime time.Time,
+) ([]string, []*skv2corev1.TypedObjectRef, error) {
+	var errs error
+	var restartedPods []string
+	var restartedControllers []*skv2corev1.TypedObjectRef
+	// the result of restarting each controller, to restart each controller once
+	controllerRestarted := map[string]bool{}
+	for _, pod := range pods {
+		controllerKind, controllerName, err := p.getRolloutController(ctx, pod)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+		if controllerKind == "" {
+			contextutils.LoggerFrom(ctx).Debugf("deleting pod %v", sets.Key(pod))
+			if err := p.podClient.DeletePod(ctx, ezkube.MakeClientObjectKey(pod)); err != nil {
+				errs = multierror.Append(errs, err)
+				continue
+			}
+			restartedPods = append(restartedPods, pod.Name)
+			continue
+		}
+
+		key := controllerKey(controllerKind, pod.Namespace, controllerName)
+		restarted, attempted := controllerRestarted[key]
+		if !attempted {
+			err := p.restartController(ctx, controllerKind, client.ObjectKey{Name: controllerName, Namespace: pod.Namespace}, rolloutTime)
+			if err != nil {
+				errs = multierror.Append(errs, err)
+			} else {
+				restartedControllers = append(restartedControllers, &skv2corev1.TypedObjectRef{
+					Kind:      &wrappers.StringValue{Value: controllerKind},
+					Name:      controllerName,
+					Namespace: pod.Namespace,
+				})
+			}
+			restarted = err == nil
+			controllerRestarted[key] = restarted
+		}
+		if restarted {
+			restartedPods = append(restartedPods, pod.Name)
+		}
+	}
+	return restartedPods, restartedControllers, errs
+}
+
+// return the kind and name of the controller which restarts the pod by a rollout,
+// or an empty kind if the pod has no such controller and must be deleted instead
+func (p *podBouncer) getRolloutController(ctx context.Context, pod *corev1.Pod) (string, string, error) {
+	controllerRef := metav1.GetControllerOf(pod)
+	if controllerRef == nil {
+		return "", "", nil
+	}
+	switch controllerRef.Kind {
+	case "ReplicaSet":
+		// restart the Deployment which owns the ReplicaSet
+		replicaSet, err := p.appsClientset.ReplicaSets().GetReplicaSet(ctx, client.ObjectKey{Name: controllerRef.Name, Namespace: pod.Namespace})
+		if err != nil {
+			return "", "", err
+		}
+		deploymentRef := metav1.GetControllerOf(replicaSet)
+		if deploymentRef == nil || deploymentRef.Kind != "Deployment" {
+			contextutils.LoggerFrom(ctx).Debugf("cannot roll out pod %v: ReplicaSet %v is not owned by a Deployment", sets.Key(pod), controllerRef.Name)
+			return "", "", nil
+		}
+		return deploymentRef.Kind, deploymentRef.Name, nil
+	case "StatefulSet", "DaemonSet":
+		return controllerRef.Kind, controllerRef.Name, nil
+	}
+	contextutils.LoggerFrom(ctx).Debugf("cannot roll out pod %v: pods controlled by a %v cannot be restarted", sets.Key(pod), controllerRef.Kind)
+	return "", "", nil
+}
+
+func controllerKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// restart the pods of the controller in the same way as `kubectl rollout restart`,
+// i.e. by setting the restartedAt annotation on its pod template
+func (p *podBouncer) restartController(ctx context.Context, kind string, key client.ObjectKey, rolloutTime time.Time) error {
+	contextutils.LoggerFrom(ctx).Debugf("restarting %v %v", kind, key)
+	restartedAt := rolloutTime.Format(time.RFC3339)
+	switch kind {
+	case "Deployment":
+		deployment, err := p.appsClientset.Deployments().GetDeployment(ctx, key)
+		if err != nil {
+			return err
+		}
+		patch := client.MergeFrom(deployment.DeepCopy())
+		setRestartedAt(&deployment.Spec.Template, restartedAt)
+		return p.appsClientset.Deployments().PatchDeployment(ctx, deployment, patch)
+	case "StatefulSet":
+		statefulSet, err := p.appsClientset.StatefulSets().GetStatefulSet(ctx, key)
+		if err != nil {
+			return err
+		}
+		patch := client.MergeFrom(statefulSet.DeepCopy())
+		setRestartedAt(&statefulSet.Spec.Template, restartedAt)
+		return p.appsClientset.StatefulSets().PatchStatefulSet(ctx, statefulSet, patch)
+	case "DaemonSet":
+		daemonSet, err := p.appsClientset.DaemonSets().GetDaemonSet(ctx, key)
+		if err != nil {
+			return err
+		}
+		patch := client.MergeFrom(daemonSet.DeepCopy())
+		setRestartedAt(&daemonSet.Spec.Template, restartedAt)
+		return p.appsClientset.DaemonSets().PatchDaemonSet(ctx, daemonSet, patch)
+	}
+	return eris.Errorf("cannot restart pods controlled by %v %v", kind, key)
+}
+
+func setRestartedAt(template *corev1.PodTemplateSpec, restartedAt string) {
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[restartedAtAnnotation] = restartedAt
+}
+
+// indicates whether all selected pods of the restarted controllers have been restarted by a rollout
+// at or after the rollout time, and are ready
+func rolloutComplete(
+	currentPods corev1sets.PodSet,
+	podSelector *certificatesv1.PodBounceDirectiveSpec_PodSelector,
+	rolloutTime time.Time,
+	restartedControllers []*skv2corev1.TypedObjectRef,
+) bool {
+	restarted := map[string]bool{}
+	for _, controller := range restartedControllers {
+		restarted[controllerKey(controller.GetKind().GetValue(), controller.GetNamespace(), controller.GetName())] = true
+	}
+	for _, pod := range currentPods.List() {
+		if !isPodSelected(pod, podSelector) || pod.DeletionTimestamp != nil {
+			// terminating pods are not replaced by the rollout
+			continue
+		}
+		if controllerKind, controllerName := getPodController(pod); !restarted[controllerKey(controllerKind, pod.Namespace, controllerName)] {
+			// pods of controllers which were not restarted are not replaced by the rollout
+			continue
+		}
+		restartedAt, err := time.Parse(time.RFC3339, pod.Annotations[restartedAtAnnotation])
+		if err != nil || restartedAt.Before(rolloutTime) || !podutil.IsPodReady(pod) {
+			return false
+		}
+	}
+	return true
+}
+
+// return the kind and name of the controller which owns the pod, resolving ReplicaSets to the Deployment which owns them
+// by the pod-template-hash label which the Deployment sets on the pods of its ReplicaSets
+func getPodController(pod *corev1.Pod) (string, string) {
+	controllerRef := metav1.GetControllerOf(pod)
+	if controllerRef == nil {
+		return "", ""
+	}
+	if podTemplateHash, ok := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; controllerRef.Kind == "ReplicaSet" && ok {
+		return "Deployment", strings.TrimSuffix(controllerRef.Name, "-"+podTemplateHash)
+	}
+	return controllerRef.Kind, controllerRef.Name
+}
+
 // indicates whether replacements for the deleted pods to be ready
 func replacementsReady(
 	currentPods corev1sets.PodSet,
@@ -208,5 +429,12 @@ func (s *secretRootCertMatcher) MatchesRootCert(
 		return false, err
 	}
 
-	return bytes.Equal(secret.Data[selector.RootCertSync.SecretKey], rootCert), nil
+	publishedRootCert := secret.Data[selector.RootCertSync.SecretKey]
+	if block, _ := pem.Decode(rootCert); block == nil {
+		return bytes.Equal(publishedRootCert, rootCert), nil
+	}
+
+	// compare the decoded certificates, as the mesh may re-encode the root cert it publishes
+	return rotation.ContainsRootCerts(publishedRootCert, rootCert) &&
+		rotation.ContainsRootCerts(rootCert, publishedRootCert), nil
 }
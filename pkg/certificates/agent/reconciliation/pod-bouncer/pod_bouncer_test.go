@@ -2,16 +2,26 @@ package podbouncer_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	appsv1client "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1/mocks"
 	corev1client "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/mocks"
 	corev1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	. "github.com/solo-io/gloo-mesh/pkg/certificates/agent/reconciliation/pod-bouncer"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/keys"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"istio.io/istio/security/pkg/pki/util"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var _ = Describe("PodBouncer", func() {
@@ -19,12 +29,20 @@ var _ = Describe("PodBouncer", func() {
 		ctrl *gomock.Controller
 		ctx  context.Context
 
-		podClientMock *corev1client.MockPodClient
+		podClientMock        *corev1client.MockPodClient
+		appsClientsetMock    *appsv1client.MockClientset
+		deploymentClientMock *appsv1client.MockDeploymentClient
+		replicaSetClientMock *appsv1client.MockReplicaSetClient
 	)
 
 	BeforeEach(func() {
 		ctrl, ctx = gomock.WithContext(context.Background(), GinkgoT())
 		podClientMock = corev1client.NewMockPodClient(ctrl)
+		appsClientsetMock = appsv1client.NewMockClientset(ctrl)
+		deploymentClientMock = appsv1client.NewMockDeploymentClient(ctrl)
+		replicaSetClientMock = appsv1client.NewMockReplicaSetClient(ctrl)
+		appsClientsetMock.EXPECT().Deployments().Return(deploymentClientMock).AnyTimes()
+		appsClientsetMock.EXPECT().ReplicaSets().Return(replicaSetClientMock).AnyTimes()
 	})
 
 	AfterEach(func() {
@@ -33,7 +51,7 @@ var _ = Describe("PodBouncer", func() {
 
 	It("can signal that bounced pods are not ready", func() {
 		// Don't mock this dependency as we want to test together
-		podBouncer := NewPodBouncer(podClientMock, NewSecretRootCertMatcher())
+		podBouncer := NewPodBouncer(podClientMock, appsClientsetMock, NewSecretRootCertMatcher())
 
 		pbd := &certificatesv1.PodBounceDirective{
 			Spec: certificatesv1.PodBounceDirectiveSpec{
@@ -69,8 +87,243 @@ var _ = Describe("PodBouncer", func() {
 			},
 		)
 
-		wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+		wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil, false)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(wait).To(BeTrue())
 	})
+
+	Context("rollout", func() {
+		var (
+			podBouncer PodBouncer
+			pbd        *certificatesv1.PodBounceDirective
+
+			isController     = true
+			istiodReplicaSet = &appsv1.ReplicaSet{
+				ObjectMeta: metav1.ObjectMeta{
+					OwnerReferences: []metav1.OwnerReference{{
+						Kind:       "Deployment",
+						Name:       "istiod",
+						Controller: &isController,
+					}},
+				},
+			}
+			istiodDeployment = &skv2corev1.TypedObjectRef{
+				Kind:      &wrappers.StringValue{Value: "Deployment"},
+				Name:      "istiod",
+				Namespace: "istio-system",
+			}
+		)
+
+		ownedPod := func(name, ownerKind, ownerName string) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "istio-system",
+					Labels:    map[string]string{"app": "istiod"},
+					OwnerReferences: []metav1.OwnerReference{{
+						Kind:       ownerKind,
+						Name:       ownerName,
+						Controller: &isController,
+					}},
+				},
+			}
+		}
+
+		// a pod of a ReplicaSet of the istiod Deployment
+		controlledPod := func(podTemplateHash, name, restartedAt string, ready bool) *corev1.Pod {
+			pod := ownedPod(name, "ReplicaSet", "istiod-"+podTemplateHash)
+			pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey] = podTemplateHash
+			if restartedAt != "" {
+				pod.Annotations = map[string]string{"kubectl.kubernetes.io/restartedAt": restartedAt}
+			}
+			if ready {
+				pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+			}
+			return pod
+		}
+
+		BeforeEach(func() {
+			podBouncer = NewPodBouncer(podClientMock, appsClientsetMock, NewSecretRootCertMatcher())
+			pbd = &certificatesv1.PodBounceDirective{
+				Spec: certificatesv1.PodBounceDirectiveSpec{
+					PodsToBounce: []*certificatesv1.PodBounceDirectiveSpec_PodSelector{
+						{
+							Namespace:       "istio-system",
+							Labels:          map[string]string{"app": "istiod"},
+							WaitForReplicas: 1,
+						},
+					},
+				},
+			}
+		})
+
+		It("restarts the controllers of the pods instead of deleting them", func() {
+			replicaSetClientMock.EXPECT().
+				GetReplicaSet(ctx, client.ObjectKey{Name: "istiod-5d8f", Namespace: "istio-system"}).
+				Return(istiodReplicaSet, nil).
+				Times(2)
+			deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "istiod", Namespace: "istio-system"}}
+			deploymentClientMock.EXPECT().
+				GetDeployment(ctx, client.ObjectKey{Name: "istiod", Namespace: "istio-system"}).
+				Return(deployment, nil)
+			deploymentClientMock.EXPECT().
+				PatchDeployment(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, patched *appsv1.Deployment, _ client.Patch, _ ...client.PatchOption) error {
+					Expect(patched.Spec.Template.Annotations).To(HaveKey("kubectl.kubernetes.io/restartedAt"))
+					return nil
+				})
+
+			pods := corev1sets.NewPodSet(
+				controlledPod("5d8f", "istiod-5d8f-a", "", true),
+				controlledPod("5d8f", "istiod-5d8f-b", "", true),
+			)
+
+			wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+			Expect(pbd.Status.PodsBounced).To(HaveLen(1))
+			Expect(pbd.Status.PodsBounced[0].BouncedPods).To(ConsistOf("istiod-5d8f-a", "istiod-5d8f-b"))
+			Expect(pbd.Status.PodsBounced[0].RolloutTime).NotTo(BeNil())
+			Expect(pbd.Status.PodsBounced[0].RestartedControllers).To(Equal([]*skv2corev1.TypedObjectRef{istiodDeployment}))
+		})
+
+		It("deletes the pods whose controller cannot be rolled out, and does not wait for their rollout", func() {
+			replicaSetClientMock.EXPECT().
+				GetReplicaSet(ctx, client.ObjectKey{Name: "istiod-5d8f", Namespace: "istio-system"}).
+				Return(&appsv1.ReplicaSet{}, nil)
+
+			replicaSetPod := ownedPod("istiod-5d8f-a", "ReplicaSet", "istiod-5d8f")
+			jobPod := ownedPod("istiod-job-a", "Job", "istiod-job")
+			podClientMock.EXPECT().DeletePod(ctx, client.ObjectKey{Name: "istiod-5d8f-a", Namespace: "istio-system"})
+			podClientMock.EXPECT().DeletePod(ctx, client.ObjectKey{Name: "istiod-job-a", Namespace: "istio-system"})
+
+			pods := corev1sets.NewPodSet(replicaSetPod, jobPod)
+			wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeFalse())
+			Expect(pbd.Status.PodsBounced).To(HaveLen(1))
+			Expect(pbd.Status.PodsBounced[0].BouncedPods).To(ConsistOf("istiod-5d8f-a", "istiod-job-a"))
+			Expect(pbd.Status.PodsBounced[0].RestartedControllers).To(BeEmpty())
+
+			// the pods which were not restarted by the rollout do not block the directive
+			wait, err = podBouncer.BouncePods(ctx, pbd, pods, nil, nil, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeFalse())
+		})
+
+		It("does not record the rollout until the controllers are restarted", func() {
+			replicaSetClientMock.EXPECT().
+				GetReplicaSet(ctx, client.ObjectKey{Name: "istiod-5d8f", Namespace: "istio-system"}).
+				Return(istiodReplicaSet, nil).
+				Times(2)
+			deploymentClientMock.EXPECT().
+				GetDeployment(ctx, client.ObjectKey{Name: "istiod", Namespace: "istio-system"}).
+				Return(nil, eris.New("unavailable"))
+
+			pods := corev1sets.NewPodSet(controlledPod("5d8f", "istiod-5d8f-a", "", true))
+			wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil, true)
+			Expect(err).To(HaveOccurred())
+			Expect(wait).To(BeTrue())
+			Expect(pbd.Status.PodsBounced).To(BeEmpty())
+
+			deploymentClientMock.EXPECT().
+				GetDeployment(ctx, client.ObjectKey{Name: "istiod", Namespace: "istio-system"}).
+				Return(&appsv1.Deployment{}, nil)
+			deploymentClientMock.EXPECT().
+				PatchDeployment(ctx, gomock.Any(), gomock.Any())
+
+			wait, err = podBouncer.BouncePods(ctx, pbd, pods, nil, nil, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+			Expect(pbd.Status.PodsBounced).To(HaveLen(1))
+			Expect(pbd.Status.PodsBounced[0].RestartedControllers).To(Equal([]*skv2corev1.TypedObjectRef{istiodDeployment}))
+		})
+
+		It("waits until all pods have been restarted by the rollout", func() {
+			rolloutTime := time.Now().Truncate(time.Second)
+			rolloutTimestamp, _ := ptypes.TimestampProto(rolloutTime)
+			pbd.Status.PodsBounced = []*certificatesv1.PodBounceDirectiveStatus_BouncedPodSet{
+				{
+					BouncedPods:          []string{"istiod-5d8f-a"},
+					RolloutTime:          rolloutTimestamp,
+					RestartedControllers: []*skv2corev1.TypedObjectRef{istiodDeployment},
+				},
+			}
+			restartedAt := rolloutTime.Format(time.RFC3339)
+
+			oldPod := controlledPod("5d8f", "istiod-5d8f-a", "", true)
+			wait, err := podBouncer.BouncePods(ctx, pbd, corev1sets.NewPodSet(
+				oldPod,
+				controlledPod("7c9a", "istiod-7c9a-a", restartedAt, true),
+			), nil, nil, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+
+			// terminating pods are not replaced
+			now := metav1.Now()
+			oldPod.DeletionTimestamp = &now
+			wait, err = podBouncer.BouncePods(ctx, pbd, corev1sets.NewPodSet(
+				oldPod,
+				controlledPod("7c9a", "istiod-7c9a-a", restartedAt, false),
+			), nil, nil, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+
+			wait, err = podBouncer.BouncePods(ctx, pbd, corev1sets.NewPodSet(
+				oldPod,
+				controlledPod("7c9a", "istiod-7c9a-a", restartedAt, true),
+			), nil, nil, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeFalse())
+		})
+	})
+
+	Context("root cert matcher", func() {
+		var selector *certificatesv1.PodBounceDirectiveSpec_PodSelector
+
+		generateRoot := func() []byte {
+			key, err := keys.GeneratePrivateKey(certificatesv1.CommonCertOptions_ECDSA_P256, 0)
+			Expect(err).NotTo(HaveOccurred())
+			rootCert, _, err := util.GenRootCertFromExistingKey(util.CertOptions{
+				Org:           "org",
+				IsCA:          true,
+				IsSelfSigned:  true,
+				TTL:           time.Hour,
+				SignerPrivPem: key,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return rootCert
+		}
+
+		secretsWithRootCert := func(rootCert []byte) corev1sets.SecretSet {
+			return corev1sets.NewSecretSet(&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "istio-ca-root-cert", Namespace: "istio-system"},
+				Data:       map[string][]byte{"root-cert.pem": rootCert},
+			})
+		}
+
+		BeforeEach(func() {
+			selector = &certificatesv1.PodBounceDirectiveSpec_PodSelector{
+				RootCertSync: &certificatesv1.PodBounceDirectiveSpec_PodSelector_RootCertSync{
+					SecretRef: &skv2corev1.ObjectRef{Name: "istio-ca-root-cert", Namespace: "istio-system"},
+					SecretKey: "root-cert.pem",
+				},
+			}
+		})
+
+		It("matches the published root cert regardless of its encoding", func() {
+			rootCert := generateRoot()
+			publishedRootCert := append([]byte("# published by istiod\n"), rootCert...)
+
+			matches, err := NewSecretRootCertMatcher().MatchesRootCert(ctx, rootCert, selector, secretsWithRootCert(publishedRootCert))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matches).To(BeTrue())
+		})
+
+		It("does not match a different root cert", func() {
+			matches, err := NewSecretRootCertMatcher().MatchesRootCert(ctx, generateRoot(), selector, secretsWithRootCert(generateRoot()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matches).To(BeFalse())
+		})
+	})
 })
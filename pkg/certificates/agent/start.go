@@ -3,11 +3,15 @@ package agent
 import (
 	"context"
 
+	appsv1clients "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1"
 	corev1clients "github.com/solo-io/external-apis/pkg/api/k8s/core/v1"
 	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/reconciliation"
 	podbouncer "github.com/solo-io/gloo-mesh/pkg/certificates/agent/reconciliation/pod-bouncer"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation/certmanager"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation/vault"
 	"github.com/solo-io/gloo-mesh/pkg/common/schemes"
 	"github.com/solo-io/skv2/pkg/bootstrap"
 )
@@ -37,10 +41,21 @@ func StartFuncExt(makeExtensionOpts MakeExtensionOpts) bootstrap.StartFunc {
 
 		snapshotBuilder := input.NewSingleClusterBuilder(parameters.MasterManager)
 
-		translator := translation.NewCertAgentTranslator()
+		// sign certificate requests with Vault or cert-manager intermediate CAs before writing the issued certificates
+		certificateRequestClient := certificatesv1.NewCertificateRequestClient(parameters.MasterManager.GetClient())
+		translator := certmanager.NewTranslator(
+			vault.NewTranslator(
+				translation.NewCertAgentTranslator(),
+				vault.NewSigner(),
+				certificateRequestClient,
+			),
+			parameters.MasterManager.GetClient(),
+			certificateRequestClient,
+		)
 
 		podBounder := podbouncer.NewPodBouncer(
 			corev1clients.NewPodClient(parameters.MasterManager.GetClient()),
+			appsv1clients.NewClientset(parameters.MasterManager.GetClient()),
 			extOpts.RootCertMatcher,
 		)
 
@@ -2,18 +2,24 @@ package translation
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"time"
 
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
 	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/output/certagent"
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/utils"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/keys"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/rotation"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
+	pkiutil "istio.io/istio/security/pkg/pki/util"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -41,6 +47,39 @@ func IssuedCertificateSecretType() corev1.SecretType {
 	return corev1.SecretType(fmt.Sprintf("%s/issued_certificate", certificatesv1.SchemeGroupVersion.Group))
 }
 
+func GatewayCredentialSecretType() corev1.SecretType {
+	return corev1.SecretType(fmt.Sprintf("%s/gateway_credential", certificatesv1.SchemeGroupVersion.Group))
+}
+
+// CertificateRequestSigned returns true if the certificate request has been signed since its certificate signing request was last written.
+// The status of a certificate request whose certificate signing request has been replaced belongs to the previous request.
+func CertificateRequestSigned(certificateRequest *certificatesv1.CertificateRequest) bool {
+	return certificateRequest.Status.State == certificatesv1.CertificateRequestStatus_FINISHED &&
+		certificateRequest.Status.ObservedGeneration == certificateRequest.Generation
+}
+
+// SignCertificateRequest records the certificate signed by an external CA on the status of the certificate request.
+// The signed certificate is written once the status of the certificate request has been persisted,
+// in the same way as for certificate requests signed by the issuer.
+func SignCertificateRequest(
+	ctx context.Context,
+	certificateRequestClient certificatesv1.CertificateRequestClient,
+	certificateRequest *certificatesv1.CertificateRequest,
+	signedCertificate, signingRootCa []byte,
+) error {
+	signedRequest := certificateRequest.DeepCopy()
+	signedRequest.Status = certificatesv1.CertificateRequestStatus{
+		ObservedGeneration: certificateRequest.Generation,
+		State:              certificatesv1.CertificateRequestStatus_FINISHED,
+		SignedCertificate:  signedCertificate,
+		SigningRootCa:      signingRootCa,
+	}
+	if err := certificateRequestClient.UpdateCertificateRequestStatus(ctx, signedRequest); err != nil {
+		return eris.Wrapf(err, "failed to update status of certificate request %v", sets.Key(certificateRequest))
+	}
+	return nil
+}
+
 //go:generate mockgen -source ./cert_agent_translator.go -destination mocks/translator.go
 
 // These functions correspond to issued certiticate statuses
@@ -110,12 +149,18 @@ func (c *certAgentTranslator) ShouldProcess(ctx context.Context, issuedCertifica
 func (c *certAgentTranslator) IssuedCertiticatePending(
 	ctx context.Context,
 	issuedCertificate *certificatesv1.IssuedCertificate,
-	_ input.Snapshot,
+	inputs input.Snapshot,
 	outputs certagent.Builder,
 ) ([]byte, error) {
 
+	// keep the previously issued certificate in place until it is replaced, e.g. during a rotation
+	addPreviouslyIssuedSecrets(issuedCertificate, inputs, outputs)
+
 	// create a new private key
-	privateKey, err := utils.GeneratePrivateKey(int(issuedCertificate.Spec.CertOptions.GetRsaKeySizeBytes()))
+	privateKey, err := keys.GeneratePrivateKey(
+		issuedCertificate.Spec.GetCertOptions().GetKeyAlgorithm(),
+		int(issuedCertificate.Spec.GetCertOptions().GetRsaKeySizeBytes()),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -165,13 +210,19 @@ func (c *certAgentTranslator) IssuedCertificateRequested(
 		return eris.Errorf("invalid private key found, no data provided")
 	}
 
-	switch certificateRequest.Status.State {
+	// wait for the issuer to process a replaced certificate signing request
+	state := certificateRequest.Status.State
+	if certificateRequest.Status.ObservedGeneration != certificateRequest.Generation {
+		state = certificatesv1.CertificateRequestStatus_PENDING
+	}
+
+	switch state {
 	case certificatesv1.CertificateRequestStatus_PENDING:
 		contextutils.LoggerFrom(ctx).Infof("waiting for certificate request %v to be signed by Issuer", sets.Key(certificateRequest))
 
 		// add secret and certrequest to output to prevent them from being GC'ed
-		outputs.AddSecrets(privateKeySecret)
-		outputs.AddCertificateRequests(certificateRequest)
+		addCertificateRequestOutputs(privateKeySecret, certificateRequest, outputs)
+		addPreviouslyIssuedSecrets(issuedCertificate, inputs, outputs)
 
 		// if the certificate signing request has not been
 		// fulfilled, return and wait for the next reconcile
@@ -183,9 +234,48 @@ func (c *certAgentTranslator) IssuedCertificateRequested(
 	signedCert := certificateRequest.Status.SignedCertificate
 	signingRootCA := certificateRequest.Status.SigningRootCa
 
+	// external CAs may sign the request with a root of a different key algorithm
+	if err := keys.CheckChainAlgorithms(signedCert, signingRootCA); err != nil {
+		return eris.Wrapf(err, "invalid certificate signed for certificate request %v", sets.Key(certificateRequest))
+	}
+
+	rotationStatus := issuedCertificate.Status.GetRotation()
+	if rotation.RootRotationInProgress(rotationStatus) {
+		// the signed certificate is distributed in later stages of the root rotation
+		addCertificateRequestOutputs(privateKeySecret, certificateRequest, outputs)
+	}
+
+	rootCerts := signingRootCA
+	switch rotationStatus.GetState() {
+	case certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT:
+		// workloads keep using the previously issued certificate until they trust the new root
+		previousSecret, err := inputs.Secrets().Find(issuedCertificate.Spec.IssuedCertificateSecret)
+		if err != nil {
+			return err
+		}
+		previousSecret = previousSecret.DeepCopy()
+		previousData := secrets.IntermediateCADataFromSecretData(previousSecret.Data)
+		previousData.RootCert = rotation.BundleRootCerts(previousData.RootCert, signingRootCA)
+		previousSecret.Data = previousData.ToSecretData()
+		outputs.AddSecrets(previousSecret)
+		if trustBundle := issuedCertificate.Spec.GetTrustBundle(); trustBundle != nil {
+			if gatewayCredentialSecret, err := inputs.Secrets().Find(trustBundle.GetSecret()); err == nil {
+				outputs.AddSecrets(gatewayCredentialSecret)
+			}
+		}
+		return nil
+	case certificatesv1.IssuedCertificateStatus_Rotation_ROTATING,
+		certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE:
+		// workloads must keep trusting the roots of the certificate being replaced
+		if previousSecret, err := inputs.Secrets().Find(issuedCertificate.Spec.IssuedCertificateSecret); err == nil {
+			previousRootCerts := secrets.IntermediateCADataFromSecretData(previousSecret.Data).RootCert
+			rootCerts = rotation.BundleRootCerts(signingRootCA, previousRootCerts)
+		}
+	}
+
 	issuedCertificateData := secrets.IntermediateCAData{
 		RootCAData: secrets.RootCAData{
-			RootCert: signingRootCA,
+			RootCert: rootCerts,
 		},
 		CertChain:    utils.AppendRootCerts(signedCert, signingRootCA),
 		CaCert:       signedCert,
@@ -203,6 +293,15 @@ func (c *certAgentTranslator) IssuedCertificateRequested(
 		Type: IssuedCertificateSecretType(),
 	}
 	outputs.AddSecrets(issuedCertificateSecret)
+
+	// if the mesh does not share a root of trust with its peers, write the gateway credentials
+	if issuedCertificate.Spec.GetTrustBundle() != nil {
+		gatewayCredentialSecret, err := buildGatewayCredentialSecret(issuedCertificate, issuedCertificateData, inputs)
+		if err != nil {
+			return eris.Wrap(err, "failed to issue gateway credentials")
+		}
+		outputs.AddSecrets(gatewayCredentialSecret)
+	}
 	return nil
 }
 
@@ -222,6 +321,28 @@ func (c *certAgentTranslator) IssuedCertificateIssued(
 		// add secret output to prevent it from being GC'ed
 		outputs.AddSecrets(issuedCertificateSecret)
 	}
+
+	// ensure the gateway credential secret exists, if required
+	if trustBundle := issuedCertificate.Spec.GetTrustBundle(); trustBundle != nil {
+		gatewayCredentialSecret, err := inputs.Secrets().Find(trustBundle.GetSecret())
+		if err != nil {
+			return err
+		}
+		outputs.AddSecrets(gatewayCredentialSecret)
+	}
+
+	// the certificate request is signed once, and reused by each stage of a root rotation
+	if rotation.RootRotationInProgress(issuedCertificate.Status.GetRotation()) {
+		privateKeySecret, err := inputs.Secrets().Find(issuedCertificate)
+		if err != nil {
+			return err
+		}
+		certificateRequest, err := inputs.CertificateRequests().Find(issuedCertificate)
+		if err != nil {
+			return err
+		}
+		addCertificateRequestOutputs(privateKeySecret, certificateRequest, outputs)
+	}
 	return nil
 }
 
@@ -241,7 +362,136 @@ func (c *certAgentTranslator) IssuedCertificateFinished(
 			sets.Key(issuedCertificate.Spec.IssuedCertificateSecret),
 		)
 	}
+
+	// Once the certificate replaced by a rotation has expired, stop distributing its roots
+	if rotation.OverlapEnded(issuedCertificate.Status.GetRotation(), time.Now()) {
+		issuedCertificateSecret = issuedCertificateSecret.DeepCopy()
+		issuedCertificateData := secrets.IntermediateCADataFromSecretData(issuedCertificateSecret.Data)
+		issuedCertificateData.RootCert = rotation.RootCertsInChain(issuedCertificateData.RootCert, issuedCertificateData.CertChain)
+		issuedCertificateSecret.Data = issuedCertificateData.ToSecretData()
+	}
+
 	// Add the issuedCert to the output
 	outputs.AddSecrets(issuedCertificateSecret)
+
+	// Readd the gateway credentials to the output, if required
+	if trustBundle := issuedCertificate.Spec.GetTrustBundle(); trustBundle != nil {
+		gatewayCredentialSecret, err := inputs.Secrets().Find(trustBundle.GetSecret())
+		if err != nil {
+			return eris.Wrapf(
+				err,
+				"could not find gateway credential secret (%s), restarting workflow",
+				sets.Key(trustBundle.GetSecret()),
+			)
+		}
+		outputs.AddSecrets(gatewayCredentialSecret)
+	}
 	return nil
 }
+
+// add the secrets written for the previously issued certificate to the outputs, if they exist,
+// to prevent them from being GC'ed before they are replaced
+func addPreviouslyIssuedSecrets(
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	inputs input.Snapshot,
+	outputs certagent.Builder,
+) {
+	if issuedCertificateSecret, err := inputs.Secrets().Find(issuedCertificate.Spec.GetIssuedCertificateSecret()); err == nil {
+		outputs.AddSecrets(issuedCertificateSecret)
+	}
+	if trustBundle := issuedCertificate.Spec.GetTrustBundle(); trustBundle != nil {
+		if gatewayCredentialSecret, err := inputs.Secrets().Find(trustBundle.GetSecret()); err == nil {
+			outputs.AddSecrets(gatewayCredentialSecret)
+		}
+	}
+}
+
+// add the private key and the certificate request for the issued certificate to the outputs,
+// to prevent them from being GC'ed before the certificate has been written
+func addCertificateRequestOutputs(
+	privateKeySecret *corev1.Secret,
+	certificateRequest *certificatesv1.CertificateRequest,
+	outputs certagent.Builder,
+) {
+	outputs.AddSecrets(privateKeySecret)
+	outputs.AddCertificateRequests(certificateRequest)
+}
+
+// the gateway credentials consist of a leaf certificate signed by the issued certificate and its key,
+// along with the root certificates of the peer meshes the gateway should accept traffic from.
+// The key of the issued certificate is never shared with the gateways.
+func buildGatewayCredentialSecret(
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	issuedCertificateData secrets.IntermediateCAData,
+	inputs input.Snapshot,
+) (*corev1.Secret, error) {
+	trustBundle := issuedCertificate.Spec.GetTrustBundle()
+
+	leafCert, leafKey := getGatewayLeafCertificate(trustBundle, issuedCertificateData.CaCert, inputs)
+	if leafCert == nil {
+		certOptions := issuedCertificate.Spec.GetCertOptions()
+		var err error
+		leafKey, err = keys.GeneratePrivateKey(certOptions.GetKeyAlgorithm(), int(certOptions.GetRsaKeySizeBytes()))
+		if err != nil {
+			return nil, err
+		}
+		leafCert, err = utils.GenerateLeafCertificate(
+			trustBundle.GetHosts(),
+			issuedCertificate.Spec.GetOrg(),
+			leafKey,
+			issuedCertificateData.CaCert,
+			issuedCertificateData.CaPrivateKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var rootCerts []byte
+	for _, rootCert := range trustBundle.GetRootCertificates() {
+		rootCerts = utils.AppendRootCerts(rootCerts, []byte(rootCert))
+	}
+
+	gatewayCredentialData := secrets.GatewayCredentialData{
+		CertChain:   utils.AppendRootCerts(leafCert, issuedCertificateData.CertChain),
+		PrivateKey:  leafKey,
+		TrustBundle: rootCerts,
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      trustBundle.GetSecret().GetName(),
+			Namespace: trustBundle.GetSecret().GetNamespace(),
+			Labels:    agentLabels(),
+		},
+		Data: gatewayCredentialData.ToSecretData(),
+		Type: GatewayCredentialSecretType(),
+	}, nil
+}
+
+// returns the leaf certificate and key of the existing gateway credentials if they were signed by the issued certificate,
+// so that the gateways are not issued a new certificate each time the issued certificate is written
+func getGatewayLeafCertificate(
+	trustBundle *certificatesv1.IssuedCertificateSpec_TrustBundle,
+	signedCert []byte,
+	inputs input.Snapshot,
+) ([]byte, []byte) {
+	gatewayCredentialSecret, err := inputs.Secrets().Find(trustBundle.GetSecret())
+	if err != nil {
+		return nil, nil
+	}
+	leafKey := gatewayCredentialSecret.Data[secrets.GatewayKeyID]
+	leafBlock, _ := pem.Decode(gatewayCredentialSecret.Data[secrets.GatewayCertID])
+	if leafBlock == nil || len(leafKey) == 0 {
+		return nil, nil
+	}
+	leafCert, err := x509.ParseCertificate(leafBlock.Bytes)
+	if err != nil {
+		return nil, nil
+	}
+	issuedCert, err := pkiutil.ParsePemEncodedCertificate(signedCert)
+	if err != nil || leafCert.CheckSignatureFrom(issuedCert) != nil {
+		return nil, nil
+	}
+	return pem.EncodeToMemory(leafBlock), leafKey
+}
@@ -4,17 +4,22 @@ import (
 	"context"
 	"crypto/x509"
 	"encoding/pem"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
 	mock_certagent "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/output/certagent/mocks"
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/utils"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/keys"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
 	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"github.com/solo-io/skv2/pkg/ezkube"
+	"istio.io/istio/security/pkg/pki/util"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -149,6 +154,32 @@ var _ = Describe("CertAgentTranslator", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("will re-add csr and secret if csr was signed before its certificate signing request was replaced", func() {
+			translator := translation.NewCertAgentTranslator()
+
+			csr := &certificatesv1.CertificateRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 2,
+				},
+				Status: certificatesv1.CertificateRequestStatus{
+					ObservedGeneration: 1,
+					State:              certificatesv1.CertificateRequestStatus_FINISHED,
+					SignedCertificate:  []byte("I'm a previous signing cert"),
+					SigningRootCa:      []byte("I'm a root ca"),
+				},
+			}
+
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				AddSecrets([]*corev1.Secret{privateKeySecret}).
+				Build()
+
+			mockOutput.EXPECT().AddSecrets(privateKeySecret)
+			mockOutput.EXPECT().AddCertificateRequests(csr)
+
+			err := translator.IssuedCertificateRequested(ctx, issuedCertiticate, csr, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
 		It("will save certs to issued cert if csr is finished", func() {
 			translator := translation.NewCertAgentTranslator()
 
@@ -187,6 +218,225 @@ var _ = Describe("CertAgentTranslator", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("will issue a gateway certificate if a trust bundle is present", func() {
+			translator := translation.NewCertAgentTranslator()
+
+			caKey, err := keys.GeneratePrivateKey(certificatesv1.CommonCertOptions_RSA, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			caCert, _, err := util.GenRootCertFromExistingKey(util.CertOptions{
+				Org:           "org",
+				IsCA:          true,
+				IsSelfSigned:  true,
+				TTL:           time.Hour,
+				SignerPrivPem: caKey,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			privateKeySecret := privateKeySecret.DeepCopy()
+			privateKeySecret.Data = map[string][]byte{"private-key": caKey}
+
+			issuedCertiticate := issuedCertiticate.DeepCopy()
+			issuedCertiticate.Spec.CertOptions = &certificatesv1.CommonCertOptions{RsaKeySizeBytes: 2048}
+			issuedCertiticate.Spec.TrustBundle = &certificatesv1.IssuedCertificateSpec_TrustBundle{
+				Secret: &skv2corev1.ObjectRef{
+					Name:      "gateway-credential",
+					Namespace: "istio-system",
+				},
+				RootCertificates: []string{"peer root 1\n", "peer root 2\n"},
+				Hosts:            []string{"*.global"},
+			}
+
+			csr := &certificatesv1.CertificateRequest{
+				Status: certificatesv1.CertificateRequestStatus{
+					State:             certificatesv1.CertificateRequestStatus_FINISHED,
+					SignedCertificate: caCert,
+					SigningRootCa:     []byte("I'm a root ca"),
+				},
+			}
+
+			var gatewayCredentialSecret *corev1.Secret
+			mockOutput.EXPECT().
+				AddSecrets(gomock.Any()).
+				Do(func(secret *corev1.Secret) {
+					Expect(secret.Type).To(Equal(translation.IssuedCertificateSecretType()))
+				})
+			mockOutput.EXPECT().
+				AddSecrets(gomock.Any()).
+				Do(func(secret *corev1.Secret) {
+					gatewayCredentialSecret = secret
+				})
+
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				AddSecrets([]*corev1.Secret{privateKeySecret}).
+				Build()
+			err = translator.IssuedCertificateRequested(ctx, issuedCertiticate, csr, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(gatewayCredentialSecret.ObjectMeta).To(Equal(metav1.ObjectMeta{
+				Name:      "gateway-credential",
+				Namespace: "istio-system",
+				Labels: map[string]string{
+					"agent.certificates.mesh.gloo.solo.io": "gloo-mesh",
+				},
+			}))
+			Expect(gatewayCredentialSecret.Type).To(Equal(translation.GatewayCredentialSecretType()))
+			Expect(gatewayCredentialSecret.Data[secrets.GatewayCaCertID]).To(Equal([]byte("peer root 1\npeer root 2\n")))
+
+			// the gateway is issued a leaf certificate rather than the key of the issued certificate
+			Expect(gatewayCredentialSecret.Data[secrets.GatewayKeyID]).NotTo(Equal(caKey))
+			certChain := gatewayCredentialSecret.Data[secrets.GatewayCertID]
+			Expect(string(certChain)).To(HaveSuffix(string(caCert) + "I'm a root ca"))
+			leafCert, err := util.ParsePemEncodedCertificate(certChain)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leafCert.IsCA).To(BeFalse())
+			Expect(leafCert.DNSNames).To(ConsistOf("*.global"))
+			issuedCert, err := util.ParsePemEncodedCertificate(caCert)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leafCert.CheckSignatureFrom(issuedCert)).To(Succeed())
+
+			// the leaf certificate is reused while it is signed by the issued certificate
+			mockOutput.EXPECT().AddSecrets(gomock.Any())
+			mockOutput.EXPECT().
+				AddSecrets(gomock.Any()).
+				Do(func(secret *corev1.Secret) {
+					Expect(secret.Data).To(Equal(gatewayCredentialSecret.Data))
+				})
+
+			inputSnap = input.NewInputSnapshotManualBuilder("hello").
+				AddSecrets([]*corev1.Secret{privateKeySecret, gatewayCredentialSecret}).
+				Build()
+			err = translator.IssuedCertificateRequested(ctx, issuedCertiticate, csr, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("will distribute the previous root alongside the new root during a rotation", func() {
+			translator := translation.NewCertAgentTranslator()
+
+			issuedCertiticate := issuedCertiticate.DeepCopy()
+			issuedCertiticate.Status.Rotation = &certificatesv1.IssuedCertificateStatus_Rotation{
+				State: certificatesv1.IssuedCertificateStatus_Rotation_ROTATING,
+			}
+
+			previousRoot := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("previous root")})
+			newRoot := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("new root")})
+			previousIssuedCertSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      issuedCertiticate.Spec.IssuedCertificateSecret.Name,
+					Namespace: issuedCertiticate.Spec.IssuedCertificateSecret.Namespace,
+				},
+				Data: secrets.IntermediateCAData{
+					RootCAData: secrets.RootCAData{RootCert: previousRoot},
+				}.ToSecretData(),
+			}
+
+			csr := &certificatesv1.CertificateRequest{
+				Status: certificatesv1.CertificateRequestStatus{
+					State:             certificatesv1.CertificateRequestStatus_FINISHED,
+					SignedCertificate: []byte("I'm a signing cert"),
+					SigningRootCa:     newRoot,
+				},
+			}
+
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				AddSecrets([]*corev1.Secret{privateKeySecret, previousIssuedCertSecret}).
+				Build()
+
+			mockOutput.EXPECT().
+				AddSecrets(gomock.Any()).
+				Do(func(secret *corev1.Secret) {
+					intCaData := secrets.IntermediateCADataFromSecretData(secret.Data)
+					Expect(intCaData.RootCert).To(Equal(utils.AppendRootCerts(newRoot, previousRoot)))
+					Expect(intCaData.CertChain).To(Equal(utils.AppendRootCerts([]byte("I'm a signing cert"), newRoot)))
+				})
+
+			err := translator.IssuedCertificateRequested(ctx, issuedCertiticate, csr, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("root rotation", func() {
+			var (
+				previousRoot             []byte
+				newRoot                  []byte
+				previousIssuedCertSecret *corev1.Secret
+				csr                      *certificatesv1.CertificateRequest
+			)
+
+			BeforeEach(func() {
+				previousRoot = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("previous root")})
+				newRoot = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("new root")})
+				previousIssuedCertSecret = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      issuedCertiticate.Spec.IssuedCertificateSecret.Name,
+						Namespace: issuedCertiticate.Spec.IssuedCertificateSecret.Namespace,
+					},
+					Data: secrets.IntermediateCAData{
+						RootCAData: secrets.RootCAData{RootCert: previousRoot},
+						CaCert:     []byte("I'm the previous cert"),
+					}.ToSecretData(),
+				}
+				csr = &certificatesv1.CertificateRequest{
+					Status: certificatesv1.CertificateRequestStatus{
+						State:             certificatesv1.CertificateRequestStatus_FINISHED,
+						SignedCertificate: []byte("I'm a signing cert"),
+						SigningRootCa:     newRoot,
+					},
+				}
+			})
+
+			translateStage := func(state certificatesv1.IssuedCertificateStatus_Rotation_State) *corev1.Secret {
+				translator := translation.NewCertAgentTranslator()
+
+				issuedCertiticate := issuedCertiticate.DeepCopy()
+				issuedCertiticate.Status.Rotation = &certificatesv1.IssuedCertificateStatus_Rotation{State: state}
+
+				inputSnap := input.NewInputSnapshotManualBuilder("hello").
+					AddSecrets([]*corev1.Secret{privateKeySecret, previousIssuedCertSecret}).
+					Build()
+
+				// the private key and certificate request are reused by the following stages
+				mockOutput.EXPECT().AddSecrets(privateKeySecret)
+				mockOutput.EXPECT().AddCertificateRequests(csr)
+
+				var issuedCertSecret *corev1.Secret
+				mockOutput.EXPECT().
+					AddSecrets(gomock.Any()).
+					Do(func(secret *corev1.Secret) {
+						issuedCertSecret = secret
+					})
+
+				err := translator.IssuedCertificateRequested(ctx, issuedCertiticate, csr, inputSnap, mockOutput)
+				Expect(err).NotTo(HaveOccurred())
+				return issuedCertSecret
+			}
+
+			It("will add the new root to the previous certificate", func() {
+				intCaData := secrets.IntermediateCADataFromSecretData(
+					translateStage(certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT).Data,
+				)
+				Expect(intCaData.CaCert).To(Equal([]byte("I'm the previous cert")))
+				Expect(intCaData.RootCert).To(Equal(utils.AppendRootCerts(previousRoot, newRoot)))
+			})
+
+			It("will distribute the new certificate alongside both roots", func() {
+				previousIssuedCertSecret.Data = secrets.IntermediateCAData{
+					RootCAData: secrets.RootCAData{RootCert: utils.AppendRootCerts(previousRoot, newRoot)},
+				}.ToSecretData()
+
+				intCaData := secrets.IntermediateCADataFromSecretData(
+					translateStage(certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE).Data,
+				)
+				Expect(intCaData.CaCert).To(Equal([]byte("I'm a signing cert")))
+				Expect(intCaData.RootCert).To(Equal(utils.AppendRootCerts(newRoot, previousRoot)))
+			})
+
+			It("will remove the previous root", func() {
+				intCaData := secrets.IntermediateCADataFromSecretData(
+					translateStage(certificatesv1.IssuedCertificateStatus_Rotation_REMOVING_PREVIOUS_ROOT).Data,
+				)
+				Expect(intCaData.CaCert).To(Equal([]byte("I'm a signing cert")))
+				Expect(intCaData.RootCert).To(Equal(newRoot))
+			})
+		})
 	})
 
 	Context("IssuedCertiticateIssued", func() {
@@ -298,6 +548,57 @@ var _ = Describe("CertAgentTranslator", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("Will stop distributing the previous root once the previous certificate has expired", func() {
+			translator := translation.NewCertAgentTranslator()
+
+			previousRoot := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("previous root")})
+			newRoot := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("new root")})
+			issuedCertData := secrets.IntermediateCAData{
+				RootCAData: secrets.RootCAData{RootCert: utils.AppendRootCerts(newRoot, previousRoot)},
+				CertChain:  utils.AppendRootCerts([]byte("I'm a signing cert"), newRoot),
+				CaCert:     []byte("I'm a signing cert"),
+			}
+			issuedCertSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "issued",
+					Namespace: "cert",
+				},
+				Data: issuedCertData.ToSecretData(),
+			}
+
+			issuedCertiticate := &certificatesv1.IssuedCertificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "namespace",
+				},
+				Spec: certificatesv1.IssuedCertificateSpec{
+					IssuedCertificateSecret: ezkube.MakeObjectRef(issuedCertSecret),
+				},
+				Status: certificatesv1.IssuedCertificateStatus{
+					Rotation: &certificatesv1.IssuedCertificateStatus_Rotation{
+						State:            certificatesv1.IssuedCertificateStatus_Rotation_OVERLAPPING,
+						PreviousNotAfter: ptypes.TimestampNow(),
+					},
+				},
+			}
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				AddSecrets([]*corev1.Secret{issuedCertSecret}).
+				Build()
+
+			expectedData := issuedCertData
+			expectedData.RootCert = newRoot
+			mockOutput.EXPECT().
+				AddSecrets(gomock.Any()).
+				Do(func(secret *corev1.Secret) {
+					Expect(secret.Data).To(Equal(expectedData.ToSecretData()))
+				})
+
+			err := translator.IssuedCertificateFinished(ctx, issuedCertiticate, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+			// the input secret is left untouched
+			Expect(issuedCertSecret.Data).To(Equal(issuedCertData.ToSecretData()))
+		})
+
 	})
 
 })
@@ -0,0 +1,258 @@
+package certmanager
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/output/certagent"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultIssuerKind  = "Issuer"
+	defaultIssuerGroup = "cert-manager.io"
+
+	// reasons of the Ready condition of a cert-manager CertificateRequest
+	reasonPending = "Pending"
+	reasonIssued  = "Issued"
+	reasonFailed  = "Failed"
+	reasonDenied  = "Denied"
+)
+
+// cert-manager is not a dependency of Gloo Mesh, so its CertificateRequests are managed as unstructured objects
+var certificateRequestGVK = schema.GroupVersionKind{
+	Group:   "cert-manager.io",
+	Version: "v1",
+	Kind:    "CertificateRequest",
+}
+
+// NewTranslator returns a Translator which submits the certificate requests of IssuedCertificates
+// with a cert-manager CA as cert-manager CertificateRequests, and otherwise defers to the given translator.
+// The given translator writes the certificate signed by the cert-manager issuer to the IssuedCertificateSecret.
+func NewTranslator(
+	translator translation.Translator,
+	kubeClient client.Client,
+	certificateRequestClient certificatesv1.CertificateRequestClient,
+) translation.Translator {
+	return &certManagerTranslator{
+		Translator:               translator,
+		kubeClient:               kubeClient,
+		certificateRequestClient: certificateRequestClient,
+	}
+}
+
+type certManagerTranslator struct {
+	translation.Translator
+	kubeClient               client.Client
+	certificateRequestClient certificatesv1.CertificateRequestClient
+}
+
+func (t *certManagerTranslator) IssuedCertificateRequested(
+	ctx context.Context,
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	certificateRequest *certificatesv1.CertificateRequest,
+	inputs input.Snapshot,
+	outputs certagent.Builder,
+) error {
+	certManagerCA := issuedCertificate.Spec.GetAgentCa().GetCertManager()
+	if certManagerCA == nil || translation.CertificateRequestSigned(certificateRequest) {
+		return t.Translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, outputs)
+	}
+
+	certManagerRequest, err := t.getOrCreateCertManagerRequest(ctx, certManagerCA, issuedCertificate, certificateRequest)
+	if err != nil {
+		return err
+	}
+
+	reason, message := readyCondition(certManagerRequest)
+	issuedCertificate.Status.ExternalRequest = &certificatesv1.IssuedCertificateStatus_ExternalRequest{
+		Name:    certManagerRequest.GetName(),
+		Reason:  reason,
+		Message: message,
+	}
+
+	switch reason {
+	case reasonIssued:
+	case reasonFailed, reasonDenied:
+		return eris.Errorf("cert-manager CertificateRequest %v was not signed: %v", sets.Key(certManagerRequest), message)
+	default:
+		// keep the private key and certificate request until the issuer signs the request
+		return t.Translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, outputs)
+	}
+
+	signedCertificate, rootCert, err := signedCertificates(certManagerRequest)
+	if err != nil {
+		return eris.Wrapf(err, "invalid cert-manager CertificateRequest %v", sets.Key(certManagerRequest))
+	}
+
+	if err := translation.SignCertificateRequest(ctx, t.certificateRequestClient, certificateRequest, signedCertificate, rootCert); err != nil {
+		return err
+	}
+
+	return t.Translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, outputs)
+}
+
+// return the cert-manager CertificateRequest for the CSR of the certificate request,
+// replacing any request created for a previous CSR of the IssuedCertificate
+func (t *certManagerTranslator) getOrCreateCertManagerRequest(
+	ctx context.Context,
+	certManagerCA *certificatesv1.CertManagerCA,
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	certificateRequest *certificatesv1.CertificateRequest,
+) (*unstructured.Unstructured, error) {
+	csr := certificateRequest.Spec.GetCertificateSigningRequest()
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(certificateRequestGVK)
+	err := t.kubeClient.Get(ctx, client.ObjectKey{Name: issuedCertificate.Name, Namespace: issuedCertificate.Namespace}, existing)
+	switch {
+	case err == nil:
+		request, _, _ := unstructured.NestedString(existing.Object, "spec", "request")
+		if request == base64.StdEncoding.EncodeToString(csr) {
+			return existing, nil
+		}
+		contextutils.LoggerFrom(ctx).Debugf("replacing cert-manager CertificateRequest %v for a new CSR", sets.Key(existing))
+		if err := t.kubeClient.Delete(ctx, existing); err != nil && !k8serrors.IsNotFound(err) {
+			return nil, eris.Wrapf(err, "failed to delete cert-manager CertificateRequest %v", sets.Key(existing))
+		}
+	case !k8serrors.IsNotFound(err):
+		return nil, eris.Wrapf(err, "failed to get cert-manager CertificateRequest for %v", sets.Key(issuedCertificate))
+	}
+
+	certManagerRequest := newCertManagerRequest(certManagerCA, issuedCertificate, csr)
+	contextutils.LoggerFrom(ctx).Infof("requesting certificate for %v from cert-manager %v %v",
+		sets.Key(issuedCertificate), issuerKind(certManagerCA), certManagerCA.GetName())
+	if err := t.kubeClient.Create(ctx, certManagerRequest); err != nil {
+		return nil, eris.Wrapf(err, "failed to create cert-manager CertificateRequest %v", sets.Key(certManagerRequest))
+	}
+	return certManagerRequest, nil
+}
+
+func newCertManagerRequest(
+	certManagerCA *certificatesv1.CertManagerCA,
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	csr []byte,
+) *unstructured.Unstructured {
+	issuerGroup := certManagerCA.GetGroup()
+	if issuerGroup == "" {
+		issuerGroup = defaultIssuerGroup
+	}
+
+	spec := map[string]interface{}{
+		"request": base64.StdEncoding.EncodeToString(csr),
+		"isCA":    true,
+		"usages":  []interface{}{"cert sign", "crl sign", "digital signature", "key encipherment"},
+		"issuerRef": map[string]interface{}{
+			"name":  certManagerCA.GetName(),
+			"kind":  issuerKind(certManagerCA),
+			"group": issuerGroup,
+		},
+	}
+	if ttlDays := issuedCertificate.Spec.GetCertOptions().GetTtlDays(); ttlDays > 0 {
+		spec["duration"] = (time.Duration(ttlDays) * 24 * time.Hour).String()
+	}
+
+	certManagerRequest := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	certManagerRequest.SetGroupVersionKind(certificateRequestGVK)
+	certManagerRequest.SetName(issuedCertificate.Name)
+	certManagerRequest.SetNamespace(issuedCertificate.Namespace)
+	// garbage collect the request along with the IssuedCertificate
+	certManagerRequest.SetOwnerReferences([]metav1.OwnerReference{
+		*metav1.NewControllerRef(issuedCertificate, certificatesv1.SchemeGroupVersion.WithKind("IssuedCertificate")),
+	})
+	return certManagerRequest
+}
+
+func issuerKind(certManagerCA *certificatesv1.CertManagerCA) string {
+	if kind := certManagerCA.GetKind(); kind != "" {
+		return kind
+	}
+	return defaultIssuerKind
+}
+
+// return the reason and message of the Ready condition of the cert-manager CertificateRequest.
+// requests denied by an approver are reported as Denied.
+func readyCondition(certManagerRequest *unstructured.Unstructured) (string, string) {
+	conditions, _, _ := unstructured.NestedSlice(certManagerRequest.Object, "status", "conditions")
+	reason, message := reasonPending, "waiting for the issuer to sign the request"
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionType, _, _ := unstructured.NestedString(condition, "type")
+		conditionStatus, _, _ := unstructured.NestedString(condition, "status")
+		conditionReason, _, _ := unstructured.NestedString(condition, "reason")
+		conditionMessage, _, _ := unstructured.NestedString(condition, "message")
+		switch {
+		case conditionType == reasonDenied && conditionStatus == string(metav1.ConditionTrue):
+			return reasonDenied, conditionMessage
+		case conditionType == "Ready":
+			if conditionReason != "" {
+				reason = conditionReason
+			}
+			message = conditionMessage
+		}
+	}
+	return reason, message
+}
+
+// return the signed certificate chain and the root certificate of an issued cert-manager CertificateRequest
+func signedCertificates(certManagerRequest *unstructured.Unstructured) ([]byte, []byte, error) {
+	certificate, err := decodeStatusField(certManagerRequest, "certificate")
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(certificate) == 0 {
+		return nil, nil, eris.New("issued request has no certificate")
+	}
+	ca, err := decodeStatusField(certManagerRequest, "ca")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caCerts := pemBlocks(ca)
+	if len(caCerts) > 0 {
+		return certificate, pem.EncodeToMemory(caCerts[len(caCerts)-1]), nil
+	}
+
+	// the CA is not returned by all issuers, in which case the chain of the certificate ends with the root
+	chain := pemBlocks(certificate)
+	if len(chain) < 2 {
+		return nil, nil, eris.New("issued request has no CA certificate")
+	}
+	certificate = nil
+	for _, block := range chain[:len(chain)-1] {
+		certificate = append(certificate, pem.EncodeToMemory(block)...)
+	}
+	return certificate, pem.EncodeToMemory(chain[len(chain)-1]), nil
+}
+
+func decodeStatusField(certManagerRequest *unstructured.Unstructured, field string) ([]byte, error) {
+	encoded, _, _ := unstructured.NestedString(certManagerRequest.Object, "status", field)
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, eris.Wrapf(err, "decoding status.%v", field)
+	}
+	return decoded, nil
+}
+
+func pemBlocks(data []byte) []*pem.Block {
+	var blocks []*pem.Block
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
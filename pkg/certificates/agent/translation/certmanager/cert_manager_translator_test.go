@@ -0,0 +1,247 @@
+package certmanager_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
+	mock_certagent "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/output/certagent/mocks"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	mock_certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation/certmanager"
+	mock_translation "github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation/mocks"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("CertManagerTranslator", func() {
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		mockTranslator               *mock_translation.MockTranslator
+		mockCertificateRequestClient *mock_certificatesv1.MockCertificateRequestClient
+		mockOutput                   *mock_certagent.MockBuilder
+		kubeClient                   client.Client
+		translator                   translation.Translator
+
+		inputs             input.Snapshot
+		issuedCertificate  *certificatesv1.IssuedCertificate
+		certificateRequest *certificatesv1.CertificateRequest
+	)
+
+	certManagerRequestGVK := schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "CertificateRequest"}
+
+	pemEncode := func(data string) []byte {
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte(data)})
+	}
+
+	getCertManagerRequest := func() *unstructured.Unstructured {
+		certManagerRequest := &unstructured.Unstructured{}
+		certManagerRequest.SetGroupVersionKind(certManagerRequestGVK)
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "mesh", Namespace: "gloo-mesh"}, certManagerRequest)).To(Succeed())
+		return certManagerRequest
+	}
+
+	// simulate cert-manager processing the request
+	setStatus := func(status map[string]interface{}) {
+		certManagerRequest := getCertManagerRequest()
+		certManagerRequest.Object["status"] = status
+		Expect(kubeClient.Update(ctx, certManagerRequest)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctrl, ctx = gomock.WithContext(context.Background(), GinkgoT())
+
+		mockTranslator = mock_translation.NewMockTranslator(ctrl)
+		mockCertificateRequestClient = mock_certificatesv1.NewMockCertificateRequestClient(ctrl)
+		mockOutput = mock_certagent.NewMockBuilder(ctrl)
+		kubeClient = fake.NewFakeClient()
+		translator = certmanager.NewTranslator(mockTranslator, kubeClient, mockCertificateRequestClient)
+
+		inputs = input.NewInputSnapshotManualBuilder("test").Build()
+		issuedCertificate = &certificatesv1.IssuedCertificate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mesh",
+				Namespace: "gloo-mesh",
+			},
+			Spec: certificatesv1.IssuedCertificateSpec{
+				CertOptions: &certificatesv1.CommonCertOptions{TtlDays: 30},
+				CertificateAuthority: &certificatesv1.IssuedCertificateSpec_AgentCa{
+					AgentCa: &certificatesv1.IntermediateCertificateAuthority{
+						CaSource: &certificatesv1.IntermediateCertificateAuthority_CertManager{
+							CertManager: &certificatesv1.CertManagerCA{
+								Name: "internal-ca",
+								Kind: "ClusterIssuer",
+							},
+						},
+					},
+				},
+			},
+		}
+		certificateRequest = &certificatesv1.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "mesh",
+				Namespace:  "gloo-mesh",
+				Generation: 1,
+			},
+			Spec: certificatesv1.CertificateRequestSpec{
+				CertificateSigningRequest: []byte("csr"),
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("defers to the translator if the certificate is not issued by cert-manager", func() {
+		issuedCertificate.Spec.CertificateAuthority = nil
+
+		mockTranslator.EXPECT().
+			IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput).
+			Return(nil)
+
+		Expect(translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput)).To(Succeed())
+		Expect(issuedCertificate.Status.ExternalRequest).To(BeNil())
+	})
+
+	It("submits the CSR to the issuer and waits for it to be signed", func() {
+		mockTranslator.EXPECT().
+			IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput).
+			Return(nil).
+			Times(2)
+
+		Expect(translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput)).To(Succeed())
+
+		certManagerRequest := getCertManagerRequest()
+		Expect(certManagerRequest.Object["spec"]).To(Equal(map[string]interface{}{
+			"request":  base64.StdEncoding.EncodeToString([]byte("csr")),
+			"isCA":     true,
+			"usages":   []interface{}{"cert sign", "crl sign", "digital signature", "key encipherment"},
+			"duration": "720h0m0s",
+			"issuerRef": map[string]interface{}{
+				"name":  "internal-ca",
+				"kind":  "ClusterIssuer",
+				"group": "cert-manager.io",
+			},
+		}))
+		Expect(certManagerRequest.GetOwnerReferences()).To(HaveLen(1))
+		Expect(certManagerRequest.GetOwnerReferences()[0].Kind).To(Equal("IssuedCertificate"))
+		Expect(issuedCertificate.Status.ExternalRequest).To(Equal(&certificatesv1.IssuedCertificateStatus_ExternalRequest{
+			Name:    "mesh",
+			Reason:  "Pending",
+			Message: "waiting for the issuer to sign the request",
+		}))
+
+		setStatus(map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "False", "reason": "Pending", "message": "Waiting on certificate issuance"},
+			},
+		})
+
+		Expect(translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput)).To(Succeed())
+		Expect(issuedCertificate.Status.ExternalRequest.Message).To(Equal("Waiting on certificate issuance"))
+	})
+
+	It("records the certificate signed by the issuer on the certificate request", func() {
+		mockTranslator.EXPECT().
+			IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput).
+			Return(nil).
+			Times(2)
+
+		Expect(translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput)).To(Succeed())
+
+		setStatus(map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True", "reason": "Issued", "message": "Certificate fetched from issuer successfully"},
+			},
+			"certificate": base64.StdEncoding.EncodeToString(append(pemEncode("signed"), pemEncode("intermediate")...)),
+			"ca":          base64.StdEncoding.EncodeToString(pemEncode("root")),
+		})
+
+		expectedRequest := certificateRequest.DeepCopy()
+		expectedRequest.Status = certificatesv1.CertificateRequestStatus{
+			ObservedGeneration: 1,
+			State:              certificatesv1.CertificateRequestStatus_FINISHED,
+			SignedCertificate:  append(pemEncode("signed"), pemEncode("intermediate")...),
+			SigningRootCa:      pemEncode("root"),
+		}
+		mockCertificateRequestClient.EXPECT().
+			UpdateCertificateRequestStatus(ctx, expectedRequest).
+			Return(nil)
+
+		Expect(translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput)).To(Succeed())
+		Expect(issuedCertificate.Status.ExternalRequest.Reason).To(Equal("Issued"))
+	})
+
+	It("reports requests denied by an approver", func() {
+		mockTranslator.EXPECT().
+			IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput).
+			Return(nil)
+
+		Expect(translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput)).To(Succeed())
+
+		setStatus(map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Denied", "status": "True", "reason": "policy", "message": "intermediate CAs are not allowed"},
+				map[string]interface{}{"type": "Ready", "status": "False", "reason": "Denied", "message": "The CertificateRequest was denied by an approval controller"},
+			},
+		})
+
+		err := translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput)
+		Expect(err).To(MatchError(ContainSubstring("intermediate CAs are not allowed")))
+		Expect(issuedCertificate.Status.ExternalRequest.Reason).To(Equal("Denied"))
+	})
+
+	It("replaces the request submitted for a previous CSR", func() {
+		mockTranslator.EXPECT().
+			IssuedCertificateRequested(ctx, issuedCertificate, gomock.Any(), inputs, mockOutput).
+			Return(nil).
+			Times(2)
+
+		Expect(translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput)).To(Succeed())
+		setStatus(map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "False", "reason": "Failed", "message": "issuer not ready"},
+			},
+		})
+
+		certificateRequest.Spec.CertificateSigningRequest = []byte("new csr")
+		Expect(translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput)).To(Succeed())
+
+		spec := getCertManagerRequest().Object["spec"].(map[string]interface{})
+		Expect(spec["request"]).To(Equal(base64.StdEncoding.EncodeToString([]byte("new csr"))))
+		Expect(issuedCertificate.Status.ExternalRequest.Reason).To(Equal("Pending"))
+	})
+
+	It("submits the CSR of a certificate request which was replaced after the previous request was signed", func() {
+		// signed for the previous generation of the certificate request
+		certificateRequest.Generation = 2
+		certificateRequest.Spec.CertificateSigningRequest = []byte("new csr")
+		certificateRequest.Status = certificatesv1.CertificateRequestStatus{
+			ObservedGeneration: 1,
+			State:              certificatesv1.CertificateRequestStatus_FINISHED,
+			SignedCertificate:  pemEncode("previous"),
+			SigningRootCa:      pemEncode("root"),
+		}
+
+		mockTranslator.EXPECT().
+			IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput).
+			Return(nil)
+
+		Expect(translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput)).To(Succeed())
+
+		spec := getCertManagerRequest().Object["spec"].(map[string]interface{})
+		Expect(spec["request"]).To(Equal(base64.StdEncoding.EncodeToString([]byte("new csr"))))
+		Expect(issuedCertificate.Status.ExternalRequest.Reason).To(Equal("Pending"))
+	})
+})
@@ -0,0 +1,280 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/utils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+)
+
+//go:generate mockgen -source ./signer.go -destination mocks/signer.go
+
+const (
+	// the key of the Vault token in the secret referenced by `tokenSecretRef`
+	tokenSecretKey = "token"
+
+	defaultKubernetesAuthMountPath   = "/v1/auth/kubernetes"
+	defaultServiceAccountTokenKey    = "token"
+	defaultMountedServiceAccountPath = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+	// the maximum duration of a request to Vault, including reading the response
+	requestTimeout = 30 * time.Second
+)
+
+// A certificate signed by Vault.
+type SignedCertificate struct {
+	// The PEM-encoded signed certificate, followed by the certificates of the intermediate CAs which issued it, if any.
+	Certificate []byte
+
+	// The PEM-encoded root certificate of the chain.
+	RootCert []byte
+}
+
+// A Signer submits certificate signing requests to the PKI secrets engine of a Vault server.
+type Signer interface {
+	// Sign the PEM-encoded CSR of the IssuedCertificate at the `ca_path` of the Vault CA.
+	// Credentials used to authenticate with Vault are read from the inputs.
+	Sign(
+		ctx context.Context,
+		vaultCA *certificatesv1.VaultCA,
+		csr []byte,
+		issuedCertificate *certificatesv1.IssuedCertificate,
+		inputs input.Snapshot,
+	) (*SignedCertificate, error)
+}
+
+func NewSigner() Signer {
+	return &signer{}
+}
+
+type signer struct{}
+
+// the subset of Vault API responses used by the signer
+type vaultResponse struct {
+	Auth *struct {
+		ClientToken string `json:"client_token"`
+	} `json:"auth"`
+	Data struct {
+		Certificate string   `json:"certificate"`
+		IssuingCa   string   `json:"issuing_ca"`
+		CaChain     []string `json:"ca_chain"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+func (s *signer) Sign(
+	ctx context.Context,
+	vaultCA *certificatesv1.VaultCA,
+	csr []byte,
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	inputs input.Snapshot,
+) (*SignedCertificate, error) {
+	if vaultCA.GetServer() == "" || vaultCA.GetCaPath() == "" {
+		return nil, eris.New("Vault CA must specify the server and the ca path")
+	}
+
+	httpClient, err := newHTTPClient(vaultCA)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.authenticate(ctx, httpClient, vaultCA, inputs)
+	if err != nil {
+		return nil, eris.Wrap(err, "authenticating with Vault")
+	}
+
+	request := map[string]interface{}{
+		"csr":         string(csr),
+		"common_name": issuedCertificate.Spec.GetOrg(),
+		"uri_sans":    strings.Join(issuedCertificate.Spec.GetHosts(), ","),
+		"format":      "pem",
+	}
+	if ttlDays := issuedCertificate.Spec.GetCertOptions().GetTtlDays(); ttlDays > 0 {
+		request["ttl"] = fmt.Sprintf("%dh", ttlDays*24)
+	}
+
+	response, err := doRequest(ctx, httpClient, vaultCA, token, "/v1/"+strings.TrimPrefix(vaultCA.GetCaPath(), "/"), request)
+	if err != nil {
+		return nil, err
+	}
+	if response.Data.Certificate == "" {
+		return nil, eris.Errorf("Vault returned no certificate for %v", vaultCA.GetCaPath())
+	}
+
+	// the CA chain of the signed certificate ends with the root CA
+	caChain := response.Data.CaChain
+	if len(caChain) == 0 && response.Data.IssuingCa != "" {
+		caChain = []string{response.Data.IssuingCa}
+	}
+	if len(caChain) == 0 {
+		return nil, eris.Errorf("Vault returned no CA chain for %v", vaultCA.GetCaPath())
+	}
+
+	certificate := []byte(response.Data.Certificate)
+	for _, intermediateCert := range caChain[:len(caChain)-1] {
+		certificate = utils.AppendRootCerts(certificate, []byte(intermediateCert))
+	}
+
+	return &SignedCertificate{
+		Certificate: certificate,
+		RootCert:    []byte(caChain[len(caChain)-1]),
+	}, nil
+}
+
+// return the Vault token used to sign certificates
+func (s *signer) authenticate(
+	ctx context.Context,
+	httpClient *http.Client,
+	vaultCA *certificatesv1.VaultCA,
+	inputs input.Snapshot,
+) (string, error) {
+	switch authType := vaultCA.GetAuthType().(type) {
+	case *certificatesv1.VaultCA_TokenSecretRef:
+		tokenSecret, err := inputs.Secrets().Find(authType.TokenSecretRef)
+		if err != nil {
+			return "", eris.Wrapf(err, "failed to find Vault token secret %v", sets.Key(authType.TokenSecretRef))
+		}
+		token := string(tokenSecret.Data[tokenSecretKey])
+		if token == "" {
+			return "", eris.Errorf("Vault token secret %v has no %q key", sets.Key(authType.TokenSecretRef), tokenSecretKey)
+		}
+		return token, nil
+	case *certificatesv1.VaultCA_KubernetesAuth:
+		kubernetesAuth := authType.KubernetesAuth
+		jwt, err := serviceAccountToken(kubernetesAuth, inputs)
+		if err != nil {
+			return "", err
+		}
+		mountPath := kubernetesAuth.GetMountPath()
+		if mountPath == "" {
+			mountPath = defaultKubernetesAuthMountPath
+		}
+		response, err := doRequest(ctx, httpClient, vaultCA, "", strings.TrimSuffix(mountPath, "/")+"/login", map[string]interface{}{
+			"role": kubernetesAuth.GetRole(),
+			"jwt":  jwt,
+		})
+		if err != nil {
+			return "", err
+		}
+		if response.Auth == nil || response.Auth.ClientToken == "" {
+			return "", eris.New("Vault kubernetes login returned no token")
+		}
+		return response.Auth.ClientToken, nil
+	}
+	return "", eris.New("Vault CA must specify an authentication method")
+}
+
+// return the service account token presented to Vault by the kubernetes auth method
+func serviceAccountToken(kubernetesAuth *certificatesv1.VaultKubernetesAuth, inputs input.Snapshot) (string, error) {
+	tokenKey := kubernetesAuth.GetSecretTokenKey()
+	if tokenKey == "" {
+		tokenKey = defaultServiceAccountTokenKey
+	}
+
+	if serviceAccountRef := kubernetesAuth.GetServiceAccountRef(); serviceAccountRef != nil {
+		serviceAccount, err := inputs.ServiceAccounts().Find(serviceAccountRef)
+		if err != nil {
+			return "", eris.Wrapf(err, "failed to find service account %v", sets.Key(serviceAccountRef))
+		}
+		for _, secretRef := range serviceAccount.Secrets {
+			tokenSecret, err := inputs.Secrets().Find(&skv2corev1.ObjectRef{
+				Name:      secretRef.Name,
+				Namespace: serviceAccount.Namespace,
+			})
+			if err != nil {
+				continue
+			}
+			if token := tokenSecret.Data[tokenKey]; len(token) > 0 {
+				return string(token), nil
+			}
+		}
+		return "", eris.Errorf("no token found for service account %v", sets.Key(serviceAccountRef))
+	}
+
+	tokenPath := kubernetesAuth.GetMountedSaPath()
+	if tokenPath == "" {
+		tokenPath = defaultMountedServiceAccountPath
+	}
+	if info, err := os.Stat(tokenPath); err == nil && info.IsDir() {
+		tokenPath = filepath.Join(tokenPath, tokenKey)
+	}
+	token, err := ioutil.ReadFile(tokenPath)
+	if err != nil {
+		return "", eris.Wrapf(err, "reading service account token")
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+func newHTTPClient(vaultCA *certificatesv1.VaultCA) (*http.Client, error) {
+	if len(vaultCA.GetCaBundle()) == 0 {
+		return &http.Client{Timeout: requestTimeout}, nil
+	}
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(vaultCA.GetCaBundle()) {
+		return nil, eris.New("Vault CA bundle contains no valid certificates")
+	}
+	// keep the proxy and connection settings of the default transport
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   requestTimeout,
+	}, nil
+}
+
+// send a request to the Vault API at the given path, authenticated by the token if set
+func doRequest(
+	ctx context.Context,
+	httpClient *http.Client,
+	vaultCA *certificatesv1.VaultCA,
+	token string,
+	path string,
+	body map[string]interface{},
+) (*vaultResponse, error) {
+	requestBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	url := strings.TrimSuffix(vaultCA.GetServer(), "/") + path
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(requestBody))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		request.Header.Set("X-Vault-Token", token)
+	}
+	if namespace := vaultCA.GetNamespace(); namespace != "" {
+		request.Header.Set("X-Vault-Namespace", namespace)
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, eris.Wrapf(err, "Vault request to %s failed", path)
+	}
+	defer response.Body.Close()
+
+	var vaultResp vaultResponse
+	if err := json.NewDecoder(response.Body).Decode(&vaultResp); err != nil && response.StatusCode < 300 {
+		return nil, eris.Wrapf(err, "decoding Vault response from %s", path)
+	}
+	if response.StatusCode >= 300 {
+		return nil, eris.Errorf("Vault request to %s failed with status %d: %s", path, response.StatusCode, strings.Join(vaultResp.Errors, "; "))
+	}
+	return &vaultResp, nil
+}
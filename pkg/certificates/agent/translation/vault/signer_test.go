@@ -0,0 +1,222 @@
+package vault_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation/vault"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/utils"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/keys"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	pkiutil "istio.io/istio/security/pkg/pki/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Signer", func() {
+	var (
+		ctx               context.Context
+		csr               []byte
+		issuedCertificate *certificatesv1.IssuedCertificate
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		privateKey, err := keys.GeneratePrivateKey(certificatesv1.CommonCertOptions_RSA, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		issuedCertificate = &certificatesv1.IssuedCertificate{
+			Spec: certificatesv1.IssuedCertificateSpec{
+				Hosts:       []string{"spiffe://cluster.local/ns/istio-system/sa/istiod-service-account"},
+				Org:         "Istio",
+				CertOptions: &certificatesv1.CommonCertOptions{TtlDays: 30},
+			},
+		}
+		csr, err = utils.GenerateCertificateSigningRequest(issuedCertificate.Spec.Hosts, issuedCertificate.Spec.Org, privateKey)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("fake Vault server", func() {
+		var (
+			server   *httptest.Server
+			requests map[string]map[string]interface{}
+			headers  map[string]http.Header
+		)
+
+		BeforeEach(func() {
+			requests = map[string]map[string]interface{}{}
+			headers = map[string]http.Header{}
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				var body map[string]interface{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				requests[r.URL.Path] = body
+				headers[r.URL.Path] = r.Header
+
+				switch r.URL.Path {
+				case "/v1/auth/kubernetes/login":
+					_, _ = w.Write([]byte(`{"auth": {"client_token": "login-token"}}`))
+				case "/v1/pki_int/sign/istio":
+					_, _ = w.Write([]byte(`{"data": {"certificate": "signed\n", "issuing_ca": "intermediate\n", "ca_chain": ["intermediate\n", "root\n"]}}`))
+				default:
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"errors": ["permission denied"]}`))
+				}
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("signs the CSR with a token", func() {
+			tokenSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: "gloo-mesh"},
+				Data:       map[string][]byte{"token": []byte("root-token")},
+			}
+			vaultCA := &certificatesv1.VaultCA{
+				Server:    server.URL,
+				CaPath:    "pki_int/sign/istio",
+				Namespace: "ns1",
+				AuthType: &certificatesv1.VaultCA_TokenSecretRef{
+					TokenSecretRef: &skv2corev1.ObjectRef{Name: "vault-token", Namespace: "gloo-mesh"},
+				},
+			}
+			inputs := input.NewInputSnapshotManualBuilder("test").
+				AddSecrets([]*corev1.Secret{tokenSecret}).
+				Build()
+
+			signedCertificate, err := vault.NewSigner().Sign(ctx, vaultCA, csr, issuedCertificate, inputs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signedCertificate.Certificate).To(Equal([]byte("signed\nintermediate\n")))
+			Expect(signedCertificate.RootCert).To(Equal([]byte("root\n")))
+
+			Expect(headers["/v1/pki_int/sign/istio"].Get("X-Vault-Token")).To(Equal("root-token"))
+			Expect(headers["/v1/pki_int/sign/istio"].Get("X-Vault-Namespace")).To(Equal("ns1"))
+			Expect(requests["/v1/pki_int/sign/istio"]).To(Equal(map[string]interface{}{
+				"csr":         string(csr),
+				"common_name": "Istio",
+				"uri_sans":    "spiffe://cluster.local/ns/istio-system/sa/istiod-service-account",
+				"format":      "pem",
+				"ttl":         "720h",
+			}))
+		})
+
+		It("signs the CSR with kubernetes auth", func() {
+			serviceAccount := &corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{Name: "cert-agent", Namespace: "gloo-mesh"},
+				Secrets:    []corev1.ObjectReference{{Name: "cert-agent-token"}},
+			}
+			serviceAccountSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cert-agent-token", Namespace: "gloo-mesh"},
+				Data:       map[string][]byte{"token": []byte("service-account-jwt")},
+			}
+			vaultCA := &certificatesv1.VaultCA{
+				Server: server.URL,
+				CaPath: "pki_int/sign/istio",
+				AuthType: &certificatesv1.VaultCA_KubernetesAuth{
+					KubernetesAuth: &certificatesv1.VaultKubernetesAuth{
+						Role: "gloo-mesh",
+						ServiceAccountLocation: &certificatesv1.VaultKubernetesAuth_ServiceAccountRef{
+							ServiceAccountRef: &skv2corev1.ObjectRef{Name: "cert-agent", Namespace: "gloo-mesh"},
+						},
+					},
+				},
+			}
+			inputs := input.NewInputSnapshotManualBuilder("test").
+				AddServiceAccounts([]*corev1.ServiceAccount{serviceAccount}).
+				AddSecrets([]*corev1.Secret{serviceAccountSecret}).
+				Build()
+
+			_, err := vault.NewSigner().Sign(ctx, vaultCA, csr, issuedCertificate, inputs)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(requests["/v1/auth/kubernetes/login"]).To(Equal(map[string]interface{}{
+				"role": "gloo-mesh",
+				"jwt":  "service-account-jwt",
+			}))
+			Expect(headers["/v1/pki_int/sign/istio"].Get("X-Vault-Token")).To(Equal("login-token"))
+		})
+
+		It("returns the errors reported by Vault", func() {
+			vaultCA := &certificatesv1.VaultCA{
+				Server: server.URL,
+				CaPath: "pki_int/sign/unknown",
+				AuthType: &certificatesv1.VaultCA_TokenSecretRef{
+					TokenSecretRef: &skv2corev1.ObjectRef{Name: "vault-token", Namespace: "gloo-mesh"},
+				},
+			}
+			inputs := input.NewInputSnapshotManualBuilder("test").
+				AddSecrets([]*corev1.Secret{{
+					ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: "gloo-mesh"},
+					Data:       map[string][]byte{"token": []byte("root-token")},
+				}}).
+				Build()
+
+			_, err := vault.NewSigner().Sign(ctx, vaultCA, csr, issuedCertificate, inputs)
+			Expect(err).To(MatchError(ContainSubstring("failed with status 403: permission denied")))
+		})
+	})
+
+	// run against a Vault dev server, e.g. `vault server -dev -dev-root-token-id=root`,
+	// by setting VAULT_ADDR and VAULT_TOKEN
+	Context("Vault dev server", func() {
+		var (
+			vaultAddr  string
+			vaultToken string
+		)
+
+		vaultRequest := func(path string, body string) {
+			request, err := http.NewRequest(http.MethodPost, vaultAddr+path, strings.NewReader(body))
+			Expect(err).NotTo(HaveOccurred())
+			request.Header.Set("X-Vault-Token", vaultToken)
+			response, err := http.DefaultClient.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+			_ = response.Body.Close()
+		}
+
+		BeforeEach(func() {
+			vaultAddr, vaultToken = os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN")
+			if vaultAddr == "" || vaultToken == "" {
+				Skip("VAULT_ADDR and VAULT_TOKEN are not set")
+			}
+			// mount a PKI secrets engine with a root CA, ignoring errors if it already exists
+			vaultRequest("/v1/sys/mounts/gloo-mesh-pki", `{"type": "pki", "config": {"max_lease_ttl": "87600h"}}`)
+			vaultRequest("/v1/gloo-mesh-pki/root/generate/internal", `{"common_name": "gloo-mesh-root", "ttl": "87600h"}`)
+		})
+
+		It("signs an intermediate CA certificate", func() {
+			vaultCA := &certificatesv1.VaultCA{
+				Server: vaultAddr,
+				CaPath: "gloo-mesh-pki/root/sign-intermediate",
+				AuthType: &certificatesv1.VaultCA_TokenSecretRef{
+					TokenSecretRef: &skv2corev1.ObjectRef{Name: "vault-token", Namespace: "gloo-mesh"},
+				},
+			}
+			inputs := input.NewInputSnapshotManualBuilder("test").
+				AddSecrets([]*corev1.Secret{{
+					ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: "gloo-mesh"},
+					Data:       map[string][]byte{"token": []byte(vaultToken)},
+				}}).
+				Build()
+
+			signedCertificate, err := vault.NewSigner().Sign(ctx, vaultCA, csr, issuedCertificate, inputs)
+			Expect(err).NotTo(HaveOccurred())
+
+			cert, err := pkiutil.ParsePemEncodedCertificate(signedCertificate.Certificate)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cert.IsCA).To(BeTrue())
+			rootCert, err := pkiutil.ParsePemEncodedCertificate(signedCertificate.RootCert)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cert.CheckSignatureFrom(rootCert)).To(Succeed())
+		})
+	})
+})
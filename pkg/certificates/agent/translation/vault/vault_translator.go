@@ -0,0 +1,66 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/output/certagent"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+)
+
+// NewTranslator returns a Translator which signs the certificate requests of IssuedCertificates
+// with a Vault intermediate CA, and otherwise defers to the given translator.
+// The given translator writes the certificate signed by Vault to the IssuedCertificateSecret.
+func NewTranslator(
+	translator translation.Translator,
+	signer Signer,
+	certificateRequestClient certificatesv1.CertificateRequestClient,
+) translation.Translator {
+	return &vaultTranslator{
+		Translator:               translator,
+		signer:                   signer,
+		certificateRequestClient: certificateRequestClient,
+	}
+}
+
+type vaultTranslator struct {
+	translation.Translator
+	signer                   Signer
+	certificateRequestClient certificatesv1.CertificateRequestClient
+}
+
+func (t *vaultTranslator) IssuedCertificateRequested(
+	ctx context.Context,
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	certificateRequest *certificatesv1.CertificateRequest,
+	inputs input.Snapshot,
+	outputs certagent.Builder,
+) error {
+	vaultCA := issuedCertificate.Spec.GetAgentCa().GetVault()
+	if vaultCA == nil || translation.CertificateRequestSigned(certificateRequest) {
+		return t.Translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, outputs)
+	}
+
+	contextutils.LoggerFrom(ctx).Infof("signing certificate request %v with Vault server %v", sets.Key(certificateRequest), vaultCA.GetServer())
+	signedCertificate, err := t.signer.Sign(
+		ctx,
+		vaultCA,
+		certificateRequest.Spec.GetCertificateSigningRequest(),
+		issuedCertificate,
+		inputs,
+	)
+	if err != nil {
+		return eris.Wrapf(err, "failed to sign certificate request %v with Vault", sets.Key(certificateRequest))
+	}
+
+	if err := translation.SignCertificateRequest(ctx, t.certificateRequestClient, certificateRequest, signedCertificate.Certificate, signedCertificate.RootCert); err != nil {
+		return err
+	}
+
+	// keep the private key and certificate request until the signed certificate is written
+	return t.Translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, outputs)
+}
@@ -0,0 +1,163 @@
+package vault_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
+	mock_certagent "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/output/certagent/mocks"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	mock_certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1/mocks"
+	mock_translation "github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation/vault"
+	mock_vault "github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation/vault/mocks"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VaultTranslator", func() {
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		mockTranslator               *mock_translation.MockTranslator
+		mockSigner                   *mock_vault.MockSigner
+		mockCertificateRequestClient *mock_certificatesv1.MockCertificateRequestClient
+		mockOutput                   *mock_certagent.MockBuilder
+
+		inputs             input.Snapshot
+		certificateRequest *certificatesv1.CertificateRequest
+	)
+
+	BeforeEach(func() {
+		ctrl, ctx = gomock.WithContext(context.Background(), GinkgoT())
+
+		mockTranslator = mock_translation.NewMockTranslator(ctrl)
+		mockSigner = mock_vault.NewMockSigner(ctrl)
+		mockCertificateRequestClient = mock_certificatesv1.NewMockCertificateRequestClient(ctrl)
+		mockOutput = mock_certagent.NewMockBuilder(ctrl)
+
+		inputs = input.NewInputSnapshotManualBuilder("test").Build()
+		certificateRequest = &certificatesv1.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "name",
+				Namespace:  "namespace",
+				Generation: 2,
+			},
+			Spec: certificatesv1.CertificateRequestSpec{
+				CertificateSigningRequest: []byte("csr"),
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("defers to the translator if the certificate is not issued by Vault", func() {
+		issuedCertificate := &certificatesv1.IssuedCertificate{}
+
+		mockTranslator.EXPECT().
+			IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput).
+			Return(nil)
+
+		translator := vault.NewTranslator(mockTranslator, mockSigner, mockCertificateRequestClient)
+		Expect(translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput)).To(Succeed())
+	})
+
+	It("signs the certificate request with Vault and records the signed certificate on its status", func() {
+		vaultCA := &certificatesv1.VaultCA{
+			Server: "https://vault:8200",
+			CaPath: "pki_int/sign/istio",
+		}
+		issuedCertificate := &certificatesv1.IssuedCertificate{
+			Spec: certificatesv1.IssuedCertificateSpec{
+				CertificateAuthority: &certificatesv1.IssuedCertificateSpec_AgentCa{
+					AgentCa: &certificatesv1.IntermediateCertificateAuthority{
+						CaSource: &certificatesv1.IntermediateCertificateAuthority_Vault{
+							Vault: vaultCA,
+						},
+					},
+				},
+			},
+		}
+
+		mockSigner.EXPECT().
+			Sign(ctx, vaultCA, []byte("csr"), issuedCertificate, inputs).
+			Return(&vault.SignedCertificate{
+				Certificate: []byte("signed"),
+				RootCert:    []byte("root"),
+			}, nil)
+
+		expectedRequest := certificateRequest.DeepCopy()
+		expectedRequest.Status = certificatesv1.CertificateRequestStatus{
+			ObservedGeneration: 2,
+			State:              certificatesv1.CertificateRequestStatus_FINISHED,
+			SignedCertificate:  []byte("signed"),
+			SigningRootCa:      []byte("root"),
+		}
+		mockCertificateRequestClient.EXPECT().
+			UpdateCertificateRequestStatus(ctx, expectedRequest).
+			Return(nil)
+
+		// the pending certificate request is passed on so the private key is kept
+		mockTranslator.EXPECT().
+			IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput).
+			Return(nil)
+
+		translator := vault.NewTranslator(mockTranslator, mockSigner, mockCertificateRequestClient)
+		Expect(translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput)).To(Succeed())
+		Expect(certificateRequest.Status.State).To(Equal(certificatesv1.CertificateRequestStatus_PENDING))
+	})
+
+	It("signs a certificate request which was replaced after Vault signed the previous request", func() {
+		vaultCA := &certificatesv1.VaultCA{
+			Server: "https://vault:8200",
+			CaPath: "pki_int/sign/istio",
+		}
+		issuedCertificate := &certificatesv1.IssuedCertificate{
+			Spec: certificatesv1.IssuedCertificateSpec{
+				CertificateAuthority: &certificatesv1.IssuedCertificateSpec_AgentCa{
+					AgentCa: &certificatesv1.IntermediateCertificateAuthority{
+						CaSource: &certificatesv1.IntermediateCertificateAuthority_Vault{
+							Vault: vaultCA,
+						},
+					},
+				},
+			},
+		}
+		// signed for the previous generation of the certificate request
+		certificateRequest.Status = certificatesv1.CertificateRequestStatus{
+			ObservedGeneration: 1,
+			State:              certificatesv1.CertificateRequestStatus_FINISHED,
+			SignedCertificate:  []byte("previous"),
+			SigningRootCa:      []byte("root"),
+		}
+
+		mockSigner.EXPECT().
+			Sign(ctx, vaultCA, []byte("csr"), issuedCertificate, inputs).
+			Return(&vault.SignedCertificate{
+				Certificate: []byte("signed"),
+				RootCert:    []byte("root"),
+			}, nil)
+
+		expectedRequest := certificateRequest.DeepCopy()
+		expectedRequest.Status = certificatesv1.CertificateRequestStatus{
+			ObservedGeneration: 2,
+			State:              certificatesv1.CertificateRequestStatus_FINISHED,
+			SignedCertificate:  []byte("signed"),
+			SigningRootCa:      []byte("root"),
+		}
+		mockCertificateRequestClient.EXPECT().
+			UpdateCertificateRequestStatus(ctx, expectedRequest).
+			Return(nil)
+
+		mockTranslator.EXPECT().
+			IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput).
+			Return(nil)
+
+		translator := vault.NewTranslator(mockTranslator, mockSigner, mockCertificateRequestClient)
+		Expect(translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, mockOutput)).To(Succeed())
+	})
+})
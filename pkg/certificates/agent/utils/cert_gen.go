@@ -2,35 +2,16 @@ package utils
 
 import (
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"strings"
+	"time"
 
 	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/keys"
 	pkiutil "istio.io/istio/security/pkg/pki/util"
 )
 
-const (
-	rsaKeySize = 4096
-)
-
-func GeneratePrivateKey(keySize int) ([]byte, error) {
-	if keySize == 0 {
-		keySize = rsaKeySize
-	}
-	priv, err := rsa.GenerateKey(rand.Reader, keySize)
-	if err != nil {
-		return nil, eris.Errorf("RSA key generation failed (%v)", err)
-	}
-	privKey := x509.MarshalPKCS1PrivateKey(priv)
-	keyBlock := &pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: privKey,
-	}
-	return pem.EncodeToMemory(keyBlock), nil
-}
-
 /*
 	The reason for these constants stem from the golang pem package
 	https://golang.org/pkg/encoding/pem/#Block
@@ -46,15 +27,14 @@ func GeneratePrivateKey(keySize int) ([]byte, error) {
 */
 const (
 	certificateRequest = "CERTIFICATE REQUEST"
+	certificate        = "CERTIFICATE"
 )
 
 func GenerateCertificateSigningRequest(hosts []string, org string, privateKey []byte) (csr []byte, err error) {
 
-	// Attempt to decode the key from the PEM format, currently only one format is supported (PKCS1)
-	block, _ := pem.Decode(privateKey)
-	priv, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	priv, err := keys.ParsePrivateKey(privateKey)
 	if err != nil {
-		return nil, eris.Wrapf(err, "unable to decode private key, currently only supporting PKCS1 encrypted keys")
+		return nil, err
 	}
 
 	template, err := pkiutil.GenCSRTemplate(pkiutil.CertOptions{
@@ -80,6 +60,33 @@ func GenerateCertificateSigningRequest(hosts []string, org string, privateKey []
 	return csrByt, nil
 }
 
+// Generate a PEM-encoded leaf certificate for the given private key, signed by the signing certificate.
+// The certificate may be used for both server and client authentication, and expires with the signing certificate.
+func GenerateLeafCertificate(hosts []string, org string, privateKey, signingCert, signingKey []byte) ([]byte, error) {
+	csrPem, err := GenerateCertificateSigningRequest(hosts, org, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	csr, err := pkiutil.ParsePemEncodedCSR(csrPem)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := pkiutil.ParsePemEncodedCertificate(signingCert)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keys.ParsePrivateKey(signingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	leafCert, err := pkiutil.GenCertFromCSR(csr, cert, csr.PublicKey, key, hosts, time.Until(cert.NotAfter), false)
+	if err != nil {
+		return nil, eris.Wrap(err, "leaf certificate creation failed")
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: certificate, Bytes: leafCert}), nil
+}
+
 /*
 	AppendRootCerts appends the root virtual mesh cert to the generated CaCert, It is yanked from the following Mesh
 	function:
@@ -0,0 +1,119 @@
+package keys
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/rotisserie/eris"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	pkiutil "istio.io/istio/security/pkg/pki/util"
+)
+
+const (
+	defaultRsaKeySize = 4096
+
+	// PEM block types of the encoded private keys
+	rsaPrivateKey   = "RSA PRIVATE KEY"
+	ecPrivateKey    = "EC PRIVATE KEY"
+	pkcs8PrivateKey = "PRIVATE KEY"
+	certificate     = "CERTIFICATE"
+)
+
+// Generate a PEM-encoded private key using the given algorithm.
+// RSA keys are PKCS1 encoded, ECDSA keys SEC1 encoded, and Ed25519 keys PKCS8 encoded.
+func GeneratePrivateKey(algorithm certificatesv1.CommonCertOptions_KeyAlgorithm, rsaKeySize int) ([]byte, error) {
+	switch algorithm {
+	case certificatesv1.CommonCertOptions_RSA:
+		if rsaKeySize == 0 {
+			rsaKeySize = defaultRsaKeySize
+		}
+		priv, err := rsa.GenerateKey(rand.Reader, rsaKeySize)
+		if err != nil {
+			return nil, eris.Errorf("RSA key generation failed (%v)", err)
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: rsaPrivateKey, Bytes: x509.MarshalPKCS1PrivateKey(priv)}), nil
+	case certificatesv1.CommonCertOptions_ECDSA_P256, certificatesv1.CommonCertOptions_ECDSA_P384:
+		curve := elliptic.P256()
+		if algorithm == certificatesv1.CommonCertOptions_ECDSA_P384 {
+			curve = elliptic.P384()
+		}
+		priv, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			return nil, eris.Errorf("ECDSA key generation failed (%v)", err)
+		}
+		privKey, err := x509.MarshalECPrivateKey(priv)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: ecPrivateKey, Bytes: privKey}), nil
+	case certificatesv1.CommonCertOptions_ED25519:
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, eris.Errorf("Ed25519 key generation failed (%v)", err)
+		}
+		privKey, err := x509.MarshalPKCS8PrivateKey(priv)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: pkcs8PrivateKey, Bytes: privKey}), nil
+	}
+	return nil, eris.Errorf("unsupported key algorithm %v", algorithm)
+}
+
+// Parse a PEM-encoded private key generated by GeneratePrivateKey.
+func ParsePrivateKey(privateKey []byte) (crypto.Signer, error) {
+	key, err := pkiutil.ParsePemEncodedKey(privateKey)
+	if err != nil {
+		return nil, eris.Wrap(err, "unable to decode private key")
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, eris.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+// Return an error if a certificate signed by the signing certificate would use a different key algorithm,
+// as certificate chains which mix key algorithms are not supported.
+func CheckSameAlgorithm(signingCert *x509.Certificate, csr *x509.CertificateRequest) error {
+	if signingCert.PublicKeyAlgorithm != csr.PublicKeyAlgorithm {
+		return mixedAlgorithmsError(csr.PublicKeyAlgorithm, signingCert.PublicKeyAlgorithm)
+	}
+	return nil
+}
+
+// Return an error if the PEM-encoded certificates use different key algorithms,
+// given a certificate chain followed by its root certificates.
+// Certificates which cannot be parsed are ignored, they are rejected by their consumers.
+func CheckChainAlgorithms(pemCerts ...[]byte) error {
+	var algorithm x509.PublicKeyAlgorithm
+	for _, certs := range pemCerts {
+		for block, rest := pem.Decode(certs); block != nil; block, rest = pem.Decode(rest) {
+			if block.Type != certificate {
+				continue
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				continue
+			}
+			if algorithm == x509.UnknownPublicKeyAlgorithm {
+				algorithm = cert.PublicKeyAlgorithm
+			} else if cert.PublicKeyAlgorithm != algorithm {
+				return mixedAlgorithmsError(algorithm, cert.PublicKeyAlgorithm)
+			}
+		}
+	}
+	return nil
+}
+
+func mixedAlgorithmsError(algorithm, signingAlgorithm x509.PublicKeyAlgorithm) error {
+	return eris.Errorf("the %v key of the certificate does not match the %v key of its signing certificate: "+
+		"certificate chains mixing key algorithms are not supported, use the same keyAlgorithm for the root and intermediate certificates",
+		algorithm, signingAlgorithm)
+}
@@ -0,0 +1,190 @@
+package rotation
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+)
+
+// The annotation of IssuedCertificates containing the furthest state of a root CA rotation which the issued certificate
+// may proceed to, as coordinated across the Meshes of a VirtualMesh.
+// An annotation is used as changing the spec of an IssuedCertificate restarts its workflow.
+const RootRotationStateAnnotation = "certificates.mesh.gloo.solo.io/root-rotation-state"
+
+// the states of a root CA rotation, in order
+var rootRotationStates = []certificatesv1.IssuedCertificateStatus_Rotation_State{
+	certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT,
+	certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE,
+	certificatesv1.IssuedCertificateStatus_Rotation_REMOVING_PREVIOUS_ROOT,
+}
+
+// Return the time at which the certificate enters its rotation grace period,
+// i.e. the time after which only the given ratio of its lifetime remains.
+// A ratio of 0 starts the grace period when the certificate expires.
+func GracePeriodStart(cert *x509.Certificate, gracePeriodRatio float32) time.Time {
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+	gracePeriod := time.Duration(float64(lifetime) * float64(gracePeriodRatio))
+	return cert.NotAfter.Add(-gracePeriod)
+}
+
+// Return true if the previously issued certificate of a rotation has expired,
+// at which point its root certificates no longer need to be distributed.
+func OverlapEnded(rotation *certificatesv1.IssuedCertificateStatus_Rotation, now time.Time) bool {
+	if rotation.GetState() != certificatesv1.IssuedCertificateStatus_Rotation_OVERLAPPING {
+		return false
+	}
+	previousNotAfter, err := ptypes.Timestamp(rotation.GetPreviousNotAfter())
+	if err != nil {
+		// the expiry of the previous certificate is unknown, so there is nothing left to wait for
+		return true
+	}
+	return !now.Before(previousNotAfter)
+}
+
+// Return true if the root CA of the issued certificate is being replaced.
+// The root CA is replaced in stages, restarting the workloads after each stage:
+// the new root certificate is first trusted alongside the previous roots,
+// then the certificate issued by the new root CA is distributed,
+// and finally the previous root certificates are removed.
+func RootRotationInProgress(rotation *certificatesv1.IssuedCertificateStatus_Rotation) bool {
+	switch rotation.GetState() {
+	case certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT,
+		certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE,
+		certificatesv1.IssuedCertificateStatus_Rotation_REMOVING_PREVIOUS_ROOT:
+		return true
+	}
+	return false
+}
+
+// Return true if the PEM-encoded root certificates contain all of the given certificates.
+func ContainsRootCerts(rootCerts, certs []byte) bool {
+	current := decodeCerts(rootCerts)
+	for _, cert := range decodeCerts(certs) {
+		if !containsCert(current, cert) {
+			return false
+		}
+	}
+	return true
+}
+
+// Return the states of a root CA rotation which have been completed by the issued certificate.
+// Issued certificates which are not being rotated have completed every state once their current spec has been issued,
+// and none while it is being issued, as the certificate signed for it may start a root CA rotation.
+func CompletedRootRotationStates(issuedCertificate *certificatesv1.IssuedCertificate) int {
+	rotation := issuedCertificate.Status.GetRotation()
+	if !RootRotationInProgress(rotation) {
+		if issuedCertificate.Status.GetState() == certificatesv1.IssuedCertificateStatus_FINISHED &&
+			issuedCertificate.Status.GetObservedGeneration() == issuedCertificate.Generation {
+			return len(rootRotationStates)
+		}
+		return 0
+	}
+	completed := rootRotationStateIndex(rotation.GetState())
+	if rotation.GetStateCompleted() {
+		completed++
+	}
+	return completed
+}
+
+// Return the furthest state of a root CA rotation which issued certificates may proceed to,
+// once each of them has completed the given number of states.
+func AllowedRootRotationState(completedStates int) certificatesv1.IssuedCertificateStatus_Rotation_State {
+	if completedStates >= len(rootRotationStates) {
+		// the final state is left without waiting for other issued certificates
+		return rootRotationStates[len(rootRotationStates)-1]
+	}
+	return rootRotationStates[completedStates]
+}
+
+// Record the furthest state of a root CA rotation which the issued certificate may proceed to.
+func SetAllowedRootRotationState(
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	state certificatesv1.IssuedCertificateStatus_Rotation_State,
+) {
+	if issuedCertificate.Annotations == nil {
+		issuedCertificate.Annotations = map[string]string{}
+	}
+	issuedCertificate.Annotations[RootRotationStateAnnotation] = state.String()
+}
+
+// Return true if the issued certificate may proceed to the given state of a root CA rotation.
+// The first state may always be entered, and issued certificates without a recorded state are rotated independently.
+func RootRotationStateAllowed(
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	state certificatesv1.IssuedCertificateStatus_Rotation_State,
+) bool {
+	allowed, ok := issuedCertificate.Annotations[RootRotationStateAnnotation]
+	if !ok {
+		return true
+	}
+	allowedState := certificatesv1.IssuedCertificateStatus_Rotation_State(
+		certificatesv1.IssuedCertificateStatus_Rotation_State_value[allowed],
+	)
+	return rootRotationStateIndex(state) <= rootRotationStateIndex(allowedState)
+}
+
+// returns the index of the state among the states of a root CA rotation, or 0 if it is not a state of a root CA rotation
+func rootRotationStateIndex(state certificatesv1.IssuedCertificateStatus_Rotation_State) int {
+	for i, rootRotationState := range rootRotationStates {
+		if state == rootRotationState {
+			return i
+		}
+	}
+	return 0
+}
+
+// Return the PEM-encoded root certificates followed by the previous root certificates which are not among them.
+// The resulting bundle is trusted by workloads during a rotation, so that certificates issued
+// before and after the rotation are accepted.
+func BundleRootCerts(rootCerts, previousRootCerts []byte) []byte {
+	bundle := append([]byte{}, rootCerts...)
+	current := decodeCerts(rootCerts)
+	for _, previous := range decodeCerts(previousRootCerts) {
+		if containsCert(current, previous) {
+			continue
+		}
+		if len(bundle) > 0 && !bytes.HasSuffix(bundle, []byte("\n")) {
+			bundle = append(bundle, '\n')
+		}
+		bundle = append(bundle, pem.EncodeToMemory(previous)...)
+	}
+	return bundle
+}
+
+// Return the root certificates of the bundle which belong to the given certificate chain,
+// removing the root certificates of previously issued certificates from the bundle.
+func RootCertsInChain(rootCerts, certChain []byte) []byte {
+	chain := decodeCerts(certChain)
+	var result []byte
+	for _, rootCert := range decodeCerts(rootCerts) {
+		if containsCert(chain, rootCert) {
+			result = append(result, pem.EncodeToMemory(rootCert)...)
+		}
+	}
+	return result
+}
+
+func decodeCerts(pemCerts []byte) []*pem.Block {
+	var blocks []*pem.Block
+	for {
+		var block *pem.Block
+		block, pemCerts = pem.Decode(pemCerts)
+		if block == nil {
+			return blocks
+		}
+		blocks = append(blocks, block)
+	}
+}
+
+func containsCert(blocks []*pem.Block, cert *pem.Block) bool {
+	for _, block := range blocks {
+		if bytes.Equal(block.Bytes, cert.Bytes) {
+			return true
+		}
+	}
+	return false
+}
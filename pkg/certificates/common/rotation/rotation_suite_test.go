@@ -0,0 +1,13 @@
+package rotation_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRotation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rotation Suite")
+}
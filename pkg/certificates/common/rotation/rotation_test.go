@@ -0,0 +1,122 @@
+package rotation_test
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/utils"
+	. "github.com/solo-io/gloo-mesh/pkg/certificates/common/rotation"
+	pkiutil "istio.io/istio/security/pkg/pki/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Rotation", func() {
+	generateRootCert := func() []byte {
+		rootCert, _, err := pkiutil.GenCertKeyFromOptions(pkiutil.CertOptions{
+			Org:          "org",
+			IsCA:         true,
+			IsSelfSigned: true,
+			TTL:          time.Hour,
+			RSAKeySize:   2048,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return rootCert
+	}
+
+	It("starts the grace period once the given ratio of the certificate's lifetime remains", func() {
+		notBefore := time.Now().Add(-time.Hour).Truncate(time.Second)
+		certPem, _, err := pkiutil.GenCertKeyFromOptions(pkiutil.CertOptions{
+			Org:          "org",
+			IsCA:         true,
+			IsSelfSigned: true,
+			NotBefore:    notBefore,
+			TTL:          10 * time.Hour,
+			RSAKeySize:   2048,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		cert, err := pkiutil.ParsePemEncodedCertificate(certPem)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(GracePeriodStart(cert, 0.2)).To(BeTemporally("~", notBefore.Add(8*time.Hour), time.Second))
+		Expect(GracePeriodStart(cert, 0)).To(Equal(cert.NotAfter))
+	})
+
+	It("ends the overlap once the previous certificate has expired", func() {
+		previousNotAfter := time.Now()
+		previousNotAfterProto, err := ptypes.TimestampProto(previousNotAfter)
+		Expect(err).NotTo(HaveOccurred())
+		rotation := &certificatesv1.IssuedCertificateStatus_Rotation{
+			State:            certificatesv1.IssuedCertificateStatus_Rotation_OVERLAPPING,
+			PreviousNotAfter: previousNotAfterProto,
+		}
+
+		Expect(OverlapEnded(rotation, previousNotAfter.Add(-time.Minute))).To(BeFalse())
+		Expect(OverlapEnded(rotation, previousNotAfter)).To(BeTrue())
+
+		rotation.State = certificatesv1.IssuedCertificateStatus_Rotation_ROTATING
+		Expect(OverlapEnded(rotation, previousNotAfter)).To(BeFalse())
+		Expect(OverlapEnded(nil, previousNotAfter)).To(BeFalse())
+	})
+
+	It("bundles the previous root certificates which differ from the current ones", func() {
+		currentRoot := generateRootCert()
+		previousRoot := generateRootCert()
+
+		Expect(BundleRootCerts(currentRoot, previousRoot)).To(Equal(utils.AppendRootCerts(currentRoot, previousRoot)))
+		Expect(BundleRootCerts(currentRoot, currentRoot)).To(Equal(currentRoot))
+		Expect(BundleRootCerts(currentRoot, utils.AppendRootCerts(previousRoot, currentRoot))).To(Equal(utils.AppendRootCerts(currentRoot, previousRoot)))
+	})
+
+	It("removes the root certificates which do not belong to the certificate chain", func() {
+		currentRoot := generateRootCert()
+		previousRoot := generateRootCert()
+		certChain := utils.AppendRootCerts([]byte("-----BEGIN CERTIFICATE-----\nY2VydA==\n-----END CERTIFICATE-----\n"), currentRoot)
+
+		Expect(RootCertsInChain(utils.AppendRootCerts(currentRoot, previousRoot), certChain)).To(Equal(currentRoot))
+		Expect(RootCertsInChain(currentRoot, certChain)).To(Equal(currentRoot))
+	})
+
+	It("allows each state of a root CA rotation once every issued certificate has completed the prior state", func() {
+		issuedCertificate := &certificatesv1.IssuedCertificate{
+			ObjectMeta: metav1.ObjectMeta{Generation: 2},
+			Status: certificatesv1.IssuedCertificateStatus{
+				ObservedGeneration: 1,
+				State:              certificatesv1.IssuedCertificateStatus_FINISHED,
+			},
+		}
+		// the certificate for the current spec may yet start a root CA rotation
+		Expect(CompletedRootRotationStates(issuedCertificate)).To(Equal(0))
+
+		issuedCertificate.Status.ObservedGeneration = 2
+		Expect(CompletedRootRotationStates(issuedCertificate)).To(Equal(3))
+
+		issuedCertificate.Status.Rotation = &certificatesv1.IssuedCertificateStatus_Rotation{
+			State: certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE,
+		}
+		Expect(CompletedRootRotationStates(issuedCertificate)).To(Equal(1))
+		issuedCertificate.Status.Rotation.StateCompleted = true
+		Expect(CompletedRootRotationStates(issuedCertificate)).To(Equal(2))
+
+		Expect(AllowedRootRotationState(0)).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT))
+		Expect(AllowedRootRotationState(2)).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_REMOVING_PREVIOUS_ROOT))
+		Expect(AllowedRootRotationState(3)).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_REMOVING_PREVIOUS_ROOT))
+	})
+
+	It("only proceeds to the states of a root CA rotation recorded on the issued certificate", func() {
+		issuedCertificate := &certificatesv1.IssuedCertificate{}
+		// issued certificates without a recorded state are rotated independently
+		Expect(RootRotationStateAllowed(issuedCertificate, certificatesv1.IssuedCertificateStatus_Rotation_REMOVING_PREVIOUS_ROOT)).To(BeTrue())
+
+		SetAllowedRootRotationState(issuedCertificate, certificatesv1.IssuedCertificateStatus_Rotation_NOT_ROTATING)
+		Expect(RootRotationStateAllowed(issuedCertificate, certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT)).To(BeTrue())
+		Expect(RootRotationStateAllowed(issuedCertificate, certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE)).To(BeFalse())
+
+		SetAllowedRootRotationState(issuedCertificate, certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE)
+		Expect(issuedCertificate.Annotations).To(HaveKeyWithValue(RootRotationStateAnnotation, "PROPAGATING_CERTIFICATE"))
+		Expect(RootRotationStateAllowed(issuedCertificate, certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE)).To(BeTrue())
+		Expect(RootRotationStateAllowed(issuedCertificate, certificatesv1.IssuedCertificateStatus_Rotation_REMOVING_PREVIOUS_ROOT)).To(BeFalse())
+	})
+})
@@ -71,3 +71,29 @@ func IntermediateCADataFromSecretData(data map[string][]byte) IntermediateCAData
 		CaPrivateKey: caKey,
 	}
 }
+
+const (
+	// GatewayCertID is the ID/name for the certificate chain presented by a gateway.
+	// Matches the keys expected by Istio for gateway credentials.
+	GatewayCertID = "cert"
+	// GatewayKeyID is the ID/name for the private key of a gateway.
+	GatewayKeyID = "key"
+	// GatewayCaCertID is the ID/name for the trusted root certificates used by a gateway to validate peers.
+	GatewayCaCertID = "cacert"
+)
+
+// The credentials used by the gateways of a mesh to authenticate traffic from other meshes
+// when the meshes do not share a root of trust
+type GatewayCredentialData struct {
+	CertChain   []byte
+	PrivateKey  []byte
+	TrustBundle []byte
+}
+
+func (d GatewayCredentialData) ToSecretData() map[string][]byte {
+	return map[string][]byte{
+		GatewayCertID:   d.CertChain,
+		GatewayKeyID:    d.PrivateKey,
+		GatewayCaCertID: d.TrustBundle,
+	}
+}
@@ -2,6 +2,7 @@ package reconciliation_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
@@ -16,6 +17,7 @@ import (
 	mock_translation "github.com/solo-io/gloo-mesh/pkg/certificates/issuer/translation/mocks"
 	"github.com/solo-io/gloo-mesh/test/matchers"
 	skv2_matchers "github.com/solo-io/skv2/test/matchers"
+	pkiutil "istio.io/istio/security/pkg/pki/util"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -247,4 +249,81 @@ var _ = Describe("CertIssueReconciler", func() {
 		}))
 
 	})
+
+	It("will sign the certificate request again once the signed certificate enters its grace period", func() {
+		reconcileFunc := reconciliation.NewCertificateRequestReconciler(
+			ctx,
+			mockBuilder,
+			func(ctx context.Context, snapshot input.Snapshot) error {
+				return nil
+			},
+			mockTranslator,
+		)
+
+		signedCert, _, err := pkiutil.GenCertKeyFromOptions(pkiutil.CertOptions{
+			Org:          "org",
+			IsCA:         true,
+			IsSelfSigned: true,
+			NotBefore:    time.Now().Add(-9 * time.Hour),
+			TTL:          10 * time.Hour,
+			RSAKeySize:   2048,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		certRequest := &certificatesv1.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "issued-cert",
+				Namespace:  "ns",
+				Generation: 2,
+			},
+			Spec: certificatesv1.CertificateRequestSpec{
+				CertificateSigningRequest: []byte("hello"),
+			},
+			Status: certificatesv1.CertificateRequestStatus{
+				ObservedGeneration: 2,
+				State:              certificatesv1.CertificateRequestStatus_FINISHED,
+				SignedCertificate:  signedCert,
+				SigningRootCa:      []byte("ca"),
+			},
+		}
+
+		issuedCert := &certificatesv1.IssuedCertificate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "issued-cert",
+				Namespace: "ns",
+			},
+			Spec: certificatesv1.IssuedCertificateSpec{
+				CertOptions: &certificatesv1.CommonCertOptions{
+					SecretRotationGracePeriodRatio: 0.2,
+				},
+			},
+		}
+
+		mockBuilder.EXPECT().
+			BuildSnapshot(gomock.Any(), "cert-issuer", input.BuildOptions{}).
+			Return(input.NewSnapshot(
+				"hello",
+				v1sets.NewIssuedCertificateSet(issuedCert),
+				v1sets.NewCertificateRequestSet(certRequest),
+			), nil)
+
+		output := &translation.Output{
+			SignedCertificate: []byte("renewed cert"),
+			SigningRootCa:     []byte("ca"),
+		}
+
+		mockTranslator.EXPECT().
+			Translate(gomock.Any(), certRequest, issuedCert).
+			Return(output, nil)
+
+		_, err = reconcileFunc(nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(&certRequest.Status).To(skv2_matchers.MatchProto(&certificatesv1.CertificateRequestStatus{
+			ObservedGeneration: 2,
+			State:              certificatesv1.CertificateRequestStatus_FINISHED,
+			SignedCertificate:  output.SignedCertificate,
+			SigningRootCa:      output.SigningRootCa,
+		}))
+	})
 })
@@ -8,11 +8,13 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/issuer/input"
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	v1sets "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/rotation"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/issuer/translation"
 	"github.com/solo-io/go-utils/contextutils"
 	skinput "github.com/solo-io/skv2/contrib/pkg/input"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
 	"github.com/solo-io/skv2/pkg/ezkube"
+	pkiutil "istio.io/istio/security/pkg/pki/util"
 )
 
 // function which defines how the cert issuer reconciler should be registered with internal components.
@@ -97,11 +99,11 @@ func (r *certIssuerReconciler) reconcileCertificateRequest(certificateRequest *c
 
 	switch certificateRequest.Status.State {
 	case certificatesv1.CertificateRequestStatus_FINISHED:
-		if len(certificateRequest.Status.SignedCertificate) > 0 {
+		if len(certificateRequest.Status.SignedCertificate) > 0 && !r.signedCertificateExpiring(certificateRequest, issuedCertificates) {
 			contextutils.LoggerFrom(r.ctx).Debugf("skipping cert request %v which has already been fulfilled", sets.Key(certificateRequest))
 			return nil
 		}
-		// else treat as pending
+		// else treat as pending, signing the request again if the signed certificate is about to expire
 		fallthrough
 	case certificatesv1.CertificateRequestStatus_FAILED:
 		// restart the workflow from PENDING
@@ -134,3 +136,21 @@ func (r *certIssuerReconciler) reconcileCertificateRequest(certificateRequest *c
 
 	return nil
 }
+
+// returns true if the certificate signed for the request has entered the rotation grace period of its IssuedCertificate
+func (r *certIssuerReconciler) signedCertificateExpiring(
+	certificateRequest *certificatesv1.CertificateRequest,
+	issuedCertificates v1sets.IssuedCertificateSet,
+) bool {
+	issuedCertificate, err := issuedCertificates.Find(certificateRequest)
+	if err != nil {
+		return false
+	}
+	signedCert, err := pkiutil.ParsePemEncodedCertificate(certificateRequest.Status.SignedCertificate)
+	if err != nil {
+		contextutils.LoggerFrom(r.ctx).Warnf("failed to parse certificate signed for cert request %v: %v", sets.Key(certificateRequest), err)
+		return false
+	}
+	gracePeriodStart := rotation.GracePeriodStart(signedCert, issuedCertificate.Spec.GetCertOptions().GetSecretRotationGracePeriodRatio())
+	return !time.Now().Before(gracePeriodStart)
+}
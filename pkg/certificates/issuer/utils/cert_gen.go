@@ -4,6 +4,7 @@ import (
 	"encoding/pem"
 	"time"
 
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/keys"
 	pkiutil "istio.io/istio/security/pkg/pki/util"
 )
 
@@ -48,6 +49,9 @@ func GenCertForCSR(
 	if err != nil {
 		return nil, err
 	}
+	if err := keys.CheckSameAlgorithm(cert, csr); err != nil {
+		return nil, err
+	}
 
 	newCertBytes, err := pkiutil.GenCertFromCSR(
 		csr,
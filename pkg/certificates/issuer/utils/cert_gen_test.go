@@ -6,25 +6,46 @@ import (
 	"time"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/utils"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/keys"
 	. "github.com/solo-io/gloo-mesh/pkg/certificates/issuer/utils"
 	"istio.io/istio/security/pkg/pki/util"
 )
 
 var _ = Describe("CertGen workflow", func() {
-	assertCsrWorks := func(signingRoot, signingKey []byte) {
-		privateKey, err := utils.GeneratePrivateKey(4096)
+	hosts := []string{"spiffe://custom-domain/ns/istio-system/sa/istio-pilot-service-account"}
+
+	generateCsr := func(algorithm certificatesv1.CommonCertOptions_KeyAlgorithm) []byte {
+		privateKey, err := keys.GeneratePrivateKey(algorithm, 4096)
 		Expect(err).NotTo(HaveOccurred())
 
-		hosts := []string{"spiffe://custom-domain/ns/istio-system/sa/istio-pilot-service-account"}
 		csr, err := utils.GenerateCertificateSigningRequest(
 			hosts,
 			"gloo-mesh",
 			privateKey,
 		)
 		Expect(err).NotTo(HaveOccurred())
+		return csr
+	}
 
+	generateRoot := func(algorithm certificatesv1.CommonCertOptions_KeyAlgorithm) ([]byte, []byte) {
+		signingKey, err := keys.GeneratePrivateKey(algorithm, 4096)
+		Expect(err).NotTo(HaveOccurred())
+		signingRoot, _, err := util.GenRootCertFromExistingKey(util.CertOptions{
+			Org:           "org",
+			IsCA:          true,
+			IsSelfSigned:  true,
+			TTL:           time.Hour * 24 * 365,
+			SignerPrivPem: signingKey,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return signingRoot, signingKey
+	}
+
+	assertCsrWorks := func(csr, signingRoot, signingKey []byte) *x509.Certificate {
 		inetermediaryCert, err := GenCertForCSR(
 			hosts,
 			csr,
@@ -37,6 +58,7 @@ var _ = Describe("CertGen workflow", func() {
 		cert, err := x509.ParseCertificate(pemByt.Bytes)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(cert.IsCA).To(BeTrue())
+		return cert
 	}
 
 	It("generates a certificate using generated self signed cert, private key, and certificate signing request", func() {
@@ -52,6 +74,36 @@ var _ = Describe("CertGen workflow", func() {
 		signingRoot, signingKey, err := util.GenCertKeyFromOptions(options)
 		Expect(err).NotTo(HaveOccurred())
 
-		assertCsrWorks(signingRoot, signingKey)
+		assertCsrWorks(generateCsr(certificatesv1.CommonCertOptions_RSA), signingRoot, signingKey)
+	})
+
+	DescribeTable("generates a certificate for each key algorithm",
+		func(algorithm certificatesv1.CommonCertOptions_KeyAlgorithm, publicKeyAlgorithm x509.PublicKeyAlgorithm) {
+			signingRoot, signingKey := generateRoot(algorithm)
+
+			cert := assertCsrWorks(generateCsr(algorithm), signingRoot, signingKey)
+			Expect(cert.PublicKeyAlgorithm).To(Equal(publicKeyAlgorithm))
+
+			rootCert, err := util.ParsePemEncodedCertificate(signingRoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cert.CheckSignatureFrom(rootCert)).To(Succeed())
+		},
+		Entry("RSA", certificatesv1.CommonCertOptions_RSA, x509.RSA),
+		Entry("ECDSA P-256", certificatesv1.CommonCertOptions_ECDSA_P256, x509.ECDSA),
+		Entry("ECDSA P-384", certificatesv1.CommonCertOptions_ECDSA_P384, x509.ECDSA),
+		Entry("Ed25519", certificatesv1.CommonCertOptions_ED25519, x509.Ed25519),
+	)
+
+	It("rejects certificate signing requests using a different key algorithm than the signing cert", func() {
+		signingRoot, signingKey := generateRoot(certificatesv1.CommonCertOptions_RSA)
+
+		_, err := GenCertForCSR(
+			hosts,
+			generateCsr(certificatesv1.CommonCertOptions_ECDSA_P256),
+			signingRoot,
+			signingKey,
+			0,
+		)
+		Expect(err).To(MatchError(ContainSubstring("the ECDSA key of the certificate does not match the RSA key of its signing certificate")))
 	})
 })
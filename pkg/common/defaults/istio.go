@@ -7,10 +7,16 @@ var DefaultGatewayWorkloadLabels = map[string]string{
 	IstioGatewayLabelKey: IstioIngressGatewayLabelValue,
 }
 
+// the labels of the egress gateway deployed by the default Istio installation profiles
+var DefaultEgressGatewayWorkloadLabels = map[string]string{
+	IstioGatewayLabelKey: IstioEgressGatewayLabelValue,
+}
+
 const (
 	// Defaults to {"istio": "ingressgateway"} based on https://github.com/istio/istio/blob/ab6cc48134a698d7ad218a83390fe27e8098919f/pkg/config/constants/constants.go#L73
 	IstioGatewayLabelKey          = "istio"
 	IstioIngressGatewayLabelValue = "ingressgateway"
+	IstioEgressGatewayLabelValue  = "egressgateway"
 	// The name of the externally-reachable port on which the ingress gateway is listening for TLS connections.
 	IstioGatewayTlsPortName = "tls"
 )
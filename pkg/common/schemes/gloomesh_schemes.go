@@ -8,6 +8,7 @@ import (
 	smisplitv1alpha2 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	consulv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
 	enterprisenetworkingv1beta1 "github.com/solo-io/gloo-mesh/pkg/api/networking.enterprise.mesh.gloo.solo.io/v1beta1"
 	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	observabilityv1 "github.com/solo-io/gloo-mesh/pkg/api/observability.enterprise.mesh.gloo.solo.io/v1"
@@ -41,6 +42,7 @@ var SchemeBuilder = runtime.SchemeBuilder{
 	smispecsv1alpha3.AddToScheme,
 	smiaccess1alpha2.AddToScheme,
 	appmeshv1beta2.AddToScheme,
+	consulv1alpha1.AddToScheme,
 
 	// sk types
 	skv2multiclusterv1alpha1.AddToScheme,
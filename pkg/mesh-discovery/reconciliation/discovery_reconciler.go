@@ -17,6 +17,7 @@ import (
 	v1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"github.com/solo-io/skv2/pkg/reconcile"
 	"github.com/solo-io/skv2/pkg/verifier"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/hashicorp/go-multierror"
@@ -82,6 +83,12 @@ func Start(
 			Version: appmeshv1beta2.GroupVersion.Version,
 			Kind:    "Mesh",
 		}: verifier.ServerVerifyOption_IgnoreIfNotPresent,
+		// only warn (avoids error) if Istio ServiceEntry resource is not available on cluster
+		schema.GroupVersionKind{
+			Group:   istionetworkingv1alpha3.SchemeGroupVersion.Group,
+			Version: istionetworkingv1alpha3.SchemeGroupVersion.Version,
+			Kind:    "ServiceEntry",
+		}: verifier.ServerVerifyOption_IgnoreIfNotPresent,
 	})
 	translator := translation.NewTranslator(translation.DefaultDependencyFactory)
 	r := &discoveryReconciler{
@@ -113,6 +120,9 @@ func Start(
 					Meshes: reconcile.Options{
 						Verifier: verifier,
 					},
+					ServiceEntries: reconcile.Options{
+						Verifier: verifier,
+					},
 					Predicates: []predicate.Predicate{filterDiscoveryEvents},
 				},
 				Local:             input.LocalReconcileOptions{},
@@ -161,6 +171,12 @@ func (r *discoveryReconciler) reconcile(obj ezkube.ClusterResourceId) (bool, err
 		Meshes: input.ResourceDiscoveryInputBuildOptions{
 			Verifier: r.verifier,
 		},
+		// ignore NoKindMatchError for Istio ServiceEntry CRs
+		// (only clusters with Istio installed will
+		// have this kind registered)
+		ServiceEntries: input.ResourceDiscoveryInputBuildOptions{
+			Verifier: r.verifier,
+		},
 	})
 	if err != nil {
 		// failed to read from cache; should never happen
@@ -3,6 +3,7 @@ package destination
 import (
 	"context"
 
+	v1alpha3sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
 	corev1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/destination/detector"
@@ -12,7 +13,8 @@ import (
 
 //go:generate mockgen -source ./destination_translator.go -destination mocks/destination_translator.go
 
-// the destination translator converts deployments with injected sidecars into Destination CRs
+// the destination translator converts deployments with injected sidecars into Destination CRs,
+// and user-authored Istio ServiceEntries into ExternalService Destination CRs
 type Translator interface {
 	TranslateDestinations(
 		ctx context.Context,
@@ -22,16 +24,24 @@ type Translator interface {
 		workloads v1.WorkloadSet,
 		meshes v1.MeshSet,
 		endpoints corev1sets.EndpointsSet,
+		serviceEntries v1alpha3sets.ServiceEntrySet,
 	) v1.DestinationSet
 }
 
 type translator struct {
-	ctx                 context.Context
-	destinationDetector detector.DestinationDetector
+	ctx                     context.Context
+	destinationDetector     detector.DestinationDetector
+	externalServiceDetector detector.ExternalServiceDetector
 }
 
-func NewTranslator(destinationDetector detector.DestinationDetector) Translator {
-	return &translator{destinationDetector: destinationDetector}
+func NewTranslator(
+	destinationDetector detector.DestinationDetector,
+	externalServiceDetector detector.ExternalServiceDetector,
+) Translator {
+	return &translator{
+		destinationDetector:     destinationDetector,
+		externalServiceDetector: externalServiceDetector,
+	}
 }
 
 func (t *translator) TranslateDestinations(
@@ -42,6 +52,7 @@ func (t *translator) TranslateDestinations(
 	workloads v1.WorkloadSet,
 	meshes v1.MeshSet,
 	endpoints corev1sets.EndpointsSet,
+	serviceEntries v1alpha3sets.ServiceEntrySet,
 ) v1.DestinationSet {
 
 	DestinationSet := v1.NewDestinationSet()
@@ -62,5 +73,18 @@ func (t *translator) TranslateDestinations(
 		contextutils.LoggerFrom(t.ctx).Debugf("detected destination %v", sets.Key(destination))
 		DestinationSet.Insert(destination)
 	}
+
+	for _, serviceEntry := range serviceEntries.List() {
+		destination := t.externalServiceDetector.DetectExternalService(
+			ctx,
+			serviceEntry,
+			meshes,
+		)
+		if destination == nil {
+			continue
+		}
+		contextutils.LoggerFrom(t.ctx).Debugf("detected external service destination %v", sets.Key(destination))
+		DestinationSet.Insert(destination)
+	}
 	return DestinationSet
 }
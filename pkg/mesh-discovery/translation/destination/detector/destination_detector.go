@@ -138,6 +138,16 @@ func addMeshForKubeService(
 					validMesh = ezkube.MakeObjectRef(mesh)
 					break
 				}
+			case *v1.MeshSpec_Linkerd:
+				if typedMesh.Linkerd.GetInstallation().GetCluster() == service.GetClusterName() {
+					validMesh = ezkube.MakeObjectRef(mesh)
+					break
+				}
+			case *v1.MeshSpec_ConsulConnect:
+				if typedMesh.ConsulConnect.GetInstallation().GetCluster() == service.GetClusterName() {
+					validMesh = ezkube.MakeObjectRef(mesh)
+					break
+				}
 			}
 		}
 
@@ -0,0 +1,103 @@
+package detector
+
+import (
+	"context"
+
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+)
+
+// the ExternalServiceDetector detects Destinations from user-authored Istio ServiceEntries,
+// which describe services external to the Mesh.
+// If no Istio Mesh is detected on the ServiceEntry's cluster, nil is returned
+type ExternalServiceDetector interface {
+	DetectExternalService(
+		ctx context.Context,
+		serviceEntry *networkingv1alpha3.ServiceEntry,
+		meshes discoveryv1sets.MeshSet,
+	) *discoveryv1.Destination
+}
+
+type externalServiceDetector struct{}
+
+func NewExternalServiceDetector() ExternalServiceDetector {
+	return &externalServiceDetector{}
+}
+
+func (d *externalServiceDetector) DetectExternalService(
+	ctx context.Context,
+	serviceEntry *networkingv1alpha3.ServiceEntry,
+	meshes discoveryv1sets.MeshSet,
+) *discoveryv1.Destination {
+	// ServiceEntries output by Gloo Mesh (i.e. for federation) do not represent external services
+	if _, translated := serviceEntry.Labels[metautils.OwnershipLabelKey]; translated {
+		return nil
+	}
+
+	if len(serviceEntry.Spec.GetHosts()) == 0 {
+		contextutils.LoggerFrom(ctx).Debugf("ignoring ServiceEntry %v with no hosts", sets.Key(serviceEntry))
+		return nil
+	}
+
+	mesh := getIstioMeshForCluster(meshes, serviceEntry.GetClusterName())
+	if mesh == nil {
+		return nil
+	}
+
+	return &discoveryv1.Destination{
+		ObjectMeta: utils.DiscoveredObjectMeta(serviceEntry),
+		Spec: discoveryv1.DestinationSpec{
+			Type: &discoveryv1.DestinationSpec_ExternalService_{
+				ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+					Name:      serviceEntry.GetName(),
+					Hosts:     serviceEntry.Spec.GetHosts(),
+					Addresses: serviceEntry.Spec.GetAddresses(),
+					Ports:     convertServiceEntryPorts(serviceEntry),
+					Endpoints: convertServiceEntryEndpoints(serviceEntry),
+					Ref:       ezkube.MakeClusterObjectRef(serviceEntry),
+				},
+			},
+			Mesh: ezkube.MakeObjectRef(mesh),
+		},
+	}
+}
+
+// ServiceEntries are only consumed by Istio, so the Destination belongs to the Istio Mesh on the same cluster
+func getIstioMeshForCluster(meshes discoveryv1sets.MeshSet, clusterName string) *discoveryv1.Mesh {
+	for _, mesh := range meshes.List() {
+		istio := mesh.Spec.GetIstio()
+		if istio != nil && istio.GetInstallation().GetCluster() == clusterName {
+			return mesh
+		}
+	}
+	return nil
+}
+
+func convertServiceEntryPorts(serviceEntry *networkingv1alpha3.ServiceEntry) []*discoveryv1.DestinationSpec_ExternalService_ServicePort {
+	var ports []*discoveryv1.DestinationSpec_ExternalService_ServicePort
+	for _, port := range serviceEntry.Spec.GetPorts() {
+		ports = append(ports, &discoveryv1.DestinationSpec_ExternalService_ServicePort{
+			Number:   port.GetNumber(),
+			Name:     port.GetName(),
+			Protocol: port.GetProtocol(),
+		})
+	}
+	return ports
+}
+
+func convertServiceEntryEndpoints(serviceEntry *networkingv1alpha3.ServiceEntry) []*discoveryv1.DestinationSpec_ExternalService_ExternalEndpoint {
+	var endpoints []*discoveryv1.DestinationSpec_ExternalService_ExternalEndpoint
+	for _, endpoint := range serviceEntry.Spec.GetEndpoints() {
+		endpoints = append(endpoints, &discoveryv1.DestinationSpec_ExternalService_ExternalEndpoint{
+			Address: endpoint.GetAddress(),
+			Ports:   endpoint.GetPorts(),
+		})
+	}
+	return endpoints
+}
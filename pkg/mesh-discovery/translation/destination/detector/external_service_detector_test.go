@@ -0,0 +1,126 @@
+package detector_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/destination/detector"
+)
+
+var _ = Describe("ExternalServiceDetector", func() {
+
+	var (
+		ctx = context.TODO()
+
+		istioMesh = &v1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "istio",
+				Namespace: "gloo-mesh",
+			},
+			Spec: v1.MeshSpec{
+				Type: &v1.MeshSpec_Istio_{
+					Istio: &v1.MeshSpec_Istio{
+						Installation: &v1.MeshInstallation{
+							Cluster: "cluster",
+						},
+					},
+				},
+			},
+		}
+	)
+
+	makeServiceEntry := func() *networkingv1alpha3.ServiceEntry {
+		return &networkingv1alpha3.ServiceEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "database",
+				Namespace:   "namespace",
+				ClusterName: "cluster",
+			},
+			Spec: networkingv1alpha3spec.ServiceEntry{
+				Hosts:     []string{"db.example.com"},
+				Addresses: []string{"10.0.0.1"},
+				Ports: []*networkingv1alpha3spec.Port{
+					{
+						Number:   5432,
+						Protocol: "TCP",
+						Name:     "postgres",
+					},
+				},
+				Endpoints: []*networkingv1alpha3spec.WorkloadEntry{
+					{
+						Address: "db-primary.example.com",
+						Ports:   map[string]uint32{"postgres": 5433},
+					},
+				},
+				Resolution: networkingv1alpha3spec.ServiceEntry_DNS,
+			},
+		}
+	}
+
+	It("translates a ServiceEntry to an ExternalService Destination", func() {
+		serviceEntry := makeServiceEntry()
+
+		destination := NewExternalServiceDetector().DetectExternalService(ctx, serviceEntry, v1sets.NewMeshSet(istioMesh))
+
+		Expect(destination).To(Equal(&v1.Destination{
+			ObjectMeta: utils.DiscoveredObjectMeta(serviceEntry),
+			Spec: v1.DestinationSpec{
+				Type: &v1.DestinationSpec_ExternalService_{
+					ExternalService: &v1.DestinationSpec_ExternalService{
+						Name:      "database",
+						Hosts:     []string{"db.example.com"},
+						Addresses: []string{"10.0.0.1"},
+						Ports: []*v1.DestinationSpec_ExternalService_ServicePort{
+							{
+								Number:   5432,
+								Name:     "postgres",
+								Protocol: "TCP",
+							},
+						},
+						Endpoints: []*v1.DestinationSpec_ExternalService_ExternalEndpoint{
+							{
+								Address: "db-primary.example.com",
+								Ports:   map[string]uint32{"postgres": 5433},
+							},
+						},
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "database",
+							Namespace:   "namespace",
+							ClusterName: "cluster",
+						},
+					},
+				},
+				Mesh: &skv2corev1.ObjectRef{
+					Name:      "istio",
+					Namespace: "gloo-mesh",
+				},
+			},
+		}))
+	})
+
+	It("ignores ServiceEntries translated by Gloo Mesh", func() {
+		serviceEntry := makeServiceEntry()
+		serviceEntry.Labels = metautils.TranslatedObjectLabels()
+
+		destination := NewExternalServiceDetector().DetectExternalService(ctx, serviceEntry, v1sets.NewMeshSet(istioMesh))
+		Expect(destination).To(BeNil())
+	})
+
+	It("ignores ServiceEntries on clusters without an Istio mesh", func() {
+		serviceEntry := makeServiceEntry()
+		serviceEntry.ClusterName = "other-cluster"
+
+		destination := NewExternalServiceDetector().DetectExternalService(ctx, serviceEntry, v1sets.NewMeshSet(istioMesh))
+		Expect(destination).To(BeNil())
+	})
+})
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	v1alpha3sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
 	v1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	v1sets0 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
 )
@@ -37,15 +38,15 @@ func (m *MockTranslator) EXPECT() *MockTranslatorMockRecorder {
 }
 
 // TranslateDestinations mocks base method.
-func (m *MockTranslator) TranslateDestinations(ctx context.Context, services v1sets.ServiceSet, pods v1sets.PodSet, nodes v1sets.NodeSet, workloads v1sets0.WorkloadSet, meshes v1sets0.MeshSet, endpoints v1sets.EndpointsSet) v1sets0.DestinationSet {
+func (m *MockTranslator) TranslateDestinations(ctx context.Context, services v1sets.ServiceSet, pods v1sets.PodSet, nodes v1sets.NodeSet, workloads v1sets0.WorkloadSet, meshes v1sets0.MeshSet, endpoints v1sets.EndpointsSet, serviceEntries v1alpha3sets.ServiceEntrySet) v1sets0.DestinationSet {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TranslateDestinations", ctx, services, pods, nodes, workloads, meshes, endpoints)
+	ret := m.ctrl.Call(m, "TranslateDestinations", ctx, services, pods, nodes, workloads, meshes, endpoints, serviceEntries)
 	ret0, _ := ret[0].(v1sets0.DestinationSet)
 	return ret0
 }
 
 // TranslateDestinations indicates an expected call of TranslateDestinations.
-func (mr *MockTranslatorMockRecorder) TranslateDestinations(ctx, services, pods, nodes, workloads, meshes, endpoints interface{}) *gomock.Call {
+func (mr *MockTranslatorMockRecorder) TranslateDestinations(ctx, services, pods, nodes, workloads, meshes, endpoints, serviceEntries interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslateDestinations", reflect.TypeOf((*MockTranslator)(nil).TranslateDestinations), ctx, services, pods, nodes, workloads, meshes, endpoints)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslateDestinations", reflect.TypeOf((*MockTranslator)(nil).TranslateDestinations), ctx, services, pods, nodes, workloads, meshes, endpoints, serviceEntries)
 }
@@ -63,6 +63,7 @@ func (t translator) Translate(
 		workloads,
 		meshes,
 		in.Endpoints(),
+		in.ServiceEntries(),
 	)
 
 	t.totalTranslates++
@@ -11,11 +11,15 @@ import (
 	destinationdetector "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/destination/detector"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh"
 	meshdetector "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector/consul"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector/istio"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector/linkerd"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector/osm"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload"
 	workloaddetector "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector"
+	consulsidecar "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/consul"
 	istiosidecar "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/istio"
+	linkerdsidecar "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/linkerd"
 	osmsidecar "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/osm"
 )
 
@@ -48,6 +52,8 @@ func (d DependencyFactoryImpl) MakeMeshTranslator(
 		istio.NewMeshDetector(ctx),
 		appmesh.NewMeshDetector(ctx),
 		osm.NewMeshDetector(ctx),
+		linkerd.NewMeshDetector(ctx),
+		consul.NewMeshDetector(ctx),
 	}
 
 	return mesh.NewTranslator(ctx, detectors)
@@ -61,6 +67,8 @@ func (d DependencyFactoryImpl) MakeWorkloadTranslator(
 		istiosidecar.NewSidecarDetector(ctx),
 		appmeshsidecar.NewSidecarDetector(ctx),
 		osmsidecar.NewSidecarDetector(ctx),
+		linkerdsidecar.NewSidecarDetector(ctx),
+		consulsidecar.NewSidecarDetector(ctx),
 	}
 
 	workloadDetector := workloaddetector.NewWorkloadDetector(
@@ -73,6 +81,9 @@ func (d DependencyFactoryImpl) MakeWorkloadTranslator(
 }
 
 func (d DependencyFactoryImpl) MakeDestinationTranslator() destination.Translator {
-	return destination.NewTranslator(destinationdetector.NewDestinationDetector())
+	return destination.NewTranslator(
+		destinationdetector.NewDestinationDetector(),
+		destinationdetector.NewExternalServiceDetector(),
+	)
 
 }
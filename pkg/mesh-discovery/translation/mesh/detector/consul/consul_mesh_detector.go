@@ -0,0 +1,141 @@
+package consul
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	appsv1sets "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/dockerutils"
+	"github.com/solo-io/go-utils/contextutils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// the consul-k8s helm chart names the injector "<release>-consul-connect-injector"
+	// (or "<release>-consul-connect-injector-webhook-deployment" in older releases)
+	connectInjectorKeyword = "connect-injector"
+	consulK8sImageKeyword  = "consul-k8s"
+	// the consul-k8s helm chart names the servers "<release>-consul-server"
+	consulServerKeyword = "consul-server"
+	consulImageKeyword  = "consul"
+)
+
+// detects Consul Connect if a deployment contains the consul-k8s connect injector.
+type meshDetector struct {
+	ctx context.Context
+}
+
+func NewMeshDetector(
+	ctx context.Context,
+) detector.MeshDetector {
+	return &meshDetector{
+		ctx: contextutils.WithLogger(ctx, "detector"),
+	}
+}
+
+// returns a mesh for each deployment that contains the consul-k8s connect injector image
+func (d *meshDetector) DetectMeshes(in input.DiscoveryInputSnapshot, _ *settingsv1.DiscoverySettings) (v1.MeshSlice, error) {
+	var meshes v1.MeshSlice
+	var errs error
+	for _, deployment := range in.Deployments().List() {
+		mesh, err := d.detectMesh(deployment, in.StatefulSets())
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if mesh == nil {
+			continue
+		}
+		meshes = append(meshes, mesh)
+	}
+	return meshes, errs
+}
+
+func (d *meshDetector) detectMesh(deployment *appsv1.Deployment, statefulSets appsv1sets.StatefulSetSet) (*v1.Mesh, error) {
+	injectorVersion, err := getConnectInjectorVersion(deployment)
+	if err != nil {
+		return nil, err
+	}
+	if injectorVersion == "" {
+		return nil, nil
+	}
+
+	// prefer the version of the Consul servers, as that determines which config entries are supported
+	version, err := getConsulServerVersion(statefulSets, deployment.ClusterName, deployment.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if version == "" {
+		contextutils.LoggerFrom(d.ctx).Debugf("no Consul servers found in namespace %s on cluster %s, using connect injector version", deployment.Namespace, deployment.ClusterName)
+		version = injectorVersion
+	}
+
+	return &v1.Mesh{
+		ObjectMeta: utils.DiscoveredObjectMeta(deployment),
+		Spec: v1.MeshSpec{
+			Type: &v1.MeshSpec_ConsulConnect{
+				ConsulConnect: &v1.MeshSpec_ConsulConnectMesh{
+					Installation: &v1.MeshInstallation{
+						Namespace: deployment.Namespace,
+						Cluster:   deployment.ClusterName,
+						PodLabels: deployment.Spec.Selector.MatchLabels,
+						Version:   version,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func getConnectInjectorVersion(deployment *appsv1.Deployment) (string, error) {
+	if !strings.Contains(deployment.GetName(), connectInjectorKeyword) {
+		return "", nil
+	}
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if strings.Contains(container.Image, consulK8sImageKeyword) {
+			return getImageVersion(container)
+		}
+	}
+	return "", nil
+}
+
+// returns the version of the Consul server StatefulSet colocated with the connect injector, if any
+func getConsulServerVersion(statefulSets appsv1sets.StatefulSetSet, cluster, namespace string) (string, error) {
+	for _, statefulSet := range statefulSets.List(func(statefulSet *appsv1.StatefulSet) bool {
+		return statefulSet.GetClusterName() != cluster ||
+			statefulSet.GetNamespace() != namespace ||
+			!strings.Contains(statefulSet.GetName(), consulServerKeyword)
+	}) {
+		for _, container := range statefulSet.Spec.Template.Spec.Containers {
+			if isConsulServer(&container) {
+				return getImageVersion(container)
+			}
+		}
+	}
+	return "", nil
+}
+
+// Return true if the container runs the Consul agent image (rather than a consul-k8s helper image)
+func isConsulServer(container *corev1.Container) bool {
+	return strings.Contains(container.Image, consulImageKeyword) &&
+		!strings.Contains(container.Image, consulK8sImageKeyword)
+}
+
+func getImageVersion(container corev1.Container) (string, error) {
+	parsedImage, err := dockerutils.ParseImageName(container.Image)
+	if err != nil {
+		return "", eris.Wrapf(err, "failed to parse consul image tag: %s", container.Image)
+	}
+	version := parsedImage.Tag
+	if parsedImage.Digest != "" {
+		version = parsedImage.Digest
+	}
+	return version, nil
+}
@@ -0,0 +1,142 @@
+package consul_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector/consul"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/labelutils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ConsulMeshDetector", func() {
+
+	ctx := context.Background()
+	serviceAccountName := "service-account-name"
+	meshNs := "consul"
+	clusterName := "cluster"
+
+	connectInjector := func(deploymentName, image string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   meshNs,
+				Name:        deploymentName,
+				ClusterName: clusterName,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:  "sidecar-injector",
+								Image: image,
+							},
+						},
+						ServiceAccountName: serviceAccountName,
+					},
+				},
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "consul", "component": "connect-injector"},
+				},
+			},
+		}
+	}
+
+	consulServer := func(namespace string) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   namespace,
+				Name:        "consul-consul-server",
+				ClusterName: clusterName,
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:  "consul",
+								Image: "hashicorp/consul:1.9.4",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	expectedMesh := func(version string) *v1.Mesh {
+		return &v1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "consul-consul-connect-injector-consul-cluster",
+				Namespace: defaults.GetPodNamespace(),
+				Labels:    labelutils.ClusterLabels(clusterName),
+			},
+			Spec: v1.MeshSpec{
+				Type: &v1.MeshSpec_ConsulConnect{
+					ConsulConnect: &v1.MeshSpec_ConsulConnectMesh{
+						Installation: &v1.MeshInstallation{
+							Namespace: meshNs,
+							Cluster:   clusterName,
+							Version:   version,
+							PodLabels: map[string]string{"app": "consul", "component": "connect-injector"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("does not detect Consul when it is not there", func() {
+
+		deployment := connectInjector("test", "test-image")
+
+		detector := NewMeshDetector(
+			ctx,
+		)
+
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{deployment})
+
+		meshes, err := detector.DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(BeNil())
+	})
+
+	It("detects a mesh from the connect injector using the consul server version", func() {
+
+		detector := NewMeshDetector(
+			ctx,
+		)
+
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{connectInjector("consul-consul-connect-injector", "hashicorp/consul-k8s:0.25.0")})
+		in.AddStatefulSets([]*appsv1.StatefulSet{consulServer(meshNs)})
+
+		meshes, err := detector.DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(HaveLen(1))
+		Expect(meshes[0]).To(Equal(expectedMesh("1.9.4")))
+	})
+
+	It("falls back to the connect injector version when no consul server is colocated", func() {
+
+		detector := NewMeshDetector(
+			ctx,
+		)
+
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{connectInjector("consul-consul-connect-injector", "hashicorp/consul-k8s:0.25.0")})
+		in.AddStatefulSets([]*appsv1.StatefulSet{consulServer("other-namespace")})
+
+		meshes, err := detector.DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(HaveLen(1))
+		Expect(meshes[0]).To(Equal(expectedMesh("0.25.0")))
+	})
+})
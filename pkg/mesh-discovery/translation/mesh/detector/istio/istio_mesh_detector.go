@@ -110,6 +110,13 @@ func (d *meshDetector) detectMesh(
 		in.Nodes(),
 	)
 
+	egressGateways := getEgressGateways(
+		deployment.Namespace,
+		deployment.ClusterName,
+		utils.GetEgressGatewayWorkloadLabels(settings, deployment.ClusterName),
+		in.Services(),
+	)
+
 	agent := getAgent(
 		deployment.ClusterName,
 		in.Pods(),
@@ -137,6 +144,7 @@ func (d *meshDetector) detectMesh(
 					// This assumes that the istiod deployment is the cert provider
 					IstiodServiceAccount: deployment.Spec.Template.Spec.ServiceAccountName,
 					IngressGateways:      ingressGateways,
+					EgressGateways:       egressGateways,
 				},
 			},
 			AgentInfo: agent,
@@ -158,7 +166,7 @@ func getIngressGateways(
 	pods corev1sets.PodSet,
 	nodes corev1sets.NodeSet,
 ) []*discoveryv1.MeshSpec_Istio_IngressGatewayInfo {
-	ingressSvcs := getIngressServices(services, namespace, clusterName, workloadLabels)
+	ingressSvcs := getGatewayServices(services, namespace, clusterName, workloadLabels)
 	var ingressGateways []*discoveryv1.MeshSpec_Istio_IngressGatewayInfo
 	for _, svc := range ingressSvcs {
 		gateway, err := getIngressGateway(svc, workloadLabels, tlsPortName, pods, nodes)
@@ -273,7 +281,33 @@ func getIngressGateway(
 	return gatewayInfo, nil
 }
 
-func getIngressServices(
+// egress gateways are detected from the Services in the Istio control plane namespace selecting the egress gateway workload
+func getEgressGateways(
+	namespace string,
+	clusterName string,
+	workloadLabels map[string]string,
+	services corev1sets.ServiceSet,
+) []*discoveryv1.MeshSpec_Istio_EgressGatewayInfo {
+	var egressGateways []*discoveryv1.MeshSpec_Istio_EgressGatewayInfo
+	for _, svc := range getGatewayServices(services, namespace, clusterName, workloadLabels) {
+		var ports []*discoveryv1.MeshSpec_Istio_EgressGatewayInfo_ServicePort
+		for _, port := range svc.Spec.Ports {
+			ports = append(ports, &discoveryv1.MeshSpec_Istio_EgressGatewayInfo_ServicePort{
+				Port: uint32(port.Port),
+				Name: port.Name,
+			})
+		}
+		egressGateways = append(egressGateways, &discoveryv1.MeshSpec_Istio_EgressGatewayInfo{
+			Name:           svc.Name,
+			Namespace:      svc.Namespace,
+			WorkloadLabels: svc.Spec.Selector,
+			Ports:          ports,
+		})
+	}
+	return egressGateways
+}
+
+func getGatewayServices(
 	services corev1sets.ServiceSet,
 	namespace string,
 	clusterName string,
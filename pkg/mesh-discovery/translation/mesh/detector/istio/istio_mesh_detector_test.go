@@ -693,4 +693,107 @@ var _ = Describe("IstioMeshDetector", func() {
 		Expect(meshes[0]).To(Equal(expectedMesh))
 	})
 
+	It("uses settings to detect egress gateways", func() {
+		configMaps := istioConfigMap()
+		workloadLabels := map[string]string{"egress": "gateway"}
+		services := corev1sets.NewServiceSet(
+			&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "egress-svc",
+					Namespace:   meshNs,
+					ClusterName: clusterName,
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{
+						{
+							Name:     "http",
+							Protocol: "TCP",
+							Port:     80,
+						},
+						{
+							Name:     "tls",
+							Protocol: "TCP",
+							Port:     443,
+						},
+					},
+					Selector: workloadLabels,
+					Type:     corev1.ServiceTypeClusterIP,
+				},
+			},
+			// egress gateways in other namespaces are ignored
+			&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "egress-svc",
+					Namespace:   "other-namespace",
+					ClusterName: clusterName,
+				},
+				Spec: corev1.ServiceSpec{
+					Selector: workloadLabels,
+				},
+			},
+		)
+
+		detector := NewMeshDetector(
+			ctx,
+		)
+
+		deployment := istioDeployment(istiodDeploymentName)
+
+		inRemote := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		inRemote.AddDeployments([]*appsv1.Deployment{deployment})
+		inRemote.AddConfigMaps(configMaps.List())
+		inRemote.AddServices(services.List())
+		settings := &settingsv1.DiscoverySettings{
+			Istio: &settingsv1.DiscoverySettings_Istio{
+				EgressGatewayDetectors: map[string]*settingsv1.DiscoverySettings_Istio_EgressGatewayDetector{
+					clusterName: {
+						GatewayWorkloadLabels: workloadLabels,
+					},
+				},
+			},
+		}
+
+		meshes, err := detector.DetectMeshes(inRemote.Build(), settings)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedMesh := &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "istiod-namespace-cluster",
+				Namespace: defaults.GetPodNamespace(),
+				Labels:    labelutils.ClusterLabels(clusterName),
+			},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Istio_{Istio: &discoveryv1.MeshSpec_Istio{
+					SmartDnsProxyingEnabled: smartDnsProxyingEnabled,
+					Installation: &discoveryv1.MeshInstallation{
+						Namespace: meshNs,
+						Cluster:   clusterName,
+						Version:   "latest",
+						PodLabels: map[string]string{"app": "istiod"},
+					},
+					TrustDomain:          trustDomain,
+					IstiodServiceAccount: serviceAccountName,
+					EgressGateways: []*discoveryv1.MeshSpec_Istio_EgressGatewayInfo{{
+						Name:           "egress-svc",
+						Namespace:      meshNs,
+						WorkloadLabels: workloadLabels,
+						Ports: []*discoveryv1.MeshSpec_Istio_EgressGatewayInfo_ServicePort{
+							{
+								Port: 80,
+								Name: "http",
+							},
+							{
+								Port: 443,
+								Name: "tls",
+							},
+						},
+					}},
+				}},
+			},
+		}
+
+		Expect(meshes).To(HaveLen(1))
+		Expect(meshes[0]).To(Equal(expectedMesh))
+	})
+
 })
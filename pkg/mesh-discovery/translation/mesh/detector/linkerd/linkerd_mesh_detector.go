@@ -0,0 +1,159 @@
+package linkerd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	corev1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/dockerutils"
+	"github.com/solo-io/go-utils/contextutils"
+	skv1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// the destination service is present in every Linkerd 2.x control plane
+	linkerdControlPlaneDeploymentName = "linkerd-destination"
+	linkerdContainerKeyword           = "linkerd"
+	controllerContainerKeyword        = "controller"
+	linkerdConfigMapName              = "linkerd-config"
+	// Linkerd >= 2.9 stores the install values as YAML
+	linkerdConfigMapValuesKey = "values"
+	// Linkerd < 2.9 stores the global config as JSON
+	linkerdConfigMapGlobalKey = "global"
+)
+
+// the subset of the Linkerd install configuration used for discovery
+type linkerdConfig struct {
+	ClusterDomain string `json:"clusterDomain"`
+}
+
+// detects Linkerd if a deployment contains the linkerd destination controller.
+type meshDetector struct {
+	ctx context.Context
+}
+
+func NewMeshDetector(
+	ctx context.Context,
+) detector.MeshDetector {
+	return &meshDetector{
+		ctx: contextutils.WithLogger(ctx, "detector"),
+	}
+}
+
+// returns a mesh for each deployment that contains the linkerd destination controller image
+func (d *meshDetector) DetectMeshes(in input.DiscoveryInputSnapshot, _ *settingsv1.DiscoverySettings) (v1.MeshSlice, error) {
+	var meshes v1.MeshSlice
+	var errs error
+	for _, deployment := range in.Deployments().List() {
+		mesh, err := d.detectMesh(deployment, in.ConfigMaps())
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if mesh == nil {
+			continue
+		}
+		meshes = append(meshes, mesh)
+	}
+	return meshes, errs
+}
+
+func (d *meshDetector) detectMesh(deployment *appsv1.Deployment, configMaps corev1sets.ConfigMapSet) (*v1.Mesh, error) {
+	version, err := getLinkerdControllerVersion(deployment)
+	if err != nil {
+		return nil, err
+	}
+	if version == "" {
+		return nil, nil
+	}
+
+	clusterDomain, err := d.getClusterDomain(configMaps, deployment.ClusterName, deployment.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.Mesh{
+		ObjectMeta: utils.DiscoveredObjectMeta(deployment),
+		Spec: v1.MeshSpec{
+			Type: &v1.MeshSpec_Linkerd{
+				Linkerd: &v1.MeshSpec_LinkerdMesh{
+					Installation: &v1.MeshInstallation{
+						Namespace: deployment.Namespace,
+						Cluster:   deployment.ClusterName,
+						PodLabels: deployment.Spec.Selector.MatchLabels,
+						Version:   version,
+					},
+					ClusterDomain: clusterDomain,
+				},
+			},
+		},
+	}, nil
+}
+
+func getLinkerdControllerVersion(deployment *appsv1.Deployment) (string, error) {
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if isLinkerdController(deployment, &container) {
+			parsedImage, err := dockerutils.ParseImageName(container.Image)
+			if err != nil {
+				return "", eris.Wrapf(err, "failed to parse linkerd controller image tag: %s", container.Image)
+			}
+			version := parsedImage.Tag
+			if parsedImage.Digest != "" {
+				version = parsedImage.Digest
+			}
+			return version, nil
+		}
+	}
+	return "", nil
+}
+
+// Return true if deployment is inferred to be a Linkerd control plane deployment
+func isLinkerdController(deployment *appsv1.Deployment, container *corev1.Container) bool {
+	return deployment.GetName() == linkerdControlPlaneDeploymentName &&
+		strings.Contains(container.Image, linkerdContainerKeyword) &&
+		strings.Contains(container.Image, controllerContainerKeyword)
+}
+
+// read the cluster domain from the linkerd-config ConfigMap in the control plane namespace.
+// falls back to the default cluster domain if it is not configured.
+func (d *meshDetector) getClusterDomain(
+	configMaps corev1sets.ConfigMapSet,
+	cluster, namespace string,
+) (string, error) {
+	linkerdConfigMap, err := configMaps.Find(&skv1.ClusterObjectRef{
+		Name:        linkerdConfigMapName,
+		Namespace:   namespace,
+		ClusterName: cluster,
+	})
+	if err != nil {
+		contextutils.LoggerFrom(d.ctx).Debugf("no ConfigMap %s found in namespace %s on cluster %s, using default cluster domain", linkerdConfigMapName, namespace, cluster)
+		return defaults.DefaultClusterDomain, nil
+	}
+
+	for _, key := range []string{linkerdConfigMapValuesKey, linkerdConfigMapGlobalKey} {
+		configString, ok := linkerdConfigMap.Data[key]
+		if !ok {
+			continue
+		}
+		var config linkerdConfig
+		// JSON is valid YAML, so both formats can be parsed the same way
+		if err := yaml.Unmarshal([]byte(configString), &config); err != nil {
+			return "", eris.Wrapf(err, "failed to parse '%s' entry in ConfigMap with name/namespace/cluster %s/%s/%s", key, linkerdConfigMapName, namespace, cluster)
+		}
+		if config.ClusterDomain != "" {
+			return config.ClusterDomain, nil
+		}
+	}
+
+	return defaults.DefaultClusterDomain, nil
+}
@@ -0,0 +1,134 @@
+package linkerd_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector/linkerd"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/labelutils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("LinkerdMeshDetector", func() {
+
+	ctx := context.Background()
+	meshNs := "linkerd"
+	clusterName := "cluster"
+
+	linkerdDestination := func(deploymentName string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   meshNs,
+				Name:        deploymentName,
+				ClusterName: clusterName,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Image: "cr.l5d.io/linkerd/controller:stable-2.10.2",
+							},
+						},
+					},
+				},
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"linkerd.io/control-plane-component": "destination"},
+				},
+			},
+		}
+	}
+
+	expectedMesh := func(clusterDomain string) *v1.Mesh {
+		return &v1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "linkerd-destination-linkerd-cluster",
+				Namespace: defaults.GetPodNamespace(),
+				Labels:    labelutils.ClusterLabels(clusterName),
+			},
+			Spec: v1.MeshSpec{
+				Type: &v1.MeshSpec_Linkerd{
+					Linkerd: &v1.MeshSpec_LinkerdMesh{
+						Installation: &v1.MeshInstallation{
+							Namespace: meshNs,
+							Cluster:   clusterName,
+							Version:   "stable-2.10.2",
+							PodLabels: map[string]string{"linkerd.io/control-plane-component": "destination"},
+						},
+						ClusterDomain: clusterDomain,
+					},
+				},
+			},
+		}
+	}
+
+	linkerdConfig := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   meshNs,
+				Name:        "linkerd-config",
+				ClusterName: clusterName,
+			},
+			Data: data,
+		}
+	}
+
+	It("does not detect Linkerd when it is not there", func() {
+		detector := NewMeshDetector(ctx)
+
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{linkerdDestination("linkerd-proxy-injector")})
+
+		meshes, err := detector.DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(BeNil())
+	})
+
+	It("detects a mesh with the default cluster domain", func() {
+		detector := NewMeshDetector(ctx)
+
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{linkerdDestination("linkerd-destination")})
+
+		meshes, err := detector.DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(HaveLen(1))
+		Expect(meshes[0]).To(Equal(expectedMesh("cluster.local")))
+	})
+
+	It("detects the cluster domain from the install values", func() {
+		detector := NewMeshDetector(ctx)
+
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{linkerdDestination("linkerd-destination")})
+		in.AddConfigMaps([]*corev1.ConfigMap{linkerdConfig(map[string]string{
+			"values": "clusterDomain: custom.domain\ncontrollerReplicas: 1\n",
+		})})
+
+		meshes, err := detector.DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(HaveLen(1))
+		Expect(meshes[0]).To(Equal(expectedMesh("custom.domain")))
+	})
+
+	It("detects the cluster domain from the legacy global config", func() {
+		detector := NewMeshDetector(ctx)
+
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{linkerdDestination("linkerd-destination")})
+		in.AddConfigMaps([]*corev1.ConfigMap{linkerdConfig(map[string]string{
+			"global": `{"linkerdNamespace":"linkerd","clusterDomain":"legacy.domain"}`,
+		})})
+
+		meshes, err := detector.DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(HaveLen(1))
+		Expect(meshes[0]).To(Equal(expectedMesh("legacy.domain")))
+	})
+})
@@ -42,3 +42,19 @@ func GetIngressGatewayDetector(settings *settingsv1.DiscoverySettings, clusterNa
 		GatewayTlsPortName:    portName,
 	}, nil
 }
+
+// Get the workload labels used to detect egress gateways in the given cluster.
+func GetEgressGatewayWorkloadLabels(settings *settingsv1.DiscoverySettings, clusterName string) map[string]string {
+	gatewayDetectors := settings.GetIstio().GetEgressGatewayDetectors()
+
+	// First, check if cluster-specific values are set, then check the wildcard (all clusters) entry
+	if labels := gatewayDetectors[clusterName].GetGatewayWorkloadLabels(); labels != nil {
+		return labels
+	}
+	if labels := gatewayDetectors["*"].GetGatewayWorkloadLabels(); labels != nil {
+		return labels
+	}
+
+	// Fall back to default values
+	return defaults.DefaultEgressGatewayWorkloadLabels
+}
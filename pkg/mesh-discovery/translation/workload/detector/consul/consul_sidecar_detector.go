@@ -0,0 +1,75 @@
+package consul
+
+import (
+	"context"
+
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// annotation set by the consul-k8s connect injector on pods it has injected
+	connectInjectStatusAnnotation = "consul.hashicorp.com/connect-inject-status"
+	connectInjectStatusInjected   = "injected"
+	// proxy container name
+	sidecarProxyName = "envoy-sidecar"
+	// init container name
+	proxyInitName = "consul-connect-inject-init"
+)
+
+// detects a consul connect sidecar
+type sidecarDetector struct {
+	ctx context.Context
+}
+
+func NewSidecarDetector(ctx context.Context) *sidecarDetector {
+	ctx = contextutils.WithLogger(ctx, "consul-sidecar-detector")
+	return &sidecarDetector{ctx: ctx}
+}
+
+/*
+	The consul-k8s connect injector adds an Envoy sidecar and an init container which registers the pod with Consul,
+	and marks the pod as injected.
+*/
+func (s *sidecarDetector) DetectMeshSidecar(pod *corev1.Pod, meshes v1sets.MeshSet) *v1.Mesh {
+	if !isInjected(pod) {
+		return nil
+	}
+
+	for _, mesh := range meshes.List() {
+		consulMesh := mesh.Spec.GetConsulConnect()
+		if consulMesh == nil {
+			continue
+		}
+
+		// we assume one Consul datacenter per cluster,
+		// so the control plane for a given sidecar is always
+		// the mesh on the pod's cluster
+		if consulMesh.GetInstallation().GetCluster() == pod.ClusterName {
+			return mesh
+		}
+	}
+
+	contextutils.LoggerFrom(s.ctx).Warnw("warning: no mesh found corresponding to pod with consul connect sidecar", "pod", sets.Key(pod))
+
+	return nil
+}
+
+func isInjected(pod *corev1.Pod) bool {
+	if pod.Annotations[connectInjectStatusAnnotation] == connectInjectStatusInjected {
+		return true
+	}
+	return containsContainer(pod.Spec.InitContainers, proxyInitName) && containsContainer(pod.Spec.Containers, sidecarProxyName)
+}
+
+func containsContainer(containers []corev1.Container, name string) bool {
+	for _, container := range containers {
+		if container.Name == name {
+			return true
+		}
+	}
+	return false
+}
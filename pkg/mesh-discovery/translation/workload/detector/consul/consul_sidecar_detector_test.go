@@ -0,0 +1,127 @@
+package consul_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/consul"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ConsulSidecarDetector", func() {
+	serviceAccountName := "service-account-name"
+	ns := "namespace"
+	clusterName := "cluster"
+	podName := "pod"
+
+	pod := func() *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   ns,
+				Name:        podName,
+				ClusterName: clusterName,
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  "envoy-sidecar",
+						Image: "envoyproxy/envoy-alpine:v1.16.0",
+					},
+				},
+				InitContainers: []corev1.Container{
+					{
+						Name:  "consul-connect-inject-init",
+						Image: "hashicorp/consul:1.9.4",
+					},
+				},
+				ServiceAccountName: serviceAccountName,
+			},
+		}
+	}
+
+	consulMeshes := func(cluster string) v1sets.MeshSet {
+		return v1sets.NewMeshSet(
+			&v1.Mesh{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "gloo-mesh",
+					Name:      "consul-consul-connect-injector-consul-master-cluster",
+				},
+				Spec: v1.MeshSpec{
+					Type: &v1.MeshSpec_ConsulConnect{
+						ConsulConnect: &v1.MeshSpec_ConsulConnectMesh{
+							Installation: &v1.MeshInstallation{
+								Namespace: "consul",
+								Cluster:   cluster,
+							},
+						},
+					},
+				},
+			},
+		)
+	}
+
+	detector := NewSidecarDetector(context.Background())
+
+	It("detects workload when sidecar mesh is in cluster", func() {
+		pod := pod()
+
+		meshes := consulMeshes(clusterName)
+
+		workload := detector.DetectMeshSidecar(pod, meshes)
+		Expect(workload).To(Equal(meshes.List()[0]))
+	})
+
+	It("detects workload when the pod is annotated as injected", func() {
+		pod := pod()
+		pod.Spec.InitContainers = nil
+		pod.Annotations = map[string]string{"consul.hashicorp.com/connect-inject-status": "injected"}
+
+		meshes := consulMeshes(clusterName)
+
+		workload := detector.DetectMeshSidecar(pod, meshes)
+		Expect(workload).To(Equal(meshes.List()[0]))
+	})
+
+	It("does not detect workload when sidecar mesh is of different cluster", func() {
+		pod := pod()
+
+		meshes := consulMeshes("different-" + clusterName)
+
+		workload := detector.DetectMeshSidecar(pod, meshes)
+		Expect(workload).To(BeNil())
+	})
+
+	It("does not detect workload when sidecar mesh is not present", func() {
+		pod := pod()
+
+		meshes := v1sets.NewMeshSet()
+
+		workload := detector.DetectMeshSidecar(pod, meshes)
+		Expect(workload).To(BeNil())
+	})
+
+	It("does not detect workload when sidecar is not present", func() {
+		pod := pod()
+		pod.Spec.Containers = nil
+
+		meshes := consulMeshes(clusterName)
+
+		workload := detector.DetectMeshSidecar(pod, meshes)
+		Expect(workload).To(BeNil())
+	})
+
+	It("does not detect workload when init container is not present", func() {
+		pod := pod()
+		pod.Spec.InitContainers = nil
+
+		meshes := consulMeshes(clusterName)
+
+		workload := detector.DetectMeshSidecar(pod, meshes)
+		Expect(workload).To(BeNil())
+	})
+
+})
@@ -9,12 +9,15 @@ import (
 	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	networkingv1beta1 "github.com/solo-io/gloo-mesh/pkg/api/networking.enterprise.mesh.gloo.solo.io/v1beta1"
+	networkingv1beta1sets "github.com/solo-io/gloo-mesh/pkg/api/networking.enterprise.mesh.gloo.solo.io/v1beta1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	networkingv1sets "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/apply/configtarget"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/canaryutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/destinationutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/selectorutils"
@@ -56,7 +59,14 @@ func (v *applier) Apply(ctx context.Context, input input.LocalSnapshot, userSupp
 
 	validateConfigTargetReferences(input)
 
-	applyPoliciesToConfigTargets(input)
+	validateCanaries(input.TrafficPolicies().List())
+
+	applyPoliciesToConfigTargets(ctx, input)
+
+	reportRejectedPolicyFieldMerges(input, reporter)
+
+	// must be invoked after policies are applied to Destinations, as ExternalServices with applied TrafficPolicies cannot be routed through egress gateways
+	validateEgressGatewayPolicies(input.EgressGatewayPolicies().List(), input.Destinations(), input.Meshes())
 
 	// perform a dry run of translation to find any errors
 	_, err := v.translator.Translate(ctx, input, userSupplied, reporter)
@@ -73,6 +83,8 @@ func initializePolicyStatuses(input input.LocalSnapshot) {
 	trafficPolicies := input.TrafficPolicies().List()
 	accessPolicies := input.AccessPolicies().List()
 	virtualMeshes := input.VirtualMeshes().List()
+	serviceDependencies := input.ServiceDependencies().List()
+	egressGatewayPolicies := input.EgressGatewayPolicies().List()
 
 	// initialize TrafficPolicy statuses
 	for _, trafficPolicy := range trafficPolicies {
@@ -80,6 +92,8 @@ func initializePolicyStatuses(input input.LocalSnapshot) {
 			State:              commonv1.ApprovalState_ACCEPTED,
 			ObservedGeneration: trafficPolicy.Generation,
 			Destinations:       map[string]*networkingv1.ApprovalStatus{},
+			// the progress of the canary is preserved across reconciles
+			Canary: trafficPolicy.Status.Canary,
 		}
 	}
 
@@ -101,6 +115,25 @@ func initializePolicyStatuses(input input.LocalSnapshot) {
 			Destinations:       map[string]*networkingv1.ApprovalStatus{},
 		}
 	}
+
+	// initialize EgressGatewayPolicy statuses
+	for _, egressGatewayPolicy := range egressGatewayPolicies {
+		egressGatewayPolicy.Status = networkingv1.EgressGatewayPolicyStatus{
+			State:              commonv1.ApprovalState_ACCEPTED,
+			ObservedGeneration: egressGatewayPolicy.Generation,
+			Meshes:             map[string]*networkingv1.ApprovalStatus{},
+			Destinations:       map[string]*networkingv1.ApprovalStatus{},
+		}
+	}
+
+	// initialize ServiceDependency statuses
+	for _, serviceDependency := range serviceDependencies {
+		serviceDependency.Status = networkingv1beta1.ServiceDependencyStatus{
+			State:              commonv1.ApprovalState_ACCEPTED,
+			ObservedGeneration: serviceDependency.Generation,
+			Workloads:          map[string]*networkingv1.ApprovalStatus{},
+		}
+	}
 }
 
 // Append status metadata to relevant discovery resources.
@@ -120,10 +153,12 @@ func validateConfigTargetReferences(input input.LocalSnapshot) {
 	configTargetValidator.ValidateAccessPolicies(input.AccessPolicies().List())
 	configTargetValidator.ValidateTrafficPolicies(input.TrafficPolicies().List())
 	configTargetValidator.ValidateVirtualMeshes(input.VirtualMeshes().List())
+	configTargetValidator.ValidateServiceDependencies(input.ServiceDependencies().List())
+	configTargetValidator.ValidateEgressGatewayPolicies(input.EgressGatewayPolicies().List())
 }
 
 // Apply networking configuration policies to relevant discovery entities.
-func applyPoliciesToConfigTargets(input input.LocalSnapshot) {
+func applyPoliciesToConfigTargets(ctx context.Context, input input.LocalSnapshot) {
 	for _, destination := range input.Destinations().List() {
 		destination.Status.AppliedTrafficPolicies = getAppliedTrafficPolicies(input.TrafficPolicies().List(), destination)
 		destination.Status.AppliedAccessPolicies = getAppliedAccessPolicies(input.AccessPolicies().List(), destination)
@@ -131,6 +166,18 @@ func applyPoliciesToConfigTargets(input input.LocalSnapshot) {
 		destination.Status.RequiredSubsets = getRequiredSubsets(input.TrafficPolicies().List(), destination)
 	}
 
+	// must be invoked after federation is applied to Destinations, which determines their hostnames in remote clusters
+	clusterDomains := hostutils.NewClusterDomainRegistry(input.KubernetesClusters(), input.Destinations())
+	for _, workload := range input.Workloads().List() {
+		workload.Status.ServiceDependencies = getAppliedServiceDependencies(
+			ctx,
+			input.ServiceDependencies().List(),
+			input.Destinations().List(),
+			clusterDomains,
+			workload,
+		)
+	}
+
 	for _, mesh := range input.Meshes().List() {
 		mesh.Status.AppliedVirtualMesh = getAppliedVirtualMesh(input.VirtualMeshes().List(), mesh)
 		// getAppliedEastWestIngressGateways must be invoked after getAppliedVirtualMesh
@@ -142,13 +189,14 @@ func applyPoliciesToConfigTargets(input input.LocalSnapshot) {
 // Also update observed generation to indicate that it's been processed.
 func reportTranslationErrors(ctx context.Context, reporter *applyReporter, input input.LocalSnapshot) {
 	for _, workload := range input.Workloads().List() {
-		// TODO: validate config applied to workloads when introduced
 		workload.Status.ObservedGeneration = workload.Generation
+		setServiceDependencyWorkloadStatuses(input.ServiceDependencies(), workload)
 	}
 
 	for _, destination := range input.Destinations().List() {
 		destination.Status.ObservedGeneration = destination.Generation
 		destination.Status.AppliedTrafficPolicies = validateAndReturnApprovedTrafficPolicies(ctx, input, reporter, destination)
+		setMergedPolicyFields(destination)
 		destination.Status.AppliedAccessPolicies = validateAndReturnApprovedAccessPolicies(ctx, input, reporter, destination)
 		destination.Status.AppliedFederation = validateAndReturnApprovedFederation(ctx, input, reporter, destination)
 		destination.Status.RequiredSubsets = validateAndReturnRequiredSubsets(ctx, input, destination)
@@ -559,27 +607,32 @@ func getAppliedTrafficPolicies(
 		}
 	}
 
-	sortTrafficPoliciesByAcceptedDate(destination, matchingTrafficPolicies)
+	sortTrafficPoliciesByPriorityAndAcceptedDate(destination, matchingTrafficPolicies)
 
 	var appliedPolicies []*discoveryv1.DestinationStatus_AppliedTrafficPolicy
 	for _, policy := range matchingTrafficPolicies {
 		policy := policy // pike
+		spec := &policy.Spec
+		if spec.GetPolicy().GetCanary() != nil {
+			spec = applyCanary(policy, destination)
+		}
 		appliedPolicies = append(appliedPolicies, &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
 			Ref:                ezkube.MakeObjectRef(policy),
-			Spec:               &policy.Spec,
+			Spec:               spec,
 			ObservedGeneration: policy.Generation,
 		})
 	}
 	return appliedPolicies
 }
 
-// sort the set of traffic policies in the order in which they were accepted.
+// sort the set of traffic policies in order of increasing priority, such that policies of higher priority are applied over those of lower priority.
+// Traffic policies of equal priority are sorted in the order in which they were accepted.
 // Traffic policies which were accepted first and have not changed (i.e. their observedGeneration is up-to-date) take precedence.
 // Next are policies that were previously accepted but whose observedGeneration is out of date. This permits policies which were modified but formerly correct to maintain
 // their acceptance status ahead of policies which were unomdified and previously rejected.
 // Next will be the policies which have been modified and rejected.
 // Finally, policies which are rejected and modified
-func sortTrafficPoliciesByAcceptedDate(destination *discoveryv1.Destination, trafficPolicies networkingv1.TrafficPolicySlice) {
+func sortTrafficPoliciesByPriorityAndAcceptedDate(destination *discoveryv1.Destination, trafficPolicies networkingv1.TrafficPolicySlice) {
 	isUpToDate := func(tp *networkingv1.TrafficPolicy) bool {
 		return tp.Status.ObservedGeneration == tp.Generation
 	}
@@ -587,6 +640,10 @@ func sortTrafficPoliciesByAcceptedDate(destination *discoveryv1.Destination, tra
 	sort.SliceStable(trafficPolicies, func(i, j int) bool {
 		tp1, tp2 := trafficPolicies[i], trafficPolicies[j]
 
+		if priority1, priority2 := tp1.Spec.GetPriority(), tp2.Spec.GetPriority(); priority1 != priority2 {
+			return priority1 < priority2
+		}
+
 		status1 := tp1.Status.Destinations[sets.Key(destination)]
 		status2 := tp2.Status.Destinations[sets.Key(destination)]
 
@@ -647,6 +704,68 @@ func getAppliedAccessPolicies(
 	return appliedPolicies
 }
 
+// Fetch all ServiceDependencies applicable to the given Workload, along with the hostnames of the Destinations they select.
+// Returns nil if no ServiceDependencies apply to the Workload.
+func getAppliedServiceDependencies(
+	ctx context.Context,
+	serviceDependencies networkingv1beta1.ServiceDependencySlice,
+	destinations discoveryv1.DestinationSlice,
+	clusterDomains hostutils.ClusterDomainRegistry,
+	workload *discoveryv1.Workload,
+) *discoveryv1.WorkloadStatus_ServiceDependencies {
+	// ServiceDependencies only support Kubernetes workloads
+	if workload.Spec.GetKubernetes() == nil {
+		return nil
+	}
+	workloadCluster := workload.Spec.GetKubernetes().GetController().GetClusterName()
+
+	var appliedServiceDependencies []*discoveryv1.WorkloadStatus_ServiceDependencies_AppliedServiceDependency
+	destinationHostnames := utilsets.NewString()
+	for _, serviceDependency := range serviceDependencies {
+		if serviceDependency.Status.State != commonv1.ApprovalState_ACCEPTED {
+			continue
+		}
+		if !selectorutils.SelectorMatchesWorkload(ctx, serviceDependency.Spec.GetSourceSelectors(), workload) {
+			continue
+		}
+		appliedServiceDependencies = append(appliedServiceDependencies, &discoveryv1.WorkloadStatus_ServiceDependencies_AppliedServiceDependency{
+			ServiceDependencyRef: ezkube.MakeObjectRef(serviceDependency),
+			ObservedGeneration:   serviceDependency.Generation,
+		})
+		for _, destination := range destinations {
+			if selectorutils.SelectorMatchesDestination(serviceDependency.Spec.GetDestinationSelectors(), destination) {
+				destinationHostnames.Insert(hostutils.GetDestinationHostnames(clusterDomains, workloadCluster, destination)...)
+			}
+		}
+	}
+
+	if len(appliedServiceDependencies) == 0 {
+		return nil
+	}
+
+	return &discoveryv1.WorkloadStatus_ServiceDependencies{
+		AppliedServiceDependencies: appliedServiceDependencies,
+		DestinationHostnames:       destinationHostnames.List(),
+	}
+}
+
+// record the Workload on the status of each ServiceDependency applied to it
+func setServiceDependencyWorkloadStatuses(
+	serviceDependencies networkingv1beta1sets.ServiceDependencySet,
+	workload *discoveryv1.Workload,
+) {
+	for _, appliedServiceDependency := range workload.Status.GetServiceDependencies().GetAppliedServiceDependencies() {
+		serviceDependency, err := serviceDependencies.Find(appliedServiceDependency.GetServiceDependencyRef())
+		if err != nil {
+			// should never happen, as the ServiceDependency was applied from the same snapshot
+			continue
+		}
+		serviceDependency.Status.Workloads[sets.Key(workload)] = &networkingv1.ApprovalStatus{
+			State: commonv1.ApprovalState_ACCEPTED,
+		}
+	}
+}
+
 // return AppliedFederation if this Destination is federated by a VirtualMesh, otherwise return nil
 func getAppliedFederation(
 	virtualMeshes networkingv1.VirtualMeshSlice,
@@ -691,11 +810,12 @@ func getAppliedFederation(
 	federatedKeepalive := parentVirtualMesh.Spec.GetFederation().GetTcpKeepalive()
 
 	return &discoveryv1.DestinationStatus_AppliedFederation{
-		VirtualMeshRef:    ezkube.MakeObjectRef(parentVirtualMesh),
-		FederatedHostname: federatedHostname,
-		FederatedToMeshes: federatedToMeshes,
-		FlatNetwork:       parentVirtualMesh.Spec.GetFederation().GetFlatNetwork(),
-		TcpKeepalive:      federatedKeepalive,
+		VirtualMeshRef:        ezkube.MakeObjectRef(parentVirtualMesh),
+		FederatedHostname:     federatedHostname,
+		FederatedToMeshes:     federatedToMeshes,
+		FlatNetwork:           parentVirtualMesh.Spec.GetFederation().GetFlatNetwork(),
+		TcpKeepalive:          federatedKeepalive,
+		LocalityLoadBalancing: parentVirtualMesh.Spec.GetFederation().GetLocalityLoadBalancing(),
 	}
 }
 
@@ -706,7 +826,7 @@ func getRequiredSubsets(
 ) []*discoveryv1.RequiredSubsets {
 	var matchingTrafficPolicies networkingv1.TrafficPolicySlice
 	for _, policy := range trafficPolicies {
-		if referencedByTrafficShiftSubset(destination, policy) {
+		if referencedByTrafficShiftSubset(destination, policy) || selectsCanaryDestination(destination, policy) {
 			matchingTrafficPolicies = append(matchingTrafficPolicies, policy)
 		}
 	}
@@ -714,10 +834,15 @@ func getRequiredSubsets(
 	var requiredSubsets []*discoveryv1.RequiredSubsets
 	for _, policy := range matchingTrafficPolicies {
 		policy := policy // pike
+		trafficShift := policy.Spec.Policy.TrafficShift
+		if canary := policy.Spec.Policy.GetCanary(); canary != nil {
+			// the weights of the canary subsets do not affect the required subsets
+			trafficShift = canaryutils.TrafficShift(canary, destination, 0)
+		}
 		requiredSubsets = append(requiredSubsets, &discoveryv1.RequiredSubsets{
 			TrafficPolicyRef:   ezkube.MakeObjectRef(policy),
 			ObservedGeneration: policy.Generation,
-			TrafficShift:       policy.Spec.Policy.TrafficShift,
+			TrafficShift:       trafficShift,
 		})
 	}
 	return requiredSubsets
@@ -739,6 +864,13 @@ func referencedByTrafficShiftSubset(destination *discoveryv1.Destination, traffi
 	return referenced
 }
 
+// return true if the TrafficPolicy shifts traffic to the canary subset of this Destination
+func selectsCanaryDestination(destination *discoveryv1.Destination, trafficPolicy *networkingv1.TrafficPolicy) bool {
+	return trafficPolicy.Spec.GetPolicy().GetCanary() != nil &&
+		destination.Spec.GetKubeService() != nil &&
+		selectorutils.SelectorMatchesDestination(trafficPolicy.Spec.GetDestinationSelector(), destination)
+}
+
 func getAppliedVirtualMesh(
 	virtualMeshes networkingv1.VirtualMeshSlice,
 	mesh *discoveryv1.Mesh,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/golang/protobuf/ptypes/duration"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
@@ -45,6 +46,28 @@ var _ = Describe("Applier", func() {
 					},
 				},
 			}
+			externalDestination = &discoveryv1.Destination{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "external",
+					Namespace: "ns",
+				},
+				Spec: discoveryv1.DestinationSpec{
+					Mesh: &skv2corev1.ObjectRef{
+						Name:      "mesh1",
+						Namespace: "ns",
+					},
+					Type: &discoveryv1.DestinationSpec_ExternalService_{
+						ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+							Ref: &skv2corev1.ClusterObjectRef{
+								Name:        "external-api",
+								Namespace:   "svc-namespace",
+								ClusterName: "svc-cluster",
+							},
+							Hosts: []string{"api.example.com"},
+						},
+					},
+				},
+			}
 			workload = &discoveryv1.Workload{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "wkld1",
@@ -89,7 +112,7 @@ var _ = Describe("Applier", func() {
 			}
 
 			snap = input.NewInputLocalSnapshotManualBuilder("").
-				AddDestinations(discoveryv1.DestinationSlice{destination}).
+				AddDestinations(discoveryv1.DestinationSlice{destination, externalDestination}).
 				AddTrafficPolicies(networkingv1.TrafficPolicySlice{trafficPolicy1, trafficPolicy2}).
 				AddWorkloads(discoveryv1.WorkloadSlice{workload}).
 				AddMeshes(discoveryv1.MeshSlice{mesh}).
@@ -128,6 +151,10 @@ var _ = Describe("Applier", func() {
 			Expect(destination.Status.AppliedTrafficPolicies[1].Spec).To(Equal(&trafficPolicy2.Spec))
 			Expect(destination.Status.LocalFqdn).To(Equal("svc-name.svc-namespace.svc.cluster.local"))
 		})
+		It("does not apply policies without a destination selector to external services", func() {
+			Expect(trafficPolicy1.Status.Destinations).NotTo(HaveKey(sets.Key(externalDestination)))
+			Expect(externalDestination.Status.AppliedTrafficPolicies).To(BeEmpty())
+		})
 	})
 	Context("invalid traffic policies", func() {
 		var (
@@ -171,6 +198,111 @@ var _ = Describe("Applier", func() {
 		})
 	})
 
+	Context("traffic policies of different priorities", func() {
+		var (
+			destination = &discoveryv1.Destination{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ms1",
+					Namespace: "ns",
+				},
+			}
+			highPriorityPolicy = &networkingv1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "high-priority",
+					Namespace: "ns",
+				},
+				Spec: networkingv1.TrafficPolicySpec{
+					Priority: 10,
+					Policy: &networkingv1.TrafficPolicySpec_Policy{
+						Mirror: &networkingv1.TrafficPolicySpec_Policy_Mirror{
+							Percentage: 50,
+						},
+						HeaderManipulation: &networkingv1.HeaderManipulation{
+							AppendRequestHeaders: map[string]string{"foo": "bar"},
+						},
+					},
+				},
+			}
+			lowPriorityPolicy = &networkingv1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "low-priority",
+					Namespace: "ns",
+				},
+				Spec: networkingv1.TrafficPolicySpec{
+					Policy: &networkingv1.TrafficPolicySpec_Policy{
+						Mirror: &networkingv1.TrafficPolicySpec_Policy_Mirror{
+							Percentage: 10,
+						},
+						HeaderManipulation: &networkingv1.HeaderManipulation{
+							RemoveRequestHeaders: []string{"baz"},
+						},
+						Mtls: &networkingv1.TrafficPolicySpec_Policy_MTLS{
+							Istio: &networkingv1.TrafficPolicySpec_Policy_MTLS_Istio{
+								TlsMode: networkingv1.TrafficPolicySpec_Policy_MTLS_Istio_ISTIO_MUTUAL,
+							},
+						},
+					},
+				},
+			}
+			conflictingPolicy = &networkingv1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "conflicting",
+					Namespace: "ns",
+				},
+				Spec: networkingv1.TrafficPolicySpec{
+					Priority: 5,
+					Policy: &networkingv1.TrafficPolicySpec_Policy{
+						Mtls: &networkingv1.TrafficPolicySpec_Policy_MTLS{
+							Istio: &networkingv1.TrafficPolicySpec_Policy_MTLS_Istio{
+								TlsMode: networkingv1.TrafficPolicySpec_Policy_MTLS_Istio_DISABLE,
+							},
+						},
+					},
+				},
+			}
+
+			snap = input.NewInputLocalSnapshotManualBuilder("").
+				AddDestinations(discoveryv1.DestinationSlice{destination}).
+				AddTrafficPolicies(networkingv1.TrafficPolicySlice{highPriorityPolicy, lowPriorityPolicy, conflictingPolicy}).
+				Build()
+		)
+
+		BeforeEach(func() {
+			translator := testIstioTranslator{callReporter: func(reporter reporting.Reporter) {
+				// no report = accept
+			}}
+			applier := NewApplier(translator)
+			applier.Apply(context.TODO(), snap, nil)
+		})
+		It("applies traffic policies in order of increasing priority", func() {
+			Expect(destination.Status.AppliedTrafficPolicies).To(HaveLen(2))
+			Expect(destination.Status.AppliedTrafficPolicies[0].Ref).To(Equal(ezkube.MakeObjectRef(lowPriorityPolicy)))
+			Expect(destination.Status.AppliedTrafficPolicies[1].Ref).To(Equal(ezkube.MakeObjectRef(highPriorityPolicy)))
+		})
+		It("rejects traffic policies which override fields with the REJECT merge strategy", func() {
+			Expect(conflictingPolicy.Status.Destinations[sets.Key(destination)]).To(Equal(&networkingv1.ApprovalStatus{
+				AcceptanceOrder: 0,
+				State:           commonv1.ApprovalState_INVALID,
+				Errors:          []string{"field mtls cannot be overridden, and is already set by TrafficPolicy low-priority.ns. of priority 0"},
+			}))
+		})
+		It("reports merged fields on the Destination status", func() {
+			Expect(destination.Status.AppliedTrafficPolicies[0].MergedFields).To(BeEmpty())
+			Expect(destination.Status.AppliedTrafficPolicies[1].MergedFields).To(Equal([]*discoveryv1.DestinationStatus_AppliedTrafficPolicy_MergedField{
+				{
+					Field:    "header_manipulation",
+					Strategy: networkingv1.MergeStrategy_APPEND,
+					Policies: []*skv2corev1.ObjectRef{ezkube.MakeObjectRef(lowPriorityPolicy)},
+				},
+				{
+					Field:    "mirror",
+					Strategy: networkingv1.MergeStrategy_OVERRIDE,
+					Policies: []*skv2corev1.ObjectRef{ezkube.MakeObjectRef(lowPriorityPolicy)},
+				},
+			}))
+		})
+	})
+
 	Context("setting workloads status", func() {
 		var (
 			destination1 = &discoveryv1.Destination{
@@ -1409,6 +1541,357 @@ var _ = Describe("Applier", func() {
 		})
 
 	})
+
+	Context("egress gateway policies", func() {
+		var (
+			mesh         *discoveryv1.Mesh
+			nonIstioMesh *discoveryv1.Mesh
+			applier      Applier
+		)
+
+		BeforeEach(func() {
+			mesh = &discoveryv1.Mesh{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mesh1",
+					Namespace: "ns",
+				},
+				Spec: discoveryv1.MeshSpec{
+					Type: &discoveryv1.MeshSpec_Istio_{Istio: &discoveryv1.MeshSpec_Istio{
+						Installation: &discoveryv1.MeshInstallation{
+							Namespace: "istio-system",
+							Cluster:   "cluster",
+						},
+						EgressGateways: []*discoveryv1.MeshSpec_Istio_EgressGatewayInfo{{
+							Name:           "istio-egressgateway",
+							Namespace:      "istio-system",
+							WorkloadLabels: defaults.DefaultEgressGatewayWorkloadLabels,
+							Ports: []*discoveryv1.MeshSpec_Istio_EgressGatewayInfo_ServicePort{
+								{Port: 80, Name: "http"},
+							},
+						}},
+					}},
+				},
+			}
+			nonIstioMesh = &discoveryv1.Mesh{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mesh2",
+					Namespace: "ns",
+				},
+				Spec: discoveryv1.MeshSpec{
+					Type: &discoveryv1.MeshSpec_Linkerd{Linkerd: &discoveryv1.MeshSpec_LinkerdMesh{}},
+				},
+			}
+
+			translator := testIstioTranslator{callReporter: func(reporter reporting.Reporter) {}}
+			applier = NewApplier(translator)
+		})
+
+		makePolicy := func(name string, externalHosts ...*networkingv1.EgressGatewayPolicySpec_ExternalHost) *networkingv1.EgressGatewayPolicy {
+			return &networkingv1.EgressGatewayPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "ns",
+				},
+				Spec: networkingv1.EgressGatewayPolicySpec{
+					ExternalHosts: externalHosts,
+				},
+			}
+		}
+
+		It("routes external hosts through the egress gateways of all Istio meshes", func() {
+			egressGatewayPolicy := makePolicy("egress", &networkingv1.EgressGatewayPolicySpec_ExternalHost{Hostname: "httpbin.org"})
+
+			snap := input.NewInputLocalSnapshotManualBuilder("").
+				AddMeshes(discoveryv1.MeshSlice{mesh, nonIstioMesh}).
+				AddEgressGatewayPolicies(networkingv1.EgressGatewayPolicySlice{egressGatewayPolicy}).
+				Build()
+			applier.Apply(context.TODO(), snap, nil)
+
+			Expect(&egressGatewayPolicy.Status).To(Equal(&networkingv1.EgressGatewayPolicyStatus{
+				State: commonv1.ApprovalState_ACCEPTED,
+				Meshes: map[string]*networkingv1.ApprovalStatus{
+					sets.Key(mesh): {State: commonv1.ApprovalState_ACCEPTED},
+				},
+				Destinations: map[string]*networkingv1.ApprovalStatus{},
+			}))
+		})
+
+		It("rejects external hosts on ports not exposed by the egress gateway", func() {
+			egressGatewayPolicy := makePolicy("egress", &networkingv1.EgressGatewayPolicySpec_ExternalHost{
+				Hostname: "httpbin.org",
+				Protocol: networkingv1.EgressGatewayPolicySpec_ExternalHost_TLS,
+			})
+
+			snap := input.NewInputLocalSnapshotManualBuilder("").
+				AddMeshes(discoveryv1.MeshSlice{mesh}).
+				AddEgressGatewayPolicies(networkingv1.EgressGatewayPolicySlice{egressGatewayPolicy}).
+				Build()
+			applier.Apply(context.TODO(), snap, nil)
+
+			Expect(egressGatewayPolicy.Status.State).To(Equal(commonv1.ApprovalState_INVALID))
+			Expect(egressGatewayPolicy.Status.Meshes[sets.Key(mesh)]).To(Equal(&networkingv1.ApprovalStatus{
+				State:  commonv1.ApprovalState_INVALID,
+				Errors: []string{"egress gateway istio-egressgateway.istio-system does not expose port 443 for host httpbin.org"},
+			}))
+		})
+
+		It("rejects external hosts using different protocols on the same port", func() {
+			egressGatewayPolicy := makePolicy("egress",
+				&networkingv1.EgressGatewayPolicySpec_ExternalHost{Hostname: "httpbin.org"},
+				&networkingv1.EgressGatewayPolicySpec_ExternalHost{
+					Hostname: "example.com",
+					Port:     80,
+					Protocol: networkingv1.EgressGatewayPolicySpec_ExternalHost_TLS,
+				},
+			)
+
+			snap := input.NewInputLocalSnapshotManualBuilder("").
+				AddMeshes(discoveryv1.MeshSlice{mesh}).
+				AddEgressGatewayPolicies(networkingv1.EgressGatewayPolicySlice{egressGatewayPolicy}).
+				Build()
+			applier.Apply(context.TODO(), snap, nil)
+
+			Expect(egressGatewayPolicy.Status.State).To(Equal(commonv1.ApprovalState_INVALID))
+			Expect(egressGatewayPolicy.Status.Meshes[sets.Key(mesh)]).To(Equal(&networkingv1.ApprovalStatus{
+				State:  commonv1.ApprovalState_INVALID,
+				Errors: []string{"host example.com uses protocol TLS on port 80, which is already used for protocol HTTP by another host"},
+			}))
+		})
+
+		It("rejects non Istio meshes and wildcard hosts", func() {
+			nonIstioPolicy := makePolicy("non-istio", &networkingv1.EgressGatewayPolicySpec_ExternalHost{Hostname: "httpbin.org"})
+			nonIstioPolicy.Spec.Meshes = []*skv2corev1.ObjectRef{ezkube.MakeObjectRef(nonIstioMesh)}
+			wildcardPolicy := makePolicy("wildcard", &networkingv1.EgressGatewayPolicySpec_ExternalHost{Hostname: "*.example.com"})
+
+			snap := input.NewInputLocalSnapshotManualBuilder("").
+				AddMeshes(discoveryv1.MeshSlice{mesh, nonIstioMesh}).
+				AddEgressGatewayPolicies(networkingv1.EgressGatewayPolicySlice{nonIstioPolicy, wildcardPolicy}).
+				Build()
+			applier.Apply(context.TODO(), snap, nil)
+
+			Expect(nonIstioPolicy.Status.State).To(Equal(commonv1.ApprovalState_INVALID))
+			Expect(nonIstioPolicy.Status.Errors).To(ConsistOf("Mesh mesh2.ns. is not an Istio mesh"))
+			Expect(wildcardPolicy.Status.State).To(Equal(commonv1.ApprovalState_INVALID))
+			Expect(wildcardPolicy.Status.Errors).To(ConsistOf("external host *.example.com must not contain wildcards"))
+		})
+
+		It("rejects hosts already routed through the egress gateway by another policy", func() {
+			egressGatewayPolicy1 := makePolicy("egress1", &networkingv1.EgressGatewayPolicySpec_ExternalHost{Hostname: "httpbin.org"})
+			egressGatewayPolicy2 := makePolicy("egress2", &networkingv1.EgressGatewayPolicySpec_ExternalHost{Hostname: "httpbin.org"})
+
+			snap := input.NewInputLocalSnapshotManualBuilder("").
+				AddMeshes(discoveryv1.MeshSlice{mesh}).
+				AddEgressGatewayPolicies(networkingv1.EgressGatewayPolicySlice{egressGatewayPolicy1, egressGatewayPolicy2}).
+				Build()
+			applier.Apply(context.TODO(), snap, nil)
+
+			Expect(egressGatewayPolicy1.Status.State).To(Equal(commonv1.ApprovalState_ACCEPTED))
+			Expect(egressGatewayPolicy2.Status.State).To(Equal(commonv1.ApprovalState_INVALID))
+			Expect(egressGatewayPolicy2.Status.Meshes[sets.Key(mesh)].GetErrors()).To(ConsistOf(
+				"host httpbin.org is already routed through the egress gateway by EgressGatewayPolicy egress1.ns.",
+			))
+		})
+
+		It("rejects hostnames already routed through the egress gateway by another policy on a different port", func() {
+			egressGateway := mesh.Spec.GetIstio().GetEgressGateways()[0]
+			egressGateway.Ports = append(egressGateway.Ports, &discoveryv1.MeshSpec_Istio_EgressGatewayInfo_ServicePort{Port: 8080, Name: "http-alt"})
+			egressGatewayPolicy1 := makePolicy("egress1", &networkingv1.EgressGatewayPolicySpec_ExternalHost{Hostname: "httpbin.org"})
+			egressGatewayPolicy2 := makePolicy("egress2", &networkingv1.EgressGatewayPolicySpec_ExternalHost{Hostname: "httpbin.org", Port: 8080})
+
+			snap := input.NewInputLocalSnapshotManualBuilder("").
+				AddMeshes(discoveryv1.MeshSlice{mesh}).
+				AddEgressGatewayPolicies(networkingv1.EgressGatewayPolicySlice{egressGatewayPolicy1, egressGatewayPolicy2}).
+				Build()
+			applier.Apply(context.TODO(), snap, nil)
+
+			Expect(egressGatewayPolicy1.Status.State).To(Equal(commonv1.ApprovalState_ACCEPTED))
+			Expect(egressGatewayPolicy2.Status.State).To(Equal(commonv1.ApprovalState_INVALID))
+			Expect(egressGatewayPolicy2.Status.Meshes[sets.Key(mesh)].GetErrors()).To(ConsistOf(
+				"host httpbin.org is already routed through the egress gateway by EgressGatewayPolicy egress1.ns.",
+			))
+		})
+
+		It("rejects hosts using a different protocol on a port already used by another policy", func() {
+			egressGatewayPolicy1 := makePolicy("egress1", &networkingv1.EgressGatewayPolicySpec_ExternalHost{Hostname: "httpbin.org"})
+			egressGatewayPolicy2 := makePolicy("egress2", &networkingv1.EgressGatewayPolicySpec_ExternalHost{
+				Hostname: "example.com",
+				Port:     80,
+				Protocol: networkingv1.EgressGatewayPolicySpec_ExternalHost_TLS,
+			})
+
+			snap := input.NewInputLocalSnapshotManualBuilder("").
+				AddMeshes(discoveryv1.MeshSlice{mesh}).
+				AddEgressGatewayPolicies(networkingv1.EgressGatewayPolicySlice{egressGatewayPolicy1, egressGatewayPolicy2}).
+				Build()
+			applier.Apply(context.TODO(), snap, nil)
+
+			Expect(egressGatewayPolicy1.Status.State).To(Equal(commonv1.ApprovalState_ACCEPTED))
+			Expect(egressGatewayPolicy2.Status.State).To(Equal(commonv1.ApprovalState_INVALID))
+			Expect(egressGatewayPolicy2.Status.Meshes[sets.Key(mesh)].GetErrors()).To(ConsistOf(
+				"host example.com uses protocol TLS on port 80, which is already used for protocol HTTP by another host",
+			))
+		})
+	})
+
+	Context("canaries", func() {
+		var (
+			destination   *discoveryv1.Destination
+			trafficPolicy *networkingv1.TrafficPolicy
+			applier       Applier
+		)
+
+		BeforeEach(func() {
+			destination = &discoveryv1.Destination{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "reviews",
+					Namespace: "ns",
+				},
+				Spec: discoveryv1.DestinationSpec{
+					Type: &discoveryv1.DestinationSpec_KubeService_{KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "reviews",
+							Namespace:   "bookinfo",
+							ClusterName: "cluster",
+						},
+					}},
+				},
+			}
+			trafficPolicy = &networkingv1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "canary",
+					Namespace:  "ns",
+					Generation: 1,
+				},
+				Spec: networkingv1.TrafficPolicySpec{
+					Policy: &networkingv1.TrafficPolicySpec_Policy{
+						Canary: &networkingv1.TrafficPolicySpec_Policy_Canary{
+							CanarySubset: map[string]string{"version": "v2"},
+							StableSubset: map[string]string{"version": "v1"},
+							CohortHeaders: []*networkingv1.HeaderMatcher{
+								{Name: "x-canary", Value: "true"},
+							},
+							Steps: []*networkingv1.TrafficPolicySpec_Policy_Canary_Step{
+								{Weight: 10, Duration: &duration.Duration{Seconds: 60}},
+								{Weight: 50, Duration: &duration.Duration{Seconds: 60}},
+							},
+						},
+					},
+				},
+			}
+
+			translator := testIstioTranslator{callReporter: func(reporter reporting.Reporter) {}}
+			applier = NewApplier(translator)
+		})
+
+		makeTrafficShift := func(stableWeight, canaryWeight uint32) *networkingv1.TrafficPolicySpec_Policy_MultiDestination {
+			makeDestination := func(subset map[string]string, weight uint32) *networkingv1.WeightedDestination {
+				return &networkingv1.WeightedDestination{
+					Weight: weight,
+					DestinationType: &networkingv1.WeightedDestination_KubeService{
+						KubeService: &networkingv1.WeightedDestination_KubeDestination{
+							Name:        "reviews",
+							Namespace:   "bookinfo",
+							ClusterName: "cluster",
+							Subset:      subset,
+						},
+					},
+				}
+			}
+			return &networkingv1.TrafficPolicySpec_Policy_MultiDestination{
+				Destinations: []*networkingv1.WeightedDestination{
+					makeDestination(map[string]string{"version": "v1"}, stableWeight),
+					makeDestination(map[string]string{"version": "v2"}, canaryWeight),
+				},
+			}
+		}
+
+		It("applies the current weight of the canary as a traffic shift", func() {
+			trafficPolicy.Status.Canary = &networkingv1.TrafficPolicyStatus_CanaryStatus{
+				ObservedGeneration: 1,
+				CurrentStep:        1,
+				Weight:             50,
+			}
+
+			snap := input.NewInputLocalSnapshotManualBuilder("").
+				AddTrafficPolicies(networkingv1.TrafficPolicySlice{trafficPolicy}).
+				AddDestinations(discoveryv1.DestinationSlice{destination}).
+				Build()
+			applier.Apply(context.TODO(), snap, nil)
+
+			Expect(trafficPolicy.Status.State).To(Equal(commonv1.ApprovalState_ACCEPTED))
+			// the progress of the canary is preserved
+			Expect(trafficPolicy.Status.Canary.GetWeight()).To(Equal(uint32(50)))
+			// the spec of the TrafficPolicy is unmodified
+			Expect(trafficPolicy.Spec.Policy.TrafficShift).To(BeNil())
+
+			appliedTrafficPolicies := destination.Status.GetAppliedTrafficPolicies()
+			Expect(appliedTrafficPolicies).To(HaveLen(1))
+			Expect(appliedTrafficPolicies[0].GetSpec().GetPolicy().GetTrafficShift()).To(Equal(makeTrafficShift(50, 50)))
+			Expect(appliedTrafficPolicies[0].GetSpec().GetPolicy().GetCanary().GetCohortHeaders()).To(HaveLen(1))
+
+			Expect(destination.Status.GetRequiredSubsets()).To(Equal([]*discoveryv1.RequiredSubsets{{
+				TrafficPolicyRef:   ezkube.MakeObjectRef(trafficPolicy),
+				ObservedGeneration: 1,
+				TrafficShift:       makeTrafficShift(100, 0),
+			}}))
+		})
+
+		It("applies the weight of the first step to canaries which have not progressed", func() {
+			snap := input.NewInputLocalSnapshotManualBuilder("").
+				AddTrafficPolicies(networkingv1.TrafficPolicySlice{trafficPolicy}).
+				AddDestinations(discoveryv1.DestinationSlice{destination}).
+				Build()
+			applier.Apply(context.TODO(), snap, nil)
+
+			Expect(destination.Status.GetAppliedTrafficPolicies()[0].GetSpec().GetPolicy().GetTrafficShift()).To(Equal(makeTrafficShift(90, 10)))
+		})
+
+		It("stops routing the cohort to rolled back canaries", func() {
+			trafficPolicy.Status.Canary = &networkingv1.TrafficPolicyStatus_CanaryStatus{
+				ObservedGeneration: 1,
+				Phase:              networkingv1.TrafficPolicyStatus_CanaryStatus_ROLLED_BACK,
+			}
+
+			snap := input.NewInputLocalSnapshotManualBuilder("").
+				AddTrafficPolicies(networkingv1.TrafficPolicySlice{trafficPolicy}).
+				AddDestinations(discoveryv1.DestinationSlice{destination}).
+				Build()
+			applier.Apply(context.TODO(), snap, nil)
+
+			appliedSpec := destination.Status.GetAppliedTrafficPolicies()[0].GetSpec()
+			Expect(appliedSpec.GetPolicy().GetTrafficShift()).To(Equal(makeTrafficShift(100, 0)))
+			Expect(appliedSpec.GetPolicy().GetCanary().GetCohortHeaders()).To(BeEmpty())
+		})
+
+		It("rejects canaries combined with a traffic shift or without steps", func() {
+			trafficPolicy.Spec.Policy.TrafficShift = makeTrafficShift(50, 50)
+			invalidTrafficPolicy := &networkingv1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "no-steps",
+					Namespace: "ns",
+				},
+				Spec: networkingv1.TrafficPolicySpec{
+					Policy: &networkingv1.TrafficPolicySpec_Policy{
+						Canary: &networkingv1.TrafficPolicySpec_Policy_Canary{
+							CanarySubset: map[string]string{"version": "v2"},
+						},
+					},
+				},
+			}
+
+			snap := input.NewInputLocalSnapshotManualBuilder("").
+				AddTrafficPolicies(networkingv1.TrafficPolicySlice{trafficPolicy, invalidTrafficPolicy}).
+				AddDestinations(discoveryv1.DestinationSlice{destination}).
+				Build()
+			applier.Apply(context.TODO(), snap, nil)
+
+			Expect(trafficPolicy.Status.State).To(Equal(commonv1.ApprovalState_INVALID))
+			Expect(trafficPolicy.Status.Errors).To(ConsistOf("canary cannot be combined with traffic_shift"))
+			Expect(invalidTrafficPolicy.Status.State).To(Equal(commonv1.ApprovalState_INVALID))
+			Expect(invalidTrafficPolicy.Status.Errors).To(ConsistOf("canary must specify at least one step"))
+			Expect(destination.Status.GetAppliedTrafficPolicies()).To(BeEmpty())
+		})
+	})
 })
 
 // NOTE(ilackarms): we implement a test translator here instead of using a mock because
@@ -0,0 +1,88 @@
+package apply
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/rotisserie/eris"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/canaryutils"
+	"google.golang.org/protobuf/proto"
+)
+
+const minCanaryStepDuration = time.Second
+
+// Validate the canaries of the TrafficPolicies, rejecting the TrafficPolicies with invalid canaries.
+func validateCanaries(trafficPolicies networkingv1.TrafficPolicySlice) {
+	for _, trafficPolicy := range trafficPolicies {
+		// policies with invalid references have already been rejected
+		if trafficPolicy.Status.State != commonv1.ApprovalState_ACCEPTED {
+			continue
+		}
+		if err := validateCanary(trafficPolicy.Spec.GetPolicy()); err != nil {
+			trafficPolicy.Status.State = commonv1.ApprovalState_INVALID
+			trafficPolicy.Status.Errors = append(trafficPolicy.Status.Errors, err.Error())
+		}
+	}
+}
+
+func validateCanary(policy *networkingv1.TrafficPolicySpec_Policy) error {
+	canary := policy.GetCanary()
+	if canary == nil {
+		return nil
+	}
+	if policy.GetTrafficShift() != nil {
+		return eris.New("canary cannot be combined with traffic_shift")
+	}
+	if len(canary.GetCanarySubset()) == 0 {
+		return eris.New("canary must specify the canary subset")
+	}
+	if len(canary.GetSteps()) == 0 {
+		return eris.New("canary must specify at least one step")
+	}
+	for i, step := range canary.GetSteps() {
+		if step.GetWeight() > 100 {
+			return eris.Errorf("weight of canary step %d must be between 0 and 100", i)
+		}
+		duration, err := ptypes.Duration(step.GetDuration())
+		if err != nil || duration < minCanaryStepDuration {
+			return eris.Errorf("duration of canary step %d must be at least %v", i, minCanaryStepDuration)
+		}
+	}
+	for _, cookie := range canary.GetCohortCookies() {
+		if cookie.GetName() == "" {
+			return eris.New("canary cohort cookies must specify a name")
+		}
+	}
+	if analysis := canary.GetAnalysis(); analysis != nil {
+		if maxErrorRate := analysis.GetMaxErrorRate(); maxErrorRate < 0 || maxErrorRate > 100 {
+			return eris.New("max error rate of canary analysis must be between 0 and 100")
+		}
+		if analysis.GetInterval() != nil {
+			if interval, err := ptypes.Duration(analysis.GetInterval()); err != nil || interval < minCanaryStepDuration {
+				return eris.Errorf("interval of canary analysis must be at least %v", minCanaryStepDuration)
+			}
+		}
+	}
+	return nil
+}
+
+// Return a copy of the TrafficPolicy's spec with the traffic shift of the canary's current weight for the Destination.
+// The canary is translated by the traffic shift decorator, as if the traffic shift had been set on the TrafficPolicy.
+func applyCanary(
+	trafficPolicy *networkingv1.TrafficPolicy,
+	destination *discoveryv1.Destination,
+) *networkingv1.TrafficPolicySpec {
+	spec := proto.Clone(&trafficPolicy.Spec).(*networkingv1.TrafficPolicySpec)
+	canary := spec.GetPolicy().GetCanary()
+
+	spec.Policy.TrafficShift = canaryutils.TrafficShift(canary, destination, canaryutils.CurrentWeight(trafficPolicy))
+	if !canaryutils.CohortRouted(trafficPolicy) {
+		canary.CohortHeaders = nil
+		canary.CohortCookies = nil
+	}
+
+	return spec
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/rotisserie/eris"
 	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
 	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	networkingv1beta1 "github.com/solo-io/gloo-mesh/pkg/api/networking.enterprise.mesh.gloo.solo.io/v1beta1"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	v1sets "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/sets"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
@@ -35,6 +36,16 @@ type ConfigTargetValidator interface {
 	ValidateAccessPolicies(
 		accessPolicies v1.AccessPolicySlice,
 	)
+
+	// Validate Destination references declared on ServiceDependencies.
+	ValidateServiceDependencies(
+		serviceDependencies networkingv1beta1.ServiceDependencySlice,
+	)
+
+	// Validate Destination and mesh references declared on EgressGatewayPolicies.
+	ValidateEgressGatewayPolicies(
+		egressGatewayPolicies v1.EgressGatewayPolicySlice,
+	)
 }
 
 type configTargetValidator struct {
@@ -88,6 +99,29 @@ func (c *configTargetValidator) ValidateAccessPolicies(accessPolicies v1.AccessP
 	}
 }
 
+func (c *configTargetValidator) ValidateServiceDependencies(serviceDependencies networkingv1beta1.ServiceDependencySlice) {
+	for _, serviceDependency := range serviceDependencies {
+		errs := c.validateDestinationReferences(serviceDependency.Spec.DestinationSelectors)
+		if len(errs) == 0 {
+			continue
+		}
+		serviceDependency.Status.State = commonv1.ApprovalState_INVALID
+		serviceDependency.Status.Errors = getErrStrings(errs)
+	}
+}
+
+func (c *configTargetValidator) ValidateEgressGatewayPolicies(egressGatewayPolicies v1.EgressGatewayPolicySlice) {
+	for _, egressGatewayPolicy := range egressGatewayPolicies {
+		errs := c.validateDestinationReferences(egressGatewayPolicy.Spec.DestinationSelector)
+		errs = append(errs, c.validateMeshReferences(egressGatewayPolicy.Spec.Meshes)...)
+		if len(errs) == 0 {
+			continue
+		}
+		egressGatewayPolicy.Status.State = commonv1.ApprovalState_INVALID
+		egressGatewayPolicy.Status.Errors = getErrStrings(errs)
+	}
+}
+
 func (c *configTargetValidator) validateMeshReferences(meshRefs []*skv2corev1.ObjectRef) []error {
 	var errs []error
 	for _, meshRef := range meshRefs {
@@ -103,18 +137,21 @@ func (c *configTargetValidator) validateMeshReferences(meshRefs []*skv2corev1.Ob
 func (c *configTargetValidator) validateDestinationReferences(serviceSelectors []*commonv1.DestinationSelector) []error {
 	var errs []error
 	for _, destinationSelector := range serviceSelectors {
-		kubeServiceRefs := destinationSelector.GetKubeServiceRefs()
 		// only validate Destinations selected by direct reference
-		if kubeServiceRefs == nil {
-			continue
-		}
-		for _, ref := range kubeServiceRefs.Services {
+		for _, ref := range destinationSelector.GetKubeServiceRefs().GetServices() {
 			if err := validateClusterObjectRef(ref); err != nil {
 				errs = append(errs, eris.Wrap(err, "malformed kubeServiceRef"))
 			} else if !c.kubeServiceExists(ref) {
 				errs = append(errs, eris.Errorf("Destination %s not found", sets.Key(ref)))
 			}
 		}
+		for _, ref := range destinationSelector.GetExternalServiceRefs().GetServiceEntries() {
+			if err := validateClusterObjectRef(ref); err != nil {
+				errs = append(errs, eris.Wrap(err, "malformed externalServiceRef"))
+			} else if !c.externalServiceExists(ref) {
+				errs = append(errs, eris.Errorf("Destination for ServiceEntry %s not found", sets.Key(ref)))
+			}
+		}
 	}
 	return errs
 }
@@ -132,6 +169,19 @@ func (c *configTargetValidator) kubeServiceExists(ref *skv2corev1.ClusterObjectR
 	return false
 }
 
+func (c *configTargetValidator) externalServiceExists(ref *skv2corev1.ClusterObjectRef) bool {
+	for _, destination := range c.destinations.List() {
+		externalService := destination.Spec.GetExternalService()
+		if externalService == nil {
+			continue
+		}
+		if ezkube.ClusterRefsMatch(ref, externalService.Ref) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *configTargetValidator) validateVirtualMesh(virtualMesh *v1.VirtualMesh) []error {
 	var errs []error
 	meshRefErrors := c.validateMeshReferences(virtualMesh.Spec.Meshes)
@@ -7,6 +7,7 @@ import (
 	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	networkingv1beta1 "github.com/solo-io/gloo-mesh/pkg/api/networking.enterprise.mesh.gloo.solo.io/v1beta1"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/apply/configtarget"
@@ -208,17 +209,71 @@ var _ = Describe("ConfigTargetValidator", func() {
 			},
 		}
 
+		serviceDependencies := networkingv1beta1.ServiceDependencySlice{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "valid",
+					Namespace: namespace,
+				},
+				Spec: networkingv1beta1.ServiceDependencySpec{
+					DestinationSelectors: []*commonv1.DestinationSelector{
+						{
+							KubeServiceRefs: &commonv1.DestinationSelector_KubeServiceRefs{
+								Services: []*skv2corev1.ClusterObjectRef{
+									{
+										Name:        "foo",
+										Namespace:   "bar",
+										ClusterName: "cluster",
+									},
+								},
+							},
+						},
+					},
+				},
+				Status: networkingv1beta1.ServiceDependencyStatus{
+					State: commonv1.ApprovalState_ACCEPTED,
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "invalid",
+					Namespace: namespace,
+				},
+				Spec: networkingv1beta1.ServiceDependencySpec{
+					DestinationSelectors: []*commonv1.DestinationSelector{
+						{
+							KubeServiceRefs: &commonv1.DestinationSelector_KubeServiceRefs{
+								Services: []*skv2corev1.ClusterObjectRef{
+									{
+										Name:        "nonexistent",
+										Namespace:   "nonexistent",
+										ClusterName: "nonexistent",
+									},
+								},
+							},
+						},
+					},
+				},
+				Status: networkingv1beta1.ServiceDependencyStatus{
+					State: commonv1.ApprovalState_ACCEPTED,
+				},
+			},
+		}
+
 		validator.ValidateAccessPolicies(accessPolicies)
 		validator.ValidateTrafficPolicies(trafficPolicies)
 		validator.ValidateVirtualMeshes(virtualMeshes)
+		validator.ValidateServiceDependencies(serviceDependencies)
 
 		Expect(accessPolicies[0].Status.State).To(Equal(commonv1.ApprovalState_ACCEPTED))
 		Expect(trafficPolicies[0].Status.State).To(Equal(commonv1.ApprovalState_ACCEPTED))
 		Expect(virtualMeshes[0].Status.State).To(Equal(commonv1.ApprovalState_ACCEPTED))
+		Expect(serviceDependencies[0].Status.State).To(Equal(commonv1.ApprovalState_ACCEPTED))
 
 		Expect(accessPolicies[1].Status.State).To(Equal(commonv1.ApprovalState_INVALID))
 		Expect(trafficPolicies[1].Status.State).To(Equal(commonv1.ApprovalState_INVALID))
 		Expect(virtualMeshes[1].Status.State).To(Equal(commonv1.ApprovalState_INVALID))
+		Expect(serviceDependencies[1].Status.State).To(Equal(commonv1.ApprovalState_INVALID))
 	})
 
 	It("should validate one VirtualMesh per mesh", func() {
@@ -443,4 +498,76 @@ var _ = Describe("ConfigTargetValidator", func() {
 		Expect(virtualMeshes[0].Status.State).To(Equal(commonv1.ApprovalState_INVALID))
 		Expect(virtualMeshes[0].Status.Errors).To(Equal([]string{"malformed meshRef: 2 errors occurred:\n\t* 'name' must be specified'\n\t* 'namespace' must be specified'\n\n"}))
 	})
+
+	It("should invalidate TrafficPolicies that reference non-existent ExternalServices", func() {
+		destinations := discoveryv1sets.NewDestinationSet(
+			&discoveryv1.Destination{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "external-destination",
+					Namespace: "namespace",
+				},
+				Spec: discoveryv1.DestinationSpec{
+					Type: &discoveryv1.DestinationSpec_ExternalService_{
+						ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+							Hosts: []string{"db.example.com"},
+							Ref: &skv2corev1.ClusterObjectRef{
+								Name:        "database",
+								Namespace:   "bar",
+								ClusterName: "cluster",
+							},
+						},
+					},
+				},
+			})
+
+		validator = configtarget.NewConfigTargetValidator(discoveryv1sets.NewMeshSet(), destinations)
+
+		externalServiceSelector := func(name string) []*commonv1.DestinationSelector {
+			return []*commonv1.DestinationSelector{
+				{
+					ExternalServiceRefs: &commonv1.DestinationSelector_ExternalServiceRefs{
+						ServiceEntries: []*skv2corev1.ClusterObjectRef{
+							{
+								Name:        name,
+								Namespace:   "bar",
+								ClusterName: "cluster",
+							},
+						},
+					},
+				},
+			}
+		}
+
+		trafficPolicies := v1.TrafficPolicySlice{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "valid",
+					Namespace: namespace,
+				},
+				Spec: v1.TrafficPolicySpec{
+					DestinationSelector: externalServiceSelector("database"),
+				},
+				Status: v1.TrafficPolicyStatus{
+					State: commonv1.ApprovalState_ACCEPTED,
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "invalid",
+					Namespace: namespace,
+				},
+				Spec: v1.TrafficPolicySpec{
+					DestinationSelector: externalServiceSelector("nonexistent"),
+				},
+				Status: v1.TrafficPolicyStatus{
+					State: commonv1.ApprovalState_ACCEPTED,
+				},
+			},
+		}
+
+		validator.ValidateTrafficPolicies(trafficPolicies)
+
+		Expect(trafficPolicies[0].Status.State).To(Equal(commonv1.ApprovalState_ACCEPTED))
+		Expect(trafficPolicies[1].Status.State).To(Equal(commonv1.ApprovalState_INVALID))
+	})
 })
@@ -0,0 +1,243 @@
+package apply
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/egressutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+)
+
+// Validate the EgressGatewayPolicies and set their statuses for the selected Destinations and Meshes.
+// Only the Meshes accepted on the status of a policy are routed through their egress gateways by the translator.
+// EgressGatewayPolicies are processed in order, and a policy which routes a hostname already routed by a previous policy,
+// or which uses a port of the egress gateway for a different protocol than a previous policy, is rejected for the Mesh of that egress gateway.
+func validateEgressGatewayPolicies(
+	egressGatewayPolicies networkingv1.EgressGatewayPolicySlice,
+	destinations discoveryv1sets.DestinationSet,
+	meshes discoveryv1sets.MeshSet,
+) {
+	routedHosts := &egressRoutedHosts{
+		policiesByHostname: map[string]map[string]string{},
+		protocolsByPort:    map[string]map[uint32]networkingv1.EgressGatewayPolicySpec_ExternalHost_Protocol{},
+	}
+
+	for _, egressGatewayPolicy := range egressGatewayPolicies {
+		// policies with invalid references have already been rejected
+		if egressGatewayPolicy.Status.State != commonv1.ApprovalState_ACCEPTED {
+			continue
+		}
+
+		var errs []error
+		if err := validateEgressGatewayPolicySpec(&egressGatewayPolicy.Spec); err != nil {
+			egressGatewayPolicy.Status.State = commonv1.ApprovalState_INVALID
+			egressGatewayPolicy.Status.Errors = []string{err.Error()}
+			continue
+		}
+
+		// the meshes through whose egress gateways the selected Destinations are routed
+		destinationMeshes := map[string]bool{}
+		for _, destination := range destinations.List() {
+			if !egressutils.SelectsDestination(egressGatewayPolicy, destination) {
+				continue
+			}
+			if err := validateEgressDestination(egressGatewayPolicy, destination, meshes); err != nil {
+				egressGatewayPolicy.Status.Destinations[sets.Key(destination)] = &networkingv1.ApprovalStatus{
+					State:  commonv1.ApprovalState_INVALID,
+					Errors: []string{err.Error()},
+				}
+				errs = append(errs, eris.Wrapf(err, "invalid Destination %v", sets.Key(destination)))
+				continue
+			}
+			egressGatewayPolicy.Status.Destinations[sets.Key(destination)] = &networkingv1.ApprovalStatus{
+				State: commonv1.ApprovalState_ACCEPTED,
+			}
+			destinationMeshes[sets.Key(destination.Spec.GetMesh())] = true
+		}
+
+		for _, meshRef := range egressGatewayPolicy.Spec.GetMeshes() {
+			mesh, err := meshes.Find(meshRef)
+			if err != nil {
+				// reported by the config target validator
+				continue
+			}
+			if mesh.Spec.GetIstio() == nil {
+				errs = append(errs, eris.Errorf("Mesh %v is not an Istio mesh", sets.Key(mesh)))
+			}
+		}
+
+		var routesExternalHosts bool
+		for _, mesh := range meshes.List() {
+			routesExternalHosts = routesExternalHosts || egressutils.RoutesExternalHostsThroughMesh(egressGatewayPolicy, mesh)
+			if !destinationMeshes[sets.Key(mesh)] && !egressutils.RoutesExternalHostsThroughMesh(egressGatewayPolicy, mesh) {
+				continue
+			}
+
+			if meshErrs := validateEgressHostsForMesh(egressGatewayPolicy, mesh, destinations, routedHosts); len(meshErrs) > 0 {
+				egressGatewayPolicy.Status.Meshes[sets.Key(mesh)] = &networkingv1.ApprovalStatus{
+					State:  commonv1.ApprovalState_INVALID,
+					Errors: getErrStrings(meshErrs),
+				}
+				for _, err := range meshErrs {
+					errs = append(errs, eris.Wrapf(err, "invalid Mesh %v", sets.Key(mesh)))
+				}
+				continue
+			}
+			egressGatewayPolicy.Status.Meshes[sets.Key(mesh)] = &networkingv1.ApprovalStatus{
+				State: commonv1.ApprovalState_ACCEPTED,
+			}
+		}
+
+		if len(egressGatewayPolicy.Spec.GetExternalHosts()) > 0 && !routesExternalHosts && len(egressGatewayPolicy.Spec.GetMeshes()) == 0 {
+			errs = append(errs, eris.New("no Istio mesh with a matching egress gateway found for the external hosts"))
+		}
+
+		if len(errs) > 0 {
+			egressGatewayPolicy.Status.State = commonv1.ApprovalState_INVALID
+			egressGatewayPolicy.Status.Errors = getErrStrings(errs)
+		}
+	}
+}
+
+func validateEgressGatewayPolicySpec(spec *networkingv1.EgressGatewayPolicySpec) error {
+	if len(spec.GetExternalHosts()) == 0 && len(spec.GetDestinationSelector()) == 0 {
+		return eris.New("must specify at least one external host or Destination selector")
+	}
+	for _, externalHost := range spec.GetExternalHosts() {
+		if externalHost.GetHostname() == "" {
+			return eris.New("external hosts must specify a hostname")
+		}
+		if strings.Contains(externalHost.GetHostname(), "*") {
+			return eris.Errorf("external host %s must not contain wildcards", externalHost.GetHostname())
+		}
+		if spec.GetTlsOrigination() != nil && externalHost.GetProtocol() != networkingv1.EgressGatewayPolicySpec_ExternalHost_HTTP {
+			return eris.Errorf("TLS origination requires external host %s to use the HTTP protocol", externalHost.GetHostname())
+		}
+	}
+	return nil
+}
+
+func validateEgressDestination(
+	egressGatewayPolicy *networkingv1.EgressGatewayPolicy,
+	destination *discoveryv1.Destination,
+	meshes discoveryv1sets.MeshSet,
+) error {
+	mesh, err := meshes.Find(destination.Spec.GetMesh())
+	if err != nil {
+		return eris.Errorf("Mesh %v not found", sets.Key(destination.Spec.GetMesh()))
+	}
+	if mesh.Spec.GetIstio() == nil {
+		return eris.Errorf("Mesh %v is not an Istio mesh", sets.Key(mesh))
+	}
+	// the VirtualService routing to the egress gateway would conflict with the VirtualService translated for the TrafficPolicies
+	if len(destination.Status.GetAppliedTrafficPolicies()) > 0 {
+		return eris.New("cannot route ExternalServices with applied TrafficPolicies through an egress gateway")
+	}
+	hosts, err := egressutils.ExternalServiceEgressHosts(destination)
+	if err != nil {
+		return err
+	}
+
+	tlsOrigination := egressGatewayPolicy.Spec.GetTlsOrigination()
+	if tlsOrigination == nil {
+		return nil
+	}
+	var hasHttpPort bool
+	for _, host := range hosts {
+		hasHttpPort = hasHttpPort || host.Protocol == networkingv1.EgressGatewayPolicySpec_ExternalHost_HTTP
+	}
+	if !hasHttpPort {
+		return eris.New("TLS origination requires the ExternalService to expose an HTTP port")
+	}
+	// the ServiceEntry of the ExternalService must declare the port to which the egress gateway originates TLS
+	originationPort := egressutils.TlsOriginationPort(tlsOrigination)
+	for _, port := range destination.Spec.GetExternalService().GetPorts() {
+		if port.GetNumber() == originationPort {
+			return nil
+		}
+	}
+	return eris.Errorf("ExternalService must expose the TLS origination port %d", originationPort)
+}
+
+// the hosts routed through egress gateways by the EgressGatewayPolicies accepted so far
+type egressRoutedHosts struct {
+	// the policy routing each hostname, by mesh
+	policiesByHostname map[string]map[string]string
+	// the protocol served on each port, by egress gateway
+	protocolsByPort map[string]map[uint32]networkingv1.EgressGatewayPolicySpec_ExternalHost_Protocol
+}
+
+// Validate that the egress gateway of the Mesh exposes the ports of the routed hosts, that the hosts routed on each port
+// use the same protocol as all other hosts routed on that port, and that no hostname is already routed by a previous policy.
+func validateEgressHostsForMesh(
+	egressGatewayPolicy *networkingv1.EgressGatewayPolicy,
+	mesh *discoveryv1.Mesh,
+	destinations discoveryv1sets.DestinationSet,
+	routedHosts *egressRoutedHosts,
+) []error {
+	egressGateway := egressutils.GetEgressGateway(mesh, egressGatewayPolicy.Spec.GetGatewayWorkloadLabels())
+	if egressGateway == nil {
+		return []error{eris.Errorf("no egress gateway found for Mesh %v", sets.Key(mesh))}
+	}
+
+	gatewayPorts := map[uint32]bool{}
+	for _, port := range egressGateway.GetPorts() {
+		gatewayPorts[port.GetPort()] = true
+	}
+
+	meshKey := sets.Key(mesh)
+	if routedHosts.policiesByHostname[meshKey] == nil {
+		routedHosts.policiesByHostname[meshKey] = map[string]string{}
+	}
+	gatewayKey := fmt.Sprintf("%s.%s.%s", egressGateway.GetName(), egressGateway.GetNamespace(), meshKey)
+	if routedHosts.protocolsByPort[gatewayKey] == nil {
+		routedHosts.protocolsByPort[gatewayKey] = map[uint32]networkingv1.EgressGatewayPolicySpec_ExternalHost_Protocol{}
+	}
+	policyKey := sets.Key(egressGatewayPolicy)
+
+	// each port of the egress gateway serves a single protocol, including the protocols of the hosts routed by previous policies
+	protocolsByPort := map[uint32]networkingv1.EgressGatewayPolicySpec_ExternalHost_Protocol{}
+	for port, protocol := range routedHosts.protocolsByPort[gatewayKey] {
+		protocolsByPort[port] = protocol
+	}
+
+	egressHosts := egressutils.GetEgressHosts(egressGatewayPolicy, mesh, destinations)
+
+	var errs []error
+	for _, host := range egressHosts {
+		if protocol, ok := protocolsByPort[host.Port]; ok && protocol != host.Protocol {
+			errs = append(errs, eris.Errorf("host %s uses protocol %s on port %d, which is already used for protocol %s by another host",
+				host.Hostname, host.Protocol, host.Port, protocol))
+		}
+		protocolsByPort[host.Port] = host.Protocol
+		if !gatewayPorts[host.Port] {
+			errs = append(errs, eris.Errorf("egress gateway %s.%s does not expose port %d for host %s",
+				egressGateway.GetName(), egressGateway.GetNamespace(), host.Port, host.Hostname))
+		} else if routingPolicy, ok := routedHosts.policiesByHostname[meshKey][host.Hostname]; ok && routingPolicy != policyKey {
+			// the egress gateway routes of a hostname are translated into a single VirtualService
+			errs = append(errs, eris.Errorf("host %s is already routed through the egress gateway by EgressGatewayPolicy %s", host.Hostname, routingPolicy))
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+
+	for _, host := range egressHosts {
+		routedHosts.policiesByHostname[meshKey][host.Hostname] = policyKey
+		routedHosts.protocolsByPort[gatewayKey][host.Port] = host.Protocol
+	}
+	return nil
+}
+
+func getErrStrings(errs []error) []string {
+	var errStrings []string
+	for _, err := range errs {
+		errStrings = append(errStrings, err.Error())
+	}
+	return errStrings
+}
@@ -0,0 +1,152 @@
+package apply
+
+import (
+	"sort"
+
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/trafficpolicyutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	utilsets "k8s.io/apimachinery/pkg/util/sets"
+)
+
+// the merge strategy of each field of a TrafficPolicy's policy, fields which are not listed are merged with the OVERRIDE strategy.
+// these must be kept in sync with the documentation of the TrafficPolicy priority.
+var policyFieldMergeStrategies = map[string]networkingv1.MergeStrategy{
+	"header_manipulation": networkingv1.MergeStrategy_APPEND,
+	"mtls":                networkingv1.MergeStrategy_REJECT,
+	"csrf":                networkingv1.MergeStrategy_REJECT,
+	"rate_limit":          networkingv1.MergeStrategy_REJECT,
+	"jwt":                 networkingv1.MergeStrategy_REJECT,
+}
+
+// the fields of a TrafficPolicy's policy which apply to all traffic to the Destination, regardless of the TrafficPolicies' request matchers
+var destinationWidePolicyFields = utilsets.NewString(
+	"outlier_detection",
+	"mtls",
+	"csrf",
+	"rate_limit",
+	"jwt",
+	"load_balancer",
+	"connection_pool",
+)
+
+// a field set by two TrafficPolicies which apply to the same traffic
+type overlappingPolicyField struct {
+	name     string
+	strategy networkingv1.MergeStrategy
+	// true if both TrafficPolicies set the field to the same value
+	equal bool
+}
+
+// TrafficPolicies which set a field with the REJECT strategy to a different value than an applied TrafficPolicy of lower priority are reported.
+// Conflicts between TrafficPolicies of equal priority are detected during translation.
+func reportRejectedPolicyFieldMerges(input input.LocalSnapshot, reporter *applyReporter) {
+	for _, destination := range input.Destinations().List() {
+		appliedPolicies := destination.Status.AppliedTrafficPolicies
+		for i, policy := range appliedPolicies {
+			for _, lowerPriorityPolicy := range lowerPriorityPolicies(appliedPolicies[:i], policy) {
+				for _, field := range getOverlappingPolicyFields(lowerPriorityPolicy.Spec, policy.Spec) {
+					if field.strategy != networkingv1.MergeStrategy_REJECT || field.equal {
+						continue
+					}
+					reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, eris.Errorf(
+						"field %s cannot be overridden, and is already set by TrafficPolicy %s of priority %d",
+						field.name,
+						sets.Key(lowerPriorityPolicy.Ref),
+						lowerPriorityPolicy.Spec.GetPriority(),
+					))
+				}
+			}
+		}
+	}
+}
+
+// record the fields of each applied TrafficPolicy which are merged over those of applied TrafficPolicies of lower priority
+func setMergedPolicyFields(destination *discoveryv1.Destination) {
+	appliedPolicies := destination.Status.AppliedTrafficPolicies
+	for i, policy := range appliedPolicies {
+		mergedFields := map[string]*discoveryv1.DestinationStatus_AppliedTrafficPolicy_MergedField{}
+		for _, lowerPriorityPolicy := range lowerPriorityPolicies(appliedPolicies[:i], policy) {
+			for _, field := range getOverlappingPolicyFields(lowerPriorityPolicy.Spec, policy.Spec) {
+				if field.strategy == networkingv1.MergeStrategy_REJECT {
+					continue
+				}
+				mergedField, ok := mergedFields[field.name]
+				if !ok {
+					mergedField = &discoveryv1.DestinationStatus_AppliedTrafficPolicy_MergedField{
+						Field:    field.name,
+						Strategy: field.strategy,
+					}
+					mergedFields[field.name] = mergedField
+				}
+				mergedField.Policies = append(mergedField.Policies, lowerPriorityPolicy.Ref)
+			}
+		}
+
+		policy.MergedFields = nil
+		for _, mergedField := range mergedFields {
+			policy.MergedFields = append(policy.MergedFields, mergedField)
+		}
+		sort.Slice(policy.MergedFields, func(i, j int) bool {
+			return policy.MergedFields[i].Field < policy.MergedFields[j].Field
+		})
+	}
+}
+
+// return the policies of lower priority than the given policy.
+// applied TrafficPolicies are sorted in order of increasing priority, so only the preceding policies need be considered.
+func lowerPriorityPolicies(
+	precedingPolicies []*discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+	policy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+) []*discoveryv1.DestinationStatus_AppliedTrafficPolicy {
+	var lowerPriority []*discoveryv1.DestinationStatus_AppliedTrafficPolicy
+	for _, precedingPolicy := range precedingPolicies {
+		if precedingPolicy.Spec.GetPriority() < policy.Spec.GetPriority() {
+			lowerPriority = append(lowerPriority, precedingPolicy)
+		}
+	}
+	return lowerPriority
+}
+
+// return the fields of the policies which are set by both TrafficPolicies for the same traffic
+func getOverlappingPolicyFields(policySpec1, policySpec2 *networkingv1.TrafficPolicySpec) []overlappingPolicyField {
+	sameTraffic := trafficpolicyutils.RequestMatchersEqual(policySpec1, policySpec2)
+	policy1 := policySpec1.GetPolicy().ProtoReflect()
+
+	var fields []overlappingPolicyField
+	policySpec2.GetPolicy().ProtoReflect().Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		name := string(field.Name())
+		if !policy1.Has(field) || (!sameTraffic && !destinationWidePolicyFields.Has(name)) {
+			return true
+		}
+		fields = append(fields, overlappingPolicyField{
+			name:     name,
+			strategy: policyFieldMergeStrategies[name],
+			equal:    policyFieldsEqual(policy1, field, value),
+		})
+		return true
+	})
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].name < fields[j].name
+	})
+	return fields
+}
+
+// return true if the field of the policy is equal to the given value of the same field.
+// only singular message fields can be compared directly, other fields are compared within a policy which sets only that field.
+func policyFieldsEqual(policy protoreflect.Message, field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+	if field.Message() != nil && !field.IsList() && !field.IsMap() {
+		return proto.Equal(policy.Get(field).Message().Interface(), value.Message().Interface())
+	}
+	policyField := policy.Type().New()
+	policyField.Set(field, policy.Get(field))
+	valueField := policy.Type().New()
+	valueField.Set(field, value)
+	return proto.Equal(policyField.Interface(), valueField.Interface())
+}
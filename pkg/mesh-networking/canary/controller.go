@@ -0,0 +1,278 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/workloadutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/canaryutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/selectorutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/settingsutils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+//go:generate mockgen -source ./controller.go -destination mocks/controller.go
+
+const (
+	defaultAnalysisInterval = time.Minute
+
+	// the maximum duration of the queries for the metrics of the canary workloads during an analysis
+	analysisTimeout = 10 * time.Second
+)
+
+// The Controller progresses the canaries of TrafficPolicies through their steps,
+// recording the progress of each canary on the status of its TrafficPolicy.
+// The weight recorded on the status is applied to the Destinations selected by the TrafficPolicy by the Applier.
+type Controller interface {
+	// Progress the canaries of the TrafficPolicies in the input snapshot.
+	// Returns the duration after which the canaries must be progressed again, or 0 if no canary is progressing.
+	Progress(ctx context.Context, in input.LocalSnapshot) time.Duration
+}
+
+type controller struct {
+	newMetricsClient MetricsClientFactory
+
+	// the client for the Prometheus address currently configured in the Settings
+	prometheusAddress string
+	metricsClient     MetricsClient
+}
+
+func NewController(newMetricsClient MetricsClientFactory) Controller {
+	return &controller{newMetricsClient: newMetricsClient}
+}
+
+func (c *controller) Progress(ctx context.Context, in input.LocalSnapshot) time.Duration {
+	ctx = contextutils.WithLogger(ctx, "canary")
+	now := time.Now()
+
+	var requeueAfter time.Duration
+	for _, trafficPolicy := range in.TrafficPolicies().List() {
+		canary := trafficPolicy.Spec.GetPolicy().GetCanary()
+		if canary == nil {
+			trafficPolicy.Status.Canary = nil
+			continue
+		}
+		// canaries are validated by the Applier
+		if len(canary.GetSteps()) == 0 {
+			continue
+		}
+
+		progressAfter := c.progressCanary(ctx, in, trafficPolicy, now)
+		if progressAfter > 0 && (requeueAfter == 0 || progressAfter < requeueAfter) {
+			requeueAfter = progressAfter
+		}
+	}
+	return requeueAfter
+}
+
+// progress the canary of the TrafficPolicy, returning the duration after which it must be progressed again
+func (c *controller) progressCanary(
+	ctx context.Context,
+	in input.LocalSnapshot,
+	trafficPolicy *networkingv1.TrafficPolicy,
+	now time.Time,
+) time.Duration {
+	canary := trafficPolicy.Spec.GetPolicy().GetCanary()
+	status := trafficPolicy.Status.Canary
+
+	// the canary restarts whenever the TrafficPolicy is modified
+	if status == nil || status.GetObservedGeneration() != trafficPolicy.Generation {
+		status = &networkingv1.TrafficPolicyStatus_CanaryStatus{
+			ObservedGeneration: trafficPolicy.Generation,
+			Phase:              networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING,
+			Weight:             canaryutils.CurrentWeight(trafficPolicy),
+			Message:            "canary started",
+		}
+		setStepStartTime(status, now)
+		trafficPolicy.Status.Canary = status
+	}
+
+	switch status.GetPhase() {
+	case networkingv1.TrafficPolicyStatus_CanaryStatus_ROLLED_BACK,
+		networkingv1.TrafficPolicyStatus_CanaryStatus_PROMOTED:
+		return 0
+	}
+
+	// the duration after which the canary must be analyzed again, or 0 if the canary has no analysis
+	var analyzeAfter time.Duration
+	if analysis := canary.GetAnalysis(); analysis != nil {
+		analysisInterval := getAnalysisInterval(analysis)
+		lastAnalysisTime, err := ptypes.Timestamp(status.GetLastAnalysisTime())
+		if sinceLastAnalysis := now.Sub(lastAnalysisTime); err == nil && sinceLastAnalysis >= 0 && sinceLastAnalysis < analysisInterval {
+			// the metrics are analyzed once per interval rather than on every reconcile, as reading them may take up to the analysis timeout
+			analyzeAfter = analysisInterval - sinceLastAnalysis
+			if status.GetPhase() == networkingv1.TrafficPolicyStatus_CanaryStatus_PAUSED {
+				return analyzeAfter
+			}
+		} else {
+			status.LastAnalysisTime, _ = ptypes.TimestampProto(now)
+			analyzeAfter = analysisInterval
+			if reason, thresholdExceeded := c.analyze(ctx, in, trafficPolicy, analysisInterval); reason != "" {
+				if thresholdExceeded && analysis.GetFailureAction() == networkingv1.TrafficPolicySpec_Policy_Canary_Analysis_ROLLBACK {
+					status.Phase = networkingv1.TrafficPolicyStatus_CanaryStatus_ROLLED_BACK
+					status.Weight = 0
+					status.Message = reason
+					return 0
+				}
+				status.Phase = networkingv1.TrafficPolicyStatus_CanaryStatus_PAUSED
+				status.Message = reason
+				return analyzeAfter
+			}
+			if status.GetPhase() == networkingv1.TrafficPolicyStatus_CanaryStatus_PAUSED {
+				// the metrics are back within the thresholds, hold the current weight for the full duration of the step
+				status.Phase = networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING
+				status.Message = "canary resumed"
+				setStepStartTime(status, now)
+			}
+		}
+	}
+
+	steps := canary.GetSteps()
+	stepDuration, _ := ptypes.Duration(steps[status.GetCurrentStep()].GetDuration())
+	stepStartTime, _ := ptypes.Timestamp(status.GetStepStartTime())
+	remaining := stepStartTime.Add(stepDuration).Sub(now)
+	if remaining > 0 {
+		return minDuration(remaining, analyzeAfter)
+	}
+
+	if int(status.GetCurrentStep()) == len(steps)-1 {
+		status.Phase = networkingv1.TrafficPolicyStatus_CanaryStatus_PROMOTED
+		status.Weight = 100
+		status.Message = "canary promoted"
+		return 0
+	}
+
+	status.CurrentStep++
+	status.Weight = steps[status.GetCurrentStep()].GetWeight()
+	status.Message = fmt.Sprintf("canary progressed to step %d", status.GetCurrentStep())
+	setStepStartTime(status, now)
+	nextStepDuration, _ := ptypes.Duration(steps[status.GetCurrentStep()].GetDuration())
+	return minDuration(nextStepDuration, analyzeAfter)
+}
+
+// analyze the metrics of the canary workloads of each selected Destination,
+// returning the reason for which the canary cannot progress, or an empty string if it can.
+// thresholdExceeded is false if the canary cannot be analyzed yet, e.g. because not enough requests were received.
+func (c *controller) analyze(
+	ctx context.Context,
+	in input.LocalSnapshot,
+	trafficPolicy *networkingv1.TrafficPolicy,
+	window time.Duration,
+) (reason string, thresholdExceeded bool) {
+	analysis := trafficPolicy.Spec.GetPolicy().GetCanary().GetAnalysis()
+
+	metricsClient, err := c.getMetricsClient(ctx)
+	if err != nil {
+		return fmt.Sprintf("cannot read metrics: %v", err), false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, analysisTimeout)
+	defer cancel()
+
+	for _, destination := range in.Destinations().List() {
+		if destination.Spec.GetKubeService() == nil ||
+			!selectorutils.SelectorMatchesDestination(trafficPolicy.Spec.GetDestinationSelector(), destination) {
+			continue
+		}
+		canaryWorkloads := getCanaryWorkloads(trafficPolicy.Spec.GetPolicy().GetCanary(), destination, in.Workloads())
+		if len(canaryWorkloads) == 0 {
+			return fmt.Sprintf("no canary workloads found for Destination %v", sets.Key(destination)), false
+		}
+
+		metrics, err := metricsClient.GetRequestMetrics(ctx, canaryWorkloads, window)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Warnf("failed to read metrics for canary of TrafficPolicy %v: %v", sets.Key(trafficPolicy), err)
+			if ctx.Err() == context.DeadlineExceeded {
+				return fmt.Sprintf("timed out reading metrics after %v", analysisTimeout), false
+			}
+			return fmt.Sprintf("cannot read metrics: %v", err), false
+		}
+		if metrics.RequestCount == 0 {
+			// the error rate and latency of a canary without any requests are meaningless,
+			// e.g. if the metrics of the Destination's cluster are not collected
+			return fmt.Sprintf("waiting for requests to Destination %v: no requests received", sets.Key(destination)), false
+		}
+		if metrics.RequestCount < float64(analysis.GetMinRequestCount()) {
+			return fmt.Sprintf("waiting for requests to Destination %v: received %.0f of %d",
+				sets.Key(destination), metrics.RequestCount, analysis.GetMinRequestCount()), false
+		}
+		if maxErrorRate := analysis.GetMaxErrorRate(); maxErrorRate > 0 && metrics.ErrorRate > maxErrorRate {
+			return fmt.Sprintf("error rate %.2f%% of Destination %v exceeds the maximum of %.2f%%",
+				metrics.ErrorRate, sets.Key(destination), maxErrorRate), true
+		}
+		if analysis.GetMaxLatency() != nil {
+			maxLatency, _ := ptypes.Duration(analysis.GetMaxLatency())
+			if metrics.P99Latency > maxLatency {
+				return fmt.Sprintf("p99 latency %v of Destination %v exceeds the maximum of %v",
+					metrics.P99Latency, sets.Key(destination), maxLatency), true
+			}
+		}
+	}
+	return "", false
+}
+
+// return the client for the Prometheus server configured in the Settings, reusing the client if the address is unchanged
+func (c *controller) getMetricsClient(ctx context.Context) (MetricsClient, error) {
+	prometheusAddress := settingsutils.SettingsFromContext(ctx).Spec.GetMetrics().GetPrometheusAddress()
+	if prometheusAddress == "" {
+		return nil, eris.New("no Prometheus address configured in the Settings")
+	}
+	if c.metricsClient == nil || c.prometheusAddress != prometheusAddress {
+		metricsClient, err := c.newMetricsClient(prometheusAddress)
+		if err != nil {
+			return nil, err
+		}
+		c.metricsClient = metricsClient
+		c.prometheusAddress = prometheusAddress
+	}
+	return c.metricsClient, nil
+}
+
+// return the workloads backing the Destination which belong to its canary subset
+func getCanaryWorkloads(
+	canary *networkingv1.TrafficPolicySpec_Policy_Canary,
+	destination *discoveryv1.Destination,
+	workloads discoveryv1sets.WorkloadSet,
+) discoveryv1.WorkloadSlice {
+	canarySelector := labels.SelectorFromSet(canary.GetCanarySubset())
+	var canaryWorkloads discoveryv1.WorkloadSlice
+	for _, workload := range workloadutils.FindBackingWorkloads(destination.Spec.GetKubeService(), workloads) {
+		if canarySelector.Matches(labels.Set(workload.Spec.GetKubernetes().GetPodLabels())) {
+			canaryWorkloads = append(canaryWorkloads, workload)
+		}
+	}
+	return canaryWorkloads
+}
+
+func getAnalysisInterval(analysis *networkingv1.TrafficPolicySpec_Policy_Canary_Analysis) time.Duration {
+	if analysis.GetInterval() == nil {
+		return defaultAnalysisInterval
+	}
+	interval, err := ptypes.Duration(analysis.GetInterval())
+	if err != nil {
+		return defaultAnalysisInterval
+	}
+	return interval
+}
+
+// return the smaller of the durations, ignoring durations of 0
+func minDuration(d1, d2 time.Duration) time.Duration {
+	if d2 == 0 || (d1 != 0 && d1 < d2) {
+		return d1
+	}
+	return d2
+}
+
+func setStepStartTime(status *networkingv1.TrafficPolicyStatus_CanaryStatus, now time.Time) {
+	// the time is valid, so conversion cannot fail
+	status.StepStartTime, _ = ptypes.TimestampProto(now)
+}
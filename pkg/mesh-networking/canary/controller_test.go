@@ -0,0 +1,347 @@
+package canary_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/canary"
+	mock_canary "github.com/solo-io/gloo-mesh/pkg/mesh-networking/canary/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/settingsutils"
+	v1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Controller", func() {
+	var (
+		ctrl              *gomock.Controller
+		ctx               context.Context
+		mockMetricsClient *mock_canary.MockMetricsClient
+		controller        canary.Controller
+
+		destination    *discoveryv1.Destination
+		stableWorkload *discoveryv1.Workload
+		canaryWorkload *discoveryv1.Workload
+		trafficPolicy  *networkingv1.TrafficPolicy
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockMetricsClient = mock_canary.NewMockMetricsClient(ctrl)
+		controller = canary.NewController(func(prometheusAddress string) (canary.MetricsClient, error) {
+			Expect(prometheusAddress).To(Equal("http://prometheus-server.gloo-mesh"))
+			return mockMetricsClient, nil
+		})
+		ctx = settingsutils.ContextWithSettings(context.TODO(), &settingsv1.Settings{
+			Spec: settingsv1.SettingsSpec{
+				Metrics: &settingsv1.MetricsSettings{PrometheusAddress: "http://prometheus-server.gloo-mesh"},
+			},
+		})
+
+		destination = &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "gloo-mesh"},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "reviews",
+							Namespace:   "bookinfo",
+							ClusterName: "cluster-1",
+						},
+						WorkloadSelectorLabels: map[string]string{"app": "reviews"},
+					},
+				},
+			},
+		}
+		makeWorkload := func(version string) *discoveryv1.Workload {
+			return &discoveryv1.Workload{
+				ObjectMeta: metav1.ObjectMeta{Name: "reviews-" + version, Namespace: "gloo-mesh"},
+				Spec: discoveryv1.WorkloadSpec{
+					Type: &discoveryv1.WorkloadSpec_Kubernetes{
+						Kubernetes: &discoveryv1.WorkloadSpec_KubernetesWorkload{
+							Controller: &v1.ClusterObjectRef{
+								Name:        "reviews-" + version,
+								Namespace:   "bookinfo",
+								ClusterName: "cluster-1",
+							},
+							PodLabels: map[string]string{"app": "reviews", "version": version},
+						},
+					},
+				},
+			}
+		}
+		stableWorkload = makeWorkload("v1")
+		canaryWorkload = makeWorkload("v2")
+
+		trafficPolicy = &networkingv1.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "canary", Namespace: "gloo-mesh", Generation: 2},
+			Spec: networkingv1.TrafficPolicySpec{
+				Policy: &networkingv1.TrafficPolicySpec_Policy{
+					Canary: &networkingv1.TrafficPolicySpec_Policy_Canary{
+						CanarySubset: map[string]string{"version": "v2"},
+						Steps: []*networkingv1.TrafficPolicySpec_Policy_Canary_Step{
+							{Weight: 10, Duration: &duration.Duration{Seconds: 60}},
+							{Weight: 50, Duration: &duration.Duration{Seconds: 300}},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	buildSnapshot := func() input.LocalSnapshot {
+		return input.NewInputLocalSnapshotManualBuilder("").
+			AddTrafficPolicies(networkingv1.TrafficPolicySlice{trafficPolicy}).
+			AddDestinations(discoveryv1.DestinationSlice{destination}).
+			AddWorkloads(discoveryv1.WorkloadSlice{stableWorkload, canaryWorkload}).
+			Build()
+	}
+
+	// set the status of the canary as if the current step started the given duration ago
+	setCanaryStatus := func(step, weight uint32, phase networkingv1.TrafficPolicyStatus_CanaryStatus_Phase, elapsed time.Duration) {
+		stepStartTime, err := ptypes.TimestampProto(time.Now().Add(-elapsed))
+		Expect(err).NotTo(HaveOccurred())
+		trafficPolicy.Status.Canary = &networkingv1.TrafficPolicyStatus_CanaryStatus{
+			ObservedGeneration: trafficPolicy.Generation,
+			Phase:              phase,
+			CurrentStep:        step,
+			Weight:             weight,
+			StepStartTime:      stepStartTime,
+		}
+	}
+
+	// set the time of the last analysis of the canary as if it occurred the given duration ago
+	setLastAnalysisTime := func(elapsed time.Duration) {
+		lastAnalysisTime, err := ptypes.TimestampProto(time.Now().Add(-elapsed))
+		Expect(err).NotTo(HaveOccurred())
+		trafficPolicy.Status.Canary.LastAnalysisTime = lastAnalysisTime
+	}
+
+	It("should start the canary at its first step", func() {
+		progressAfter := controller.Progress(ctx, buildSnapshot())
+
+		Expect(progressAfter).To(BeNumerically("~", time.Minute, time.Second))
+		Expect(trafficPolicy.Status.Canary.GetObservedGeneration()).To(Equal(int64(2)))
+		Expect(trafficPolicy.Status.Canary.GetPhase()).To(Equal(networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING))
+		Expect(trafficPolicy.Status.Canary.GetCurrentStep()).To(Equal(uint32(0)))
+		Expect(trafficPolicy.Status.Canary.GetWeight()).To(Equal(uint32(10)))
+		Expect(trafficPolicy.Status.Canary.GetStepStartTime()).NotTo(BeNil())
+	})
+
+	It("should restart the canary when the TrafficPolicy is modified", func() {
+		setCanaryStatus(1, 50, networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING, time.Minute)
+		trafficPolicy.Generation = 3
+
+		controller.Progress(ctx, buildSnapshot())
+
+		Expect(trafficPolicy.Status.Canary.GetObservedGeneration()).To(Equal(int64(3)))
+		Expect(trafficPolicy.Status.Canary.GetCurrentStep()).To(Equal(uint32(0)))
+		Expect(trafficPolicy.Status.Canary.GetWeight()).To(Equal(uint32(10)))
+	})
+
+	It("should hold the weight until the duration of the step elapses", func() {
+		setCanaryStatus(0, 10, networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING, 20*time.Second)
+
+		progressAfter := controller.Progress(ctx, buildSnapshot())
+
+		Expect(progressAfter).To(BeNumerically("~", 40*time.Second, time.Second))
+		Expect(trafficPolicy.Status.Canary.GetCurrentStep()).To(Equal(uint32(0)))
+		Expect(trafficPolicy.Status.Canary.GetWeight()).To(Equal(uint32(10)))
+	})
+
+	It("should progress to the next step and promote the canary after the last step", func() {
+		setCanaryStatus(0, 10, networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING, time.Minute)
+
+		progressAfter := controller.Progress(ctx, buildSnapshot())
+
+		Expect(progressAfter).To(Equal(5 * time.Minute))
+		Expect(trafficPolicy.Status.Canary.GetCurrentStep()).To(Equal(uint32(1)))
+		Expect(trafficPolicy.Status.Canary.GetWeight()).To(Equal(uint32(50)))
+
+		setCanaryStatus(1, 50, networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING, 5*time.Minute)
+
+		progressAfter = controller.Progress(ctx, buildSnapshot())
+
+		Expect(progressAfter).To(BeZero())
+		Expect(trafficPolicy.Status.Canary.GetPhase()).To(Equal(networkingv1.TrafficPolicyStatus_CanaryStatus_PROMOTED))
+		Expect(trafficPolicy.Status.Canary.GetWeight()).To(Equal(uint32(100)))
+	})
+
+	It("should clear the canary status of TrafficPolicies without a canary", func() {
+		setCanaryStatus(0, 10, networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING, time.Minute)
+		trafficPolicy.Spec.Policy.Canary = nil
+
+		Expect(controller.Progress(ctx, buildSnapshot())).To(BeZero())
+		Expect(trafficPolicy.Status.Canary).To(BeNil())
+	})
+
+	Context("analysis", func() {
+		BeforeEach(func() {
+			trafficPolicy.Spec.Policy.Canary.Analysis = &networkingv1.TrafficPolicySpec_Policy_Canary_Analysis{
+				MaxErrorRate:    5,
+				MaxLatency:      &duration.Duration{Seconds: 1},
+				Interval:        &duration.Duration{Seconds: 30},
+				MinRequestCount: 10,
+			}
+		})
+
+		It("should progress the canary while the metrics of the canary workloads are within the thresholds", func() {
+			setCanaryStatus(0, 10, networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING, time.Minute)
+
+			mockMetricsClient.
+				EXPECT().
+				GetRequestMetrics(gomock.Any(), discoveryv1.WorkloadSlice{canaryWorkload}, 30*time.Second).
+				Return(&canary.RequestMetrics{RequestCount: 100, ErrorRate: 1, P99Latency: 100 * time.Millisecond}, nil)
+
+			progressAfter := controller.Progress(ctx, buildSnapshot())
+
+			// canaries with an analysis are progressed at every interval
+			Expect(progressAfter).To(Equal(30 * time.Second))
+			Expect(trafficPolicy.Status.Canary.GetCurrentStep()).To(Equal(uint32(1)))
+			Expect(trafficPolicy.Status.Canary.GetWeight()).To(Equal(uint32(50)))
+		})
+
+		It("should pause the canary when the error rate is exceeded, and resume it once the metrics recover", func() {
+			setCanaryStatus(0, 10, networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING, time.Minute)
+
+			mockMetricsClient.
+				EXPECT().
+				GetRequestMetrics(gomock.Any(), discoveryv1.WorkloadSlice{canaryWorkload}, 30*time.Second).
+				Return(&canary.RequestMetrics{RequestCount: 100, ErrorRate: 10}, nil)
+
+			progressAfter := controller.Progress(ctx, buildSnapshot())
+
+			Expect(progressAfter).To(Equal(30 * time.Second))
+			Expect(trafficPolicy.Status.Canary.GetPhase()).To(Equal(networkingv1.TrafficPolicyStatus_CanaryStatus_PAUSED))
+			Expect(trafficPolicy.Status.Canary.GetWeight()).To(Equal(uint32(10)))
+			Expect(trafficPolicy.Status.Canary.GetMessage()).To(ContainSubstring("error rate 10.00% of Destination reviews.gloo-mesh. exceeds the maximum of 5.00%"))
+
+			// the canary is analyzed again once the interval elapses
+			setLastAnalysisTime(30 * time.Second)
+			mockMetricsClient.
+				EXPECT().
+				GetRequestMetrics(gomock.Any(), discoveryv1.WorkloadSlice{canaryWorkload}, 30*time.Second).
+				Return(&canary.RequestMetrics{RequestCount: 100, ErrorRate: 1}, nil)
+
+			controller.Progress(ctx, buildSnapshot())
+
+			// the weight is held for the full duration of the step after resuming
+			Expect(trafficPolicy.Status.Canary.GetPhase()).To(Equal(networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING))
+			Expect(trafficPolicy.Status.Canary.GetCurrentStep()).To(Equal(uint32(0)))
+			Expect(trafficPolicy.Status.Canary.GetWeight()).To(Equal(uint32(10)))
+		})
+
+		It("should roll back the canary when the latency is exceeded", func() {
+			trafficPolicy.Spec.Policy.Canary.Analysis.FailureAction = networkingv1.TrafficPolicySpec_Policy_Canary_Analysis_ROLLBACK
+			setCanaryStatus(1, 50, networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING, time.Minute)
+
+			mockMetricsClient.
+				EXPECT().
+				GetRequestMetrics(gomock.Any(), discoveryv1.WorkloadSlice{canaryWorkload}, 30*time.Second).
+				Return(&canary.RequestMetrics{RequestCount: 100, P99Latency: 2 * time.Second}, nil)
+
+			progressAfter := controller.Progress(ctx, buildSnapshot())
+
+			Expect(progressAfter).To(BeZero())
+			Expect(trafficPolicy.Status.Canary.GetPhase()).To(Equal(networkingv1.TrafficPolicyStatus_CanaryStatus_ROLLED_BACK))
+			Expect(trafficPolicy.Status.Canary.GetWeight()).To(BeZero())
+			Expect(trafficPolicy.Status.Canary.GetMessage()).To(ContainSubstring("p99 latency 2s of Destination reviews.gloo-mesh. exceeds the maximum of 1s"))
+
+			// rolled back canaries do not progress until the TrafficPolicy is modified
+			Expect(controller.Progress(ctx, buildSnapshot())).To(BeZero())
+			Expect(trafficPolicy.Status.Canary.GetPhase()).To(Equal(networkingv1.TrafficPolicyStatus_CanaryStatus_ROLLED_BACK))
+		})
+
+		It("should pause rather than roll back the canary until enough requests are received", func() {
+			trafficPolicy.Spec.Policy.Canary.Analysis.FailureAction = networkingv1.TrafficPolicySpec_Policy_Canary_Analysis_ROLLBACK
+			setCanaryStatus(0, 10, networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING, time.Minute)
+
+			mockMetricsClient.
+				EXPECT().
+				GetRequestMetrics(gomock.Any(), discoveryv1.WorkloadSlice{canaryWorkload}, 30*time.Second).
+				Return(&canary.RequestMetrics{RequestCount: 3, ErrorRate: 100}, nil)
+
+			controller.Progress(ctx, buildSnapshot())
+
+			Expect(trafficPolicy.Status.Canary.GetPhase()).To(Equal(networkingv1.TrafficPolicyStatus_CanaryStatus_PAUSED))
+			Expect(trafficPolicy.Status.Canary.GetWeight()).To(Equal(uint32(10)))
+			Expect(trafficPolicy.Status.Canary.GetMessage()).To(ContainSubstring("received 3 of 10"))
+		})
+
+		It("should pause rather than roll back the canary if the metrics cannot be read before the analysis times out", func() {
+			trafficPolicy.Spec.Policy.Canary.Analysis.FailureAction = networkingv1.TrafficPolicySpec_Policy_Canary_Analysis_ROLLBACK
+			setCanaryStatus(0, 10, networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING, time.Minute)
+
+			mockMetricsClient.
+				EXPECT().
+				GetRequestMetrics(gomock.Any(), discoveryv1.WorkloadSlice{canaryWorkload}, 30*time.Second).
+				DoAndReturn(func(ctx context.Context, _ discoveryv1.WorkloadSlice, _ time.Duration) (*canary.RequestMetrics, error) {
+					// the queries are bounded by the analysis timeout
+					deadline, hasDeadline := ctx.Deadline()
+					Expect(hasDeadline).To(BeTrue())
+					Expect(time.Until(deadline)).To(BeNumerically("<=", 10*time.Second))
+					<-ctx.Done()
+					return nil, ctx.Err()
+				})
+
+			// expire the analysis sooner than the analysis timeout to keep the test fast
+			timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+			progressAfter := controller.Progress(timeoutCtx, buildSnapshot())
+
+			Expect(progressAfter).To(Equal(30 * time.Second))
+			Expect(trafficPolicy.Status.Canary.GetPhase()).To(Equal(networkingv1.TrafficPolicyStatus_CanaryStatus_PAUSED))
+			Expect(trafficPolicy.Status.Canary.GetWeight()).To(Equal(uint32(10)))
+			Expect(trafficPolicy.Status.Canary.GetMessage()).To(ContainSubstring("timed out reading metrics"))
+		})
+
+		It("should not analyze the canary again until the interval elapses", func() {
+			setCanaryStatus(0, 10, networkingv1.TrafficPolicyStatus_CanaryStatus_PAUSED, time.Minute)
+			setLastAnalysisTime(20 * time.Second)
+
+			// the metrics client is not called
+			progressAfter := controller.Progress(ctx, buildSnapshot())
+
+			Expect(progressAfter).To(BeNumerically("~", 10*time.Second, time.Second))
+			Expect(trafficPolicy.Status.Canary.GetPhase()).To(Equal(networkingv1.TrafficPolicyStatus_CanaryStatus_PAUSED))
+		})
+
+		It("should pause the canary if no requests were received, regardless of the minimum request count", func() {
+			trafficPolicy.Spec.Policy.Canary.Analysis.MinRequestCount = 0
+			setCanaryStatus(0, 10, networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING, time.Minute)
+
+			mockMetricsClient.
+				EXPECT().
+				GetRequestMetrics(gomock.Any(), discoveryv1.WorkloadSlice{canaryWorkload}, 30*time.Second).
+				Return(&canary.RequestMetrics{}, nil)
+
+			controller.Progress(ctx, buildSnapshot())
+
+			Expect(trafficPolicy.Status.Canary.GetPhase()).To(Equal(networkingv1.TrafficPolicyStatus_CanaryStatus_PAUSED))
+			Expect(trafficPolicy.Status.Canary.GetCurrentStep()).To(BeZero())
+			Expect(trafficPolicy.Status.Canary.GetMessage()).To(ContainSubstring("no requests received"))
+		})
+
+		It("should pause the canary if no Prometheus server is configured", func() {
+			ctx = settingsutils.ContextWithSettings(context.TODO(), &settingsv1.Settings{})
+			setCanaryStatus(0, 10, networkingv1.TrafficPolicyStatus_CanaryStatus_PROGRESSING, time.Minute)
+
+			controller.Progress(ctx, buildSnapshot())
+
+			Expect(trafficPolicy.Status.Canary.GetPhase()).To(Equal(networkingv1.TrafficPolicyStatus_CanaryStatus_PAUSED))
+			Expect(trafficPolicy.Status.Canary.GetMessage()).To(ContainSubstring("no Prometheus address configured in the Settings"))
+		})
+	})
+})
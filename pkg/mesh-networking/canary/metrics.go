@@ -0,0 +1,123 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/api"
+	prometheusv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+)
+
+//go:generate mockgen -source ./metrics.go -destination mocks/metrics.go
+
+// The request metrics of a set of workloads, aggregated over a window.
+type RequestMetrics struct {
+	// The number of requests received by the workloads.
+	RequestCount float64
+
+	// The percentage of requests which failed with a 5xx response code.
+	ErrorRate float64
+
+	// The 99th percentile latency of the requests.
+	P99Latency time.Duration
+}
+
+// A MetricsClient reads the request metrics of workloads from the metrics collected from the managed clusters.
+type MetricsClient interface {
+	// Return the metrics of the requests received by the given workloads over the window.
+	// The workloads must belong to the same cluster and namespace.
+	GetRequestMetrics(ctx context.Context, workloads discoveryv1.WorkloadSlice, window time.Duration) (*RequestMetrics, error)
+}
+
+// Constructs a MetricsClient for the Prometheus server at the given address.
+type MetricsClientFactory func(prometheusAddress string) (MetricsClient, error)
+
+type prometheusMetricsClient struct {
+	api prometheusv1.API
+}
+
+// NewPrometheusMetricsClient returns a MetricsClient which queries the standard Istio request metrics from a Prometheus server.
+func NewPrometheusMetricsClient(prometheusAddress string) (MetricsClient, error) {
+	client, err := api.NewClient(api.Config{Address: prometheusAddress})
+	if err != nil {
+		return nil, eris.Wrapf(err, "creating Prometheus client for %s", prometheusAddress)
+	}
+	return &prometheusMetricsClient{api: prometheusv1.NewAPI(client)}, nil
+}
+
+func (c *prometheusMetricsClient) GetRequestMetrics(
+	ctx context.Context,
+	workloads discoveryv1.WorkloadSlice,
+	window time.Duration,
+) (*RequestMetrics, error) {
+	selector := workloadsSelector(workloads)
+	promWindow := model.Duration(window).String()
+
+	requestCount, err := c.queryScalar(ctx, fmt.Sprintf(
+		`sum(increase(istio_requests_total{%s}[%s]))`, selector, promWindow))
+	if err != nil {
+		return nil, err
+	}
+	errorCount, err := c.queryScalar(ctx, fmt.Sprintf(
+		`sum(increase(istio_requests_total{%s,response_code=~"5.."}[%s]))`, selector, promWindow))
+	if err != nil {
+		return nil, err
+	}
+	p99LatencyMillis, err := c.queryScalar(ctx, fmt.Sprintf(
+		`histogram_quantile(0.99, sum(rate(istio_request_duration_milliseconds_bucket{%s}[%s])) by (le))`, selector, promWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := &RequestMetrics{
+		RequestCount: requestCount,
+		P99Latency:   time.Duration(p99LatencyMillis * float64(time.Millisecond)),
+	}
+	if requestCount > 0 {
+		metrics.ErrorRate = 100 * errorCount / requestCount
+	}
+	return metrics, nil
+}
+
+// return the value of a query which aggregates into a single sample, or 0 if there are no samples
+func (c *prometheusMetricsClient) queryScalar(ctx context.Context, query string) (float64, error) {
+	result, _, err := c.api.Query(ctx, query, time.Now())
+	if err != nil {
+		return 0, eris.Wrapf(err, "querying Prometheus: %s", query)
+	}
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return 0, eris.Errorf("unexpected result type %v for Prometheus query: %s", result.Type(), query)
+	}
+	if len(vector) == 0 {
+		return 0, nil
+	}
+	value := float64(vector[0].Value)
+	if math.IsNaN(value) {
+		// e.g. the quantile of a histogram without any observations
+		return 0, nil
+	}
+	return value, nil
+}
+
+// select the metrics reported by the proxies of the given workloads for the requests they receive
+func workloadsSelector(workloads discoveryv1.WorkloadSlice) string {
+	var names []string
+	for _, workload := range workloads {
+		names = append(names, regexp.QuoteMeta(workload.Spec.GetKubernetes().GetController().GetName()))
+	}
+	controller := workloads[0].Spec.GetKubernetes().GetController()
+	return fmt.Sprintf(
+		`reporter="destination",destination_cluster=%q,destination_workload_namespace=%q,destination_workload=~%q`,
+		controller.GetClusterName(),
+		controller.GetNamespace(),
+		strings.Join(names, "|"),
+	)
+}
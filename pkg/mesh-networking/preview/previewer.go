@@ -0,0 +1,277 @@
+package preview
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/apply"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/skv2/pkg/controllerutils"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ChangeType describes how a translated output resource is affected by a proposal.
+type ChangeType string
+
+const (
+	Added   ChangeType = "added"
+	Changed ChangeType = "changed"
+	Removed ChangeType = "removed"
+)
+
+// Proposal contains the networking configuration a user would like to preview.
+// Proposed resources replace any existing resources with the same name and namespace.
+type Proposal struct {
+	TrafficPolicies []*networkingv1.TrafficPolicy
+	AccessPolicies  []*networkingv1.AccessPolicy
+	VirtualMeshes   []*networkingv1.VirtualMesh
+}
+
+// ResourceChange is an output resource that would be added, changed or removed by a proposal.
+type ResourceChange struct {
+	Type ChangeType
+	// the kind of the output resource, e.g. VirtualService
+	Kind string
+	// the output resource as currently translated, nil if Added
+	Current client.Object
+	// the output resource as translated with the proposal, nil if Removed
+	Proposed client.Object
+}
+
+// PolicyErrors are the status errors the Applier would set on a networking policy.
+type PolicyErrors struct {
+	// the kind of the policy, e.g. TrafficPolicy
+	Kind   string
+	Ref    *skv2corev1.ObjectRef
+	Errors []string
+}
+
+// Result is the outcome of previewing a proposal.
+type Result struct {
+	// changes to output resources, ordered by kind and then by resource key
+	Changes []ResourceChange
+	// status errors on the proposed policies, as well as on any existing policies whose errors would change
+	PolicyErrors []PolicyErrors
+}
+
+// the Previewer performs a dry run of the Applier and networking Translator
+// to show the mesh config that would result from applying a proposal, without writing anything to the cluster.
+type Previewer interface {
+	// Preview the proposal against the given input snapshot. The input snapshot is not modified.
+	// Settings must be stored in the context with settingsutils.ContextWithSettings, as is done by the networking reconciler.
+	Preview(ctx context.Context, in input.LocalSnapshot, proposal Proposal) (*Result, error)
+}
+
+type previewer struct {
+	applier    apply.Applier
+	translator translation.Translator
+}
+
+func NewPreviewer(
+	applier apply.Applier,
+	translator translation.Translator,
+) Previewer {
+	return &previewer{
+		applier:    applier,
+		translator: translator,
+	}
+}
+
+func (p *previewer) Preview(ctx context.Context, in input.LocalSnapshot, proposal Proposal) (*Result, error) {
+	current := in.Clone()
+	proposed := in.Clone()
+	for _, trafficPolicy := range proposal.TrafficPolicies {
+		proposed.TrafficPolicies().Insert(trafficPolicy.DeepCopy())
+	}
+	for _, accessPolicy := range proposal.AccessPolicies {
+		proposed.AccessPolicies().Insert(accessPolicy.DeepCopy())
+	}
+	for _, virtualMesh := range proposal.VirtualMeshes {
+		proposed.VirtualMeshes().Insert(virtualMesh.DeepCopy())
+	}
+
+	currentOutputs, err := p.applyAndTranslate(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+	proposedOutputs, err := p.applyAndTranslate(ctx, proposed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Changes:      DiffOutputs(currentOutputs, proposedOutputs),
+		PolicyErrors: diffPolicyErrors(current, proposed, proposal),
+	}, nil
+}
+
+// run the Applier and Translator as the networking reconciler would
+func (p *previewer) applyAndTranslate(ctx context.Context, in input.LocalSnapshot) (*translation.Outputs, error) {
+	p.applier.Apply(ctx, in, nil)
+	return p.translator.Translate(ctx, in, nil, reporting.NewPanickingReporter(ctx))
+}
+
+// DiffOutputs returns the Istio and SMI resources that differ between two sets of translated outputs,
+// ordered by kind and then by resource key.
+func DiffOutputs(current, proposed *translation.Outputs) []ResourceChange {
+	var changes []ResourceChange
+	changes = append(changes, diffResources("VirtualService",
+		current.Istio.GetVirtualServices().Generic(), proposed.Istio.GetVirtualServices().Generic())...)
+	changes = append(changes, diffResources("DestinationRule",
+		current.Istio.GetDestinationRules().Generic(), proposed.Istio.GetDestinationRules().Generic())...)
+	changes = append(changes, diffResources("AuthorizationPolicy",
+		current.Istio.GetAuthorizationPolicies().Generic(), proposed.Istio.GetAuthorizationPolicies().Generic())...)
+	changes = append(changes, diffResources("RequestAuthentication",
+		current.Istio.GetRequestAuthentications().Generic(), proposed.Istio.GetRequestAuthentications().Generic())...)
+	changes = append(changes, diffResources("TrafficSplit",
+		current.Smi.GetTrafficSplits().Generic(), proposed.Smi.GetTrafficSplits().Generic())...)
+	changes = append(changes, diffResources("TrafficTarget",
+		current.Smi.GetTrafficTargets().Generic(), proposed.Smi.GetTrafficTargets().Generic())...)
+	changes = append(changes, diffResources("HTTPRouteGroup",
+		current.Smi.GetHTTPRouteGroups().Generic(), proposed.Smi.GetHTTPRouteGroups().Generic())...)
+
+	return changes
+}
+
+func diffResources(kind string, current, proposed sets.ResourceSet) []ResourceChange {
+	var changes []ResourceChange
+
+	currentResources := current.Map()
+	proposedResources := proposed.Map()
+
+	for _, key := range proposed.Keys().List() {
+		proposedResource := proposedResources[key].(client.Object)
+		currentResource, ok := currentResources[key]
+		if !ok {
+			changes = append(changes, ResourceChange{
+				Type:     Added,
+				Kind:     kind,
+				Proposed: proposedResource,
+			})
+			continue
+		}
+		if !controllerutils.ObjectsEqual(currentResource.(client.Object), proposedResource) {
+			changes = append(changes, ResourceChange{
+				Type:     Changed,
+				Kind:     kind,
+				Current:  currentResource.(client.Object),
+				Proposed: proposedResource,
+			})
+		}
+	}
+
+	for _, key := range current.Keys().List() {
+		if _, ok := proposedResources[key]; !ok {
+			changes = append(changes, ResourceChange{
+				Type:    Removed,
+				Kind:    kind,
+				Current: currentResources[key].(client.Object),
+			})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return sets.Key(resourceOf(changes[i])) < sets.Key(resourceOf(changes[j]))
+	})
+
+	return changes
+}
+
+func resourceOf(change ResourceChange) client.Object {
+	if change.Proposed != nil {
+		return change.Proposed
+	}
+	return change.Current
+}
+
+// return errors for all proposed policies, and for existing policies whose errors differ as a result of the proposal
+func diffPolicyErrors(current, proposed input.LocalSnapshot, proposal Proposal) []PolicyErrors {
+	proposedKeys := map[string]bool{}
+	for _, trafficPolicy := range proposal.TrafficPolicies {
+		proposedKeys[policyKey("TrafficPolicy", ezkube.MakeObjectRef(trafficPolicy))] = true
+	}
+	for _, accessPolicy := range proposal.AccessPolicies {
+		proposedKeys[policyKey("AccessPolicy", ezkube.MakeObjectRef(accessPolicy))] = true
+	}
+	for _, virtualMesh := range proposal.VirtualMeshes {
+		proposedKeys[policyKey("VirtualMesh", ezkube.MakeObjectRef(virtualMesh))] = true
+	}
+
+	currentErrors := map[string][]string{}
+	for _, policyErrors := range GetPolicyErrors(current) {
+		currentErrors[policyKey(policyErrors.Kind, policyErrors.Ref)] = policyErrors.Errors
+	}
+
+	var result []PolicyErrors
+	for _, policyErrors := range GetPolicyErrors(proposed) {
+		key := policyKey(policyErrors.Kind, policyErrors.Ref)
+		if proposedKeys[key] {
+			result = append(result, policyErrors)
+			continue
+		}
+		if len(policyErrors.Errors) > 0 && !reflect.DeepEqual(currentErrors[key], policyErrors.Errors) {
+			result = append(result, policyErrors)
+		}
+	}
+	return result
+}
+
+// GetPolicyErrors returns the status errors set by the Applier on each networking policy in the snapshot.
+func GetPolicyErrors(in input.LocalSnapshot) []PolicyErrors {
+	var result []PolicyErrors
+	for _, trafficPolicy := range in.TrafficPolicies().List() {
+		result = append(result, PolicyErrors{
+			Kind:   "TrafficPolicy",
+			Ref:    ezkube.MakeObjectRef(trafficPolicy),
+			Errors: collectErrors(trafficPolicy.Status.GetErrors(), "destination", trafficPolicy.Status.GetDestinations()),
+		})
+	}
+	for _, accessPolicy := range in.AccessPolicies().List() {
+		result = append(result, PolicyErrors{
+			Kind:   "AccessPolicy",
+			Ref:    ezkube.MakeObjectRef(accessPolicy),
+			Errors: collectErrors(accessPolicy.Status.GetErrors(), "destination", accessPolicy.Status.GetDestinations()),
+		})
+	}
+	for _, virtualMesh := range in.VirtualMeshes().List() {
+		errs := collectErrors(virtualMesh.Status.GetErrors(), "mesh", virtualMesh.Status.GetMeshes())
+		errs = append(errs, collectErrors(nil, "destination", virtualMesh.Status.GetDestinations())...)
+		result = append(result, PolicyErrors{
+			Kind:   "VirtualMesh",
+			Ref:    ezkube.MakeObjectRef(virtualMesh),
+			Errors: errs,
+		})
+	}
+	return result
+}
+
+// flatten top-level errors and the errors of each approval status, prefixed with the key of the selected object
+func collectErrors(errs []string, selectedKind string, approvalStatuses map[string]*networkingv1.ApprovalStatus) []string {
+	var result []string
+	result = append(result, errs...)
+
+	var keys []string
+	for key := range approvalStatuses {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, err := range approvalStatuses[key].GetErrors() {
+			result = append(result, fmt.Sprintf("%s %s: %s", selectedKind, key, err))
+		}
+	}
+	return result
+}
+
+func policyKey(kind string, id ezkube.ResourceId) string {
+	return kind + "." + sets.Key(id)
+}
@@ -0,0 +1,183 @@
+package preview_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	istiooutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
+	smioutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/smi"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/preview"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Previewer", func() {
+	var (
+		ctx       = context.TODO()
+		previewer Previewer
+	)
+
+	BeforeEach(func() {
+		previewer = NewPreviewer(testApplier{}, testTranslator{})
+	})
+
+	makeTrafficPolicy := func(name string, attempts int32) *networkingv1.TrafficPolicy {
+		return &networkingv1.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns",
+			},
+			Spec: networkingv1.TrafficPolicySpec{
+				Policy: &networkingv1.TrafficPolicySpec_Policy{
+					Retries: &networkingv1.TrafficPolicySpec_Policy_RetryPolicy{
+						Attempts: attempts,
+					},
+				},
+			},
+		}
+	}
+
+	It("should report added, changed and removed resources", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddTrafficPolicies([]*networkingv1.TrafficPolicy{
+				makeTrafficPolicy("unchanged", 1),
+				makeTrafficPolicy("changed", 1),
+				makeTrafficPolicy("removed", 1),
+			}).
+			Build()
+
+		result, err := previewer.Preview(ctx, in, Proposal{
+			TrafficPolicies: []*networkingv1.TrafficPolicy{
+				makeTrafficPolicy("added", 1),
+				makeTrafficPolicy("changed", 2),
+				// the test applier rejects policies with zero attempts
+				makeTrafficPolicy("removed", 0),
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(result.Changes).To(HaveLen(3))
+		Expect(result.Changes[0].Type).To(Equal(Added))
+		Expect(result.Changes[0].Kind).To(Equal("VirtualService"))
+		Expect(result.Changes[0].Current).To(BeNil())
+		Expect(result.Changes[0].Proposed.GetName()).To(Equal("added"))
+		Expect(result.Changes[1].Type).To(Equal(Changed))
+		Expect(result.Changes[1].Current.GetName()).To(Equal("changed"))
+		Expect(result.Changes[1].Proposed.GetName()).To(Equal("changed"))
+		Expect(result.Changes[2].Type).To(Equal(Removed))
+		Expect(result.Changes[2].Current.GetName()).To(Equal("removed"))
+		Expect(result.Changes[2].Proposed).To(BeNil())
+
+		Expect(errorsByPolicy(result)).To(Equal(map[string][]string{
+			"TrafficPolicy added.ns":   nil,
+			"TrafficPolicy changed.ns": nil,
+			"TrafficPolicy removed.ns": {"retry attempts must be positive"},
+		}))
+	})
+
+	It("should not modify the input snapshot", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddTrafficPolicies([]*networkingv1.TrafficPolicy{
+				makeTrafficPolicy("existing", 1),
+			}).
+			Build()
+
+		_, err := previewer.Preview(ctx, in, Proposal{
+			TrafficPolicies: []*networkingv1.TrafficPolicy{
+				makeTrafficPolicy("existing", 0),
+				makeTrafficPolicy("added", 1),
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(in.TrafficPolicies().Length()).To(Equal(1))
+		existing := in.TrafficPolicies().List()[0]
+		Expect(existing.Spec.GetPolicy().GetRetries().GetAttempts()).To(Equal(int32(1)))
+		Expect(existing.Status.GetState()).To(Equal(commonv1.ApprovalState_PENDING))
+	})
+
+	It("should only report errors on existing policies if they change", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddTrafficPolicies([]*networkingv1.TrafficPolicy{
+				makeTrafficPolicy("already-invalid", 0),
+			}).
+			Build()
+
+		result, err := previewer.Preview(ctx, in, Proposal{
+			TrafficPolicies: []*networkingv1.TrafficPolicy{
+				makeTrafficPolicy("added", 1),
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(errorsByPolicy(result)).To(Equal(map[string][]string{
+			"TrafficPolicy added.ns": nil,
+		}))
+	})
+})
+
+func errorsByPolicy(result *Result) map[string][]string {
+	errs := map[string][]string{}
+	for _, policyErrors := range result.PolicyErrors {
+		errs[fmt.Sprintf("%s %s.%s", policyErrors.Kind, policyErrors.Ref.GetName(), policyErrors.Ref.GetNamespace())] = policyErrors.Errors
+	}
+	return errs
+}
+
+// the test applier accepts TrafficPolicies with a positive number of retry attempts
+type testApplier struct{}
+
+func (testApplier) Apply(ctx context.Context, in input.LocalSnapshot, userSupplied input.RemoteSnapshot) {
+	for _, trafficPolicy := range in.TrafficPolicies().List() {
+		trafficPolicy.Status = networkingv1.TrafficPolicyStatus{
+			State: commonv1.ApprovalState_ACCEPTED,
+		}
+		if trafficPolicy.Spec.GetPolicy().GetRetries().GetAttempts() <= 0 {
+			trafficPolicy.Status.State = commonv1.ApprovalState_INVALID
+			trafficPolicy.Status.Errors = []string{"retry attempts must be positive"}
+		}
+	}
+}
+
+// the test translator outputs a VirtualService for each accepted TrafficPolicy
+type testTranslator struct{}
+
+func (testTranslator) Translate(
+	ctx context.Context,
+	in input.LocalSnapshot,
+	userSupplied input.RemoteSnapshot,
+	reporter reporting.Reporter,
+) (*translation.Outputs, error) {
+	istioOutputs := istiooutput.NewBuilder(ctx, "test")
+	for _, trafficPolicy := range in.TrafficPolicies().List() {
+		if trafficPolicy.Status.State != commonv1.ApprovalState_ACCEPTED {
+			continue
+		}
+		istioOutputs.AddVirtualServices(&networkingv1alpha3.VirtualService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      trafficPolicy.Name,
+				Namespace: trafficPolicy.Namespace,
+			},
+			Spec: networkingv1alpha3spec.VirtualService{
+				Hosts: []string{fmt.Sprintf("%s.%s", trafficPolicy.Name, trafficPolicy.Namespace)},
+				Http: []*networkingv1alpha3spec.HTTPRoute{{
+					Retries: &networkingv1alpha3spec.HTTPRetry{
+						Attempts: trafficPolicy.Spec.GetPolicy().GetRetries().GetAttempts(),
+					},
+				}},
+			},
+		})
+	}
+	return &translation.Outputs{
+		Istio: istioOutputs,
+		Smi:   smioutput.NewBuilder(ctx, "test"),
+	}, nil
+}
@@ -0,0 +1,111 @@
+package preview
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PrintChanges writes a human readable description of the given changes.
+// Added resources are printed in full, and changed resources are printed as a line diff of their YAML.
+func PrintChanges(out io.Writer, changes []ResourceChange) error {
+	for _, change := range changes {
+		switch change.Type {
+		case Added:
+			fmt.Fprintf(out, "+ %s %s (added)\n", change.Kind, sets.Key(change.Proposed))
+			proposedYaml, err := toYaml(change.Proposed)
+			if err != nil {
+				return err
+			}
+			printLines(out, "+ ", proposedYaml)
+		case Changed:
+			fmt.Fprintf(out, "~ %s %s (changed)\n", change.Kind, sets.Key(change.Proposed))
+			currentYaml, err := toYaml(change.Current)
+			if err != nil {
+				return err
+			}
+			proposedYaml, err := toYaml(change.Proposed)
+			if err != nil {
+				return err
+			}
+			printLines(out, "", diffLines(currentYaml, proposedYaml))
+		case Removed:
+			fmt.Fprintf(out, "- %s %s (removed)\n", change.Kind, sets.Key(change.Current))
+		}
+		fmt.Fprintln(out)
+	}
+	return nil
+}
+
+// PrintPolicyErrors writes the errors of each policy which has any.
+func PrintPolicyErrors(out io.Writer, policyErrors []PolicyErrors) {
+	for _, policy := range policyErrors {
+		if len(policy.Errors) == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "%s %s.%s has errors:\n", policy.Kind, policy.Ref.GetName(), policy.Ref.GetNamespace())
+		printLines(out, "  - ", policy.Errors)
+	}
+}
+
+func toYaml(obj client.Object) ([]string, error) {
+	byt, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(strings.TrimSuffix(string(byt), "\n"), "\n"), nil
+}
+
+func printLines(out io.Writer, prefix string, lines []string) {
+	for _, line := range lines {
+		fmt.Fprintln(out, prefix+line)
+	}
+}
+
+// produce a line diff of two YAML documents, using the longest common subsequence of lines
+func diffLines(current, proposed []string) []string {
+	// lcs[i][j] is the length of the longest common subsequence of current[i:] and proposed[j:]
+	lcs := make([][]int, len(current)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(proposed)+1)
+	}
+	for i := len(current) - 1; i >= 0; i-- {
+		for j := len(proposed) - 1; j >= 0; j-- {
+			if current[i] == proposed[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var diff []string
+	i, j := 0, 0
+	for i < len(current) && j < len(proposed) {
+		switch {
+		case current[i] == proposed[j]:
+			diff = append(diff, "  "+current[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, "- "+current[i])
+			i++
+		default:
+			diff = append(diff, "+ "+proposed[j])
+			j++
+		}
+	}
+	for ; i < len(current); i++ {
+		diff = append(diff, "- "+current[i])
+	}
+	for ; j < len(proposed); j++ {
+		diff = append(diff, "+ "+proposed[j])
+	}
+	return diff
+}
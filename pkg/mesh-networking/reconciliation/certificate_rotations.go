@@ -0,0 +1,73 @@
+package reconciliation
+
+import (
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	certificatesv1sets "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1/sets"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/rotation"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+)
+
+// Record the progress of the rotation of the certificate issued to each Mesh of the VirtualMeshes,
+// as reported by the cert-agent on the IssuedCertificate translated for the Mesh,
+// along with the furthest state of a root CA rotation which the certificates of the VirtualMesh may proceed to.
+// Returns true if the root CA of any VirtualMesh is being rotated.
+func reportCertificateRotations(
+	virtualMeshes networkingv1.VirtualMeshSlice,
+	meshes discoveryv1sets.MeshSet,
+	issuedCertificates certificatesv1sets.IssuedCertificateSet,
+) bool {
+	var rootRotationInProgress bool
+	for _, virtualMesh := range virtualMeshes {
+		virtualMesh.Status.CertificateRotations = nil
+		virtualMesh.Status.RootRotationState = certificatesv1.IssuedCertificateStatus_Rotation_NOT_ROTATING
+
+		// the number of states of a root CA rotation completed by every Mesh
+		completedStates := -1
+		var rotating bool
+		for _, meshRef := range virtualMesh.Spec.GetMeshes() {
+			mesh, err := meshes.Find(meshRef)
+			if err != nil {
+				continue
+			}
+			agentInfo := mesh.Spec.GetAgentInfo()
+			if agentInfo == nil {
+				// certificates are only issued to meshes with a cert-agent
+				continue
+			}
+
+			// the IssuedCertificate is named after the Mesh, see the mtls translator
+			issuedCertificate, err := issuedCertificates.Find(&skv2corev1.ClusterObjectRef{
+				Name:        mesh.Name,
+				Namespace:   agentInfo.GetAgentNamespace(),
+				ClusterName: mesh.Spec.GetIstio().GetInstallation().GetCluster(),
+			})
+			if err != nil {
+				// the certificate has yet to be issued
+				completedStates = 0
+				continue
+			}
+
+			if meshCompletedStates := rotation.CompletedRootRotationStates(issuedCertificate); completedStates < 0 || meshCompletedStates < completedStates {
+				completedStates = meshCompletedStates
+			}
+			rotating = rotating || rotation.RootRotationInProgress(issuedCertificate.Status.GetRotation())
+
+			if issuedCertificate.Status.GetRotation() == nil {
+				continue
+			}
+			if virtualMesh.Status.CertificateRotations == nil {
+				virtualMesh.Status.CertificateRotations = map[string]*certificatesv1.IssuedCertificateStatus_Rotation{}
+			}
+			virtualMesh.Status.CertificateRotations[sets.Key(mesh)] = issuedCertificate.Status.GetRotation()
+		}
+
+		if rotating {
+			virtualMesh.Status.RootRotationState = rotation.AllowedRootRotationState(completedStates)
+			rootRotationInProgress = true
+		}
+	}
+	return rootRotationInProgress
+}
@@ -0,0 +1,117 @@
+package reconciliation
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	certificatesv1sets "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1/sets"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("CertificateRotations", func() {
+	newMesh := func(name, cluster string) *discoveryv1.Mesh {
+		return &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "gloo-mesh"},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Istio_{Istio: &discoveryv1.MeshSpec_Istio{
+					Installation: &discoveryv1.MeshInstallation{Cluster: cluster},
+				}},
+				AgentInfo: &discoveryv1.MeshSpec_AgentInfo{AgentNamespace: "gloo-mesh"},
+			},
+		}
+	}
+
+	It("reports the rotation of the certificate issued to each mesh of the VirtualMesh", func() {
+		rotatingMesh := newMesh("istiod-istio-system-cluster1", "cluster1")
+		issuedMesh := newMesh("istiod-istio-system-cluster2", "cluster2")
+
+		rotation := &certificatesv1.IssuedCertificateStatus_Rotation{
+			State: certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE,
+		}
+		issuedCertificates := certificatesv1sets.NewIssuedCertificateSet(
+			&certificatesv1.IssuedCertificate{
+				ObjectMeta: metav1.ObjectMeta{Name: rotatingMesh.Name, Namespace: "gloo-mesh", ClusterName: "cluster1"},
+				Status:     certificatesv1.IssuedCertificateStatus{Rotation: rotation},
+			},
+			&certificatesv1.IssuedCertificate{
+				ObjectMeta: metav1.ObjectMeta{Name: issuedMesh.Name, Namespace: "gloo-mesh", ClusterName: "cluster2"},
+			},
+		)
+
+		virtualMesh := &networkingv1.VirtualMesh{
+			Spec: networkingv1.VirtualMeshSpec{
+				Meshes: []*skv2corev1.ObjectRef{
+					ezkube.MakeObjectRef(rotatingMesh),
+					ezkube.MakeObjectRef(issuedMesh),
+				},
+			},
+		}
+
+		rootRotationInProgress := reportCertificateRotations(
+			networkingv1.VirtualMeshSlice{virtualMesh},
+			discoveryv1sets.NewMeshSet(rotatingMesh, issuedMesh),
+			issuedCertificates,
+		)
+
+		Expect(rootRotationInProgress).To(BeTrue())
+		Expect(virtualMesh.Status.CertificateRotations).To(Equal(map[string]*certificatesv1.IssuedCertificateStatus_Rotation{
+			sets.Key(rotatingMesh): rotation,
+		}))
+	})
+
+	It("only allows the next state of a root CA rotation once every mesh of the VirtualMesh has completed the current state", func() {
+		mesh1 := newMesh("istiod-istio-system-cluster1", "cluster1")
+		mesh2 := newMesh("istiod-istio-system-cluster2", "cluster2")
+		newIssuedCertificate := func(mesh *discoveryv1.Mesh, stateCompleted bool) *certificatesv1.IssuedCertificate {
+			return &certificatesv1.IssuedCertificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        mesh.Name,
+					Namespace:   "gloo-mesh",
+					ClusterName: mesh.Spec.GetIstio().GetInstallation().GetCluster(),
+				},
+				Status: certificatesv1.IssuedCertificateStatus{
+					Rotation: &certificatesv1.IssuedCertificateStatus_Rotation{
+						State:          certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT,
+						StateCompleted: stateCompleted,
+					},
+				},
+			}
+		}
+		virtualMesh := &networkingv1.VirtualMesh{
+			Spec: networkingv1.VirtualMeshSpec{
+				Meshes: []*skv2corev1.ObjectRef{ezkube.MakeObjectRef(mesh1), ezkube.MakeObjectRef(mesh2)},
+			},
+		}
+		meshes := discoveryv1sets.NewMeshSet(mesh1, mesh2)
+
+		// the root of mesh2 has yet to be added
+		reportCertificateRotations(
+			networkingv1.VirtualMeshSlice{virtualMesh},
+			meshes,
+			certificatesv1sets.NewIssuedCertificateSet(newIssuedCertificate(mesh1, true), newIssuedCertificate(mesh2, false)),
+		)
+		Expect(virtualMesh.Status.RootRotationState).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_ADDING_ROOT))
+
+		reportCertificateRotations(
+			networkingv1.VirtualMeshSlice{virtualMesh},
+			meshes,
+			certificatesv1sets.NewIssuedCertificateSet(newIssuedCertificate(mesh1, true), newIssuedCertificate(mesh2, true)),
+		)
+		Expect(virtualMesh.Status.RootRotationState).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE))
+
+		// no root CA rotation is reported once the meshes have finished rotating
+		rootRotationInProgress := reportCertificateRotations(
+			networkingv1.VirtualMeshSlice{virtualMesh},
+			meshes,
+			certificatesv1sets.NewIssuedCertificateSet(),
+		)
+		Expect(rootRotationInProgress).To(BeFalse())
+		Expect(virtualMesh.Status.RootRotationState).To(Equal(certificatesv1.IssuedCertificateStatus_Rotation_NOT_ROTATING))
+	})
+})
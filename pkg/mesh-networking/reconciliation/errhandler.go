@@ -93,6 +93,17 @@ func (e *errHandler) handleError(resource ezkube.Object, err error) {
 				tp.Status.Errors = append(tp.Status.Errors, err.Error())
 				tp.Status.State = commonv1.ApprovalState_FAILED
 			}
+		case v1.EgressGatewayPolicy{}.GVK().String():
+			for _, parentEgp := range parents {
+				egp, findErr := e.in.EgressGatewayPolicies().Find(parentEgp)
+				if findErr != nil {
+					contextutils.LoggerFrom(e.ctx).Errorf("internal error: resource for parent not found: %s", parentEgp.String())
+					continue
+				}
+
+				egp.Status.Errors = append(egp.Status.Errors, err.Error())
+				egp.Status.State = commonv1.ApprovalState_FAILED
+			}
 		case enterprisenetworkingv1beta1.WasmDeployment{}.GVK().String():
 			for _, parentWd := range parents {
 				fs, findErr := e.in.WasmDeployments().Find(parentWd)
@@ -13,11 +13,14 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/solo-io/gloo-mesh/codegen/io"
+	certissuerinput "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/issuer/input"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/extensions/v1beta1"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/apply"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/canary"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/extensions"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation"
@@ -41,6 +44,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// the interval at which the progress of root CA rotations is reported while a rotation is in progress
+const certificateRotationPollInterval = 5 * time.Second
+
 // function which defines how the Networking reconciler should be registered with internal components.
 type RegisterReconcilerFunc func(
 	ctx context.Context,
@@ -60,7 +66,9 @@ type networkingReconciler struct {
 	ctx                        context.Context
 	localBuilder               input.LocalBuilder
 	remoteBuilder              input.RemoteBuilder
+	certificatesBuilder        certissuerinput.Builder
 	applier                    apply.Applier
+	canaryController           canary.Controller
 	reporter                   reporting.Reporter
 	translator                 translation.Translator
 	syncOutputs                SyncOutputsFunc
@@ -73,6 +81,8 @@ type networkingReconciler struct {
 	reconciler                 skinput.InputReconciler
 	remoteResourceVerifier     verifier.ServerResourceVerifier
 	disallowIntersectingConfig bool
+	canaryTimer                *time.Timer
+	certificateRotationTimer   *time.Timer
 }
 
 var (
@@ -81,6 +91,16 @@ var (
 		Name: "push-notification-event",
 	}
 
+	// canaryProgressId is a special identifier for a reconcile event triggered to progress TrafficPolicy canaries
+	canaryProgressId = &v1.ObjectRef{
+		Name: "canary-progress-event",
+	}
+
+	// certificateRotationId is a special identifier for a reconcile event triggered to report the progress of root CA rotations
+	certificateRotationId = &v1.ObjectRef{
+		Name: "certificate-rotation-event",
+	}
+
 	// predicates use by the networking reconciler.
 	// exported for use in Enterprise.
 	NetworkingReconcilePredicates = []predicate.Predicate{
@@ -94,7 +114,9 @@ func Start(
 	ctx context.Context,
 	localBuilder input.LocalBuilder,
 	remoteBuilder input.RemoteBuilder,
+	certificatesBuilder certissuerinput.Builder,
 	applier apply.Applier,
+	canaryController canary.Controller,
 	reporter reporting.Reporter,
 	translator translation.Translator,
 	registerReconciler RegisterReconcilerFunc,
@@ -116,7 +138,9 @@ func Start(
 		ctx:                        ctx,
 		localBuilder:               localBuilder,
 		remoteBuilder:              remoteBuilder,
+		certificatesBuilder:        certificatesBuilder,
 		applier:                    applier,
+		canaryController:           canaryController,
 		reporter:                   reporter,
 		translator:                 translator,
 		mgmtClient:                 mgmtClient,
@@ -134,30 +158,33 @@ func Start(
 	// this should eventually reach a steady state since Gloo Mesh performs equality checks before updating existing objects
 	remoteReconcileOptions := reconcile.Options{Verifier: remoteResourceVerifier}
 	remoteReconcileOpts := input.RemoteReconcileOptions{
-		IssuedCertificates:    remoteReconcileOptions,
-		PodBounceDirectives:   remoteReconcileOptions,
-		XdsConfigs:            remoteReconcileOptions,
-		DestinationRules:      remoteReconcileOptions,
-		EnvoyFilters:          remoteReconcileOptions,
-		Gateways:              remoteReconcileOptions,
-		ServiceEntries:        remoteReconcileOptions,
-		VirtualServices:       remoteReconcileOptions,
-		AuthorizationPolicies: remoteReconcileOptions,
-		Sidecars:              remoteReconcileOptions,
+		IssuedCertificates:     remoteReconcileOptions,
+		PodBounceDirectives:    remoteReconcileOptions,
+		XdsConfigs:             remoteReconcileOptions,
+		DestinationRules:       remoteReconcileOptions,
+		EnvoyFilters:           remoteReconcileOptions,
+		Gateways:               remoteReconcileOptions,
+		ServiceEntries:         remoteReconcileOptions,
+		VirtualServices:        remoteReconcileOptions,
+		AuthorizationPolicies:  remoteReconcileOptions,
+		RequestAuthentications: remoteReconcileOptions,
+		Sidecars:               remoteReconcileOptions,
 		Predicates: []predicate.Predicate{
 			skv2predicate.SimplePredicate{
 				Filter: skv2predicate.SimpleEventFilterFunc(isIgnoredConfigMap),
 			},
 		},
 	}
-	// ignore all events (i.e. don't reconcile) if not watching output types
+	// ignore all events (i.e. don't reconcile) if not watching output types,
+	// except for IssuedCertificates, whose statuses report the progress of certificate rotations
 	if !watchOutputTypes {
 		remoteReconcileOpts.Predicates = append(
 			remoteReconcileOpts.Predicates,
 			skv2predicate.SimplePredicate{
 				Filter: skv2predicate.SimpleEventFilterFunc(
 					func(obj metav1.Object) bool {
-						return true
+						_, isIssuedCertificate := obj.(*certificatesv1.IssuedCertificate)
+						return !isIssuedCertificate
 					},
 				),
 			},
@@ -229,16 +256,17 @@ func (r *networkingReconciler) reconcile(obj ezkube.ResourceId) (bool, error) {
 			Verifier: r.remoteResourceVerifier,
 		}
 		userSupplied, err = r.remoteBuilder.BuildSnapshot(ctx, "mesh-networking-istio-inputs", input.RemoteBuildOptions{
-			IssuedCertificates:    resourceBuildOptions,
-			PodBounceDirectives:   resourceBuildOptions,
-			XdsConfigs:            resourceBuildOptions,
-			DestinationRules:      resourceBuildOptions,
-			EnvoyFilters:          resourceBuildOptions,
-			Gateways:              resourceBuildOptions,
-			ServiceEntries:        resourceBuildOptions,
-			VirtualServices:       resourceBuildOptions,
-			AuthorizationPolicies: resourceBuildOptions,
-			Sidecars:              resourceBuildOptions,
+			IssuedCertificates:     resourceBuildOptions,
+			PodBounceDirectives:    resourceBuildOptions,
+			XdsConfigs:             resourceBuildOptions,
+			DestinationRules:       resourceBuildOptions,
+			EnvoyFilters:           resourceBuildOptions,
+			Gateways:               resourceBuildOptions,
+			ServiceEntries:         resourceBuildOptions,
+			VirtualServices:        resourceBuildOptions,
+			AuthorizationPolicies:  resourceBuildOptions,
+			RequestAuthentications: resourceBuildOptions,
+			Sidecars:               resourceBuildOptions,
 		})
 		if err != nil {
 			// failed to read from cache; should never happen
@@ -246,12 +274,26 @@ func (r *networkingReconciler) reconcile(obj ezkube.ResourceId) (bool, error) {
 		}
 	}
 
+	// progress canaries before applying policies, as the applier routes traffic according to the current weight of each canary
+	if progressAfter := r.canaryController.Progress(ctx, inputSnap); progressAfter > 0 {
+		r.scheduleCanaryProgress(progressAfter)
+	}
+
 	// apply policies to the discovery resources they target
 	r.applier.Apply(ctx, inputSnap, userSupplied)
 
 	// append errors as we still want to sync statuses if applying translation fails
 	var errs error
 
+	// report the progress of certificate rotations, which are performed by the cert-agents
+	if certificatesSnap, err := r.certificatesBuilder.BuildSnapshot(ctx, "mesh-networking-certificates", certissuerinput.BuildOptions{}); err != nil {
+		errs = multierror.Append(errs, err)
+	} else {
+		if reportCertificateRotations(inputSnap.VirtualMeshes().List(), inputSnap.Meshes(), certificatesSnap.IssuedCertificates()) {
+			r.scheduleCertificateRotationProgress()
+		}
+	}
+
 	// translate and apply outputs
 	if err := r.applyTranslation(ctx, inputSnap, userSupplied); err != nil {
 		errs = multierror.Append(errs, err)
@@ -260,20 +302,21 @@ func (r *networkingReconciler) reconcile(obj ezkube.ResourceId) (bool, error) {
 	// update statuses of input objects
 	if err := inputSnap.SyncStatuses(ctx, r.mgmtClient, input.LocalSyncStatusOptions{
 		// keep this list up to date with all networking status outputs
-		Settings:           true,
-		Destination:        true,
-		Workload:           true,
-		Mesh:               true,
-		TrafficPolicy:      true,
-		AccessPolicy:       true,
-		VirtualMesh:        true,
-		WasmDeployment:     true,
-		AccessLogRecord:    true,
-		VirtualDestination: true,
-		VirtualGateway:     true,
-		VirtualHost:        true,
-		RouteTable:         true,
-		ServiceDependency:  true,
+		Settings:            true,
+		Destination:         true,
+		Workload:            true,
+		Mesh:                true,
+		TrafficPolicy:       true,
+		AccessPolicy:        true,
+		VirtualMesh:         true,
+		EgressGatewayPolicy: true,
+		WasmDeployment:      true,
+		AccessLogRecord:     true,
+		VirtualDestination:  true,
+		VirtualGateway:      true,
+		VirtualHost:         true,
+		RouteTable:          true,
+		ServiceDependency:   true,
 	}); err != nil {
 		errs = multierror.Append(errs, err)
 	}
@@ -322,6 +365,30 @@ func (r *networkingReconciler) syncSettings(ctx *context.Context, in input.Local
 	})
 }
 
+// trigger a reconcile after the given duration, replacing any previously scheduled canary progression.
+// canaries otherwise only progress when an input object changes.
+func (r *networkingReconciler) scheduleCanaryProgress(after time.Duration) {
+	if r.canaryTimer != nil {
+		r.canaryTimer.Stop()
+	}
+	r.canaryTimer = time.AfterFunc(after, func() {
+		// ignore error because underlying impl should never error here
+		_, _ = r.reconciler.ReconcileLocalGeneric(canaryProgressId)
+	})
+}
+
+// trigger a reconcile after the certificate rotation poll interval, replacing any previously scheduled reconcile.
+// ensures the progress of root CA rotations is reported even if no input object changes.
+func (r *networkingReconciler) scheduleCertificateRotationProgress() {
+	if r.certificateRotationTimer != nil {
+		r.certificateRotationTimer.Stop()
+	}
+	r.certificateRotationTimer = time.AfterFunc(certificateRotationPollInterval, func() {
+		// ignore error because underlying impl should never error here
+		_, _ = r.reconciler.ReconcileLocalGeneric(certificateRotationId)
+	})
+}
+
 // returns true if the passed object is a secret which is of a type that is ignored by GlooMesh
 func isIgnoredSecret(obj metav1.Object) bool {
 	secret, ok := obj.(*corev1.Secret)
@@ -0,0 +1,50 @@
+package replay
+
+import (
+	"context"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/apply"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/settingsutils"
+)
+
+// Replay runs validation and translation over the given snapshots as the networking reconciler would,
+// without reading from or writing to any cluster.
+// The statuses of the objects in the input snapshot are updated by the Applier.
+// userSupplied may be nil, in which case intersecting config is not detected.
+func Replay(
+	ctx context.Context,
+	applier apply.Applier,
+	translator translation.Translator,
+	in input.LocalSnapshot,
+	userSupplied input.RemoteSnapshot,
+) (*translation.Outputs, error) {
+	settings, err := getSettings(in)
+	if err != nil {
+		return nil, err
+	}
+	ctx = settingsutils.ContextWithSettings(ctx, settings)
+
+	applier.Apply(ctx, in, userSupplied)
+
+	return translator.Translate(ctx, in, userSupplied, reporting.NewPanickingReporter(ctx))
+}
+
+// return the default Settings object, or the only Settings object if the default is not present
+func getSettings(in input.LocalSnapshot) (*settingsv1.Settings, error) {
+	settings := in.Settings().List()
+	for _, s := range settings {
+		if s.GetName() == defaults.DefaultSettingsName {
+			return s, nil
+		}
+	}
+	if len(settings) == 1 {
+		return settings[0], nil
+	}
+	return nil, eris.Errorf("expected snapshot to contain the %s Settings object", defaults.DefaultSettingsName)
+}
@@ -0,0 +1,159 @@
+package replay_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	splitv1alpha2 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	appmeshoutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/appmesh"
+	consuloutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/consul"
+	istiooutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
+	localoutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/local"
+	smioutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/smi"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/replay"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/settingsutils"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Replay", func() {
+	ctx := context.TODO()
+
+	It("should rebuild an input snapshot from its JSON representation", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("networking").
+			AddTrafficPolicies([]*networkingv1.TrafficPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "tp", Namespace: "ns"},
+				Spec: networkingv1.TrafficPolicySpec{
+					Policy: &networkingv1.TrafficPolicySpec_Policy{
+						Retries: &networkingv1.TrafficPolicySpec_Policy_RetryPolicy{Attempts: 3},
+					},
+				},
+			}}).
+			AddDestinations([]*discoveryv1.Destination{{
+				ObjectMeta: metav1.ObjectMeta{Name: "destination", Namespace: "gloo-mesh"},
+			}}).
+			AddSettings([]*settingsv1.Settings{{
+				ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "gloo-mesh"},
+			}}).
+			Build()
+
+		byt, err := in.MarshalJSON()
+		Expect(err).ToNot(HaveOccurred())
+
+		replayed, err := LocalSnapshotFromJSON(byt)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(replayed.TrafficPolicies().Length()).To(Equal(1))
+		trafficPolicy := replayed.TrafficPolicies().List()[0]
+		Expect(trafficPolicy.GetName()).To(Equal("tp"))
+		Expect(trafficPolicy.GetNamespace()).To(Equal("ns"))
+		Expect(trafficPolicy.Spec.GetPolicy().GetRetries().GetAttempts()).To(Equal(int32(3)))
+		Expect(replayed.Destinations().Keys().List()).To(Equal(in.Destinations().Keys().List()))
+		Expect(replayed.Settings().Keys().List()).To(Equal(in.Settings().Keys().List()))
+		Expect(replayed.Workloads().Length()).To(Equal(0))
+	})
+
+	It("should rebuild the Istio and SMI outputs from the JSON representation of the output snapshots", func() {
+		labels := metautils.TranslatedObjectLabels()
+		outputs := &translation.Outputs{
+			Istio:   istiooutput.NewBuilder(ctx, "istio"),
+			Appmesh: appmeshoutput.NewBuilder(ctx, "appmesh"),
+			Smi:     smioutput.NewBuilder(ctx, "smi"),
+			Consul:  consuloutput.NewBuilder(ctx, "consul"),
+			Local:   localoutput.NewBuilder(ctx, "local"),
+		}
+		outputs.Istio.AddVirtualServices(&networkingv1alpha3.VirtualService{
+			ObjectMeta: metav1.ObjectMeta{Name: "vs", Namespace: "ns", ClusterName: "cluster", Labels: labels},
+			Spec:       networkingv1alpha3spec.VirtualService{Hosts: []string{"foo.ns"}},
+		})
+		outputs.Smi.AddTrafficSplits(&splitv1alpha2.TrafficSplit{
+			ObjectMeta: metav1.ObjectMeta{Name: "split", Namespace: "ns", ClusterName: "cluster", Labels: labels},
+			Spec:       splitv1alpha2.TrafficSplitSpec{Service: "foo"},
+		})
+
+		byt, err := outputs.MarshalJSON()
+		Expect(err).ToNot(HaveOccurred())
+
+		replayed, err := OutputsFromJSON(ctx, byt)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(replayed.Istio.GetVirtualServices().Keys().List()).To(Equal(outputs.Istio.GetVirtualServices().Keys().List()))
+		Expect(replayed.Istio.GetVirtualServices().List()[0].Spec.GetHosts()).To(Equal([]string{"foo.ns"}))
+		Expect(replayed.Smi.GetTrafficSplits().Keys().List()).To(Equal(outputs.Smi.GetTrafficSplits().Keys().List()))
+		Expect(replayed.Smi.GetTrafficSplits().List()[0].Spec.Service).To(Equal("foo"))
+		Expect(replayed.Istio.GetDestinationRules().Length()).To(Equal(0))
+	})
+
+	It("should apply and translate the snapshot with its Settings", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddTrafficPolicies([]*networkingv1.TrafficPolicy{{
+				ObjectMeta: metav1.ObjectMeta{Name: "tp", Namespace: "ns"},
+			}}).
+			AddSettings([]*settingsv1.Settings{{
+				ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "gloo-mesh"},
+			}}).
+			Build()
+
+		outputs, err := Replay(ctx, testApplier{}, testTranslator{}, in, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(in.TrafficPolicies().List()[0].Status.GetState()).To(Equal(commonv1.ApprovalState_ACCEPTED))
+		Expect(outputs.Istio.GetVirtualServices().Length()).To(Equal(1))
+	})
+
+	It("should error if the snapshot does not contain Settings", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+
+		_, err := Replay(ctx, testApplier{}, testTranslator{}, in, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+// the test applier accepts all TrafficPolicies
+type testApplier struct{}
+
+func (testApplier) Apply(ctx context.Context, in input.LocalSnapshot, userSupplied input.RemoteSnapshot) {
+	for _, trafficPolicy := range in.TrafficPolicies().List() {
+		trafficPolicy.Status.State = commonv1.ApprovalState_ACCEPTED
+	}
+}
+
+// the test translator outputs a VirtualService for each accepted TrafficPolicy if Settings are present in the context
+type testTranslator struct{}
+
+func (testTranslator) Translate(
+	ctx context.Context,
+	in input.LocalSnapshot,
+	userSupplied input.RemoteSnapshot,
+	reporter reporting.Reporter,
+) (*translation.Outputs, error) {
+	istioOutputs := istiooutput.NewBuilder(ctx, "test")
+	if settingsutils.SettingsFromContext(ctx) == nil {
+		return nil, eris.New("settings missing from context")
+	}
+	for _, trafficPolicy := range in.TrafficPolicies().List() {
+		if trafficPolicy.Status.State != commonv1.ApprovalState_ACCEPTED {
+			continue
+		}
+		istioOutputs.AddVirtualServices(&networkingv1alpha3.VirtualService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      trafficPolicy.Name,
+				Namespace: trafficPolicy.Namespace,
+			},
+		})
+	}
+	return &translation.Outputs{
+		Istio: istioOutputs,
+	}, nil
+}
@@ -0,0 +1,221 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/rotisserie/eris"
+	accessv1alpha2 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/access/v1alpha2"
+	specsv1alpha3 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/specs/v1alpha3"
+	splitv1alpha2 "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha2"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	networkingv1beta1 "github.com/solo-io/gloo-mesh/pkg/api/networking.enterprise.mesh.gloo.solo.io/v1beta1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	appmeshoutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/appmesh"
+	consuloutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/consul"
+	istiooutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
+	localoutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/local"
+	smioutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/smi"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	observabilityv1 "github.com/solo-io/gloo-mesh/pkg/api/observability.enterprise.mesh.gloo.solo.io/v1"
+	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
+	xdsv1beta1 "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation"
+	multiclusterv1alpha1 "github.com/solo-io/skv2/pkg/api/multicluster.solo.io/v1alpha1"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	securityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// the JSON representation of an input.LocalSnapshot, as produced by its MarshalJSON method
+type localSnapshotJSON struct {
+	Name string `json:"name"`
+
+	WasmDeployments          []*networkingv1beta1.WasmDeployment          `json:"wasmDeployments"`
+	RateLimiterServerConfigs []*networkingv1beta1.RateLimiterServerConfig `json:"rateLimiterServerConfigs"`
+	VirtualDestinations      []*networkingv1beta1.VirtualDestination      `json:"virtualDestinations"`
+	VirtualGateways          []*networkingv1beta1.VirtualGateway          `json:"virtualGateways"`
+	VirtualHosts             []*networkingv1beta1.VirtualHost             `json:"virtualHosts"`
+	RouteTables              []*networkingv1beta1.RouteTable              `json:"routeTables"`
+	ServiceDependencies      []*networkingv1beta1.ServiceDependency       `json:"serviceDependencies"`
+
+	TrafficPolicies []*networkingv1.TrafficPolicy `json:"trafficPolicies"`
+	AccessPolicies  []*networkingv1.AccessPolicy  `json:"accessPolicies"`
+	VirtualMeshes   []*networkingv1.VirtualMesh   `json:"virtualMeshes"`
+
+	EgressGatewayPolicies []*networkingv1.EgressGatewayPolicy `json:"egressGatewayPolicies"`
+
+	Settings []*settingsv1.Settings `json:"settings"`
+
+	Destinations []*discoveryv1.Destination `json:"destinations"`
+	Workloads    []*discoveryv1.Workload    `json:"workloads"`
+	Meshes       []*discoveryv1.Mesh        `json:"meshes"`
+
+	AccessLogRecords []*observabilityv1.AccessLogRecord `json:"accessLogRecords"`
+
+	Secrets []*corev1.Secret `json:"secrets"`
+
+	KubernetesClusters []*multiclusterv1alpha1.KubernetesCluster `json:"kubernetesClusters"`
+}
+
+// the JSON representation of an input.RemoteSnapshot, as produced by its MarshalJSON method.
+// the Istio output snapshot shares the same representation.
+type remoteSnapshotJSON struct {
+	Name string `json:"name"`
+
+	IssuedCertificates  []*certificatesv1.IssuedCertificate  `json:"issuedCertificates"`
+	PodBounceDirectives []*certificatesv1.PodBounceDirective `json:"podBounceDirectives"`
+
+	XdsConfigs []*xdsv1beta1.XdsConfig `json:"xdsConfigs"`
+
+	DestinationRules []*networkingv1alpha3.DestinationRule `json:"destinationRules"`
+	EnvoyFilters     []*networkingv1alpha3.EnvoyFilter     `json:"envoyFilters"`
+	Gateways         []*networkingv1alpha3.Gateway         `json:"gateways"`
+	ServiceEntries   []*networkingv1alpha3.ServiceEntry    `json:"serviceEntries"`
+	VirtualServices  []*networkingv1alpha3.VirtualService  `json:"virtualServices"`
+	Sidecars         []*networkingv1alpha3.Sidecar         `json:"sidecars"`
+
+	AuthorizationPolicies  []*securityv1beta1.AuthorizationPolicy   `json:"authorizationPolicies"`
+	RequestAuthentications []*securityv1beta1.RequestAuthentication `json:"requestAuthentications"`
+}
+
+// the JSON representation of the SMI output snapshot
+type smiOutputsJSON struct {
+	TrafficSplits   []*splitv1alpha2.TrafficSplit   `json:"trafficSplits"`
+	TrafficTargets  []*accessv1alpha2.TrafficTarget `json:"trafficTargets"`
+	HTTPRouteGroups []*specsv1alpha3.HTTPRouteGroup `json:"hTTPRouteGroups"`
+}
+
+// LocalSnapshotFromJSON rebuilds an input.LocalSnapshot from its JSON representation,
+// i.e. the networking input snapshot dumped by `meshctl debug snapshot networking input --json`.
+func LocalSnapshotFromJSON(data []byte) (input.LocalSnapshot, error) {
+	var snap localSnapshotJSON
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, eris.Wrap(err, "decoding input snapshot")
+	}
+
+	return input.NewInputLocalSnapshotManualBuilder(snap.Name).
+		AddWasmDeployments(snap.WasmDeployments).
+		AddRateLimiterServerConfigs(snap.RateLimiterServerConfigs).
+		AddVirtualDestinations(snap.VirtualDestinations).
+		AddVirtualGateways(snap.VirtualGateways).
+		AddVirtualHosts(snap.VirtualHosts).
+		AddRouteTables(snap.RouteTables).
+		AddServiceDependencies(snap.ServiceDependencies).
+		AddTrafficPolicies(snap.TrafficPolicies).
+		AddAccessPolicies(snap.AccessPolicies).
+		AddVirtualMeshes(snap.VirtualMeshes).
+		AddEgressGatewayPolicies(snap.EgressGatewayPolicies).
+		AddSettings(snap.Settings).
+		AddDestinations(snap.Destinations).
+		AddWorkloads(snap.Workloads).
+		AddMeshes(snap.Meshes).
+		AddAccessLogRecords(snap.AccessLogRecords).
+		AddSecrets(snap.Secrets).
+		AddKubernetesClusters(snap.KubernetesClusters).
+		Build(), nil
+}
+
+// RemoteSnapshotFromJSON rebuilds an input.RemoteSnapshot, containing the user-supplied mesh config
+// used to detect intersecting config, from its JSON representation.
+func RemoteSnapshotFromJSON(data []byte) (input.RemoteSnapshot, error) {
+	var snap remoteSnapshotJSON
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, eris.Wrap(err, "decoding user-supplied snapshot")
+	}
+
+	return input.NewInputRemoteSnapshotManualBuilder(snap.Name).
+		AddIssuedCertificates(snap.IssuedCertificates).
+		AddPodBounceDirectives(snap.PodBounceDirectives).
+		AddXdsConfigs(snap.XdsConfigs).
+		AddDestinationRules(snap.DestinationRules).
+		AddEnvoyFilters(snap.EnvoyFilters).
+		AddGateways(snap.Gateways).
+		AddServiceEntries(snap.ServiceEntries).
+		AddVirtualServices(snap.VirtualServices).
+		AddSidecars(snap.Sidecars).
+		AddAuthorizationPolicies(snap.AuthorizationPolicies).
+		AddRequestAuthentications(snap.RequestAuthentications).
+		Build(), nil
+}
+
+// OutputsFromJSON rebuilds the Istio and SMI outputs from the networking output snapshot
+// dumped by `meshctl debug snapshot networking output --json`, which contains one JSON document per output type.
+// Outputs for other mesh types are left empty.
+func OutputsFromJSON(ctx context.Context, data []byte) (*translation.Outputs, error) {
+	outputs := &translation.Outputs{
+		Istio:   istiooutput.NewBuilder(ctx, "replay-istio"),
+		Appmesh: appmeshoutput.NewBuilder(ctx, "replay-appmesh"),
+		Smi:     smioutput.NewBuilder(ctx, "replay-smi"),
+		Consul:  consuloutput.NewBuilder(ctx, "replay-consul"),
+		Local:   localoutput.NewBuilder(ctx, "replay-local"),
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc map[string]json.RawMessage
+		if err := decoder.Decode(&doc); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, eris.Wrap(err, "decoding output snapshot")
+		}
+
+		// identify each document by a resource type unique to its output snapshot
+		var err error
+		switch {
+		case doc["destinationRules"] != nil:
+			err = addIstioOutputs(doc, outputs.Istio)
+		case doc["trafficSplits"] != nil:
+			err = addSmiOutputs(doc, outputs.Smi)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return outputs, nil
+}
+
+func addIstioOutputs(doc map[string]json.RawMessage, istioOutputs istiooutput.Builder) error {
+	// re-encoding is cheap relative to translation, and lets us share the remote snapshot representation
+	byt, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	var snap remoteSnapshotJSON
+	if err := json.Unmarshal(byt, &snap); err != nil {
+		return eris.Wrap(err, "decoding istio output snapshot")
+	}
+
+	istioOutputs.AddIssuedCertificates(snap.IssuedCertificates...)
+	istioOutputs.AddPodBounceDirectives(snap.PodBounceDirectives...)
+	istioOutputs.AddXdsConfigs(snap.XdsConfigs...)
+	istioOutputs.AddDestinationRules(snap.DestinationRules...)
+	istioOutputs.AddEnvoyFilters(snap.EnvoyFilters...)
+	istioOutputs.AddGateways(snap.Gateways...)
+	istioOutputs.AddServiceEntries(snap.ServiceEntries...)
+	istioOutputs.AddVirtualServices(snap.VirtualServices...)
+	istioOutputs.AddSidecars(snap.Sidecars...)
+	istioOutputs.AddAuthorizationPolicies(snap.AuthorizationPolicies...)
+	istioOutputs.AddRequestAuthentications(snap.RequestAuthentications...)
+	return nil
+}
+
+func addSmiOutputs(doc map[string]json.RawMessage, smiOutputs smioutput.Builder) error {
+	byt, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	var snap smiOutputsJSON
+	if err := json.Unmarshal(byt, &snap); err != nil {
+		return eris.Wrap(err, "decoding smi output snapshot")
+	}
+
+	smiOutputs.AddTrafficSplits(snap.TrafficSplits...)
+	smiOutputs.AddTrafficTargets(snap.TrafficTargets...)
+	smiOutputs.AddHTTPRouteGroups(snap.HTTPRouteGroups...)
+	return nil
+}
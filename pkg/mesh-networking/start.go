@@ -10,13 +10,11 @@ import (
 	certissuertranslation "github.com/solo-io/gloo-mesh/pkg/certificates/issuer/translation"
 	"github.com/solo-io/gloo-mesh/pkg/common/schemes"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/apply"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/canary"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/extensions"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reconciliation"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation"
-	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/appmesh"
-	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio"
-	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/osm"
 	"github.com/solo-io/skv2/pkg/bootstrap"
 	"github.com/spf13/pflag"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -71,11 +69,14 @@ func (s networkingStarter) startReconciler(ctx context.Context, parameters boots
 	extensionOpts := s.makeExtensions(ctx, parameters)
 	extensionOpts.initDefaults(parameters)
 
+	// contains the IssuedCertificates and CertificateRequests read from all registered clusters
+	certIssuerSnapshotBuilder := extensionOpts.CertIssuerReconciler.MakeCertIssuerSnapshotBuilder(parameters)
+
 	baseTranslator := certissuertranslation.NewTranslator(corev1clients.NewSecretClient(parameters.MasterManager.GetClient()))
 	if err := startCertIssuer(
 		ctx,
 		extensionOpts.CertIssuerReconciler.RegisterCertIssuerReconciler,
-		extensionOpts.CertIssuerReconciler.MakeCertIssuerSnapshotBuilder(parameters),
+		certIssuerSnapshotBuilder,
 		extensionOpts.CertIssuerReconciler.SyncCertificateIssuerInputStatuses,
 		extensionOpts.CertIssuerReconciler.MakeTranslator(baseTranslator),
 		parameters.MasterManager,
@@ -92,16 +93,10 @@ func (s networkingStarter) startReconciler(ctx context.Context, parameters boots
 
 	reporter := reporting.NewPanickingReporter(ctx)
 
-	translator := extensionOpts.NetworkingReconciler.MakeTranslator(translation.NewTranslator(
-		istio.NewIstioTranslator(extensionClientset),
-		appmesh.NewAppmeshTranslator(),
-		osm.NewOSMTranslator(),
-	))
-	validatingTranslator := extensionOpts.NetworkingReconciler.MakeTranslator(translation.NewTranslator(
-		istio.NewIstioTranslator(nil), // the applier should not call the extender
-		appmesh.NewAppmeshTranslator(),
-		osm.NewOSMTranslator(),
-	))
+	translator := extensionOpts.NetworkingReconciler.MakeTranslator(translation.NewMeshTranslator(extensionClientset))
+	validatingTranslator := extensionOpts.NetworkingReconciler.MakeTranslator(
+		translation.NewMeshTranslator(nil), // the applier should not call the extender
+	)
 
 	applier := apply.NewApplier(validatingTranslator)
 
@@ -109,7 +104,9 @@ func (s networkingStarter) startReconciler(ctx context.Context, parameters boots
 		ctx,
 		inputSnapshotBuilder,
 		userProvidedSnapshotBuilder,
+		certIssuerSnapshotBuilder,
 		applier,
+		canary.NewController(canary.NewPrometheusMetricsClient),
 		reporter,
 		translator,
 		extensionOpts.NetworkingReconciler.RegisterNetworkingReconciler,
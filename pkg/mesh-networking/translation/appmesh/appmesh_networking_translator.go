@@ -7,6 +7,7 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/appmesh"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/appmesh/internal"
 	"github.com/solo-io/go-utils/contextutils"
 )
 
@@ -25,10 +26,13 @@ type Translator interface {
 
 type appmeshTranslator struct {
 	totalTranslates int // TODO(ilackarms): metric
+	dependencies    internal.DependencyFactory
 }
 
 func NewAppmeshTranslator() Translator {
-	return &appmeshTranslator{}
+	return &appmeshTranslator{
+		dependencies: internal.NewDependencyFactory(),
+	}
 }
 
 func (t *appmeshTranslator) Translate(
@@ -39,7 +43,35 @@ func (t *appmeshTranslator) Translate(
 ) {
 	ctx = contextutils.WithLogger(ctx, fmt.Sprintf("appmesh-translator-%v", t.totalTranslates))
 
-	//TODO: implement AppMesh translation
+	meshTranslator := t.dependencies.MakeMeshTranslator()
+
+	for _, mesh := range in.Meshes().List() {
+		mesh := mesh
+
+		meshTranslator.Translate(ctx, in, mesh, appmeshOutputs, reporter)
+	}
+
+	destinationTranslator := t.dependencies.MakeDestinationTranslator(
+		in.KubernetesClusters(),
+		in.Destinations(),
+	)
+
+	for _, destination := range in.Destinations().List() {
+		destination := destination
+
+		destinationTranslator.Translate(ctx, in, destination, appmeshOutputs, reporter)
+	}
+
+	workloadTranslator := t.dependencies.MakeWorkloadTranslator(
+		in.KubernetesClusters(),
+		in.Destinations(),
+	)
+
+	for _, workload := range in.Workloads().List() {
+		workload := workload
+
+		workloadTranslator.Translate(ctx, in, workload, appmeshOutputs, reporter)
+	}
 
 	t.totalTranslates++
 }
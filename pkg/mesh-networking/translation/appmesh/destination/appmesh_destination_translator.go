@@ -0,0 +1,639 @@
+package destination
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	appmeshv1beta2 "github.com/aws/aws-app-mesh-controller-for-k8s/apis/appmesh/v1beta2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/appmesh"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/workloadutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/smi/destination/split"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/appmeshutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/destinationutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+//go:generate mockgen -source ./appmesh_destination_translator.go -destination mocks/appmesh_destination_translator.go
+
+const (
+	// App Mesh route priorities range from 0 (highest) to 1000 (lowest)
+	defaultRoutePriority = 1000
+
+	// App Mesh limits the number of weighted targets on a single route
+	maxWeightedTargets = 10
+
+	// App Mesh requires a per retry timeout, default to the Envoy route timeout
+	defaultPerRetryTimeout = 15 * time.Second
+
+	defaultRouteName = "default"
+)
+
+var (
+	defaultHTTPRetryEvents = []appmeshv1beta2.HTTPRetryPolicyEvent{"server-error", "gateway-error"}
+	defaultTCPRetryEvents  = []appmeshv1beta2.TCPRetryPolicyEvent{"connection-error"}
+)
+
+// the App Mesh Destination translator translates a Destination into a VirtualService and a VirtualRouter.
+type Translator interface {
+	// Translate translates the appropriate VirtualService and VirtualRouter for the given Destination.
+	// Output resources will be added to the appmesh.Builder
+	// Errors caused by invalid user config will be reported using the Reporter.
+	Translate(
+		ctx context.Context,
+		in input.LocalSnapshot,
+		destination *discoveryv1.Destination,
+		outputs appmesh.Builder,
+		reporter reporting.Reporter,
+	)
+}
+
+type translator struct {
+	clusterDomains hostutils.ClusterDomainRegistry
+}
+
+func NewTranslator(clusterDomains hostutils.ClusterDomainRegistry) Translator {
+	return &translator{
+		clusterDomains: clusterDomains,
+	}
+}
+
+// translate the appropriate resources for the given Destination.
+func (t *translator) Translate(
+	ctx context.Context,
+	in input.LocalSnapshot,
+	destination *discoveryv1.Destination,
+	outputs appmesh.Builder,
+	reporter reporting.Reporter,
+) {
+	kubeService := destination.Spec.GetKubeService()
+
+	// only translate App Mesh Destinations backed by Kubernetes services
+	if kubeService == nil || !appmeshutils.IsAppMeshMesh(destination.Spec.GetMesh(), in.Meshes()) {
+		return
+	}
+
+	for _, ap := range destination.Status.GetAppliedAccessPolicies() {
+		validateAccessPolicy(ap, destination, reporter)
+	}
+
+	if len(kubeService.GetPorts()) == 0 {
+		contextutils.LoggerFrom(ctx).Debugf("skipping App Mesh Destination %v with no ports", sets.Key(destination))
+		return
+	}
+
+	// by default, each backing workload receives an equal share of traffic
+	backingWorkloads := backingVirtualNodes(in, kubeService, nil)
+	defaultTargets := makeWeightedTargets(backingWorkloads, uint32(len(backingWorkloads)))
+	if len(defaultTargets) == 0 {
+		contextutils.LoggerFrom(ctx).Debugf("skipping App Mesh Destination %v with no backing workloads", sets.Key(destination))
+		return
+	}
+	if len(defaultTargets) > maxWeightedTargets {
+		contextutils.LoggerFrom(ctx).Warnf("App Mesh Destination %v is backed by more than %d workloads, only the first %d will receive traffic",
+			sets.Key(destination), maxWeightedTargets, maxWeightedTargets)
+		defaultTargets = defaultTargets[:maxWeightedTargets]
+	}
+
+	// App Mesh VirtualRouters support a single listener, so only the first service port is routed
+	servicePort := kubeService.GetPorts()[0]
+	protocol := appmeshutils.PortProtocol(servicePort)
+
+	routes, appliedTrafficPolicies := t.translateRoutes(in, destination, protocol, defaultTargets, reporter)
+
+	virtualRouter := &appmeshv1beta2.VirtualRouter{
+		ObjectMeta: metautils.TranslatedObjectMeta(
+			kubeService.GetRef(),
+			destination.Annotations,
+		),
+		Spec: appmeshv1beta2.VirtualRouterSpec{
+			Listeners: []appmeshv1beta2.VirtualRouterListener{{
+				PortMapping: appmeshv1beta2.PortMapping{
+					Port:     appmeshv1beta2.PortNumber(servicePort.GetPort()),
+					Protocol: protocol,
+				},
+			}},
+			Routes: routes,
+		},
+	}
+
+	for _, tpRef := range appliedTrafficPolicies {
+		metautils.AppendParent(ctx, virtualRouter, tpRef, v1.TrafficPolicy{}.GVK())
+	}
+
+	awsName := t.clusterDomains.GetLocalFQDN(kubeService.GetRef())
+	virtualService := &appmeshv1beta2.VirtualService{
+		ObjectMeta: metautils.TranslatedObjectMeta(
+			kubeService.GetRef(),
+			destination.Annotations,
+		),
+		Spec: appmeshv1beta2.VirtualServiceSpec{
+			// clients address the VirtualService by its Kubernetes DNS name
+			AWSName: &awsName,
+			Provider: &appmeshv1beta2.VirtualServiceProvider{
+				VirtualRouter: &appmeshv1beta2.VirtualRouterServiceProvider{
+					VirtualRouterRef: &appmeshv1beta2.VirtualRouterReference{
+						Name:      virtualRouter.GetName(),
+						Namespace: &virtualRouter.Namespace,
+					},
+				},
+			},
+		},
+	}
+
+	outputs.AddVirtualRouters(virtualRouter)
+	outputs.AddVirtualServices(virtualService)
+}
+
+// translate a Route for each request matcher of each applied TrafficPolicy.
+// returns the Routes along with the TrafficPolicies that were translated into them.
+func (t *translator) translateRoutes(
+	in input.LocalSnapshot,
+	destination *discoveryv1.Destination,
+	protocol appmeshv1beta2.PortProtocol,
+	defaultTargets []appmeshv1beta2.WeightedTarget,
+	reporter reporting.Reporter,
+) ([]appmeshv1beta2.Route, []*skv2corev1.ObjectRef) {
+	var matchedRoutes []matchedRoute
+	var catchAllRoutes []appmeshv1beta2.Route
+	var appliedTrafficPolicies []*skv2corev1.ObjectRef
+
+	// applied TrafficPolicies are sorted in order of increasing priority,
+	// while App Mesh gives precedence to the route with the lowest priority number
+	appliedPolicies := destination.Status.GetAppliedTrafficPolicies()
+	for i := len(appliedPolicies) - 1; i >= 0; i-- {
+		tp := appliedPolicies[i]
+		if !validateTrafficPolicy(tp, destination, protocol, reporter) {
+			continue
+		}
+
+		targets, err := translateWeightedTargets(in, destination, tp.GetRef(), tp.GetSpec().GetPolicy().GetTrafficShift())
+		if err != nil {
+			reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), err)
+			continue
+		}
+		if targets == nil {
+			targets = defaultTargets
+		}
+
+		matchers := tp.GetSpec().GetHttpRequestMatchers()
+		if len(matchers) == 0 {
+			route := translateRoute(appmeshutils.ChildName(tp.GetRef(), "0"), protocol, nil, targets, tp.GetSpec().GetPolicy())
+			catchAllRoutes = append(catchAllRoutes, route)
+		}
+		for idx, matcher := range matchers {
+			route := translateRoute(appmeshutils.ChildName(tp.GetRef(), fmt.Sprintf("%d", idx)), protocol, matcher, targets, tp.GetSpec().GetPolicy())
+			matchedRoutes = append(matchedRoutes, matchedRoute{
+				route:    route,
+				matcher:  matcher,
+				priority: tp.GetSpec().GetPriority(),
+			})
+		}
+
+		appliedTrafficPolicies = append(appliedTrafficPolicies, tp.GetRef())
+	}
+
+	// App Mesh does not order routes by specificity, so a broad matcher would shadow
+	// a more specific matcher of the same TrafficPolicy priority which follows it
+	sort.SliceStable(matchedRoutes, func(i, j int) bool {
+		if matchedRoutes[i].priority != matchedRoutes[j].priority {
+			return matchedRoutes[i].priority > matchedRoutes[j].priority
+		}
+		return isMatcherMoreSpecific(matchedRoutes[i].matcher, matchedRoutes[j].matcher)
+	})
+
+	// routes with request matchers take precedence over routes without
+	var routes []appmeshv1beta2.Route
+	for _, matched := range matchedRoutes {
+		routes = append(routes, matched.route)
+	}
+	routes = append(routes, catchAllRoutes...)
+	for i := range routes {
+		priority := int64(i)
+		if priority > defaultRoutePriority {
+			priority = defaultRoutePriority
+		}
+		routes[i].Priority = &priority
+	}
+
+	if len(catchAllRoutes) == 0 {
+		defaultRoute := translateRoute(defaultRouteName, protocol, nil, defaultTargets, nil)
+		priority := int64(defaultRoutePriority)
+		defaultRoute.Priority = &priority
+		routes = append(routes, defaultRoute)
+	}
+
+	return routes, appliedTrafficPolicies
+}
+
+// a Route translated from a request matcher, along with the priority of its TrafficPolicy
+type matchedRoute struct {
+	route    appmeshv1beta2.Route
+	matcher  *v1.HttpMatcher
+	priority int32
+}
+
+// returns true if matcher a matches a subset of the requests matched by matcher b,
+// considering only the fields supported by App Mesh
+func isMatcherMoreSpecific(a, b *v1.HttpMatcher) bool {
+	if prefixA, prefixB := a.GetUri().GetPrefix(), b.GetUri().GetPrefix(); len(prefixA) != len(prefixB) {
+		return len(prefixA) > len(prefixB)
+	}
+	if headersA, headersB := len(a.GetHeaders()), len(b.GetHeaders()); headersA != headersB {
+		return headersA > headersB
+	}
+	return a.GetMethod() != "" && b.GetMethod() == ""
+}
+
+// matcher and policy may be nil. The matcher is assumed to have been validated.
+func translateRoute(
+	name string,
+	protocol appmeshv1beta2.PortProtocol,
+	matcher *v1.HttpMatcher,
+	targets []appmeshv1beta2.WeightedTarget,
+	policy *v1.TrafficPolicySpec_Policy,
+) appmeshv1beta2.Route {
+	route := appmeshv1beta2.Route{
+		Name: name,
+	}
+
+	switch protocol {
+	case appmeshv1beta2.PortProtocolHTTP, appmeshv1beta2.PortProtocolHTTP2:
+		httpRoute := &appmeshv1beta2.HTTPRoute{
+			Match:       translateHTTPRouteMatch(matcher),
+			Action:      appmeshv1beta2.HTTPRouteAction{WeightedTargets: targets},
+			RetryPolicy: translateHTTPRetryPolicy(policy.GetRetries()),
+		}
+		if timeout := policy.GetRequestTimeout(); timeout != nil {
+			perRequest := appmeshutils.Duration(timeout)
+			httpRoute.Timeout = &appmeshv1beta2.HTTPTimeout{PerRequest: &perRequest}
+		}
+		if protocol == appmeshv1beta2.PortProtocolHTTP2 {
+			route.HTTP2Route = httpRoute
+		} else {
+			route.HTTPRoute = httpRoute
+		}
+	case appmeshv1beta2.PortProtocolGRPC:
+		grpcRoute := &appmeshv1beta2.GRPCRoute{
+			Action:      appmeshv1beta2.GRPCRouteAction{WeightedTargets: targets},
+			RetryPolicy: translateGRPCRetryPolicy(policy.GetRetries()),
+		}
+		if timeout := policy.GetRequestTimeout(); timeout != nil {
+			perRequest := appmeshutils.Duration(timeout)
+			grpcRoute.Timeout = &appmeshv1beta2.GRPCTimeout{PerRequest: &perRequest}
+		}
+		route.GRPCRoute = grpcRoute
+	default:
+		route.TCPRoute = &appmeshv1beta2.TCPRoute{
+			Action: appmeshv1beta2.TCPRouteAction{WeightedTargets: targets},
+		}
+	}
+
+	return route
+}
+
+func translateHTTPRouteMatch(matcher *v1.HttpMatcher) appmeshv1beta2.HTTPRouteMatch {
+	// App Mesh requires a prefix on every HTTP route
+	routeMatch := appmeshv1beta2.HTTPRouteMatch{
+		Prefix: "/",
+	}
+	if matcher == nil {
+		return routeMatch
+	}
+
+	if prefix := matcher.GetUri().GetPrefix(); prefix != "" {
+		routeMatch.Prefix = prefix
+	}
+
+	if method := matcher.GetMethod(); method != "" {
+		method = strings.ToUpper(method)
+		routeMatch.Method = &method
+	}
+
+	for _, headerMatcher := range matcher.GetHeaders() {
+		routeHeader := appmeshv1beta2.HTTPRouteHeader{
+			Name: headerMatcher.GetName(),
+		}
+		if value := headerMatcher.GetValue(); value != "" {
+			if headerMatcher.GetRegex() {
+				routeHeader.Match = &appmeshv1beta2.HeaderMatchMethod{Regex: &value}
+			} else {
+				routeHeader.Match = &appmeshv1beta2.HeaderMatchMethod{Exact: &value}
+			}
+		}
+		if headerMatcher.GetInvertMatch() {
+			invert := true
+			routeHeader.Invert = &invert
+		}
+		routeMatch.Headers = append(routeMatch.Headers, routeHeader)
+	}
+
+	return routeMatch
+}
+
+func translateHTTPRetryPolicy(retries *v1.TrafficPolicySpec_Policy_RetryPolicy) *appmeshv1beta2.HTTPRetryPolicy {
+	if retries == nil {
+		return nil
+	}
+	return &appmeshv1beta2.HTTPRetryPolicy{
+		HTTPRetryEvents: defaultHTTPRetryEvents,
+		TCPRetryEvents:  defaultTCPRetryEvents,
+		MaxRetries:      int64(retries.GetAttempts()),
+		PerRetryTimeout: perRetryTimeout(retries),
+	}
+}
+
+func translateGRPCRetryPolicy(retries *v1.TrafficPolicySpec_Policy_RetryPolicy) *appmeshv1beta2.GRPCRetryPolicy {
+	if retries == nil {
+		return nil
+	}
+	return &appmeshv1beta2.GRPCRetryPolicy{
+		HTTPRetryEvents: defaultHTTPRetryEvents,
+		TCPRetryEvents:  defaultTCPRetryEvents,
+		MaxRetries:      int64(retries.GetAttempts()),
+		PerRetryTimeout: perRetryTimeout(retries),
+	}
+}
+
+func perRetryTimeout(retries *v1.TrafficPolicySpec_Policy_RetryPolicy) appmeshv1beta2.Duration {
+	if perTryTimeout := retries.GetPerTryTimeout(); perTryTimeout != nil {
+		return appmeshutils.Duration(perTryTimeout)
+	}
+	return appmeshutils.Duration(ptypes.DurationProto(defaultPerRetryTimeout))
+}
+
+// translate the traffic shift destinations into weighted VirtualNode targets.
+// returns nil if the TrafficPolicy does not shift traffic.
+func translateWeightedTargets(
+	in input.LocalSnapshot,
+	destination *discoveryv1.Destination,
+	tpRef *skv2corev1.ObjectRef,
+	trafficShift *v1.TrafficPolicySpec_Policy_MultiDestination,
+) ([]appmeshv1beta2.WeightedTarget, error) {
+	if len(trafficShift.GetDestinations()) == 0 {
+		return nil, nil
+	}
+
+	kubeService := destination.Spec.GetKubeService()
+
+	var targets []appmeshv1beta2.WeightedTarget
+	for idx, weightedDestination := range trafficShift.GetDestinations() {
+		kubeDestination := weightedDestination.GetKubeService()
+		if kubeDestination == nil {
+			return nil, split.NewUnsupportedFeatureError(
+				tpRef,
+				fmt.Sprintf("TrafficShift.Destinations[%d]", idx),
+				"App Mesh only supports traffic shifting to Kubernetes services",
+			)
+		}
+
+		if kubeDestination.GetClusterName() != kubeService.GetRef().GetClusterName() {
+			return nil, split.NewUnsupportedFeatureError(
+				tpRef,
+				fmt.Sprintf("TrafficShift.Destinations[%d].ClusterName", idx),
+				"App Mesh does not currently support multi cluster traffic shifting",
+			)
+		}
+
+		shiftDestination, err := destinationutils.FindDestinationForKubeService(in.Destinations().List(), &skv2corev1.ClusterObjectRef{
+			Name:        kubeDestination.GetName(),
+			Namespace:   kubeDestination.GetNamespace(),
+			ClusterName: kubeDestination.GetClusterName(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if port := kubeDestination.GetPort(); port != 0 {
+			shiftPorts := shiftDestination.Spec.GetKubeService().GetPorts()
+			if len(shiftPorts) == 0 || shiftPorts[0].GetPort() != port {
+				return nil, split.NewUnsupportedFeatureError(
+					tpRef,
+					fmt.Sprintf("TrafficShift.Destinations[%d].Port", idx),
+					"App Mesh only routes to the first port of a Kubernetes service",
+				)
+			}
+		}
+
+		workloads := backingVirtualNodes(in, shiftDestination.Spec.GetKubeService(), kubeDestination.GetSubset())
+		if len(workloads) == 0 {
+			return nil, eris.Errorf("no App Mesh workloads found for traffic shift destination %s with subset %v",
+				sets.Key(shiftDestination.Spec.GetKubeService().GetRef()), kubeDestination.GetSubset())
+		}
+
+		targets = append(targets, makeWeightedTargets(workloads, weightedDestination.GetWeight())...)
+	}
+
+	if len(targets) > maxWeightedTargets {
+		return nil, eris.Errorf("App Mesh supports at most %d weighted targets per route, traffic shift resolves to %d workloads",
+			maxWeightedTargets, len(targets))
+	}
+
+	return targets, nil
+}
+
+// find the App Mesh Workloads backing the given service, optionally filtered by subset labels
+func backingVirtualNodes(
+	in input.LocalSnapshot,
+	kubeService *discoveryv1.DestinationSpec_KubeService,
+	subset map[string]string,
+) discoveryv1.WorkloadSlice {
+	var workloads discoveryv1.WorkloadSlice
+	for _, workload := range workloadutils.FindBackingWorkloads(kubeService, in.Workloads()) {
+		if !appmeshutils.IsAppMeshMesh(workload.Spec.GetMesh(), in.Meshes()) {
+			continue
+		}
+		if len(subset) > 0 && !labels.SelectorFromSet(subset).Matches(labels.Set(workload.Spec.GetKubernetes().GetPodLabels())) {
+			continue
+		}
+		workloads = append(workloads, workload)
+	}
+	return workloads
+}
+
+// split the given weight evenly between the VirtualNodes of the given workloads
+func makeWeightedTargets(workloads discoveryv1.WorkloadSlice, weight uint32) []appmeshv1beta2.WeightedTarget {
+	var targets []appmeshv1beta2.WeightedTarget
+	for i, workload := range workloads {
+		workloadWeight := weight / uint32(len(workloads))
+		if i == 0 {
+			workloadWeight += weight % uint32(len(workloads))
+		}
+		targets = append(targets, appmeshv1beta2.WeightedTarget{
+			VirtualNodeRef: appmeshutils.VirtualNodeRef(workload),
+			Weight:         int64(workloadWeight),
+		})
+	}
+	return targets
+}
+
+// report any features of the TrafficPolicy which cannot be translated to App Mesh.
+// returns false if the TrafficPolicy should not be translated.
+func validateTrafficPolicy(
+	tp *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+	destination *discoveryv1.Destination,
+	protocol appmeshv1beta2.PortProtocol,
+	reporter reporting.Reporter,
+) bool {
+	var errs []error
+	policy := tp.GetSpec().GetPolicy()
+
+	if len(tp.GetSpec().GetSourceSelector()) > 0 {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "SourceSelector", "App Mesh does not support source selectors for traffic policies"))
+	}
+	if policy.GetFaultInjection() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "FaultInjection", "App Mesh does not support fault injection"))
+	}
+	if policy.GetCorsPolicy() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "CorsPolicy", "App Mesh does not support cors policy"))
+	}
+	if policy.GetMirror() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "Mirror", "App Mesh does not support request mirroring"))
+	}
+	if policy.GetHeaderManipulation() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "HeaderManipulation", "App Mesh does not support header manipulation"))
+	}
+	if policy.GetOutlierDetection() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "OutlierDetection", "App Mesh does not support outlier detection"))
+	}
+	if policy.GetMtls() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "Mtls", "App Mesh does not support mTLS settings on traffic policies"))
+	}
+	if policy.GetCsrf() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "Csrf", "App Mesh does not support CSRF policy"))
+	}
+	if policy.GetRateLimit() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "RateLimit", "App Mesh does not support rate limiting"))
+	}
+	if policy.GetJwt() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "Jwt", "App Mesh does not support JWT policy"))
+	}
+	if policy.GetCanary() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "Canary", "App Mesh does not support canaries"))
+	}
+	if len(policy.GetRetries().GetRetryOn()) > 0 || len(policy.GetRetries().GetRetriableStatusCodes()) > 0 || len(policy.GetRetries().GetRetriableGrpcStatusCodes()) > 0 {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "Retries.RetryOn", "App Mesh retry conditions are not configurable on traffic policies"))
+	}
+	if policy.GetRetries().GetRetryRemoteLocalities() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "Retries.RetryRemoteLocalities", "App Mesh does not support retrying remote localities"))
+	}
+	if policy.GetLoadBalancer() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "LoadBalancer", "App Mesh does not support configuring the load balancing algorithm"))
+	}
+	if policy.GetConnectionPool() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "ConnectionPool", "App Mesh connection pools are not configurable on traffic policies"))
+	}
+	if len(tp.GetSpec().GetTcpRequestMatchers()) > 0 {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "TcpRequestMatchers", "App Mesh selects TCP routes by the protocol of the Destination port"))
+	}
+	if len(tp.GetSpec().GetTlsRequestMatchers()) > 0 {
+		errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "TlsRequestMatchers", "App Mesh does not support TLS request matchers"))
+	}
+
+	switch protocol {
+	case appmeshv1beta2.PortProtocolHTTP, appmeshv1beta2.PortProtocolHTTP2:
+		for idx, matcher := range tp.GetSpec().GetHttpRequestMatchers() {
+			errs = append(errs, validateHttpMatcher(tp.GetRef(), idx, matcher)...)
+		}
+	case appmeshv1beta2.PortProtocolGRPC:
+		if len(tp.GetSpec().GetHttpRequestMatchers()) > 0 {
+			errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "HttpRequestMatchers", "App Mesh does not support HTTP request matchers on gRPC routes"))
+		}
+	default:
+		if len(tp.GetSpec().GetHttpRequestMatchers()) > 0 {
+			errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "HttpRequestMatchers", "App Mesh does not support HTTP request matchers on TCP routes"))
+		}
+		if policy.GetRetries() != nil {
+			errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "Retries", "App Mesh does not support retries on TCP routes"))
+		}
+		if policy.GetRequestTimeout() != nil {
+			errs = append(errs, split.NewUnsupportedFeatureError(tp.GetRef(), "RequestTimeout", "App Mesh does not support request timeouts on TCP routes"))
+		}
+	}
+
+	for _, err := range errs {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), err)
+	}
+
+	return len(errs) == 0
+}
+
+func validateHttpMatcher(tpRef *skv2corev1.ObjectRef, idx int, matcher *v1.HttpMatcher) []error {
+	var errs []error
+	fieldName := func(field string) string {
+		return fmt.Sprintf("HttpRequestMatchers[%d].%s", idx, field)
+	}
+
+	uri := matcher.GetUri()
+	if uri.GetExact() != "" || uri.GetRegex() != "" || uri.GetSuffix() != "" {
+		errs = append(errs, split.NewUnsupportedFeatureError(tpRef, fieldName("Uri"), "App Mesh only supports prefix URI matching"))
+	}
+	if uri.GetIgnoreCase() {
+		errs = append(errs, split.NewUnsupportedFeatureError(tpRef, fieldName("Uri.IgnoreCase"), "App Mesh does not support case insensitive URI matching"))
+	}
+	if len(matcher.GetQueryParameters()) > 0 {
+		errs = append(errs, split.NewUnsupportedFeatureError(tpRef, fieldName("QueryParameters"), "App Mesh does not support query parameter matching"))
+	}
+	if matcher.GetGrpc() != nil {
+		errs = append(errs, split.NewUnsupportedFeatureError(tpRef, fieldName("Grpc"), "App Mesh does not support gRPC matchers on HTTP routes"))
+	}
+
+	return errs
+}
+
+// report any features of the AccessPolicy which cannot be translated to App Mesh.
+// AccessPolicies are translated into VirtualNode backends by the workload translator.
+func validateAccessPolicy(
+	ap *discoveryv1.DestinationStatus_AppliedAccessPolicy,
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) {
+	if len(ap.GetSpec().GetAllowedPaths()) > 0 {
+		reporter.ReportAccessPolicyToDestination(destination, ap.GetRef(), split.NewUnsupportedFeatureError(
+			ap.GetRef(),
+			"AllowedPaths",
+			"App Mesh does not support restricting access by path",
+		))
+	}
+	if len(ap.GetSpec().GetAllowedMethods()) > 0 {
+		reporter.ReportAccessPolicyToDestination(destination, ap.GetRef(), split.NewUnsupportedFeatureError(
+			ap.GetRef(),
+			"AllowedMethods",
+			"App Mesh does not support restricting access by method",
+		))
+	}
+	if len(ap.GetSpec().GetAllowedPorts()) > 0 {
+		reporter.ReportAccessPolicyToDestination(destination, ap.GetRef(), split.NewUnsupportedFeatureError(
+			ap.GetRef(),
+			"AllowedPorts",
+			"App Mesh does not support restricting access by port",
+		))
+	}
+	if ap.GetSpec().GetAction() == v1.AccessPolicySpec_DENY {
+		reporter.ReportAccessPolicyToDestination(destination, ap.GetRef(), split.NewUnsupportedFeatureError(
+			ap.GetRef(),
+			"Action",
+			"App Mesh VirtualNode backends cannot deny traffic",
+		))
+	}
+	if len(ap.GetSpec().GetConditions()) > 0 {
+		reporter.ReportAccessPolicyToDestination(destination, ap.GetRef(), split.NewUnsupportedFeatureError(
+			ap.GetRef(),
+			"Conditions",
+			"App Mesh does not support restricting access by request attributes",
+		))
+	}
+}
@@ -0,0 +1,395 @@
+package destination_test
+
+import (
+	"context"
+
+	appmeshv1beta2 "github.com/aws/aws-app-mesh-controller-for-k8s/apis/appmesh/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/appmesh"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/appmesh/destination"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	skv1alpha1sets "github.com/solo-io/skv2/pkg/api/multicluster.solo.io/v1alpha1/sets"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("AppmeshDestinationTranslator", func() {
+	var (
+		ctx          context.Context
+		ctrl         *gomock.Controller
+		mockReporter *mock_reporting.MockReporter
+		translator   Translator
+		outputs      appmesh.Builder
+
+		meshRef = &skv2corev1.ObjectRef{
+			Name:      "appmesh",
+			Namespace: "gloo-mesh",
+		}
+	)
+
+	makeWorkload := func(version string) *discoveryv1.Workload {
+		return &discoveryv1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "reviews-" + version,
+				Namespace: "gloo-mesh",
+			},
+			Spec: discoveryv1.WorkloadSpec{
+				Type: &discoveryv1.WorkloadSpec_Kubernetes{
+					Kubernetes: &discoveryv1.WorkloadSpec_KubernetesWorkload{
+						Controller: &skv2corev1.ClusterObjectRef{
+							Name:        "reviews-" + version,
+							Namespace:   "bookinfo",
+							ClusterName: "cluster",
+						},
+						PodLabels: map[string]string{
+							"app":     "reviews",
+							"version": version,
+						},
+					},
+				},
+				Mesh: meshRef,
+			},
+		}
+	}
+
+	virtualNodeRef := func(name string) *appmeshv1beta2.VirtualNodeReference {
+		namespace := "bookinfo"
+		return &appmeshv1beta2.VirtualNodeReference{
+			Name:      name,
+			Namespace: &namespace,
+		}
+	}
+
+	var (
+		destination *discoveryv1.Destination
+		in          input.LocalSnapshot
+	)
+
+	BeforeEach(func() {
+		ctrl, ctx = gomock.WithContext(context.Background(), GinkgoT())
+		mockReporter = mock_reporting.NewMockReporter(ctrl)
+		translator = NewTranslator(hostutils.NewClusterDomainRegistry(skv1alpha1sets.NewKubernetesClusterSet(), discoveryv1sets.NewDestinationSet()))
+		outputs = appmesh.NewBuilder(ctx, "test")
+
+		destination = &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "reviews-bookinfo-cluster",
+				Namespace: "gloo-mesh",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "reviews",
+							Namespace:   "bookinfo",
+							ClusterName: "cluster",
+						},
+						WorkloadSelectorLabels: map[string]string{"app": "reviews"},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port: 9080,
+								Name: "http",
+								TargetPort: &discoveryv1.DestinationSpec_KubeService_KubeServicePort_TargetPortNumber{
+									TargetPortNumber: 9080,
+								},
+							},
+						},
+					},
+				},
+				Mesh: meshRef,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	buildInput := func() input.LocalSnapshot {
+		return input.NewInputLocalSnapshotManualBuilder("").
+			AddMeshes([]*discoveryv1.Mesh{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      meshRef.Name,
+						Namespace: meshRef.Namespace,
+					},
+					Spec: discoveryv1.MeshSpec{
+						Type: &discoveryv1.MeshSpec_AwsAppMesh_{
+							AwsAppMesh: &discoveryv1.MeshSpec_AwsAppMesh{
+								AwsName:  "mesh",
+								Clusters: []string{"cluster"},
+							},
+						},
+					},
+				},
+			}).
+			AddDestinations([]*discoveryv1.Destination{destination}).
+			AddWorkloads([]*discoveryv1.Workload{makeWorkload("v1"), makeWorkload("v2")}).
+			Build()
+	}
+
+	It("should translate traffic shifts, retries and timeouts into a VirtualRouter", func() {
+		tpRef := &skv2corev1.ObjectRef{
+			Name:      "tp",
+			Namespace: "gloo-mesh",
+		}
+		destination.Status.AppliedTrafficPolicies = []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			{
+				Ref: tpRef,
+				Spec: &v1.TrafficPolicySpec{
+					HttpRequestMatchers: []*v1.HttpMatcher{
+						{
+							Uri: &commonv1.StringMatch{
+								MatchType: &commonv1.StringMatch_Prefix{Prefix: "/api"},
+							},
+							Headers: []*v1.HeaderMatcher{
+								{
+									Name:  "x-canary",
+									Value: "true",
+								},
+							},
+							Method: "get",
+						},
+					},
+					Policy: &v1.TrafficPolicySpec_Policy{
+						TrafficShift: &v1.TrafficPolicySpec_Policy_MultiDestination{
+							Destinations: []*v1.WeightedDestination{
+								{
+									DestinationType: &v1.WeightedDestination_KubeService{
+										KubeService: &v1.WeightedDestination_KubeDestination{
+											Name:        "reviews",
+											Namespace:   "bookinfo",
+											ClusterName: "cluster",
+											Subset:      map[string]string{"version": "v1"},
+										},
+									},
+									Weight: 75,
+								},
+								{
+									DestinationType: &v1.WeightedDestination_KubeService{
+										KubeService: &v1.WeightedDestination_KubeDestination{
+											Name:        "reviews",
+											Namespace:   "bookinfo",
+											ClusterName: "cluster",
+											Subset:      map[string]string{"version": "v2"},
+										},
+									},
+									Weight: 25,
+								},
+							},
+						},
+						RequestTimeout: &duration.Duration{Seconds: 2},
+						Retries: &v1.TrafficPolicySpec_Policy_RetryPolicy{
+							Attempts:      3,
+							PerTryTimeout: &duration.Duration{Nanos: 500000000},
+						},
+					},
+				},
+			},
+		}
+		in = buildInput()
+
+		translator.Translate(ctx, in, destination, outputs, mockReporter)
+
+		method := "GET"
+		headerValue := "true"
+		routePriority := int64(0)
+		defaultPriority := int64(1000)
+		namespace := "bookinfo"
+		awsName := "reviews.bookinfo.svc.cluster.local"
+
+		expectedRouter := &appmeshv1beta2.VirtualRouter{
+			ObjectMeta: metautils.TranslatedObjectMeta(destination.Spec.GetKubeService().GetRef(), nil),
+			Spec: appmeshv1beta2.VirtualRouterSpec{
+				Listeners: []appmeshv1beta2.VirtualRouterListener{{
+					PortMapping: appmeshv1beta2.PortMapping{Port: 9080, Protocol: appmeshv1beta2.PortProtocolHTTP},
+				}},
+				Routes: []appmeshv1beta2.Route{
+					{
+						Name: "tp-gloo-mesh-0",
+						HTTPRoute: &appmeshv1beta2.HTTPRoute{
+							Match: appmeshv1beta2.HTTPRouteMatch{
+								Prefix: "/api",
+								Method: &method,
+								Headers: []appmeshv1beta2.HTTPRouteHeader{
+									{
+										Name:  "x-canary",
+										Match: &appmeshv1beta2.HeaderMatchMethod{Exact: &headerValue},
+									},
+								},
+							},
+							Action: appmeshv1beta2.HTTPRouteAction{
+								WeightedTargets: []appmeshv1beta2.WeightedTarget{
+									{VirtualNodeRef: virtualNodeRef("reviews-v1"), Weight: 75},
+									{VirtualNodeRef: virtualNodeRef("reviews-v2"), Weight: 25},
+								},
+							},
+							RetryPolicy: &appmeshv1beta2.HTTPRetryPolicy{
+								HTTPRetryEvents: []appmeshv1beta2.HTTPRetryPolicyEvent{"server-error", "gateway-error"},
+								TCPRetryEvents:  []appmeshv1beta2.TCPRetryPolicyEvent{"connection-error"},
+								MaxRetries:      3,
+								PerRetryTimeout: appmeshv1beta2.Duration{Unit: appmeshv1beta2.DurationUnitMS, Value: 500},
+							},
+							Timeout: &appmeshv1beta2.HTTPTimeout{
+								PerRequest: &appmeshv1beta2.Duration{Unit: appmeshv1beta2.DurationUnitS, Value: 2},
+							},
+						},
+						Priority: &routePriority,
+					},
+					{
+						Name: "default",
+						HTTPRoute: &appmeshv1beta2.HTTPRoute{
+							Match: appmeshv1beta2.HTTPRouteMatch{Prefix: "/"},
+							Action: appmeshv1beta2.HTTPRouteAction{
+								WeightedTargets: []appmeshv1beta2.WeightedTarget{
+									{VirtualNodeRef: virtualNodeRef("reviews-v1"), Weight: 1},
+									{VirtualNodeRef: virtualNodeRef("reviews-v2"), Weight: 1},
+								},
+							},
+						},
+						Priority: &defaultPriority,
+					},
+				},
+			},
+		}
+		metautils.AppendParent(ctx, expectedRouter, tpRef, v1.TrafficPolicy{}.GVK())
+
+		expectedService := &appmeshv1beta2.VirtualService{
+			ObjectMeta: metautils.TranslatedObjectMeta(destination.Spec.GetKubeService().GetRef(), nil),
+			Spec: appmeshv1beta2.VirtualServiceSpec{
+				AWSName: &awsName,
+				Provider: &appmeshv1beta2.VirtualServiceProvider{
+					VirtualRouter: &appmeshv1beta2.VirtualRouterServiceProvider{
+						VirtualRouterRef: &appmeshv1beta2.VirtualRouterReference{
+							Name:      "reviews",
+							Namespace: &namespace,
+						},
+					},
+				},
+			},
+		}
+
+		Expect(outputs.GetVirtualRouters().List()).To(ConsistOf(expectedRouter))
+		Expect(outputs.GetVirtualServices().List()).To(ConsistOf(expectedService))
+	})
+
+	It("should order routes by TrafficPolicy priority and then by the specificity of their request matchers", func() {
+		prefixMatcher := func(prefix string) *v1.HttpMatcher {
+			return &v1.HttpMatcher{
+				Uri: &commonv1.StringMatch{
+					MatchType: &commonv1.StringMatch_Prefix{Prefix: prefix},
+				},
+			}
+		}
+		destination.Status.AppliedTrafficPolicies = []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			{
+				Ref: &skv2corev1.ObjectRef{Name: "low", Namespace: "gloo-mesh"},
+				Spec: &v1.TrafficPolicySpec{
+					HttpRequestMatchers: []*v1.HttpMatcher{prefixMatcher("/api/v2")},
+				},
+			},
+			{
+				Ref: &skv2corev1.ObjectRef{Name: "high", Namespace: "gloo-mesh"},
+				Spec: &v1.TrafficPolicySpec{
+					Priority:            1,
+					HttpRequestMatchers: []*v1.HttpMatcher{prefixMatcher("/api"), prefixMatcher("/api/v1")},
+				},
+			},
+		}
+		in = buildInput()
+
+		translator.Translate(ctx, in, destination, outputs, mockReporter)
+
+		routers := outputs.GetVirtualRouters().List()
+		Expect(routers).To(HaveLen(1))
+
+		type namedRoute struct {
+			name     string
+			priority int64
+		}
+		var routes []namedRoute
+		for _, route := range routers[0].Spec.Routes {
+			routes = append(routes, namedRoute{name: route.Name, priority: *route.Priority})
+		}
+		Expect(routes).To(Equal([]namedRoute{
+			{name: "high-gloo-mesh-1", priority: 0},
+			{name: "high-gloo-mesh-0", priority: 1},
+			{name: "low-gloo-mesh-0", priority: 2},
+			{name: "default", priority: 1000},
+		}))
+	})
+
+	It("should report unsupported TrafficPolicy and AccessPolicy features", func() {
+		tpRef := &skv2corev1.ObjectRef{
+			Name:      "tp",
+			Namespace: "gloo-mesh",
+		}
+		apRef := &skv2corev1.ObjectRef{
+			Name:      "ap",
+			Namespace: "gloo-mesh",
+		}
+		destination.Status.AppliedTrafficPolicies = []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			{
+				Ref: tpRef,
+				Spec: &v1.TrafficPolicySpec{
+					HttpRequestMatchers: []*v1.HttpMatcher{
+						{
+							Uri: &commonv1.StringMatch{
+								MatchType: &commonv1.StringMatch_Exact{Exact: "/api"},
+							},
+						},
+					},
+					Policy: &v1.TrafficPolicySpec_Policy{
+						FaultInjection: &v1.TrafficPolicySpec_Policy_FaultInjection{},
+					},
+				},
+			},
+		}
+		destination.Status.AppliedAccessPolicies = []*discoveryv1.DestinationStatus_AppliedAccessPolicy{
+			{
+				Ref: apRef,
+				Spec: &v1.AccessPolicySpec{
+					AllowedMethods: []string{"GET"},
+				},
+			},
+		}
+		in = buildInput()
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, tpRef, gomock.Any()).
+			Times(2)
+		mockReporter.
+			EXPECT().
+			ReportAccessPolicyToDestination(destination, apRef, gomock.Any())
+
+		translator.Translate(ctx, in, destination, outputs, mockReporter)
+
+		// the invalid TrafficPolicy is not translated, leaving only the default route
+		routers := outputs.GetVirtualRouters().List()
+		Expect(routers).To(HaveLen(1))
+		Expect(routers[0].Spec.Routes).To(HaveLen(1))
+		Expect(routers[0].Spec.Routes[0].Name).To(Equal("default"))
+		Expect(routers[0].Annotations).To(BeNil())
+	})
+
+	It("should not translate non App Mesh Destinations", func() {
+		destination.Spec.Mesh = &skv2corev1.ObjectRef{Name: "istio", Namespace: "gloo-mesh"}
+		in = buildInput()
+
+		translator.Translate(ctx, in, destination, outputs, mockReporter)
+
+		Expect(outputs.GetVirtualRouters().Length()).To(Equal(0))
+		Expect(outputs.GetVirtualServices().Length()).To(Equal(0))
+	})
+})
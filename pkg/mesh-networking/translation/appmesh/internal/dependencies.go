@@ -0,0 +1,53 @@
+package internal
+
+import (
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/appmesh/destination"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/appmesh/mesh"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/appmesh/workload"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	skv1alpha1sets "github.com/solo-io/skv2/pkg/api/multicluster.solo.io/v1alpha1/sets"
+)
+
+//go:generate mockgen -source ./dependencies.go -destination mocks/dependencies.go
+
+// the DependencyFactory creates dependencies for the translator from a given snapshot
+// NOTE(ilackarms): private interface used here as it's not expected we'll need to
+// define our DependencyFactory anywhere else
+type DependencyFactory interface {
+	MakeMeshTranslator() mesh.Translator
+	MakeDestinationTranslator(
+		clusters skv1alpha1sets.KubernetesClusterSet,
+		destinations discoveryv1sets.DestinationSet,
+	) destination.Translator
+	MakeWorkloadTranslator(
+		clusters skv1alpha1sets.KubernetesClusterSet,
+		destinations discoveryv1sets.DestinationSet,
+	) workload.Translator
+}
+
+type dependencyFactoryImpl struct{}
+
+func NewDependencyFactory() DependencyFactory {
+	return dependencyFactoryImpl{}
+}
+
+func (d dependencyFactoryImpl) MakeMeshTranslator() mesh.Translator {
+	return mesh.NewTranslator()
+}
+
+func (d dependencyFactoryImpl) MakeDestinationTranslator(
+	clusters skv1alpha1sets.KubernetesClusterSet,
+	destinations discoveryv1sets.DestinationSet,
+) destination.Translator {
+	clusterDomains := hostutils.NewClusterDomainRegistry(clusters, destinations)
+	return destination.NewTranslator(clusterDomains)
+}
+
+func (d dependencyFactoryImpl) MakeWorkloadTranslator(
+	clusters skv1alpha1sets.KubernetesClusterSet,
+	destinations discoveryv1sets.DestinationSet,
+) workload.Translator {
+	clusterDomains := hostutils.NewClusterDomainRegistry(clusters, destinations)
+	return workload.NewTranslator(clusterDomains)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./dependencies.go
+
+// Package mock_internal is a generated GoMock package.
+package mock_internal
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	destination "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/appmesh/destination"
+	mesh "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/appmesh/mesh"
+	workload "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/appmesh/workload"
+	v1alpha1sets "github.com/solo-io/skv2/pkg/api/multicluster.solo.io/v1alpha1/sets"
+)
+
+// MockDependencyFactory is a mock of DependencyFactory interface.
+type MockDependencyFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependencyFactoryMockRecorder
+}
+
+// MockDependencyFactoryMockRecorder is the mock recorder for MockDependencyFactory.
+type MockDependencyFactoryMockRecorder struct {
+	mock *MockDependencyFactory
+}
+
+// NewMockDependencyFactory creates a new mock instance.
+func NewMockDependencyFactory(ctrl *gomock.Controller) *MockDependencyFactory {
+	mock := &MockDependencyFactory{ctrl: ctrl}
+	mock.recorder = &MockDependencyFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependencyFactory) EXPECT() *MockDependencyFactoryMockRecorder {
+	return m.recorder
+}
+
+// MakeDestinationTranslator mocks base method.
+func (m *MockDependencyFactory) MakeDestinationTranslator(clusters v1alpha1sets.KubernetesClusterSet, destinations v1sets.DestinationSet) destination.Translator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeDestinationTranslator", clusters, destinations)
+	ret0, _ := ret[0].(destination.Translator)
+	return ret0
+}
+
+// MakeDestinationTranslator indicates an expected call of MakeDestinationTranslator.
+func (mr *MockDependencyFactoryMockRecorder) MakeDestinationTranslator(clusters, destinations interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeDestinationTranslator", reflect.TypeOf((*MockDependencyFactory)(nil).MakeDestinationTranslator), clusters, destinations)
+}
+
+// MakeMeshTranslator mocks base method.
+func (m *MockDependencyFactory) MakeMeshTranslator() mesh.Translator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeMeshTranslator")
+	ret0, _ := ret[0].(mesh.Translator)
+	return ret0
+}
+
+// MakeMeshTranslator indicates an expected call of MakeMeshTranslator.
+func (mr *MockDependencyFactoryMockRecorder) MakeMeshTranslator() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeMeshTranslator", reflect.TypeOf((*MockDependencyFactory)(nil).MakeMeshTranslator))
+}
+
+// MakeWorkloadTranslator mocks base method.
+func (m *MockDependencyFactory) MakeWorkloadTranslator(clusters v1alpha1sets.KubernetesClusterSet, destinations v1sets.DestinationSet) workload.Translator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeWorkloadTranslator", clusters, destinations)
+	ret0, _ := ret[0].(workload.Translator)
+	return ret0
+}
+
+// MakeWorkloadTranslator indicates an expected call of MakeWorkloadTranslator.
+func (mr *MockDependencyFactoryMockRecorder) MakeWorkloadTranslator(clusters, destinations interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeWorkloadTranslator", reflect.TypeOf((*MockDependencyFactory)(nil).MakeWorkloadTranslator), clusters, destinations)
+}
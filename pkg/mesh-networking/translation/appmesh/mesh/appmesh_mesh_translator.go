@@ -0,0 +1,51 @@
+package mesh
+
+import (
+	"context"
+
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/appmesh"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+)
+
+//go:generate mockgen -source ./appmesh_mesh_translator.go -destination mocks/appmesh_mesh_translator.go
+
+// the App Mesh Mesh translator registers the clusters belonging to an App Mesh with the output snapshot.
+type Translator interface {
+	// Translate translates the appropriate resources for the given Mesh.
+	// Output resources will be added to the appmesh.Builder
+	// Errors caused by invalid user config will be reported using the Reporter.
+	Translate(
+		ctx context.Context,
+		in input.LocalSnapshot,
+		mesh *discoveryv1.Mesh,
+		outputs appmesh.Builder,
+		reporter reporting.Reporter,
+	)
+}
+
+type translator struct{}
+
+func NewTranslator() Translator {
+	return &translator{}
+}
+
+// translate the appropriate resources for the given Mesh.
+func (t *translator) Translate(
+	ctx context.Context,
+	in input.LocalSnapshot,
+	mesh *discoveryv1.Mesh,
+	outputs appmesh.Builder,
+	reporter reporting.Reporter,
+) {
+	appMesh := mesh.Spec.GetAwsAppMesh()
+	if appMesh == nil {
+		return
+	}
+
+	// an App Mesh can span multiple clusters, each of which runs its own App Mesh controller
+	for _, cluster := range appMesh.GetClusters() {
+		outputs.AddCluster(cluster)
+	}
+}
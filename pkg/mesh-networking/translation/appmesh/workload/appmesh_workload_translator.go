@@ -0,0 +1,159 @@
+package workload
+
+import (
+	"context"
+
+	appmeshv1beta2 "github.com/aws/aws-app-mesh-controller-for-k8s/apis/appmesh/v1beta2"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/appmesh"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/workloadutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/appmeshutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/selectorutils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//go:generate mockgen -source ./appmesh_workload_translator.go -destination mocks/appmesh_workload_translator.go
+
+// the App Mesh Workload translator translates a Workload into a VirtualNode.
+type Translator interface {
+	// Translate translates the appropriate VirtualNode for the given Workload.
+	// The VirtualNode's backends are the VirtualServices of the Destinations which the Workload
+	// is permitted to access by an AccessPolicy.
+	// Output resources will be added to the appmesh.Builder
+	// Errors caused by invalid user config will be reported using the Reporter.
+	Translate(
+		ctx context.Context,
+		in input.LocalSnapshot,
+		workload *discoveryv1.Workload,
+		outputs appmesh.Builder,
+		reporter reporting.Reporter,
+	)
+}
+
+type translator struct {
+	clusterDomains hostutils.ClusterDomainRegistry
+}
+
+func NewTranslator(clusterDomains hostutils.ClusterDomainRegistry) Translator {
+	return &translator{
+		clusterDomains: clusterDomains,
+	}
+}
+
+// translate the appropriate resources for the given Workload.
+func (t *translator) Translate(
+	ctx context.Context,
+	in input.LocalSnapshot,
+	workload *discoveryv1.Workload,
+	outputs appmesh.Builder,
+	reporter reporting.Reporter,
+) {
+	kubeWorkload := workload.Spec.GetKubernetes()
+
+	// only translate App Mesh Workloads backed by Kubernetes pods
+	if kubeWorkload == nil || !appmeshutils.IsAppMeshMesh(workload.Spec.GetMesh(), in.Meshes()) {
+		return
+	}
+
+	virtualNode := &appmeshv1beta2.VirtualNode{
+		ObjectMeta: metautils.TranslatedObjectMeta(
+			kubeWorkload.GetController(),
+			workload.Annotations,
+		),
+		Spec: appmeshv1beta2.VirtualNodeSpec{
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: kubeWorkload.GetPodLabels(),
+			},
+		},
+	}
+
+	t.translateListener(ctx, in, workload, virtualNode)
+
+	for _, destination := range in.Destinations().List() {
+		kubeService := destination.Spec.GetKubeService()
+		if kubeService == nil ||
+			kubeService.GetRef().GetClusterName() != kubeWorkload.GetController().GetClusterName() ||
+			!appmeshutils.IsAppMeshMesh(destination.Spec.GetMesh(), in.Meshes()) {
+			continue
+		}
+
+		allowed := false
+		for _, ap := range destination.Status.GetAppliedAccessPolicies() {
+			if !selectorutils.IdentityMatchesWorkload(ap.GetSpec().GetSourceSelector(), workload) {
+				continue
+			}
+			allowed = true
+			metautils.AppendParent(ctx, virtualNode, ap.GetRef(), v1.AccessPolicy{}.GVK())
+		}
+		if !allowed {
+			continue
+		}
+
+		virtualNode.Spec.Backends = append(virtualNode.Spec.Backends, appmeshv1beta2.Backend{
+			VirtualService: appmeshv1beta2.VirtualServiceBackend{
+				VirtualServiceRef: appmeshutils.VirtualServiceRef(kubeService.GetRef()),
+			},
+		})
+	}
+
+	outputs.AddVirtualNodes(virtualNode)
+}
+
+// populate the listener and service discovery of the VirtualNode from the Destination(s) the Workload backs.
+// Workloads which do not back any Destination are translated as client-only VirtualNodes.
+func (t *translator) translateListener(
+	ctx context.Context,
+	in input.LocalSnapshot,
+	workload *discoveryv1.Workload,
+	virtualNode *appmeshv1beta2.VirtualNode,
+) {
+	for _, destination := range in.Destinations().List() {
+		kubeService := destination.Spec.GetKubeService()
+		if kubeService == nil || len(kubeService.GetPorts()) == 0 {
+			continue
+		}
+		backingWorkloads := workloadutils.FindBackingWorkloads(kubeService, in.Workloads())
+		if !containsWorkload(backingWorkloads, workload) {
+			continue
+		}
+
+		// App Mesh VirtualNodes support a single listener, so only the first port of the first Destination is used
+		servicePort := kubeService.GetPorts()[0]
+		portMapping := appmeshv1beta2.PortMapping{
+			Port:     appmeshutils.TargetPort(servicePort),
+			Protocol: appmeshutils.PortProtocol(servicePort),
+		}
+		// prefer the container port declared on the workload, if discovered
+		if ports := workload.Spec.GetAppMesh().GetPorts(); len(ports) > 0 {
+			portMapping.Port = appmeshv1beta2.PortNumber(ports[0].GetPort())
+		}
+
+		virtualNode.Spec.Listeners = []appmeshv1beta2.Listener{{
+			PortMapping: portMapping,
+		}}
+		virtualNode.Spec.ServiceDiscovery = &appmeshv1beta2.ServiceDiscovery{
+			DNS: &appmeshv1beta2.DNSServiceDiscovery{
+				Hostname: t.clusterDomains.GetLocalFQDN(kubeService.GetRef()),
+			},
+		}
+		return
+	}
+
+	contextutils.LoggerFrom(ctx).Debugf("App Mesh Workload %v does not back any Destination, translating as client-only VirtualNode", sets.Key(workload))
+}
+
+func containsWorkload(workloads discoveryv1.WorkloadSlice, workload *discoveryv1.Workload) bool {
+	for _, w := range workloads {
+		if sets.Key(w) == sets.Key(workload) {
+			return true
+		}
+	}
+	return false
+}
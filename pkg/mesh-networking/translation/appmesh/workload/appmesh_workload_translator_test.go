@@ -0,0 +1,201 @@
+package workload_test
+
+import (
+	"context"
+
+	appmeshv1beta2 "github.com/aws/aws-app-mesh-controller-for-k8s/apis/appmesh/v1beta2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/appmesh"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/appmesh/workload"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	skv1alpha1sets "github.com/solo-io/skv2/pkg/api/multicluster.solo.io/v1alpha1/sets"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("AppmeshWorkloadTranslator", func() {
+	var (
+		ctx          context.Context
+		ctrl         *gomock.Controller
+		mockReporter *mock_reporting.MockReporter
+		translator   Translator
+		outputs      appmesh.Builder
+
+		meshRef = &skv2corev1.ObjectRef{
+			Name:      "appmesh",
+			Namespace: "gloo-mesh",
+		}
+	)
+
+	makeWorkload := func(name, serviceAccount string) *discoveryv1.Workload {
+		return &discoveryv1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "gloo-mesh",
+			},
+			Spec: discoveryv1.WorkloadSpec{
+				Type: &discoveryv1.WorkloadSpec_Kubernetes{
+					Kubernetes: &discoveryv1.WorkloadSpec_KubernetesWorkload{
+						Controller: &skv2corev1.ClusterObjectRef{
+							Name:        name,
+							Namespace:   "bookinfo",
+							ClusterName: "cluster",
+						},
+						PodLabels:          map[string]string{"app": name},
+						ServiceAccountName: serviceAccount,
+					},
+				},
+				Mesh: meshRef,
+			},
+		}
+	}
+
+	makeDestination := func(name string, accessPolicies ...*discoveryv1.DestinationStatus_AppliedAccessPolicy) *discoveryv1.Destination {
+		return &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name + "-bookinfo-cluster",
+				Namespace: "gloo-mesh",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        name,
+							Namespace:   "bookinfo",
+							ClusterName: "cluster",
+						},
+						WorkloadSelectorLabels: map[string]string{"app": name},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port: 9080,
+								Name: "http",
+								TargetPort: &discoveryv1.DestinationSpec_KubeService_KubeServicePort_TargetPortNumber{
+									TargetPortNumber: 8080,
+								},
+							},
+						},
+					},
+				},
+				Mesh: meshRef,
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedAccessPolicies: accessPolicies,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl, ctx = gomock.WithContext(context.Background(), GinkgoT())
+		mockReporter = mock_reporting.NewMockReporter(ctrl)
+		translator = NewTranslator(hostutils.NewClusterDomainRegistry(skv1alpha1sets.NewKubernetesClusterSet(), discoveryv1sets.NewDestinationSet()))
+		outputs = appmesh.NewBuilder(ctx, "test")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should translate AccessPolicies into VirtualNode backends", func() {
+		apRef := &skv2corev1.ObjectRef{
+			Name:      "ap",
+			Namespace: "gloo-mesh",
+		}
+		productpage := makeWorkload("productpage", "bookinfo-productpage")
+		ratings := makeWorkload("ratings", "bookinfo-ratings")
+
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddMeshes([]*discoveryv1.Mesh{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      meshRef.Name,
+						Namespace: meshRef.Namespace,
+					},
+					Spec: discoveryv1.MeshSpec{
+						Type: &discoveryv1.MeshSpec_AwsAppMesh_{
+							AwsAppMesh: &discoveryv1.MeshSpec_AwsAppMesh{
+								AwsName:  "mesh",
+								Clusters: []string{"cluster"},
+							},
+						},
+					},
+				},
+			}).
+			AddDestinations([]*discoveryv1.Destination{
+				makeDestination("productpage"),
+				// only productpage may access reviews
+				makeDestination("reviews", &discoveryv1.DestinationStatus_AppliedAccessPolicy{
+					Ref: apRef,
+					Spec: &v1.AccessPolicySpec{
+						SourceSelector: []*commonv1.IdentitySelector{
+							{
+								KubeServiceAccountRefs: &commonv1.IdentitySelector_KubeServiceAccountRefs{
+									ServiceAccounts: []*skv2corev1.ClusterObjectRef{
+										{
+											Name:        "bookinfo-productpage",
+											Namespace:   "bookinfo",
+											ClusterName: "cluster",
+										},
+									},
+								},
+							},
+						},
+					},
+				}),
+			}).
+			AddWorkloads([]*discoveryv1.Workload{productpage, ratings}).
+			Build()
+
+		translator.Translate(ctx, in, productpage, outputs, mockReporter)
+		translator.Translate(ctx, in, ratings, outputs, mockReporter)
+
+		namespace := "bookinfo"
+		expectedProductpage := &appmeshv1beta2.VirtualNode{
+			ObjectMeta: metautils.TranslatedObjectMeta(productpage.Spec.GetKubernetes().GetController(), nil),
+			Spec: appmeshv1beta2.VirtualNodeSpec{
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "productpage"},
+				},
+				Listeners: []appmeshv1beta2.Listener{{
+					PortMapping: appmeshv1beta2.PortMapping{Port: 8080, Protocol: appmeshv1beta2.PortProtocolHTTP},
+				}},
+				ServiceDiscovery: &appmeshv1beta2.ServiceDiscovery{
+					DNS: &appmeshv1beta2.DNSServiceDiscovery{
+						Hostname: "productpage.bookinfo.svc.cluster.local",
+					},
+				},
+				Backends: []appmeshv1beta2.Backend{
+					{
+						VirtualService: appmeshv1beta2.VirtualServiceBackend{
+							VirtualServiceRef: &appmeshv1beta2.VirtualServiceReference{
+								Name:      "reviews",
+								Namespace: &namespace,
+							},
+						},
+					},
+				},
+			},
+		}
+		metautils.AppendParent(ctx, expectedProductpage, apRef, v1.AccessPolicy{}.GVK())
+
+		// ratings does not back any Destination and may not access reviews
+		expectedRatings := &appmeshv1beta2.VirtualNode{
+			ObjectMeta: metautils.TranslatedObjectMeta(ratings.Spec.GetKubernetes().GetController(), nil),
+			Spec: appmeshv1beta2.VirtualNodeSpec{
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "ratings"},
+				},
+			},
+		}
+
+		Expect(outputs.GetVirtualNodes().List()).To(ConsistOf(expectedProductpage, expectedRatings))
+	})
+})
@@ -1,49 +1,49 @@
-package osm
+package consul
 
 import (
 	"context"
 	"fmt"
 
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
-	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/smi"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/consul"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
-	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/osm/internal"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/internal"
 	"github.com/solo-io/go-utils/contextutils"
 )
 
 var DefaultDependencyFactory = internal.NewDependencyFactory()
 
-// the smi translator translates an input networking snapshot to an output snapshot of SMI resources
+// the consul translator translates an input networking snapshot to an output snapshot of Consul config entries
 type Translator interface {
-	// Translate translates the appropriate resources to apply input configuration resources for all OSM meshes contained in the input snapshot.
-	// Output resources will be added to the smi.Builder
+	// Translate translates the appropriate resources to apply input configuration resources for all Consul Connect meshes contained in the input snapshot.
+	// Output resources will be added to the consul.Builder
 	// Errors caused by invalid user config will be reported using the Reporter.
 	Translate(
 		ctx context.Context,
 		in input.LocalSnapshot,
-		outputs smi.Builder,
+		outputs consul.Builder,
 		reporter reporting.Reporter,
 	)
 }
 
-type osmTranslator struct {
-	totalTranslates int // TODO(ilackarms): metric
+type consulTranslator struct {
+	totalTranslates int
 	dependencies    internal.DependencyFactory
 }
 
-func NewOSMTranslator() Translator {
-	return &osmTranslator{
+func NewConsulTranslator() Translator {
+	return &consulTranslator{
 		dependencies: internal.NewDependencyFactory(),
 	}
 }
 
-func (s *osmTranslator) Translate(
+func (s *consulTranslator) Translate(
 	ctx context.Context,
 	in input.LocalSnapshot,
-	outputs smi.Builder,
+	outputs consul.Builder,
 	reporter reporting.Reporter,
 ) {
-	ctx = contextutils.WithLogger(ctx, fmt.Sprintf("osm-translator-%v", s.totalTranslates))
+	ctx = contextutils.WithLogger(ctx, fmt.Sprintf("consul-translator-%v", s.totalTranslates))
 
 	meshTranslator := s.dependencies.MakeMeshTranslator()
 
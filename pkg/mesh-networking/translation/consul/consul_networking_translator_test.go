@@ -1,4 +1,4 @@
-package osm
+package consul
 
 import (
 	"context"
@@ -7,15 +7,15 @@ import (
 	. "github.com/onsi/ginkgo"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
-	mock_output "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/smi/mocks"
+	mock_output "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/consul/mocks"
 	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
-	mock_destination "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/osm/destination/mocks"
-	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/osm/internal/mocks"
-	mock_mesh "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/osm/mesh/mocks"
+	mock_destination "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/mocks"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/internal/mocks"
+	mock_mesh "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/mesh/mocks"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-var _ = Describe("SmiNetworkingTranslator", func() {
+var _ = Describe("ConsulNetworkingTranslator", func() {
 	var (
 		ctrl                      *gomock.Controller
 		ctx                       context.Context
@@ -24,7 +24,7 @@ var _ = Describe("SmiNetworkingTranslator", func() {
 		mockDependencyFactory     *MockDependencyFactory
 		mockMeshTranslator        *mock_mesh.MockTranslator
 		mockDestinationTranslator *mock_destination.MockTranslator
-		translator                *osmTranslator
+		translator                *consulTranslator
 	)
 
 	BeforeEach(func() {
@@ -35,7 +35,7 @@ var _ = Describe("SmiNetworkingTranslator", func() {
 		mockOutputs = mock_output.NewMockBuilder(ctrl)
 		mockMeshTranslator = mock_mesh.NewMockTranslator(ctrl)
 		mockDestinationTranslator = mock_destination.NewMockTranslator(ctrl)
-		translator = &osmTranslator{dependencies: mockDependencyFactory}
+		translator = &consulTranslator{dependencies: mockDependencyFactory}
 	})
 
 	AfterEach(func() {
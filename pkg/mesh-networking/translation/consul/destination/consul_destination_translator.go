@@ -0,0 +1,100 @@
+package destination
+
+import (
+	"context"
+
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/consul"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/serviceintentions"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/servicerouter"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/servicesplitter"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+)
+
+//go:generate mockgen -source ./consul_destination_translator.go -destination mocks/consul_destination_translator.go
+
+// translates a Destination into Consul config entries.
+type Translator interface {
+	// Translate translates the appropriate ServiceSplitter, ServiceRouter and ServiceIntentions for the given Destination.
+	// Output resources will be added to the consul.Builder
+	// Errors caused by invalid user config will be reported using the Reporter.
+	Translate(
+		ctx context.Context,
+		in input.LocalSnapshot,
+		destination *discoveryv1.Destination,
+		outputs consul.Builder,
+		reporter reporting.Reporter,
+	)
+}
+
+type translator struct {
+	serviceSplitterTranslator   servicesplitter.Translator
+	serviceRouterTranslator     servicerouter.Translator
+	serviceIntentionsTranslator serviceintentions.Translator
+}
+
+func NewTranslator(
+	serviceSplitterTranslator servicesplitter.Translator,
+	serviceRouterTranslator servicerouter.Translator,
+	serviceIntentionsTranslator serviceintentions.Translator,
+) Translator {
+	return &translator{
+		serviceSplitterTranslator:   serviceSplitterTranslator,
+		serviceRouterTranslator:     serviceRouterTranslator,
+		serviceIntentionsTranslator: serviceIntentionsTranslator,
+	}
+}
+
+// translate the appropriate resources for the given Destination.
+func (t *translator) Translate(
+	ctx context.Context,
+	in input.LocalSnapshot,
+	destination *discoveryv1.Destination,
+	outputs consul.Builder,
+	reporter reporting.Reporter,
+) {
+	// only translate Consul Connect Destinations
+	if !t.isConsulDestination(ctx, destination, in.Meshes()) {
+		return
+	}
+
+	serviceSplitter := t.serviceSplitterTranslator.Translate(ctx, in, destination, reporter)
+	serviceRouter := t.serviceRouterTranslator.Translate(ctx, in, destination, reporter)
+	serviceIntentions := t.serviceIntentionsTranslator.Translate(ctx, in, destination, reporter)
+
+	if serviceSplitter != nil {
+		outputs.AddServiceSplitters(serviceSplitter)
+	}
+	if serviceRouter != nil {
+		outputs.AddServiceRouters(serviceRouter)
+	}
+	if serviceIntentions != nil {
+		outputs.AddServiceIntentions(serviceIntentions)
+	}
+}
+
+func (t *translator) isConsulDestination(
+	ctx context.Context,
+	destination *discoveryv1.Destination,
+	allMeshes discoveryv1sets.MeshSet,
+) bool {
+	meshRef := destination.Spec.Mesh
+	if meshRef == nil {
+		if _, ok := destination.Spec.Type.(*discoveryv1.DestinationSpec_KubeService_); ok {
+			// Is KubeService, MeshRef is required
+			contextutils.LoggerFrom(ctx).Debugf("unexpected state: KubeService destination %v missing mesh ref", sets.Key(destination))
+		}
+		return false
+	}
+	mesh, err := allMeshes.Find(meshRef)
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Debugf("unexpected state: could not find mesh %v for destination %v", sets.Key(meshRef), sets.Key(destination))
+		return false
+	}
+
+	return mesh.Spec.GetConsulConnect() != nil
+}
@@ -0,0 +1,104 @@
+package destination_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	consulv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	mock_output "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/consul/mocks"
+	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination"
+	mock_serviceintentions "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/serviceintentions/mocks"
+	mock_servicerouter "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/servicerouter/mocks"
+	mock_servicesplitter "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/servicesplitter/mocks"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ConsulDestinationTranslator", func() {
+	var (
+		ctx                             context.Context
+		ctrl                            *gomock.Controller
+		mockOutputs                     *mock_output.MockBuilder
+		mockReporter                    *mock_reporting.MockReporter
+		mockServiceSplitterTranslator   *mock_servicesplitter.MockTranslator
+		mockServiceRouterTranslator     *mock_servicerouter.MockTranslator
+		mockServiceIntentionsTranslator *mock_serviceintentions.MockTranslator
+		consulDestinationTranslator     Translator
+	)
+
+	BeforeEach(func() {
+		ctrl, ctx = gomock.WithContext(context.Background(), GinkgoT())
+		mockOutputs = mock_output.NewMockBuilder(ctrl)
+		mockReporter = mock_reporting.NewMockReporter(ctrl)
+		mockServiceSplitterTranslator = mock_servicesplitter.NewMockTranslator(ctrl)
+		mockServiceRouterTranslator = mock_servicerouter.NewMockTranslator(ctrl)
+		mockServiceIntentionsTranslator = mock_serviceintentions.NewMockTranslator(ctrl)
+		consulDestinationTranslator = NewTranslator(
+			mockServiceSplitterTranslator,
+			mockServiceRouterTranslator,
+			mockServiceIntentionsTranslator,
+		)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should not translate when not a consul destination", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		destination := &v1.Destination{}
+
+		consulDestinationTranslator.Translate(ctx, in, destination, mockOutputs, mockReporter)
+	})
+
+	It("should translate when a consul destination", func() {
+		destination := &v1.Destination{
+			Spec: v1.DestinationSpec{
+				Mesh: &skv2corev1.ObjectRef{
+					Name:      "hello",
+					Namespace: "world",
+				},
+			},
+		}
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddMeshes([]*v1.Mesh{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      destination.Spec.GetMesh().GetName(),
+						Namespace: destination.Spec.GetMesh().GetNamespace(),
+					},
+					Spec: v1.MeshSpec{
+						Type: &v1.MeshSpec_ConsulConnect{
+							ConsulConnect: &v1.MeshSpec_ConsulConnectMesh{},
+						},
+					},
+				},
+			}).
+			Build()
+
+		serviceSplitter := &consulv1alpha1.ServiceSplitter{}
+		serviceIntentions := &consulv1alpha1.ServiceIntentions{}
+
+		mockServiceSplitterTranslator.
+			EXPECT().
+			Translate(gomock.AssignableToTypeOf(ctx), in, destination, mockReporter).
+			Return(serviceSplitter)
+		mockServiceRouterTranslator.
+			EXPECT().
+			Translate(gomock.AssignableToTypeOf(ctx), in, destination, mockReporter).
+			Return(nil)
+		mockServiceIntentionsTranslator.
+			EXPECT().
+			Translate(gomock.AssignableToTypeOf(ctx), in, destination, mockReporter).
+			Return(serviceIntentions)
+
+		mockOutputs.EXPECT().AddServiceSplitters(serviceSplitter)
+		mockOutputs.EXPECT().AddServiceIntentions(serviceIntentions)
+
+		consulDestinationTranslator.Translate(ctx, in, destination, mockOutputs, mockReporter)
+	})
+})
@@ -0,0 +1,242 @@
+package serviceintentions
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	consulv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/smi/destination/split"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+)
+
+//go:generate mockgen -source ./service_intentions_translator.go -destination mocks/service_intentions_translator.go
+
+const (
+	intentionActionAllow = "allow"
+	// matches all Consul services
+	wildcardService = "*"
+)
+
+// the ServiceIntentions Translator translates a Destination into a Consul ServiceIntentions.
+type Translator interface {
+	// Translate translates the appropriate ServiceIntentions for the given Destination.
+	// returns nil if no ServiceIntentions are required
+	//
+	// Errors caused by invalid user config will be reported using the Reporter.
+	//
+	// Note that the input snapshot DestinationSet contains the given Destination.
+	Translate(
+		ctx context.Context,
+		in input.LocalSnapshot,
+		destination *discoveryv1.Destination,
+		reporter reporting.Reporter,
+	) *consulv1alpha1.ServiceIntentions
+}
+
+func NewTranslator() Translator {
+	return &translator{}
+}
+
+type translator struct{}
+
+func (t *translator) Translate(
+	ctx context.Context,
+	_ input.LocalSnapshot,
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) *consulv1alpha1.ServiceIntentions {
+	kubeService := destination.Spec.GetKubeService()
+
+	if kubeService == nil {
+		// non kube services currently unsupported
+		return nil
+	}
+
+	if len(destination.Status.GetAppliedAccessPolicies()) == 0 {
+		return nil
+	}
+
+	serviceIntentions := &consulv1alpha1.ServiceIntentions{
+		ObjectMeta: metautils.TranslatedObjectMeta(
+			kubeService.GetRef(),
+			destination.Annotations,
+		),
+		Spec: consulv1alpha1.ServiceIntentionsSpec{
+			Destination: &consulv1alpha1.ServiceIntentionsSpec_IntentionDestination{
+				Name: kubeService.GetRef().GetName(),
+			},
+		},
+	}
+
+	// Consul allows only one ServiceIntentions per destination service, and one intention per source,
+	// so the sources from all AccessPolicies are merged
+	sources := newSourceIntentions()
+
+	for _, ap := range destination.Status.GetAppliedAccessPolicies() {
+		sourceNames, err := translateSourceNames(ap.GetSpec().GetSourceSelector(), kubeService.GetRef().GetClusterName())
+		if err != nil {
+			reporter.ReportAccessPolicyToDestination(destination, ap.GetRef(), err)
+			continue
+		}
+
+		if len(ap.GetSpec().GetAllowedPorts()) > 0 {
+			reporter.ReportAccessPolicyToDestination(destination, ap.GetRef(), split.NewUnsupportedFeatureError(
+				ap.GetRef(),
+				"AllowedPorts",
+				"Consul intentions cannot be scoped to specific ports",
+			))
+			continue
+		}
+
+		if ap.GetSpec().GetAction() == v1.AccessPolicySpec_DENY {
+			reporter.ReportAccessPolicyToDestination(destination, ap.GetRef(), split.NewUnsupportedFeatureError(
+				ap.GetRef(),
+				"Action",
+				"Consul intentions are merged per source, so DENY AccessPolicies are not supported",
+			))
+			continue
+		}
+
+		if len(ap.GetSpec().GetConditions()) > 0 {
+			reporter.ReportAccessPolicyToDestination(destination, ap.GetRef(), split.NewUnsupportedFeatureError(
+				ap.GetRef(),
+				"Conditions",
+				"Consul intentions cannot be scoped by request conditions",
+			))
+			continue
+		}
+
+		permissions := translatePermissions(ap.GetSpec())
+		for _, sourceName := range sourceNames {
+			sources.add(sourceName, permissions)
+		}
+
+		metautils.AppendParent(ctx, serviceIntentions, ap.GetRef(), v1.AccessPolicy{}.GVK())
+	}
+
+	if len(sources.list) == 0 {
+		return nil
+	}
+
+	serviceIntentions.Spec.Sources = sources.list
+
+	return serviceIntentions
+}
+
+// consul-k8s requires the ServiceAccount of a Connect workload to match its Consul service name,
+// so ServiceAccount names can be used as intention sources
+func translateSourceNames(
+	sourceSelectors []*commonv1.IdentitySelector,
+	clusterName string,
+) ([]string, error) {
+	if len(sourceSelectors) == 0 {
+		return []string{wildcardService}, nil
+	}
+
+	var sourceNames []string
+	for _, sourceSelector := range sourceSelectors {
+		if kubeIdentityMatcher := sourceSelector.GetKubeIdentityMatcher(); kubeIdentityMatcher != nil {
+			if len(kubeIdentityMatcher.GetNamespaces()) > 0 || len(kubeIdentityMatcher.GetClusters()) > 0 {
+				return nil, eris.New("Consul does not support selecting sources by namespace or cluster")
+			}
+			sourceNames = append(sourceNames, wildcardService)
+		}
+
+		for _, ref := range sourceSelector.GetKubeServiceAccountRefs().GetServiceAccounts() {
+			if ref.GetClusterName() != clusterName {
+				return nil, eris.New("Consul intention sources cannot be multi cluster")
+			}
+			sourceNames = append(sourceNames, ref.GetName())
+		}
+	}
+	return sourceNames, nil
+}
+
+// returns nil if all requests are allowed
+func translatePermissions(accessPolicy *v1.AccessPolicySpec) []*consulv1alpha1.ServiceIntentionsSpec_IntentionPermission {
+	methods := accessPolicy.GetAllowedMethods()
+	paths := accessPolicy.GetAllowedPaths()
+	if len(methods) == 0 && len(paths) == 0 {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		return []*consulv1alpha1.ServiceIntentionsSpec_IntentionPermission{
+			{
+				Action: intentionActionAllow,
+				Http: &consulv1alpha1.ServiceIntentionsSpec_IntentionHTTPPermission{
+					Methods: methods,
+				},
+			},
+		}
+	}
+
+	var permissions []*consulv1alpha1.ServiceIntentionsSpec_IntentionPermission
+	for _, path := range paths {
+		httpPermission := translatePath(path)
+		httpPermission.Methods = methods
+		permissions = append(permissions, &consulv1alpha1.ServiceIntentionsSpec_IntentionPermission{
+			Action: intentionActionAllow,
+			Http:   httpPermission,
+		})
+	}
+	return permissions
+}
+
+// AccessPolicy paths support exact, prefix ("/books*") and suffix ("*books/") matching
+func translatePath(path string) *consulv1alpha1.ServiceIntentionsSpec_IntentionHTTPPermission {
+	switch {
+	case strings.HasSuffix(path, "*"):
+		return &consulv1alpha1.ServiceIntentionsSpec_IntentionHTTPPermission{
+			PathPrefix: strings.TrimSuffix(path, "*"),
+		}
+	case strings.HasPrefix(path, "*"):
+		return &consulv1alpha1.ServiceIntentionsSpec_IntentionHTTPPermission{
+			PathRegex: ".*" + regexp.QuoteMeta(strings.TrimPrefix(path, "*")),
+		}
+	default:
+		return &consulv1alpha1.ServiceIntentionsSpec_IntentionHTTPPermission{
+			PathExact: path,
+		}
+	}
+}
+
+// an ordered set of source intentions keyed by source name
+type sourceIntentions struct {
+	list   []*consulv1alpha1.ServiceIntentionsSpec_SourceIntention
+	byName map[string]*consulv1alpha1.ServiceIntentionsSpec_SourceIntention
+}
+
+func newSourceIntentions() *sourceIntentions {
+	return &sourceIntentions{
+		byName: map[string]*consulv1alpha1.ServiceIntentionsSpec_SourceIntention{},
+	}
+}
+
+// nil permissions allow all requests from the source, which takes precedence over any L7 permissions
+func (s *sourceIntentions) add(name string, permissions []*consulv1alpha1.ServiceIntentionsSpec_IntentionPermission) {
+	source, ok := s.byName[name]
+	if !ok {
+		source = &consulv1alpha1.ServiceIntentionsSpec_SourceIntention{
+			Name: name,
+		}
+		s.byName[name] = source
+		s.list = append(s.list, source)
+	} else if source.Action == intentionActionAllow {
+		return
+	}
+
+	if permissions == nil {
+		source.Action = intentionActionAllow
+		source.Permissions = nil
+		return
+	}
+	source.Permissions = append(source.Permissions, permissions...)
+}
@@ -0,0 +1,201 @@
+package serviceintentions_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	consulv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/serviceintentions"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ServiceIntentionsTranslator", func() {
+	var (
+		ctx          context.Context
+		ctrl         *gomock.Controller
+		mockReporter *mock_reporting.MockReporter
+	)
+
+	ns := "default"
+	clusterName := "cluster"
+
+	serviceAccounts := func(cluster string, names ...string) []*commonv1.IdentitySelector {
+		var refs []*skv2corev1.ClusterObjectRef
+		for _, name := range names {
+			refs = append(refs, &skv2corev1.ClusterObjectRef{
+				Name:        name,
+				Namespace:   ns,
+				ClusterName: cluster,
+			})
+		}
+		return []*commonv1.IdentitySelector{
+			{
+				KubeServiceAccountRefs: &commonv1.IdentitySelector_KubeServiceAccountRefs{
+					ServiceAccounts: refs,
+				},
+			},
+		}
+	}
+
+	destinationWithPolicies := func(aps ...*discoveryv1.DestinationStatus_AppliedAccessPolicy) *discoveryv1.Destination {
+		return &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "reviews",
+							Namespace:   ns,
+							ClusterName: clusterName,
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedAccessPolicies: aps,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl, ctx = gomock.WithContext(context.Background(), GinkgoT())
+		mockReporter = mock_reporting.NewMockReporter(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("will return nothing if no access policies are applied", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		destination := destinationWithPolicies()
+
+		intentions := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(intentions).To(BeNil())
+	})
+
+	It("merges the sources of all access policies", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		destination := destinationWithPolicies(
+			&discoveryv1.DestinationStatus_AppliedAccessPolicy{
+				Ref: &skv2corev1.ObjectRef{Name: "read-only", Namespace: ns},
+				Spec: &v1.AccessPolicySpec{
+					SourceSelector: serviceAccounts(clusterName, "productpage", "ratings"),
+					AllowedPaths:   []string{"/reviews*", "/health"},
+					AllowedMethods: []string{"GET"},
+				},
+			},
+			&discoveryv1.DestinationStatus_AppliedAccessPolicy{
+				Ref: &skv2corev1.ObjectRef{Name: "admin", Namespace: ns},
+				Spec: &v1.AccessPolicySpec{
+					SourceSelector: serviceAccounts(clusterName, "ratings"),
+				},
+			},
+		)
+
+		expected := &consulv1alpha1.ServiceIntentions{
+			ObjectMeta: metautils.TranslatedObjectMeta(
+				destination.Spec.GetKubeService().Ref,
+				destination.Annotations,
+			),
+			Spec: consulv1alpha1.ServiceIntentionsSpec{
+				Destination: &consulv1alpha1.ServiceIntentionsSpec_IntentionDestination{
+					Name: "reviews",
+				},
+				Sources: []*consulv1alpha1.ServiceIntentionsSpec_SourceIntention{
+					{
+						Name: "productpage",
+						Permissions: []*consulv1alpha1.ServiceIntentionsSpec_IntentionPermission{
+							{
+								Action: "allow",
+								Http: &consulv1alpha1.ServiceIntentionsSpec_IntentionHTTPPermission{
+									PathPrefix: "/reviews",
+									Methods:    []string{"GET"},
+								},
+							},
+							{
+								Action: "allow",
+								Http: &consulv1alpha1.ServiceIntentionsSpec_IntentionHTTPPermission{
+									PathExact: "/health",
+									Methods:   []string{"GET"},
+								},
+							},
+						},
+					},
+					{
+						Name:   "ratings",
+						Action: "allow",
+					},
+				},
+			},
+		}
+		expected.Annotations = map[string]string{
+			metautils.ParentLabelkey: `{"networking.mesh.gloo.solo.io/v1, Kind=AccessPolicy":[{"name":"read-only","namespace":"default"},{"name":"admin","namespace":"default"}]}`,
+		}
+
+		intentions := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(intentions).To(Equal(expected))
+	})
+
+	It("allows all sources when no source selector is set", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		destination := destinationWithPolicies(&discoveryv1.DestinationStatus_AppliedAccessPolicy{
+			Ref:  &skv2corev1.ObjectRef{Name: "all", Namespace: ns},
+			Spec: &v1.AccessPolicySpec{},
+		})
+
+		intentions := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(intentions.Spec.GetSources()).To(Equal([]*consulv1alpha1.ServiceIntentionsSpec_SourceIntention{
+			{
+				Name:   "*",
+				Action: "allow",
+			},
+		}))
+	})
+
+	It("reports an error for multi cluster sources", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		ap := &discoveryv1.DestinationStatus_AppliedAccessPolicy{
+			Ref: &skv2corev1.ObjectRef{Name: "remote", Namespace: ns},
+			Spec: &v1.AccessPolicySpec{
+				SourceSelector: serviceAccounts("other-cluster", "productpage"),
+			},
+		}
+		destination := destinationWithPolicies(ap)
+
+		mockReporter.
+			EXPECT().
+			ReportAccessPolicyToDestination(destination, ap.GetRef(), gomock.Any())
+
+		intentions := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(intentions).To(BeNil())
+	})
+
+	It("reports an error for DENY access policies", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		ap := &discoveryv1.DestinationStatus_AppliedAccessPolicy{
+			Ref: &skv2corev1.ObjectRef{Name: "deny", Namespace: ns},
+			Spec: &v1.AccessPolicySpec{
+				SourceSelector: serviceAccounts(clusterName, "productpage"),
+				Action:         v1.AccessPolicySpec_DENY,
+			},
+		}
+		destination := destinationWithPolicies(ap)
+
+		mockReporter.
+			EXPECT().
+			ReportAccessPolicyToDestination(destination, ap.GetRef(), gomock.Any())
+
+		intentions := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(intentions).To(BeNil())
+	})
+})
@@ -0,0 +1,13 @@
+package serviceintentions_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestServiceIntentions(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ServiceIntentions Suite")
+}
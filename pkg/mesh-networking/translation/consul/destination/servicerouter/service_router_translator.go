@@ -0,0 +1,427 @@
+package servicerouter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	consulv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/servicesplitter"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/smi/destination/split"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+)
+
+const (
+	// the retry conditions which can be expressed by a Consul ServiceRouter
+	connectFailureRetryCondition       = "connect-failure"
+	retriableStatusCodesRetryCondition = "retriable-status-codes"
+)
+
+//go:generate mockgen -source ./service_router_translator.go -destination mocks/service_router_translator.go
+
+// the ServiceRouter Translator translates a Destination into a Consul ServiceRouter.
+type Translator interface {
+	// Translate translates the appropriate ServiceRouter for the given Destination.
+	// returns nil if no ServiceRouter is required
+	//
+	// Errors caused by invalid user config will be reported using the Reporter.
+	//
+	// Note that the input snapshot DestinationSet contains the given Destination.
+	Translate(
+		ctx context.Context,
+		in input.LocalSnapshot,
+		destination *discoveryv1.Destination,
+		reporter reporting.Reporter,
+	) *consulv1alpha1.ServiceRouter
+}
+
+func NewTranslator() Translator {
+	return &translator{}
+}
+
+type translator struct{}
+
+func (t *translator) Translate(
+	ctx context.Context,
+	_ input.LocalSnapshot,
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) *consulv1alpha1.ServiceRouter {
+	kubeService := destination.Spec.GetKubeService()
+
+	if kubeService == nil {
+		// non kube services currently unsupported
+		return nil
+	}
+
+	serviceRouter := &consulv1alpha1.ServiceRouter{
+		// Consul requires the ServiceRouter to be named after the service it applies to
+		ObjectMeta: metautils.TranslatedObjectMeta(
+			kubeService.GetRef(),
+			destination.Annotations,
+		),
+	}
+
+	// the routes translated from each TrafficPolicy, in order of increasing priority
+	type translatedRoutes struct {
+		tpRef    *skv2corev1.ObjectRef
+		routes   []*consulv1alpha1.ServiceRouterSpec_ServiceRoute
+		catchAll bool
+	}
+	var translated []translatedRoutes
+
+	for _, tp := range destination.Status.GetAppliedTrafficPolicies() {
+		validate(tp, destination, reporter)
+
+		routeDestination, err := translateRouteDestination(tp, kubeService)
+		if err != nil {
+			reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), err)
+			continue
+		}
+		if routeDestination == nil {
+			continue
+		}
+
+		if len(tp.GetSpec().GetHttpRequestMatchers()) == 0 {
+			translated = append(translated, translatedRoutes{
+				tpRef: tp.GetRef(),
+				routes: []*consulv1alpha1.ServiceRouterSpec_ServiceRoute{{
+					Destination: routeDestination,
+				}},
+				catchAll: true,
+			})
+		} else {
+			routes, err := translateMatchedRoutes(tp.GetSpec().GetHttpRequestMatchers(), routeDestination)
+			if err != nil {
+				reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), err)
+				continue
+			}
+			translated = append(translated, translatedRoutes{
+				tpRef:  tp.GetRef(),
+				routes: routes,
+			})
+		}
+	}
+
+	// only a single route without matchers can ever be selected, keep that of the TrafficPolicy with the highest priority
+	var catchAllRef *skv2corev1.ObjectRef
+	for _, tpRoutes := range translated {
+		if tpRoutes.catchAll {
+			catchAllRef = tpRoutes.tpRef
+		}
+	}
+
+	// Consul selects the first matching route, so routes of higher priority TrafficPolicies are ordered first,
+	// and routes without matchers match all requests, so they must be ordered after all routes with matchers
+	var matchedRoutes, catchAllRoutes []*consulv1alpha1.ServiceRouterSpec_ServiceRoute
+	for _, tpRoutes := range translated {
+		if tpRoutes.catchAll {
+			if tpRoutes.tpRef != catchAllRef {
+				reporter.ReportTrafficPolicyToDestination(destination, tpRoutes.tpRef, eris.Errorf(
+					"TrafficPolicy applies to all requests and conflicts with TrafficPolicy %s of higher priority, which also applies to all requests",
+					sets.Key(catchAllRef),
+				))
+				continue
+			}
+			catchAllRoutes = tpRoutes.routes
+		} else {
+			matchedRoutes = append(tpRoutes.routes, matchedRoutes...)
+		}
+
+		metautils.AppendParent(ctx, serviceRouter, tpRoutes.tpRef, v1.TrafficPolicy{}.GVK())
+	}
+
+	// If no TrafficPolicy requires a route, return nil
+	if len(matchedRoutes)+len(catchAllRoutes) == 0 {
+		return nil
+	}
+
+	serviceRouter.Spec.Routes = append(matchedRoutes, catchAllRoutes...)
+
+	return serviceRouter
+}
+
+// returns nil if the TrafficPolicy does not configure anything which requires a route
+func translateRouteDestination(
+	tp *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+	meshKubeService *discoveryv1.DestinationSpec_KubeService,
+) (*consulv1alpha1.ServiceRouterSpec_ServiceRouteDestination, error) {
+	policy := tp.GetSpec().GetPolicy()
+	hasMatchers := len(tp.GetSpec().GetHttpRequestMatchers()) > 0
+	trafficShiftDestinations := policy.GetTrafficShift().GetDestinations()
+
+	// traffic shifts which apply to all requests are translated to ServiceSplitters
+	if !hasMatchers && policy.GetRequestTimeout() == nil && policy.GetRetries() == nil {
+		return nil, nil
+	}
+
+	routeDestination := &consulv1alpha1.ServiceRouterSpec_ServiceRouteDestination{
+		// by default, route back to the service itself so any ServiceSplitter for the service still applies
+		Service:        meshKubeService.GetRef().GetName(),
+		RequestTimeout: policy.GetRequestTimeout(),
+		NumRetries:     uint32(policy.GetRetries().GetAttempts()),
+		// Consul supports a subset of the retry conditions, the rest are reported by validate()
+		RetryOnConnectFailure: retriesOnConnectFailure(policy.GetRetries()),
+		RetryOnStatusCodes:    policy.GetRetries().GetRetriableStatusCodes(),
+	}
+
+	if hasMatchers {
+		switch len(trafficShiftDestinations) {
+		case 0:
+		case 1:
+			service, err := servicesplitter.TranslateTrafficShiftDestination(tp.GetRef(), 0, trafficShiftDestinations[0], meshKubeService)
+			if err != nil {
+				return nil, err
+			}
+			routeDestination.Service = service
+		default:
+			return nil, split.NewUnsupportedFeatureError(
+				tp.GetRef(),
+				"TrafficShift",
+				"Consul routes can only target a single service, weighted traffic shifts cannot be combined with request matchers",
+			)
+		}
+	}
+
+	return routeDestination, nil
+}
+
+// returns a route per request matcher, as Consul ANDs together the criteria within a single match
+func translateMatchedRoutes(
+	matchers []*v1.HttpMatcher,
+	routeDestination *consulv1alpha1.ServiceRouterSpec_ServiceRouteDestination,
+) ([]*consulv1alpha1.ServiceRouterSpec_ServiceRoute, error) {
+	var routes []*consulv1alpha1.ServiceRouterSpec_ServiceRoute
+	for _, matcher := range matchers {
+		match, err := translateMatch(matcher)
+		if err != nil {
+			return nil, err
+		}
+		routes = append(routes, &consulv1alpha1.ServiceRouterSpec_ServiceRoute{
+			Match:       match,
+			Destination: routeDestination,
+		})
+	}
+	return routes, nil
+}
+
+func translateMatch(matcher *v1.HttpMatcher) (*consulv1alpha1.ServiceRouterSpec_ServiceRouteMatch, error) {
+	httpMatch := &consulv1alpha1.ServiceRouterSpec_ServiceRouteHTTPMatch{}
+
+	if matcher.GetGrpc() != nil {
+		return nil, eris.New("Consul does not support gRPC matchers")
+	}
+
+	if uri := matcher.GetUri(); uri != nil {
+		if uri.GetIgnoreCase() {
+			return nil, eris.New("Consul does not support case insensitive path matching")
+		}
+		switch {
+		case uri.GetExact() != "":
+			httpMatch.PathExact = uri.GetExact()
+		case uri.GetPrefix() != "":
+			httpMatch.PathPrefix = uri.GetPrefix()
+		case uri.GetRegex() != "":
+			httpMatch.PathRegex = uri.GetRegex()
+		case uri.GetSuffix() != "":
+			httpMatch.PathRegex = ".*" + regexp.QuoteMeta(uri.GetSuffix())
+		}
+	}
+
+	for _, header := range matcher.GetHeaders() {
+		headerMatch := &consulv1alpha1.ServiceRouterSpec_ServiceRouteHTTPMatchHeader{
+			Name:   header.GetName(),
+			Invert: header.GetInvertMatch(),
+		}
+		switch {
+		case header.GetValue() == "":
+			headerMatch.Present = true
+		case header.GetRegex():
+			headerMatch.Regex = header.GetValue()
+		default:
+			headerMatch.Exact = header.GetValue()
+		}
+		httpMatch.Header = append(httpMatch.Header, headerMatch)
+	}
+
+	for _, queryParam := range matcher.GetQueryParameters() {
+		queryParamMatch := &consulv1alpha1.ServiceRouterSpec_ServiceRouteHTTPMatchQueryParam{
+			Name: queryParam.GetName(),
+		}
+		switch {
+		case queryParam.GetValue() == "":
+			queryParamMatch.Present = true
+		case queryParam.GetRegex():
+			queryParamMatch.Regex = queryParam.GetValue()
+		default:
+			queryParamMatch.Exact = queryParam.GetValue()
+		}
+		httpMatch.QueryParam = append(httpMatch.QueryParam, queryParamMatch)
+	}
+
+	if matcher.GetMethod() != "" {
+		httpMatch.Methods = []string{matcher.GetMethod()}
+	}
+
+	return &consulv1alpha1.ServiceRouterSpec_ServiceRouteMatch{
+		Http: httpMatch,
+	}, nil
+}
+
+func retriesOnConnectFailure(retries *v1.TrafficPolicySpec_Policy_RetryPolicy) bool {
+	for _, condition := range retries.GetRetryOn() {
+		if condition == connectFailureRetryCondition {
+			return true
+		}
+	}
+	return false
+}
+
+func validate(
+	tp *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) {
+	if tp.GetSpec().GetPolicy().GetCorsPolicy() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"CorsPolicy",
+			"Consul does not support cors policy",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetFaultInjection() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"FaultInjection",
+			"Consul does not support fault injection",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetHeaderManipulation() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"HeaderManipulation",
+			"Consul does not support header manipulation",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetMirror() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"Mirror",
+			"Consul does not support request mirroring",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetJwt() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"Jwt",
+			"Consul does not support JWT policy",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetCanary() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"Canary",
+			"Consul does not support canaries",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetOutlierDetection() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"OutlierDetection",
+			"Consul does not support outlier detection",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetMtls() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"Mtls",
+			"Consul does not support configuring mTLS on traffic policies",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetCsrf() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"Csrf",
+			"Consul does not support CSRF policy",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetRateLimit() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"RateLimit",
+			"Consul does not support rate limiting",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetLoadBalancer() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"LoadBalancer",
+			"Consul does not support configuring the load balancing algorithm",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetConnectionPool() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"ConnectionPool",
+			"Consul does not support connection pools",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetRetries().GetPerTryTimeout() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"Retries.PerTryTimeout",
+			"Consul does not support per try timeouts",
+		))
+	}
+	for _, condition := range tp.GetSpec().GetPolicy().GetRetries().GetRetryOn() {
+		if condition != connectFailureRetryCondition && condition != retriableStatusCodesRetryCondition {
+			reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+				tp.GetRef(),
+				"Retries.RetryOn",
+				fmt.Sprintf("Consul only supports the %s retry condition and retriable status codes, found %s", connectFailureRetryCondition, condition),
+			))
+		}
+	}
+	if len(tp.GetSpec().GetPolicy().GetRetries().GetRetriableGrpcStatusCodes()) > 0 {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"Retries.RetriableGrpcStatusCodes",
+			"Consul does not support retrying gRPC status codes",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetRetries().GetRetryRemoteLocalities() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"Retries.RetryRemoteLocalities",
+			"Consul does not support retrying remote localities",
+		))
+	}
+	if tp.GetSpec().GetSourceSelector() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"SourceSelector",
+			"Consul does not support source selectors for traffic policies",
+		))
+	}
+	if len(tp.GetSpec().GetTcpRequestMatchers()) > 0 {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"TcpRequestMatchers",
+			"Consul does not support TCP request matchers",
+		))
+	}
+	if len(tp.GetSpec().GetTlsRequestMatchers()) > 0 {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), split.NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"TlsRequestMatchers",
+			"Consul does not support TLS request matchers",
+		))
+	}
+}
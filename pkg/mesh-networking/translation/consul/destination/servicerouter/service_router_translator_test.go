@@ -0,0 +1,306 @@
+package servicerouter_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	consulv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/csrf"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/ratelimit"
+	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/servicerouter"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ServiceRouterTranslator", func() {
+	var (
+		ctx          context.Context
+		ctrl         *gomock.Controller
+		mockReporter *mock_reporting.MockReporter
+	)
+
+	ns := "default"
+
+	weightedDestination := func(name string) *v1.WeightedDestination {
+		return &v1.WeightedDestination{
+			DestinationType: &v1.WeightedDestination_KubeService{
+				KubeService: &v1.WeightedDestination_KubeDestination{
+					Name:        name,
+					Namespace:   ns,
+					ClusterName: "cluster",
+				},
+			},
+			Weight: 50,
+		}
+	}
+
+	destinationWithPolicies := func(tps ...*discoveryv1.DestinationStatus_AppliedTrafficPolicy) *discoveryv1.Destination {
+		return &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "service",
+							Namespace:   ns,
+							ClusterName: "cluster",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: tps,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl, ctx = gomock.WithContext(context.Background(), GinkgoT())
+		mockReporter = mock_reporting.NewMockReporter(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("will return nothing if only unscoped traffic shifts are applied", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		destination := destinationWithPolicies(&discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{Name: "tp", Namespace: ns},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					TrafficShift: &v1.TrafficPolicySpec_Policy_MultiDestination{
+						Destinations: []*v1.WeightedDestination{weightedDestination("one"), weightedDestination("two")},
+					},
+				},
+			},
+		})
+
+		router := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(router).To(BeNil())
+	})
+
+	It("orders matched routes before catch-all routes", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		destination := destinationWithPolicies(
+			&discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+				Ref: &skv2corev1.ObjectRef{Name: "timeout", Namespace: ns},
+				Spec: &v1.TrafficPolicySpec{
+					Policy: &v1.TrafficPolicySpec_Policy{
+						RequestTimeout: &duration.Duration{Seconds: 5},
+						Retries: &v1.TrafficPolicySpec_Policy_RetryPolicy{
+							Attempts: 3,
+						},
+					},
+				},
+			},
+			&discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+				Ref: &skv2corev1.ObjectRef{Name: "canary", Namespace: ns},
+				Spec: &v1.TrafficPolicySpec{
+					HttpRequestMatchers: []*v1.HttpMatcher{
+						{
+							Uri: &commonv1.StringMatch{
+								MatchType: &commonv1.StringMatch_Prefix{Prefix: "/v2"},
+							},
+							Headers: []*v1.HeaderMatcher{
+								{Name: "x-canary", Value: "true"},
+								{Name: "x-debug"},
+							},
+							Method: "GET",
+						},
+					},
+					Policy: &v1.TrafficPolicySpec_Policy{
+						TrafficShift: &v1.TrafficPolicySpec_Policy_MultiDestination{
+							Destinations: []*v1.WeightedDestination{weightedDestination("canary")},
+						},
+					},
+				},
+			},
+		)
+
+		expected := &consulv1alpha1.ServiceRouter{
+			ObjectMeta: metautils.TranslatedObjectMeta(
+				destination.Spec.GetKubeService().Ref,
+				destination.Annotations,
+			),
+			Spec: consulv1alpha1.ServiceRouterSpec{
+				Routes: []*consulv1alpha1.ServiceRouterSpec_ServiceRoute{
+					{
+						Match: &consulv1alpha1.ServiceRouterSpec_ServiceRouteMatch{
+							Http: &consulv1alpha1.ServiceRouterSpec_ServiceRouteHTTPMatch{
+								PathPrefix: "/v2",
+								Header: []*consulv1alpha1.ServiceRouterSpec_ServiceRouteHTTPMatchHeader{
+									{Name: "x-canary", Exact: "true"},
+									{Name: "x-debug", Present: true},
+								},
+								Methods: []string{"GET"},
+							},
+						},
+						Destination: &consulv1alpha1.ServiceRouterSpec_ServiceRouteDestination{
+							Service: "canary",
+						},
+					},
+					{
+						Destination: &consulv1alpha1.ServiceRouterSpec_ServiceRouteDestination{
+							Service:        "service",
+							RequestTimeout: &duration.Duration{Seconds: 5},
+							NumRetries:     3,
+						},
+					},
+				},
+			},
+		}
+		expected.Annotations = map[string]string{
+			metautils.ParentLabelkey: `{"networking.mesh.gloo.solo.io/v1, Kind=TrafficPolicy":[{"name":"timeout","namespace":"default"},{"name":"canary","namespace":"default"}]}`,
+		}
+
+		router := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(router).To(Equal(expected))
+	})
+
+	It("keeps the catch-all route of the highest priority TrafficPolicy and reports the others", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		lowRef := &skv2corev1.ObjectRef{Name: "low", Namespace: ns}
+		highRef := &skv2corev1.ObjectRef{Name: "high", Namespace: ns}
+		destination := destinationWithPolicies(
+			&discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+				Ref: lowRef,
+				Spec: &v1.TrafficPolicySpec{
+					Policy: &v1.TrafficPolicySpec_Policy{
+						RequestTimeout: &duration.Duration{Seconds: 5},
+					},
+				},
+			},
+			&discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+				Ref: highRef,
+				Spec: &v1.TrafficPolicySpec{
+					Priority: 1,
+					Policy: &v1.TrafficPolicySpec_Policy{
+						RequestTimeout: &duration.Duration{Seconds: 10},
+					},
+				},
+			},
+		)
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, lowRef, gomock.Any()).
+			DoAndReturn(func(destination *discoveryv1.Destination, tp *skv2corev1.ObjectRef, err error) {
+				Expect(err).To(MatchError(ContainSubstring("conflicts with TrafficPolicy high.default")))
+			})
+
+		router := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(router.Spec.Routes).To(Equal([]*consulv1alpha1.ServiceRouterSpec_ServiceRoute{
+			{
+				Destination: &consulv1alpha1.ServiceRouterSpec_ServiceRouteDestination{
+					Service:        "service",
+					RequestTimeout: &duration.Duration{Seconds: 10},
+				},
+			},
+		}))
+		Expect(router.Annotations[metautils.ParentLabelkey]).To(Equal(
+			`{"networking.mesh.gloo.solo.io/v1, Kind=TrafficPolicy":[{"name":"high","namespace":"default"}]}`,
+		))
+	})
+
+	It("reports an error when weighted traffic shifts are combined with request matchers", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		tp := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{Name: "tp", Namespace: ns},
+			Spec: &v1.TrafficPolicySpec{
+				HttpRequestMatchers: []*v1.HttpMatcher{{Method: "GET"}},
+				Policy: &v1.TrafficPolicySpec_Policy{
+					TrafficShift: &v1.TrafficPolicySpec_Policy_MultiDestination{
+						Destinations: []*v1.WeightedDestination{weightedDestination("one"), weightedDestination("two")},
+					},
+				},
+			},
+		}
+		destination := destinationWithPolicies(tp)
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, tp.GetRef(), gomock.Any())
+
+		router := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(router).To(BeNil())
+	})
+
+	It("translates supported retry conditions and reports unsupported ones", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		tp := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{Name: "tp", Namespace: ns},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					Retries: &v1.TrafficPolicySpec_Policy_RetryPolicy{
+						Attempts:             2,
+						RetryOn:              []string{"connect-failure", "reset"},
+						RetriableStatusCodes: []uint32{503},
+					},
+				},
+			},
+		}
+		destination := destinationWithPolicies(tp)
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, tp.GetRef(), gomock.Any()).
+			Do(func(_ *discoveryv1.Destination, _ *skv2corev1.ObjectRef, err error) {
+				Expect(err.Error()).To(ContainSubstring("Retries.RetryOn"))
+			})
+
+		router := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(router.Spec.Routes).To(Equal([]*consulv1alpha1.ServiceRouterSpec_ServiceRoute{
+			{
+				Destination: &consulv1alpha1.ServiceRouterSpec_ServiceRouteDestination{
+					Service:               "service",
+					NumRetries:            2,
+					RetryOnConnectFailure: true,
+					RetryOnStatusCodes:    []uint32{503},
+				},
+			},
+		}))
+	})
+
+	It("reports the policies which Consul does not support", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		tp := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{Name: "tp", Namespace: ns},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					OutlierDetection: &v1.TrafficPolicySpec_Policy_OutlierDetection{},
+					Mtls:             &v1.TrafficPolicySpec_Policy_MTLS{},
+					Csrf:             &csrf.CsrfPolicy{},
+					RateLimit:        &ratelimit.RouteRateLimit{},
+					LoadBalancer:     &v1.TrafficPolicySpec_Policy_LoadBalancer{},
+					ConnectionPool:   &v1.TrafficPolicySpec_Policy_ConnectionPool{},
+				},
+			},
+		}
+		destination := destinationWithPolicies(tp)
+
+		var unsupportedFields []string
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, tp.GetRef(), gomock.Any()).
+			Do(func(_ *discoveryv1.Destination, _ *skv2corev1.ObjectRef, err error) {
+				unsupportedFields = append(unsupportedFields, err.Error())
+			}).
+			Times(6)
+
+		router := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(router).To(BeNil())
+		for i, field := range []string{"OutlierDetection", "Mtls", "Csrf", "RateLimit", "LoadBalancer", "ConnectionPool"} {
+			Expect(unsupportedFields[i]).To(ContainSubstring(field))
+		}
+	})
+})
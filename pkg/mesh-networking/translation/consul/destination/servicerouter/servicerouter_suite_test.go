@@ -0,0 +1,13 @@
+package servicerouter_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestServiceRouter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ServiceRouter Suite")
+}
@@ -0,0 +1,204 @@
+package servicesplitter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	consulv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/smi/destination/split"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+)
+
+//go:generate mockgen -source ./service_splitter_translator.go -destination mocks/service_splitter_translator.go
+
+// the ServiceSplitter Translator translates a Destination into a Consul ServiceSplitter.
+type Translator interface {
+	// Translate translates the appropriate ServiceSplitter for the given Destination.
+	// returns nil if no ServiceSplitter is required
+	//
+	// Errors caused by invalid user config will be reported using the Reporter.
+	//
+	// Note that the input snapshot DestinationSet contains the given Destination.
+	Translate(
+		ctx context.Context,
+		in input.LocalSnapshot,
+		destination *discoveryv1.Destination,
+		reporter reporting.Reporter,
+	) *consulv1alpha1.ServiceSplitter
+}
+
+func NewTranslator() Translator {
+	return &translator{}
+}
+
+type translator struct{}
+
+func (t *translator) Translate(
+	ctx context.Context,
+	_ input.LocalSnapshot,
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) *consulv1alpha1.ServiceSplitter {
+	kubeService := destination.Spec.GetKubeService()
+
+	if kubeService == nil {
+		// non kube services currently unsupported
+		return nil
+	}
+
+	var appliedTrafficPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy
+
+	for _, tp := range destination.Status.GetAppliedTrafficPolicies() {
+		// traffic shifts scoped to specific requests are translated to ServiceRouter routes
+		if len(tp.GetSpec().GetHttpRequestMatchers()) > 0 {
+			continue
+		}
+
+		// If there is no traffic shifting, skip the rest of the translation
+		if len(tp.GetSpec().GetPolicy().GetTrafficShift().GetDestinations()) == 0 {
+			continue
+		} else if appliedTrafficPolicy != nil {
+			// Consul only allows a single ServiceSplitter per service
+			reporter.ReportTrafficPolicyToDestination(
+				destination,
+				tp.GetRef(),
+				eris.New("Consul only supports one ServiceSplitter per service, multiple found"),
+			)
+			continue
+		}
+
+		appliedTrafficPolicy = tp
+	}
+
+	// If no TrafficPolicy has been applied, return nil
+	if appliedTrafficPolicy == nil {
+		return nil
+	}
+
+	splits, err := buildSplits(appliedTrafficPolicy.GetRef(), appliedTrafficPolicy.GetSpec().GetPolicy().GetTrafficShift(), kubeService)
+	if err != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, appliedTrafficPolicy.GetRef(), err)
+		return nil
+	}
+
+	serviceSplitter := &consulv1alpha1.ServiceSplitter{
+		// Consul requires the ServiceSplitter to be named after the service it applies to
+		ObjectMeta: metautils.TranslatedObjectMeta(
+			kubeService.GetRef(),
+			destination.Annotations,
+		),
+		Spec: consulv1alpha1.ServiceSplitterSpec{
+			Splits: splits,
+		},
+	}
+
+	metautils.AppendParent(ctx, serviceSplitter, appliedTrafficPolicy.GetRef(), v1.TrafficPolicy{}.GVK())
+
+	return serviceSplitter
+}
+
+// Consul supports split weights with a precision of up to two decimal places
+const weightPrecision = 100
+
+// Consul requires split weights to be percentages which add up to exactly 100
+func buildSplits(
+	tp *skv2corev1.ObjectRef,
+	multiDest *v1.TrafficPolicySpec_Policy_MultiDestination,
+	meshKubeService *discoveryv1.DestinationSpec_KubeService,
+) ([]*consulv1alpha1.ServiceSplitterSpec_ServiceSplit, error) {
+	var totalWeight uint32
+	for _, dest := range multiDest.GetDestinations() {
+		totalWeight += dest.GetWeight()
+	}
+	if totalWeight == 0 {
+		return nil, eris.New("Consul traffic splits require at least one destination with a non-zero weight")
+	}
+
+	// weights are computed in hundredths of a percent to avoid floating point error
+	const total = 100 * weightPrecision
+	var (
+		splits       []*consulv1alpha1.ServiceSplitterSpec_ServiceSplit
+		weights      []int64
+		assigned     int64
+		lastWeighted int
+	)
+	for idx, dest := range multiDest.GetDestinations() {
+		service, err := TranslateTrafficShiftDestination(tp, idx, dest, meshKubeService)
+		if err != nil {
+			return nil, err
+		}
+		weight := (int64(dest.GetWeight())*total + int64(totalWeight)/2) / int64(totalWeight)
+		if dest.GetWeight() > 0 {
+			lastWeighted = idx
+		}
+		assigned += weight
+		weights = append(weights, weight)
+		splits = append(splits, &consulv1alpha1.ServiceSplitterSpec_ServiceSplit{
+			Service: service,
+		})
+	}
+
+	// give the rounding remainder to the last split with a non-zero weight
+	weights[lastWeighted] += total - assigned
+
+	for idx, weight := range weights {
+		splits[idx].Weight = float32(weight) / weightPrecision
+	}
+	return splits, nil
+}
+
+// TranslateTrafficShiftDestination returns the name of the Consul service targeted by a traffic shift destination.
+// consul-k8s registers each Kubernetes service under its own name, so only services in the namespace and cluster of the
+// Destination can be targeted.
+func TranslateTrafficShiftDestination(
+	tp *skv2corev1.ObjectRef,
+	idx int,
+	dest *v1.WeightedDestination,
+	meshKubeService *discoveryv1.DestinationSpec_KubeService,
+) (string, error) {
+	kubeService := dest.GetKubeService()
+	if kubeService == nil {
+		return "", eris.Errorf("Consul traffic shifting only supports Kube destinations, found %T", dest.GetDestinationType())
+	}
+
+	if len(kubeService.GetSubset()) != 0 {
+		return "", split.NewUnsupportedFeatureError(
+			tp,
+			fmt.Sprintf("TrafficShift.Destination[%d].Subset", idx),
+			"Consul subsets require a ServiceResolver, which Gloo Mesh does not manage",
+		)
+	}
+
+	if kubeService.GetPort() != 0 {
+		return "", split.NewUnsupportedFeatureError(
+			tp,
+			fmt.Sprintf("TrafficShift.Destination[%d].Port", idx),
+			"Consul does not support specifying a service port for traffic shifting",
+		)
+	}
+
+	// consul-k8s registers services by name only, so services in other namespaces cannot be told apart
+	if kubeService.GetNamespace() != meshKubeService.GetRef().GetNamespace() {
+		return "", split.NewUnsupportedFeatureError(
+			tp,
+			fmt.Sprintf("TrafficShift.Destination[%d].Namespace", idx),
+			"Consul does not support traffic shifting to services in other namespaces",
+		)
+	}
+
+	if kubeService.GetClusterName() != meshKubeService.GetRef().GetClusterName() {
+		return "", split.NewUnsupportedFeatureError(
+			tp,
+			fmt.Sprintf("TrafficShift.Destination[%d].Cluster", idx),
+			"Consul does not currently support multi cluster traffic shifting",
+		)
+	}
+
+	return kubeService.GetName(), nil
+}
@@ -0,0 +1,240 @@
+package servicesplitter_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	consulv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/consul.hashicorp.com/v1alpha1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/servicesplitter"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ServiceSplitterTranslator", func() {
+	var (
+		ctx          context.Context
+		ctrl         *gomock.Controller
+		mockReporter *mock_reporting.MockReporter
+	)
+
+	ns := "default"
+
+	weightedDestination := func(name, cluster string, weight uint32) *v1.WeightedDestination {
+		return &v1.WeightedDestination{
+			DestinationType: &v1.WeightedDestination_KubeService{
+				KubeService: &v1.WeightedDestination_KubeDestination{
+					Name:        name,
+					Namespace:   ns,
+					ClusterName: cluster,
+				},
+			},
+			Weight: weight,
+		}
+	}
+
+	destinationWithPolicies := func(tps ...*discoveryv1.DestinationStatus_AppliedTrafficPolicy) *discoveryv1.Destination {
+		return &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "service",
+							Namespace:   ns,
+							ClusterName: "cluster",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: tps,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl, ctx = gomock.WithContext(context.Background(), GinkgoT())
+		mockReporter = mock_reporting.NewMockReporter(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("will return nothing if no traffic policies are applied", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		destination := &discoveryv1.Destination{}
+
+		splitter := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(splitter).To(BeNil())
+	})
+
+	It("can build a ServiceSplitter with weights as percentages", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		destination := destinationWithPolicies(&discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "tp",
+				Namespace: ns,
+			},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					TrafficShift: &v1.TrafficPolicySpec_Policy_MultiDestination{
+						Destinations: []*v1.WeightedDestination{
+							weightedDestination("one", "cluster", 1),
+							weightedDestination("two", "cluster", 3),
+						},
+					},
+				},
+			},
+		})
+
+		expected := &consulv1alpha1.ServiceSplitter{
+			ObjectMeta: metautils.TranslatedObjectMeta(
+				destination.Spec.GetKubeService().Ref,
+				destination.Annotations,
+			),
+			Spec: consulv1alpha1.ServiceSplitterSpec{
+				Splits: []*consulv1alpha1.ServiceSplitterSpec_ServiceSplit{
+					{
+						Service: "one",
+						Weight:  25,
+					},
+					{
+						Service: "two",
+						Weight:  75,
+					},
+				},
+			},
+		}
+		expected.Annotations = map[string]string{
+			metautils.ParentLabelkey: `{"networking.mesh.gloo.solo.io/v1, Kind=TrafficPolicy":[{"name":"tp","namespace":"default"}]}`,
+		}
+
+		splitter := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(splitter).To(Equal(expected))
+	})
+
+	It("rounds weights so that they add up to exactly 100", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		destination := destinationWithPolicies(&discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "tp",
+				Namespace: ns,
+			},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					TrafficShift: &v1.TrafficPolicySpec_Policy_MultiDestination{
+						Destinations: []*v1.WeightedDestination{
+							weightedDestination("one", "cluster", 1),
+							weightedDestination("two", "cluster", 1),
+							weightedDestination("three", "cluster", 1),
+						},
+					},
+				},
+			},
+		})
+
+		splitter := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(splitter.Spec.Splits).To(Equal([]*consulv1alpha1.ServiceSplitterSpec_ServiceSplit{
+			{
+				Service: "one",
+				Weight:  33.33,
+			},
+			{
+				Service: "two",
+				Weight:  33.33,
+			},
+			{
+				Service: "three",
+				Weight:  33.34,
+			},
+		}))
+	})
+
+	It("ignores traffic shifts with request matchers", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		destination := destinationWithPolicies(&discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "tp",
+				Namespace: ns,
+			},
+			Spec: &v1.TrafficPolicySpec{
+				HttpRequestMatchers: []*v1.HttpMatcher{{Method: "GET"}},
+				Policy: &v1.TrafficPolicySpec_Policy{
+					TrafficShift: &v1.TrafficPolicySpec_Policy_MultiDestination{
+						Destinations: []*v1.WeightedDestination{
+							weightedDestination("one", "cluster", 1),
+						},
+					},
+				},
+			},
+		})
+
+		splitter := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(splitter).To(BeNil())
+	})
+
+	It("reports an error for multi cluster traffic shifts", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		tp := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "tp",
+				Namespace: ns,
+			},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					TrafficShift: &v1.TrafficPolicySpec_Policy_MultiDestination{
+						Destinations: []*v1.WeightedDestination{
+							weightedDestination("one", "other-cluster", 1),
+						},
+					},
+				},
+			},
+		}
+		destination := destinationWithPolicies(tp)
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, tp.GetRef(), gomock.Any())
+
+		splitter := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(splitter).To(BeNil())
+	})
+
+	It("reports an error for traffic shifts to other namespaces", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		otherNamespaceDestination := weightedDestination("service", "cluster", 1)
+		otherNamespaceDestination.GetKubeService().Namespace = "other-namespace"
+		tp := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "tp",
+				Namespace: ns,
+			},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					TrafficShift: &v1.TrafficPolicySpec_Policy_MultiDestination{
+						Destinations: []*v1.WeightedDestination{
+							weightedDestination("one", "cluster", 1),
+							otherNamespaceDestination,
+						},
+					},
+				},
+			},
+		}
+		destination := destinationWithPolicies(tp)
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, tp.GetRef(), gomock.Any())
+
+		splitter := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(splitter).To(BeNil())
+	})
+})
@@ -0,0 +1,13 @@
+package servicesplitter_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestServiceSplitter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ServiceSplitter Suite")
+}
@@ -0,0 +1,35 @@
+package internal
+
+import (
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/serviceintentions"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/servicerouter"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination/servicesplitter"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/mesh"
+)
+
+//go:generate mockgen -source ./dependencies.go -destination mocks/dependencies.go
+
+// the DependencyFactory creates dependencies for the translator from a given snapshot
+type DependencyFactory interface {
+	MakeMeshTranslator() mesh.Translator
+	MakeDestinationTranslator() destination.Translator
+}
+
+type dependencyFactoryImpl struct{}
+
+func NewDependencyFactory() DependencyFactory {
+	return dependencyFactoryImpl{}
+}
+
+func (d dependencyFactoryImpl) MakeMeshTranslator() mesh.Translator {
+	return mesh.NewTranslator()
+}
+
+func (d dependencyFactoryImpl) MakeDestinationTranslator() destination.Translator {
+	return destination.NewTranslator(
+		servicesplitter.NewTranslator(),
+		servicerouter.NewTranslator(),
+		serviceintentions.NewTranslator(),
+	)
+}
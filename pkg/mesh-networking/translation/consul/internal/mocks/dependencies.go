@@ -8,8 +8,8 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	destination "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/osm/destination"
-	mesh "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/osm/mesh"
+	destination "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/destination"
+	mesh "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul/mesh"
 )
 
 // MockDependencyFactory is a mock of DependencyFactory interface.
@@ -5,22 +5,22 @@ import (
 
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
-	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/smi"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/consul"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
 )
 
-//go:generate mockgen -source ./osm_mesh_translator.go -destination mocks/osm_mesh_translator.go
+//go:generate mockgen -source ./consul_mesh_translator.go -destination mocks/consul_mesh_translator.go
 
-// the VirtualService translator translates a Mesh into a VirtualService.
+// the mesh translator registers the cluster of each Consul Connect Mesh with the output snapshot.
 type Translator interface {
-	// Translate translates the appropriate resources to apply the VirtualMesh to the given Mesh.
-	// Output resources will be added to the smi.Builder
+	// Translate translates the appropriate resources for the given Mesh.
+	// Output resources will be added to the consul.Builder
 	// Errors caused by invalid user config will be reported using the Reporter.
 	Translate(
 		ctx context.Context,
 		in input.LocalSnapshot,
 		mesh *discoveryv1.Mesh,
-		outputs smi.Builder,
+		outputs consul.Builder,
 		reporter reporting.Reporter,
 	)
 }
@@ -38,14 +38,14 @@ func (t *translator) Translate(
 	ctx context.Context,
 	in input.LocalSnapshot,
 	mesh *discoveryv1.Mesh,
-	outputs smi.Builder,
+	outputs consul.Builder,
 	reporter reporting.Reporter,
 ) {
 
-	osmMesh := mesh.Spec.GetOsm()
-	if osmMesh == nil {
+	consulMesh := mesh.Spec.GetConsulConnect()
+	if consulMesh == nil {
 		return
 	}
 
-	outputs.AddCluster(osmMesh.GetInstallation().GetCluster())
+	outputs.AddCluster(consulMesh.GetInstallation().GetCluster())
 }
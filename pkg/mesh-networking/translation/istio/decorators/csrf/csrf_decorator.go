@@ -0,0 +1,163 @@
+package csrf
+
+import (
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoycsrf "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/csrf/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/rotisserie/eris"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/csrf"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/envoyfilterutils"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+)
+
+const (
+	decoratorName = "csrf"
+
+	// the name of Envoy's CSRF HTTP filter
+	CsrfFilterName = "envoy.filters.http.csrf"
+)
+
+func init() {
+	decorators.Register(decoratorConstructor)
+}
+
+func decoratorConstructor(_ decorators.Parameters) decorators.Decorator {
+	return NewCsrfDecorator()
+}
+
+// Handles configuring Envoy's CSRF filter on the sidecars of a Destination.
+type csrfDecorator struct{}
+
+var _ decorators.TrafficPolicyEnvoyFilterDecorator = &csrfDecorator{}
+
+func NewCsrfDecorator() *csrfDecorator {
+	return &csrfDecorator{}
+}
+
+func (d *csrfDecorator) DecoratorName() string {
+	return decoratorName
+}
+
+func (d *csrfDecorator) ApplyTrafficPolicyToEnvoyFilter(
+	appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+	_ *discoveryv1.Destination,
+	output *networkingv1alpha3spec.EnvoyFilter,
+	registerField decorators.RegisterField,
+) error {
+	csrfPolicy := appliedPolicy.GetSpec().GetPolicy().GetCsrf()
+	if csrfPolicy == nil {
+		return nil
+	}
+
+	// the filter applies to all requests received by the Destination's sidecars
+	if len(appliedPolicy.GetSpec().GetHttpRequestMatchers()) > 0 || len(appliedPolicy.GetSpec().GetSourceSelector()) > 0 {
+		return eris.New("CSRF policies cannot be applied to a subset of requests, httpRequestMatchers and sourceSelector must be empty")
+	}
+
+	envoyCsrfPolicy, err := translateCsrfPolicy(csrfPolicy)
+	if err != nil {
+		return err
+	}
+
+	patchValue, err := envoyfilterutils.MakeHttpFilterPatchValue(CsrfFilterName, envoyCsrfPolicy)
+	if err != nil {
+		return err
+	}
+
+	configPatch := envoyfilterutils.GetOrAddInboundHttpFilterPatch(output, CsrfFilterName)
+	if err := registerField(&configPatch.Patch.Value, patchValue); err != nil {
+		return err
+	}
+	configPatch.Patch.Value = patchValue
+
+	return nil
+}
+
+func translateCsrfPolicy(csrfPolicy *csrf.CsrfPolicy) (*envoycsrf.CsrfPolicy, error) {
+	if !csrfPolicy.GetFilterEnabled() && !csrfPolicy.GetShadowEnabled() {
+		return nil, eris.New("CSRF policy must set at least one of filterEnabled or shadowEnabled")
+	}
+
+	percentage, err := translatePercentage(csrfPolicy.GetPercentage())
+	if err != nil {
+		return nil, err
+	}
+
+	additionalOrigins, err := translateStringMatches(csrfPolicy.GetAdditionalOrigins())
+	if err != nil {
+		return nil, err
+	}
+
+	envoyCsrfPolicy := &envoycsrf.CsrfPolicy{
+		AdditionalOrigins: additionalOrigins,
+	}
+	// Envoy requires filter_enabled to be set, disable enforcement when only shadow mode is requested
+	if csrfPolicy.GetFilterEnabled() {
+		envoyCsrfPolicy.FilterEnabled = percentage
+	} else {
+		envoyCsrfPolicy.FilterEnabled = fractionalPercent(0)
+	}
+	if csrfPolicy.GetShadowEnabled() {
+		envoyCsrfPolicy.ShadowEnabled = percentage
+	}
+
+	return envoyCsrfPolicy, nil
+}
+
+// the percentage defaults to 100 when unset
+func translatePercentage(percentage float64) (*envoycorev3.RuntimeFractionalPercent, error) {
+	if percentage < 0 || percentage > 100 {
+		return nil, eris.Errorf("CSRF percentage must be between 0 and 100, got %v", percentage)
+	}
+	if percentage == 0 {
+		percentage = 100
+	}
+	// a denominator of one million allows up to four decimal places of precision
+	return fractionalPercent(uint32(percentage * 10000)), nil
+}
+
+func fractionalPercent(numerator uint32) *envoycorev3.RuntimeFractionalPercent {
+	return &envoycorev3.RuntimeFractionalPercent{
+		DefaultValue: &envoytypev3.FractionalPercent{
+			Numerator:   numerator,
+			Denominator: envoytypev3.FractionalPercent_MILLION,
+		},
+	}
+}
+
+func translateStringMatches(stringMatches []*commonv1.StringMatch) ([]*envoymatcherv3.StringMatcher, error) {
+	var stringMatchers []*envoymatcherv3.StringMatcher
+	for _, stringMatch := range stringMatches {
+		stringMatcher := &envoymatcherv3.StringMatcher{
+			IgnoreCase: stringMatch.GetIgnoreCase(),
+		}
+		switch matchType := stringMatch.GetMatchType().(type) {
+		case *commonv1.StringMatch_Exact:
+			stringMatcher.MatchPattern = &envoymatcherv3.StringMatcher_Exact{Exact: matchType.Exact}
+		case *commonv1.StringMatch_Prefix:
+			stringMatcher.MatchPattern = &envoymatcherv3.StringMatcher_Prefix{Prefix: matchType.Prefix}
+		case *commonv1.StringMatch_Suffix:
+			stringMatcher.MatchPattern = &envoymatcherv3.StringMatcher_Suffix{Suffix: matchType.Suffix}
+		case *commonv1.StringMatch_Regex:
+			if stringMatch.GetIgnoreCase() {
+				return nil, eris.Errorf("ignoreCase is unsupported for regex origin %s", matchType.Regex)
+			}
+			stringMatcher.MatchPattern = &envoymatcherv3.StringMatcher_SafeRegex{
+				SafeRegex: &envoymatcherv3.RegexMatcher{
+					EngineType: &envoymatcherv3.RegexMatcher_GoogleRe2{
+						GoogleRe2: &envoymatcherv3.RegexMatcher_GoogleRE2{},
+					},
+					Regex: matchType.Regex,
+				},
+			}
+		default:
+			return nil, eris.Errorf("unsupported additional origin match type %T", matchType)
+		}
+		stringMatchers = append(stringMatchers, stringMatcher)
+	}
+	return stringMatchers, nil
+}
@@ -0,0 +1,152 @@
+package csrf_test
+
+import (
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoycsrf "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/csrf/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/csrf"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/csrf"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/envoyfilterutils"
+	"github.com/solo-io/go-utils/testutils"
+	"istio.io/api/networking/v1alpha3"
+)
+
+var _ = Describe("CsrfDecorator", func() {
+	var (
+		csrfDecorator decorators.TrafficPolicyEnvoyFilterDecorator
+		output        *v1alpha3.EnvoyFilter
+		registerField = func(fieldPtr, val interface{}) error {
+			return nil
+		}
+	)
+
+	BeforeEach(func() {
+		csrfDecorator = NewCsrfDecorator()
+		output = &v1alpha3.EnvoyFilter{}
+	})
+
+	makeAppliedPolicy := func(csrfPolicy *csrf.CsrfPolicy) *discoveryv1.DestinationStatus_AppliedTrafficPolicy {
+		return &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					Csrf: csrfPolicy,
+				},
+			},
+		}
+	}
+
+	percent := func(numerator uint32) *envoycorev3.RuntimeFractionalPercent {
+		return &envoycorev3.RuntimeFractionalPercent{
+			DefaultValue: &envoytypev3.FractionalPercent{
+				Numerator:   numerator,
+				Denominator: envoytypev3.FractionalPercent_MILLION,
+			},
+		}
+	}
+
+	It("should add a CSRF filter", func() {
+		appliedPolicy := makeAppliedPolicy(&csrf.CsrfPolicy{
+			FilterEnabled: true,
+			Percentage:    50.5,
+			AdditionalOrigins: []*commonv1.StringMatch{
+				{
+					MatchType:  &commonv1.StringMatch_Exact{Exact: "https://example.com"},
+					IgnoreCase: true,
+				},
+				{
+					MatchType: &commonv1.StringMatch_Regex{Regex: "https://.*\\.example\\.com"},
+				},
+			},
+		})
+
+		err := csrfDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+
+		expectedValue, err := envoyfilterutils.MakeHttpFilterPatchValue(CsrfFilterName, &envoycsrf.CsrfPolicy{
+			FilterEnabled: percent(505000),
+			AdditionalOrigins: []*envoymatcherv3.StringMatcher{
+				{
+					MatchPattern: &envoymatcherv3.StringMatcher_Exact{Exact: "https://example.com"},
+					IgnoreCase:   true,
+				},
+				{
+					MatchPattern: &envoymatcherv3.StringMatcher_SafeRegex{
+						SafeRegex: &envoymatcherv3.RegexMatcher{
+							EngineType: &envoymatcherv3.RegexMatcher_GoogleRe2{
+								GoogleRe2: &envoymatcherv3.RegexMatcher_GoogleRE2{},
+							},
+							Regex: "https://.*\\.example\\.com",
+						},
+					},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(output.ConfigPatches).To(HaveLen(1))
+		Expect(output.ConfigPatches[0].ApplyTo).To(Equal(v1alpha3.EnvoyFilter_HTTP_FILTER))
+		Expect(output.ConfigPatches[0].Match.Context).To(Equal(v1alpha3.EnvoyFilter_SIDECAR_INBOUND))
+		Expect(output.ConfigPatches[0].Patch.Value).To(Equal(expectedValue))
+	})
+
+	It("should disable enforcement in shadow mode", func() {
+		appliedPolicy := makeAppliedPolicy(&csrf.CsrfPolicy{
+			ShadowEnabled: true,
+		})
+
+		err := csrfDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+
+		expectedValue, err := envoyfilterutils.MakeHttpFilterPatchValue(CsrfFilterName, &envoycsrf.CsrfPolicy{
+			FilterEnabled: percent(0),
+			ShadowEnabled: percent(1000000),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(output.ConfigPatches).To(HaveLen(1))
+		Expect(output.ConfigPatches[0].Patch.Value).To(Equal(expectedValue))
+	})
+
+	It("should not set the filter if error during field registration", func() {
+		testErr := eris.New("registration error")
+		registerField := func(fieldPtr, val interface{}) error {
+			return testErr
+		}
+		appliedPolicy := makeAppliedPolicy(&csrf.CsrfPolicy{
+			FilterEnabled: true,
+		})
+
+		err := csrfDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, nil, output, registerField)
+		Expect(err).To(testutils.HaveInErrorChain(testErr))
+	})
+
+	It("should return an error for an out of range percentage", func() {
+		appliedPolicy := makeAppliedPolicy(&csrf.CsrfPolicy{
+			FilterEnabled: true,
+			Percentage:    150,
+		})
+
+		err := csrfDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.ConfigPatches).To(BeEmpty())
+	})
+
+	It("should return an error if the policy applies to a subset of requests", func() {
+		appliedPolicy := makeAppliedPolicy(&csrf.CsrfPolicy{
+			FilterEnabled: true,
+		})
+		appliedPolicy.Spec.SourceSelector = []*commonv1.WorkloadSelector{{}}
+
+		err := csrfDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.ConfigPatches).To(BeEmpty())
+	})
+})
@@ -1,4 +1,4 @@
-package osm_test
+package csrf_test
 
 import (
 	"testing"
@@ -7,7 +7,7 @@ import (
 	. "github.com/onsi/gomega"
 )
 
-func TestIstio(t *testing.T) {
+func TestCsrf(t *testing.T) {
 	RegisterFailHandler(Fail)
-	RunSpecs(t, "Istio Suite")
+	RunSpecs(t, "Csrf Suite")
 }
@@ -109,3 +109,21 @@ type TrafficPolicyVirtualServiceDecorator interface {
 		registerField RegisterField,
 	) error
 }
+
+/*
+	A TrafficPolicyEnvoyFilterDecorator modifies the EnvoyFilter applied to the sidecars of the Destination's backing workloads
+	based on a TrafficPolicy which applies to the Destination.
+
+	Because the EnvoyFilter configures the inbound listeners of the Destination's sidecars, it applies to all traffic
+	received by the Destination.
+*/
+type TrafficPolicyEnvoyFilterDecorator interface {
+	Decorator
+
+	ApplyTrafficPolicyToEnvoyFilter(
+		appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+		destination *discoveryv1.Destination,
+		output *networkingv1alpha3spec.EnvoyFilter,
+		registerField RegisterField,
+	) error
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/trafficpolicyutils"
 	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 const (
@@ -42,6 +43,8 @@ func (d *headerManipulationDecorator) ApplyTrafficPolicyToVirtualService(
 ) error {
 	headers := d.translateHeaderManipulation(appliedPolicy.Spec)
 	if headers != nil {
+		// header manipulation is merged with that of TrafficPolicies of lower priority, which have already been applied
+		headers = appendHeaders(output.Headers, headers)
 		if err := registerField(&output.Headers, headers); err != nil {
 			return err
 		}
@@ -56,3 +59,45 @@ func (d *headerManipulationDecorator) translateHeaderManipulation(
 	headerManipulation := trafficPolicy.GetPolicy().GetHeaderManipulation()
 	return trafficpolicyutils.TranslateHeaderManipulation(headerManipulation)
 }
+
+// combine the header operations, preferring the values of the given headers over those of the existing headers
+func appendHeaders(existing, headers *networkingv1alpha3spec.Headers) *networkingv1alpha3spec.Headers {
+	if existing == nil {
+		return headers
+	}
+	return &networkingv1alpha3spec.Headers{
+		Request:  appendHeaderOperations(existing.GetRequest(), headers.GetRequest()),
+		Response: appendHeaderOperations(existing.GetResponse(), headers.GetResponse()),
+	}
+}
+
+func appendHeaderOperations(
+	existing, operations *networkingv1alpha3spec.Headers_HeaderOperations,
+) *networkingv1alpha3spec.Headers_HeaderOperations {
+	appended := &networkingv1alpha3spec.Headers_HeaderOperations{}
+
+	for _, headerOperations := range []*networkingv1alpha3spec.Headers_HeaderOperations{existing, operations} {
+		for name, value := range headerOperations.GetSet() {
+			if appended.Set == nil {
+				appended.Set = map[string]string{}
+			}
+			appended.Set[name] = value
+		}
+		for name, value := range headerOperations.GetAdd() {
+			if appended.Add == nil {
+				appended.Add = map[string]string{}
+			}
+			appended.Add[name] = value
+		}
+	}
+
+	removed := sets.NewString()
+	for _, name := range append(existing.GetRemove(), operations.GetRemove()...) {
+		if !removed.Has(name) {
+			removed.Insert(name)
+			appended.Remove = append(appended.Remove, name)
+		}
+	}
+
+	return appended
+}
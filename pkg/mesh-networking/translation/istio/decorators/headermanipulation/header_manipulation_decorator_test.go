@@ -54,6 +54,42 @@ var _ = Describe("HeaderManipulationDecorator", func() {
 		Expect(output.Headers).To(Equal(expectedHeaderManipulation))
 	})
 
+	It("should append headers to those set by TrafficPolicies of lower priority", func() {
+		registerField := func(fieldPtr, val interface{}) error {
+			return nil
+		}
+		output.Headers = &v1alpha3.Headers{
+			Request: &v1alpha3.Headers_HeaderOperations{
+				Add:    map[string]string{"a": "b", "c": "d"},
+				Remove: []string{"3"},
+			},
+		}
+		appliedPolicy := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Priority: 1,
+				Policy: &v1.TrafficPolicySpec_Policy{
+					HeaderManipulation: &v1.HeaderManipulation{
+						AppendRequestHeaders:  map[string]string{"a": "override"},
+						RemoveRequestHeaders:  []string{"3", "4"},
+						AppendResponseHeaders: map[string]string{"foo": "bar"},
+					},
+				},
+			},
+		}
+		expectedHeaderManipulation := &v1alpha3.Headers{
+			Request: &v1alpha3.Headers_HeaderOperations{
+				Add:    map[string]string{"a": "override", "c": "d"},
+				Remove: []string{"3", "4"},
+			},
+			Response: &v1alpha3.Headers_HeaderOperations{
+				Add: map[string]string{"foo": "bar"},
+			},
+		}
+		err := headerManipulationDecorator.ApplyTrafficPolicyToVirtualService(appliedPolicy, nil, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.Headers).To(Equal(expectedHeaderManipulation))
+	})
+
 	It("should not set headers if error during field registration", func() {
 		testErr := eris.New("registration error")
 		registerField := func(fieldPtr, val interface{}) error {
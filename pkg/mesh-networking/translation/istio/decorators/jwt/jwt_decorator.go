@@ -0,0 +1,138 @@
+package jwt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	envoylua "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/jwt"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/envoyfilterutils"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+)
+
+const (
+	decoratorName = "jwt"
+
+	// the dynamic metadata namespace in which Istio's JWT filter stores the payloads of validated JWTs, keyed by issuer
+	jwtAuthnMetadataNamespace = "envoy.filters.http.jwt_authn"
+)
+
+func init() {
+	decorators.Register(decoratorConstructor)
+}
+
+func decoratorConstructor(_ decorators.Parameters) decorators.Decorator {
+	return NewJwtDecorator()
+}
+
+// Handles forwarding the claims of validated JWTs to a Destination as request headers.
+// The JWTs themselves are validated by the RequestAuthentication translated for the Destination,
+// as Istio's RequestAuthentication does not support forwarding claims.
+type jwtDecorator struct{}
+
+var _ decorators.TrafficPolicyEnvoyFilterDecorator = &jwtDecorator{}
+
+func NewJwtDecorator() *jwtDecorator {
+	return &jwtDecorator{}
+}
+
+func (d *jwtDecorator) DecoratorName() string {
+	return decoratorName
+}
+
+func (d *jwtDecorator) ApplyTrafficPolicyToEnvoyFilter(
+	appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+	_ *discoveryv1.Destination,
+	output *networkingv1alpha3spec.EnvoyFilter,
+	registerField decorators.RegisterField,
+) error {
+	jwtPolicy := appliedPolicy.GetSpec().GetPolicy().GetJwt()
+	if jwtPolicy == nil {
+		return nil
+	}
+
+	// invalid JWT policies are reported by the RequestAuthentication translator
+	if len(appliedPolicy.GetSpec().GetHttpRequestMatchers()) > 0 || len(appliedPolicy.GetSpec().GetSourceSelector()) > 0 {
+		return nil
+	}
+
+	luaCode := buildClaimsToHeadersLua(jwtPolicy)
+	if luaCode == "" {
+		return nil
+	}
+
+	patchValue, err := envoyfilterutils.MakeHttpFilterPatchValue(wellknown.Lua, &envoylua.Lua{
+		InlineCode: luaCode,
+	})
+	if err != nil {
+		return err
+	}
+
+	configPatch := envoyfilterutils.GetOrAddInboundHttpFilterPatch(output, wellknown.Lua)
+	if err := registerField(&configPatch.Patch.Value, patchValue); err != nil {
+		return err
+	}
+	configPatch.Patch.Value = patchValue
+
+	return nil
+}
+
+// Build the Lua script which copies claims from the JWT payloads to request headers,
+// returning the empty string if no claims are forwarded.
+// Headers supplied by the client are always removed so that they cannot be spoofed.
+func buildClaimsToHeadersLua(jwtPolicy *jwt.JwtPolicy) string {
+	var claimsToHeaders strings.Builder
+	for _, provider := range jwtPolicy.GetProviders() {
+		for _, claimToHeader := range provider.GetClaimsToHeaders() {
+			if claimToHeader.GetClaim() == "" || claimToHeader.GetHeader() == "" {
+				continue
+			}
+			fmt.Fprintf(&claimsToHeaders, "  {issuer = %s, claim = %s, header = %s},\n",
+				strconv.Quote(provider.GetIssuer()),
+				strconv.Quote(claimToHeader.GetClaim()),
+				strconv.Quote(strings.ToLower(claimToHeader.GetHeader())),
+			)
+		}
+	}
+	if claimsToHeaders.Len() == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(claimsToHeadersLuaTemplate, claimsToHeaders.String(), strconv.Quote(jwtAuthnMetadataNamespace))
+}
+
+const claimsToHeadersLuaTemplate = `local claimsToHeaders = {
+%s}
+
+local function claimValue(value)
+  if type(value) == "table" then
+    local values = {}
+    for _, v in ipairs(value) do
+      values[#values + 1] = tostring(v)
+    end
+    return table.concat(values, ",")
+  end
+  return tostring(value)
+end
+
+function envoy_on_request(request_handle)
+  local headers = request_handle:headers()
+  for _, c in ipairs(claimsToHeaders) do
+    headers:remove(c.header)
+  end
+  local payloads = request_handle:streamInfo():dynamicMetadata():get(%s)
+  if payloads == nil then
+    return
+  end
+  for _, c in ipairs(claimsToHeaders) do
+    local payload = payloads[c.issuer]
+    if payload ~= nil and payload[c.claim] ~= nil then
+      headers:add(c.header, claimValue(payload[c.claim]))
+    end
+  end
+end
+`
@@ -0,0 +1,85 @@
+package jwt_test
+
+import (
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/jwt"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/jwt"
+	"istio.io/api/networking/v1alpha3"
+)
+
+var _ = Describe("JwtDecorator", func() {
+	var (
+		jwtDecorator  decorators.TrafficPolicyEnvoyFilterDecorator
+		output        *v1alpha3.EnvoyFilter
+		registerField = func(fieldPtr, val interface{}) error {
+			return nil
+		}
+	)
+
+	BeforeEach(func() {
+		jwtDecorator = NewJwtDecorator()
+		output = &v1alpha3.EnvoyFilter{}
+	})
+
+	makeAppliedPolicy := func(jwtPolicy *jwt.JwtPolicy) *discoveryv1.DestinationStatus_AppliedTrafficPolicy {
+		return &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					Jwt: jwtPolicy,
+				},
+			},
+		}
+	}
+
+	// decode the Lua code from the filter patched into the EnvoyFilter
+	getLuaCode := func() string {
+		Expect(output.ConfigPatches).To(HaveLen(1))
+		patchValue := output.ConfigPatches[0].GetPatch().GetValue()
+		Expect(patchValue.GetFields()["name"].GetStringValue()).To(Equal(wellknown.Lua))
+
+		typedConfig := patchValue.GetFields()["typed_config"].GetStructValue()
+		Expect(typedConfig.GetFields()["@type"].GetStringValue()).To(HaveSuffix("envoy.extensions.filters.http.lua.v3.Lua"))
+		return typedConfig.GetFields()["inline_code"].GetStringValue()
+	}
+
+	It("should add a Lua filter which forwards claims to headers", func() {
+		appliedPolicy := makeAppliedPolicy(&jwt.JwtPolicy{
+			Providers: []*jwt.JwtProvider{
+				{
+					Issuer: "https://issuer.example.com",
+					ClaimsToHeaders: []*jwt.JwtProvider_ClaimToHeader{
+						{Claim: "sub", Header: "X-Jwt-Sub"},
+						{Claim: "groups", Header: "x-jwt-groups"},
+					},
+				},
+				{
+					Issuer: "other-issuer",
+				},
+			},
+		})
+
+		err := jwtDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+
+		luaCode := getLuaCode()
+		Expect(luaCode).To(ContainSubstring(`{issuer = "https://issuer.example.com", claim = "sub", header = "x-jwt-sub"},`))
+		Expect(luaCode).To(ContainSubstring(`{issuer = "https://issuer.example.com", claim = "groups", header = "x-jwt-groups"},`))
+		Expect(luaCode).ToNot(ContainSubstring("other-issuer"))
+		Expect(luaCode).To(ContainSubstring(`dynamicMetadata():get("envoy.filters.http.jwt_authn")`))
+	})
+
+	It("should not add a filter if no claims are forwarded", func() {
+		appliedPolicy := makeAppliedPolicy(&jwt.JwtPolicy{
+			Providers: []*jwt.JwtProvider{{Issuer: "issuer"}},
+		})
+
+		err := jwtDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.ConfigPatches).To(BeEmpty())
+	})
+})
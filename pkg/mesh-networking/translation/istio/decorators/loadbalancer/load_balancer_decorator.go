@@ -0,0 +1,334 @@
+package loadbalancer
+
+import (
+	"math"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/rotisserie/eris"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/gogoutils"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+)
+
+const (
+	decoratorName = "load-balancer"
+)
+
+func init() {
+	decorators.Register(decoratorConstructor)
+}
+
+func decoratorConstructor(_ decorators.Parameters) decorators.Decorator {
+	return NewLoadBalancerDecorator()
+}
+
+// Handles setting the LoadBalancer and ConnectionPool settings on a DestinationRule.
+type loadBalancerDecorator struct{}
+
+var _ decorators.TrafficPolicyDestinationRuleDecorator = &loadBalancerDecorator{}
+
+func NewLoadBalancerDecorator() *loadBalancerDecorator {
+	return &loadBalancerDecorator{}
+}
+
+func (d *loadBalancerDecorator) DecoratorName() string {
+	return decoratorName
+}
+
+func (d *loadBalancerDecorator) ApplyTrafficPolicyToDestinationRule(
+	appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+	_ *discoveryv1.Destination,
+	output *networkingv1alpha3spec.DestinationRule,
+	registerField decorators.RegisterField,
+) error {
+	loadBalancer := appliedPolicy.Spec.GetPolicy().GetLoadBalancer()
+	connectionPool := appliedPolicy.Spec.GetPolicy().GetConnectionPool()
+	if loadBalancer == nil && connectionPool == nil {
+		return nil
+	}
+
+	// DestinationRule traffic policies apply to all traffic sent to the destination
+	if len(appliedPolicy.Spec.GetSourceSelector()) > 0 {
+		return eris.New("loadBalancer and connectionPool settings apply to all traffic, sourceSelector must be empty")
+	}
+
+	if loadBalancer != nil {
+		var loadBalancerSettings *networkingv1alpha3spec.LoadBalancerSettings
+		// the load balancing algorithm is left unset if only locality settings are specified
+		if loadBalancer.GetLbPolicy() != nil || loadBalancer.GetLocality() == nil {
+			var err error
+			loadBalancerSettings, err = TranslateLoadBalancer(loadBalancer)
+			if err != nil {
+				return err
+			}
+		}
+		var localityLbSetting *networkingv1alpha3spec.LocalityLoadBalancerSetting
+		if locality := loadBalancer.GetLocality(); locality != nil {
+			var err error
+			localityLbSetting, err = TranslateLocalityLoadBalancing(locality)
+			if err != nil {
+				return err
+			}
+		}
+
+		// register the LbPolicy and LocalityLbSetting rather than the LoadBalancerSettings, so that other fields of the LoadBalancerSettings
+		// can be owned by other TrafficPolicies
+		if output.TrafficPolicy.LoadBalancer == nil {
+			output.TrafficPolicy.LoadBalancer = &networkingv1alpha3spec.LoadBalancerSettings{}
+		}
+		if loadBalancerSettings != nil {
+			if err := registerField(&output.TrafficPolicy.LoadBalancer.LbPolicy, loadBalancerSettings.LbPolicy); err != nil {
+				return err
+			}
+			output.TrafficPolicy.LoadBalancer.LbPolicy = loadBalancerSettings.LbPolicy
+		}
+		if localityLbSetting != nil {
+			if err := registerField(&output.TrafficPolicy.LoadBalancer.LocalityLbSetting, localityLbSetting); err != nil {
+				return err
+			}
+			output.TrafficPolicy.LoadBalancer.LocalityLbSetting = localityLbSetting
+		}
+	}
+
+	if connectionPool != nil {
+		connectionPoolSettings, err := TranslateConnectionPool(connectionPool)
+		if err != nil {
+			return err
+		}
+		if err := registerField(&output.TrafficPolicy.ConnectionPool, connectionPoolSettings); err != nil {
+			return err
+		}
+		output.TrafficPolicy.ConnectionPool = connectionPoolSettings
+	}
+
+	return nil
+}
+
+// TranslateLoadBalancer public to be used in enterprise
+func TranslateLoadBalancer(
+	loadBalancer *v1.TrafficPolicySpec_Policy_LoadBalancer,
+) (*networkingv1alpha3spec.LoadBalancerSettings, error) {
+	switch lbPolicy := loadBalancer.GetLbPolicy().(type) {
+	case *v1.TrafficPolicySpec_Policy_LoadBalancer_Simple:
+		simple, err := translateSimpleLb(lbPolicy.Simple)
+		if err != nil {
+			return nil, err
+		}
+		return &networkingv1alpha3spec.LoadBalancerSettings{
+			LbPolicy: &networkingv1alpha3spec.LoadBalancerSettings_Simple{Simple: simple},
+		}, nil
+	case *v1.TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_:
+		consistentHash, err := translateConsistentHash(lbPolicy.ConsistentHash)
+		if err != nil {
+			return nil, err
+		}
+		return &networkingv1alpha3spec.LoadBalancerSettings{
+			LbPolicy: &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHash{ConsistentHash: consistentHash},
+		}, nil
+	default:
+		// round robin is the default load balancing algorithm
+		return &networkingv1alpha3spec.LoadBalancerSettings{
+			LbPolicy: &networkingv1alpha3spec.LoadBalancerSettings_Simple{
+				Simple: networkingv1alpha3spec.LoadBalancerSettings_ROUND_ROBIN,
+			},
+		}, nil
+	}
+}
+
+// TranslateLocalityLoadBalancing public to be used in enterprise
+func TranslateLocalityLoadBalancing(
+	locality *commonv1.LocalityLoadBalancing,
+) (*networkingv1alpha3spec.LocalityLoadBalancerSetting, error) {
+	if locality.GetDisabled() {
+		return &networkingv1alpha3spec.LocalityLoadBalancerSetting{
+			Enabled: &types.BoolValue{Value: false},
+		}, nil
+	}
+
+	if len(locality.GetFailover()) > 0 && len(locality.GetDistribute()) > 0 {
+		return nil, eris.New("locality failover and distribute cannot both be set")
+	}
+
+	localityLbSetting := &networkingv1alpha3spec.LocalityLoadBalancerSetting{
+		Enabled: &types.BoolValue{Value: true},
+	}
+
+	for _, failover := range locality.GetFailover() {
+		// failover is configured between regions
+		for _, region := range []string{failover.GetFrom(), failover.GetTo()} {
+			if region == "" || region == "*" || strings.Contains(region, "/") {
+				return nil, eris.Errorf("locality failover must specify the from and to regions, found %q", region)
+			}
+		}
+		if failover.GetFrom() == failover.GetTo() {
+			return nil, eris.Errorf("locality failover from region %s must differ from the to region", failover.GetFrom())
+		}
+		localityLbSetting.Failover = append(localityLbSetting.Failover, &networkingv1alpha3spec.LocalityLoadBalancerSetting_Failover{
+			From: failover.GetFrom(),
+			To:   failover.GetTo(),
+		})
+	}
+
+	for _, distribute := range locality.GetDistribute() {
+		if err := validateLocality(distribute.GetFrom()); err != nil {
+			return nil, err
+		}
+		var totalWeight uint32
+		for to, weight := range distribute.GetTo() {
+			if err := validateLocality(to); err != nil {
+				return nil, err
+			}
+			totalWeight += weight
+		}
+		if totalWeight != 100 {
+			return nil, eris.Errorf("locality distribute weights from %s must sum to 100, found %d", distribute.GetFrom(), totalWeight)
+		}
+		localityLbSetting.Distribute = append(localityLbSetting.Distribute, &networkingv1alpha3spec.LocalityLoadBalancerSetting_Distribute{
+			From: distribute.GetFrom(),
+			To:   distribute.GetTo(),
+		})
+	}
+
+	return localityLbSetting, nil
+}
+
+// localities are of the form region/zone/sub-zone, where only the last specified segment may be a wildcard
+func validateLocality(locality string) error {
+	segments := strings.Split(locality, "/")
+	if len(segments) > 3 {
+		return eris.Errorf("invalid locality %q, must be of the form region/zone/sub-zone", locality)
+	}
+	for i, segment := range segments {
+		if segment == "" {
+			return eris.Errorf("invalid locality %q, region, zone and sub-zone must not be empty", locality)
+		}
+		if segment == "*" && i != len(segments)-1 {
+			return eris.Errorf("invalid locality %q, wildcard is only allowed as the last segment", locality)
+		}
+	}
+	return nil
+}
+
+func translateSimpleLb(
+	simple v1.TrafficPolicySpec_Policy_LoadBalancer_SimpleLB,
+) (networkingv1alpha3spec.LoadBalancerSettings_SimpleLB, error) {
+	switch simple {
+	case v1.TrafficPolicySpec_Policy_LoadBalancer_ROUND_ROBIN:
+		return networkingv1alpha3spec.LoadBalancerSettings_ROUND_ROBIN, nil
+	case v1.TrafficPolicySpec_Policy_LoadBalancer_LEAST_REQUEST:
+		// Istio's LEAST_CONN is implemented with Envoy's least request load balancer
+		return networkingv1alpha3spec.LoadBalancerSettings_LEAST_CONN, nil
+	case v1.TrafficPolicySpec_Policy_LoadBalancer_RANDOM:
+		return networkingv1alpha3spec.LoadBalancerSettings_RANDOM, nil
+	case v1.TrafficPolicySpec_Policy_LoadBalancer_PASSTHROUGH:
+		return networkingv1alpha3spec.LoadBalancerSettings_PASSTHROUGH, nil
+	default:
+		return 0, eris.Errorf("unsupported load balancing algorithm %v", simple)
+	}
+}
+
+func translateConsistentHash(
+	consistentHash *v1.TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash,
+) (*networkingv1alpha3spec.LoadBalancerSettings_ConsistentHashLB, error) {
+	consistentHashLb := &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHashLB{
+		MinimumRingSize: consistentHash.GetMinimumRingSize(),
+	}
+
+	switch hashKey := consistentHash.GetHashKey().(type) {
+	case *v1.TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpHeaderName:
+		if hashKey.HttpHeaderName == "" {
+			return nil, eris.New("consistentHash httpHeaderName must not be empty")
+		}
+		consistentHashLb.HashKey = &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHashLB_HttpHeaderName{
+			HttpHeaderName: hashKey.HttpHeaderName,
+		}
+	case *v1.TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie_:
+		cookie := hashKey.HttpCookie
+		if cookie.GetName() == "" || cookie.GetTtl() == nil {
+			return nil, eris.New("consistentHash httpCookie must specify a name and ttl")
+		}
+		consistentHashLb.HashKey = &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHashLB_HttpCookie{
+			HttpCookie: &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHashLB_HTTPCookie{
+				Name: cookie.GetName(),
+				Path: cookie.GetPath(),
+				Ttl:  gogoutils.DurationProtoToGogo(cookie.GetTtl()),
+			},
+		}
+	case *v1.TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_UseSourceIp:
+		if !hashKey.UseSourceIp {
+			return nil, eris.New("consistentHash useSourceIp must be true if set")
+		}
+		consistentHashLb.HashKey = &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHashLB_UseSourceIp{
+			UseSourceIp: true,
+		}
+	case *v1.TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpQueryParameterName:
+		if hashKey.HttpQueryParameterName == "" {
+			return nil, eris.New("consistentHash httpQueryParameterName must not be empty")
+		}
+		consistentHashLb.HashKey = &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHashLB_HttpQueryParameterName{
+			HttpQueryParameterName: hashKey.HttpQueryParameterName,
+		}
+	default:
+		return nil, eris.New("consistentHash must specify a hash key")
+	}
+
+	return consistentHashLb, nil
+}
+
+// TranslateConnectionPool public to be used in enterprise
+func TranslateConnectionPool(
+	connectionPool *v1.TrafficPolicySpec_Policy_ConnectionPool,
+) (*networkingv1alpha3spec.ConnectionPoolSettings, error) {
+	connectionPoolSettings := &networkingv1alpha3spec.ConnectionPoolSettings{}
+
+	if tcp := connectionPool.GetTcp(); tcp != nil {
+		maxConnections, err := toInt32("maxConnections", tcp.GetMaxConnections())
+		if err != nil {
+			return nil, err
+		}
+		connectionPoolSettings.Tcp = &networkingv1alpha3spec.ConnectionPoolSettings_TCPSettings{
+			MaxConnections: maxConnections,
+			ConnectTimeout: gogoutils.DurationProtoToGogo(tcp.GetConnectTimeout()),
+		}
+	}
+
+	if http := connectionPool.GetHttp(); http != nil {
+		http1MaxPendingRequests, err := toInt32("http1MaxPendingRequests", http.GetHttp1MaxPendingRequests())
+		if err != nil {
+			return nil, err
+		}
+		http2MaxRequests, err := toInt32("http2MaxRequests", http.GetHttp2MaxRequests())
+		if err != nil {
+			return nil, err
+		}
+		maxRequestsPerConnection, err := toInt32("maxRequestsPerConnection", http.GetMaxRequestsPerConnection())
+		if err != nil {
+			return nil, err
+		}
+		maxRetries, err := toInt32("maxRetries", http.GetMaxRetries())
+		if err != nil {
+			return nil, err
+		}
+		connectionPoolSettings.Http = &networkingv1alpha3spec.ConnectionPoolSettings_HTTPSettings{
+			Http1MaxPendingRequests:  http1MaxPendingRequests,
+			Http2MaxRequests:         http2MaxRequests,
+			MaxRequestsPerConnection: maxRequestsPerConnection,
+			MaxRetries:               maxRetries,
+			IdleTimeout:              gogoutils.DurationProtoToGogo(http.GetIdleTimeout()),
+		}
+	}
+
+	return connectionPoolSettings, nil
+}
+
+// Istio represents connection pool limits as int32
+func toInt32(fieldName string, value uint32) (int32, error) {
+	if value > math.MaxInt32 {
+		return 0, eris.Errorf("connectionPool %s must not exceed %d", fieldName, math.MaxInt32)
+	}
+	return int32(value), nil
+}
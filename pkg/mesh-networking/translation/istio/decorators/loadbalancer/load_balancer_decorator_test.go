@@ -0,0 +1,282 @@
+package loadbalancer_test
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/loadbalancer"
+	"github.com/solo-io/go-utils/testutils"
+	"istio.io/api/networking/v1alpha3"
+)
+
+var _ = Describe("LoadBalancerDecorator", func() {
+	var (
+		loadBalancerDecorator decorators.TrafficPolicyDestinationRuleDecorator
+		output                *v1alpha3.DestinationRule
+		registerField         = func(fieldPtr, val interface{}) error {
+			return nil
+		}
+	)
+
+	BeforeEach(func() {
+		loadBalancerDecorator = loadbalancer.NewLoadBalancerDecorator()
+		output = &v1alpha3.DestinationRule{
+			TrafficPolicy: &v1alpha3.TrafficPolicy{},
+		}
+	})
+
+	It("should set a simple load balancer", func() {
+		appliedPolicy := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancer{
+						LbPolicy: &v1.TrafficPolicySpec_Policy_LoadBalancer_Simple{
+							Simple: v1.TrafficPolicySpec_Policy_LoadBalancer_LEAST_REQUEST,
+						},
+					},
+				},
+			},
+		}
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.TrafficPolicy.LoadBalancer).To(Equal(&v1alpha3.LoadBalancerSettings{
+			LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{
+				Simple: v1alpha3.LoadBalancerSettings_LEAST_CONN,
+			},
+		}))
+		Expect(output.TrafficPolicy.ConnectionPool).To(BeNil())
+	})
+
+	It("should set a consistent hash load balancer", func() {
+		appliedPolicy := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancer{
+						LbPolicy: &v1.TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_{
+							ConsistentHash: &v1.TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash{
+								HashKey: &v1.TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie_{
+									HttpCookie: &v1.TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_HttpCookie{
+										Name: "session",
+										Ttl:  &duration.Duration{Seconds: 60},
+									},
+								},
+								MinimumRingSize: 1024,
+							},
+						},
+					},
+				},
+			},
+		}
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.TrafficPolicy.LoadBalancer).To(Equal(&v1alpha3.LoadBalancerSettings{
+			LbPolicy: &v1alpha3.LoadBalancerSettings_ConsistentHash{
+				ConsistentHash: &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+					HashKey: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HttpCookie{
+						HttpCookie: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HTTPCookie{
+							Name: "session",
+							Ttl:  &types.Duration{Seconds: 60},
+						},
+					},
+					MinimumRingSize: 1024,
+				},
+			},
+		}))
+	})
+
+	It("should set locality load balancing settings without a load balancing algorithm", func() {
+		appliedPolicy := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancer{
+						Locality: &commonv1.LocalityLoadBalancing{
+							Failover: []*commonv1.LocalityLoadBalancing_Failover{
+								{
+									From: "us-east",
+									To:   "us-west",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.TrafficPolicy.LoadBalancer).To(Equal(&v1alpha3.LoadBalancerSettings{
+			LocalityLbSetting: &v1alpha3.LocalityLoadBalancerSetting{
+				Enabled: &types.BoolValue{Value: true},
+				Failover: []*v1alpha3.LocalityLoadBalancerSetting_Failover{
+					{
+						From: "us-east",
+						To:   "us-west",
+					},
+				},
+			},
+		}))
+	})
+
+	It("should set locality distribution with a load balancing algorithm", func() {
+		appliedPolicy := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancer{
+						LbPolicy: &v1.TrafficPolicySpec_Policy_LoadBalancer_Simple{
+							Simple: v1.TrafficPolicySpec_Policy_LoadBalancer_RANDOM,
+						},
+						Locality: &commonv1.LocalityLoadBalancing{
+							Distribute: []*commonv1.LocalityLoadBalancing_Distribute{
+								{
+									From: "us-west/zone1/*",
+									To: map[string]uint32{
+										"us-west/zone1/*": 80,
+										"us-west/zone2/*": 20,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.TrafficPolicy.LoadBalancer).To(Equal(&v1alpha3.LoadBalancerSettings{
+			LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{
+				Simple: v1alpha3.LoadBalancerSettings_RANDOM,
+			},
+			LocalityLbSetting: &v1alpha3.LocalityLoadBalancerSetting{
+				Enabled: &types.BoolValue{Value: true},
+				Distribute: []*v1alpha3.LocalityLoadBalancerSetting_Distribute{
+					{
+						From: "us-west/zone1/*",
+						To: map[string]uint32{
+							"us-west/zone1/*": 80,
+							"us-west/zone2/*": 20,
+						},
+					},
+				},
+			},
+		}))
+	})
+
+	It("should disable locality load balancing", func() {
+		localityLbSetting, err := loadbalancer.TranslateLocalityLoadBalancing(&commonv1.LocalityLoadBalancing{Disabled: true})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(localityLbSetting).To(Equal(&v1alpha3.LocalityLoadBalancerSetting{
+			Enabled: &types.BoolValue{Value: false},
+		}))
+	})
+
+	DescribeTable("should return an error for invalid locality load balancing settings",
+		func(locality *commonv1.LocalityLoadBalancing) {
+			_, err := loadbalancer.TranslateLocalityLoadBalancing(locality)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("failover and distribute", &commonv1.LocalityLoadBalancing{
+			Failover:   []*commonv1.LocalityLoadBalancing_Failover{{From: "us-east", To: "us-west"}},
+			Distribute: []*commonv1.LocalityLoadBalancing_Distribute{{From: "us-east", To: map[string]uint32{"us-east": 100}}},
+		}),
+		Entry("failover to a zone", &commonv1.LocalityLoadBalancing{
+			Failover: []*commonv1.LocalityLoadBalancing_Failover{{From: "us-east", To: "us-west/zone1"}},
+		}),
+		Entry("failover to the same region", &commonv1.LocalityLoadBalancing{
+			Failover: []*commonv1.LocalityLoadBalancing_Failover{{From: "us-east", To: "us-east"}},
+		}),
+		Entry("distribute weights not summing to 100", &commonv1.LocalityLoadBalancing{
+			Distribute: []*commonv1.LocalityLoadBalancing_Distribute{{From: "us-east", To: map[string]uint32{"us-east": 50, "us-west": 40}}},
+		}),
+		Entry("distribute with a wildcard region", &commonv1.LocalityLoadBalancing{
+			Distribute: []*commonv1.LocalityLoadBalancing_Distribute{{From: "*/zone1", To: map[string]uint32{"us-east": 100}}},
+		}),
+	)
+
+	It("should set connection pool settings", func() {
+		appliedPolicy := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					ConnectionPool: &v1.TrafficPolicySpec_Policy_ConnectionPool{
+						Tcp: &v1.TrafficPolicySpec_Policy_ConnectionPool_Tcp{
+							MaxConnections: 100,
+						},
+						Http: &v1.TrafficPolicySpec_Policy_ConnectionPool_Http{
+							Http1MaxPendingRequests: 10,
+							IdleTimeout:             &duration.Duration{Seconds: 30},
+						},
+					},
+				},
+			},
+		}
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.TrafficPolicy.ConnectionPool).To(Equal(&v1alpha3.ConnectionPoolSettings{
+			Tcp: &v1alpha3.ConnectionPoolSettings_TCPSettings{
+				MaxConnections: 100,
+			},
+			Http: &v1alpha3.ConnectionPoolSettings_HTTPSettings{
+				Http1MaxPendingRequests: 10,
+				IdleTimeout:             &types.Duration{Seconds: 30},
+			},
+		}))
+		Expect(output.TrafficPolicy.LoadBalancer).To(BeNil())
+	})
+
+	It("should not set settings if error during field registration", func() {
+		testErr := eris.New("registration error")
+		registerField := func(fieldPtr, val interface{}) error {
+			return testErr
+		}
+		appliedPolicy := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					ConnectionPool: &v1.TrafficPolicySpec_Policy_ConnectionPool{
+						Tcp: &v1.TrafficPolicySpec_Policy_ConnectionPool_Tcp{
+							MaxConnections: 100,
+						},
+					},
+				},
+			},
+		}
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).To(testutils.HaveInErrorChain(testErr))
+		Expect(output.TrafficPolicy.ConnectionPool).To(BeNil())
+	})
+
+	It("should return an error for a consistent hash without a hash key", func() {
+		appliedPolicy := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancer{
+						LbPolicy: &v1.TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash_{
+							ConsistentHash: &v1.TrafficPolicySpec_Policy_LoadBalancer_ConsistentHash{},
+						},
+					},
+				},
+			},
+		}
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.TrafficPolicy.LoadBalancer).To(BeNil())
+	})
+
+	It("should return an error if the policy selects sources", func() {
+		appliedPolicy := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				SourceSelector: []*commonv1.WorkloadSelector{{}},
+				Policy: &v1.TrafficPolicySpec_Policy{
+					LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancer{},
+				},
+			},
+		}
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.TrafficPolicy.LoadBalancer).To(BeNil())
+	})
+})
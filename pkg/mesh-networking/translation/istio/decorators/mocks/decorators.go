@@ -239,3 +239,54 @@ func (mr *MockTrafficPolicyVirtualServiceDecoratorMockRecorder) DecoratorName()
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecoratorName", reflect.TypeOf((*MockTrafficPolicyVirtualServiceDecorator)(nil).DecoratorName))
 }
+
+// MockTrafficPolicyEnvoyFilterDecorator is a mock of TrafficPolicyEnvoyFilterDecorator interface.
+type MockTrafficPolicyEnvoyFilterDecorator struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrafficPolicyEnvoyFilterDecoratorMockRecorder
+}
+
+// MockTrafficPolicyEnvoyFilterDecoratorMockRecorder is the mock recorder for MockTrafficPolicyEnvoyFilterDecorator.
+type MockTrafficPolicyEnvoyFilterDecoratorMockRecorder struct {
+	mock *MockTrafficPolicyEnvoyFilterDecorator
+}
+
+// NewMockTrafficPolicyEnvoyFilterDecorator creates a new mock instance.
+func NewMockTrafficPolicyEnvoyFilterDecorator(ctrl *gomock.Controller) *MockTrafficPolicyEnvoyFilterDecorator {
+	mock := &MockTrafficPolicyEnvoyFilterDecorator{ctrl: ctrl}
+	mock.recorder = &MockTrafficPolicyEnvoyFilterDecoratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrafficPolicyEnvoyFilterDecorator) EXPECT() *MockTrafficPolicyEnvoyFilterDecoratorMockRecorder {
+	return m.recorder
+}
+
+// ApplyTrafficPolicyToEnvoyFilter mocks base method.
+func (m *MockTrafficPolicyEnvoyFilterDecorator) ApplyTrafficPolicyToEnvoyFilter(appliedPolicy *v1.DestinationStatus_AppliedTrafficPolicy, destination *v1.Destination, output *v1alpha3.EnvoyFilter, registerField decorators.RegisterField) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyTrafficPolicyToEnvoyFilter", appliedPolicy, destination, output, registerField)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyTrafficPolicyToEnvoyFilter indicates an expected call of ApplyTrafficPolicyToEnvoyFilter.
+func (mr *MockTrafficPolicyEnvoyFilterDecoratorMockRecorder) ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyTrafficPolicyToEnvoyFilter", reflect.TypeOf((*MockTrafficPolicyEnvoyFilterDecorator)(nil).ApplyTrafficPolicyToEnvoyFilter), appliedPolicy, destination, output, registerField)
+}
+
+// DecoratorName mocks base method.
+func (m *MockTrafficPolicyEnvoyFilterDecorator) DecoratorName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecoratorName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// DecoratorName indicates an expected call of DecoratorName.
+func (mr *MockTrafficPolicyEnvoyFilterDecoratorMockRecorder) DecoratorName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecoratorName", reflect.TypeOf((*MockTrafficPolicyEnvoyFilterDecorator)(nil).DecoratorName))
+}
@@ -0,0 +1,137 @@
+package ratelimit
+
+import (
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylocalratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/ratelimit"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/envoyfilterutils"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+)
+
+const (
+	decoratorName = "rate-limit"
+
+	// the name of Envoy's local rate limit HTTP filter
+	LocalRateLimitFilterName = "envoy.filters.http.local_ratelimit"
+	localRateLimitStatPrefix = "http_local_rate_limiter"
+)
+
+func init() {
+	decorators.Register(decoratorConstructor)
+}
+
+func decoratorConstructor(_ decorators.Parameters) decorators.Decorator {
+	return NewRateLimitDecorator()
+}
+
+// Handles configuring Envoy's local rate limit filter on the sidecars of a Destination.
+type rateLimitDecorator struct{}
+
+var _ decorators.TrafficPolicyEnvoyFilterDecorator = &rateLimitDecorator{}
+
+func NewRateLimitDecorator() *rateLimitDecorator {
+	return &rateLimitDecorator{}
+}
+
+func (d *rateLimitDecorator) DecoratorName() string {
+	return decoratorName
+}
+
+func (d *rateLimitDecorator) ApplyTrafficPolicyToEnvoyFilter(
+	appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+	_ *discoveryv1.Destination,
+	output *networkingv1alpha3spec.EnvoyFilter,
+	registerField decorators.RegisterField,
+) error {
+	rateLimit := appliedPolicy.GetSpec().GetPolicy().GetRateLimit()
+	if rateLimit == nil {
+		return nil
+	}
+
+	// the filter applies to all requests received by the Destination's sidecars
+	if len(appliedPolicy.GetSpec().GetHttpRequestMatchers()) > 0 || len(appliedPolicy.GetSpec().GetSourceSelector()) > 0 {
+		return eris.New("rate limits cannot be applied to a subset of requests, httpRequestMatchers and sourceSelector must be empty")
+	}
+
+	localRateLimit, err := translateLocalRateLimit(rateLimit)
+	if err != nil {
+		return err
+	}
+
+	patchValue, err := envoyfilterutils.MakeHttpFilterPatchValue(LocalRateLimitFilterName, localRateLimit)
+	if err != nil {
+		return err
+	}
+
+	configPatch := envoyfilterutils.GetOrAddInboundHttpFilterPatch(output, LocalRateLimitFilterName)
+	if err := registerField(&configPatch.Patch.Value, patchValue); err != nil {
+		return err
+	}
+	configPatch.Patch.Value = patchValue
+
+	return nil
+}
+
+// Only the basic rate limit for anonymous users can be enforced by Envoy's local rate limit filter.
+// Other rate limit configuration requires an external rate limit server.
+func translateLocalRateLimit(rateLimit *ratelimit.RouteRateLimit) (*envoylocalratelimit.LocalRateLimit, error) {
+	basic := rateLimit.GetBasic()
+	if basic == nil {
+		return nil, eris.New("only basic rate limits are supported, advanced rate limits and config refs require a rate limit server")
+	}
+	if basic.GetAuthorizedLimits() != nil {
+		return nil, eris.New("authorizedLimits are unsupported, local rate limits cannot distinguish authorized requests")
+	}
+
+	limit := basic.GetAnonymousLimits()
+	if limit.GetRequestsPerUnit() == 0 {
+		return nil, eris.New("anonymousLimits must specify a non-zero requestsPerUnit")
+	}
+	fillInterval, err := unitToDuration(limit.GetUnit())
+	if err != nil {
+		return nil, err
+	}
+
+	return &envoylocalratelimit.LocalRateLimit{
+		StatPrefix: localRateLimitStatPrefix,
+		TokenBucket: &envoytypev3.TokenBucket{
+			MaxTokens:     limit.GetRequestsPerUnit(),
+			TokensPerFill: &wrappers.UInt32Value{Value: limit.GetRequestsPerUnit()},
+			FillInterval:  ptypes.DurationProto(fillInterval),
+		},
+		FilterEnabled:  allRequests(),
+		FilterEnforced: allRequests(),
+	}, nil
+}
+
+func unitToDuration(unit ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio_Unit) (time.Duration, error) {
+	switch unit {
+	case ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio_SECOND:
+		return time.Second, nil
+	case ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio_MINUTE:
+		return time.Minute, nil
+	case ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio_HOUR:
+		return time.Hour, nil
+	case ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio_DAY:
+		return 24 * time.Hour, nil
+	default:
+		return 0, eris.Errorf("unsupported rate limit unit %v", unit)
+	}
+}
+
+func allRequests() *envoycorev3.RuntimeFractionalPercent {
+	return &envoycorev3.RuntimeFractionalPercent{
+		DefaultValue: &envoytypev3.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoytypev3.FractionalPercent_HUNDRED,
+		},
+	}
+}
@@ -0,0 +1,155 @@
+package ratelimit_test
+
+import (
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylocalratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/ratelimit"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/ratelimit"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/envoyfilterutils"
+	"github.com/solo-io/go-utils/testutils"
+	"istio.io/api/networking/v1alpha3"
+)
+
+var _ = Describe("RateLimitDecorator", func() {
+	var (
+		rateLimitDecorator decorators.TrafficPolicyEnvoyFilterDecorator
+		output             *v1alpha3.EnvoyFilter
+		registerField      = func(fieldPtr, val interface{}) error {
+			return nil
+		}
+	)
+
+	BeforeEach(func() {
+		rateLimitDecorator = NewRateLimitDecorator()
+		output = &v1alpha3.EnvoyFilter{}
+	})
+
+	makeAppliedPolicy := func(rateLimit *ratelimit.RouteRateLimit) *discoveryv1.DestinationStatus_AppliedTrafficPolicy {
+		return &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					RateLimit: rateLimit,
+				},
+			},
+		}
+	}
+
+	basicRateLimit := func(limits *ratelimit.RouteRateLimit_BasicRateLimit) *ratelimit.RouteRateLimit {
+		return &ratelimit.RouteRateLimit{
+			RateLimitConfigType: &ratelimit.RouteRateLimit_Basic{
+				Basic: limits,
+			},
+		}
+	}
+
+	It("should add a local rate limit filter for anonymous limits", func() {
+		appliedPolicy := makeAppliedPolicy(basicRateLimit(&ratelimit.RouteRateLimit_BasicRateLimit{
+			AnonymousLimits: &ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio{
+				Unit:            ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio_MINUTE,
+				RequestsPerUnit: 10,
+			},
+		}))
+
+		err := rateLimitDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+
+		allRequests := &envoycorev3.RuntimeFractionalPercent{
+			DefaultValue: &envoytypev3.FractionalPercent{
+				Numerator:   100,
+				Denominator: envoytypev3.FractionalPercent_HUNDRED,
+			},
+		}
+		expectedValue, err := envoyfilterutils.MakeHttpFilterPatchValue(LocalRateLimitFilterName, &envoylocalratelimit.LocalRateLimit{
+			StatPrefix: "http_local_rate_limiter",
+			TokenBucket: &envoytypev3.TokenBucket{
+				MaxTokens:     10,
+				TokensPerFill: &wrappers.UInt32Value{Value: 10},
+				FillInterval:  &duration.Duration{Seconds: 60},
+			},
+			FilterEnabled:  allRequests,
+			FilterEnforced: allRequests,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(output.ConfigPatches).To(HaveLen(1))
+		Expect(output.ConfigPatches[0].ApplyTo).To(Equal(v1alpha3.EnvoyFilter_HTTP_FILTER))
+		Expect(output.ConfigPatches[0].Match.Context).To(Equal(v1alpha3.EnvoyFilter_SIDECAR_INBOUND))
+		Expect(output.ConfigPatches[0].Patch.Value).To(Equal(expectedValue))
+	})
+
+	It("should not set the filter if error during field registration", func() {
+		testErr := eris.New("registration error")
+		registerField := func(fieldPtr, val interface{}) error {
+			return testErr
+		}
+		appliedPolicy := makeAppliedPolicy(basicRateLimit(&ratelimit.RouteRateLimit_BasicRateLimit{
+			AnonymousLimits: &ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio{
+				Unit:            ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio_SECOND,
+				RequestsPerUnit: 10,
+			},
+		}))
+
+		err := rateLimitDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, nil, output, registerField)
+		Expect(err).To(testutils.HaveInErrorChain(testErr))
+	})
+
+	It("should return an error for rate limits requiring a rate limit server", func() {
+		appliedPolicy := makeAppliedPolicy(&ratelimit.RouteRateLimit{
+			RateLimitConfigType: &ratelimit.RouteRateLimit_Advanced{
+				Advanced: &ratelimit.RouteRateLimit_AdvancedRateLimit{},
+			},
+		})
+
+		err := rateLimitDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.ConfigPatches).To(BeEmpty())
+	})
+
+	It("should return an error for authorized limits", func() {
+		appliedPolicy := makeAppliedPolicy(basicRateLimit(&ratelimit.RouteRateLimit_BasicRateLimit{
+			AuthorizedLimits: &ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio{
+				Unit:            ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio_SECOND,
+				RequestsPerUnit: 10,
+			},
+		}))
+
+		err := rateLimitDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.ConfigPatches).To(BeEmpty())
+	})
+
+	It("should return an error for an unknown unit", func() {
+		appliedPolicy := makeAppliedPolicy(basicRateLimit(&ratelimit.RouteRateLimit_BasicRateLimit{
+			AnonymousLimits: &ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio{
+				RequestsPerUnit: 10,
+			},
+		}))
+
+		err := rateLimitDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.ConfigPatches).To(BeEmpty())
+	})
+
+	It("should return an error if the policy applies to a subset of requests", func() {
+		appliedPolicy := makeAppliedPolicy(basicRateLimit(&ratelimit.RouteRateLimit_BasicRateLimit{
+			AnonymousLimits: &ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio{
+				Unit:            ratelimit.RouteRateLimit_BasicRateLimit_RateLimitRatio_SECOND,
+				RequestsPerUnit: 10,
+			},
+		}))
+		appliedPolicy.Spec.HttpRequestMatchers = []*v1.HttpMatcher{{}}
+
+		err := rateLimitDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.ConfigPatches).To(BeEmpty())
+	})
+})
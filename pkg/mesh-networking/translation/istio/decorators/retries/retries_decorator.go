@@ -58,5 +58,5 @@ func (d *retriesDecorator) translateRetries(
 	trafficPolicy *v1.TrafficPolicySpec,
 ) (*networkingv1alpha3spec.HTTPRetry, error) {
 	retries := trafficPolicy.GetPolicy().GetRetries()
-	return trafficpolicyutils.TranslateRetries(retries), nil
+	return trafficpolicyutils.TranslateRetries(retries)
 }
@@ -66,4 +66,23 @@ var _ = Describe("RetriesDecorator", func() {
 		err := retriesDecorator.ApplyTrafficPolicyToVirtualService(appliedPolicy, nil, nil, output, registerField)
 		Expect(err).To(testutils.HaveInErrorChain(testErr))
 	})
+
+	It("should return an error for unknown retry conditions", func() {
+		registerField := func(fieldPtr, val interface{}) error {
+			return nil
+		}
+		appliedPolicy := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					Retries: &v1.TrafficPolicySpec_Policy_RetryPolicy{
+						Attempts: 5,
+						RetryOn:  []string{"5xx", "unknown-condition"},
+					},
+				},
+			},
+		}
+		err := retriesDecorator.ApplyTrafficPolicyToVirtualService(appliedPolicy, nil, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.Retries).To(BeNil())
+	})
 })
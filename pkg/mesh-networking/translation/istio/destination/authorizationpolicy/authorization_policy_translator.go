@@ -2,7 +2,9 @@ package authorizationpolicy
 
 import (
 	"fmt"
+	"net"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/rotisserie/eris"
@@ -22,6 +24,10 @@ import (
 
 const (
 	translatorName = "authorization-policy-translator"
+
+	// suffix appended to the name of the AuthorizationPolicy containing the rules of DENY AccessPolicies.
+	// Kubernetes service names cannot contain dots, so the name cannot collide with the ALLOW AuthorizationPolicy of another service.
+	denyPolicyNameSuffix = ".deny"
 )
 
 var (
@@ -30,9 +36,10 @@ var (
 	}
 )
 
-// the AuthorizationPolicy translator translates a Destination into a AuthorizationPolicy.
+// the AuthorizationPolicy translator translates a Destination into AuthorizationPolicies.
 type Translator interface {
-	// Translate translates an appropriate AuthorizationPolicy for the given Destination.
+	// Translate translates the appropriate AuthorizationPolicies for the given Destination,
+	// i.e. an ALLOW AuthorizationPolicy for the applied ALLOW AccessPolicies and a DENY AuthorizationPolicy for the applied DENY AccessPolicies.
 	// returns nil if no AuthorizationPolicy is required for the Destination (i.e. if no AuthorizationPolicy features are required, such access control).
 	//
 	// Errors caused by invalid user config will be reported using the Reporter.
@@ -42,7 +49,7 @@ type Translator interface {
 		in input.LocalSnapshot,
 		destination *discoveryv1.Destination,
 		reporter reporting.Reporter,
-	) *securityv1beta1.AuthorizationPolicy
+	) []*securityv1beta1.AuthorizationPolicy
 }
 
 type translator struct{}
@@ -55,15 +62,21 @@ func (t *translator) Translate(
 	in input.LocalSnapshot,
 	destination *discoveryv1.Destination,
 	reporter reporting.Reporter,
-) *securityv1beta1.AuthorizationPolicy {
+) []*securityv1beta1.AuthorizationPolicy {
+	if destination.Spec.GetExternalService() != nil {
+		reportExternalServiceAccessPolicies(destination, reporter)
+		return nil
+	}
+
 	kubeService := destination.Spec.GetKubeService()
 
 	if kubeService == nil {
-		// TODO(harveyxia): non kube services currently unsupported
 		return nil
 	}
 
-	authPolicy := t.initializeAuthorizationPolicy(destination)
+	allowPolicy := t.initializeAuthorizationPolicy(destination, securityv1beta1spec.AuthorizationPolicy_ALLOW)
+	denyPolicy := t.initializeAuthorizationPolicy(destination, securityv1beta1spec.AuthorizationPolicy_DENY)
+	denyPolicy.Name += denyPolicyNameSuffix
 
 	for _, policy := range destination.Status.AppliedAccessPolicies {
 		rule, err := t.translateAccessPolicy(policy.Spec, in.Meshes())
@@ -71,20 +84,53 @@ func (t *translator) Translate(
 			reporter.ReportAccessPolicyToDestination(destination, policy.Ref, eris.Wrapf(err, "%v", translatorName))
 			continue
 		}
-		authPolicy.Spec.Rules = append(authPolicy.Spec.Rules, rule)
+		switch policy.Spec.GetAction() {
+		case v1.AccessPolicySpec_DENY:
+			denyPolicy.Spec.Rules = append(denyPolicy.Spec.Rules, rule)
+		default:
+			allowPolicy.Spec.Rules = append(allowPolicy.Spec.Rules, rule)
+		}
 	}
 
-	// don't output an AuthPolicy with no matching rules, which semantically denies all requests
+	var authPolicies []*securityv1beta1.AuthorizationPolicy
+
+	// don't output an ALLOW AuthPolicy with no matching rules, which semantically denies all requests
 	// reference: https://istio.io/latest/docs/reference/config/security/authorization-policy/#AuthorizationPolicy
-	if len(authPolicy.Spec.Rules) == 0 {
-		return nil
+	if len(allowPolicy.Spec.Rules) > 0 {
+		authPolicies = append(authPolicies, allowPolicy)
+	}
+	// Istio evaluates DENY AuthPolicies before ALLOW AuthPolicies, so denied requests are rejected even if they are allowed by an AccessPolicy
+	if len(denyPolicy.Spec.Rules) > 0 {
+		authPolicies = append(authPolicies, denyPolicy)
 	}
 
-	return authPolicy
+	return authPolicies
+}
+
+// Istio AuthorizationPolicies are enforced by the server-side proxy, which does not exist for ExternalServices.
+// Report an error for AccessPolicies which explicitly select an ExternalService so that users are aware
+// they are not enforced, while ignoring AccessPolicies which select ExternalServices through wildcards.
+func reportExternalServiceAccessPolicies(
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) {
+	for _, policy := range destination.Status.GetAppliedAccessPolicies() {
+		for _, destinationSelector := range policy.GetSpec().GetDestinationSelector() {
+			if destinationSelector.GetExternalServiceRefs() != nil {
+				reporter.ReportAccessPolicyToDestination(
+					destination,
+					policy.GetRef(),
+					eris.Errorf("%v: AccessPolicies cannot be enforced for ExternalService Destinations", translatorName),
+				)
+				break
+			}
+		}
+	}
 }
 
 func (t *translator) initializeAuthorizationPolicy(
 	destination *discoveryv1.Destination,
+	action securityv1beta1spec.AuthorizationPolicy_Action,
 ) *securityv1beta1.AuthorizationPolicy {
 	meta := metautils.TranslatedObjectMeta(
 		destination.Spec.GetKubeService().Ref,
@@ -96,15 +142,15 @@ func (t *translator) initializeAuthorizationPolicy(
 			Selector: &typesv1beta1.WorkloadSelector{
 				MatchLabels: destination.Spec.GetKubeService().WorkloadSelectorLabels,
 			},
-			Action: securityv1beta1spec.AuthorizationPolicy_ALLOW,
+			Action: action,
 		},
 	}
 	return authPolicy
 }
 
 /*
-	Translate an AccessPolicy instance into a Rule consisting of a Rule_From for each SourceSelector
-	and a single Rule_To containing the rules specified in the AccessPolicy.
+	Translate an AccessPolicy instance into a Rule consisting of a Rule_From for each SourceSelector,
+	a single Rule_To containing the rules specified in the AccessPolicy, and a Condition for each of the AccessPolicy's conditions.
 */
 func (t *translator) translateAccessPolicy(
 	accessPolicy *v1.AccessPolicySpec,
@@ -119,9 +165,14 @@ func (t *translator) translateAccessPolicy(
 		fromRules = append(fromRules, fromRule)
 	}
 	toRules := buildToRules(accessPolicy)
+	conditions, err := translateConditions(accessPolicy.Conditions)
+	if err != nil {
+		return nil, err
+	}
 	return &securityv1beta1spec.Rule{
 		From: fromRules,
 		To:   toRules,
+		When: conditions,
 	}, nil
 }
 
@@ -142,6 +193,60 @@ func buildToRules(accessPolicy *v1.AccessPolicySpec) []*securityv1beta1spec.Rule
 	return ruleTo
 }
 
+// Translate the AccessPolicy's conditions into Istio Conditions, all of which must match for the Rule to apply.
+// Reference: https://istio.io/latest/docs/reference/config/security/conditions/
+func translateConditions(conditions []*v1.AccessPolicySpec_Condition) ([]*securityv1beta1spec.Condition, error) {
+	var istioConditions []*securityv1beta1spec.Condition
+	for i, condition := range conditions {
+		var key string
+		var values, notValues []string
+		switch conditionType := condition.GetConditionType().(type) {
+		case *v1.AccessPolicySpec_Condition_RequestHeader:
+			header := conditionType.RequestHeader
+			if header.GetName() == "" {
+				return nil, eris.Errorf("Conditions[%d]: request header name must be specified", i)
+			}
+			key = fmt.Sprintf("request.headers[%s]", header.GetName())
+			values, notValues = header.GetValues(), header.GetNotValues()
+		case *v1.AccessPolicySpec_Condition_JwtClaim:
+			claim := conditionType.JwtClaim
+			if claim.GetClaim() == "" {
+				return nil, eris.Errorf("Conditions[%d]: JWT claim must be specified", i)
+			}
+			key = fmt.Sprintf("request.auth.claims[%s]", claim.GetClaim())
+			values, notValues = claim.GetValues(), claim.GetNotValues()
+		case *v1.AccessPolicySpec_Condition_SourceIp:
+			ipBlocks := conditionType.SourceIp
+			for _, ipBlock := range append(ipBlocks.GetIpBlocks(), ipBlocks.GetNotIpBlocks()...) {
+				if !isValidIpBlock(ipBlock) {
+					return nil, eris.Errorf("Conditions[%d]: invalid IP address or CIDR block %s", i, ipBlock)
+				}
+			}
+			key = "source.ip"
+			values, notValues = ipBlocks.GetIpBlocks(), ipBlocks.GetNotIpBlocks()
+		default:
+			return nil, eris.Errorf("Conditions[%d]: condition type must be specified", i)
+		}
+		if len(values) == 0 && len(notValues) == 0 {
+			return nil, eris.Errorf("Conditions[%d]: at least one value must be specified", i)
+		}
+		istioConditions = append(istioConditions, &securityv1beta1spec.Condition{
+			Key:       key,
+			Values:    values,
+			NotValues: notValues,
+		})
+	}
+	return istioConditions, nil
+}
+
+func isValidIpBlock(ipBlock string) bool {
+	if strings.Contains(ipBlock, "/") {
+		_, _, err := net.ParseCIDR(ipBlock)
+		return err == nil
+	}
+	return net.ParseIP(ipBlock) != nil
+}
+
 // Generate all fully qualified principal names for specified service accounts.
 // Reference: https://istio.io/docs/reference/config/security/authorization-policy/#Source
 func (t *translator) buildSource(
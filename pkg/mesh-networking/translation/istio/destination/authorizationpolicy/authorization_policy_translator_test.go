@@ -174,8 +174,8 @@ var _ = Describe("AuthorizationPolicyTranslator", func() {
 			},
 		}
 		inputSnapshot := input.NewInputLocalSnapshotManualBuilder("").AddMeshes(meshes).Build()
-		authPolicy := translator.Translate(inputSnapshot, destination, mockReporter)
-		Expect(authPolicy).To(Equal(expectedAuthPolicy))
+		authPolicies := translator.Translate(inputSnapshot, destination, mockReporter)
+		Expect(authPolicies).To(Equal([]*securityv1beta1.AuthorizationPolicy{expectedAuthPolicy}))
 	})
 
 	It("should handle wildcard (empty) cluster source selectors", func() {
@@ -304,8 +304,184 @@ var _ = Describe("AuthorizationPolicyTranslator", func() {
 			},
 		}
 		inputSnapshot := input.NewInputLocalSnapshotManualBuilder("").AddMeshes(meshes).Build()
-		authPolicy := translator.Translate(inputSnapshot, destination, mockReporter)
+		authPolicies := translator.Translate(inputSnapshot, destination, mockReporter)
 		//Expect(equalityutils.DeepEqual(authPolicy, expectedAuthPolicy)).To(BeTrue())
-		Expect(authPolicy).To(Equal(expectedAuthPolicy))
+		Expect(authPolicies).To(Equal([]*securityv1beta1.AuthorizationPolicy{expectedAuthPolicy}))
+	})
+
+	It("should translate DENY AccessPolicies with conditions into a DENY AuthorizationPolicy", func() {
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ms",
+				Namespace: "ms-namespace",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "kube-service",
+							Namespace:   "kube-service-namespace",
+							ClusterName: "cluster",
+						},
+						WorkloadSelectorLabels: map[string]string{
+							"app": "kube-service",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedAccessPolicies: []*discoveryv1.DestinationStatus_AppliedAccessPolicy{
+					{
+						Spec: &networkingv1.AccessPolicySpec{
+							SourceSelector: []*commonv1.IdentitySelector{
+								{
+									KubeIdentityMatcher: &commonv1.IdentitySelector_KubeIdentityMatcher{
+										Namespaces: []string{"compromised"},
+									},
+								},
+							},
+							Action: networkingv1.AccessPolicySpec_DENY,
+						},
+					},
+					{
+						Spec: &networkingv1.AccessPolicySpec{
+							AllowedPaths: []string{"/admin*"},
+							Action:       networkingv1.AccessPolicySpec_DENY,
+							Conditions: []*networkingv1.AccessPolicySpec_Condition{
+								{
+									ConditionType: &networkingv1.AccessPolicySpec_Condition_RequestHeader{
+										RequestHeader: &networkingv1.AccessPolicySpec_Condition_HeaderCondition{
+											Name:      "x-user",
+											NotValues: []string{"admin"},
+										},
+									},
+								},
+								{
+									ConditionType: &networkingv1.AccessPolicySpec_Condition_JwtClaim{
+										JwtClaim: &networkingv1.AccessPolicySpec_Condition_JwtClaimCondition{
+											Claim:  "groups",
+											Values: []string{"guests"},
+										},
+									},
+								},
+								{
+									ConditionType: &networkingv1.AccessPolicySpec_Condition_SourceIp{
+										SourceIp: &networkingv1.AccessPolicySpec_Condition_IpBlockCondition{
+											NotIpBlocks: []string{"10.0.0.0/16", "192.168.1.1"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		expectedAuthPolicy := &securityv1beta1.AuthorizationPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        destination.Spec.GetKubeService().Ref.Name + ".deny",
+				Namespace:   destination.Spec.GetKubeService().Ref.Namespace,
+				ClusterName: destination.Spec.GetKubeService().Ref.ClusterName,
+				Labels: map[string]string{
+					"owner.networking.mesh.gloo.solo.io": "gloo-mesh",
+				},
+			},
+			Spec: securityv1beta1spec.AuthorizationPolicy{
+				Selector: &v1beta1.WorkloadSelector{
+					MatchLabels: destination.Spec.GetKubeService().WorkloadSelectorLabels,
+				},
+				Rules: []*securityv1beta1spec.Rule{
+					{
+						From: []*securityv1beta1spec.Rule_From{
+							{
+								Source: &securityv1beta1spec.Source{
+									Namespaces: []string{"compromised"},
+								},
+							},
+						},
+					},
+					{
+						To: []*securityv1beta1spec.Rule_To{
+							{
+								Operation: &securityv1beta1spec.Operation{
+									Paths: []string{"/admin*"},
+								},
+							},
+						},
+						When: []*securityv1beta1spec.Condition{
+							{
+								Key:       "request.headers[x-user]",
+								NotValues: []string{"admin"},
+							},
+							{
+								Key:    "request.auth.claims[groups]",
+								Values: []string{"guests"},
+							},
+							{
+								Key:       "source.ip",
+								NotValues: []string{"10.0.0.0/16", "192.168.1.1"},
+							},
+						},
+					},
+				},
+				Action: securityv1beta1spec.AuthorizationPolicy_DENY,
+			},
+		}
+		inputSnapshot := input.NewInputLocalSnapshotManualBuilder("").Build()
+		authPolicies := translator.Translate(inputSnapshot, destination, mockReporter)
+		Expect(authPolicies).To(Equal([]*securityv1beta1.AuthorizationPolicy{expectedAuthPolicy}))
+	})
+
+	It("should report AccessPolicies with invalid conditions", func() {
+		policyRef := &v1.ObjectRef{
+			Name:      "ap",
+			Namespace: "ns",
+		}
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ms",
+				Namespace: "ms-namespace",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "kube-service",
+							Namespace:   "kube-service-namespace",
+							ClusterName: "cluster",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedAccessPolicies: []*discoveryv1.DestinationStatus_AppliedAccessPolicy{
+					{
+						Ref: policyRef,
+						Spec: &networkingv1.AccessPolicySpec{
+							Action: networkingv1.AccessPolicySpec_DENY,
+							Conditions: []*networkingv1.AccessPolicySpec_Condition{
+								{
+									ConditionType: &networkingv1.AccessPolicySpec_Condition_SourceIp{
+										SourceIp: &networkingv1.AccessPolicySpec_Condition_IpBlockCondition{
+											IpBlocks: []string{"10.0.0.0/33"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		mockReporter.
+			EXPECT().
+			ReportAccessPolicyToDestination(destination, policyRef, gomock.Any()).
+			DoAndReturn(func(destination *discoveryv1.Destination, accessPolicy *v1.ObjectRef, err error) {
+				Expect(err).To(MatchError(ContainSubstring("Conditions[0]: invalid IP address or CIDR block 10.0.0.0/33")))
+			})
+
+		inputSnapshot := input.NewInputLocalSnapshotManualBuilder("").Build()
+		authPolicies := translator.Translate(inputSnapshot, destination, mockReporter)
+		Expect(authPolicies).To(BeNil())
 	})
 })
@@ -11,6 +11,7 @@ import (
 
 	v1alpha3sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
 	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/loadbalancer"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/tls"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/utils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/selectorutils"
@@ -86,21 +87,49 @@ func (t *translator) Translate(
 	sourceMeshInstallation *discoveryv1.MeshInstallation,
 	reporter reporting.Reporter,
 ) *networkingv1alpha3.DestinationRule {
-	kubeService := destination.Spec.GetKubeService()
+	var (
+		destinationRule   *networkingv1alpha3.DestinationRule
+		sourceClusterName string
+	)
+
+	switch destinationType := destination.Spec.GetType().(type) {
+	case *discoveryv1.DestinationSpec_KubeService_:
+		sourceClusterName = destinationType.KubeService.GetRef().GetClusterName()
+		if sourceMeshInstallation != nil {
+			sourceClusterName = sourceMeshInstallation.Cluster
+		}
 
-	if kubeService == nil {
-		// TODO(ilackarms): non kube services currently unsupported
-		return nil
-	}
+		var err error
+		destinationRule, err = t.initializeDestinationRule(destination, t.settings.Spec.Mtls, sourceMeshInstallation)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Error(err)
+			return nil
+		}
 
-	sourceClusterName := kubeService.Ref.ClusterName
-	if sourceMeshInstallation != nil {
-		sourceClusterName = sourceMeshInstallation.Cluster
-	}
+		// federated Destinations default to the locality load balancing settings of their VirtualMesh, which TrafficPolicies may override
+		if sourceMeshInstallation != nil {
+			if err := addFederatedLocalityLoadBalancing(destination, destinationRule); err != nil {
+				reporter.ReportVirtualMeshToDestination(destination, destination.Status.AppliedFederation.GetVirtualMeshRef(), err)
+				return nil
+			}
+		}
+	case *discoveryv1.DestinationSpec_ExternalService_:
+		// ExternalServices are only routed to from the cluster on which their ServiceEntry exists,
+		// and only require a DestinationRule if TrafficPolicies are applied
+		if sourceMeshInstallation != nil || len(destination.Status.GetAppliedTrafficPolicies()) == 0 {
+			return nil
+		}
+		sourceClusterName = destinationType.ExternalService.GetRef().GetClusterName()
 
-	destinationRule, err := t.initializeDestinationRule(destination, t.settings.Spec.Mtls, sourceMeshInstallation)
-	if err != nil {
-		contextutils.LoggerFrom(ctx).Error(err)
+		var err error
+		destinationRule, err = initializeExternalServiceDestinationRule(destination)
+		if err != nil {
+			for _, policy := range destination.Status.GetAppliedTrafficPolicies() {
+				reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, err)
+			}
+			return nil
+		}
+	default:
 		return nil
 	}
 
@@ -119,7 +148,7 @@ func (t *translator) Translate(
 			continue
 		}
 
-		registerField := registerFieldFunc(destinationRuleFields, destinationRule, policy.Ref)
+		registerField := registerFieldFunc(destinationRuleFields, destinationRule, policy)
 		for _, decorator := range drDecorators {
 
 			if destinationRuleDecorator, ok := decorator.(decorators.TrafficPolicyDestinationRuleDecorator); ok {
@@ -138,6 +167,12 @@ func (t *translator) Translate(
 	// possible todo - see function comment
 	addKeepaliveToDestinationRule(destination, sourceMeshInstallation, destinationRule)
 
+	// an empty DestinationRule for an ExternalService has no effect
+	if destination.Spec.GetExternalService() != nil &&
+		equalityutils.DeepEqual(destinationRule.Spec.GetTrafficPolicy(), &networkingv1alpha3spec.TrafficPolicy{}) {
+		return nil
+	}
+
 	if t.userDestinationRules == nil {
 		return destinationRule
 	}
@@ -162,7 +197,7 @@ func (t *translator) Translate(
 func registerFieldFunc(
 	destinationRuleFields fieldutils.FieldOwnershipRegistry,
 	destinationRule *networkingv1alpha3.DestinationRule,
-	policy ezkube.ResourceId,
+	policy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
 ) decorators.RegisterField {
 	return func(fieldPtr, val interface{}) error {
 		fieldVal := reflect.ValueOf(fieldPtr).Elem().Interface()
@@ -173,9 +208,9 @@ func registerFieldFunc(
 		if err := destinationRuleFields.RegisterFieldOwnership(
 			destinationRule,
 			fieldPtr,
-			[]ezkube.ResourceId{policy},
+			[]ezkube.ResourceId{policy.Ref},
 			&v1.TrafficPolicy{},
-			0, //TODO(ilackarms): priority
+			policy.Spec.GetPriority(),
 		); err != nil {
 			return err
 		}
@@ -224,6 +259,28 @@ func (t *translator) initializeDestinationRule(
 	return destinationRule, nil
 }
 
+// DestinationRules for ExternalServices do not use the default mTLS settings, as external services are not part of the mesh.
+func initializeExternalServiceDestinationRule(
+	destination *discoveryv1.Destination,
+) (*networkingv1alpha3.DestinationRule, error) {
+	externalService := destination.Spec.GetExternalService()
+	hostname, err := utils.ExternalServiceHostname(externalService)
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkingv1alpha3.DestinationRule{
+		ObjectMeta: metautils.TranslatedObjectMeta(
+			externalService.GetRef(),
+			destination.Annotations,
+		),
+		Spec: networkingv1alpha3spec.DestinationRule{
+			Host:          hostname,
+			TrafficPolicy: &networkingv1alpha3spec.TrafficPolicy{},
+		},
+	}, nil
+}
+
 // Return errors for each user-supplied VirtualService that applies to the same hostname as the translated VirtualService
 func conflictsWithUserDestinationRule(
 	userDestinationRules v1alpha3sets.DestinationRuleSet,
@@ -285,3 +342,21 @@ func addKeepaliveToDestinationRule(destination *discoveryv1.Destination, sourceM
 		}
 	}
 }
+
+// Set the locality load balancing settings of the VirtualMesh federating the Destination, if specified.
+func addFederatedLocalityLoadBalancing(destination *discoveryv1.Destination, destinationRule *networkingv1alpha3.DestinationRule) error {
+	localityLoadBalancing := destination.Status.AppliedFederation.GetLocalityLoadBalancing()
+	if localityLoadBalancing == nil {
+		return nil
+	}
+
+	localityLbSetting, err := loadbalancer.TranslateLocalityLoadBalancing(localityLoadBalancing)
+	if err != nil {
+		return eris.Wrap(err, "invalid federation localityLoadBalancing")
+	}
+	if destinationRule.Spec.TrafficPolicy.LoadBalancer == nil {
+		destinationRule.Spec.TrafficPolicy.LoadBalancer = &networkingv1alpha3spec.LoadBalancerSettings{}
+	}
+	destinationRule.Spec.TrafficPolicy.LoadBalancer.LocalityLbSetting = localityLbSetting
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
 	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/loadbalancer"
 	mock_decorators "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/mocks"
 	mock_trafficpolicy "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/mocks"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/destinationrule"
@@ -695,4 +696,338 @@ var _ = Describe("DestinationRuleTranslator", func() {
 
 		_ = destinationRuleTranslator.Translate(ctx, in, destination, nil, mockReporter)
 	})
+
+	It("should output DestinationRule without default mTLS for an ExternalService Destination", func() {
+		settings.Spec = settingsv1.SettingsSpec{
+			Mtls: &v1.TrafficPolicySpec_Policy_MTLS{
+				Istio: &v1.TrafficPolicySpec_Policy_MTLS_Istio{
+					TlsMode: v1.TrafficPolicySpec_Policy_MTLS_Istio_ISTIO_MUTUAL,
+				},
+			},
+		}
+
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "database",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_ExternalService_{
+					ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+						Name:  "database",
+						Hosts: []string{"db.example.com"},
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "database",
+							Namespace:   "database-namespace",
+							ClusterName: "database-cluster",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+					{
+						Ref: &skv2corev1.ObjectRef{
+							Name:      "tp-1",
+							Namespace: "tp-namespace-1",
+						},
+						Spec: &v1.TrafficPolicySpec{
+							Policy: &v1.TrafficPolicySpec_Policy{
+								OutlierDetection: &v1.TrafficPolicySpec_Policy_OutlierDetection{
+									ConsecutiveErrors: 5,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(decorators.Parameters{
+				ClusterDomains: mockClusterDomainRegistry,
+				Snapshot:       in,
+			}).
+			Return([]decorators.Decorator{mockDecorator})
+
+		outlierDetection := &networkingv1alpha3spec.OutlierDetection{
+			Consecutive_5XxErrors: &types.UInt32Value{Value: 5},
+		}
+
+		mockDecorator.
+			EXPECT().
+			ApplyTrafficPolicyToDestinationRule(
+				destination.Status.AppliedTrafficPolicies[0],
+				destination,
+				&networkingv1alpha3spec.DestinationRule{
+					Host:          "db.example.com",
+					TrafficPolicy: &networkingv1alpha3spec.TrafficPolicy{},
+				},
+				gomock.Any(),
+			).DoAndReturn(
+			func(
+				appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+				destination *discoveryv1.Destination,
+				output *networkingv1alpha3spec.DestinationRule,
+				registerField decorators.RegisterField,
+			) error {
+				output.TrafficPolicy.OutlierDetection = outlierDetection
+				return nil
+			})
+
+		expectedDestinationRule := &networkingv1alpha3.DestinationRule{
+			ObjectMeta: metautils.TranslatedObjectMeta(
+				destination.Spec.GetExternalService().Ref,
+				destination.Annotations,
+			),
+			Spec: networkingv1alpha3spec.DestinationRule{
+				Host: "db.example.com",
+				TrafficPolicy: &networkingv1alpha3spec.TrafficPolicy{
+					OutlierDetection: outlierDetection,
+				},
+			},
+		}
+
+		destinationRule := destinationRuleTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		Expect(destinationRule).To(Equal(expectedDestinationRule))
+	})
+
+	It("should not output DestinationRule for an ExternalService Destination with no applied TrafficPolicies", func() {
+		destination := &discoveryv1.Destination{
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_ExternalService_{
+					ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+						Name:  "database",
+						Hosts: []string{"db.example.com"},
+					},
+				},
+			},
+		}
+
+		destinationRule := destinationRuleTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		Expect(destinationRule).To(BeNil())
+	})
+
+	It("should report conflicting load balancer settings between TrafficPolicies", func() {
+		settings.Spec = settingsv1.SettingsSpec{}
+
+		makeLoadBalancerPolicy := func(name string, simple v1.TrafficPolicySpec_Policy_LoadBalancer_SimpleLB) *discoveryv1.DestinationStatus_AppliedTrafficPolicy {
+			return &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+				Ref: &skv2corev1.ObjectRef{
+					Name:      name,
+					Namespace: "tp-namespace-1",
+				},
+				Spec: &v1.TrafficPolicySpec{
+					Policy: &v1.TrafficPolicySpec_Policy{
+						LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancer{
+							LbPolicy: &v1.TrafficPolicySpec_Policy_LoadBalancer_Simple{
+								Simple: simple,
+							},
+						},
+					},
+				},
+			}
+		}
+
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "traffic-target",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "traffic-target",
+							Namespace:   "traffic-target-namespace",
+							ClusterName: "traffic-target-cluster",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+					makeLoadBalancerPolicy("tp-1", v1.TrafficPolicySpec_Policy_LoadBalancer_RANDOM),
+					makeLoadBalancerPolicy("tp-2", v1.TrafficPolicySpec_Policy_LoadBalancer_RANDOM),
+					makeLoadBalancerPolicy("tp-3", v1.TrafficPolicySpec_Policy_LoadBalancer_LEAST_REQUEST),
+				},
+			},
+		}
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(gomock.Any()).
+			Return([]decorators.Decorator{loadbalancer.NewLoadBalancerDecorator()})
+
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN(destination.Spec.GetKubeService().Ref.ClusterName, destination.Spec.GetKubeService().Ref).
+			Return("local-hostname")
+
+		// identical settings do not conflict
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(
+				destination,
+				destination.Status.AppliedTrafficPolicies[2].Ref,
+				gomock.Any()).
+			DoAndReturn(func(destination *discoveryv1.Destination, trafficPolicy ezkube.ResourceId, err error) {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("already owned by"))
+			})
+
+		destinationRule := destinationRuleTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		Expect(destinationRule.Spec.TrafficPolicy.LoadBalancer).To(Equal(&networkingv1alpha3spec.LoadBalancerSettings{
+			LbPolicy: &networkingv1alpha3spec.LoadBalancerSettings_Simple{
+				Simple: networkingv1alpha3spec.LoadBalancerSettings_RANDOM,
+			},
+		}))
+	})
+
+	It("should default federated DestinationRules to the locality load balancing settings of the VirtualMesh", func() {
+		settings.Spec = settingsv1.SettingsSpec{}
+
+		sourceMeshInstallation := &discoveryv1.MeshInstallation{
+			Cluster: "source-cluster",
+		}
+
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "traffic-target",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "traffic-target",
+							Namespace:   "traffic-target-namespace",
+							ClusterName: "traffic-target-cluster",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedFederation: &discoveryv1.DestinationStatus_AppliedFederation{
+					VirtualMeshRef: &skv2corev1.ObjectRef{
+						Name:      "virtual-mesh",
+						Namespace: "gloo-mesh",
+					},
+					LocalityLoadBalancing: &commonv1.LocalityLoadBalancing{
+						Failover: []*commonv1.LocalityLoadBalancing_Failover{
+							{
+								From: "us-east",
+								To:   "us-west",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(gomock.Any()).
+			Return([]decorators.Decorator{loadbalancer.NewLoadBalancerDecorator()}).
+			Times(2)
+
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN(sourceMeshInstallation.Cluster, destination.Spec.GetKubeService().Ref).
+			Return("global-hostname").
+			Times(2)
+
+		destinationRule := destinationRuleTranslator.Translate(ctx, in, destination, sourceMeshInstallation, mockReporter)
+		Expect(destinationRule.Spec.TrafficPolicy.LoadBalancer).To(Equal(&networkingv1alpha3spec.LoadBalancerSettings{
+			LocalityLbSetting: &networkingv1alpha3spec.LocalityLoadBalancerSetting{
+				Enabled: &types.BoolValue{Value: true},
+				Failover: []*networkingv1alpha3spec.LocalityLoadBalancerSetting_Failover{
+					{
+						From: "us-east",
+						To:   "us-west",
+					},
+				},
+			},
+		}))
+
+		// TrafficPolicies override the VirtualMesh settings
+		destination.Status.AppliedTrafficPolicies = []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			{
+				Ref: &skv2corev1.ObjectRef{
+					Name:      "tp-1",
+					Namespace: "tp-namespace-1",
+				},
+				Spec: &v1.TrafficPolicySpec{
+					Policy: &v1.TrafficPolicySpec_Policy{
+						LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancer{
+							Locality: &commonv1.LocalityLoadBalancing{
+								Disabled: true,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		destinationRule = destinationRuleTranslator.Translate(ctx, in, destination, sourceMeshInstallation, mockReporter)
+		Expect(destinationRule.Spec.TrafficPolicy.LoadBalancer).To(Equal(&networkingv1alpha3spec.LoadBalancerSettings{
+			LocalityLbSetting: &networkingv1alpha3spec.LocalityLoadBalancerSetting{
+				Enabled: &types.BoolValue{Value: false},
+			},
+		}))
+	})
+
+	It("should report invalid locality load balancing settings of the VirtualMesh", func() {
+		settings.Spec = settingsv1.SettingsSpec{}
+
+		sourceMeshInstallation := &discoveryv1.MeshInstallation{
+			Cluster: "source-cluster",
+		}
+
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "traffic-target",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "traffic-target",
+							Namespace:   "traffic-target-namespace",
+							ClusterName: "traffic-target-cluster",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedFederation: &discoveryv1.DestinationStatus_AppliedFederation{
+					VirtualMeshRef: &skv2corev1.ObjectRef{
+						Name:      "virtual-mesh",
+						Namespace: "gloo-mesh",
+					},
+					LocalityLoadBalancing: &commonv1.LocalityLoadBalancing{
+						Failover: []*commonv1.LocalityLoadBalancing_Failover{
+							{
+								From: "us-east",
+								To:   "us-east",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN(sourceMeshInstallation.Cluster, destination.Spec.GetKubeService().Ref).
+			Return("global-hostname")
+
+		mockReporter.
+			EXPECT().
+			ReportVirtualMeshToDestination(destination, destination.Status.AppliedFederation.VirtualMeshRef, gomock.Any()).
+			DoAndReturn(func(destination *discoveryv1.Destination, virtualMesh ezkube.ResourceId, err error) {
+				Expect(err.Error()).To(ContainSubstring("localityLoadBalancing"))
+			})
+
+		destinationRule := destinationRuleTranslator.Translate(ctx, in, destination, sourceMeshInstallation, mockReporter)
+		Expect(destinationRule).To(BeNil())
+	})
 })
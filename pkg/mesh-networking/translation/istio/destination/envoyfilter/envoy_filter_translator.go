@@ -0,0 +1,129 @@
+package envoyfilter
+
+import (
+	"reflect"
+
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/fieldutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/skv2/pkg/equalityutils"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+)
+
+//go:generate mockgen -source ./envoy_filter_translator.go -destination mocks/envoy_filter_translator.go
+
+// the EnvoyFilter translator translates a Destination into an EnvoyFilter which configures the Destination's sidecars.
+type Translator interface {
+	// Translate translates the appropriate EnvoyFilter for the given Destination.
+	// returns nil if no EnvoyFilter is required for the Destination (i.e. if no applied TrafficPolicy requires an Envoy HTTP filter).
+	//
+	// Errors caused by invalid user config will be reported using the Reporter.
+	Translate(
+		in input.LocalSnapshot,
+		destination *discoveryv1.Destination,
+		reporter reporting.Reporter,
+	) *networkingv1alpha3.EnvoyFilter
+}
+
+type translator struct {
+	clusterDomains   hostutils.ClusterDomainRegistry
+	decoratorFactory decorators.Factory
+}
+
+func NewTranslator(
+	clusterDomains hostutils.ClusterDomainRegistry,
+	decoratorFactory decorators.Factory,
+) Translator {
+	return &translator{
+		clusterDomains:   clusterDomains,
+		decoratorFactory: decoratorFactory,
+	}
+}
+
+func (t *translator) Translate(
+	in input.LocalSnapshot,
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) *networkingv1alpha3.EnvoyFilter {
+	kubeService := destination.Spec.GetKubeService()
+
+	// the EnvoyFilter selects the workloads backing the Destination, so only KubeServices are supported
+	if kubeService == nil {
+		return nil
+	}
+
+	envoyFilter := &networkingv1alpha3.EnvoyFilter{
+		ObjectMeta: metautils.TranslatedObjectMeta(
+			kubeService.Ref,
+			destination.Annotations,
+		),
+		Spec: networkingv1alpha3spec.EnvoyFilter{
+			WorkloadSelector: &networkingv1alpha3spec.WorkloadSelector{
+				Labels: kubeService.WorkloadSelectorLabels,
+			},
+		},
+	}
+
+	// register the owners of the envoyfilter fields
+	envoyFilterFields := fieldutils.NewOwnershipRegistry()
+	efDecorators := t.decoratorFactory.MakeDecorators(decorators.Parameters{
+		ClusterDomains: t.clusterDomains,
+		Snapshot:       in,
+	})
+
+	for _, policy := range destination.Status.AppliedTrafficPolicies {
+		registerField := registerFieldFunc(envoyFilterFields, envoyFilter, policy)
+		for _, decorator := range efDecorators {
+
+			if envoyFilterDecorator, ok := decorator.(decorators.TrafficPolicyEnvoyFilterDecorator); ok {
+				if err := envoyFilterDecorator.ApplyTrafficPolicyToEnvoyFilter(
+					policy,
+					destination,
+					&envoyFilter.Spec,
+					registerField,
+				); err != nil {
+					reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, eris.Wrapf(err, "%v", decorator.DecoratorName()))
+				}
+			}
+		}
+	}
+
+	if len(envoyFilter.Spec.ConfigPatches) == 0 {
+		return nil
+	}
+
+	return envoyFilter
+}
+
+// construct the callback for registering fields in the envoy filter
+func registerFieldFunc(
+	envoyFilterFields fieldutils.FieldOwnershipRegistry,
+	envoyFilter *networkingv1alpha3.EnvoyFilter,
+	policy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+) decorators.RegisterField {
+	return func(fieldPtr, val interface{}) error {
+		fieldVal := reflect.ValueOf(fieldPtr).Elem().Interface()
+
+		if equalityutils.DeepEqual(fieldVal, val) {
+			return nil
+		}
+		if err := envoyFilterFields.RegisterFieldOwnership(
+			envoyFilter,
+			fieldPtr,
+			[]ezkube.ResourceId{policy.Ref},
+			&v1.TrafficPolicy{},
+			policy.Spec.GetPriority(),
+		); err != nil {
+			return err
+		}
+		return nil
+	}
+}
@@ -0,0 +1,200 @@
+package envoyfilter_test
+
+import (
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/csrf"
+	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	csrfdecorator "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/csrf"
+	mock_decorators "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/envoyfilter"
+	mock_hostutils "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("EnvoyFilterTranslator", func() {
+	var (
+		ctrl                      *gomock.Controller
+		mockClusterDomainRegistry *mock_hostutils.MockClusterDomainRegistry
+		mockDecoratorFactory      *mock_decorators.MockFactory
+		mockReporter              *mock_reporting.MockReporter
+		mockDecorator             *mock_decorators.MockTrafficPolicyEnvoyFilterDecorator
+		envoyFilterTranslator     envoyfilter.Translator
+		in                        input.LocalSnapshot
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockClusterDomainRegistry = mock_hostutils.NewMockClusterDomainRegistry(ctrl)
+		mockDecoratorFactory = mock_decorators.NewMockFactory(ctrl)
+		mockReporter = mock_reporting.NewMockReporter(ctrl)
+		mockDecorator = mock_decorators.NewMockTrafficPolicyEnvoyFilterDecorator(ctrl)
+		envoyFilterTranslator = envoyfilter.NewTranslator(mockClusterDomainRegistry, mockDecoratorFactory)
+		in = input.NewInputLocalSnapshotManualBuilder("").Build()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	makeDestination := func(policies ...*discoveryv1.DestinationStatus_AppliedTrafficPolicy) *discoveryv1.Destination {
+		return &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "traffic-target",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "traffic-target",
+							Namespace:   "traffic-target-namespace",
+							ClusterName: "traffic-target-cluster",
+						},
+						WorkloadSelectorLabels: map[string]string{"app": "traffic-target"},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: policies,
+			},
+		}
+	}
+
+	It("should translate an EnvoyFilter selecting the Destination's workloads", func() {
+		destination := makeDestination(&discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "tp-1",
+				Namespace: "tp-namespace-1",
+			},
+			Spec: &v1.TrafficPolicySpec{},
+		})
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(decorators.Parameters{
+				ClusterDomains: mockClusterDomainRegistry,
+				Snapshot:       in,
+			}).
+			Return([]decorators.Decorator{mockDecorator})
+
+		configPatch := &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch{
+			ApplyTo: networkingv1alpha3spec.EnvoyFilter_HTTP_FILTER,
+		}
+		mockDecorator.
+			EXPECT().
+			ApplyTrafficPolicyToEnvoyFilter(
+				destination.Status.AppliedTrafficPolicies[0],
+				destination,
+				gomock.Any(),
+				gomock.Any(),
+			).
+			DoAndReturn(func(
+				appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+				destination *discoveryv1.Destination,
+				output *networkingv1alpha3spec.EnvoyFilter,
+				registerField decorators.RegisterField,
+			) error {
+				output.ConfigPatches = append(output.ConfigPatches, configPatch)
+				return nil
+			})
+
+		envoyFilter := envoyFilterTranslator.Translate(in, destination, mockReporter)
+		Expect(envoyFilter.ObjectMeta).To(Equal(metautils.TranslatedObjectMeta(
+			destination.Spec.GetKubeService().Ref,
+			destination.Annotations,
+		)))
+		Expect(envoyFilter.Spec).To(Equal(networkingv1alpha3spec.EnvoyFilter{
+			WorkloadSelector: &networkingv1alpha3spec.WorkloadSelector{
+				Labels: map[string]string{"app": "traffic-target"},
+			},
+			ConfigPatches: []*networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch{configPatch},
+		}))
+	})
+
+	It("should not output an EnvoyFilter if no decorators add patches", func() {
+		destination := makeDestination(&discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "tp-1",
+				Namespace: "tp-namespace-1",
+			},
+			Spec: &v1.TrafficPolicySpec{},
+		})
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(gomock.Any()).
+			Return([]decorators.Decorator{mockDecorator})
+		mockDecorator.
+			EXPECT().
+			ApplyTrafficPolicyToEnvoyFilter(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil)
+
+		envoyFilter := envoyFilterTranslator.Translate(in, destination, mockReporter)
+		Expect(envoyFilter).To(BeNil())
+	})
+
+	It("should report conflicting TrafficPolicies", func() {
+		destination := makeDestination(
+			&discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+				Ref: &skv2corev1.ObjectRef{
+					Name:      "tp-1",
+					Namespace: "tp-namespace-1",
+				},
+				Spec: &v1.TrafficPolicySpec{
+					Policy: &v1.TrafficPolicySpec_Policy{
+						Csrf: &csrf.CsrfPolicy{FilterEnabled: true},
+					},
+				},
+			},
+			&discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+				Ref: &skv2corev1.ObjectRef{
+					Name:      "tp-2",
+					Namespace: "tp-namespace-1",
+				},
+				Spec: &v1.TrafficPolicySpec{
+					Policy: &v1.TrafficPolicySpec_Policy{
+						Csrf: &csrf.CsrfPolicy{ShadowEnabled: true},
+					},
+				},
+			},
+		)
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(gomock.Any()).
+			Return([]decorators.Decorator{csrfdecorator.NewCsrfDecorator()})
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(
+				destination,
+				destination.Status.AppliedTrafficPolicies[1].Ref,
+				gomock.Any(),
+			)
+
+		envoyFilter := envoyFilterTranslator.Translate(in, destination, mockReporter)
+		Expect(envoyFilter.Spec.ConfigPatches).To(HaveLen(1))
+	})
+
+	It("should not translate ExternalService Destinations", func() {
+		destination := &discoveryv1.Destination{
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_ExternalService_{
+					ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+						Hosts: []string{"db.example.com"},
+					},
+				},
+			},
+		}
+
+		envoyFilter := envoyFilterTranslator.Translate(in, destination, mockReporter)
+		Expect(envoyFilter).To(BeNil())
+	})
+})
@@ -0,0 +1,13 @@
+package envoyfilter_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestEnvoyfilter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Envoyfilter Suite")
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/destinationrule"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/virtualservice"
+	meshfederation "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/federation"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/mtls"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/destinationutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
@@ -50,17 +52,20 @@ type translator struct {
 	ctx                       context.Context
 	virtualServiceTranslator  virtualservice.Translator
 	destinationRuleTranslator destinationrule.Translator
+	clusterDomains            hostutils.ClusterDomainRegistry
 }
 
 func NewTranslator(
 	ctx context.Context,
 	virtualServiceTranslator virtualservice.Translator,
 	destinationRuleTranslator destinationrule.Translator,
+	clusterDomains hostutils.ClusterDomainRegistry,
 ) Translator {
 	return &translator{
 		ctx:                       ctx,
 		virtualServiceTranslator:  virtualServiceTranslator,
 		destinationRuleTranslator: destinationRuleTranslator,
+		clusterDomains:            clusterDomains,
 	}
 }
 
@@ -140,6 +145,17 @@ func (t *translator) Translate(
 		virtualServices = append(virtualServices, virtualService)
 		destinationRules = append(destinationRules, destinationRule)
 
+		// with limited trust, the remote mesh's east-west gateway forwards the traffic of its clients to the Destination's mesh
+		if destinationVirtualMesh.Spec.GetMtlsConfig().GetLimited() != nil && serviceEntry != nil {
+			egressServiceEntry, egressVirtualService, egressDestinationRule := t.translateLimitedTrustEgress(destination, remoteMesh, remoteServiceEntryTemplate)
+			metautils.AppendParent(t.ctx, egressServiceEntry, destination.Status.AppliedFederation.GetVirtualMeshRef(), networkingv1.VirtualMesh{}.GVK())
+			metautils.AppendParent(t.ctx, egressVirtualService, destination.Status.AppliedFederation.GetVirtualMeshRef(), networkingv1.VirtualMesh{}.GVK())
+			metautils.AppendParent(t.ctx, egressDestinationRule, destination.Status.AppliedFederation.GetVirtualMeshRef(), networkingv1.VirtualMesh{}.GVK())
+			serviceEntries = append(serviceEntries, egressServiceEntry)
+			virtualServices = append(virtualServices, egressVirtualService)
+			destinationRules = append(destinationRules, egressDestinationRule)
+		}
+
 		// take a reference to any translated remote DestinationRule so that we can copy over any necessary fields for the local DestinationRule for the federated FQDN
 		// this avoids re-translating the DestinationRule
 		if remoteDestinationRule == nil {
@@ -153,6 +169,7 @@ func (t *translator) Translate(
 		destinationMesh,
 		remoteServiceEntryTemplate,
 		remoteDestinationRule,
+		destinationVirtualMesh,
 	)
 	if err != nil {
 		reporter.ReportVirtualMeshToDestination(destination, destinationVirtualMesh, err)
@@ -165,6 +182,13 @@ func (t *translator) Translate(
 	serviceEntries = append(serviceEntries, localServiceEntry)
 	destinationRules = append(destinationRules, localDestinationRule)
 
+	// with limited trust, cross mesh traffic is terminated at the east-west gateway and must be routed to the local Destination
+	if destinationVirtualMesh.Spec.GetMtlsConfig().GetLimited() != nil {
+		localGatewayVirtualService := t.translateLimitedTrustGatewayVirtualService(destination, destinationMesh, remoteServiceEntryTemplate)
+		metautils.AppendParent(t.ctx, localGatewayVirtualService, destination.Status.AppliedFederation.GetVirtualMeshRef(), networkingv1.VirtualMesh{}.GVK())
+		virtualServices = append(virtualServices, localGatewayVirtualService)
+	}
+
 	return serviceEntries, virtualServices, destinationRules
 }
 
@@ -215,9 +239,10 @@ func (t *translator) translateRemoteServiceEntryTemplate(
 			for _, endpointSubset := range kubeService.EndpointSubsets {
 				for _, endpoint := range endpointSubset.Endpoints {
 					workloadEntry := &networkingv1alpha3spec.WorkloadEntry{
-						Address: externalAddress,
-						Ports:   workloadEntryPortMapping,
-						Labels:  endpoint.Labels,
+						Address:  externalAddress,
+						Ports:    workloadEntryPortMapping,
+						Labels:   endpoint.Labels,
+						Locality: getEndpointLocality(kubeService.GetRegion(), endpoint.GetSubLocality()),
 					}
 					workloadEntries = append(workloadEntries, workloadEntry)
 				}
@@ -256,6 +281,7 @@ func (t *translator) translateForLocalMesh(
 	destinationMesh *discoveryv1.Mesh,
 	remoteServiceEntryTemplate *networkingv1alpha3.ServiceEntry,
 	remoteDestinationRule *networkingv1alpha3.DestinationRule,
+	destinationVirtualMesh *networkingv1.VirtualMesh,
 ) (*networkingv1alpha3.ServiceEntry, *networkingv1alpha3.DestinationRule, error) {
 	federatedHostname := destination.Status.AppliedFederation.GetFederatedHostname()
 	destinationIstioMesh := destinationMesh.Spec.GetIstio()
@@ -276,9 +302,10 @@ func (t *translator) translateForLocalMesh(
 			}
 
 			workloadEntry := &networkingv1alpha3spec.WorkloadEntry{
-				Address: endpoint.IpAddress,
-				Ports:   ports,
-				Labels:  endpoint.Labels,
+				Address:  endpoint.IpAddress,
+				Ports:    ports,
+				Labels:   endpoint.Labels,
+				Locality: getEndpointLocality(destination.Spec.GetKubeService().GetRegion(), endpoint.GetSubLocality()),
 			}
 			workloadEntries = append(workloadEntries, workloadEntry)
 		}
@@ -311,6 +338,14 @@ func (t *translator) translateForLocalMesh(
 	var dr *networkingv1alpha3.DestinationRule
 	// if the remote DestinationRule is nil, that means no DestinationRule config is required for this federated Destination
 	if remoteDestinationRule != nil {
+		trafficPolicy := remoteDestinationRule.Spec.TrafficPolicy
+		// with limited trust, the gateway uses Istio mTLS rather than the gateway credentials when forwarding to the local Destination
+		if destinationVirtualMesh.Spec.GetMtlsConfig().GetLimited() != nil && trafficPolicy != nil {
+			trafficPolicy = trafficPolicy.DeepCopy()
+			trafficPolicy.Tls = &networkingv1alpha3spec.ClientTLSSettings{
+				Mode: networkingv1alpha3spec.ClientTLSSettings_ISTIO_MUTUAL,
+			}
+		}
 		dr = &networkingv1alpha3.DestinationRule{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        federatedHostname,
@@ -321,7 +356,7 @@ func (t *translator) translateForLocalMesh(
 			Spec: networkingv1alpha3spec.DestinationRule{
 				Host:          federatedHostname,
 				Subsets:       remoteDestinationRule.Spec.Subsets,
-				TrafficPolicy: remoteDestinationRule.Spec.TrafficPolicy,
+				TrafficPolicy: trafficPolicy,
 			},
 		}
 	}
@@ -366,9 +401,226 @@ func (t *translator) translateForRemoteMesh(
 	// translate DestinationRule for federated Destinations, can be nil
 	destinationRule := t.destinationRuleTranslator.Translate(t.ctx, in, destination, remoteIstioMesh.Installation, reporter)
 
+	// with limited trust, clients cannot authenticate to the Destination's east-west gateway,
+	// so they send traffic to the remote mesh's east-west gateway using Istio mTLS instead
+	if destinationVirtualMesh.Spec.GetMtlsConfig().GetLimited() != nil {
+		endpoints, err := t.translateLimitedTrustEgressEndpoints(destination, in, remoteMesh)
+		if err != nil {
+			reporter.ReportVirtualMeshToDestination(destination, destinationVirtualMesh, err)
+			return nil, nil, nil
+		}
+		serviceEntry.Spec.Endpoints = endpoints
+		serviceEntry.Spec.Resolution = networkingv1alpha3spec.ServiceEntry_DNS
+
+		if destinationRule == nil {
+			destinationRule = &networkingv1alpha3.DestinationRule{
+				ObjectMeta: metautils.FederatedObjectMeta(destination, remoteIstioMesh.Installation, nil),
+				Spec: networkingv1alpha3spec.DestinationRule{
+					Host: destination.Status.AppliedFederation.GetFederatedHostname(),
+				},
+			}
+		}
+		if destinationRule.Spec.TrafficPolicy == nil {
+			destinationRule.Spec.TrafficPolicy = &networkingv1alpha3spec.TrafficPolicy{}
+		}
+		// the SNI selects the gateway server forwarding traffic to the Destination's mesh
+		destinationRule.Spec.TrafficPolicy.Tls = &networkingv1alpha3spec.ClientTLSSettings{
+			Mode: networkingv1alpha3spec.ClientTLSSettings_ISTIO_MUTUAL,
+			Sni:  destination.Status.AppliedFederation.GetFederatedHostname(),
+		}
+	}
+
+	return serviceEntry, virtualService, destinationRule
+}
+
+// translate the endpoints of the federated Destination for clients in a remote mesh using limited trust,
+// which are the remote mesh's own east-west gateways
+func (t *translator) translateLimitedTrustEgressEndpoints(
+	destination *discoveryv1.Destination,
+	in input.LocalSnapshot,
+	remoteMesh *discoveryv1.Mesh,
+) ([]*networkingv1alpha3spec.WorkloadEntry, error) {
+	appliedIngressGateways := remoteMesh.Status.GetAppliedEastWestIngressGateways()
+	if len(appliedIngressGateways) == 0 {
+		return nil, eris.Errorf("istio mesh %v has no applied east west ingress gateways to forward traffic to other meshes with limited trust", sets.Key(remoteMesh))
+	}
+
+	kubeService := destination.Spec.GetKubeService()
+	var workloadEntries []*networkingv1alpha3spec.WorkloadEntry
+	for _, appliedIngressGateway := range appliedIngressGateways {
+		gatewayDestination, err := in.Destinations().Find(ezkube.MakeObjectRef(appliedIngressGateway.GetDestinationRef()))
+		if err != nil {
+			return nil, eris.Wrapf(err, "east west ingress gateway of istio mesh %v not found", sets.Key(remoteMesh))
+		}
+		gatewayKubeService := gatewayDestination.Spec.GetKubeService()
+		gatewayPort := getGatewayServicePort(gatewayKubeService, appliedIngressGateway)
+
+		ports := map[string]uint32{}
+		for _, port := range kubeService.GetPorts() {
+			portName := port.Name
+			// fall back to protocol for port name if k8s port name is unpopulated
+			if portName == "" {
+				portName = port.Protocol
+			}
+			ports[portName] = gatewayPort
+		}
+
+		for _, endpointSubset := range kubeService.EndpointSubsets {
+			for _, endpoint := range endpointSubset.Endpoints {
+				workloadEntries = append(workloadEntries, &networkingv1alpha3spec.WorkloadEntry{
+					Address:  t.clusterDomains.GetLocalFQDN(gatewayKubeService.GetRef()),
+					Ports:    ports,
+					Labels:   endpoint.Labels,
+					Locality: getEndpointLocality(kubeService.GetRegion(), endpoint.GetSubLocality()),
+				})
+			}
+		}
+	}
+	return workloadEntries, nil
+}
+
+// returns the port of the gateway's Service which targets the gateway's tls container port
+func getGatewayServicePort(
+	gatewayKubeService *discoveryv1.DestinationSpec_KubeService,
+	appliedIngressGateway *discoveryv1.MeshStatus_AppliedIngressGateway,
+) uint32 {
+	for _, port := range gatewayKubeService.GetPorts() {
+		if port.GetTargetPortNumber() == appliedIngressGateway.GetContainerPort() {
+			return port.GetPort()
+		}
+	}
+	return appliedIngressGateway.GetDestinationPort()
+}
+
+// translate the resources which forward the traffic of clients in a remote mesh using limited trust
+// from the remote mesh's east-west gateway to the Destination's east-west gateway.
+// The gateway authenticates using the gateway credentials issued to the remote mesh.
+// Because the gateway terminates TLS, only the first port of the Destination is reachable from remote meshes.
+func (t *translator) translateLimitedTrustEgress(
+	destination *discoveryv1.Destination,
+	remoteMesh *discoveryv1.Mesh,
+	serviceEntryTemplate *networkingv1alpha3.ServiceEntry,
+) (
+	*networkingv1alpha3.ServiceEntry,
+	*networkingv1alpha3.VirtualService,
+	*networkingv1alpha3.DestinationRule,
+) {
+	federatedHostname := destination.Status.AppliedFederation.GetFederatedHostname()
+	egressHostname := limitedTrustEgressHostname(federatedHostname)
+	remoteIstioMesh := remoteMesh.Spec.GetIstio()
+
+	objectMeta := metav1.ObjectMeta{
+		Name:        egressHostname,
+		Namespace:   remoteIstioMesh.Installation.Namespace,
+		ClusterName: remoteIstioMesh.Installation.Cluster,
+		Labels:      metautils.TranslatedObjectLabels(),
+	}
+
+	// the egress host resolves to the Destination's east-west gateways
+	serviceEntry := serviceEntryTemplate.DeepCopy()
+	serviceEntry.ObjectMeta = objectMeta
+	serviceEntry.Spec.Hosts = []string{egressHostname}
+	serviceEntry.Spec.Addresses = nil
+	// only export to Gateway workload namespace
+	serviceEntry.Spec.ExportTo = []string{"."}
+
+	destinationRule := &networkingv1alpha3.DestinationRule{
+		ObjectMeta: *objectMeta.DeepCopy(),
+		Spec: networkingv1alpha3spec.DestinationRule{
+			Host:     egressHostname,
+			ExportTo: []string{"."},
+			TrafficPolicy: &networkingv1alpha3spec.TrafficPolicy{
+				// credentialName is only supported by gateways
+				Tls: &networkingv1alpha3spec.ClientTLSSettings{
+					Mode:           networkingv1alpha3spec.ClientTLSSettings_MUTUAL,
+					CredentialName: mtls.LimitedTrustCredentialSecretName,
+					Sni:            federatedHostname,
+				},
+			},
+		},
+	}
+
+	var gateways []string
+	for _, appliedIngressGateway := range remoteMesh.Status.GetAppliedEastWestIngressGateways() {
+		gateways = append(gateways, remoteIstioMesh.Installation.Namespace+"/"+meshfederation.BuildGatewayName(appliedIngressGateway))
+	}
+
+	routeDestination := &networkingv1alpha3spec.Destination{
+		Host: egressHostname,
+	}
+	if len(serviceEntryTemplate.Spec.Ports) > 0 {
+		routeDestination.Port = &networkingv1alpha3spec.PortSelector{
+			Number: serviceEntryTemplate.Spec.Ports[0].Number,
+		}
+	}
+
+	virtualService := &networkingv1alpha3.VirtualService{
+		ObjectMeta: *objectMeta.DeepCopy(),
+		Spec: networkingv1alpha3spec.VirtualService{
+			Hosts:    []string{federatedHostname},
+			Gateways: gateways,
+			ExportTo: []string{"."},
+			Tcp: []*networkingv1alpha3spec.TCPRoute{{
+				Route: []*networkingv1alpha3spec.RouteDestination{{
+					Destination: routeDestination,
+				}},
+			}},
+		},
+	}
+
 	return serviceEntry, virtualService, destinationRule
 }
 
+// the host used by east-west gateways to forward traffic for the federated hostname to the Destination's mesh
+func limitedTrustEgressHostname(federatedHostname string) string {
+	return "egress." + federatedHostname
+}
+
+// translate the VirtualService which routes traffic terminated at the local east-west gateways to the Destination.
+// Because the gateway terminates TLS, only the first port of the Destination is reachable from remote meshes.
+func (t *translator) translateLimitedTrustGatewayVirtualService(
+	destination *discoveryv1.Destination,
+	destinationMesh *discoveryv1.Mesh,
+	remoteServiceEntryTemplate *networkingv1alpha3.ServiceEntry,
+) *networkingv1alpha3.VirtualService {
+	federatedHostname := destination.Status.AppliedFederation.GetFederatedHostname()
+	destinationIstioMesh := destinationMesh.Spec.GetIstio()
+
+	var gateways []string
+	for _, appliedIngressGateway := range destinationMesh.Status.GetAppliedEastWestIngressGateways() {
+		gateways = append(gateways, destinationIstioMesh.Installation.Namespace+"/"+meshfederation.BuildGatewayName(appliedIngressGateway))
+	}
+
+	routeDestination := &networkingv1alpha3spec.Destination{
+		Host: federatedHostname,
+	}
+	if len(remoteServiceEntryTemplate.Spec.Ports) > 0 {
+		routeDestination.Port = &networkingv1alpha3spec.PortSelector{
+			Number: remoteServiceEntryTemplate.Spec.Ports[0].Number,
+		}
+	}
+
+	return &networkingv1alpha3.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        federatedHostname,
+			Namespace:   destinationIstioMesh.Installation.Namespace,
+			ClusterName: destinationIstioMesh.Installation.Cluster,
+			Labels:      metautils.TranslatedObjectLabels(),
+		},
+		Spec: networkingv1alpha3spec.VirtualService{
+			Hosts:    []string{federatedHostname},
+			Gateways: gateways,
+			// only export to Gateway workload namespace
+			ExportTo: []string{"."},
+			Tcp: []*networkingv1alpha3spec.TCPRoute{{
+				Route: []*networkingv1alpha3spec.RouteDestination{{
+					Destination: routeDestination,
+				}},
+			}},
+		},
+	}
+}
+
 // ConvertKubePortProtocol converts protocol of k8s Service port to application level protocol
 // exported for use in enterprise
 func ConvertKubePortProtocol(port *discoveryv1.DestinationSpec_KubeService_KubeServicePort) string {
@@ -388,6 +640,25 @@ func getHostnameSuffix(hostname string) string {
 	return split[len(split)-1]
 }
 
+// Return the discovered locality of the endpoint in the form region/zone/sub-zone, which allows Istio to prefer
+// federated endpoints closest to the client. Returns the empty string if the region of the endpoint is unknown.
+func getEndpointLocality(
+	region string,
+	subLocality *discoveryv1.DestinationSpec_KubeService_EndpointsSubset_Endpoint_SubLocality,
+) string {
+	if region == "" {
+		return ""
+	}
+	locality := region
+	if zone := subLocality.GetZone(); zone != "" {
+		locality += "/" + zone
+		if subZone := subLocality.GetSubZone(); subZone != "" {
+			locality += "/" + subZone
+		}
+	}
+	return locality
+}
+
 // Workaround for Istio issue where ipv6 addresses are supplied to Envoy incorrectly: https://github.com/envoyproxy/envoy/issues/10489#issuecomment-606290733.
 // If any endpoints have an ipv6 address, set the resolution to STATIC,
 // else, set the resolution to DNS.
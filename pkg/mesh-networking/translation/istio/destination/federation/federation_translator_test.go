@@ -7,15 +7,19 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
 	mock_destinationrule "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/destinationrule/mocks"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/federation"
 	mock_virtualservice "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/virtualservice/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/mtls"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	"github.com/solo-io/gloo-mesh/test/data"
 	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	skv1alpha1sets "github.com/solo-io/skv2/pkg/api/multicluster.solo.io/v1alpha1/sets"
 	"github.com/solo-io/skv2/pkg/ezkube"
 	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
 	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
@@ -39,7 +43,12 @@ var _ = Describe("FederationTranslator", func() {
 		mockVirtualServiceTranslator = mock_virtualservice.NewMockTranslator(ctrl)
 		mockDestinationRuleTranslator = mock_destinationrule.NewMockTranslator(ctrl)
 		mockReporter = mock_reporting.NewMockReporter(ctrl)
-		federationTranslator = federation.NewTranslator(ctx, mockVirtualServiceTranslator, mockDestinationRuleTranslator)
+		federationTranslator = federation.NewTranslator(
+			ctx,
+			mockVirtualServiceTranslator,
+			mockDestinationRuleTranslator,
+			hostutils.NewClusterDomainRegistry(skv1alpha1sets.NewKubernetesClusterSet(), discoveryv1sets.NewDestinationSet()),
+		)
 	})
 
 	AfterEach(func() {
@@ -147,7 +156,8 @@ var _ = Describe("FederationTranslator", func() {
 			Spec: discoveryv1.DestinationSpec{
 				Type: &discoveryv1.DestinationSpec_KubeService_{
 					KubeService: &discoveryv1.DestinationSpec_KubeService{
-						Ref: backingService,
+						Ref:    backingService,
+						Region: "us-east",
 						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
 							{
 								Port:     1234,
@@ -162,12 +172,19 @@ var _ = Describe("FederationTranslator", func() {
 										Labels: map[string]string{
 											"version": "v1",
 										},
+										SubLocality: &discoveryv1.DestinationSpec_KubeService_EndpointsSubset_Endpoint_SubLocality{
+											Zone:    "us-east-1a",
+											SubZone: "rack-1",
+										},
 									},
 									{
 										IpAddress: "192.168.21.2",
 										Labels: map[string]string{
 											"version": "v2",
 										},
+										SubLocality: &discoveryv1.DestinationSpec_KubeService_EndpointsSubset_Endpoint_SubLocality{
+											Zone: "us-east-1b",
+										},
 									},
 								},
 								Ports: []*discoveryv1.DestinationSpec_KubeService_EndpointPort{
@@ -278,28 +295,32 @@ var _ = Describe("FederationTranslator", func() {
 						Ports: map[string]uint32{
 							"TCP": 8181,
 						},
-						Labels: map[string]string{"version": "v1"},
+						Labels:   map[string]string{"version": "v1"},
+						Locality: "us-east/us-east-1a/rack-1",
 					},
 					{
 						Address: "mesh-gateway.dns.name",
 						Ports: map[string]uint32{
 							"TCP": 8181,
 						},
-						Labels: map[string]string{"version": "v2"},
+						Labels:   map[string]string{"version": "v2"},
+						Locality: "us-east/us-east-1b",
 					},
 					{
 						Address: "172.18.0.2",
 						Ports: map[string]uint32{
 							"TCP": 8181,
 						},
-						Labels: map[string]string{"version": "v1"},
+						Labels:   map[string]string{"version": "v1"},
+						Locality: "us-east/us-east-1a/rack-1",
 					},
 					{
 						Address: "172.18.0.2",
 						Ports: map[string]uint32{
 							"TCP": 8181,
 						},
-						Labels: map[string]string{"version": "v2"},
+						Labels:   map[string]string{"version": "v2"},
+						Locality: "us-east/us-east-1b",
 					},
 				},
 			},
@@ -332,15 +353,17 @@ var _ = Describe("FederationTranslator", func() {
 						// map to the local hostname
 						Address: "192.168.21.1",
 						// needed for cross cluster subset routing
-						Labels: map[string]string{"version": "v1"},
-						Ports:  map[string]uint32{"TCP": 1234},
+						Labels:   map[string]string{"version": "v1"},
+						Ports:    map[string]uint32{"TCP": 1234},
+						Locality: "us-east/us-east-1a/rack-1",
 					},
 					{
 						// map to the local hostname
 						Address: "192.168.21.2",
 						// needed for cross cluster subset routing
-						Labels: map[string]string{"version": "v2"},
-						Ports:  map[string]uint32{"TCP": 1234},
+						Labels:   map[string]string{"version": "v2"},
+						Ports:    map[string]uint32{"TCP": 1234},
+						Locality: "us-east/us-east-1b",
 					},
 				},
 				Ports: expectedRemoteServiceEntry.Spec.Ports,
@@ -371,6 +394,272 @@ var _ = Describe("FederationTranslator", func() {
 		Expect(destinationRules).To(ConsistOf([]*networkingv1alpha3.DestinationRule{expectedRemoteDR, expectedRemoteDR, expectedLocalDestinationRule}))
 	})
 
+	It("authenticates cross mesh traffic at the east-west gateway for a VirtualMesh using limited trust", func() {
+		destinationMesh := &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "config-namespace",
+				Name:      "federated-mesh",
+			},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Istio_{Istio: &discoveryv1.MeshSpec_Istio{
+					Installation: &discoveryv1.MeshInstallation{
+						Namespace: "namespace",
+						Cluster:   "cluster",
+					},
+				}},
+			},
+			Status: discoveryv1.MeshStatus{
+				AppliedEastWestIngressGateways: []*discoveryv1.MeshStatus_AppliedIngressGateway{
+					{
+						DestinationRef: &skv2corev1.ObjectRef{
+							Name:      "istio-ingressgateway",
+							Namespace: "istio-system",
+						},
+						ExternalAddresses: []string{"mesh-gateway.dns.name"},
+						DestinationPort:   8181,
+					},
+				},
+			},
+		}
+
+		remoteMesh := &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "config-namespace",
+				Name:      "client-mesh",
+			},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Istio_{Istio: &discoveryv1.MeshSpec_Istio{
+					Installation: &discoveryv1.MeshInstallation{
+						Namespace: "remote-namespace",
+						Cluster:   "remote-cluster",
+					},
+				}},
+			},
+			Status: discoveryv1.MeshStatus{
+				AppliedEastWestIngressGateways: []*discoveryv1.MeshStatus_AppliedIngressGateway{
+					{
+						DestinationRef: &skv2corev1.ObjectRef{
+							Name:      "istio-eastwestgateway",
+							Namespace: "istio-system",
+						},
+						ExternalAddresses: []string{"remote-gateway.dns.name"},
+						DestinationPort:   32443,
+						ContainerPort:     15443,
+					},
+				},
+			},
+		}
+
+		remoteGatewayDestination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "istio-eastwestgateway",
+				Namespace: "istio-system",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "istio-eastwestgateway",
+							Namespace:   "istio-system",
+							ClusterName: "remote-cluster",
+						},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port:       443,
+								Name:       "tls",
+								TargetPort: &discoveryv1.DestinationSpec_KubeService_KubeServicePort_TargetPortNumber{TargetPortNumber: 15443},
+								NodePort:   32443,
+							},
+						},
+					},
+				},
+				Mesh: ezkube.MakeObjectRef(remoteMesh),
+			},
+		}
+
+		destinationVirtualMesh := &networkingv1.VirtualMesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virtual-mesh",
+				Namespace: "namespace",
+			},
+			Spec: networkingv1.VirtualMeshSpec{
+				MtlsConfig: &networkingv1.VirtualMeshSpec_MTLSConfig{
+					TrustModel: &networkingv1.VirtualMeshSpec_MTLSConfig_Limited{
+						Limited: &networkingv1.VirtualMeshSpec_MTLSConfig_LimitedTrust{},
+					},
+				},
+			},
+		}
+
+		destination := &discoveryv1.Destination{
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "some-svc",
+							Namespace:   "some-ns",
+							ClusterName: "cluster",
+						},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port:     1234,
+								Name:     "http",
+								Protocol: "TCP",
+							},
+						},
+						EndpointSubsets: []*discoveryv1.DestinationSpec_KubeService_EndpointsSubset{
+							{
+								Endpoints: []*discoveryv1.DestinationSpec_KubeService_EndpointsSubset_Endpoint{
+									{
+										IpAddress: "192.168.21.1",
+										Labels:    map[string]string{"version": "v1"},
+									},
+								},
+								Ports: []*discoveryv1.DestinationSpec_KubeService_EndpointPort{
+									{
+										Port:     1234,
+										Name:     "http",
+										Protocol: "TCP",
+									},
+								},
+							},
+						},
+					},
+				},
+				Mesh: ezkube.MakeObjectRef(destinationMesh),
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedFederation: &discoveryv1.DestinationStatus_AppliedFederation{
+					VirtualMeshRef:    ezkube.MakeObjectRef(destinationVirtualMesh),
+					FederatedHostname: "some-svc.some-ns.svc.cluster.global",
+					FederatedToMeshes: []*skv2corev1.ObjectRef{
+						ezkube.MakeObjectRef(remoteMesh),
+					},
+				},
+			},
+		}
+
+		in := input.NewInputLocalSnapshotManualBuilder("ignored").
+			AddDestinations(discoveryv1.DestinationSlice{destination, remoteGatewayDestination}).
+			AddMeshes(discoveryv1.MeshSlice{destinationMesh, remoteMesh}).
+			AddVirtualMeshes(networkingv1.VirtualMeshSlice{destinationVirtualMesh}).
+			Build()
+
+		mockVirtualServiceTranslator.
+			EXPECT().
+			Translate(ctx, in, destination, remoteMesh.Spec.GetIstio().Installation, mockReporter).
+			Return(nil)
+		mockDestinationRuleTranslator.
+			EXPECT().
+			Translate(ctx, in, destination, remoteMesh.Spec.GetIstio().Installation, mockReporter).
+			Return(&networkingv1alpha3.DestinationRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "some-svc-some-ns-cluster",
+					Namespace:   "remote-namespace",
+					ClusterName: "remote-cluster",
+				},
+				Spec: networkingv1alpha3spec.DestinationRule{
+					TrafficPolicy: &networkingv1alpha3spec.TrafficPolicy{
+						Tls: &networkingv1alpha3spec.ClientTLSSettings{
+							Mode: networkingv1alpha3spec.ClientTLSSettings_ISTIO_MUTUAL,
+						},
+					},
+				},
+			})
+
+		serviceEntries, virtualServices, destinationRules := federationTranslator.Translate(in, destination, mockReporter)
+
+		// clients in the remote mesh send traffic to their own east-west gateway using Istio mTLS
+		Expect(serviceEntries[0].Spec.Hosts).To(Equal([]string{"some-svc.some-ns.svc.cluster.global"}))
+		Expect(serviceEntries[0].Spec.Resolution).To(Equal(networkingv1alpha3spec.ServiceEntry_DNS))
+		Expect(serviceEntries[0].Spec.Endpoints).To(Equal([]*networkingv1alpha3spec.WorkloadEntry{{
+			Address: "istio-eastwestgateway.istio-system.svc.cluster.local",
+			Ports:   map[string]uint32{"http": 443},
+			Labels:  map[string]string{"version": "v1"},
+		}}))
+		Expect(destinationRules).To(HaveLen(3))
+		Expect(destinationRules[0].Spec.TrafficPolicy.Tls).To(Equal(&networkingv1alpha3spec.ClientTLSSettings{
+			Mode: networkingv1alpha3spec.ClientTLSSettings_ISTIO_MUTUAL,
+			Sni:  "some-svc.some-ns.svc.cluster.global",
+		}))
+
+		// the remote mesh's gateway presents the gateway credentials issued to its mesh to the Destination's gateway
+		Expect(serviceEntries[1].Name).To(Equal("egress.some-svc.some-ns.svc.cluster.global"))
+		Expect(serviceEntries[1].Spec.Hosts).To(Equal([]string{"egress.some-svc.some-ns.svc.cluster.global"}))
+		Expect(serviceEntries[1].Spec.Endpoints[0].Address).To(Equal("mesh-gateway.dns.name"))
+		Expect(destinationRules[1].Spec).To(Equal(networkingv1alpha3spec.DestinationRule{
+			Host:     "egress.some-svc.some-ns.svc.cluster.global",
+			ExportTo: []string{"."},
+			TrafficPolicy: &networkingv1alpha3spec.TrafficPolicy{
+				Tls: &networkingv1alpha3spec.ClientTLSSettings{
+					Mode:           networkingv1alpha3spec.ClientTLSSettings_MUTUAL,
+					CredentialName: mtls.LimitedTrustCredentialSecretName,
+					Sni:            "some-svc.some-ns.svc.cluster.global",
+				},
+			},
+		}))
+		Expect(virtualServices).To(ContainElement(&networkingv1alpha3.VirtualService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "egress.some-svc.some-ns.svc.cluster.global",
+				Namespace:   "remote-namespace",
+				ClusterName: "remote-cluster",
+				Labels:      metautils.TranslatedObjectLabels(),
+				Annotations: map[string]string{
+					metautils.ParentLabelkey: `{"networking.mesh.gloo.solo.io/v1, Kind=VirtualMesh":[{"name":"virtual-mesh","namespace":"namespace"}]}`,
+				},
+			},
+			Spec: networkingv1alpha3spec.VirtualService{
+				Hosts:    []string{"some-svc.some-ns.svc.cluster.global"},
+				Gateways: []string{"remote-namespace/istio-eastwestgateway-istio-system"},
+				ExportTo: []string{"."},
+				Tcp: []*networkingv1alpha3spec.TCPRoute{{
+					Route: []*networkingv1alpha3spec.RouteDestination{{
+						Destination: &networkingv1alpha3spec.Destination{
+							Host: "egress.some-svc.some-ns.svc.cluster.global",
+							Port: &networkingv1alpha3spec.PortSelector{
+								Number: 1234,
+							},
+						},
+					}},
+				}},
+			},
+		}))
+
+		// the Destination's gateway forwards to the local Destination using Istio mTLS
+		Expect(destinationRules[2].ClusterName).To(Equal("cluster"))
+		Expect(destinationRules[2].Spec.TrafficPolicy.Tls).To(Equal(&networkingv1alpha3spec.ClientTLSSettings{
+			Mode: networkingv1alpha3spec.ClientTLSSettings_ISTIO_MUTUAL,
+		}))
+
+		expectedGatewayVirtualService := &networkingv1alpha3.VirtualService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "some-svc.some-ns.svc.cluster.global",
+				Namespace:   "namespace",
+				ClusterName: "cluster",
+				Labels:      metautils.TranslatedObjectLabels(),
+				Annotations: map[string]string{
+					metautils.ParentLabelkey: `{"networking.mesh.gloo.solo.io/v1, Kind=VirtualMesh":[{"name":"virtual-mesh","namespace":"namespace"}]}`,
+				},
+			},
+			Spec: networkingv1alpha3spec.VirtualService{
+				Hosts:    []string{"some-svc.some-ns.svc.cluster.global"},
+				Gateways: []string{"namespace/istio-ingressgateway-istio-system"},
+				ExportTo: []string{"."},
+				Tcp: []*networkingv1alpha3spec.TCPRoute{{
+					Route: []*networkingv1alpha3spec.RouteDestination{{
+						Destination: &networkingv1alpha3spec.Destination{
+							Host: "some-svc.some-ns.svc.cluster.global",
+							Port: &networkingv1alpha3spec.PortSelector{
+								Number: 1234,
+							},
+						},
+					}},
+				}},
+			},
+		}
+		Expect(virtualServices).To(ContainElement(expectedGatewayVirtualService))
+	})
+
 	It("should set ServiceEntry resolution to STATIC if any endpoints have ipv6 address", func() {
 		workloadEntries := []*networkingv1alpha3spec.WorkloadEntry{
 			{
@@ -15,6 +15,8 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/authorizationpolicy"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/destinationrule"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/envoyfilter"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/requestauthentication"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/virtualservice"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
@@ -39,12 +41,14 @@ type Translator interface {
 }
 
 type translator struct {
-	ctx                   context.Context
-	userSupplied          input.RemoteSnapshot
-	destinationRules      destinationrule.Translator
-	virtualServices       virtualservice.Translator
-	authorizationPolicies authorizationpolicy.Translator
-	federation            federation.Translator
+	ctx                    context.Context
+	userSupplied           input.RemoteSnapshot
+	destinationRules       destinationrule.Translator
+	virtualServices        virtualservice.Translator
+	authorizationPolicies  authorizationpolicy.Translator
+	requestAuthentications requestauthentication.Translator
+	envoyFilters           envoyfilter.Translator
+	federation             federation.Translator
 }
 
 func NewTranslator(
@@ -61,15 +65,17 @@ func NewTranslator(
 		existingDestinationRules = userSupplied.DestinationRules()
 	}
 
-	virtualServiceTranslator := virtualservice.NewTranslator(existingVirtualServices, clusterDomains, decoratorFactory)
+	virtualServiceTranslator := virtualservice.NewTranslator(settingsutils.SettingsFromContext(ctx), existingVirtualServices, clusterDomains, decoratorFactory)
 	destinationRuleTranslator := destinationrule.NewTranslator(settingsutils.SettingsFromContext(ctx), existingDestinationRules, clusterDomains, decoratorFactory, destinations)
 
 	return &translator{
-		ctx:                   ctx,
-		virtualServices:       virtualServiceTranslator,
-		destinationRules:      destinationRuleTranslator,
-		authorizationPolicies: authorizationpolicy.NewTranslator(),
-		federation:            federation.NewTranslator(ctx, virtualServiceTranslator, destinationRuleTranslator),
+		ctx:                    ctx,
+		virtualServices:        virtualServiceTranslator,
+		destinationRules:       destinationRuleTranslator,
+		authorizationPolicies:  authorizationpolicy.NewTranslator(),
+		requestAuthentications: requestauthentication.NewTranslator(),
+		envoyFilters:           envoyfilter.NewTranslator(clusterDomains, decoratorFactory),
+		federation:             federation.NewTranslator(ctx, virtualServiceTranslator, destinationRuleTranslator, clusterDomains),
 	}
 }
 
@@ -98,11 +104,28 @@ func (t *translator) Translate(
 	metautils.AppendParent(t.ctx, dr, destination, destination.GVK())
 	outputs.AddDestinationRules(dr)
 
-	// Translate AuthorizationPolicies for Destinations, can be nil if there is no service or applied traffic policies
-	ap := t.authorizationPolicies.Translate(in, destination, reporter)
-	// Append the Destination as a parent to the authorization policy
-	metautils.AppendParent(t.ctx, ap, destination, destination.GVK())
-	outputs.AddAuthorizationPolicies(ap)
+	// Translate AuthorizationPolicies for Destinations, can be empty if there is no service or applied access policies
+	aps := t.authorizationPolicies.Translate(in, destination, reporter)
+	for _, ap := range aps {
+		// Append the Destination as a parent to the authorization policy
+		metautils.AppendParent(t.ctx, ap, destination, destination.GVK())
+	}
+	outputs.AddAuthorizationPolicies(aps...)
+
+	// Translate RequestAuthentications for Destinations, can be nil if no applied traffic policies set a JWT policy
+	// along with the AuthorizationPolicy which rejects requests without a JWT, can be nil if the JWT policy allows them
+	ra, jwtAp := t.requestAuthentications.Translate(destination, reporter)
+	// Append the Destination as a parent to the request authentication and authorization policy
+	metautils.AppendParent(t.ctx, ra, destination, destination.GVK())
+	metautils.AppendParent(t.ctx, jwtAp, destination, destination.GVK())
+	outputs.AddRequestAuthentications(ra)
+	outputs.AddAuthorizationPolicies(jwtAp)
+
+	// Translate EnvoyFilters for Destinations, can be nil if no applied traffic policies require Envoy HTTP filters
+	ef := t.envoyFilters.Translate(in, destination, reporter)
+	// Append the Destination as a parent to the envoy filter
+	metautils.AppendParent(t.ctx, ef, destination, destination.GVK())
+	outputs.AddEnvoyFilters(ef)
 
 	// parent annotations are added inside Translate()
 	serviceEntries, virtualServices, destinationRules := t.federation.Translate(in, destination, reporter)
@@ -11,7 +11,9 @@ import (
 	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
 	mock_authorizationpolicy "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/authorizationpolicy/mocks"
 	mock_destinationrule "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/destinationrule/mocks"
+	mock_envoyfilter "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/envoyfilter/mocks"
 	mock_federation "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/federation/mocks"
+	mock_requestauthentication "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/requestauthentication/mocks"
 	mock_virtualservice "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/virtualservice/mocks"
 	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"istio.io/client-go/pkg/apis/networking/v1alpha3"
@@ -21,15 +23,17 @@ import (
 
 var _ = Describe("IstioDestinationTranslator", func() {
 	var (
-		ctrl                              *gomock.Controller
-		mockDestinationRuleTranslator     *mock_destinationrule.MockTranslator
-		mockVirtualServiceTranslator      *mock_virtualservice.MockTranslator
-		mockAuthorizationPolicyTranslator *mock_authorizationpolicy.MockTranslator
-		mockFederationTranslator          *mock_federation.MockTranslator
-		mockOutputs                       *mock_output.MockBuilder
-		mockReporter                      *mock_reporting.MockReporter
-		istioDestinationTranslator        Translator
-		ctx                               = context.TODO()
+		ctrl                                *gomock.Controller
+		mockDestinationRuleTranslator       *mock_destinationrule.MockTranslator
+		mockVirtualServiceTranslator        *mock_virtualservice.MockTranslator
+		mockAuthorizationPolicyTranslator   *mock_authorizationpolicy.MockTranslator
+		mockRequestAuthenticationTranslator *mock_requestauthentication.MockTranslator
+		mockEnvoyFilterTranslator           *mock_envoyfilter.MockTranslator
+		mockFederationTranslator            *mock_federation.MockTranslator
+		mockOutputs                         *mock_output.MockBuilder
+		mockReporter                        *mock_reporting.MockReporter
+		istioDestinationTranslator          Translator
+		ctx                                 = context.TODO()
 	)
 
 	BeforeEach(func() {
@@ -37,15 +41,19 @@ var _ = Describe("IstioDestinationTranslator", func() {
 		mockDestinationRuleTranslator = mock_destinationrule.NewMockTranslator(ctrl)
 		mockVirtualServiceTranslator = mock_virtualservice.NewMockTranslator(ctrl)
 		mockAuthorizationPolicyTranslator = mock_authorizationpolicy.NewMockTranslator(ctrl)
+		mockRequestAuthenticationTranslator = mock_requestauthentication.NewMockTranslator(ctrl)
+		mockEnvoyFilterTranslator = mock_envoyfilter.NewMockTranslator(ctrl)
 		mockFederationTranslator = mock_federation.NewMockTranslator(ctrl)
 		mockOutputs = mock_output.NewMockBuilder(ctrl)
 		mockReporter = mock_reporting.NewMockReporter(ctrl)
 		istioDestinationTranslator = &translator{
-			ctx:                   ctx,
-			destinationRules:      mockDestinationRuleTranslator,
-			virtualServices:       mockVirtualServiceTranslator,
-			authorizationPolicies: mockAuthorizationPolicyTranslator,
-			federation:            mockFederationTranslator,
+			ctx:                    ctx,
+			destinationRules:       mockDestinationRuleTranslator,
+			virtualServices:        mockVirtualServiceTranslator,
+			authorizationPolicies:  mockAuthorizationPolicyTranslator,
+			requestAuthentications: mockRequestAuthenticationTranslator,
+			envoyFilters:           mockEnvoyFilterTranslator,
+			federation:             mockFederationTranslator,
 		}
 	})
 
@@ -81,7 +89,10 @@ var _ = Describe("IstioDestinationTranslator", func() {
 
 		vs := &v1alpha3.VirtualService{}
 		dr := &v1alpha3.DestinationRule{}
-		ap := &v1beta1.AuthorizationPolicy{}
+		aps := []*v1beta1.AuthorizationPolicy{{}}
+		ra := &v1beta1.RequestAuthentication{}
+		jwtAp := &v1beta1.AuthorizationPolicy{}
+		ef := &v1alpha3.EnvoyFilter{}
 		federatedSe := []*v1alpha3.ServiceEntry{}
 		federatedVs := []*v1alpha3.VirtualService{}
 		federatedDr := []*v1alpha3.DestinationRule{}
@@ -97,7 +108,15 @@ var _ = Describe("IstioDestinationTranslator", func() {
 		mockAuthorizationPolicyTranslator.
 			EXPECT().
 			Translate(in, destination, mockReporter).
-			Return(ap)
+			Return(aps)
+		mockRequestAuthenticationTranslator.
+			EXPECT().
+			Translate(destination, mockReporter).
+			Return(ra, jwtAp)
+		mockEnvoyFilterTranslator.
+			EXPECT().
+			Translate(in, destination, mockReporter).
+			Return(ef)
 		mockFederationTranslator.
 			EXPECT().
 			Translate(in, destination, mockReporter).
@@ -110,7 +129,16 @@ var _ = Describe("IstioDestinationTranslator", func() {
 			AddDestinationRules(dr)
 		mockOutputs.
 			EXPECT().
-			AddAuthorizationPolicies(ap)
+			AddAuthorizationPolicies(aps)
+		mockOutputs.
+			EXPECT().
+			AddRequestAuthentications(ra)
+		mockOutputs.
+			EXPECT().
+			AddAuthorizationPolicies(jwtAp)
+		mockOutputs.
+			EXPECT().
+			AddEnvoyFilters(ef)
 		mockOutputs.
 			EXPECT().
 			AddServiceEntries(federatedSe)
@@ -0,0 +1,185 @@
+package requestauthentication
+
+import (
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/jwt"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	securityv1beta1spec "istio.io/api/security/v1beta1"
+	typesv1beta1 "istio.io/api/type/v1beta1"
+	securityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+)
+
+//go:generate mockgen -source ./request_authentication_translator.go -destination mocks/request_authentication_translator.go
+
+const (
+	translatorName = "request-authentication-translator"
+
+	// suffix appended to the name of the AuthorizationPolicy which rejects requests without a JWT
+	requireJwtPolicyNameSuffix = "-require-jwt"
+)
+
+// the RequestAuthentication translator translates a Destination into a RequestAuthentication,
+// which validates the JWTs of requests to the Destination.
+type Translator interface {
+	// Translate translates the appropriate RequestAuthentication for the given Destination,
+	// along with a DENY AuthorizationPolicy which rejects requests without a JWT unless the JWT policy allows them.
+	// returns nil if no RequestAuthentication is required for the Destination (i.e. if no applied TrafficPolicy sets a JWT policy).
+	//
+	// Errors caused by invalid user config will be reported using the Reporter.
+	Translate(
+		destination *discoveryv1.Destination,
+		reporter reporting.Reporter,
+	) (*securityv1beta1.RequestAuthentication, *securityv1beta1.AuthorizationPolicy)
+}
+
+type translator struct{}
+
+func NewTranslator() Translator {
+	return &translator{}
+}
+
+func (t *translator) Translate(
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) (*securityv1beta1.RequestAuthentication, *securityv1beta1.AuthorizationPolicy) {
+	if destination.Spec.GetExternalService() != nil {
+		reportExternalServiceJwtPolicies(destination, reporter)
+		return nil, nil
+	}
+
+	kubeService := destination.Spec.GetKubeService()
+
+	if kubeService == nil {
+		return nil, nil
+	}
+
+	// JWT policies are merged with the REJECT strategy, so at most one JWT policy applies to the Destination
+	var jwtPolicy *jwt.JwtPolicy
+	for _, policy := range destination.Status.AppliedTrafficPolicies {
+		policyJwt := policy.GetSpec().GetPolicy().GetJwt()
+		if policyJwt == nil {
+			continue
+		}
+		if len(policy.GetSpec().GetHttpRequestMatchers()) > 0 || len(policy.GetSpec().GetSourceSelector()) > 0 {
+			reporter.ReportTrafficPolicyToDestination(destination, policy.GetRef(),
+				eris.Errorf("%v: JWT policies cannot be applied to a subset of requests, httpRequestMatchers and sourceSelector must be empty", translatorName))
+			continue
+		}
+		if err := validateJwtPolicy(policyJwt); err != nil {
+			reporter.ReportTrafficPolicyToDestination(destination, policy.GetRef(), eris.Wrapf(err, "%v", translatorName))
+			continue
+		}
+		jwtPolicy = policyJwt
+		break
+	}
+
+	if jwtPolicy == nil {
+		return nil, nil
+	}
+
+	workloadSelector := &typesv1beta1.WorkloadSelector{
+		MatchLabels: kubeService.WorkloadSelectorLabels,
+	}
+
+	requestAuthentication := &securityv1beta1.RequestAuthentication{
+		ObjectMeta: metautils.TranslatedObjectMeta(
+			kubeService.Ref,
+			destination.Annotations,
+		),
+		Spec: securityv1beta1spec.RequestAuthentication{
+			Selector: workloadSelector,
+			JwtRules: translateJwtRules(jwtPolicy),
+		},
+	}
+
+	// a RequestAuthentication only rejects requests with invalid JWTs, requests without a JWT must be rejected by an AuthorizationPolicy
+	if jwtPolicy.GetAllowMissingJwt() {
+		return requestAuthentication, nil
+	}
+
+	authPolicy := &securityv1beta1.AuthorizationPolicy{
+		ObjectMeta: metautils.TranslatedObjectMeta(
+			kubeService.Ref,
+			destination.Annotations,
+		),
+		Spec: securityv1beta1spec.AuthorizationPolicy{
+			Selector: workloadSelector,
+			Action:   securityv1beta1spec.AuthorizationPolicy_DENY,
+			Rules: []*securityv1beta1spec.Rule{
+				{
+					From: []*securityv1beta1spec.Rule_From{
+						{
+							Source: &securityv1beta1spec.Source{
+								// the request principal is only set for requests with a JWT validated by the RequestAuthentication
+								NotRequestPrincipals: []string{"*"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	authPolicy.Name += requireJwtPolicyNameSuffix
+
+	return requestAuthentication, authPolicy
+}
+
+// Istio RequestAuthentications are enforced by the server-side proxy, which does not exist for ExternalServices.
+// Report an error for JWT policies which explicitly select an ExternalService so that users are aware
+// they are not enforced, while ignoring JWT policies which select ExternalServices through wildcards.
+func reportExternalServiceJwtPolicies(
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) {
+	for _, policy := range destination.Status.GetAppliedTrafficPolicies() {
+		if policy.GetSpec().GetPolicy().GetJwt() == nil {
+			continue
+		}
+		for _, destinationSelector := range policy.GetSpec().GetDestinationSelector() {
+			if destinationSelector.GetExternalServiceRefs() != nil {
+				reporter.ReportTrafficPolicyToDestination(
+					destination,
+					policy.GetRef(),
+					eris.Errorf("%v: JWT policies cannot be enforced for ExternalService Destinations", translatorName),
+				)
+				break
+			}
+		}
+	}
+}
+
+func validateJwtPolicy(jwtPolicy *jwt.JwtPolicy) error {
+	if len(jwtPolicy.GetProviders()) == 0 {
+		return eris.New("JWT policy must specify at least one provider")
+	}
+	var errs *multierror.Error
+	for i, provider := range jwtPolicy.GetProviders() {
+		if provider.GetIssuer() == "" {
+			errs = multierror.Append(errs, eris.Errorf("Providers[%d]: issuer must be specified", i))
+		}
+		for j, claimToHeader := range provider.GetClaimsToHeaders() {
+			if claimToHeader.GetClaim() == "" || claimToHeader.GetHeader() == "" {
+				errs = multierror.Append(errs, eris.Errorf("Providers[%d].ClaimsToHeaders[%d]: claim and header must be specified", i, j))
+			}
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+// claims are forwarded to headers by the jwt decorator, as Istio JWTRules do not support it
+func translateJwtRules(jwtPolicy *jwt.JwtPolicy) []*securityv1beta1spec.JWTRule {
+	var jwtRules []*securityv1beta1spec.JWTRule
+	for _, provider := range jwtPolicy.GetProviders() {
+		jwtRules = append(jwtRules, &securityv1beta1spec.JWTRule{
+			Issuer:               provider.GetIssuer(),
+			Audiences:            provider.GetAudiences(),
+			Jwks:                 provider.GetJwks(),
+			JwksUri:              provider.GetJwksUri(),
+			ForwardOriginalToken: provider.GetForwardOriginalToken(),
+		})
+	}
+	return jwtRules
+}
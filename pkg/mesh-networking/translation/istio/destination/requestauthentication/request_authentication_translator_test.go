@@ -0,0 +1,197 @@
+package requestauthentication_test
+
+import (
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/jwt"
+	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/requestauthentication"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	v1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	securityv1beta1spec "istio.io/api/security/v1beta1"
+	"istio.io/api/type/v1beta1"
+	securityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("RequestAuthenticationTranslator", func() {
+	var (
+		ctrl         *gomock.Controller
+		translator   requestauthentication.Translator
+		mockReporter *mock_reporting.MockReporter
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockReporter = mock_reporting.NewMockReporter(ctrl)
+		translator = requestauthentication.NewTranslator()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	kubeServiceRef := &v1.ClusterObjectRef{
+		Name:        "kube-service",
+		Namespace:   "kube-service-namespace",
+		ClusterName: "cluster",
+	}
+
+	makeDestination := func(policies ...*discoveryv1.DestinationStatus_AppliedTrafficPolicy) *discoveryv1.Destination {
+		return &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ms",
+				Namespace: "ms-namespace",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref:                    kubeServiceRef,
+						WorkloadSelectorLabels: map[string]string{"app": "kube-service"},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: policies,
+			},
+		}
+	}
+
+	makeAppliedPolicy := func(jwtPolicy *jwt.JwtPolicy) *discoveryv1.DestinationStatus_AppliedTrafficPolicy {
+		return &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &v1.ObjectRef{
+				Name:      "tp",
+				Namespace: "tp-namespace",
+			},
+			Spec: &networkingv1.TrafficPolicySpec{
+				Policy: &networkingv1.TrafficPolicySpec_Policy{
+					Jwt: jwtPolicy,
+				},
+			},
+		}
+	}
+
+	It("should translate a RequestAuthentication and an AuthorizationPolicy requiring a JWT", func() {
+		destination := makeDestination(makeAppliedPolicy(&jwt.JwtPolicy{
+			Providers: []*jwt.JwtProvider{
+				{
+					Issuer:    "https://issuer.example.com",
+					Audiences: []string{"petstore"},
+					JwksSource: &jwt.JwtProvider_JwksUri{
+						JwksUri: "https://issuer.example.com/.well-known/jwks.json",
+					},
+					ForwardOriginalToken: true,
+				},
+				{
+					Issuer: "other-issuer",
+					JwksSource: &jwt.JwtProvider_Jwks{
+						Jwks: `{"keys":[]}`,
+					},
+				},
+			},
+		}))
+
+		expectedRequestAuthentication := &securityv1beta1.RequestAuthentication{
+			ObjectMeta: metautils.TranslatedObjectMeta(kubeServiceRef, destination.Annotations),
+			Spec: securityv1beta1spec.RequestAuthentication{
+				Selector: &v1beta1.WorkloadSelector{
+					MatchLabels: map[string]string{"app": "kube-service"},
+				},
+				JwtRules: []*securityv1beta1spec.JWTRule{
+					{
+						Issuer:               "https://issuer.example.com",
+						Audiences:            []string{"petstore"},
+						JwksUri:              "https://issuer.example.com/.well-known/jwks.json",
+						ForwardOriginalToken: true,
+					},
+					{
+						Issuer: "other-issuer",
+						Jwks:   `{"keys":[]}`,
+					},
+				},
+			},
+		}
+		expectedAuthPolicy := &securityv1beta1.AuthorizationPolicy{
+			ObjectMeta: metautils.TranslatedObjectMeta(kubeServiceRef, destination.Annotations),
+			Spec: securityv1beta1spec.AuthorizationPolicy{
+				Selector: &v1beta1.WorkloadSelector{
+					MatchLabels: map[string]string{"app": "kube-service"},
+				},
+				Action: securityv1beta1spec.AuthorizationPolicy_DENY,
+				Rules: []*securityv1beta1spec.Rule{
+					{
+						From: []*securityv1beta1spec.Rule_From{
+							{
+								Source: &securityv1beta1spec.Source{
+									NotRequestPrincipals: []string{"*"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		expectedAuthPolicy.Name += "-require-jwt"
+
+		requestAuthentication, authPolicy := translator.Translate(destination, mockReporter)
+		Expect(requestAuthentication).To(Equal(expectedRequestAuthentication))
+		Expect(authPolicy).To(Equal(expectedAuthPolicy))
+	})
+
+	It("should not translate an AuthorizationPolicy if requests without a JWT are allowed", func() {
+		destination := makeDestination(makeAppliedPolicy(&jwt.JwtPolicy{
+			Providers:       []*jwt.JwtProvider{{Issuer: "issuer"}},
+			AllowMissingJwt: true,
+		}))
+
+		requestAuthentication, authPolicy := translator.Translate(destination, mockReporter)
+		Expect(requestAuthentication).ToNot(BeNil())
+		Expect(authPolicy).To(BeNil())
+	})
+
+	It("should not translate anything if no JWT policy is applied", func() {
+		destination := makeDestination(makeAppliedPolicy(nil))
+
+		requestAuthentication, authPolicy := translator.Translate(destination, mockReporter)
+		Expect(requestAuthentication).To(BeNil())
+		Expect(authPolicy).To(BeNil())
+	})
+
+	It("should report invalid JWT policies", func() {
+		invalidProvider := makeAppliedPolicy(&jwt.JwtPolicy{
+			Providers: []*jwt.JwtProvider{
+				{
+					ClaimsToHeaders: []*jwt.JwtProvider_ClaimToHeader{{Claim: "sub"}},
+				},
+			},
+		})
+		requestSubset := makeAppliedPolicy(&jwt.JwtPolicy{
+			Providers: []*jwt.JwtProvider{{Issuer: "issuer"}},
+		})
+		requestSubset.Ref.Name = "tp-2"
+		requestSubset.Spec.SourceSelector = []*commonv1.WorkloadSelector{{}}
+		destination := makeDestination(invalidProvider, requestSubset)
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, invalidProvider.Ref, gomock.Any()).
+			DoAndReturn(func(_ *discoveryv1.Destination, _ *v1.ObjectRef, err error) {
+				Expect(err).To(MatchError(ContainSubstring("Providers[0]: issuer must be specified")))
+				Expect(err).To(MatchError(ContainSubstring("Providers[0].ClaimsToHeaders[0]: claim and header must be specified")))
+			})
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, requestSubset.Ref, gomock.Any()).
+			DoAndReturn(func(_ *discoveryv1.Destination, _ *v1.ObjectRef, err error) {
+				Expect(err).To(MatchError(ContainSubstring("httpRequestMatchers and sourceSelector must be empty")))
+			})
+
+		requestAuthentication, authPolicy := translator.Translate(destination, mockReporter)
+		Expect(requestAuthentication).To(BeNil())
+		Expect(authPolicy).To(BeNil())
+	})
+})
@@ -0,0 +1,13 @@
+package requestauthentication_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRequestauthentication(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Requestauthentication Suite")
+}
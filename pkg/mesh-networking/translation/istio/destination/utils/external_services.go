@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"istio.io/istio/pkg/config/protocol"
+)
+
+// Return the hostname targeted by VirtualServices and DestinationRules translated for an ExternalService Destination.
+// Istio routes and traffic policies apply to a single host, so ExternalServices with multiple hosts cannot be configured.
+func ExternalServiceHostname(externalService *discoveryv1.DestinationSpec_ExternalService) (string, error) {
+	if len(externalService.GetHosts()) != 1 {
+		return "", eris.Errorf("TrafficPolicies can only be applied to ExternalServices with exactly one host, found %v", externalService.GetHosts())
+	}
+	return externalService.GetHosts()[0], nil
+}
+
+// Return the numbers of the ports on the ExternalService that can carry HTTP routes.
+func ExternalServiceHttpPorts(externalService *discoveryv1.DestinationSpec_ExternalService) []uint32 {
+	var ports []uint32
+	for _, port := range externalService.GetPorts() {
+		if protocol.Parse(port.GetProtocol()).IsHTTP() {
+			ports = append(ports, port.GetNumber())
+		}
+	}
+	return ports
+}
+
+// Return the numbers of the ports on the ExternalService that can carry TCP and TLS routes.
+func ExternalServiceConnectionPorts(externalService *discoveryv1.DestinationSpec_ExternalService) []uint32 {
+	var ports []uint32
+	for _, port := range externalService.GetPorts() {
+		if portProtocol := protocol.Parse(port.GetProtocol()); !portProtocol.IsHTTP() && portProtocol != protocol.UDP {
+			ports = append(ports, port.GetNumber())
+		}
+	}
+	return ports
+}
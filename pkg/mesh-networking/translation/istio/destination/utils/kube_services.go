@@ -0,0 +1,25 @@
+package utils
+
+import (
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"istio.io/istio/pkg/config/kube"
+	"istio.io/istio/pkg/config/protocol"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Return the numbers of the ports on the Kubernetes service that can carry TCP and TLS routes,
+// i.e. the TCP ports which Istio does not treat as HTTP ports according to their appProtocol or name.
+func KubeServiceConnectionPorts(kubeService *discoveryv1.DestinationSpec_KubeService) []uint32 {
+	var ports []uint32
+	for _, port := range kubeService.GetPorts() {
+		var appProtocol *string
+		if port.GetAppProtocol() != "" {
+			appProtocol = &port.AppProtocol
+		}
+		portProtocol := kube.ConvertProtocol(int32(port.GetPort()), port.GetName(), corev1.Protocol(port.GetProtocol()), appProtocol)
+		if !portProtocol.IsHTTP() && portProtocol != protocol.UDP {
+			ports = append(ports, port.GetPort())
+		}
+	}
+	return ports
+}
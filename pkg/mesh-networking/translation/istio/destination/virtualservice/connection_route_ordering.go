@@ -0,0 +1,95 @@
+package virtualservice
+
+import (
+	"strings"
+
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+)
+
+type TcpRoutesBySpecificity []*networkingv1alpha3spec.TCPRoute
+
+func (b TcpRoutesBySpecificity) Len() int {
+	return len(b)
+}
+
+func (b TcpRoutesBySpecificity) Less(i, j int) bool {
+	if len(b[i].GetMatch()) == 0 || len(b[j].GetMatch()) == 0 {
+		return len(b[i].GetMatch()) > 0
+	}
+	// each TCPRoute translated by Gloo Mesh is guaranteed to only have a single L4MatchAttributes
+	a := b[i].GetMatch()[0]
+	c := b[j].GetMatch()[0]
+	return isSourceMatchMoreSpecific(a.GetSourceLabels(), a.GetSourceNamespace(), a.GetPort(), c.GetSourceLabels(), c.GetSourceNamespace(), c.GetPort())
+}
+
+func (b TcpRoutesBySpecificity) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+type TlsRoutesBySpecificity []*networkingv1alpha3spec.TLSRoute
+
+func (b TlsRoutesBySpecificity) Len() int {
+	return len(b)
+}
+
+func (b TlsRoutesBySpecificity) Less(i, j int) bool {
+	if len(b[i].GetMatch()) == 0 || len(b[j].GetMatch()) == 0 {
+		return len(b[i].GetMatch()) > 0
+	}
+	// each TLSRoute translated by Gloo Mesh is guaranteed to only have a single TLSMatchAttributes
+	a := b[i].GetMatch()[0]
+	c := b[j].GetMatch()[0]
+	if isSniHostsMoreSpecific(a.GetSniHosts(), c.GetSniHosts()) {
+		return true
+	} else if isSniHostsMoreSpecific(c.GetSniHosts(), a.GetSniHosts()) {
+		return false
+	}
+	return isSourceMatchMoreSpecific(a.GetSourceLabels(), a.GetSourceNamespace(), a.GetPort(), c.GetSourceLabels(), c.GetSourceNamespace(), c.GetPort())
+}
+
+func (b TlsRoutesBySpecificity) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+// Order decreasing by specificity of the source and port of a TCP or TLS matcher, by the following fields:
+//  1. SourceLabels, number of items decreasing
+//  2. SourceNamespace, alphabetical decreasing
+//  3. Port, with unset ports last and in increasing order otherwise for determinism
+func isSourceMatchMoreSpecific(
+	labelsA map[string]string, namespaceA string, portA uint32,
+	labelsB map[string]string, namespaceB string, portB uint32,
+) bool {
+	if len(labelsA) > len(labelsB) {
+		return true
+	} else if len(labelsA) < len(labelsB) {
+		return false
+	}
+	if namespaceA > namespaceB {
+		return true
+	} else if namespaceA < namespaceB {
+		return false
+	}
+	if portA == portB || portA == 0 {
+		return false
+	}
+	return portB == 0 || portA < portB
+}
+
+// SNI hosts without wildcards are more specific, then fewer SNI hosts
+func isSniHostsMoreSpecific(a, b []string) bool {
+	wildcardsA, wildcardsB := countWildcards(a), countWildcards(b)
+	if wildcardsA != wildcardsB {
+		return wildcardsA < wildcardsB
+	}
+	return len(a) < len(b)
+}
+
+func countWildcards(hosts []string) int {
+	var wildcards int
+	for _, host := range hosts {
+		if strings.Contains(host, "*") {
+			wildcards++
+		}
+	}
+	return wildcards
+}
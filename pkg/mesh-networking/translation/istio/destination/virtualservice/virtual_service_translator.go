@@ -4,16 +4,18 @@ import (
 	"context"
 	"reflect"
 	"sort"
+	"strings"
 
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/canaryutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/routeutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/trafficpolicyutils"
 
-	"github.com/golang/protobuf/proto"
 	v1alpha3sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
-	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/utils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/selectorutils"
 	skv2sets "github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"github.com/solo-io/skv2/pkg/ezkube"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -21,6 +23,7 @@ import (
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/fieldutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
@@ -33,6 +36,9 @@ import (
 
 //go:generate mockgen -source ./virtual_service_translator.go -destination mocks/virtual_service_translator.go
 
+// the reserved gateway name which applies a VirtualService to all sidecars in the mesh
+const meshGateway = "mesh"
+
 // Translator translates a Destination into a VirtualService.
 type Translator interface {
 	/*
@@ -57,17 +63,20 @@ type Translator interface {
 }
 
 type translator struct {
+	settings            *settingsv1.Settings
 	userVirtualServices v1alpha3sets.VirtualServiceSet
 	clusterDomains      hostutils.ClusterDomainRegistry
 	decoratorFactory    decorators.Factory
 }
 
 func NewTranslator(
+	settings *settingsv1.Settings,
 	userVirtualServices v1alpha3sets.VirtualServiceSet,
 	clusterDomains hostutils.ClusterDomainRegistry,
 	decoratorFactory decorators.Factory,
 ) Translator {
 	return &translator{
+		settings:            settings,
 		userVirtualServices: userVirtualServices,
 		clusterDomains:      clusterDomains,
 		decoratorFactory:    decoratorFactory,
@@ -83,21 +92,51 @@ func (t *translator) Translate(
 	sourceMeshInstallation *discoveryv1.MeshInstallation,
 	reporter reporting.Reporter,
 ) *networkingv1alpha3.VirtualService {
-	kubeService := destination.Spec.GetKubeService()
+	var (
+		destinationRef  *skv2corev1.ClusterObjectRef
+		destinationFQDN string
+		sourceCluster   string
+		ports           []uint32
+		// the ports that can carry TCP and TLS routes
+		connectionPorts []uint32
+	)
 
-	if kubeService == nil {
-		// TODO(ilackarms): non kube services currently unsupported
+	switch destinationType := destination.Spec.GetType().(type) {
+	case *discoveryv1.DestinationSpec_KubeService_:
+		kubeService := destinationType.KubeService
+		destinationRef = kubeService.GetRef()
+		sourceCluster = destinationRef.GetClusterName()
+		if sourceMeshInstallation != nil {
+			sourceCluster = sourceMeshInstallation.Cluster
+		}
+		destinationFQDN = t.clusterDomains.GetDestinationFQDN(sourceCluster, destinationRef)
+		for _, port := range kubeService.GetPorts() {
+			ports = append(ports, port.GetPort())
+		}
+		connectionPorts = utils.KubeServiceConnectionPorts(kubeService)
+	case *discoveryv1.DestinationSpec_ExternalService_:
+		externalService := destinationType.ExternalService
+		// ExternalServices are only routed to from the cluster on which their ServiceEntry exists
+		if sourceMeshInstallation != nil || len(destination.Status.GetAppliedTrafficPolicies()) == 0 {
+			return nil
+		}
+		hostname, err := utils.ExternalServiceHostname(externalService)
+		if err != nil {
+			for _, policy := range destination.Status.GetAppliedTrafficPolicies() {
+				reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, err)
+			}
+			return nil
+		}
+		destinationRef = externalService.GetRef()
+		sourceCluster = destinationRef.GetClusterName()
+		destinationFQDN = hostname
+		ports = utils.ExternalServiceHttpPorts(externalService)
+		connectionPorts = utils.ExternalServiceConnectionPorts(externalService)
+	default:
 		return nil
 	}
 
-	sourceCluster := kubeService.Ref.ClusterName
-	if sourceMeshInstallation != nil {
-		sourceCluster = sourceMeshInstallation.Cluster
-	}
-
-	destinationFQDN := t.clusterDomains.GetDestinationFQDN(sourceCluster, destination.Spec.GetKubeService().Ref)
-
-	virtualService := t.initializeVirtualService(destination, sourceMeshInstallation, destinationFQDN)
+	virtualService := t.initializeVirtualService(destinationRef, destination.Annotations, sourceMeshInstallation, destinationFQDN)
 	// register the owners of the virtualservice fields
 	virtualServiceFields := fieldutils.NewOwnershipRegistry()
 	vsDecorators := t.decoratorFactory.MakeDecorators(decorators.Parameters{
@@ -107,6 +146,9 @@ func (t *translator) Translate(
 
 	appliedTpsByRequestMatcher := groupAppliedTpsByRequestMatcher(destination.Status.AppliedTrafficPolicies)
 
+	// the TrafficPolicies from which each route was translated, used to report conflicts with user-supplied routes
+	policiesByRoute := map[*networkingv1alpha3spec.HTTPRoute][]*discoveryv1.DestinationStatus_AppliedTrafficPolicy{}
+
 	for _, tpsByRequestMatcher := range appliedTpsByRequestMatcher {
 
 		// initialize base route for TP's group by request matcher
@@ -118,7 +160,17 @@ func (t *translator) Translate(
 				continue
 			}
 
-			registerField := registerFieldFunc(virtualServiceFields, virtualService, policy.Ref)
+			if err := validateConnectionPolicy(policy.Spec); err != nil {
+				reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, err)
+			}
+			if err := validateConnectionMatcherPorts(policy.Spec, connectionPorts); err != nil {
+				reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, err)
+			}
+			if err := routeutils.ValidateRequestMatchers(policy.Spec.GetHttpRequestMatchers()); err != nil {
+				reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, err)
+			}
+
+			registerField := registerFieldFunc(virtualServiceFields, virtualService, policy)
 			for _, decorator := range vsDecorators {
 
 				if trafficPolicyDecorator, ok := decorator.(decorators.TrafficPolicyVirtualServiceDecorator); ok {
@@ -140,13 +192,30 @@ func (t *translator) Translate(
 			continue
 		}
 
+		if spec := tpsByRequestMatcher[0].Spec; hasConnectionMatchers(spec) {
+			// only the route destinations of the base route can be applied to TCP and TLS routes
+			if baseRoute.Route != nil {
+				virtualService.Spec.Tcp = append(virtualService.Spec.Tcp, translateTcpRoutes(spec, baseRoute.Route, connectionPorts)...)
+				virtualService.Spec.Tls = append(virtualService.Spec.Tls, translateTlsRoutes(spec, baseRoute.Route, connectionPorts, destinationFQDN)...)
+			}
+			if len(spec.GetHttpRequestMatchers()) == 0 {
+				continue
+			}
+		}
+
 		// set a default destination for the route (to the target Destination)
 		// if a decorator has not already set it
 		t.setDefaultDestinationAndPortMatchers(baseRoute, destinationFQDN)
 
 		// construct a copy of a route for each service port
 		// required because Istio needs the destination port for every route
-		routesPerPort := duplicateRouteForEachPort(baseRoute, kubeService.Ports)
+		routesPerPort := duplicateRouteForEachPort(baseRoute, ports)
+
+		// requests of the canary cohort are routed to the canary subset regardless of the canary's weight.
+		// cohort routes match more headers than the base route, so they are ordered before it when sorting routes by specificity.
+		if cohortRoute := translateCanaryCohortRoute(tpsByRequestMatcher, baseRoute); cohortRoute != nil {
+			routesPerPort = append(routesPerPort, duplicateRouteForEachPort(cohortRoute, ports)...)
+		}
 
 		// split routes with multiple HTTP matchers into one matcher per route for easier route sorting later on
 		var routesWithSingleMatcher []*networkingv1alpha3spec.HTTPRoute
@@ -154,13 +223,18 @@ func (t *translator) Translate(
 			splitRoutes := splitRouteByMatchers(route)
 			routesWithSingleMatcher = append(routesWithSingleMatcher, splitRoutes...)
 		}
+		for _, route := range routesWithSingleMatcher {
+			policiesByRoute[route] = tpsByRequestMatcher
+		}
 
 		virtualService.Spec.Http = append(virtualService.Spec.Http, routesWithSingleMatcher...)
 	}
 
 	sort.Sort(RoutesBySpecificity(virtualService.Spec.Http))
+	sort.Sort(TcpRoutesBySpecificity(virtualService.Spec.Tcp))
+	sort.Sort(TlsRoutesBySpecificity(virtualService.Spec.Tls))
 
-	if len(virtualService.Spec.Http) == 0 {
+	if len(virtualService.Spec.Http) == 0 && len(virtualService.Spec.Tcp) == 0 && len(virtualService.Spec.Tls) == 0 {
 		// no need to create this VirtualService as it has no effect
 		return nil
 	}
@@ -170,19 +244,28 @@ func (t *translator) Translate(
 	}
 
 	// detect and report error on intersecting config if enabled in settings
-	if errs := conflictsWithUserVirtualService(
-		t.userVirtualServices,
-		virtualService,
-	); len(errs) > 0 {
-		for _, err := range errs {
-			for _, policy := range destination.Status.AppliedTrafficPolicies {
-				reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, err)
-			}
+	conflictingVirtualServices := conflictingUserVirtualServices(t.userVirtualServices, virtualService)
+	if len(conflictingVirtualServices) == 0 {
+		return virtualService
+	}
+
+	// translated routes can only be combined with a single user-supplied VirtualService
+	if len(conflictingVirtualServices) == 1 {
+		switch t.userVirtualServiceMode(destination) {
+		case settingsv1.UserVirtualServiceSettings_MERGE:
+			return mergeVirtualService(conflictingVirtualServices[0], virtualService, policiesByRoute, destination, reporter)
+		case settingsv1.UserVirtualServiceSettings_DELEGATE:
+			return delegateVirtualService(conflictingVirtualServices[0], virtualService, destination, reporter)
 		}
-		return nil
 	}
 
-	return virtualService
+	for _, vs := range conflictingVirtualServices {
+		reportToAllPolicies(destination, reporter,
+			eris.Errorf("Unable to translate AppliedTrafficPolicies to VirtualService, applies to hosts %+v that are already configured by the existing VirtualService %s",
+				utils.CommonHostnames(vs.Spec.Hosts, virtualService.Spec.Hosts), skv2sets.Key(vs)),
+		)
+	}
+	return nil
 }
 
 // ensure that only a single VirtualService HTTPRoute gets created per TrafficPolicy request matcher
@@ -196,7 +279,7 @@ func groupAppliedTpsByRequestMatcher(
 		var grouped bool
 		for i, groupedTps := range allGroupedTps {
 			// append to existing group
-			if requestMatchersEqual(appliedTp.Spec, groupedTps[0].Spec) {
+			if trafficpolicyutils.RequestMatchersEqual(appliedTp.Spec, groupedTps[0].Spec) {
 				allGroupedTps[i] = append(groupedTps, appliedTp)
 				grouped = true
 				break
@@ -211,59 +294,11 @@ func groupAppliedTpsByRequestMatcher(
 	return allGroupedTps
 }
 
-func requestMatchersEqual(tp1, tp2 *v1.TrafficPolicySpec) bool {
-	return workloadSelectorListsEqual(tp1.GetSourceSelector(), tp2.GetSourceSelector()) &&
-		httpRequestMatchersEqual(tp1.GetHttpRequestMatchers(), tp2.GetHttpRequestMatchers())
-}
-
-func httpRequestMatchersEqual(matchers1, matchers2 []*v1.HttpMatcher) bool {
-	if len(matchers1) != len(matchers2) {
-		return false
-	}
-	for i := range matchers1 {
-		if !proto.Equal(matchers1[i], matchers2[i]) {
-			return false
-		}
-	}
-	return true
-}
-
-// return true if workload selectors' labels and namespaces are equivalent, ignore clusters
-func workloadSelectorsEqual(ws1, ws2 *commonv1.WorkloadSelector) bool {
-	return reflect.DeepEqual(ws1.GetKubeWorkloadMatcher().Labels, ws2.GetKubeWorkloadMatcher().Labels) &&
-		sets.NewString(ws1.GetKubeWorkloadMatcher().Namespaces...).Equal(sets.NewString(ws2.GetKubeWorkloadMatcher().Namespaces...))
-}
-
-// return true if two lists of WorkloadSelectors are semantically equivalent, abstracting away order
-func workloadSelectorListsEqual(wsList1, wsList2 []*commonv1.WorkloadSelector) bool {
-	if len(wsList1) != len(wsList2) {
-		return false
-	}
-	matchedWs2 := sets.NewInt()
-	for _, ws1 := range wsList1 {
-		var matched bool
-		for i, ws2 := range wsList2 {
-			if matchedWs2.Has(i) {
-				continue
-			}
-			if workloadSelectorsEqual(ws1, ws2) {
-				matchedWs2.Insert(i)
-				matched = true
-				break
-			}
-		}
-		if !matched {
-			return false
-		}
-	}
-	return true
-}
-
 // construct the callback for registering fields in the virtual service
 func registerFieldFunc(
 	virtualServiceFields fieldutils.FieldOwnershipRegistry,
 	virtualService *networkingv1alpha3.VirtualService,
-	policyRef ezkube.ResourceId,
+	policy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
 ) decorators.RegisterField {
 	return func(fieldPtr, val interface{}) error {
 		fieldVal := reflect.ValueOf(fieldPtr).Elem().Interface()
@@ -274,9 +309,10 @@ func registerFieldFunc(
 		if err := virtualServiceFields.RegisterFieldOwnership(
 			virtualService,
 			fieldPtr,
-			[]ezkube.ResourceId{policyRef},
+			[]ezkube.ResourceId{policy.Ref},
 			&v1.TrafficPolicy{},
-			0, // TODO(ilackarms): priority
+			// TrafficPolicies are applied in order of increasing priority, so that higher priority TrafficPolicies override lower priority ones
+			policy.Spec.GetPriority(),
 		); err != nil {
 			return err
 		}
@@ -285,21 +321,22 @@ func registerFieldFunc(
 }
 
 func (t *translator) initializeVirtualService(
-	destination *discoveryv1.Destination,
+	destinationRef *skv2corev1.ClusterObjectRef,
+	annotations map[string]string,
 	sourceMeshInstallation *discoveryv1.MeshInstallation,
 	destinationFQDN string,
 ) *networkingv1alpha3.VirtualService {
 	var meta metav1.ObjectMeta
 	if sourceMeshInstallation != nil {
 		meta = metautils.FederatedObjectMeta(
-			destination.Spec.GetKubeService().Ref,
+			destinationRef,
 			sourceMeshInstallation,
-			destination.Annotations,
+			annotations,
 		)
 	} else {
 		meta = metautils.TranslatedObjectMeta(
-			destination.Spec.GetKubeService().Ref,
-			destination.Annotations,
+			destinationRef,
+			annotations,
 		)
 	}
 
@@ -348,7 +385,7 @@ func (t *translator) setDefaultDestinationAndPortMatchers(
 // if the service has multiple service ports defined
 func duplicateRouteForEachPort(
 	baseRoute *networkingv1alpha3spec.HTTPRoute,
-	ports []*discoveryv1.DestinationSpec_KubeService_KubeServicePort,
+	ports []uint32,
 ) []*networkingv1alpha3spec.HTTPRoute {
 	var routesWithPort []*networkingv1alpha3spec.HTTPRoute
 	for _, port := range ports {
@@ -357,7 +394,7 @@ func duplicateRouteForEachPort(
 
 		for _, matcher := range baseRoute.Match {
 			matcher := matcher.DeepCopy()
-			matcher.Port = port.GetPort()
+			matcher.Port = port
 			matchersWithPort = append(matchersWithPort, matcher)
 		}
 
@@ -369,7 +406,7 @@ func duplicateRouteForEachPort(
 			// don't overwrite ports that were derived from traffic shift
 			if destination.GetDestination().GetPort().GetNumber() == 0 {
 				destination.Destination.Port = &networkingv1alpha3spec.PortSelector{
-					Number: port.GetPort(),
+					Number: port,
 				}
 			}
 
@@ -385,6 +422,154 @@ func duplicateRouteForEachPort(
 	return routesWithPort
 }
 
+func hasConnectionMatchers(trafficPolicy *v1.TrafficPolicySpec) bool {
+	return len(trafficPolicy.GetTcpRequestMatchers()) > 0 || len(trafficPolicy.GetTlsRequestMatchers()) > 0
+}
+
+// TrafficPolicies which only apply to TCP and TLS connections cannot specify policies which only apply to HTTP requests
+func validateConnectionPolicy(trafficPolicy *v1.TrafficPolicySpec) error {
+	if !hasConnectionMatchers(trafficPolicy) || len(trafficPolicy.GetHttpRequestMatchers()) > 0 {
+		return nil
+	}
+
+	policy := trafficPolicy.GetPolicy()
+	var httpFields []string
+	if policy.GetFaultInjection() != nil {
+		httpFields = append(httpFields, "FaultInjection")
+	}
+	if policy.GetRequestTimeout() != nil {
+		httpFields = append(httpFields, "RequestTimeout")
+	}
+	if policy.GetRetries() != nil {
+		httpFields = append(httpFields, "Retries")
+	}
+	if policy.GetCorsPolicy() != nil {
+		httpFields = append(httpFields, "CorsPolicy")
+	}
+	if policy.GetMirror() != nil {
+		httpFields = append(httpFields, "Mirror")
+	}
+	if policy.GetHeaderManipulation() != nil {
+		httpFields = append(httpFields, "HeaderManipulation")
+	}
+	if policy.GetCsrf() != nil {
+		httpFields = append(httpFields, "Csrf")
+	}
+	if policy.GetRateLimit() != nil {
+		httpFields = append(httpFields, "RateLimit")
+	}
+	if policy.GetJwt() != nil {
+		httpFields = append(httpFields, "Jwt")
+	}
+	if len(httpFields) > 0 {
+		return eris.Errorf("%v can only be applied to HTTP requests, but the TrafficPolicy only specifies TCP and TLS request matchers", httpFields)
+	}
+	return nil
+}
+
+// return an error if a TCP or TLS matcher specifies a port which cannot carry TCP and TLS routes, e.g. an HTTP port
+func validateConnectionMatcherPorts(trafficPolicy *v1.TrafficPolicySpec, connectionPorts []uint32) error {
+	var invalidPorts []uint32
+	for _, tcpMatcher := range trafficPolicy.GetTcpRequestMatchers() {
+		if port := tcpMatcher.GetPort(); port != 0 && !containsPort(connectionPorts, port) {
+			invalidPorts = append(invalidPorts, port)
+		}
+	}
+	for _, tlsMatcher := range trafficPolicy.GetTlsRequestMatchers() {
+		if port := tlsMatcher.GetPort(); port != 0 && !containsPort(connectionPorts, port) {
+			invalidPorts = append(invalidPorts, port)
+		}
+	}
+	if len(invalidPorts) > 0 {
+		return eris.Errorf("TCP and TLS request matchers can only match the TCP ports %v of the Destination, found %v", connectionPorts, invalidPorts)
+	}
+	return nil
+}
+
+// return the ports matched by a TCP or TLS matcher, which are none if the matcher specifies a port that cannot carry TCP and TLS routes
+func matcherPorts(matcherPort uint32, ports []uint32) []uint32 {
+	if matcherPort == 0 {
+		return ports
+	}
+	if containsPort(ports, matcherPort) {
+		return []uint32{matcherPort}
+	}
+	return nil
+}
+
+func containsPort(ports []uint32, port uint32) bool {
+	for _, p := range ports {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
+
+// translate a TCP route for each TCP matcher, port and source, routing to the given destinations.
+// each route has a single match so that the routes can be ordered by specificity.
+func translateTcpRoutes(
+	trafficPolicy *v1.TrafficPolicySpec,
+	httpDestinations []*networkingv1alpha3spec.HTTPRouteDestination,
+	ports []uint32,
+) []*networkingv1alpha3spec.TCPRoute {
+	var tcpRoutes []*networkingv1alpha3spec.TCPRoute
+	for _, tcpMatcher := range trafficPolicy.GetTcpRequestMatchers() {
+		for _, port := range matcherPorts(tcpMatcher.GetPort(), ports) {
+			for _, match := range routeutils.TranslateTcpMatcher(trafficPolicy.GetSourceSelector(), port) {
+				tcpRoutes = append(tcpRoutes, &networkingv1alpha3spec.TCPRoute{
+					Match: []*networkingv1alpha3spec.L4MatchAttributes{match},
+					Route: translateRouteDestinations(httpDestinations, port),
+				})
+			}
+		}
+	}
+	return tcpRoutes
+}
+
+// translate a TLS route for each TLS matcher, port and source, routing to the given destinations.
+// each route has a single match so that the routes can be ordered by specificity.
+func translateTlsRoutes(
+	trafficPolicy *v1.TrafficPolicySpec,
+	httpDestinations []*networkingv1alpha3spec.HTTPRouteDestination,
+	ports []uint32,
+	destinationFQDN string,
+) []*networkingv1alpha3spec.TLSRoute {
+	var tlsRoutes []*networkingv1alpha3spec.TLSRoute
+	for _, tlsMatcher := range trafficPolicy.GetTlsRequestMatchers() {
+		for _, port := range matcherPorts(tlsMatcher.GetPort(), ports) {
+			for _, match := range routeutils.TranslateTlsMatcher(tlsMatcher, trafficPolicy.GetSourceSelector(), port, []string{destinationFQDN}) {
+				tlsRoutes = append(tlsRoutes, &networkingv1alpha3spec.TLSRoute{
+					Match: []*networkingv1alpha3spec.TLSMatchAttributes{match},
+					Route: translateRouteDestinations(httpDestinations, port),
+				})
+			}
+		}
+	}
+	return tlsRoutes
+}
+
+// convert HTTP route destinations into L4 route destinations, using the given port if the destination does not specify one
+func translateRouteDestinations(
+	httpDestinations []*networkingv1alpha3spec.HTTPRouteDestination,
+	port uint32,
+) []*networkingv1alpha3spec.RouteDestination {
+	var routeDestinations []*networkingv1alpha3spec.RouteDestination
+	for _, httpDestination := range httpDestinations {
+		destination := httpDestination.GetDestination().DeepCopy()
+		if destination.GetPort().GetNumber() == 0 {
+			destination.Port = &networkingv1alpha3spec.PortSelector{
+				Number: port,
+			}
+		}
+		routeDestinations = append(routeDestinations, &networkingv1alpha3spec.RouteDestination{
+			Destination: destination,
+			Weight:      httpDestination.GetWeight(),
+		})
+	}
+	return routeDestinations
+}
+
 func splitRouteByMatchers(baseRoute *networkingv1alpha3spec.HTTPRoute) []*networkingv1alpha3spec.HTTPRoute {
 	if len(baseRoute.Match) < 1 {
 		return []*networkingv1alpha3spec.HTTPRoute{baseRoute}
@@ -399,6 +584,50 @@ func splitRouteByMatchers(baseRoute *networkingv1alpha3spec.HTTPRoute) []*networ
 	return singleMatcherRoutes
 }
 
+// Return a copy of the route which routes the cohort of the canary to its canary subset,
+// or nil if the route does not shift traffic to a canary with a cohort.
+func translateCanaryCohortRoute(
+	appliedTrafficPolicies []*discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+	route *networkingv1alpha3spec.HTTPRoute,
+) *networkingv1alpha3spec.HTTPRoute {
+	// TrafficPolicies are sorted by increasing priority, so the canary of the TrafficPolicy with the highest priority is used
+	var canary *v1.TrafficPolicySpec_Policy_Canary
+	for _, appliedTrafficPolicy := range appliedTrafficPolicies {
+		if policyCanary := appliedTrafficPolicy.GetSpec().GetPolicy().GetCanary(); policyCanary != nil {
+			canary = policyCanary
+		}
+	}
+	cohortHeaderMatchers := canaryutils.CohortHeaderMatchers(canary)
+	if len(cohortHeaderMatchers) == 0 {
+		return nil
+	}
+
+	// the route destination of the canary subset is translated by the traffic shift decorator,
+	// and is absent if the traffic shift of the canary was overridden
+	canarySubset := routeutils.SubsetName(canary.GetCanarySubset())
+	var canaryDestination *networkingv1alpha3spec.HTTPRouteDestination
+	for _, routeDestination := range route.Route {
+		if routeDestination.GetDestination().GetSubset() == canarySubset {
+			canaryDestination = routeDestination.DeepCopy()
+			canaryDestination.Weight = 0
+			break
+		}
+	}
+	if canaryDestination == nil {
+		return nil
+	}
+
+	cohortRoute := route.DeepCopy()
+	cohortRoute.Route = []*networkingv1alpha3spec.HTTPRouteDestination{canaryDestination}
+	cohortRoute.Match = nil
+	for _, match := range route.Match {
+		for _, headerMatcher := range cohortHeaderMatchers {
+			cohortRoute.Match = append(cohortRoute.Match, routeutils.AddHeaderMatcher(match, headerMatcher))
+		}
+	}
+	return cohortRoute
+}
+
 func translateRequestMatchers(
 	trafficPolicy *v1.TrafficPolicySpec,
 ) []*networkingv1alpha3spec.HTTPMatchRequest {
@@ -408,31 +637,166 @@ func translateRequestMatchers(
 	)
 }
 
-// Return errors for each user-supplied VirtualService that applies to the same hostname as the translated VirtualService
-func conflictsWithUserVirtualService(
+// Return each user-supplied VirtualService that applies to the same hostname as the translated VirtualService
+func conflictingUserVirtualServices(
 	userVirtualServices v1alpha3sets.VirtualServiceSet,
 	translatedVirtualService *networkingv1alpha3.VirtualService,
-) []error {
-	// For each user VS, check whether any hosts match any hosts from translated VS
-	var errs []error
-
-	// virtual services from RemoteSnapshot only contain non-translated objects
-	userVirtualServices.List(func(vs *networkingv1alpha3.VirtualService) (_ bool) {
+) []*networkingv1alpha3.VirtualService {
+	// virtual services from RemoteSnapshot only contain non-translated objects, or objects into which translated config was merged
+	return userVirtualServices.List(func(vs *networkingv1alpha3.VirtualService) bool {
 		// different cluster, no conflict
 		if vs.ClusterName != translatedVirtualService.ClusterName {
-			return
+			return true
 		}
 
 		// check if common hostnames exist
-		commonHostnames := utils.CommonHostnames(vs.Spec.Hosts, translatedVirtualService.Spec.Hosts)
-		if len(commonHostnames) > 0 {
-			errs = append(
-				errs,
-				eris.Errorf("Unable to translate AppliedTrafficPolicies to VirtualService, applies to hosts %+v that are already configured by the existing VirtualService %s", commonHostnames, skv2sets.Key(vs)),
-			)
-		}
-		return
+		return len(utils.CommonHostnames(vs.Spec.Hosts, translatedVirtualService.Spec.Hosts)) == 0
 	})
+}
+
+// return the mode for handling user-supplied VirtualServices for the Destination, from the first matching override in the Settings
+func (t *translator) userVirtualServiceMode(destination *discoveryv1.Destination) settingsv1.UserVirtualServiceSettings_Mode {
+	var userVirtualServiceSettings *settingsv1.UserVirtualServiceSettings
+	if t.settings != nil {
+		userVirtualServiceSettings = t.settings.Spec.GetUserVirtualServices()
+	}
+	for _, override := range userVirtualServiceSettings.GetDestinationOverrides() {
+		if selectorutils.SelectorMatchesDestination(override.GetDestinationSelector(), destination) {
+			return override.GetMode()
+		}
+	}
+	return userVirtualServiceSettings.GetMode()
+}
+
+// Merge the translated routes with the routes of the user-supplied VirtualService into a delegate VirtualService, leaving the
+// user-supplied VirtualService unmodified. The user-supplied VirtualService must delegate to it in its first route, so that
+// the translated routes take precedence over copies of the remaining user-supplied routes.
+// Conflicts with the user-supplied routes are reported on the TrafficPolicies from which the conflicting routes were translated.
+func mergeVirtualService(
+	userVirtualService *networkingv1alpha3.VirtualService,
+	translatedVirtualService *networkingv1alpha3.VirtualService,
+	policiesByRoute map[*networkingv1alpha3spec.HTTPRoute][]*discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) *networkingv1alpha3.VirtualService {
+	// the merged routes have no host matchers, so they can only be merged for a VirtualService which applies to the Destination alone
+	if hosts := userVirtualService.Spec.Hosts; len(hosts) != 1 || strings.Contains(hosts[0], "*") {
+		reportToAllPolicies(destination, reporter,
+			eris.Errorf("Unable to merge translated routes into the existing VirtualService %s, which applies to hosts %+v rather than a single host", skv2sets.Key(userVirtualService), hosts))
+		return nil
+	}
+
+	if gateways := userVirtualService.Spec.Gateways; len(gateways) > 0 && !sets.NewString(gateways...).Has(meshGateway) {
+		reportToAllPolicies(destination, reporter,
+			eris.Errorf("Unable to merge translated routes into the existing VirtualService %s, which only applies to gateways %+v", skv2sets.Key(userVirtualService), gateways))
+		return nil
+	}
+
+	userRoutes := userVirtualService.Spec.Http
+	if len(userRoutes) > 0 && delegatesTo(userRoutes[0], userVirtualService, translatedVirtualService) {
+		userRoutes = userRoutes[1:]
+	} else {
+		reportToAllPolicies(destination, reporter,
+			eris.Errorf("the first route of the existing VirtualService %s must delegate to VirtualService %s.%s", skv2sets.Key(userVirtualService), translatedVirtualService.Name, translatedVirtualService.Namespace))
+	}
+
+	for _, route := range translatedVirtualService.Spec.Http {
+		for _, userRoute := range userRoutes {
+			if routeMatchesOverlap(route, userRoute) {
+				for _, policy := range policiesByRoute[route] {
+					reporter.ReportTrafficPolicyToDestination(destination, policy.Ref,
+						eris.Errorf("translated route conflicts with route %q of the existing VirtualService %s, which has the same match", userRoute.GetName(), skv2sets.Key(userVirtualService)))
+				}
+			}
+		}
+	}
+
+	for _, userRoute := range userRoutes {
+		// Istio does not support nested delegation
+		if userRoute.GetDelegate() != nil {
+			continue
+		}
+		translatedVirtualService.Spec.Http = append(translatedVirtualService.Spec.Http, userRoute.DeepCopy())
+	}
+
+	return toDelegateVirtualService(userVirtualService, translatedVirtualService, destination, reporter)
+}
+
+// Convert the translated VirtualService into a delegate VirtualService, which must be delegated to by the user-supplied VirtualService.
+func delegateVirtualService(
+	userVirtualService *networkingv1alpha3.VirtualService,
+	translatedVirtualService *networkingv1alpha3.VirtualService,
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) *networkingv1alpha3.VirtualService {
+	var delegated bool
+	for _, route := range userVirtualService.Spec.Http {
+		if delegatesTo(route, userVirtualService, translatedVirtualService) {
+			delegated = true
+			break
+		}
+	}
+	if !delegated {
+		reportToAllPolicies(destination, reporter,
+			eris.Errorf("the existing VirtualService %s must contain a route delegating to VirtualService %s.%s", skv2sets.Key(userVirtualService), translatedVirtualService.Name, translatedVirtualService.Namespace))
+	}
 
-	return errs
+	return toDelegateVirtualService(userVirtualService, translatedVirtualService, destination, reporter)
+}
+
+func toDelegateVirtualService(
+	userVirtualService *networkingv1alpha3.VirtualService,
+	translatedVirtualService *networkingv1alpha3.VirtualService,
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) *networkingv1alpha3.VirtualService {
+	// delegate VirtualServices must not specify hosts, which are inherited from the delegating VirtualService
+	translatedVirtualService.Spec.Hosts = nil
+
+	// Istio only supports delegation of HTTP routes
+	if len(translatedVirtualService.Spec.Tcp) > 0 || len(translatedVirtualService.Spec.Tls) > 0 {
+		reportToAllPolicies(destination, reporter,
+			eris.Errorf("TCP and TLS routes cannot be delegated to by the existing VirtualService %s", skv2sets.Key(userVirtualService)))
+	}
+
+	return translatedVirtualService
+}
+
+// return true if the route of the user-supplied VirtualService delegates to the translated VirtualService
+func delegatesTo(
+	route *networkingv1alpha3spec.HTTPRoute,
+	userVirtualService *networkingv1alpha3.VirtualService,
+	translatedVirtualService *networkingv1alpha3.VirtualService,
+) bool {
+	delegate := route.GetDelegate()
+	if delegate == nil || delegate.GetName() != translatedVirtualService.Name {
+		return false
+	}
+	// the namespace of the delegate defaults to that of the delegating VirtualService
+	return delegate.GetNamespace() == translatedVirtualService.Namespace ||
+		(delegate.GetNamespace() == "" && userVirtualService.Namespace == translatedVirtualService.Namespace)
+}
+
+// return true if the user-supplied route has a matcher equivalent to the matcher of the translated route,
+// ignoring the port of the translated route if the user-supplied matcher does not specify one
+func routeMatchesOverlap(translatedRoute, userRoute *networkingv1alpha3spec.HTTPRoute) bool {
+	for _, translatedMatch := range translatedRoute.GetMatch() {
+		for _, userMatch := range userRoute.GetMatch() {
+			translatedMatch := translatedMatch
+			if userMatch.GetPort() == 0 {
+				translatedMatch = translatedMatch.DeepCopy()
+				translatedMatch.Port = 0
+			}
+			if equalityutils.DeepEqual(translatedMatch, userMatch) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func reportToAllPolicies(destination *discoveryv1.Destination, reporter reporting.Reporter, err error) {
+	for _, policy := range destination.Status.AppliedTrafficPolicies {
+		reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, err)
+	}
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/virtualservice"
 	mock_hostutils "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils/mocks"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/routeutils"
 	"github.com/solo-io/go-utils/testutils"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
 	v1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
@@ -51,7 +52,7 @@ var _ = Describe("VirtualServiceTranslator", func() {
 		mockDecoratorFactory = mock_decorators.NewMockFactory(ctrl)
 		mockReporter = mock_reporting.NewMockReporter(ctrl)
 		mockDecorator = mock_trafficpolicy.NewMockTrafficPolicyVirtualServiceDecorator(ctrl)
-		virtualServiceTranslator = virtualservice.NewTranslator(nil, mockClusterDomainRegistry, mockDecoratorFactory)
+		virtualServiceTranslator = virtualservice.NewTranslator(nil, nil, mockClusterDomainRegistry, mockDecoratorFactory)
 		in = input.NewInputLocalSnapshotManualBuilder("").AddSettings(settingsv1.SettingsSlice{{}}).Build()
 	})
 
@@ -1092,7 +1093,7 @@ var _ = Describe("VirtualServiceTranslator", func() {
 				)
 			})
 
-		virtualServiceTranslator = virtualservice.NewTranslator(existingVirtualServices, mockClusterDomainRegistry, mockDecoratorFactory)
+		virtualServiceTranslator = virtualservice.NewTranslator(nil, existingVirtualServices, mockClusterDomainRegistry, mockDecoratorFactory)
 		_ = virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
 	})
 
@@ -1485,4 +1486,1262 @@ var _ = Describe("VirtualServiceTranslator", func() {
 		virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
 		Expect(virtualService).To(Equal(expectedVirtualService))
 	})
+
+	It("should translate for an ExternalService Destination on its HTTP ports", func() {
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "external-api",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_ExternalService_{
+					ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+						Name:  "external-api",
+						Hosts: []string{"api.example.com"},
+						Ports: []*discoveryv1.DestinationSpec_ExternalService_ServicePort{
+							{
+								Number:   80,
+								Name:     "http",
+								Protocol: "HTTP",
+							},
+							{
+								Number:   443,
+								Name:     "tls",
+								Protocol: "TLS",
+							},
+						},
+						Ref: &v1.ClusterObjectRef{
+							Name:        "external-api",
+							Namespace:   "external-api-namespace",
+							ClusterName: "external-api-cluster",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "tp-1",
+							Namespace: "tp-namespace-1",
+						},
+						Spec: &networkingv1.TrafficPolicySpec{
+							Policy: &networkingv1.TrafficPolicySpec_Policy{
+								RequestTimeout: &duration.Duration{Seconds: 5},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(decorators.Parameters{
+				ClusterDomains: mockClusterDomainRegistry,
+				Snapshot:       in,
+			}).
+			Return([]decorators.Decorator{mockDecorator})
+
+		mockDecorator.
+			EXPECT().
+			ApplyTrafficPolicyToVirtualService(
+				destination.Status.AppliedTrafficPolicies[0],
+				destination,
+				nil,
+				&networkingv1alpha3spec.HTTPRoute{},
+				gomock.Any(),
+			).DoAndReturn(
+			func(
+				appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+				service *discoveryv1.Destination,
+				sourceMeshInstallation *discoveryv1.MeshInstallation,
+				output *networkingv1alpha3spec.HTTPRoute,
+				registerField decorators.RegisterField,
+			) error {
+				output.Timeout = &types.Duration{Seconds: 5}
+				return nil
+			})
+
+		expectedVirtualService := &networkingv1alpha3.VirtualService{
+			ObjectMeta: metautils.TranslatedObjectMeta(
+				destination.Spec.GetExternalService().Ref,
+				destination.Annotations,
+			),
+			Spec: networkingv1alpha3spec.VirtualService{
+				Hosts: []string{"api.example.com"},
+				Http: []*networkingv1alpha3spec.HTTPRoute{
+					{
+						Match: []*networkingv1alpha3spec.HTTPMatchRequest{
+							{
+								Port: 80,
+							},
+						},
+						Route: []*networkingv1alpha3spec.HTTPRouteDestination{
+							{
+								Destination: &networkingv1alpha3spec.Destination{
+									Host: "api.example.com",
+									Port: &networkingv1alpha3spec.PortSelector{
+										Number: 80,
+									},
+								},
+							},
+						},
+						Timeout: &types.Duration{Seconds: 5},
+					},
+				},
+			},
+		}
+
+		virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		Expect(virtualService).To(Equal(expectedVirtualService))
+	})
+
+	It("should report an error for an ExternalService Destination with multiple hosts", func() {
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "external-api",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_ExternalService_{
+					ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+						Name:  "external-api",
+						Hosts: []string{"api.example.com", "api.example.org"},
+						Ref: &v1.ClusterObjectRef{
+							Name:        "external-api",
+							Namespace:   "external-api-namespace",
+							ClusterName: "external-api-cluster",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "tp-1",
+							Namespace: "tp-namespace-1",
+						},
+						Spec: &networkingv1.TrafficPolicySpec{},
+					},
+				},
+			},
+		}
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any())
+
+		virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		Expect(virtualService).To(BeNil())
+	})
+
+	It("should translate gRPC matchers into path and content type matchers", func() {
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "traffic-target",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "traffic-target",
+							Namespace:   "traffic-target-namespace",
+							ClusterName: "traffic-target-cluster",
+						},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port:     9000,
+								Name:     "grpc",
+								Protocol: "TCP",
+							},
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "grpc-timeout",
+							Namespace: "gloo-mesh",
+						},
+						Spec: &networkingv1.TrafficPolicySpec{
+							HttpRequestMatchers: []*networkingv1.HttpMatcher{
+								{
+									Grpc: &networkingv1.GrpcMatcher{
+										Service: "helloworld.Greeter",
+										Method:  "SayHello",
+									},
+								},
+								{
+									Grpc: &networkingv1.GrpcMatcher{
+										Service: "helloworld.Farewell",
+									},
+									Headers: []*networkingv1.HeaderMatcher{
+										{
+											Name:  "Content-Type",
+											Value: "application/grpc+proto",
+										},
+									},
+								},
+							},
+							Policy: &networkingv1.TrafficPolicySpec_Policy{
+								RequestTimeout: &duration.Duration{Seconds: 1},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN(destination.Spec.GetKubeService().Ref.ClusterName, destination.Spec.GetKubeService().Ref).
+			Return("local-hostname")
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(decorators.Parameters{
+				ClusterDomains: mockClusterDomainRegistry,
+				Snapshot:       in,
+			}).
+			Return([]decorators.Decorator{mockDecorator})
+
+		mockDecorator.
+			EXPECT().
+			ApplyTrafficPolicyToVirtualService(
+				destination.Status.AppliedTrafficPolicies[0],
+				destination,
+				nil,
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(
+			func(
+				appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+				service *discoveryv1.Destination,
+				sourceMeshInstallation *discoveryv1.MeshInstallation,
+				output *networkingv1alpha3spec.HTTPRoute,
+				registerField decorators.RegisterField,
+			) error {
+				output.Timeout = &types.Duration{Seconds: 1}
+				return nil
+			})
+
+		route := func(match *networkingv1alpha3spec.HTTPMatchRequest) *networkingv1alpha3spec.HTTPRoute {
+			return &networkingv1alpha3spec.HTTPRoute{
+				Match: []*networkingv1alpha3spec.HTTPMatchRequest{match},
+				Route: []*networkingv1alpha3spec.HTTPRouteDestination{
+					{
+						Destination: &networkingv1alpha3spec.Destination{
+							Host: "local-hostname",
+							Port: &networkingv1alpha3spec.PortSelector{
+								Number: 9000,
+							},
+						},
+					},
+				},
+				Timeout: &types.Duration{Seconds: 1},
+			}
+		}
+
+		virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		Expect(virtualService.Spec.Http).To(ConsistOf(
+			route(&networkingv1alpha3spec.HTTPMatchRequest{
+				Uri: &networkingv1alpha3spec.StringMatch{
+					MatchType: &networkingv1alpha3spec.StringMatch_Exact{Exact: "/helloworld.Greeter/SayHello"},
+				},
+				Headers: map[string]*networkingv1alpha3spec.StringMatch{
+					"content-type": {MatchType: &networkingv1alpha3spec.StringMatch_Prefix{Prefix: "application/grpc"}},
+				},
+				Port: 9000,
+			}),
+			route(&networkingv1alpha3spec.HTTPMatchRequest{
+				Uri: &networkingv1alpha3spec.StringMatch{
+					MatchType: &networkingv1alpha3spec.StringMatch_Prefix{Prefix: "/helloworld.Farewell/"},
+				},
+				Headers: map[string]*networkingv1alpha3spec.StringMatch{
+					"Content-Type": {MatchType: &networkingv1alpha3spec.StringMatch_Exact{Exact: "application/grpc+proto"}},
+				},
+				Port: 9000,
+			}),
+		))
+	})
+
+	It("should report request matchers which specify both a uri and a gRPC matcher", func() {
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "traffic-target",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "traffic-target",
+							Namespace:   "traffic-target-namespace",
+							ClusterName: "traffic-target-cluster",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "grpc-uri",
+							Namespace: "gloo-mesh",
+						},
+						Spec: &networkingv1.TrafficPolicySpec{
+							HttpRequestMatchers: []*networkingv1.HttpMatcher{
+								{
+									Uri: &commonv1.StringMatch{
+										MatchType: &commonv1.StringMatch_Prefix{Prefix: "/"},
+									},
+									Grpc: &networkingv1.GrpcMatcher{
+										Service: "helloworld.Greeter",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN(destination.Spec.GetKubeService().Ref.ClusterName, destination.Spec.GetKubeService().Ref).
+			Return("local-hostname")
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(decorators.Parameters{
+				ClusterDomains: mockClusterDomainRegistry,
+				Snapshot:       in,
+			}).
+			Return([]decorators.Decorator{})
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any()).
+			DoAndReturn(func(destination *discoveryv1.Destination, trafficPolicy ezkube.ResourceId, err error) {
+				Expect(err).To(testutils.HaveInErrorChain(eris.New("HttpRequestMatchers[0] cannot specify both a uri and a gRPC matcher")))
+			})
+
+		virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+	})
+
+	It("should translate TCP and TLS routes for TrafficPolicies with TCP and TLS request matchers", func() {
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "traffic-target",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "traffic-target",
+							Namespace:   "traffic-target-namespace",
+							ClusterName: "traffic-target-cluster",
+						},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port:     3306,
+								Name:     "tcp",
+								Protocol: "TCP",
+							},
+							{
+								Port:     8443,
+								Name:     "tls",
+								Protocol: "TCP",
+							},
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "tcp-shift",
+							Namespace: "gloo-mesh",
+						},
+						Spec: &networkingv1.TrafficPolicySpec{
+							SourceSelector: []*commonv1.WorkloadSelector{
+								{
+									KubeWorkloadMatcher: &commonv1.WorkloadSelector_KubeWorkloadMatcher{
+										Labels:     map[string]string{"app": "client"},
+										Namespaces: []string{"client-namespace"},
+									},
+								},
+							},
+							TcpRequestMatchers: []*networkingv1.TcpMatcher{
+								{
+									Port: 3306,
+								},
+							},
+							TlsRequestMatchers: []*networkingv1.TlsMatcher{
+								{
+									SniHosts: []string{"*.example.com"},
+								},
+							},
+							Policy: &networkingv1.TrafficPolicySpec_Policy{
+								TrafficShift: &networkingv1.TrafficPolicySpec_Policy_MultiDestination{},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN(destination.Spec.GetKubeService().Ref.ClusterName, destination.Spec.GetKubeService().Ref).
+			Return("local-hostname")
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(decorators.Parameters{
+				ClusterDomains: mockClusterDomainRegistry,
+				Snapshot:       in,
+			}).
+			Return([]decorators.Decorator{mockDecorator})
+
+		mockDecorator.
+			EXPECT().
+			ApplyTrafficPolicyToVirtualService(
+				destination.Status.AppliedTrafficPolicies[0],
+				destination,
+				nil,
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(
+			func(
+				appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+				service *discoveryv1.Destination,
+				sourceMeshInstallation *discoveryv1.MeshInstallation,
+				output *networkingv1alpha3spec.HTTPRoute,
+				registerField decorators.RegisterField,
+			) error {
+				output.Route = []*networkingv1alpha3spec.HTTPRouteDestination{
+					{
+						Destination: &networkingv1alpha3spec.Destination{
+							Host:   "local-hostname",
+							Subset: "v2",
+						},
+						Weight: 100,
+					},
+				}
+				return nil
+			})
+
+		routeDestination := func(port uint32) []*networkingv1alpha3spec.RouteDestination {
+			return []*networkingv1alpha3spec.RouteDestination{
+				{
+					Destination: &networkingv1alpha3spec.Destination{
+						Host:   "local-hostname",
+						Subset: "v2",
+						Port: &networkingv1alpha3spec.PortSelector{
+							Number: port,
+						},
+					},
+					Weight: 100,
+				},
+			}
+		}
+		tlsRoute := func(port uint32) *networkingv1alpha3spec.TLSRoute {
+			return &networkingv1alpha3spec.TLSRoute{
+				Match: []*networkingv1alpha3spec.TLSMatchAttributes{
+					{
+						SniHosts:        []string{"*.example.com"},
+						Port:            port,
+						SourceNamespace: "client-namespace",
+						SourceLabels:    map[string]string{"app": "client"},
+					},
+				},
+				Route: routeDestination(port),
+			}
+		}
+
+		expectedVirtualService := &networkingv1alpha3.VirtualService{
+			ObjectMeta: metautils.TranslatedObjectMeta(
+				destination.Spec.GetKubeService().Ref,
+				destination.Annotations,
+			),
+			Spec: networkingv1alpha3spec.VirtualService{
+				Hosts: []string{"local-hostname"},
+				Tcp: []*networkingv1alpha3spec.TCPRoute{
+					{
+						Match: []*networkingv1alpha3spec.L4MatchAttributes{
+							{
+								Port:            3306,
+								SourceNamespace: "client-namespace",
+								SourceLabels:    map[string]string{"app": "client"},
+							},
+						},
+						Route: routeDestination(3306),
+					},
+				},
+				Tls: []*networkingv1alpha3spec.TLSRoute{
+					tlsRoute(3306),
+					tlsRoute(8443),
+				},
+			},
+		}
+
+		virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		Expect(virtualService).To(Equal(expectedVirtualService))
+	})
+
+	It("should order TCP and TLS routes by specificity and only translate TCP routes for non-HTTP ports", func() {
+		shiftPolicy := func(name string, sourceSelector []*commonv1.WorkloadSelector, sniHosts []string) *discoveryv1.DestinationStatus_AppliedTrafficPolicy {
+			return &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+				Ref: &v1.ObjectRef{
+					Name:      name,
+					Namespace: "gloo-mesh",
+				},
+				Spec: &networkingv1.TrafficPolicySpec{
+					SourceSelector:     sourceSelector,
+					TcpRequestMatchers: []*networkingv1.TcpMatcher{{}},
+					TlsRequestMatchers: []*networkingv1.TlsMatcher{
+						{
+							SniHosts: sniHosts,
+							Port:     3306,
+						},
+					},
+					Policy: &networkingv1.TrafficPolicySpec_Policy{
+						TrafficShift: &networkingv1.TrafficPolicySpec_Policy_MultiDestination{},
+					},
+				},
+			}
+		}
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "traffic-target",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "traffic-target",
+							Namespace:   "traffic-target-namespace",
+							ClusterName: "traffic-target-cluster",
+						},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port:     8080,
+								Name:     "http",
+								Protocol: "TCP",
+							},
+							{
+								Port:     3306,
+								Name:     "tcp",
+								Protocol: "TCP",
+							},
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+					// the catch-all policy precedes the more specific one
+					shiftPolicy("catch-all", nil, []string{"*.example.com"}),
+					shiftPolicy("client", []*commonv1.WorkloadSelector{
+						{
+							KubeWorkloadMatcher: &commonv1.WorkloadSelector_KubeWorkloadMatcher{
+								Labels:     map[string]string{"app": "client"},
+								Namespaces: []string{"client-namespace"},
+							},
+						},
+					}, []string{"client.example.com"}),
+				},
+			},
+		}
+
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN(destination.Spec.GetKubeService().Ref.ClusterName, destination.Spec.GetKubeService().Ref).
+			Return("local-hostname")
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(decorators.Parameters{
+				ClusterDomains: mockClusterDomainRegistry,
+				Snapshot:       in,
+			}).
+			Return([]decorators.Decorator{mockDecorator})
+
+		mockDecorator.
+			EXPECT().
+			ApplyTrafficPolicyToVirtualService(
+				gomock.Any(),
+				destination,
+				nil,
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(
+			func(
+				appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+				service *discoveryv1.Destination,
+				sourceMeshInstallation *discoveryv1.MeshInstallation,
+				output *networkingv1alpha3spec.HTTPRoute,
+				registerField decorators.RegisterField,
+			) error {
+				output.Route = []*networkingv1alpha3spec.HTTPRouteDestination{
+					{
+						Destination: &networkingv1alpha3spec.Destination{
+							Host:   "local-hostname",
+							Subset: appliedPolicy.GetRef().GetName(),
+						},
+					},
+				}
+				return nil
+			}).
+			Times(2)
+
+		routeDestination := func(subset string, port uint32) []*networkingv1alpha3spec.RouteDestination {
+			return []*networkingv1alpha3spec.RouteDestination{
+				{
+					Destination: &networkingv1alpha3spec.Destination{
+						Host:   "local-hostname",
+						Subset: subset,
+						Port: &networkingv1alpha3spec.PortSelector{
+							Number: port,
+						},
+					},
+				},
+			}
+		}
+
+		virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		Expect(virtualService.Spec.Tcp).To(Equal([]*networkingv1alpha3spec.TCPRoute{
+			{
+				Match: []*networkingv1alpha3spec.L4MatchAttributes{
+					{
+						Port:            3306,
+						SourceNamespace: "client-namespace",
+						SourceLabels:    map[string]string{"app": "client"},
+					},
+				},
+				Route: routeDestination("client", 3306),
+			},
+			{
+				Match: []*networkingv1alpha3spec.L4MatchAttributes{
+					{
+						Port: 3306,
+					},
+				},
+				Route: routeDestination("catch-all", 3306),
+			},
+		}))
+		Expect(virtualService.Spec.Tls).To(Equal([]*networkingv1alpha3spec.TLSRoute{
+			{
+				Match: []*networkingv1alpha3spec.TLSMatchAttributes{
+					{
+						SniHosts:        []string{"client.example.com"},
+						Port:            3306,
+						SourceNamespace: "client-namespace",
+						SourceLabels:    map[string]string{"app": "client"},
+					},
+				},
+				Route: routeDestination("client", 3306),
+			},
+			{
+				Match: []*networkingv1alpha3spec.TLSMatchAttributes{
+					{
+						SniHosts: []string{"*.example.com"},
+						Port:     3306,
+					},
+				},
+				Route: routeDestination("catch-all", 3306),
+			},
+		}))
+	})
+
+	It("should report TCP and TLS request matchers for ports which cannot carry TCP routes", func() {
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "traffic-target",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "traffic-target",
+							Namespace:   "traffic-target-namespace",
+							ClusterName: "traffic-target-cluster",
+						},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port:     8080,
+								Name:     "http",
+								Protocol: "TCP",
+							},
+							{
+								Port:     3306,
+								Name:     "tcp",
+								Protocol: "TCP",
+							},
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "http-port",
+							Namespace: "gloo-mesh",
+						},
+						Spec: &networkingv1.TrafficPolicySpec{
+							TcpRequestMatchers: []*networkingv1.TcpMatcher{{Port: 8080}},
+							Policy: &networkingv1.TrafficPolicySpec_Policy{
+								TrafficShift: &networkingv1.TrafficPolicySpec_Policy_MultiDestination{},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN(destination.Spec.GetKubeService().Ref.ClusterName, destination.Spec.GetKubeService().Ref).
+			Return("local-hostname")
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(decorators.Parameters{
+				ClusterDomains: mockClusterDomainRegistry,
+				Snapshot:       in,
+			}).
+			Return([]decorators.Decorator{mockDecorator})
+
+		mockDecorator.
+			EXPECT().
+			ApplyTrafficPolicyToVirtualService(
+				gomock.Any(),
+				destination,
+				nil,
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(
+			func(
+				appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+				service *discoveryv1.Destination,
+				sourceMeshInstallation *discoveryv1.MeshInstallation,
+				output *networkingv1alpha3spec.HTTPRoute,
+				registerField decorators.RegisterField,
+			) error {
+				output.Route = []*networkingv1alpha3spec.HTTPRouteDestination{
+					{
+						Destination: &networkingv1alpha3spec.Destination{
+							Host: "local-hostname",
+						},
+					},
+				}
+				return nil
+			})
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any()).
+			DoAndReturn(func(destination *discoveryv1.Destination, trafficPolicy ezkube.ResourceId, err error) {
+				Expect(err).To(testutils.HaveInErrorChain(eris.New("TCP and TLS request matchers can only match the TCP ports [3306] of the Destination, found [8080]")))
+			})
+
+		virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		Expect(virtualService).To(BeNil())
+	})
+
+	It("should report HTTP policies on TrafficPolicies with only TCP request matchers", func() {
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "traffic-target",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "traffic-target",
+							Namespace:   "traffic-target-namespace",
+							ClusterName: "traffic-target-cluster",
+						},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port:     3306,
+								Name:     "tcp",
+								Protocol: "TCP",
+							},
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "tcp-timeout",
+							Namespace: "gloo-mesh",
+						},
+						Spec: &networkingv1.TrafficPolicySpec{
+							TcpRequestMatchers: []*networkingv1.TcpMatcher{{}},
+							Policy: &networkingv1.TrafficPolicySpec_Policy{
+								RequestTimeout: &duration.Duration{Seconds: 1},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN(destination.Spec.GetKubeService().Ref.ClusterName, destination.Spec.GetKubeService().Ref).
+			Return("local-hostname")
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(decorators.Parameters{
+				ClusterDomains: mockClusterDomainRegistry,
+				Snapshot:       in,
+			}).
+			Return([]decorators.Decorator{mockDecorator})
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any()).
+			DoAndReturn(func(destination *discoveryv1.Destination, trafficPolicy ezkube.ResourceId, err error) {
+				Expect(err).To(testutils.HaveInErrorChain(eris.New("[RequestTimeout] can only be applied to HTTP requests, but the TrafficPolicy only specifies TCP and TLS request matchers")))
+			})
+
+		mockDecorator.
+			EXPECT().
+			ApplyTrafficPolicyToVirtualService(
+				destination.Status.AppliedTrafficPolicies[0],
+				destination,
+				nil,
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(
+			func(
+				appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+				service *discoveryv1.Destination,
+				sourceMeshInstallation *discoveryv1.MeshInstallation,
+				output *networkingv1alpha3spec.HTTPRoute,
+				registerField decorators.RegisterField,
+			) error {
+				output.Timeout = &types.Duration{Seconds: 1}
+				return nil
+			})
+
+		virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		Expect(virtualService).To(BeNil())
+	})
+
+	It("should route the canary cohort to the canary subset", func() {
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "traffic-target",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "traffic-target",
+							Namespace:   "traffic-target-namespace",
+							ClusterName: "traffic-target-cluster",
+						},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port:     9080,
+								Name:     "http",
+								Protocol: "http",
+							},
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "canary",
+							Namespace: "gloo-mesh",
+						},
+						Spec: &networkingv1.TrafficPolicySpec{
+							Policy: &networkingv1.TrafficPolicySpec_Policy{
+								Canary: &networkingv1.TrafficPolicySpec_Policy_Canary{
+									CanarySubset: map[string]string{"version": "v2"},
+									CohortHeaders: []*networkingv1.HeaderMatcher{
+										{Name: "x-canary", Value: "true"},
+									},
+									CohortCookies: []*networkingv1.TrafficPolicySpec_Policy_Canary_CookieMatcher{
+										{Name: "canary", Value: "always"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN(destination.Spec.GetKubeService().Ref.ClusterName, destination.Spec.GetKubeService().Ref).
+			Return("local-hostname")
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(decorators.Parameters{
+				ClusterDomains: mockClusterDomainRegistry,
+				Snapshot:       in,
+			}).
+			Return([]decorators.Decorator{mockDecorator})
+
+		stableDestination := &networkingv1alpha3spec.HTTPRouteDestination{
+			Destination: &networkingv1alpha3spec.Destination{
+				Host: "local-hostname",
+			},
+			Weight: 90,
+		}
+		canaryDestination := &networkingv1alpha3spec.HTTPRouteDestination{
+			Destination: &networkingv1alpha3spec.Destination{
+				Host:   "local-hostname",
+				Subset: routeutils.SubsetName(map[string]string{"version": "v2"}),
+			},
+			Weight: 10,
+		}
+
+		mockDecorator.
+			EXPECT().
+			ApplyTrafficPolicyToVirtualService(
+				destination.Status.AppliedTrafficPolicies[0],
+				destination,
+				nil,
+				gomock.Any(),
+				gomock.Any(),
+			).DoAndReturn(
+			func(
+				appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+				service *discoveryv1.Destination,
+				sourceMeshInstallation *discoveryv1.MeshInstallation,
+				output *networkingv1alpha3spec.HTTPRoute,
+				registerField decorators.RegisterField,
+			) error {
+				// the traffic shift decorator translates the current weight of the canary
+				output.Route = []*networkingv1alpha3spec.HTTPRouteDestination{stableDestination, canaryDestination}
+				return nil
+			})
+
+		cohortDestination := &networkingv1alpha3spec.HTTPRouteDestination{
+			Destination: &networkingv1alpha3spec.Destination{
+				Host:   "local-hostname",
+				Subset: routeutils.SubsetName(map[string]string{"version": "v2"}),
+				Port:   &networkingv1alpha3spec.PortSelector{Number: 9080},
+			},
+		}
+
+		virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		Expect(virtualService.Spec.Http).To(ConsistOf(
+			&networkingv1alpha3spec.HTTPRoute{
+				Match: []*networkingv1alpha3spec.HTTPMatchRequest{{
+					Port: 9080,
+					Headers: map[string]*networkingv1alpha3spec.StringMatch{
+						"x-canary": {MatchType: &networkingv1alpha3spec.StringMatch_Exact{Exact: "true"}},
+					},
+				}},
+				Route: []*networkingv1alpha3spec.HTTPRouteDestination{cohortDestination},
+			},
+			&networkingv1alpha3spec.HTTPRoute{
+				Match: []*networkingv1alpha3spec.HTTPMatchRequest{{
+					Port: 9080,
+					Headers: map[string]*networkingv1alpha3spec.StringMatch{
+						"cookie": {MatchType: &networkingv1alpha3spec.StringMatch_Regex{Regex: `^(.*?;\s*)?canary=always(;.*)?$`}},
+					},
+				}},
+				Route: []*networkingv1alpha3spec.HTTPRouteDestination{cohortDestination},
+			},
+			&networkingv1alpha3spec.HTTPRoute{
+				Match: []*networkingv1alpha3spec.HTTPMatchRequest{{Port: 9080}},
+				Route: []*networkingv1alpha3spec.HTTPRouteDestination{
+					{
+						Destination: &networkingv1alpha3spec.Destination{
+							Host: "local-hostname",
+							Port: &networkingv1alpha3spec.PortSelector{Number: 9080},
+						},
+						Weight: 90,
+					},
+					{
+						Destination: &networkingv1alpha3spec.Destination{
+							Host:   "local-hostname",
+							Subset: routeutils.SubsetName(map[string]string{"version": "v2"}),
+							Port:   &networkingv1alpha3spec.PortSelector{Number: 9080},
+						},
+						Weight: 10,
+					},
+				},
+			},
+		))
+		// cohort routes are more specific, and must precede the weighted route
+		Expect(virtualService.Spec.Http[2].Match[0].Headers).To(BeEmpty())
+	})
+
+	Context("user-supplied VirtualServices", func() {
+		var (
+			destination *discoveryv1.Destination
+			settings    *settingsv1.Settings
+		)
+
+		makeUserVirtualService := func(routes ...*networkingv1alpha3spec.HTTPRoute) *networkingv1alpha3.VirtualService {
+			return &networkingv1alpha3.VirtualService{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "user-provided-vs",
+					Namespace:   "foo",
+					ClusterName: "traffic-target-cluster",
+					Labels:      map[string]string{"team": "foo"},
+				},
+				Spec: networkingv1alpha3spec.VirtualService{
+					Hosts: []string{"local-hostname"},
+					Http:  routes,
+				},
+			}
+		}
+
+		// the translated route, as output by the mock decorator
+		translatedRoute := func() *networkingv1alpha3spec.HTTPRoute {
+			return &networkingv1alpha3spec.HTTPRoute{
+				Match: []*networkingv1alpha3spec.HTTPMatchRequest{{Port: 8080}},
+				Route: []*networkingv1alpha3spec.HTTPRouteDestination{{
+					Destination: &networkingv1alpha3spec.Destination{
+						Host: "local-hostname",
+						Port: &networkingv1alpha3spec.PortSelector{Number: 8080},
+					},
+				}},
+				Retries: &networkingv1alpha3spec.HTTPRetry{Attempts: 5},
+			}
+		}
+
+		// a route delegating to the translated VirtualService
+		delegateRoute := func() *networkingv1alpha3spec.HTTPRoute {
+			return &networkingv1alpha3spec.HTTPRoute{
+				Delegate: &networkingv1alpha3spec.Delegate{
+					Name:      "traffic-target",
+					Namespace: "traffic-target-namespace",
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			destination = &discoveryv1.Destination{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "traffic-target",
+				},
+				Spec: discoveryv1.DestinationSpec{
+					Type: &discoveryv1.DestinationSpec_KubeService_{
+						KubeService: &discoveryv1.DestinationSpec_KubeService{
+							Ref: &v1.ClusterObjectRef{
+								Name:        "traffic-target",
+								Namespace:   "traffic-target-namespace",
+								ClusterName: "traffic-target-cluster",
+							},
+							Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+								{
+									Port:     8080,
+									Name:     "http1",
+									Protocol: "http",
+								},
+							},
+						},
+					},
+				},
+				Status: discoveryv1.DestinationStatus{
+					AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+						{
+							Ref: &v1.ObjectRef{
+								Name:      "tp-1",
+								Namespace: "tp-namespace-1",
+							},
+							Spec: &networkingv1.TrafficPolicySpec{},
+						},
+					},
+				},
+			}
+			settings = &settingsv1.Settings{
+				Spec: settingsv1.SettingsSpec{
+					UserVirtualServices: &settingsv1.UserVirtualServiceSettings{
+						Mode: settingsv1.UserVirtualServiceSettings_MERGE,
+					},
+				},
+			}
+
+			mockClusterDomainRegistry.
+				EXPECT().
+				GetDestinationFQDN(destination.Spec.GetKubeService().Ref.ClusterName, destination.Spec.GetKubeService().Ref).
+				Return("local-hostname")
+
+			mockDecoratorFactory.
+				EXPECT().
+				MakeDecorators(decorators.Parameters{
+					ClusterDomains: mockClusterDomainRegistry,
+					Snapshot:       in,
+				}).
+				Return([]decorators.Decorator{mockDecorator})
+
+			mockDecorator.
+				EXPECT().
+				ApplyTrafficPolicyToVirtualService(
+					gomock.Any(),
+					destination,
+					nil,
+					gomock.Any(),
+					gomock.Any(),
+				).
+				DoAndReturn(func(
+					appliedPolicy *discoveryv1.DestinationStatus_AppliedTrafficPolicy,
+					service *discoveryv1.Destination,
+					sourceMeshInstallation *discoveryv1.MeshInstallation,
+					output *networkingv1alpha3spec.HTTPRoute,
+					registerField decorators.RegisterField,
+				) error {
+					output.Retries = &networkingv1alpha3spec.HTTPRetry{
+						Attempts: 5,
+					}
+					return nil
+				}).
+				AnyTimes()
+		})
+
+		It("should merge translated routes with the user-supplied routes into a delegate VirtualService in merge mode", func() {
+			userRoute := &networkingv1alpha3spec.HTTPRoute{
+				Name:  "user-route",
+				Match: []*networkingv1alpha3spec.HTTPMatchRequest{{Uri: &networkingv1alpha3spec.StringMatch{MatchType: &networkingv1alpha3spec.StringMatch_Prefix{Prefix: "/foo"}}}},
+			}
+			userVirtualService := makeUserVirtualService(delegateRoute(), userRoute)
+			originalUserVirtualService := userVirtualService.DeepCopy()
+
+			virtualServiceTranslator = virtualservice.NewTranslator(settings, v1alpha3sets.NewVirtualServiceSet(userVirtualService), mockClusterDomainRegistry, mockDecoratorFactory)
+			virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+			Expect(virtualService).To(Equal(&networkingv1alpha3.VirtualService{
+				ObjectMeta: metautils.TranslatedObjectMeta(destination.Spec.GetKubeService().GetRef(), nil),
+				Spec: networkingv1alpha3spec.VirtualService{
+					Http: []*networkingv1alpha3spec.HTTPRoute{translatedRoute(), userRoute},
+				},
+			}))
+			Expect(userVirtualService).To(Equal(originalUserVirtualService))
+		})
+
+		It("should report an error in merge mode if the first route of the user-supplied VirtualService does not delegate to the translated VirtualService", func() {
+			userVirtualService := makeUserVirtualService(&networkingv1alpha3spec.HTTPRoute{Name: "user-route"}, delegateRoute())
+
+			mockReporter.
+				EXPECT().
+				ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any()).
+				DoAndReturn(func(destination *discoveryv1.Destination, trafficPolicy ezkube.ResourceId, err error) {
+					Expect(err).To(testutils.HaveInErrorChain(
+						eris.Errorf("the first route of the existing VirtualService %s must delegate to VirtualService %s.%s", sets.Key(userVirtualService), "traffic-target", "traffic-target-namespace"),
+					))
+				})
+
+			virtualServiceTranslator = virtualservice.NewTranslator(settings, v1alpha3sets.NewVirtualServiceSet(userVirtualService), mockClusterDomainRegistry, mockDecoratorFactory)
+			_ = virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		})
+
+		It("should not merge translated routes for a user-supplied VirtualService with multiple hosts", func() {
+			userVirtualService := makeUserVirtualService(delegateRoute())
+			userVirtualService.Spec.Hosts = append(userVirtualService.Spec.Hosts, "other-hostname")
+
+			mockReporter.
+				EXPECT().
+				ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any())
+
+			virtualServiceTranslator = virtualservice.NewTranslator(settings, v1alpha3sets.NewVirtualServiceSet(userVirtualService), mockClusterDomainRegistry, mockDecoratorFactory)
+			virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+			Expect(virtualService).To(BeNil())
+		})
+
+		It("should not merge translated routes for a user-supplied VirtualService which only applies to gateways", func() {
+			userVirtualService := makeUserVirtualService(delegateRoute())
+			userVirtualService.Spec.Gateways = []string{"istio-ingressgateway"}
+
+			mockReporter.
+				EXPECT().
+				ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any())
+
+			virtualServiceTranslator = virtualservice.NewTranslator(settings, v1alpha3sets.NewVirtualServiceSet(userVirtualService), mockClusterDomainRegistry, mockDecoratorFactory)
+			virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+			Expect(virtualService).To(BeNil())
+		})
+
+		It("should use the mode of the first Destination override", func() {
+			settings.Spec.UserVirtualServices.DestinationOverrides = []*settingsv1.UserVirtualServiceSettings_DestinationOverride{
+				{
+					DestinationSelector: []*commonv1.DestinationSelector{{
+						KubeServiceRefs: &commonv1.DestinationSelector_KubeServiceRefs{
+							Services: []*v1.ClusterObjectRef{destination.Spec.GetKubeService().GetRef()},
+						},
+					}},
+					Mode: settingsv1.UserVirtualServiceSettings_REJECT,
+				},
+			}
+
+			mockReporter.
+				EXPECT().
+				ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any())
+
+			virtualServiceTranslator = virtualservice.NewTranslator(settings, v1alpha3sets.NewVirtualServiceSet(makeUserVirtualService()), mockClusterDomainRegistry, mockDecoratorFactory)
+			virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+			Expect(virtualService).To(BeNil())
+		})
+
+		It("should report user-supplied routes with the same match as a translated route in merge mode", func() {
+			userVirtualService := makeUserVirtualService(delegateRoute(), &networkingv1alpha3spec.HTTPRoute{
+				Name:  "catch-all",
+				Match: []*networkingv1alpha3spec.HTTPMatchRequest{{}},
+			})
+
+			mockReporter.
+				EXPECT().
+				ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any()).
+				DoAndReturn(func(destination *discoveryv1.Destination, trafficPolicy ezkube.ResourceId, err error) {
+					Expect(err).To(testutils.HaveInErrorChain(
+						eris.Errorf("translated route conflicts with route %q of the existing VirtualService %s, which has the same match", "catch-all", sets.Key(userVirtualService)),
+					))
+				})
+
+			virtualServiceTranslator = virtualservice.NewTranslator(settings, v1alpha3sets.NewVirtualServiceSet(userVirtualService), mockClusterDomainRegistry, mockDecoratorFactory)
+			_ = virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		})
+
+		It("should output a delegate VirtualService in delegate mode", func() {
+			settings.Spec.UserVirtualServices.Mode = settingsv1.UserVirtualServiceSettings_DELEGATE
+			userVirtualService := makeUserVirtualService(delegateRoute())
+
+			virtualServiceTranslator = virtualservice.NewTranslator(settings, v1alpha3sets.NewVirtualServiceSet(userVirtualService), mockClusterDomainRegistry, mockDecoratorFactory)
+			virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+			Expect(virtualService).To(Equal(&networkingv1alpha3.VirtualService{
+				ObjectMeta: metautils.TranslatedObjectMeta(destination.Spec.GetKubeService().GetRef(), nil),
+				Spec: networkingv1alpha3spec.VirtualService{
+					Http: []*networkingv1alpha3spec.HTTPRoute{translatedRoute()},
+				},
+			}))
+		})
+
+		It("should report an error in delegate mode if the user-supplied VirtualService does not delegate to the translated VirtualService", func() {
+			settings.Spec.UserVirtualServices.Mode = settingsv1.UserVirtualServiceSettings_DELEGATE
+			userVirtualService := makeUserVirtualService()
+
+			mockReporter.
+				EXPECT().
+				ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any()).
+				DoAndReturn(func(destination *discoveryv1.Destination, trafficPolicy ezkube.ResourceId, err error) {
+					Expect(err).To(testutils.HaveInErrorChain(
+						eris.Errorf("the existing VirtualService %s must contain a route delegating to VirtualService %s.%s", sets.Key(userVirtualService), "traffic-target", "traffic-target-namespace"),
+					))
+				})
+
+			virtualServiceTranslator = virtualservice.NewTranslator(settings, v1alpha3sets.NewVirtualServiceSet(userVirtualService), mockClusterDomainRegistry, mockDecoratorFactory)
+			_ = virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		})
+	})
 })
@@ -14,7 +14,9 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/access"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/egress"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/federation"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/workload"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
 	skv1alpha1sets "github.com/solo-io/skv2/pkg/api/multicluster.solo.io/v1alpha1/sets"
 )
@@ -36,6 +38,11 @@ type DependencyFactory interface {
 		secrets corev1sets.SecretSet,
 		workloads discoveryv1sets.WorkloadSet,
 	) mesh.Translator
+	MakeWorkloadTranslator(
+		ctx context.Context,
+		clusters skv1alpha1sets.KubernetesClusterSet,
+		destinations discoveryv1sets.DestinationSet,
+	) workload.Translator
 }
 
 type dependencyFactoryImpl struct{}
@@ -64,11 +71,23 @@ func (d dependencyFactoryImpl) MakeMeshTranslator(
 	federationTranslator := federation.NewTranslator(ctx)
 	mtlsTranslator := mtls.NewTranslator(ctx, secrets, workloads)
 	accessTranslator := access.NewTranslator(ctx)
+	egressTranslator := egress.NewTranslator(ctx)
 
 	return mesh.NewTranslator(
 		ctx,
 		mtlsTranslator,
 		federationTranslator,
 		accessTranslator,
+		egressTranslator,
 	)
 }
+
+func (d dependencyFactoryImpl) MakeWorkloadTranslator(
+	ctx context.Context,
+	clusters skv1alpha1sets.KubernetesClusterSet,
+	destinations discoveryv1sets.DestinationSet,
+) workload.Translator {
+	clusterDomains := hostutils.NewClusterDomainRegistry(clusters, destinations)
+
+	return workload.NewTranslator(ctx, clusterDomains)
+}
@@ -14,6 +14,7 @@ import (
 	input "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	destination "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination"
 	mesh "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh"
+	workload "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/workload"
 	v1alpha1sets "github.com/solo-io/skv2/pkg/api/multicluster.solo.io/v1alpha1/sets"
 )
 
@@ -67,3 +68,17 @@ func (mr *MockDependencyFactoryMockRecorder) MakeMeshTranslator(ctx, secrets, wo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeMeshTranslator", reflect.TypeOf((*MockDependencyFactory)(nil).MakeMeshTranslator), ctx, secrets, workloads)
 }
+
+// MakeWorkloadTranslator mocks base method.
+func (m *MockDependencyFactory) MakeWorkloadTranslator(ctx context.Context, clusters v1alpha1sets.KubernetesClusterSet, destinations v1sets0.DestinationSet) workload.Translator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeWorkloadTranslator", ctx, clusters, destinations)
+	ret0, _ := ret[0].(workload.Translator)
+	return ret0
+}
+
+// MakeWorkloadTranslator indicates an expected call of MakeWorkloadTranslator.
+func (mr *MockDependencyFactoryMockRecorder) MakeWorkloadTranslator(ctx, clusters, destinations interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeWorkloadTranslator", reflect.TypeOf((*MockDependencyFactory)(nil).MakeWorkloadTranslator), ctx, clusters, destinations)
+}
@@ -66,6 +66,16 @@ func (t *istioTranslator) Translate(
 		destinationTranslator.Translate(in, destination, istioOutputs, reporter)
 	}
 
+	workloadTranslator := t.dependencies.MakeWorkloadTranslator(
+		ctx,
+		in.KubernetesClusters(),
+		in.Destinations(),
+	)
+
+	for _, workload := range in.Workloads().List() {
+		workloadTranslator.Translate(in, workload, istioOutputs, reporter)
+	}
+
 	meshTranslator := t.dependencies.MakeMeshTranslator(
 		ctx,
 		in.Secrets(),
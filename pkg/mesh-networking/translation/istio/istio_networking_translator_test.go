@@ -14,6 +14,7 @@ import (
 	mock_extensions "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/extensions/mocks"
 	mock_istio "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/internal/mocks"
 	mock_mesh "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/mocks"
+	mock_workload "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/workload/mocks"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	"github.com/solo-io/go-utils/contextutils"
 	multiclusterv1alpha1 "github.com/solo-io/skv2/pkg/api/multicluster.solo.io/v1alpha1"
@@ -31,6 +32,7 @@ var _ = Describe("IstioNetworkingTranslator", func() {
 		mockLocalOutputs          *mock_local_output.MockBuilder
 		mockDestinationTranslator *mock_destination.MockTranslator
 		mockMeshTranslator        *mock_mesh.MockTranslator
+		mockWorkloadTranslator    *mock_workload.MockTranslator
 		mockDependencyFactory     *mock_istio.MockDependencyFactory
 		translator                Translator
 	)
@@ -43,6 +45,7 @@ var _ = Describe("IstioNetworkingTranslator", func() {
 		mockReporter = mock_reporting.NewMockReporter(ctrl)
 		mockDestinationTranslator = mock_destination.NewMockTranslator(ctrl)
 		mockMeshTranslator = mock_mesh.NewMockTranslator(ctrl)
+		mockWorkloadTranslator = mock_workload.NewMockTranslator(ctrl)
 		mockDependencyFactory = mock_istio.NewMockDependencyFactory(ctrl)
 		mockIstioOutputs = mock_istio_output.NewMockBuilder(ctrl)
 		mockLocalOutputs = mock_local_output.NewMockBuilder(ctrl)
@@ -116,6 +119,17 @@ var _ = Describe("IstioNetworkingTranslator", func() {
 				Translate(in, destination, mockIstioOutputs, mockReporter)
 		}
 
+		mockDependencyFactory.
+			EXPECT().
+			MakeWorkloadTranslator(contextMatcher, in.KubernetesClusters(), in.Destinations()).
+			Return(mockWorkloadTranslator)
+
+		for _, workload := range in.Workloads().List() {
+			mockWorkloadTranslator.
+				EXPECT().
+				Translate(in, workload, mockIstioOutputs, mockReporter)
+		}
+
 		mockDependencyFactory.
 			EXPECT().
 			MakeMeshTranslator(ctxWithValue, in.Secrets(), in.Workloads()).
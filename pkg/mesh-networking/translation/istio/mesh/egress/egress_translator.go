@@ -0,0 +1,396 @@
+package egress
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/egressutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/k8s-utils/kubeutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//go:generate mockgen -source ./egress_translator.go -destination mocks/egress_translator.go
+
+const (
+	// the name of the Istio gateway which represents all sidecars in the mesh
+	meshGateway = "mesh"
+)
+
+// the egress translator translates the Gateway, VirtualServices, DestinationRules and ServiceEntries
+// which route requests for external hosts through the egress gateway of a Mesh.
+type Translator interface {
+	// Translate translates the resources for the EgressGatewayPolicies accepted for the given Mesh.
+	// Output resources will be added to the istio.Builder
+	// The EgressGatewayPolicies are validated by the Applier, which only accepts Meshes that can be translated.
+	Translate(
+		in input.LocalSnapshot,
+		mesh *discoveryv1.Mesh,
+		outputs istio.Builder,
+	)
+}
+
+type translator struct {
+	ctx context.Context
+}
+
+func NewTranslator(
+	ctx context.Context,
+) Translator {
+	return &translator{ctx: ctx}
+}
+
+func (t *translator) Translate(
+	in input.LocalSnapshot,
+	mesh *discoveryv1.Mesh,
+	outputs istio.Builder,
+) {
+	istioMesh := mesh.Spec.GetIstio()
+	if istioMesh == nil {
+		contextutils.LoggerFrom(t.ctx).Debugf("ignoring non istio mesh %v %T", sets.Key(mesh), mesh.Spec.Type)
+		return
+	}
+
+	clusterDomains := hostutils.NewClusterDomainRegistry(in.KubernetesClusters(), in.Destinations())
+
+	for _, egressGatewayPolicy := range in.EgressGatewayPolicies().List() {
+		if egressGatewayPolicy.Status.GetMeshes()[sets.Key(mesh)].GetState() != commonv1.ApprovalState_ACCEPTED {
+			continue
+		}
+
+		egressGateway := egressutils.GetEgressGateway(mesh, egressGatewayPolicy.Spec.GetGatewayWorkloadLabels())
+		if egressGateway == nil {
+			contextutils.LoggerFrom(t.ctx).DPanicf("internal error: egress gateway not found for accepted mesh %v", sets.Key(mesh))
+			continue
+		}
+
+		hosts := egressutils.GetEgressHosts(egressGatewayPolicy, mesh, in.Destinations())
+		if len(hosts) == 0 {
+			continue
+		}
+
+		egressGatewayFQDN := clusterDomains.GetLocalFQDN(&skv2corev1.ClusterObjectRef{
+			Name:        egressGateway.GetName(),
+			Namespace:   egressGateway.GetNamespace(),
+			ClusterName: istioMesh.GetInstallation().GetCluster(),
+		})
+
+		t.translateEgressGatewayPolicy(
+			egressGatewayPolicy,
+			istioMesh.GetInstallation().GetCluster(),
+			egressGateway,
+			egressGatewayFQDN,
+			hosts,
+			outputs,
+		)
+	}
+}
+
+func (t *translator) translateEgressGatewayPolicy(
+	egressGatewayPolicy *networkingv1.EgressGatewayPolicy,
+	cluster string,
+	egressGateway *discoveryv1.MeshSpec_Istio_EgressGatewayInfo,
+	egressGatewayFQDN string,
+	hosts []*egressutils.EgressHost,
+	outputs istio.Builder,
+) {
+	gateway := t.buildGateway(egressGatewayPolicy, cluster, egressGateway, hosts)
+	outputs.AddGateways(gateway)
+
+	// the Istio gateway name referenced by VirtualServices, qualified by namespace
+	gatewayName := fmt.Sprintf("%s/%s", gateway.Namespace, gateway.Name)
+
+	// translate one VirtualService, ServiceEntry and DestinationRule per hostname
+	hostsByHostname := map[string][]*egressutils.EgressHost{}
+	var hostnames []string
+	for _, host := range hosts {
+		if _, ok := hostsByHostname[host.Hostname]; !ok {
+			hostnames = append(hostnames, host.Hostname)
+		}
+		hostsByHostname[host.Hostname] = append(hostsByHostname[host.Hostname], host)
+	}
+
+	for _, hostname := range hostnames {
+		outputs.AddVirtualServices(t.buildVirtualService(
+			egressGatewayPolicy,
+			cluster,
+			egressGateway.GetNamespace(),
+			gatewayName,
+			egressGatewayFQDN,
+			hostname,
+			hostsByHostname[hostname],
+		))
+
+		// ExternalService Destinations are already represented by a ServiceEntry
+		if hostsByHostname[hostname][0].Destination == nil {
+			outputs.AddServiceEntries(t.buildServiceEntry(
+				egressGatewayPolicy,
+				cluster,
+				egressGateway.GetNamespace(),
+				hostname,
+				hostsByHostname[hostname],
+			))
+		}
+
+		if egressGatewayPolicy.Spec.GetTlsOrigination() != nil {
+			outputs.AddDestinationRules(t.buildTlsOriginationDestinationRule(
+				egressGatewayPolicy,
+				cluster,
+				egressGateway.GetNamespace(),
+				hostname,
+			))
+		}
+	}
+}
+
+// the Gateway configures the egress gateway workload to accept requests for the external hosts from the sidecars in the mesh
+func (t *translator) buildGateway(
+	egressGatewayPolicy *networkingv1.EgressGatewayPolicy,
+	cluster string,
+	egressGateway *discoveryv1.MeshSpec_Istio_EgressGatewayInfo,
+	hosts []*egressutils.EgressHost,
+) *networkingv1alpha3.Gateway {
+	hostnamesByPort := map[uint32][]string{}
+	// the Applier only accepts policies whose hosts use a single protocol per port
+	protocolsByPort := map[uint32]networkingv1.EgressGatewayPolicySpec_ExternalHost_Protocol{}
+	var ports []uint32
+	for _, host := range hosts {
+		if _, ok := hostnamesByPort[host.Port]; !ok {
+			ports = append(ports, host.Port)
+		}
+		hostnamesByPort[host.Port] = append(hostnamesByPort[host.Port], host.Hostname)
+		protocolsByPort[host.Port] = host.Protocol
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i] < ports[j]
+	})
+
+	var servers []*networkingv1alpha3spec.Server
+	for _, port := range ports {
+		server := &networkingv1alpha3spec.Server{
+			Port: &networkingv1alpha3spec.Port{
+				Number:   port,
+				Protocol: "HTTP",
+				Name:     fmt.Sprintf("http-%d", port),
+			},
+			Hosts: hostnamesByPort[port],
+		}
+		if protocolsByPort[port] == networkingv1.EgressGatewayPolicySpec_ExternalHost_TLS {
+			server.Port.Protocol = "TLS"
+			server.Port.Name = fmt.Sprintf("tls-%d", port)
+			server.Tls = &networkingv1alpha3spec.ServerTLSSettings{
+				Mode: networkingv1alpha3spec.ServerTLSSettings_PASSTHROUGH,
+			}
+		}
+		servers = append(servers, server)
+	}
+
+	return &networkingv1alpha3.Gateway{
+		ObjectMeta: t.buildObjectMeta(
+			egressGatewayPolicy,
+			kubeutils.SanitizeNameV2(fmt.Sprintf("%s-%s", egressGatewayPolicy.GetName(), egressGatewayPolicy.GetNamespace())),
+			egressGateway.GetNamespace(),
+			cluster,
+		),
+		Spec: networkingv1alpha3spec.Gateway{
+			Servers:  servers,
+			Selector: egressGateway.GetWorkloadLabels(),
+		},
+	}
+}
+
+// the VirtualService routes requests for the host from the sidecars to the egress gateway,
+// and from the egress gateway to the host
+func (t *translator) buildVirtualService(
+	egressGatewayPolicy *networkingv1.EgressGatewayPolicy,
+	cluster, namespace string,
+	gatewayName string,
+	egressGatewayFQDN string,
+	hostname string,
+	hosts []*egressutils.EgressHost,
+) *networkingv1alpha3.VirtualService {
+	virtualService := &networkingv1alpha3.VirtualService{
+		ObjectMeta: t.buildObjectMeta(egressGatewayPolicy, buildHostObjectName(egressGatewayPolicy, hostname), namespace, cluster),
+		Spec: networkingv1alpha3spec.VirtualService{
+			Hosts:    []string{hostname},
+			Gateways: []string{meshGateway, gatewayName},
+		},
+	}
+
+	for _, host := range hosts {
+		switch host.Protocol {
+		case networkingv1.EgressGatewayPolicySpec_ExternalHost_HTTP:
+			upstreamPort := host.Port
+			if tlsOrigination := egressGatewayPolicy.Spec.GetTlsOrigination(); tlsOrigination != nil {
+				upstreamPort = egressutils.TlsOriginationPort(tlsOrigination)
+			}
+			virtualService.Spec.Http = append(virtualService.Spec.Http,
+				&networkingv1alpha3spec.HTTPRoute{
+					Match: []*networkingv1alpha3spec.HTTPMatchRequest{{
+						Gateways: []string{meshGateway},
+						Port:     host.Port,
+					}},
+					Route: []*networkingv1alpha3spec.HTTPRouteDestination{{
+						Destination: buildDestination(egressGatewayFQDN, host.Port),
+					}},
+				},
+				&networkingv1alpha3spec.HTTPRoute{
+					Match: []*networkingv1alpha3spec.HTTPMatchRequest{{
+						Gateways: []string{gatewayName},
+						Port:     host.Port,
+					}},
+					Route: []*networkingv1alpha3spec.HTTPRouteDestination{{
+						Destination: buildDestination(hostname, upstreamPort),
+					}},
+				},
+			)
+		case networkingv1.EgressGatewayPolicySpec_ExternalHost_TLS:
+			virtualService.Spec.Tls = append(virtualService.Spec.Tls,
+				&networkingv1alpha3spec.TLSRoute{
+					Match: []*networkingv1alpha3spec.TLSMatchAttributes{{
+						Gateways: []string{meshGateway},
+						Port:     host.Port,
+						SniHosts: []string{hostname},
+					}},
+					Route: []*networkingv1alpha3spec.RouteDestination{{
+						Destination: buildDestination(egressGatewayFQDN, host.Port),
+					}},
+				},
+				&networkingv1alpha3spec.TLSRoute{
+					Match: []*networkingv1alpha3spec.TLSMatchAttributes{{
+						Gateways: []string{gatewayName},
+						Port:     host.Port,
+						SniHosts: []string{hostname},
+					}},
+					Route: []*networkingv1alpha3spec.RouteDestination{{
+						Destination: buildDestination(hostname, host.Port),
+					}},
+				},
+			)
+		}
+	}
+
+	return virtualService
+}
+
+// the ServiceEntry registers an external host declared on the EgressGatewayPolicy with the mesh
+func (t *translator) buildServiceEntry(
+	egressGatewayPolicy *networkingv1.EgressGatewayPolicy,
+	cluster, namespace string,
+	hostname string,
+	hosts []*egressutils.EgressHost,
+) *networkingv1alpha3.ServiceEntry {
+	var ports []*networkingv1alpha3spec.Port
+	portNumbers := map[uint32]bool{}
+	for _, host := range hosts {
+		portNumbers[host.Port] = true
+		port := &networkingv1alpha3spec.Port{
+			Number:   host.Port,
+			Protocol: "HTTP",
+			Name:     fmt.Sprintf("http-%d", host.Port),
+		}
+		if host.Protocol == networkingv1.EgressGatewayPolicySpec_ExternalHost_TLS {
+			port.Protocol = "TLS"
+			port.Name = fmt.Sprintf("tls-%d", host.Port)
+		}
+		ports = append(ports, port)
+	}
+	if tlsOrigination := egressGatewayPolicy.Spec.GetTlsOrigination(); tlsOrigination != nil {
+		if originationPort := egressutils.TlsOriginationPort(tlsOrigination); !portNumbers[originationPort] {
+			ports = append(ports, &networkingv1alpha3spec.Port{
+				Number:   originationPort,
+				Protocol: "HTTPS",
+				Name:     fmt.Sprintf("https-%d", originationPort),
+			})
+		}
+	}
+
+	return &networkingv1alpha3.ServiceEntry{
+		ObjectMeta: t.buildObjectMeta(egressGatewayPolicy, buildHostObjectName(egressGatewayPolicy, hostname), namespace, cluster),
+		Spec: networkingv1alpha3spec.ServiceEntry{
+			Hosts:      []string{hostname},
+			Ports:      ports,
+			Location:   networkingv1alpha3spec.ServiceEntry_MESH_EXTERNAL,
+			Resolution: networkingv1alpha3spec.ServiceEntry_DNS,
+		},
+	}
+}
+
+// the DestinationRule configures the egress gateway to originate TLS to the host.
+// It is only exported to the namespace of the egress gateway, so that sidecars in the mesh keep sending plaintext requests.
+func (t *translator) buildTlsOriginationDestinationRule(
+	egressGatewayPolicy *networkingv1.EgressGatewayPolicy,
+	cluster, namespace string,
+	hostname string,
+) *networkingv1alpha3.DestinationRule {
+	tlsOrigination := egressGatewayPolicy.Spec.GetTlsOrigination()
+
+	tlsSettings := &networkingv1alpha3spec.ClientTLSSettings{
+		Mode: networkingv1alpha3spec.ClientTLSSettings_SIMPLE,
+		Sni:  hostname,
+	}
+	if sni := tlsOrigination.GetSni(); sni != "" {
+		tlsSettings.Sni = sni
+	}
+	if credentialName := tlsOrigination.GetCredentialName(); credentialName != "" {
+		tlsSettings.Mode = networkingv1alpha3spec.ClientTLSSettings_MUTUAL
+		tlsSettings.CredentialName = credentialName
+	}
+
+	return &networkingv1alpha3.DestinationRule{
+		ObjectMeta: t.buildObjectMeta(egressGatewayPolicy, buildHostObjectName(egressGatewayPolicy, hostname), namespace, cluster),
+		Spec: networkingv1alpha3spec.DestinationRule{
+			Host:     hostname,
+			ExportTo: []string{"."},
+			TrafficPolicy: &networkingv1alpha3spec.TrafficPolicy{
+				PortLevelSettings: []*networkingv1alpha3spec.TrafficPolicy_PortTrafficPolicy{{
+					Port: &networkingv1alpha3spec.PortSelector{
+						Number: egressutils.TlsOriginationPort(tlsOrigination),
+					},
+					Tls: tlsSettings,
+				}},
+			},
+		},
+	}
+}
+
+func (t *translator) buildObjectMeta(
+	egressGatewayPolicy *networkingv1.EgressGatewayPolicy,
+	name, namespace, cluster string,
+) metav1.ObjectMeta {
+	meta := metav1.ObjectMeta{
+		Name:        name,
+		Namespace:   namespace,
+		ClusterName: cluster,
+		Labels:      metautils.TranslatedObjectLabels(),
+	}
+	metautils.AppendParent(t.ctx, &meta, egressGatewayPolicy, networkingv1.EgressGatewayPolicy{}.GVK())
+	return meta
+}
+
+func buildHostObjectName(egressGatewayPolicy *networkingv1.EgressGatewayPolicy, hostname string) string {
+	return kubeutils.SanitizeNameV2(
+		fmt.Sprintf("%s-%s-%s", hostname, egressGatewayPolicy.GetName(), egressGatewayPolicy.GetNamespace()),
+	)
+}
+
+func buildDestination(host string, port uint32) *networkingv1alpha3spec.Destination {
+	return &networkingv1alpha3spec.Destination{
+		Host: host,
+		Port: &networkingv1alpha3spec.PortSelector{
+			Number: port,
+		},
+	}
+}
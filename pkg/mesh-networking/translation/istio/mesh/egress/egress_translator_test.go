@@ -0,0 +1,339 @@
+package egress_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/egress"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("EgressTranslator", func() {
+	var (
+		ctx  = context.TODO()
+		mesh *discoveryv1.Mesh
+
+		expectedMeta = func(name string) metav1.ObjectMeta {
+			return metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "istio-system",
+				ClusterName: "cluster",
+				Labels:      metautils.TranslatedObjectLabels(),
+				Annotations: map[string]string{
+					metautils.ParentLabelkey: `{"networking.mesh.gloo.solo.io/v1, Kind=EgressGatewayPolicy":[{"name":"egress","namespace":"config-namespace"}]}`,
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		mesh = &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "mesh",
+				Namespace: "gloo-mesh",
+			},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Istio_{Istio: &discoveryv1.MeshSpec_Istio{
+					Installation: &discoveryv1.MeshInstallation{
+						Namespace: "istio-system",
+						Cluster:   "cluster",
+					},
+					EgressGateways: []*discoveryv1.MeshSpec_Istio_EgressGatewayInfo{{
+						Name:           "istio-egressgateway",
+						Namespace:      "istio-system",
+						WorkloadLabels: map[string]string{"istio": "egressgateway"},
+						Ports: []*discoveryv1.MeshSpec_Istio_EgressGatewayInfo_ServicePort{
+							{Port: 80, Name: "http"},
+							{Port: 443, Name: "https"},
+						},
+					}},
+				}},
+			},
+		}
+	})
+
+	acceptedFor := func(mesh *discoveryv1.Mesh) networkingv1.EgressGatewayPolicyStatus {
+		return networkingv1.EgressGatewayPolicyStatus{
+			State: commonv1.ApprovalState_ACCEPTED,
+			Meshes: map[string]*networkingv1.ApprovalStatus{
+				sets.Key(mesh): {State: commonv1.ApprovalState_ACCEPTED},
+			},
+		}
+	}
+
+	It("routes external hosts through the egress gateway", func() {
+		egressGatewayPolicy := &networkingv1.EgressGatewayPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "egress",
+				Namespace: "config-namespace",
+			},
+			Spec: networkingv1.EgressGatewayPolicySpec{
+				ExternalHosts: []*networkingv1.EgressGatewayPolicySpec_ExternalHost{
+					{
+						Hostname: "httpbin.org",
+					},
+					{
+						Hostname: "secure.example.com",
+						Protocol: networkingv1.EgressGatewayPolicySpec_ExternalHost_TLS,
+					},
+				},
+			},
+			Status: acceptedFor(mesh),
+		}
+
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddMeshes([]*discoveryv1.Mesh{mesh}).
+			AddEgressGatewayPolicies([]*networkingv1.EgressGatewayPolicy{egressGatewayPolicy}).
+			Build()
+		outputs := istio.NewBuilder(ctx, "")
+
+		NewTranslator(ctx).Translate(in, mesh, outputs)
+
+		Expect(outputs.GetGateways().List()).To(ConsistOf(&networkingv1alpha3.Gateway{
+			ObjectMeta: expectedMeta("egress-config-namespace"),
+			Spec: networkingv1alpha3spec.Gateway{
+				Servers: []*networkingv1alpha3spec.Server{
+					{
+						Port: &networkingv1alpha3spec.Port{
+							Number:   80,
+							Protocol: "HTTP",
+							Name:     "http-80",
+						},
+						Hosts: []string{"httpbin.org"},
+					},
+					{
+						Port: &networkingv1alpha3spec.Port{
+							Number:   443,
+							Protocol: "TLS",
+							Name:     "tls-443",
+						},
+						Hosts: []string{"secure.example.com"},
+						Tls: &networkingv1alpha3spec.ServerTLSSettings{
+							Mode: networkingv1alpha3spec.ServerTLSSettings_PASSTHROUGH,
+						},
+					},
+				},
+				Selector: map[string]string{"istio": "egressgateway"},
+			},
+		}))
+
+		gatewayName := "istio-system/egress-config-namespace"
+		egressGatewayFQDN := "istio-egressgateway.istio-system.svc.cluster.local"
+		Expect(outputs.GetVirtualServices().List()).To(ConsistOf(
+			&networkingv1alpha3.VirtualService{
+				ObjectMeta: expectedMeta("httpbin-org-egress-config-namespace"),
+				Spec: networkingv1alpha3spec.VirtualService{
+					Hosts:    []string{"httpbin.org"},
+					Gateways: []string{"mesh", gatewayName},
+					Http: []*networkingv1alpha3spec.HTTPRoute{
+						{
+							Match: []*networkingv1alpha3spec.HTTPMatchRequest{{Gateways: []string{"mesh"}, Port: 80}},
+							Route: []*networkingv1alpha3spec.HTTPRouteDestination{{
+								Destination: &networkingv1alpha3spec.Destination{
+									Host: egressGatewayFQDN,
+									Port: &networkingv1alpha3spec.PortSelector{Number: 80},
+								},
+							}},
+						},
+						{
+							Match: []*networkingv1alpha3spec.HTTPMatchRequest{{Gateways: []string{gatewayName}, Port: 80}},
+							Route: []*networkingv1alpha3spec.HTTPRouteDestination{{
+								Destination: &networkingv1alpha3spec.Destination{
+									Host: "httpbin.org",
+									Port: &networkingv1alpha3spec.PortSelector{Number: 80},
+								},
+							}},
+						},
+					},
+				},
+			},
+			&networkingv1alpha3.VirtualService{
+				ObjectMeta: expectedMeta("secure-example-com-egress-config-namespace"),
+				Spec: networkingv1alpha3spec.VirtualService{
+					Hosts:    []string{"secure.example.com"},
+					Gateways: []string{"mesh", gatewayName},
+					Tls: []*networkingv1alpha3spec.TLSRoute{
+						{
+							Match: []*networkingv1alpha3spec.TLSMatchAttributes{{
+								Gateways: []string{"mesh"},
+								Port:     443,
+								SniHosts: []string{"secure.example.com"},
+							}},
+							Route: []*networkingv1alpha3spec.RouteDestination{{
+								Destination: &networkingv1alpha3spec.Destination{
+									Host: egressGatewayFQDN,
+									Port: &networkingv1alpha3spec.PortSelector{Number: 443},
+								},
+							}},
+						},
+						{
+							Match: []*networkingv1alpha3spec.TLSMatchAttributes{{
+								Gateways: []string{gatewayName},
+								Port:     443,
+								SniHosts: []string{"secure.example.com"},
+							}},
+							Route: []*networkingv1alpha3spec.RouteDestination{{
+								Destination: &networkingv1alpha3spec.Destination{
+									Host: "secure.example.com",
+									Port: &networkingv1alpha3spec.PortSelector{Number: 443},
+								},
+							}},
+						},
+					},
+				},
+			},
+		))
+
+		Expect(outputs.GetServiceEntries().List()).To(ConsistOf(
+			&networkingv1alpha3.ServiceEntry{
+				ObjectMeta: expectedMeta("httpbin-org-egress-config-namespace"),
+				Spec: networkingv1alpha3spec.ServiceEntry{
+					Hosts:      []string{"httpbin.org"},
+					Ports:      []*networkingv1alpha3spec.Port{{Number: 80, Protocol: "HTTP", Name: "http-80"}},
+					Location:   networkingv1alpha3spec.ServiceEntry_MESH_EXTERNAL,
+					Resolution: networkingv1alpha3spec.ServiceEntry_DNS,
+				},
+			},
+			&networkingv1alpha3.ServiceEntry{
+				ObjectMeta: expectedMeta("secure-example-com-egress-config-namespace"),
+				Spec: networkingv1alpha3spec.ServiceEntry{
+					Hosts:      []string{"secure.example.com"},
+					Ports:      []*networkingv1alpha3spec.Port{{Number: 443, Protocol: "TLS", Name: "tls-443"}},
+					Location:   networkingv1alpha3spec.ServiceEntry_MESH_EXTERNAL,
+					Resolution: networkingv1alpha3spec.ServiceEntry_DNS,
+				},
+			},
+		))
+
+		Expect(outputs.GetDestinationRules().Length()).To(Equal(0))
+	})
+
+	It("originates TLS for ExternalService Destinations", func() {
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "external-api",
+				Namespace: "gloo-mesh",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_ExternalService_{ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+					Name:  "external-api",
+					Hosts: []string{"api.example.com"},
+					Ports: []*discoveryv1.DestinationSpec_ExternalService_ServicePort{
+						{Number: 80, Name: "http", Protocol: "HTTP"},
+						{Number: 443, Name: "https", Protocol: "HTTPS"},
+					},
+					Ref: &skv2corev1.ClusterObjectRef{
+						Name:        "external-api",
+						Namespace:   "default",
+						ClusterName: "cluster",
+					},
+				}},
+				Mesh: &skv2corev1.ObjectRef{
+					Name:      "mesh",
+					Namespace: "gloo-mesh",
+				},
+			},
+		}
+
+		egressGatewayPolicy := &networkingv1.EgressGatewayPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "egress",
+				Namespace: "config-namespace",
+			},
+			Spec: networkingv1.EgressGatewayPolicySpec{
+				DestinationSelector: []*commonv1.DestinationSelector{{
+					ExternalServiceRefs: &commonv1.DestinationSelector_ExternalServiceRefs{
+						ServiceEntries: []*skv2corev1.ClusterObjectRef{destination.Spec.GetExternalService().GetRef()},
+					},
+				}},
+				TlsOrigination: &networkingv1.EgressGatewayPolicySpec_TLSOrigination{
+					CredentialName: "client-credential",
+				},
+			},
+			Status: acceptedFor(mesh),
+		}
+		egressGatewayPolicy.Status.Destinations = map[string]*networkingv1.ApprovalStatus{
+			sets.Key(destination): {State: commonv1.ApprovalState_ACCEPTED},
+		}
+
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddMeshes([]*discoveryv1.Mesh{mesh}).
+			AddDestinations([]*discoveryv1.Destination{destination}).
+			AddEgressGatewayPolicies([]*networkingv1.EgressGatewayPolicy{egressGatewayPolicy}).
+			Build()
+		outputs := istio.NewBuilder(ctx, "")
+
+		NewTranslator(ctx).Translate(in, mesh, outputs)
+
+		// the ExternalService is already represented by a ServiceEntry, and its TLS port is not routed
+		Expect(outputs.GetServiceEntries().Length()).To(Equal(0))
+		Expect(outputs.GetGateways().List()).To(HaveLen(1))
+		Expect(outputs.GetGateways().List()[0].Spec.Servers).To(HaveLen(1))
+
+		virtualServices := outputs.GetVirtualServices().List()
+		Expect(virtualServices).To(HaveLen(1))
+		Expect(virtualServices[0].Spec.Http).To(HaveLen(2))
+		Expect(virtualServices[0].Spec.Http[1].Route[0].Destination).To(Equal(&networkingv1alpha3spec.Destination{
+			Host: "api.example.com",
+			Port: &networkingv1alpha3spec.PortSelector{Number: 443},
+		}))
+
+		Expect(outputs.GetDestinationRules().List()).To(ConsistOf(&networkingv1alpha3.DestinationRule{
+			ObjectMeta: expectedMeta("api-example-com-egress-config-namespace"),
+			Spec: networkingv1alpha3spec.DestinationRule{
+				Host:     "api.example.com",
+				ExportTo: []string{"."},
+				TrafficPolicy: &networkingv1alpha3spec.TrafficPolicy{
+					PortLevelSettings: []*networkingv1alpha3spec.TrafficPolicy_PortTrafficPolicy{{
+						Port: &networkingv1alpha3spec.PortSelector{Number: 443},
+						Tls: &networkingv1alpha3spec.ClientTLSSettings{
+							Mode:           networkingv1alpha3spec.ClientTLSSettings_MUTUAL,
+							Sni:            "api.example.com",
+							CredentialName: "client-credential",
+						},
+					}},
+				},
+			},
+		}))
+	})
+
+	It("ignores policies not accepted for the mesh", func() {
+		egressGatewayPolicy := &networkingv1.EgressGatewayPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "egress",
+				Namespace: "config-namespace",
+			},
+			Spec: networkingv1.EgressGatewayPolicySpec{
+				ExternalHosts: []*networkingv1.EgressGatewayPolicySpec_ExternalHost{{Hostname: "httpbin.org"}},
+			},
+			Status: networkingv1.EgressGatewayPolicyStatus{
+				State: commonv1.ApprovalState_INVALID,
+				Meshes: map[string]*networkingv1.ApprovalStatus{
+					sets.Key(mesh): {State: commonv1.ApprovalState_INVALID},
+				},
+			},
+		}
+
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddMeshes([]*discoveryv1.Mesh{mesh}).
+			AddEgressGatewayPolicies([]*networkingv1.EgressGatewayPolicy{egressGatewayPolicy}).
+			Build()
+		outputs := istio.NewBuilder(ctx, "")
+
+		NewTranslator(ctx).Translate(in, mesh, outputs)
+
+		Expect(outputs.GetGateways().Length()).To(Equal(0))
+		Expect(outputs.GetVirtualServices().Length()).To(Equal(0))
+	})
+})
@@ -3,14 +3,17 @@ package federation
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/rotisserie/eris"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
 	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/mtls"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	"github.com/solo-io/go-utils/contextutils"
@@ -27,6 +30,8 @@ import (
 const (
 	// NOTE(ilackarms): we may want to support federating over non-tls port at some point.
 	defaultGatewayProtocol = "TLS"
+	// Gateway server port names must be unique
+	egressPortNameSuffix = "-egress"
 )
 
 // the Mesh Federation translator translates a Gateway CR for enabling the Mesh to receive cross cluster traffic
@@ -82,6 +87,12 @@ func (t *translator) Translate(
 		return
 	}
 
+	limitedTrust := virtualMesh.Spec.GetMtlsConfig().GetLimited() != nil
+	var egressHostnames []string
+	if limitedTrust {
+		egressHostnames = getFederatedHostnamesForMesh(in.Destinations(), mesh, virtualMesh.GetRef())
+	}
+
 	// translate one Gateway CR per ingress gateway Destination
 	for _, appliedIngressGateway := range mesh.Status.GetAppliedEastWestIngressGateways() {
 		destination, err := in.Destinations().Find(ezkube.MakeObjectRef(appliedIngressGateway.GetDestinationRef()))
@@ -103,6 +114,8 @@ func (t *translator) Translate(
 			ingressContainerPort,
 			federatedHostnameSuffix,
 			destination.Spec.GetKubeService().GetWorkloadSelectorLabels(),
+			limitedTrust,
+			egressHostnames,
 			virtualMesh.GetRef(),
 		)
 
@@ -115,8 +128,48 @@ func (t *translator) buildGateway(
 	ingressContainerPort uint32,
 	federatedHostnameSuffix string,
 	ingressGatewayWorkloadLabels map[string]string,
+	limitedTrust bool,
+	egressHostnames []string,
 	virtualMeshRef ezkube.ResourceId,
 ) *networkingv1alpha3.Gateway {
+	// with shared trust, traffic is passed through to the destination workload which authenticates the client
+	tlsSettings := &networkingv1alpha3spec.ServerTLSSettings{
+		Mode: networkingv1alpha3spec.ServerTLSSettings_AUTO_PASSTHROUGH,
+	}
+	// with limited trust, traffic is authenticated at the gateway using the trust bundle issued to the mesh
+	if limitedTrust {
+		tlsSettings = &networkingv1alpha3spec.ServerTLSSettings{
+			Mode:           networkingv1alpha3spec.ServerTLSSettings_MUTUAL,
+			CredentialName: mtls.LimitedTrustCredentialSecretName,
+		}
+	}
+
+	servers := []*networkingv1alpha3spec.Server{{
+		Port: &networkingv1alpha3spec.Port{
+			Number:   ingressContainerPort,
+			Protocol: defaultGatewayProtocol,
+			Name:     defaults.IstioGatewayTlsPortName,
+		},
+		Hosts: []string{"*." + federatedHostnameSuffix},
+		Tls:   tlsSettings,
+	}}
+	// with limited trust, clients send traffic for Destinations in other meshes to the gateway using Istio mTLS,
+	// and the gateway originates mTLS to the other meshes' gateways using the gateway credentials.
+	// The federated hostnames take precedence over the wildcard host of the server for remote traffic.
+	if len(egressHostnames) > 0 {
+		servers = append(servers, &networkingv1alpha3spec.Server{
+			Port: &networkingv1alpha3spec.Port{
+				Number:   ingressContainerPort,
+				Protocol: defaultGatewayProtocol,
+				Name:     defaults.IstioGatewayTlsPortName + egressPortNameSuffix,
+			},
+			Hosts: egressHostnames,
+			Tls: &networkingv1alpha3spec.ServerTLSSettings{
+				Mode: networkingv1alpha3spec.ServerTLSSettings_ISTIO_MUTUAL,
+			},
+		})
+	}
+
 	gw := &networkingv1alpha3.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        name,
@@ -125,17 +178,7 @@ func (t *translator) buildGateway(
 			Labels:      metautils.TranslatedObjectLabels(),
 		},
 		Spec: networkingv1alpha3spec.Gateway{
-			Servers: []*networkingv1alpha3spec.Server{{
-				Port: &networkingv1alpha3spec.Port{
-					Number:   ingressContainerPort,
-					Protocol: defaultGatewayProtocol,
-					Name:     defaults.IstioGatewayTlsPortName,
-				},
-				Hosts: []string{"*." + federatedHostnameSuffix},
-				Tls: &networkingv1alpha3spec.ServerTLSSettings{
-					Mode: networkingv1alpha3spec.ServerTLSSettings_AUTO_PASSTHROUGH,
-				},
-			}},
+			Servers:  servers,
 			Selector: ingressGatewayWorkloadLabels,
 		},
 	}
@@ -152,3 +195,26 @@ func BuildGatewayName(appliedIngressGateway *discoveryv1.MeshStatus_AppliedIngre
 		fmt.Sprintf("%s-%s", ingressDestinationRef.GetName(), ingressDestinationRef.GetNamespace()),
 	)
 }
+
+// returns the federated hostnames of the Destinations in other meshes which are federated to the given mesh by the VirtualMesh
+func getFederatedHostnamesForMesh(
+	destinations discoveryv1sets.DestinationSet,
+	mesh *discoveryv1.Mesh,
+	virtualMeshRef ezkube.ResourceId,
+) []string {
+	var hostnames []string
+	for _, destination := range destinations.List() {
+		appliedFederation := destination.Status.GetAppliedFederation()
+		if appliedFederation == nil || !ezkube.RefsMatch(appliedFederation.GetVirtualMeshRef(), virtualMeshRef) {
+			continue
+		}
+		for _, meshRef := range appliedFederation.GetFederatedToMeshes() {
+			if ezkube.RefsMatch(meshRef, mesh) {
+				hostnames = append(hostnames, appliedFederation.GetFederatedHostname())
+				break
+			}
+		}
+	}
+	sort.Strings(hostnames)
+	return hostnames
+}
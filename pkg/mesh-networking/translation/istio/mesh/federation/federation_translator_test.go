@@ -11,6 +11,7 @@ import (
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
 	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/federation"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/mtls"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	skv1alpha1 "github.com/solo-io/skv2/pkg/api/multicluster.solo.io/v1alpha1"
@@ -224,4 +225,148 @@ var _ = Describe("FederationTranslator", func() {
 
 		Expect(outputs.GetGateways().List()).To(ConsistOf(expectedGateways))
 	})
+
+	It("terminates and originates mTLS at the gateway for a VirtualMesh using limited trust", func() {
+		mesh := &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "config-namespace",
+				Name:      "federated-mesh",
+			},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Istio_{Istio: &discoveryv1.MeshSpec_Istio{
+					Installation: &discoveryv1.MeshInstallation{
+						Namespace: "namespace",
+						Cluster:   "cluster",
+					},
+				}},
+			},
+			Status: discoveryv1.MeshStatus{
+				AppliedEastWestIngressGateways: []*discoveryv1.MeshStatus_AppliedIngressGateway{
+					{
+						DestinationRef: &skv2corev1.ObjectRef{
+							Name:      "istio-ingressgateway",
+							Namespace: "istio-system",
+						},
+						DestinationPort: 1234,
+						ContainerPort:   91234,
+					},
+				},
+			},
+		}
+
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "istio-system",
+				Name:      "istio-ingressgateway",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "istio-ingressgateway",
+							Namespace:   "istio-system",
+							ClusterName: "cluster",
+						},
+						WorkloadSelectorLabels: map[string]string{"gatewaylabels": "righthere"},
+					},
+				},
+			},
+		}
+
+		vMesh := &discoveryv1.MeshStatus_AppliedVirtualMesh{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "my-virtual-mesh",
+				Namespace: "config-namespace",
+			},
+			Spec: &v1.VirtualMeshSpec{
+				Meshes: []*skv2corev1.ObjectRef{
+					ezkube.MakeObjectRef(mesh),
+					{Name: "client-mesh", Namespace: "config-namespace"},
+				},
+				MtlsConfig: &v1.VirtualMeshSpec_MTLSConfig{
+					TrustModel: &v1.VirtualMeshSpec_MTLSConfig_Limited{
+						Limited: &v1.VirtualMeshSpec_MTLSConfig_LimitedTrust{},
+					},
+				},
+				Federation: &v1.VirtualMeshSpec_Federation{
+					HostnameSuffix: "soloio",
+				},
+			},
+		}
+
+		// a Destination in another mesh which is federated to this mesh
+		remoteDestination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "config-namespace",
+				Name:      "reviews",
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedFederation: &discoveryv1.DestinationStatus_AppliedFederation{
+					FederatedHostname: "reviews.bookinfo.svc.remote-cluster.soloio",
+					FederatedToMeshes: []*skv2corev1.ObjectRef{ezkube.MakeObjectRef(mesh)},
+					VirtualMeshRef:    vMesh.Ref,
+				},
+			},
+		}
+
+		in := input.NewInputLocalSnapshotManualBuilder("ignored").
+			AddMeshes(discoveryv1.MeshSlice{mesh}).
+			AddDestinations(discoveryv1.DestinationSlice{destination, remoteDestination}).
+			Build()
+
+		outputs := istio.NewBuilder(context.TODO(), "")
+		NewTranslator(ctx).Translate(
+			in,
+			mesh,
+			vMesh,
+			outputs,
+			nil, // no reports expected
+		)
+
+		expectedGateway := &networkingv1alpha3.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "istio-ingressgateway-istio-system",
+				Namespace:   "namespace",
+				ClusterName: "cluster",
+				Labels:      metautils.TranslatedObjectLabels(),
+				Annotations: map[string]string{
+					metautils.ParentLabelkey: `{"networking.mesh.gloo.solo.io/v1, Kind=VirtualMesh":[{"name":"my-virtual-mesh","namespace":"config-namespace"}]}`,
+				},
+			},
+			Spec: networkingv1alpha3spec.Gateway{
+				Servers: []*networkingv1alpha3spec.Server{
+					{
+						Port: &networkingv1alpha3spec.Port{
+							Number:   91234,
+							Protocol: "TLS",
+							Name:     "tls",
+						},
+						Hosts: []string{
+							"*.soloio",
+						},
+						Tls: &networkingv1alpha3spec.ServerTLSSettings{
+							Mode:           networkingv1alpha3spec.ServerTLSSettings_MUTUAL,
+							CredentialName: mtls.LimitedTrustCredentialSecretName,
+						},
+					},
+					{
+						Port: &networkingv1alpha3spec.Port{
+							Number:   91234,
+							Protocol: "TLS",
+							Name:     "tls-egress",
+						},
+						Hosts: []string{
+							"reviews.bookinfo.svc.remote-cluster.soloio",
+						},
+						Tls: &networkingv1alpha3spec.ServerTLSSettings{
+							Mode: networkingv1alpha3spec.ServerTLSSettings_ISTIO_MUTUAL,
+						},
+					},
+				},
+				Selector: map[string]string{"gatewaylabels": "righthere"},
+			},
+		}
+
+		Expect(outputs.GetGateways().List()).To(ConsistOf(expectedGateway))
+	})
 })
@@ -11,6 +11,7 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/access"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/egress"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/federation"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
@@ -37,6 +38,7 @@ type translator struct {
 	mtlsTranslator       mtls.Translator
 	federationTranslator federation.Translator
 	accessTranslator     access.Translator
+	egressTranslator     egress.Translator
 }
 
 func NewTranslator(
@@ -44,12 +46,14 @@ func NewTranslator(
 	mtlsTranslator mtls.Translator,
 	federationTranslator federation.Translator,
 	accessTranslator access.Translator,
+	egressTranslator egress.Translator,
 ) Translator {
 	return &translator{
 		ctx:                  ctx,
 		mtlsTranslator:       mtlsTranslator,
 		federationTranslator: federationTranslator,
 		accessTranslator:     accessTranslator,
+		egressTranslator:     egressTranslator,
 	}
 }
 
@@ -72,8 +76,11 @@ func (t *translator) Translate(
 
 	appliedVirtualMesh := mesh.Status.AppliedVirtualMesh
 	if appliedVirtualMesh != nil {
-		t.mtlsTranslator.Translate(mesh, appliedVirtualMesh, istioOutputs, localOutputs, reporter)
+		t.mtlsTranslator.Translate(in, mesh, appliedVirtualMesh, istioOutputs, localOutputs, reporter)
 		t.federationTranslator.Translate(in, mesh, appliedVirtualMesh, istioOutputs, reporter)
 		t.accessTranslator.Translate(mesh, appliedVirtualMesh, istioOutputs)
 	}
+
+	// egress gateways are configured independently of VirtualMeshes
+	t.egressTranslator.Translate(in, mesh, istioOutputs)
 }
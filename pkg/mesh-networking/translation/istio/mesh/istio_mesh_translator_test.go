@@ -14,6 +14,7 @@ import (
 	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh"
 	mock_access "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/access/mocks"
+	mock_egress "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/egress/mocks"
 	mock_federation "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/federation/mocks"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -25,6 +26,7 @@ var _ = Describe("IstioMeshTranslator", func() {
 		mockMtlsTranslator       *mock_mtls.MockTranslator
 		mockFederationTranslator *mock_federation.MockTranslator
 		mockAccessTranslator     *mock_access.MockTranslator
+		mockEgressTranslator     *mock_egress.MockTranslator
 		mockReporter             *mock_reporting.MockReporter
 		in                       input.LocalSnapshot
 		istioMeshTranslator      mesh.Translator
@@ -36,9 +38,10 @@ var _ = Describe("IstioMeshTranslator", func() {
 		mockMtlsTranslator = mock_mtls.NewMockTranslator(ctrl)
 		mockFederationTranslator = mock_federation.NewMockTranslator(ctrl)
 		mockAccessTranslator = mock_access.NewMockTranslator(ctrl)
+		mockEgressTranslator = mock_egress.NewMockTranslator(ctrl)
 		mockReporter = mock_reporting.NewMockReporter(ctrl)
 		in = input.NewInputLocalSnapshotManualBuilder("").Build()
-		istioMeshTranslator = mesh.NewTranslator(ctx, mockMtlsTranslator, mockFederationTranslator, mockAccessTranslator)
+		istioMeshTranslator = mesh.NewTranslator(ctx, mockMtlsTranslator, mockFederationTranslator, mockAccessTranslator, mockEgressTranslator)
 	})
 
 	AfterEach(func() {
@@ -71,7 +74,7 @@ var _ = Describe("IstioMeshTranslator", func() {
 
 		mockMtlsTranslator.
 			EXPECT().
-			Translate(istioMesh, istioMesh.Status.AppliedVirtualMesh, outputs, localOutputs, mockReporter)
+			Translate(in, istioMesh, istioMesh.Status.AppliedVirtualMesh, outputs, localOutputs, mockReporter)
 
 		mockFederationTranslator.
 			EXPECT().
@@ -81,6 +84,10 @@ var _ = Describe("IstioMeshTranslator", func() {
 			EXPECT().
 			Translate(istioMesh, istioMesh.Status.AppliedVirtualMesh, outputs)
 
+		mockEgressTranslator.
+			EXPECT().
+			Translate(in, istioMesh, outputs)
+
 		istioMeshTranslator.Translate(in, istioMesh, outputs, localOutputs, mockReporter)
 	})
 })
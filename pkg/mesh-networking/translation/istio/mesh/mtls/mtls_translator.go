@@ -11,13 +11,16 @@ import (
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/local"
 	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/keys"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/rotation"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
-	"github.com/solo-io/gloo-mesh/pkg/common/version"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
@@ -44,6 +47,10 @@ const (
 	// not imported due to issues with dependeny imports
 	istioCaConfigMapName = "istio-ca-root-cert"
 
+	// name of the secret containing the credentials used by the east-west gateways of a mesh using limited trust
+	// exported for use by the federation translators
+	LimitedTrustCredentialSecretName = "gloo-mesh-limited-trust"
+
 	defaultRootCertTTLDays                = 365
 	defaultRootCertRsaKeySize             = 4096
 	defaultOrgName                        = "gloo-mesh"
@@ -79,6 +86,7 @@ type Translator interface {
 	// Output resources will be added to the istio.Builder
 	// Errors caused by invalid user config will be reported using the Reporter.
 	Translate(
+		in input.LocalSnapshot,
 		mesh *discoveryv1.Mesh,
 		virtualMesh *discoveryv1.MeshStatus_AppliedVirtualMesh,
 		istioOutputs istio.Builder,
@@ -107,6 +115,7 @@ func NewTranslator(
 
 // translate the appropriate resources for the given Mesh.
 func (t *translator) Translate(
+	in input.LocalSnapshot,
 	mesh *discoveryv1.Mesh,
 	virtualMesh *discoveryv1.MeshStatus_AppliedVirtualMesh,
 	istioOutputs istio.Builder,
@@ -119,7 +128,14 @@ func (t *translator) Translate(
 		return
 	}
 
-	if err := t.updateMtlsOutputs(mesh, virtualMesh, istioOutputs, localOutputs); err != nil {
+	// the furthest state of a root CA rotation which the certificates of the VirtualMesh may proceed to,
+	// which is reported on the VirtualMesh once every Mesh has completed the prior state
+	var rootRotationState certificatesv1.IssuedCertificateStatus_Rotation_State
+	if vm, err := in.VirtualMeshes().Find(virtualMesh.Ref); err == nil {
+		rootRotationState = vm.Status.GetRootRotationState()
+	}
+
+	if err := t.updateMtlsOutputs(mesh, virtualMesh, rootRotationState, istioOutputs, localOutputs); err != nil {
 		reporter.ReportVirtualMeshToMesh(mesh, virtualMesh.Ref, err)
 	}
 }
@@ -127,6 +143,7 @@ func (t *translator) Translate(
 func (t *translator) updateMtlsOutputs(
 	mesh *discoveryv1.Mesh,
 	virtualMesh *discoveryv1.MeshStatus_AppliedVirtualMesh,
+	rootRotationState certificatesv1.IssuedCertificateStatus_Rotation_State,
 	istioOutputs istio.Builder,
 	localOutputs local.Builder,
 ) error {
@@ -147,12 +164,21 @@ func (t *translator) updateMtlsOutputs(
 			mesh,
 			trustModel.Shared,
 			virtualMesh.Ref,
+			rootRotationState,
 			istioOutputs,
 			localOutputs,
 			mtlsConfig.AutoRestartPods,
 		)
 	case *networkingv1.VirtualMeshSpec_MTLSConfig_Limited:
-		return eris.Errorf("limited trust not supported in version %v of Gloo Mesh", version.Version)
+		return t.configureLimitedTrust(
+			mesh,
+			trustModel.Limited,
+			virtualMesh,
+			rootRotationState,
+			istioOutputs,
+			localOutputs,
+			mtlsConfig.AutoRestartPods,
+		)
 	}
 
 	return nil
@@ -164,6 +190,7 @@ func (t *translator) configureSharedTrust(
 	mesh *discoveryv1.Mesh,
 	sharedTrust *networkingv1.SharedTrust,
 	virtualMeshRef *skv2corev1.ObjectRef,
+	rootRotationState certificatesv1.IssuedCertificateStatus_Rotation_State,
 	istioOutputs istio.Builder,
 	localOutputs local.Builder,
 	autoRestartPods bool,
@@ -178,7 +205,7 @@ func (t *translator) configureSharedTrust(
 	// Construct the skeleton of the issuedCertificate
 	issuedCertificate, podBounceDirective := t.constructIssuedCertificate(
 		mesh,
-		sharedTrust,
+		sharedTrust.GetIntermediateCertOptions(),
 		agentInfo.AgentNamespace,
 		autoRestartPods,
 	)
@@ -192,10 +219,14 @@ func (t *translator) configureSharedTrust(
 	case *networkingv1.SharedTrust_RootCertificateAuthority:
 		switch typedCaSource := typedCa.RootCertificateAuthority.GetCaSource().(type) {
 		case *networkingv1.RootCertificateAuthority_Generated:
+			if err := validateKeyAlgorithms(typedCaSource.Generated, sharedTrust.GetIntermediateCertOptions()); err != nil {
+				return err
+			}
 			// Generated CA cert secret.
 			// Check if it exists
 			rootCaSecret, err := t.getOrCreateGeneratedCaSecret(
 				typedCaSource.Generated,
+				virtualMeshRef.Name+"."+virtualMeshRef.Namespace,
 				virtualMeshRef,
 				localOutputs,
 			)
@@ -228,6 +259,8 @@ func (t *translator) configureSharedTrust(
 		return eris.Errorf("No ca source specified for Virtual Mesh (%s)", sets.Key(virtualMeshRef))
 	}
 
+	rotation.SetAllowedRootRotationState(issuedCertificate, rootRotationState)
+
 	// Append the VirtualMesh as a parent to each output resource
 	metautils.AppendParent(t.ctx, issuedCertificate, virtualMeshRef, networkingv1.VirtualMesh{}.GVK())
 	metautils.AppendParent(t.ctx, podBounceDirective, virtualMeshRef, networkingv1.VirtualMesh{}.GVK())
@@ -237,10 +270,111 @@ func (t *translator) configureSharedTrust(
 	return nil
 }
 
+// each Mesh is issued a certificate signed by its own generated root,
+// and receives the roots of all other Meshes in the VirtualMesh as a trust bundle for its east-west gateways
+func (t *translator) configureLimitedTrust(
+	mesh *discoveryv1.Mesh,
+	limitedTrust *networkingv1.VirtualMeshSpec_MTLSConfig_LimitedTrust,
+	virtualMesh *discoveryv1.MeshStatus_AppliedVirtualMesh,
+	rootRotationState certificatesv1.IssuedCertificateStatus_Rotation_State,
+	istioOutputs istio.Builder,
+	localOutputs local.Builder,
+	autoRestartPods bool,
+) error {
+
+	agentInfo := mesh.Spec.AgentInfo
+	if agentInfo == nil {
+		contextutils.LoggerFrom(t.ctx).Debugf("cannot configure root certificates for mesh %v which has no cert-agent", sets.Key(mesh))
+		return nil
+	}
+
+	virtualMeshRef := virtualMesh.Ref
+
+	// Construct the skeleton of the issuedCertificate
+	issuedCertificate, podBounceDirective := t.constructIssuedCertificate(
+		mesh,
+		limitedTrust.GetIntermediateCertOptions(),
+		agentInfo.AgentNamespace,
+		autoRestartPods,
+	)
+
+	if err := validateKeyAlgorithms(limitedTrust.GetRootCertOptions(), limitedTrust.GetIntermediateCertOptions()); err != nil {
+		return err
+	}
+
+	rootCaSecret, err := t.getOrCreateGeneratedCaSecret(
+		limitedTrust.GetRootCertOptions(),
+		limitedTrustRootCaSecretName(virtualMeshRef, mesh),
+		virtualMeshRef,
+		localOutputs,
+	)
+	if err != nil {
+		return err
+	}
+	issuedCertificate.Spec.CertificateAuthority = &certificatesv1.IssuedCertificateSpec_GlooMeshCa{
+		GlooMeshCa: &certificatesv1.RootCertificateAuthority{
+			CertificateAuthority: &certificatesv1.RootCertificateAuthority_SigningCertificateSecret{
+				SigningCertificateSecret: rootCaSecret,
+			},
+		},
+	}
+	// Set deprecated field for backwards compatibility
+	issuedCertificate.Spec.SigningCertificateSecret = rootCaSecret
+
+	issuedCertificate.Spec.TrustBundle = &certificatesv1.IssuedCertificateSpec_TrustBundle{
+		Secret: &skv2corev1.ObjectRef{
+			Name:      LimitedTrustCredentialSecretName,
+			Namespace: getIstioNamespace(mesh.Spec.GetIstio()),
+		},
+		RootCertificates: t.getPeerRootCertificates(mesh, virtualMesh),
+		// the gateways serve the federated hostnames of the Destinations in the VirtualMesh
+		Hosts: []string{"*." + hostutils.GetFederatedHostnameSuffix(virtualMesh.Spec)},
+	}
+
+	rotation.SetAllowedRootRotationState(issuedCertificate, rootRotationState)
+
+	// Append the VirtualMesh as a parent to each output resource
+	metautils.AppendParent(t.ctx, issuedCertificate, virtualMeshRef, networkingv1.VirtualMesh{}.GVK())
+	metautils.AppendParent(t.ctx, podBounceDirective, virtualMeshRef, networkingv1.VirtualMesh{}.GVK())
+
+	istioOutputs.AddIssuedCertificates(issuedCertificate)
+	istioOutputs.AddPodBounceDirectives(podBounceDirective)
+	return nil
+}
+
+// returns the PEM-encoded roots of all other Meshes in the VirtualMesh.
+// roots which have not yet been generated will be added once they are present in the input snapshot.
+func (t *translator) getPeerRootCertificates(
+	mesh *discoveryv1.Mesh,
+	virtualMesh *discoveryv1.MeshStatus_AppliedVirtualMesh,
+) []string {
+	var rootCerts []string
+	for _, peerMeshRef := range virtualMesh.Spec.GetMeshes() {
+		if ezkube.RefsMatch(peerMeshRef, mesh) {
+			continue
+		}
+		peerRootCaSecret, err := t.secrets.Find(&skv2corev1.ObjectRef{
+			Name:      limitedTrustRootCaSecretName(virtualMesh.Ref, peerMeshRef),
+			Namespace: defaults.GetPodNamespace(),
+		})
+		if err != nil {
+			contextutils.LoggerFrom(t.ctx).Debugf("root certificate for mesh %v has not been generated yet, omitting from trust bundle of mesh %v", sets.Key(peerMeshRef), sets.Key(mesh))
+			continue
+		}
+		rootCerts = append(rootCerts, string(secrets.RootCADataFromSecretData(peerRootCaSecret.Data).RootCert))
+	}
+	return rootCerts
+}
+
+func limitedTrustRootCaSecretName(virtualMeshRef *skv2corev1.ObjectRef, mesh ezkube.ResourceId) string {
+	return virtualMeshRef.Name + "." + virtualMeshRef.Namespace + "." + mesh.GetName()
+}
+
 // will create the secret if it is self-signed,
 // otherwise will return the user-provided secret ref in the mtls config
 func (t *translator) getOrCreateGeneratedCaSecret(
 	generatedRootCa *certificatesv1.CommonCertOptions,
+	generatedSecretName string,
 	virtualMeshRef *skv2corev1.ObjectRef,
 	localOutputs local.Builder,
 ) (*skv2corev1.ObjectRef, error) {
@@ -249,7 +383,6 @@ func (t *translator) getOrCreateGeneratedCaSecret(
 		generatedRootCa = defaultSelfSignedRootCa.GetGenerated()
 	}
 
-	generatedSecretName := virtualMeshRef.Name + "." + virtualMeshRef.Namespace
 	// write the signing secret to the gloomesh namespace
 	generatedSecretNamespace := defaults.GetPodNamespace()
 	// use the existing secret if it exists
@@ -289,7 +422,7 @@ func (t *translator) getOrCreateGeneratedCaSecret(
 
 func (t *translator) constructIssuedCertificate(
 	mesh *discoveryv1.Mesh,
-	sharedTrust *networkingv1.SharedTrust,
+	intermediateCertOptions *certificatesv1.CommonCertOptions,
 	agentNamespace string,
 	autoRestartPods bool,
 ) (*certificatesv1.IssuedCertificate, *certificatesv1.PodBounceDirective) {
@@ -303,10 +436,7 @@ func (t *translator) constructIssuedCertificate(
 	if istiodServiceAccount == "" {
 		istiodServiceAccount = defaultCitadelServiceAccount
 	}
-	istioNamespace := istioMesh.GetInstallation().GetNamespace()
-	if istioNamespace == "" {
-		istioNamespace = defaultIstioNamespace
-	}
+	istioNamespace := getIstioNamespace(istioMesh)
 
 	clusterName := istioMesh.GetInstallation().GetCluster()
 	issuedCertificateMeta := metav1.ObjectMeta{
@@ -319,7 +449,7 @@ func (t *translator) constructIssuedCertificate(
 	}
 
 	// get the pods that need to be bounced for this mesh
-	podsToBounce := getPodsToBounce(mesh, sharedTrust, t.workloads, autoRestartPods)
+	podsToBounce := getPodsToBounce(mesh, t.workloads, autoRestartPods)
 	var (
 		podBounceDirective *certificatesv1.PodBounceDirective
 		podBounceRef       *skv2corev1.ObjectRef
@@ -339,7 +469,7 @@ func (t *translator) constructIssuedCertificate(
 		Spec: certificatesv1.IssuedCertificateSpec{
 			Hosts: []string{buildSpiffeURI(trustDomain, istioNamespace, istiodServiceAccount)},
 			CertOptions: buildDefaultCertOptions(
-				sharedTrust.GetIntermediateCertOptions(),
+				intermediateCertOptions,
 				defaultIstioOrg,
 			),
 			// Set deprecated field for backwards compatibility
@@ -348,20 +478,25 @@ func (t *translator) constructIssuedCertificate(
 		},
 	}
 
-	// Only set issuedCert when not using vault CA
-	if sharedTrust.GetIntermediateCertificateAuthority().GetVault() == nil {
-		// the default location of the istio CA Certs secret
-		// the certificate workflow will produce a cert with this ref
-		issuedCert.Spec.IssuedCertificateSecret = &skv2corev1.ObjectRef{
-			Name:      istioCaSecretName,
-			Namespace: istioNamespace,
-		}
+	// the default location of the istio CA Certs secret
+	// the certificate workflow will produce a cert with this ref
+	issuedCert.Spec.IssuedCertificateSecret = &skv2corev1.ObjectRef{
+		Name:      istioCaSecretName,
+		Namespace: istioNamespace,
 	}
 
 	// issue a certificate to the mesh agent
 	return issuedCert, podBounceDirective
 }
 
+func getIstioNamespace(istioMesh *discoveryv1.MeshSpec_Istio) string {
+	istioNamespace := istioMesh.GetInstallation().GetNamespace()
+	if istioNamespace == "" {
+		istioNamespace = defaultIstioNamespace
+	}
+	return istioNamespace
+}
+
 func buildDefaultCertOptions(
 	options *certificatesv1.CommonCertOptions,
 	orgName string,
@@ -385,19 +520,32 @@ func buildDefaultCertOptions(
 	return result
 }
 
+// the intermediate certificates are signed by the generated root, so both must use the same key algorithm
+func validateKeyAlgorithms(rootCertOptions, intermediateCertOptions *certificatesv1.CommonCertOptions) error {
+	rootAlgorithm, intermediateAlgorithm := rootCertOptions.GetKeyAlgorithm(), intermediateCertOptions.GetKeyAlgorithm()
+	if rootAlgorithm != intermediateAlgorithm {
+		return eris.Errorf("the intermediate cert key algorithm %v does not match the root cert key algorithm %v, "+
+			"certificate chains mixing key algorithms are not supported", intermediateAlgorithm, rootAlgorithm)
+	}
+	return nil
+}
+
 func generateSelfSignedCert(
 	builtinCA *certificatesv1.CommonCertOptions,
 ) (*secrets.RootCAData, error) {
 	certOptions := buildDefaultCertOptions(builtinCA, defaultOrgName)
+	key, err := keys.GeneratePrivateKey(certOptions.GetKeyAlgorithm(), int(certOptions.GetRsaKeySizeBytes()))
+	if err != nil {
+		return nil, err
+	}
 	options := util.CertOptions{
-		Org:          certOptions.GetOrgName(),
-		IsCA:         true,
-		IsSelfSigned: true,
-		TTL:          time.Duration(certOptions.GetTtlDays()) * 24 * time.Hour,
-		RSAKeySize:   int(certOptions.GetRsaKeySizeBytes()),
-		PKCS8Key:     false, // currently only supporting PKCS1
-	}
-	cert, key, err := util.GenCertKeyFromOptions(options)
+		Org:           certOptions.GetOrgName(),
+		IsCA:          true,
+		IsSelfSigned:  true,
+		TTL:           time.Duration(certOptions.GetTtlDays()) * 24 * time.Hour,
+		SignerPrivPem: key,
+	}
+	cert, _, err := util.GenRootCertFromExistingKey(options)
 	if err != nil {
 		return nil, err
 	}
@@ -415,7 +563,6 @@ func buildSpiffeURI(trustDomain, namespace, serviceAccount string) string {
 // get selectors for all the pods in a mesh; they need to be bounced (including the mesh control plane itself)
 func getPodsToBounce(
 	mesh *discoveryv1.Mesh,
-	sharedTrust *networkingv1.SharedTrust,
 	allWorkloads discoveryv1sets.WorkloadSet,
 	autoRestartPods bool,
 ) []*certificatesv1.PodBounceDirectiveSpec_PodSelector {
@@ -429,16 +576,12 @@ func getPodsToBounce(
 	// bounce the control plane pod first
 	// order matters
 	var podsToBounce []*certificatesv1.PodBounceDirectiveSpec_PodSelector
-	// If the pki-sidecar is fulfilling the issued certificate request,
-	// then the control-plane should not be bounced.
-	if sharedTrust.GetIntermediateCertificateAuthority().GetVault() == nil {
-		podsToBounce = append(podsToBounce, &certificatesv1.PodBounceDirectiveSpec_PodSelector{
-			Namespace: istioInstall.Namespace,
-			Labels:    istioInstall.PodLabels,
-			// ensure at least one replica of istiod is ready before restarting the other pods
-			WaitForReplicas: 1,
-		})
-	}
+	podsToBounce = append(podsToBounce, &certificatesv1.PodBounceDirectiveSpec_PodSelector{
+		Namespace: istioInstall.Namespace,
+		Labels:    istioInstall.PodLabels,
+		// ensure at least one replica of istiod is ready before restarting the other pods
+		WaitForReplicas: 1,
+	})
 
 	// bounce the ingress gateway pods
 	for _, gateway := range istioMesh.IngressGateways {
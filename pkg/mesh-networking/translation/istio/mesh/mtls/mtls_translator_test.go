@@ -2,6 +2,7 @@ package mtls_test
 
 import (
 	"context"
+	"crypto/x509"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
@@ -10,15 +11,18 @@ import (
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	mock_istio "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio/mocks"
 	mock_local "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/local/mocks"
 	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/rotation"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
 	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/mtls"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"github.com/solo-io/skv2/pkg/ezkube"
+	util "istio.io/istio/security/pkg/pki/util"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -31,6 +35,7 @@ var _ = Describe("MtlsTranslator", func() {
 		mockIstioBuilder *mock_istio.MockBuilder
 		mockLocalBuilder *mock_local.MockBuilder
 		mockReporter     *mock_reporting.MockReporter
+		in               input.LocalSnapshot
 
 		istioMesh         *discoveryv1.Mesh
 		childResourceMeta *metav1.ObjectMeta
@@ -41,6 +46,7 @@ var _ = Describe("MtlsTranslator", func() {
 		mockIstioBuilder = mock_istio.NewMockBuilder(ctrl)
 		mockLocalBuilder = mock_local.NewMockBuilder(ctrl)
 		mockReporter = mock_reporting.NewMockReporter(ctrl)
+		in = input.NewInputLocalSnapshotManualBuilder("").Build()
 
 		istioMesh = &discoveryv1.Mesh{
 			ObjectMeta: metav1.ObjectMeta{
@@ -80,7 +86,7 @@ var _ = Describe("MtlsTranslator", func() {
 		translator := mtls.NewTranslator(ctx, nil, nil)
 		mesh := &discoveryv1.Mesh{}
 		vm := &discoveryv1.MeshStatus_AppliedVirtualMesh{}
-		translator.Translate(mesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
+		translator.Translate(in, mesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
 	})
 
 	It("generated root CA", func() {
@@ -151,6 +157,7 @@ var _ = Describe("MtlsTranslator", func() {
 						},
 					},
 				}
+				rotation.SetAllowedRootRotationState(cert, certificatesv1.IssuedCertificateStatus_Rotation_NOT_ROTATING)
 				metautils.AppendParent(ctx, cert, vm.GetRef(), networkingv1.VirtualMesh{}.GVK())
 				Expect(cert).To(Equal(issuedCert))
 			})
@@ -159,7 +166,70 @@ var _ = Describe("MtlsTranslator", func() {
 
 		translator := mtls.NewTranslator(ctx, v1sets.NewSecretSet(), nil)
 
-		translator.Translate(istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
+		translator.Translate(in, istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
+	})
+
+	Context("key algorithm", func() {
+		var vm *discoveryv1.MeshStatus_AppliedVirtualMesh
+
+		BeforeEach(func() {
+			vm = &discoveryv1.MeshStatus_AppliedVirtualMesh{
+				Ref: &skv2corev1.ObjectRef{
+					Name:      "my-vm",
+					Namespace: "gloo-mesh",
+				},
+				Spec: &networkingv1.VirtualMeshSpec{
+					MtlsConfig: &networkingv1.VirtualMeshSpec_MTLSConfig{
+						TrustModel: &networkingv1.VirtualMeshSpec_MTLSConfig_Shared{
+							Shared: &networkingv1.SharedTrust{
+								CertificateAuthority: &networkingv1.SharedTrust_RootCertificateAuthority{
+									RootCertificateAuthority: &networkingv1.RootCertificateAuthority{
+										CaSource: &networkingv1.RootCertificateAuthority_Generated{
+											Generated: &certificatesv1.CommonCertOptions{
+												KeyAlgorithm: certificatesv1.CommonCertOptions_ECDSA_P256,
+											},
+										},
+									},
+								},
+								IntermediateCertOptions: &certificatesv1.CommonCertOptions{
+									KeyAlgorithm: certificatesv1.CommonCertOptions_ECDSA_P256,
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("generates a root CA with the key algorithm of the cert options", func() {
+			mockLocalBuilder.EXPECT().AddSecrets(gomock.Any()).Do(func(secret *corev1.Secret) {
+				rootCert, err := util.ParsePemEncodedCertificate(secrets.RootCADataFromSecretData(secret.Data).RootCert)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rootCert.PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+			})
+			mockIstioBuilder.EXPECT().
+				AddIssuedCertificates(gomock.Any()).
+				Do(func(issuedCert *certificatesv1.IssuedCertificate) {
+					Expect(issuedCert.Spec.CertOptions.KeyAlgorithm).To(Equal(certificatesv1.CommonCertOptions_ECDSA_P256))
+				})
+			mockIstioBuilder.EXPECT().AddPodBounceDirectives(nil)
+
+			translator := mtls.NewTranslator(ctx, v1sets.NewSecretSet(), nil)
+			translator.Translate(in, istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
+		})
+
+		It("reports an error if the root and intermediate key algorithms differ", func() {
+			vm.Spec.GetMtlsConfig().GetShared().IntermediateCertOptions = nil
+
+			mockReporter.EXPECT().
+				ReportVirtualMeshToMesh(istioMesh, vm.Ref, gomock.Any()).
+				Do(func(_ *discoveryv1.Mesh, _ *skv2corev1.ObjectRef, err error) {
+					Expect(err).To(MatchError(ContainSubstring("the intermediate cert key algorithm RSA does not match the root cert key algorithm ECDSA_P256")))
+				})
+
+			translator := mtls.NewTranslator(ctx, v1sets.NewSecretSet(), nil)
+			translator.Translate(in, istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
+		})
 	})
 
 	It("provided root CA", func() {
@@ -278,6 +348,7 @@ var _ = Describe("MtlsTranslator", func() {
 						},
 					},
 				}
+				rotation.SetAllowedRootRotationState(cert, certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE)
 				metautils.AppendParent(ctx, cert, vm.GetRef(), networkingv1.VirtualMesh{}.GVK())
 				Expect(cert).To(Equal(issuedCert))
 			})
@@ -288,13 +359,26 @@ var _ = Describe("MtlsTranslator", func() {
 				Expect(pbd).To(Equal(podBounceDirective))
 			})
 
+		// the VirtualMesh reports the state of the root CA rotation which its Meshes may proceed to
+		in = input.NewInputLocalSnapshotManualBuilder("").
+			AddVirtualMeshes([]*networkingv1.VirtualMesh{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      vm.GetRef().GetName(),
+					Namespace: vm.GetRef().GetNamespace(),
+				},
+				Status: networkingv1.VirtualMeshStatus{
+					RootRotationState: certificatesv1.IssuedCertificateStatus_Rotation_PROPAGATING_CERTIFICATE,
+				},
+			}}).
+			Build()
+
 		translator := mtls.NewTranslator(
 			ctx,
 			v1sets.NewSecretSet(generatedSecret),
 			discoveryv1sets.NewWorkloadSet(),
 		)
 
-		translator.Translate(istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
+		translator.Translate(in, istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
 	})
 
 	It("Intermediate CA", func() {
@@ -357,6 +441,11 @@ var _ = Describe("MtlsTranslator", func() {
 			},
 			Spec: certificatesv1.PodBounceDirectiveSpec{
 				PodsToBounce: []*certificatesv1.PodBounceDirectiveSpec_PodSelector{
+					{
+						Namespace:       istioMesh.Spec.GetIstio().Installation.GetNamespace(),
+						Labels:          istioMesh.Spec.GetIstio().Installation.GetPodLabels(),
+						WaitForReplicas: 1,
+					},
 					{
 						Namespace: kubeWorkload.Spec.GetKubernetes().GetController().GetNamespace(),
 						Labels:    kubeWorkload.Spec.GetKubernetes().GetPodLabels(),
@@ -396,8 +485,13 @@ var _ = Describe("MtlsTranslator", func() {
 							AgentCa: intermediateCa,
 						},
 						PodBounceDirective: ezkube.MakeObjectRef(pbd),
+						IssuedCertificateSecret: &skv2corev1.ObjectRef{
+							Name:      "cacerts",
+							Namespace: istioMesh.Spec.GetIstio().GetInstallation().GetNamespace(),
+						},
 					},
 				}
+				rotation.SetAllowedRootRotationState(cert, certificatesv1.IssuedCertificateStatus_Rotation_NOT_ROTATING)
 				metautils.AppendParent(ctx, cert, vm.GetRef(), networkingv1.VirtualMesh{}.GVK())
 				Expect(cert).To(Equal(issuedCert))
 			})
@@ -410,7 +504,105 @@ var _ = Describe("MtlsTranslator", func() {
 
 		translator := mtls.NewTranslator(ctx, nil, discoveryv1sets.NewWorkloadSet(kubeWorkload))
 
-		translator.Translate(istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
+		translator.Translate(in, istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
+	})
+
+	It("limited trust", func() {
+		vm := &discoveryv1.MeshStatus_AppliedVirtualMesh{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "my-vm",
+				Namespace: "gloo-mesh",
+			},
+			Spec: &networkingv1.VirtualMeshSpec{
+				Meshes: []*skv2corev1.ObjectRef{
+					ezkube.MakeObjectRef(istioMesh),
+					{Name: "peer-mesh", Namespace: "gloo-mesh"},
+					{Name: "pending-mesh", Namespace: "gloo-mesh"},
+				},
+				MtlsConfig: &networkingv1.VirtualMeshSpec_MTLSConfig{
+					TrustModel: &networkingv1.VirtualMeshSpec_MTLSConfig_Limited{
+						Limited: &networkingv1.VirtualMeshSpec_MTLSConfig_LimitedTrust{
+							IntermediateCertOptions: &certificatesv1.CommonCertOptions{
+								TtlDays: 30,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		rootCaSecretName := vm.GetRef().GetName() + "." + vm.GetRef().GetNamespace() + "." + istioMesh.GetName()
+
+		// the root of the peer mesh has already been generated, the root of the pending mesh has not
+		peerRootCaData := secrets.RootCAData{
+			PrivateKey: []byte("peer-private-key"),
+			RootCert:   []byte("peer-root-cert"),
+		}
+		peerRootCaSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vm.GetRef().GetName() + "." + vm.GetRef().GetNamespace() + ".peer-mesh",
+				Namespace: "gloo-mesh",
+			},
+			Data: peerRootCaData.ToSecretData(),
+		}
+
+		mockLocalBuilder.EXPECT().AddSecrets(gomock.Any()).Do(func(secret *corev1.Secret) {
+			Expect(secret.GetName()).To(Equal(rootCaSecretName))
+			Expect(mtls.IsSigningCert(secret)).To(BeTrue())
+		})
+
+		mockIstioBuilder.EXPECT().
+			AddIssuedCertificates(gomock.Any()).
+			Do(func(issuedCert *certificatesv1.IssuedCertificate) {
+				cert := &certificatesv1.IssuedCertificate{
+					ObjectMeta: *childResourceMeta,
+					Spec: certificatesv1.IssuedCertificateSpec{
+						Hosts: []string{"spiffe://cluster.not-local/ns/istio-system-2/sa/istiod-not-standard"},
+						Org:   "Istio",
+						CertOptions: &certificatesv1.CommonCertOptions{
+							TtlDays:                        30,
+							RsaKeySizeBytes:                4096,
+							OrgName:                        "Istio",
+							SecretRotationGracePeriodRatio: 0.10,
+						},
+						SigningCertificateSecret: &skv2corev1.ObjectRef{
+							Name:      rootCaSecretName,
+							Namespace: "gloo-mesh",
+						},
+						CertificateAuthority: &certificatesv1.IssuedCertificateSpec_GlooMeshCa{
+							GlooMeshCa: &certificatesv1.RootCertificateAuthority{
+								CertificateAuthority: &certificatesv1.RootCertificateAuthority_SigningCertificateSecret{
+									SigningCertificateSecret: &skv2corev1.ObjectRef{
+										Name:      rootCaSecretName,
+										Namespace: "gloo-mesh",
+									},
+								},
+							},
+						},
+						IssuedCertificateSecret: &skv2corev1.ObjectRef{
+							Name:      "cacerts",
+							Namespace: istioMesh.Spec.GetIstio().GetInstallation().GetNamespace(),
+						},
+						TrustBundle: &certificatesv1.IssuedCertificateSpec_TrustBundle{
+							Secret: &skv2corev1.ObjectRef{
+								Name:      mtls.LimitedTrustCredentialSecretName,
+								Namespace: istioMesh.Spec.GetIstio().GetInstallation().GetNamespace(),
+							},
+							RootCertificates: []string{"peer-root-cert"},
+							Hosts:            []string{"*.global"},
+						},
+					},
+				}
+				rotation.SetAllowedRootRotationState(cert, certificatesv1.IssuedCertificateStatus_Rotation_NOT_ROTATING)
+				metautils.AppendParent(ctx, cert, vm.GetRef(), networkingv1.VirtualMesh{}.GVK())
+				Expect(cert).To(Equal(issuedCert))
+			})
+
+		mockIstioBuilder.EXPECT().AddPodBounceDirectives(nil)
+
+		translator := mtls.NewTranslator(ctx, v1sets.NewSecretSet(peerRootCaSecret), nil)
+
+		translator.Translate(in, istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
 	})
 
 })
@@ -9,10 +9,14 @@ A decorator must be imported into this file in order to be used by the translato
 import (
 	// TrafficPolicy decorators
 	_ "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/cors"
+	_ "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/csrf"
 	_ "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/faultinjection"
 	_ "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/headermanipulation"
+	_ "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/jwt"
+	_ "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/loadbalancer"
 	_ "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/mirror"
 	_ "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/outlierdetection"
+	_ "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/ratelimit"
 	_ "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/retries"
 	_ "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/timeout"
 	_ "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/tls"
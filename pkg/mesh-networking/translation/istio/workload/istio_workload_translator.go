@@ -0,0 +1,197 @@
+package workload
+
+import (
+	"context"
+	"fmt"
+
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
+	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/destinationutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/selectorutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/settingsutils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	utilsets "k8s.io/apimachinery/pkg/util/sets"
+)
+
+//go:generate mockgen -source ./istio_workload_translator.go -destination mocks/istio_workload_translator.go
+
+const defaultIstioNamespace = "istio-system"
+
+// the Workload translator translates a Workload into a Sidecar which scopes the outbound configuration of its proxies.
+type Translator interface {
+	// Translate translates the appropriate Sidecar for the given Workload.
+	// No Sidecar is output if the Workload has no dependencies, in which case its proxies receive configuration for every service in the mesh.
+	// Output resources will be added to the output.Builder
+	// Errors caused by invalid user config will be reported using the Reporter.
+	Translate(
+		in input.LocalSnapshot,
+		workload *discoveryv1.Workload,
+		outputs istio.Builder,
+		reporter reporting.Reporter,
+	)
+}
+
+type translator struct {
+	ctx            context.Context
+	settings       *settingsv1.Settings
+	clusterDomains hostutils.ClusterDomainRegistry
+}
+
+func NewTranslator(
+	ctx context.Context,
+	clusterDomains hostutils.ClusterDomainRegistry,
+) Translator {
+	return &translator{
+		ctx:            ctx,
+		settings:       settingsutils.SettingsFromContext(ctx),
+		clusterDomains: clusterDomains,
+	}
+}
+
+func (t *translator) Translate(
+	in input.LocalSnapshot,
+	workload *discoveryv1.Workload,
+	outputs istio.Builder,
+	_ reporting.Reporter,
+) {
+	var mode settingsv1.SidecarScopingSettings_Mode
+	if t.settings != nil {
+		mode = t.settings.Spec.GetSidecarScoping().GetMode()
+	}
+	if mode == settingsv1.SidecarScopingSettings_DISABLED {
+		return
+	}
+
+	// the Sidecar selects the pods of the Workload's controller, so only Kubernetes workloads are supported
+	kubeWorkload := workload.Spec.GetKubernetes()
+	if kubeWorkload == nil {
+		return
+	}
+
+	mesh := t.getIstioMesh(workload, in.Meshes())
+	if mesh == nil {
+		return
+	}
+
+	// preserve the default behavior of the mesh for Workloads without dependencies.
+	// policies only extend the dependencies of Workloads which are already scoped, as most policies select every Workload as a source.
+	if workload.Status.GetServiceDependencies() == nil {
+		return
+	}
+
+	// the hostnames of the Destinations the Workload depends on
+	hostnames := utilsets.NewString(workload.Status.GetServiceDependencies().GetDestinationHostnames()...)
+	if mode == settingsv1.SidecarScopingSettings_SERVICE_DEPENDENCIES_AND_POLICIES {
+		hostnames.Insert(t.getPolicyDependencyHostnames(in.Destinations(), workload)...)
+	}
+
+	// the proxies must always receive the configuration of the Istio control plane
+	egressHosts := []string{fmt.Sprintf("%s/*", getIstioNamespace(mesh.Spec.GetIstio()))}
+	for _, hostname := range hostnames.List() {
+		egressHosts = append(egressHosts, fmt.Sprintf("*/%s", hostname))
+	}
+
+	sidecar := &networkingv1alpha3.Sidecar{
+		ObjectMeta: metautils.TranslatedObjectMeta(
+			kubeWorkload.GetController(),
+			workload.Annotations,
+		),
+		Spec: networkingv1alpha3spec.Sidecar{
+			WorkloadSelector: &networkingv1alpha3spec.WorkloadSelector{
+				Labels: kubeWorkload.GetPodLabels(),
+			},
+			Egress: []*networkingv1alpha3spec.IstioEgressListener{
+				{
+					Hosts: egressHosts,
+				},
+			},
+		},
+	}
+	// Append the Workload as a parent to the sidecar
+	metautils.AppendParent(t.ctx, sidecar, workload, workload.GVK())
+
+	outputs.AddSidecars(sidecar)
+}
+
+// return the Istio mesh of the Workload, or nil if the Workload does not belong to an Istio mesh
+func (t *translator) getIstioMesh(
+	workload *discoveryv1.Workload,
+	allMeshes discoveryv1sets.MeshSet,
+) *discoveryv1.Mesh {
+	meshRef := workload.Spec.GetMesh()
+	if meshRef == nil {
+		return nil
+	}
+	mesh, err := allMeshes.Find(meshRef)
+	if err != nil {
+		contextutils.LoggerFrom(t.ctx).Errorf("internal error: could not find mesh %v for workload %v", sets.Key(meshRef), sets.Key(workload))
+		return nil
+	}
+	if mesh.Spec.GetIstio() == nil {
+		return nil
+	}
+	return mesh
+}
+
+// return the hostnames of the Destinations referenced by the TrafficPolicies and AccessPolicies which select the Workload as a source,
+// including the Destinations to which those TrafficPolicies shift or mirror traffic
+func (t *translator) getPolicyDependencyHostnames(
+	destinations discoveryv1sets.DestinationSet,
+	workload *discoveryv1.Workload,
+) []string {
+	workloadCluster := workload.Spec.GetKubernetes().GetController().GetClusterName()
+
+	dependencies := discoveryv1sets.NewDestinationSet()
+	addKubeServiceDependency := func(kubeService ezkube.ClusterResourceId) {
+		if destination, err := destinationutils.FindDestinationForKubeService(destinations.List(), kubeService); err == nil {
+			dependencies.Insert(destination)
+		}
+	}
+
+	for _, destination := range destinations.List() {
+		for _, appliedTrafficPolicy := range destination.Status.GetAppliedTrafficPolicies() {
+			if !selectorutils.SelectorMatchesWorkload(t.ctx, appliedTrafficPolicy.GetSpec().GetSourceSelector(), workload) {
+				continue
+			}
+			dependencies.Insert(destination)
+			policy := appliedTrafficPolicy.GetSpec().GetPolicy()
+			for _, weightedDestination := range policy.GetTrafficShift().GetDestinations() {
+				if kubeService := weightedDestination.GetKubeService(); kubeService != nil {
+					addKubeServiceDependency(kubeService)
+				}
+			}
+			if kubeService := policy.GetMirror().GetKubeService(); kubeService != nil {
+				addKubeServiceDependency(kubeService)
+			}
+		}
+		for _, appliedAccessPolicy := range destination.Status.GetAppliedAccessPolicies() {
+			if selectorutils.IdentityMatchesWorkload(appliedAccessPolicy.GetSpec().GetSourceSelector(), workload) {
+				dependencies.Insert(destination)
+			}
+		}
+	}
+
+	var hostnames []string
+	for _, destination := range dependencies.List() {
+		hostnames = append(hostnames, hostutils.GetDestinationHostnames(t.clusterDomains, workloadCluster, destination)...)
+	}
+	return hostnames
+}
+
+func getIstioNamespace(istioMesh *discoveryv1.MeshSpec_Istio) string {
+	istioNamespace := istioMesh.GetInstallation().GetNamespace()
+	if istioNamespace == "" {
+		istioNamespace = defaultIstioNamespace
+	}
+	return istioNamespace
+}
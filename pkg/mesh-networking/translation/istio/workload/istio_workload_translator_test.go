@@ -0,0 +1,279 @@
+package workload_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/workload"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/settingsutils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	skv1alpha1sets "github.com/solo-io/skv2/pkg/api/multicluster.solo.io/v1alpha1/sets"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("IstioWorkloadTranslator", func() {
+	var (
+		ctx     context.Context
+		outputs istio.Builder
+		mesh    *discoveryv1.Mesh
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		outputs = istio.NewBuilder(ctx, "")
+		mesh = &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "istio",
+				Namespace: "gloo-mesh",
+			},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Istio_{
+					Istio: &discoveryv1.MeshSpec_Istio{
+						Installation: &discoveryv1.MeshInstallation{
+							Namespace: "istio-namespace",
+							Cluster:   "cluster",
+						},
+					},
+				},
+			},
+		}
+	})
+
+	makeWorkload := func(serviceDependencies *discoveryv1.WorkloadStatus_ServiceDependencies) *discoveryv1.Workload {
+		return &discoveryv1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "workload",
+				Namespace: "gloo-mesh",
+			},
+			Spec: discoveryv1.WorkloadSpec{
+				Type: &discoveryv1.WorkloadSpec_Kubernetes{
+					Kubernetes: &discoveryv1.WorkloadSpec_KubernetesWorkload{
+						Controller: &skv2corev1.ClusterObjectRef{
+							Name:        "productpage",
+							Namespace:   "bookinfo",
+							ClusterName: "cluster",
+						},
+						PodLabels:          map[string]string{"app": "productpage"},
+						ServiceAccountName: "productpage",
+					},
+				},
+				Mesh: &skv2corev1.ObjectRef{
+					Name:      mesh.Name,
+					Namespace: mesh.Namespace,
+				},
+			},
+			Status: discoveryv1.WorkloadStatus{
+				ServiceDependencies: serviceDependencies,
+			},
+		}
+	}
+
+	makeDestination := func(name string) *discoveryv1.Destination {
+		return &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "gloo-mesh",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        name,
+							Namespace:   "bookinfo",
+							ClusterName: "cluster",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	translate := func(mode settingsv1.SidecarScopingSettings_Mode, wl *discoveryv1.Workload, destinations ...*discoveryv1.Destination) []*networkingv1alpha3.Sidecar {
+		settings := &settingsv1.Settings{
+			Spec: settingsv1.SettingsSpec{
+				SidecarScoping: &settingsv1.SidecarScopingSettings{
+					Mode: mode,
+				},
+			},
+		}
+		destinationSet := discoveryv1sets.NewDestinationSet(destinations...)
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddMeshes([]*discoveryv1.Mesh{mesh}).
+			AddWorkloads([]*discoveryv1.Workload{wl}).
+			AddDestinations(destinations).
+			Build()
+		clusterDomains := hostutils.NewClusterDomainRegistry(skv1alpha1sets.NewKubernetesClusterSet(), destinationSet)
+
+		translator := workload.NewTranslator(settingsutils.ContextWithSettings(ctx, settings), clusterDomains)
+		translator.Translate(in, wl, outputs, nil)
+
+		return outputs.GetSidecars().List()
+	}
+
+	It("should translate a Sidecar scoped to the Workload's ServiceDependencies", func() {
+		wl := makeWorkload(&discoveryv1.WorkloadStatus_ServiceDependencies{
+			AppliedServiceDependencies: []*discoveryv1.WorkloadStatus_ServiceDependencies_AppliedServiceDependency{
+				{
+					ServiceDependencyRef: &skv2corev1.ObjectRef{
+						Name:      "service-dependency",
+						Namespace: "gloo-mesh",
+					},
+				},
+			},
+			DestinationHostnames: []string{"reviews.bookinfo.svc.cluster.local", "details.bookinfo.svc.cluster.local"},
+		})
+
+		expectedSidecar := &networkingv1alpha3.Sidecar{
+			ObjectMeta: metautils.TranslatedObjectMeta(wl.Spec.GetKubernetes().GetController(), wl.Annotations),
+			Spec: networkingv1alpha3spec.Sidecar{
+				WorkloadSelector: &networkingv1alpha3spec.WorkloadSelector{
+					Labels: map[string]string{"app": "productpage"},
+				},
+				Egress: []*networkingv1alpha3spec.IstioEgressListener{
+					{
+						Hosts: []string{
+							"istio-namespace/*",
+							"*/details.bookinfo.svc.cluster.local",
+							"*/reviews.bookinfo.svc.cluster.local",
+						},
+					},
+				},
+			},
+		}
+		metautils.AppendParent(ctx, expectedSidecar, wl, wl.GVK())
+
+		Expect(translate(settingsv1.SidecarScopingSettings_SERVICE_DEPENDENCIES, wl)).To(ConsistOf(expectedSidecar))
+	})
+
+	It("should not translate a Sidecar for Workloads without dependencies", func() {
+		Expect(translate(settingsv1.SidecarScopingSettings_SERVICE_DEPENDENCIES, makeWorkload(nil))).To(BeEmpty())
+	})
+
+	It("should not translate a Sidecar if sidecar scoping is disabled", func() {
+		wl := makeWorkload(&discoveryv1.WorkloadStatus_ServiceDependencies{
+			DestinationHostnames: []string{"reviews.bookinfo.svc.cluster.local"},
+		})
+		Expect(translate(settingsv1.SidecarScopingSettings_DISABLED, wl)).To(BeEmpty())
+	})
+
+	It("should include the Destinations referenced by policies selecting the Workload", func() {
+		wl := makeWorkload(&discoveryv1.WorkloadStatus_ServiceDependencies{
+			AppliedServiceDependencies: []*discoveryv1.WorkloadStatus_ServiceDependencies_AppliedServiceDependency{
+				{
+					ServiceDependencyRef: &skv2corev1.ObjectRef{
+						Name:      "service-dependency",
+						Namespace: "gloo-mesh",
+					},
+				},
+			},
+		})
+
+		reviews := makeDestination("reviews")
+		reviewsV2 := makeDestination("reviews-v2")
+		ratings := makeDestination("ratings")
+		details := makeDestination("details")
+
+		// a TrafficPolicy selecting the Workload which shifts traffic to another Destination
+		reviews.Status.AppliedTrafficPolicies = []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			{
+				Spec: &networkingv1.TrafficPolicySpec{
+					SourceSelector: []*commonv1.WorkloadSelector{
+						{
+							KubeWorkloadMatcher: &commonv1.WorkloadSelector_KubeWorkloadMatcher{
+								Labels: map[string]string{"app": "productpage"},
+							},
+						},
+					},
+					Policy: &networkingv1.TrafficPolicySpec_Policy{
+						TrafficShift: &networkingv1.TrafficPolicySpec_Policy_MultiDestination{
+							Destinations: []*networkingv1.WeightedDestination{
+								{
+									DestinationType: &networkingv1.WeightedDestination_KubeService{
+										KubeService: &networkingv1.WeightedDestination_KubeDestination{
+											Name:        "reviews-v2",
+											Namespace:   "bookinfo",
+											ClusterName: "cluster",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		// an AccessPolicy selecting the Workload's identity
+		ratings.Status.AppliedAccessPolicies = []*discoveryv1.DestinationStatus_AppliedAccessPolicy{
+			{
+				Spec: &networkingv1.AccessPolicySpec{
+					SourceSelector: []*commonv1.IdentitySelector{
+						{
+							KubeServiceAccountRefs: &commonv1.IdentitySelector_KubeServiceAccountRefs{
+								ServiceAccounts: []*skv2corev1.ClusterObjectRef{
+									{
+										Name:        "productpage",
+										Namespace:   "bookinfo",
+										ClusterName: "cluster",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		// a TrafficPolicy selecting other Workloads
+		details.Status.AppliedTrafficPolicies = []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			{
+				Spec: &networkingv1.TrafficPolicySpec{
+					SourceSelector: []*commonv1.WorkloadSelector{
+						{
+							KubeWorkloadMatcher: &commonv1.WorkloadSelector_KubeWorkloadMatcher{
+								Labels: map[string]string{"app": "reviews"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		sidecars := translate(settingsv1.SidecarScopingSettings_SERVICE_DEPENDENCIES_AND_POLICIES, wl, reviews, reviewsV2, ratings, details)
+		Expect(sidecars).To(HaveLen(1))
+		Expect(sidecars[0].Spec.GetEgress()[0].GetHosts()).To(Equal([]string{
+			"istio-namespace/*",
+			"*/ratings.bookinfo.svc.cluster.local",
+			"*/reviews-v2.bookinfo.svc.cluster.local",
+			"*/reviews.bookinfo.svc.cluster.local",
+		}))
+
+		// policies are ignored in the default mode
+		outputs = istio.NewBuilder(ctx, "")
+		sidecars = translate(settingsv1.SidecarScopingSettings_SERVICE_DEPENDENCIES, wl, reviews, reviewsV2, ratings, details)
+		Expect(sidecars).To(HaveLen(1))
+		Expect(sidecars[0].Spec.GetEgress()[0].GetHosts()).To(Equal([]string{"istio-namespace/*"}))
+	})
+
+	It("should not translate a Sidecar from policies for Workloads without ServiceDependencies", func() {
+		reviews := makeDestination("reviews")
+		// a TrafficPolicy without a source selector selects every Workload
+		reviews.Status.AppliedTrafficPolicies = []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			{
+				Spec: &networkingv1.TrafficPolicySpec{},
+			},
+		}
+
+		Expect(translate(settingsv1.SidecarScopingSettings_SERVICE_DEPENDENCIES_AND_POLICIES, makeWorkload(nil), reviews)).To(BeEmpty())
+	})
+})
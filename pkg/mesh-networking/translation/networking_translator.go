@@ -7,13 +7,16 @@ import (
 
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	appmeshoutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/appmesh"
+	consuloutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/consul"
 	istiooutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
 	localoutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/local"
 	smioutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/smi"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/extensions"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/appmesh"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/consul"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio"
-	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/osm"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/smi"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/skv2/contrib/pkg/output"
@@ -36,18 +39,36 @@ type translator struct {
 	totalTranslates   int // TODO(ilackarms): metric
 	istioTranslator   istio.Translator
 	appmeshTranslator appmesh.Translator
-	osmTranslator     osm.Translator
+	osmTranslator     smi.Translator
+	linkerdTranslator smi.Translator
+	consulTranslator  consul.Translator
+}
+
+// NewMeshTranslator returns a Translator for all supported meshes.
+// The Istio translator calls the given extension servers, which may be nil when translating offline or validating.
+func NewMeshTranslator(extensionClients extensions.Clientset) Translator {
+	return NewTranslator(
+		istio.NewIstioTranslator(extensionClients),
+		appmesh.NewAppmeshTranslator(),
+		smi.NewOSMTranslator(),
+		smi.NewLinkerdTranslator(),
+		consul.NewConsulTranslator(),
+	)
 }
 
 func NewTranslator(
 	istioTranslator istio.Translator,
 	appmeshTranslator appmesh.Translator,
-	osmTranslator osm.Translator,
+	osmTranslator smi.Translator,
+	linkerdTranslator smi.Translator,
+	consulTranslator consul.Translator,
 ) Translator {
 	return &translator{
 		istioTranslator:   istioTranslator,
 		appmeshTranslator: appmeshTranslator,
 		osmTranslator:     osmTranslator,
+		linkerdTranslator: linkerdTranslator,
+		consulTranslator:  consulTranslator,
 	}
 }
 
@@ -63,6 +84,7 @@ func (t *translator) Translate(
 	istioOutputs := istiooutput.NewBuilder(ctx, fmt.Sprintf("networking-istio-%v", t.totalTranslates))
 	appmeshOutputs := appmeshoutput.NewBuilder(ctx, fmt.Sprintf("networking-appmesh-%v", t.totalTranslates))
 	smiOutputs := smioutput.NewBuilder(ctx, fmt.Sprintf("networking-smi-%v", t.totalTranslates))
+	consulOutputs := consuloutput.NewBuilder(ctx, fmt.Sprintf("networking-consul-%v", t.totalTranslates))
 	localOutputs := localoutput.NewBuilder(ctx, fmt.Sprintf("networking-local-%v", t.totalTranslates))
 
 	t.istioTranslator.Translate(ctx, in, userSupplied, istioOutputs, localOutputs, reporter)
@@ -71,10 +93,15 @@ func (t *translator) Translate(
 
 	t.osmTranslator.Translate(ctx, in, smiOutputs, reporter)
 
+	t.linkerdTranslator.Translate(ctx, in, smiOutputs, reporter)
+
+	t.consulTranslator.Translate(ctx, in, consulOutputs, reporter)
+
 	return &Outputs{
 		Istio:   istioOutputs,
 		Appmesh: appmeshOutputs,
 		Smi:     smiOutputs,
+		Consul:  consulOutputs,
 		Local:   localOutputs,
 	}, nil
 }
@@ -83,6 +110,7 @@ type Outputs struct {
 	Istio   istiooutput.Builder
 	Appmesh appmeshoutput.Builder
 	Smi     smioutput.Builder
+	Consul  consuloutput.Builder
 	Local   localoutput.Builder
 }
 
@@ -102,6 +130,11 @@ func (t *Outputs) snapshots() (outputSnapshots, error) {
 		return outputSnapshots{}, err
 	}
 
+	consulSnapshot, err := t.Consul.BuildSinglePartitionedSnapshot(metautils.TranslatedObjectLabels())
+	if err != nil {
+		return outputSnapshots{}, err
+	}
+
 	localSnapshot, err := t.Local.BuildSinglePartitionedSnapshot(metautils.TranslatedObjectLabels())
 	if err != nil {
 		return outputSnapshots{}, err
@@ -111,6 +144,7 @@ func (t *Outputs) snapshots() (outputSnapshots, error) {
 		istio:   istioSnapshot,
 		appmesh: appmeshSnapshot,
 		smi:     smiSnapshot,
+		consul:  consulSnapshot,
 		local:   localSnapshot,
 	}, nil
 }
@@ -137,6 +171,7 @@ func (t *Outputs) ApplyMultiCluster(
 	snaps.istio.ApplyMultiCluster(ctx, multiClusterClient, errHandler)
 	snaps.appmesh.ApplyMultiCluster(ctx, multiClusterClient, errHandler)
 	snaps.smi.ApplyMultiCluster(ctx, multiClusterClient, errHandler)
+	snaps.consul.ApplyMultiCluster(ctx, multiClusterClient, errHandler)
 	// Apply local resources only to management cluster
 	snaps.local.ApplyLocalCluster(ctx, clusterClient, errHandler)
 
@@ -147,6 +182,7 @@ type outputSnapshots struct {
 	istio   istiooutput.Snapshot
 	appmesh appmeshoutput.Snapshot
 	smi     smioutput.Snapshot
+	consul  consuloutput.Snapshot
 	local   localoutput.Snapshot
 }
 
@@ -164,9 +200,13 @@ func (t outputSnapshots) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	consulByt, err := t.consul.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
 	localByt, err := t.local.MarshalJSON()
 	if err != nil {
 		return nil, err
 	}
-	return bytes.Join([][]byte{istioByt, appmeshByt, smiByt, localByt}, []byte("\n")), nil
+	return bytes.Join([][]byte{istioByt, appmeshByt, smiByt, consulByt, localByt}, []byte("\n")), nil
 }
@@ -3,6 +3,8 @@ package access
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/rotisserie/eris"
@@ -13,6 +15,7 @@ import (
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/workloadutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/smi/destination/split"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	"github.com/solo-io/go-utils/contextutils"
 	sksets "github.com/solo-io/skv2/contrib/pkg/sets"
@@ -75,6 +78,21 @@ func (t *translator) Translate(
 	backingWorkloads := workloadutils.FindBackingWorkloads(destination.Spec.GetKubeService(), in.Workloads())
 	for _, ap := range destination.Status.GetAppliedAccessPolicies() {
 
+		if ap.GetSpec().GetAction() == v1.AccessPolicySpec_DENY {
+			reporter.ReportAccessPolicyToDestination(
+				destination,
+				ap.GetRef(),
+				split.NewUnsupportedFeatureError(ap.GetRef(), "Action", "SMI TrafficTargets cannot deny traffic"),
+			)
+			continue
+		}
+
+		headers, err := translateConditions(ap.GetRef(), ap.GetSpec().GetConditions())
+		if err != nil {
+			reporter.ReportAccessPolicyToDestination(destination, ap.GetRef(), err)
+			continue
+		}
+
 		if len(backingWorkloads) == 0 {
 			reporter.ReportAccessPolicyToDestination(
 				destination,
@@ -177,6 +195,7 @@ func (t *translator) Translate(
 			Methods: methods,
 			// Need to default to * or OSM does not route at all
 			PathRegex: constants.RegexMatchAll,
+			Headers:   headers,
 		}
 
 		var httpMatches []smispecsv1alpha3.HTTPMatch
@@ -226,6 +245,49 @@ func (t *translator) Translate(
 	return trafficTargets, httpRouteGroups
 }
 
+// SMI HTTPRouteGroups can only match requests by header, so only request header conditions with a single value are supported.
+// Header values are matched as regular expressions.
+func translateConditions(
+	accessPolicyRef ezkube.ResourceId,
+	conditions []*v1.AccessPolicySpec_Condition,
+) (map[string]string, error) {
+	if len(conditions) == 0 {
+		return nil, nil
+	}
+	headers := map[string]string{}
+	for _, condition := range conditions {
+		header := condition.GetRequestHeader()
+		if header == nil {
+			return nil, split.NewUnsupportedFeatureError(
+				accessPolicyRef,
+				"Conditions",
+				"SMI HTTPRouteGroups can only match requests by header",
+			)
+		}
+		if _, ok := headers[header.GetName()]; ok || len(header.GetValues()) != 1 || len(header.GetNotValues()) > 0 {
+			return nil, split.NewUnsupportedFeatureError(
+				accessPolicyRef,
+				"Conditions",
+				"SMI HTTPRouteGroups can only match a single value for each header",
+			)
+		}
+		headers[header.GetName()] = translateHeaderValue(header.GetValues()[0])
+	}
+	return headers, nil
+}
+
+// AccessPolicy header values support exact, prefix ("foo*") and suffix ("*foo") matching
+func translateHeaderValue(value string) string {
+	switch {
+	case strings.HasSuffix(value, "*"):
+		return regexp.QuoteMeta(strings.TrimSuffix(value, "*")) + ".*"
+	case strings.HasPrefix(value, "*"):
+		return ".*" + regexp.QuoteMeta(strings.TrimPrefix(value, "*"))
+	default:
+		return regexp.QuoteMeta(value)
+	}
+}
+
 func (t *translator) kubeValidName(id ezkube.ResourceId) string {
 	return id.GetName() + "." + id.GetNamespace()
 }
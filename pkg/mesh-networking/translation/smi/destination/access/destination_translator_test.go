@@ -74,6 +74,75 @@ var _ = Describe("DestinationTranslator", func() {
 
 	})
 
+	It("will report DENY AccessPolicies and unsupported conditions", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedAccessPolicies: []*discoveryv1.DestinationStatus_AppliedAccessPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "deny",
+							Namespace: "world",
+						},
+						Spec: &networkingv1.AccessPolicySpec{
+							Action: networkingv1.AccessPolicySpec_DENY,
+						},
+					},
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "jwt",
+							Namespace: "world",
+						},
+						Spec: &networkingv1.AccessPolicySpec{
+							Conditions: []*networkingv1.AccessPolicySpec_Condition{
+								{
+									ConditionType: &networkingv1.AccessPolicySpec_Condition_JwtClaim{
+										JwtClaim: &networkingv1.AccessPolicySpec_Condition_JwtClaimCondition{
+											Claim:  "groups",
+											Values: []string{"admins"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		reporter.
+			EXPECT().
+			ReportAccessPolicyToDestination(
+				destination,
+				destination.Status.AppliedAccessPolicies[0].Ref,
+				gomock.Any(),
+			).
+			DoAndReturn(func(destination *discoveryv1.Destination, accessPolicy *v1.ObjectRef, err error) {
+				Expect(err).To(MatchError(ContainSubstring("SMI TrafficTargets cannot deny traffic")))
+			})
+		reporter.
+			EXPECT().
+			ReportAccessPolicyToDestination(
+				destination,
+				destination.Status.AppliedAccessPolicies[1].Ref,
+				gomock.Any(),
+			).
+			DoAndReturn(func(destination *discoveryv1.Destination, accessPolicy *v1.ObjectRef, err error) {
+				Expect(err).To(MatchError(ContainSubstring("SMI HTTPRouteGroups can only match requests by header")))
+			})
+
+		tt, hrg := NewTranslator().Translate(ctx, in, destination, reporter)
+		Expect(tt).To(HaveLen(0))
+		Expect(hrg).To(HaveLen(0))
+	})
+
 	It("will report an error if backing workloads belong to multiple service accounts", func() {
 		ns := "default"
 		podLabels := map[string]string{"we": "match"}
@@ -279,4 +348,75 @@ var _ = Describe("DestinationTranslator", func() {
 		Expect(hrg[0]).To(Equal(expectedHRG))
 	})
 
+	It("will translate request header conditions into HTTPRouteGroup header matches", func() {
+		ns := "default"
+		podLabels := map[string]string{"we": "match"}
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddWorkloads([]*discoveryv1.Workload{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "one",
+					},
+					Spec: discoveryv1.WorkloadSpec{
+						Type: &discoveryv1.WorkloadSpec_Kubernetes{
+							Kubernetes: &discoveryv1.WorkloadSpec_KubernetesWorkload{
+								Controller: &v1.ClusterObjectRef{
+									Namespace: ns,
+								},
+								PodLabels:          podLabels,
+								ServiceAccountName: "hello",
+							},
+						},
+					},
+				},
+			}).
+			Build()
+
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: ns,
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:      "name",
+							Namespace: ns,
+						},
+						WorkloadSelectorLabels: podLabels,
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedAccessPolicies: []*discoveryv1.DestinationStatus_AppliedAccessPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "hello",
+							Namespace: "world",
+						},
+						Spec: &networkingv1.AccessPolicySpec{
+							Conditions: []*networkingv1.AccessPolicySpec_Condition{
+								{
+									ConditionType: &networkingv1.AccessPolicySpec_Condition_RequestHeader{
+										RequestHeader: &networkingv1.AccessPolicySpec_Condition_HeaderCondition{
+											Name:   "x-tenant",
+											Values: []string{"acme.*"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		_, hrg := NewTranslator().Translate(ctx, in, destination, reporter)
+		Expect(hrg).To(HaveLen(1))
+		Expect(hrg[0].Spec.Matches).To(HaveLen(1))
+		Expect(hrg[0].Spec.Matches[0].Headers).To(HaveLen(1))
+		Expect(hrg[0].Spec.Matches[0].Headers).To(HaveKeyWithValue("x-tenant", `acme\..*`))
+	})
+
 })
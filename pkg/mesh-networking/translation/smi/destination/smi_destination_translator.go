@@ -13,9 +13,9 @@ import (
 
 //go:generate mockgen -source ./smi_destination_translator.go -destination mocks/smi_destination_translator.go
 
-// translates a Destination into a OSM resources.
+// translates a Destination into SMI resources.
 type Translator interface {
-	// Translate translates OSM resources for the given Destination.
+	// Translate translates SMI resources for the given Destination.
 	// Output resources will be added to the smi output snapshot
 	// Errors caused by invalid user config will be reported using the Reporter.
 	Translate(
@@ -184,6 +184,48 @@ func validate(
 			"SMI does not support source selectors for traffic policies",
 		))
 	}
+	if len(tp.GetSpec().GetTcpRequestMatchers()) > 0 {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"TcpRequestMatchers",
+			"SMI does not support TCP request matchers",
+		))
+	}
+	if len(tp.GetSpec().GetTlsRequestMatchers()) > 0 {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"TlsRequestMatchers",
+			"SMI does not support TLS request matchers",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetLoadBalancer() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"LoadBalancer",
+			"SMI does not support configuring the load balancing algorithm",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetConnectionPool() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"ConnectionPool",
+			"SMI does not support connection pools",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetCanary() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"Canary",
+			"SMI does not support canaries",
+		))
+	}
+	if tp.GetSpec().GetPolicy().GetJwt() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"Jwt",
+			"SMI does not support JWT policy",
+		))
+	}
 }
 
 func buildBackends(
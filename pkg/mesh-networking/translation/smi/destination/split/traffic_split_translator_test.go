@@ -117,4 +117,94 @@ var _ = Describe("TrafficSplitTranslator", func() {
 		Expect(ts).To(Equal(expectedTT))
 	})
 
+	It("reports retry policies as unsupported", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		tp := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "tp",
+				Namespace: "default",
+			},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					Retries: &v1.TrafficPolicySpec_Policy_RetryPolicy{
+						Attempts:             3,
+						RetryOn:              []string{"connect-failure"},
+						RetriableStatusCodes: []uint32{503},
+					},
+				},
+			},
+		}
+		destination := &discoveryv1.Destination{
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:      "service",
+							Namespace: "default",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{tp},
+			},
+		}
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, tp.GetRef(), gomock.Any()).
+			Do(func(_ *discoveryv1.Destination, _ *skv2corev1.ObjectRef, err error) {
+				Expect(err.Error()).To(ContainSubstring("Retries"))
+			})
+
+		ts := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(ts).To(BeNil())
+	})
+
+	It("reports load balancer, connection pool and canary policies as unsupported", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		tp := &discoveryv1.DestinationStatus_AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "tp",
+				Namespace: "default",
+			},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					LoadBalancer:   &v1.TrafficPolicySpec_Policy_LoadBalancer{},
+					ConnectionPool: &v1.TrafficPolicySpec_Policy_ConnectionPool{},
+					Canary:         &v1.TrafficPolicySpec_Policy_Canary{},
+				},
+			},
+		}
+		destination := &discoveryv1.Destination{
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:      "service",
+							Namespace: "default",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*discoveryv1.DestinationStatus_AppliedTrafficPolicy{tp},
+			},
+		}
+
+		var reportedErrs []string
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, tp.GetRef(), gomock.Any()).
+			Do(func(_ *discoveryv1.Destination, _ *skv2corev1.ObjectRef, err error) {
+				reportedErrs = append(reportedErrs, err.Error())
+			}).
+			Times(3)
+
+		ts := NewTranslator().Translate(ctx, in, destination, mockReporter)
+		Expect(ts).To(BeNil())
+		Expect(reportedErrs[0]).To(ContainSubstring("LoadBalancer"))
+		Expect(reportedErrs[1]).To(ContainSubstring("ConnectionPool"))
+		Expect(reportedErrs[2]).To(ContainSubstring("Canary"))
+	})
 })
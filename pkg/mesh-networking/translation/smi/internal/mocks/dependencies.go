@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./dependencies.go
+
+// Package mock_internal is a generated GoMock package.
+package mock_internal
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	destination "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/smi/destination"
+	mesh "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/smi/mesh"
+)
+
+// MockDependencyFactory is a mock of DependencyFactory interface.
+type MockDependencyFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependencyFactoryMockRecorder
+}
+
+// MockDependencyFactoryMockRecorder is the mock recorder for MockDependencyFactory.
+type MockDependencyFactoryMockRecorder struct {
+	mock *MockDependencyFactory
+}
+
+// NewMockDependencyFactory creates a new mock instance.
+func NewMockDependencyFactory(ctrl *gomock.Controller) *MockDependencyFactory {
+	mock := &MockDependencyFactory{ctrl: ctrl}
+	mock.recorder = &MockDependencyFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependencyFactory) EXPECT() *MockDependencyFactoryMockRecorder {
+	return m.recorder
+}
+
+// MakeDestinationTranslator mocks base method.
+func (m *MockDependencyFactory) MakeDestinationTranslator() destination.Translator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeDestinationTranslator")
+	ret0, _ := ret[0].(destination.Translator)
+	return ret0
+}
+
+// MakeDestinationTranslator indicates an expected call of MakeDestinationTranslator.
+func (mr *MockDependencyFactoryMockRecorder) MakeDestinationTranslator() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeDestinationTranslator", reflect.TypeOf((*MockDependencyFactory)(nil).MakeDestinationTranslator))
+}
+
+// MakeMeshTranslator mocks base method.
+func (m *MockDependencyFactory) MakeMeshTranslator(getInstallation func(*v1.Mesh) *v1.MeshInstallation) mesh.Translator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeMeshTranslator", getInstallation)
+	ret0, _ := ret[0].(mesh.Translator)
+	return ret0
+}
+
+// MakeMeshTranslator indicates an expected call of MakeMeshTranslator.
+func (mr *MockDependencyFactoryMockRecorder) MakeMeshTranslator(getInstallation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeMeshTranslator", reflect.TypeOf((*MockDependencyFactory)(nil).MakeMeshTranslator), getInstallation)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./osm_mesh_translator.go
+// Source: ./smi_mesh_translator.go
 
 // Package mock_mesh is a generated GoMock package.
 package mock_mesh
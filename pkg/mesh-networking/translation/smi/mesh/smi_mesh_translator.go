@@ -0,0 +1,52 @@
+package mesh
+
+import (
+	"context"
+
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/smi"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+)
+
+//go:generate mockgen -source ./smi_mesh_translator.go -destination mocks/smi_mesh_translator.go
+
+// the mesh translator registers the cluster of an SMI-compliant Mesh with the smi output snapshot.
+type Translator interface {
+	// Translate translates the appropriate resources for the given Mesh.
+	// Meshes not handled by this translator are ignored.
+	// Output resources will be added to the smi.Builder
+	// Errors caused by invalid user config will be reported using the Reporter.
+	Translate(
+		ctx context.Context,
+		in input.LocalSnapshot,
+		mesh *discoveryv1.Mesh,
+		outputs smi.Builder,
+		reporter reporting.Reporter,
+	)
+}
+
+type translator struct {
+	getInstallation func(mesh *discoveryv1.Mesh) *discoveryv1.MeshInstallation
+}
+
+// getInstallation returns the installation of a Mesh, or nil if the Mesh is not handled by this translator.
+func NewTranslator(getInstallation func(mesh *discoveryv1.Mesh) *discoveryv1.MeshInstallation) Translator {
+	return &translator{getInstallation: getInstallation}
+}
+
+// translate the appropriate resources for the given Mesh.
+func (t *translator) Translate(
+	ctx context.Context,
+	in input.LocalSnapshot,
+	mesh *discoveryv1.Mesh,
+	outputs smi.Builder,
+	reporter reporting.Reporter,
+) {
+	installation := t.getInstallation(mesh)
+	if installation == nil {
+		return
+	}
+
+	outputs.AddCluster(installation.GetCluster())
+}
@@ -0,0 +1,64 @@
+package mesh_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	mock_output "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/smi/mocks"
+	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/smi/mesh"
+)
+
+var _ = Describe("SmiMeshTranslator", func() {
+	var (
+		ctx            context.Context
+		ctrl           *gomock.Controller
+		mockOutputs    *mock_output.MockBuilder
+		mockReporter   *mock_reporting.MockReporter
+		meshTranslator Translator
+	)
+
+	BeforeEach(func() {
+		ctrl, ctx = gomock.WithContext(context.Background(), GinkgoT())
+		mockOutputs = mock_output.NewMockBuilder(ctrl)
+		mockReporter = mock_reporting.NewMockReporter(ctrl)
+		meshTranslator = NewTranslator(func(mesh *discoveryv1.Mesh) *discoveryv1.MeshInstallation {
+			return mesh.Spec.GetOsm().GetInstallation()
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should not translate meshes of another type", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		mesh := &discoveryv1.Mesh{
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Linkerd{Linkerd: &discoveryv1.MeshSpec_LinkerdMesh{
+					Installation: &discoveryv1.MeshInstallation{Cluster: "cluster"},
+				}},
+			},
+		}
+
+		meshTranslator.Translate(ctx, in, mesh, mockOutputs, mockReporter)
+	})
+
+	It("should add the cluster of meshes of its type", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+		mesh := &discoveryv1.Mesh{
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Osm{Osm: &discoveryv1.MeshSpec_OSM{
+					Installation: &discoveryv1.MeshInstallation{Cluster: "cluster"},
+				}},
+			},
+		}
+
+		mockOutputs.EXPECT().AddCluster("cluster")
+
+		meshTranslator.Translate(ctx, in, mesh, mockOutputs, mockReporter)
+	})
+})
@@ -0,0 +1,113 @@
+package smi
+
+import (
+	"context"
+	"fmt"
+
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	smioutput "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/smi"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/smi/internal"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+)
+
+// the smi translator translates an input networking snapshot to an output snapshot of SMI resources
+type Translator interface {
+	// Translate translates the appropriate resources to apply input configuration resources for all meshes of the translator's type contained in the input snapshot.
+	// Output resources will be added to the smioutput.Builder
+	// Errors caused by invalid user config will be reported using the Reporter.
+	Translate(
+		ctx context.Context,
+		in input.LocalSnapshot,
+		outputs smioutput.Builder,
+		reporter reporting.Reporter,
+	)
+}
+
+type smiTranslator struct {
+	meshType        string
+	getInstallation func(mesh *discoveryv1.Mesh) *discoveryv1.MeshInstallation
+	totalTranslates int
+	dependencies    internal.DependencyFactory
+}
+
+// NewOSMTranslator returns a Translator for OSM meshes.
+func NewOSMTranslator() Translator {
+	return newSmiTranslator("osm", func(mesh *discoveryv1.Mesh) *discoveryv1.MeshInstallation {
+		return mesh.Spec.GetOsm().GetInstallation()
+	})
+}
+
+// NewLinkerdTranslator returns a Translator for Linkerd meshes.
+func NewLinkerdTranslator() Translator {
+	return newSmiTranslator("linkerd", func(mesh *discoveryv1.Mesh) *discoveryv1.MeshInstallation {
+		return mesh.Spec.GetLinkerd().GetInstallation()
+	})
+}
+
+func newSmiTranslator(
+	meshType string,
+	getInstallation func(mesh *discoveryv1.Mesh) *discoveryv1.MeshInstallation,
+) Translator {
+	return &smiTranslator{
+		meshType:        meshType,
+		getInstallation: getInstallation,
+		dependencies:    internal.NewDependencyFactory(),
+	}
+}
+
+func (s *smiTranslator) Translate(
+	ctx context.Context,
+	in input.LocalSnapshot,
+	outputs smioutput.Builder,
+	reporter reporting.Reporter,
+) {
+	ctx = contextutils.WithLogger(ctx, fmt.Sprintf("%v-translator-%v", s.meshType, s.totalTranslates))
+
+	meshTranslator := s.dependencies.MakeMeshTranslator(s.getInstallation)
+
+	for _, mesh := range in.Meshes().List() {
+		mesh := mesh
+
+		meshTranslator.Translate(ctx, in, mesh, outputs, reporter)
+	}
+
+	destinationTranslator := s.dependencies.MakeDestinationTranslator()
+
+	for _, destination := range in.Destinations().List() {
+		destination := destination
+
+		// only translate Destinations belonging to meshes of this type
+		if !s.isMeshTypeDestination(ctx, destination, in) {
+			continue
+		}
+
+		destinationTranslator.Translate(ctx, in, destination, outputs, reporter)
+	}
+
+	s.totalTranslates++
+}
+
+func (s *smiTranslator) isMeshTypeDestination(
+	ctx context.Context,
+	destination *discoveryv1.Destination,
+	in input.LocalSnapshot,
+) bool {
+	meshRef := destination.Spec.GetMesh()
+	if meshRef == nil {
+		if _, ok := destination.Spec.Type.(*discoveryv1.DestinationSpec_KubeService_); ok {
+			// Is KubeService, MeshRef is required
+			contextutils.LoggerFrom(ctx).Debugf("unexpected state: KubeService destination %v missing mesh ref", sets.Key(destination))
+		}
+		return false
+	}
+	mesh, err := in.Meshes().Find(meshRef)
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Debugf("unexpected state: could not find mesh %v for destination %v", sets.Key(meshRef), sets.Key(destination))
+		return false
+	}
+
+	return s.getInstallation(mesh) != nil
+}
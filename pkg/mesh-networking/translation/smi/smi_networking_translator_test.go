@@ -0,0 +1,103 @@
+package smi
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	mock_output "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/smi/mocks"
+	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
+	mock_destination "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/smi/destination/mocks"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/smi/internal/mocks"
+	mock_mesh "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/smi/mesh/mocks"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("SmiNetworkingTranslator", func() {
+	var (
+		ctrl                      *gomock.Controller
+		ctx                       context.Context
+		mockReporter              *mock_reporting.MockReporter
+		mockOutputs               *mock_output.MockBuilder
+		mockDependencyFactory     *MockDependencyFactory
+		mockMeshTranslator        *mock_mesh.MockTranslator
+		mockDestinationTranslator *mock_destination.MockTranslator
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.TODO()
+		mockReporter = mock_reporting.NewMockReporter(ctrl)
+		mockDependencyFactory = NewMockDependencyFactory(ctrl)
+		mockOutputs = mock_output.NewMockBuilder(ctrl)
+		mockMeshTranslator = mock_mesh.NewMockTranslator(ctrl)
+		mockDestinationTranslator = mock_destination.NewMockTranslator(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should translate all meshes and the destinations of meshes of its type", func() {
+		osmMesh := &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{Name: "osm", Namespace: "gloo-mesh"},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Osm{Osm: &discoveryv1.MeshSpec_OSM{
+					Installation: &discoveryv1.MeshInstallation{Cluster: "cluster"},
+				}},
+			},
+		}
+		linkerdMesh := &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{Name: "linkerd", Namespace: "gloo-mesh"},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Linkerd{Linkerd: &discoveryv1.MeshSpec_LinkerdMesh{
+					Installation: &discoveryv1.MeshInstallation{Cluster: "cluster"},
+				}},
+			},
+		}
+		osmDestination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{Name: "osm-destination", Namespace: "gloo-mesh"},
+			Spec: discoveryv1.DestinationSpec{
+				Mesh: &skv2corev1.ObjectRef{Name: "osm", Namespace: "gloo-mesh"},
+			},
+		}
+		linkerdDestination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{Name: "linkerd-destination", Namespace: "gloo-mesh"},
+			Spec: discoveryv1.DestinationSpec{
+				Mesh: &skv2corev1.ObjectRef{Name: "linkerd", Namespace: "gloo-mesh"},
+			},
+		}
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddMeshes([]*discoveryv1.Mesh{osmMesh, linkerdMesh}).
+			AddDestinations([]*discoveryv1.Destination{osmDestination, linkerdDestination, {}}).
+			Build()
+
+		translator := NewLinkerdTranslator().(*smiTranslator)
+		translator.dependencies = mockDependencyFactory
+
+		mockDependencyFactory.
+			EXPECT().
+			MakeMeshTranslator(gomock.Any()).
+			Return(mockMeshTranslator)
+
+		mockDependencyFactory.
+			EXPECT().
+			MakeDestinationTranslator().
+			Return(mockDestinationTranslator)
+
+		for _, mesh := range in.Meshes().List() {
+			mockMeshTranslator.
+				EXPECT().
+				Translate(gomock.Any(), in, mesh, mockOutputs, mockReporter)
+		}
+
+		mockDestinationTranslator.
+			EXPECT().
+			Translate(gomock.Any(), in, linkerdDestination, mockOutputs, mockReporter)
+
+		translator.Translate(ctx, in, mockOutputs, mockReporter)
+	})
+})
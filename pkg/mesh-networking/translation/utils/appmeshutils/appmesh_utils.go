@@ -0,0 +1,94 @@
+package appmeshutils
+
+import (
+	"strings"
+	"time"
+
+	appmeshv1beta2 "github.com/aws/aws-app-mesh-controller-for-k8s/apis/appmesh/v1beta2"
+	"github.com/golang/protobuf/ptypes/duration"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/skv2/pkg/ezkube"
+)
+
+// returns true if the given mesh reference points to an App Mesh Mesh in the given set
+func IsAppMeshMesh(meshRef *skv2corev1.ObjectRef, allMeshes discoveryv1sets.MeshSet) bool {
+	if meshRef == nil {
+		return false
+	}
+	mesh, err := allMeshes.Find(meshRef)
+	if err != nil {
+		return false
+	}
+	return mesh.Spec.GetAwsAppMesh() != nil
+}
+
+// returns the App Mesh protocol for the given Kubernetes service port.
+// the protocol is inferred from the port's appProtocol or, if unset, the port name prefix
+// following the Istio convention (e.g. "http-web", "grpc-api").
+// defaults to TCP.
+func PortProtocol(port *discoveryv1.DestinationSpec_KubeService_KubeServicePort) appmeshv1beta2.PortProtocol {
+	protocol := port.GetAppProtocol()
+	if protocol == "" {
+		protocol = strings.SplitN(port.GetName(), "-", 2)[0]
+	}
+	switch strings.ToLower(protocol) {
+	case "http":
+		return appmeshv1beta2.PortProtocolHTTP
+	case "http2":
+		return appmeshv1beta2.PortProtocolHTTP2
+	case "grpc":
+		return appmeshv1beta2.PortProtocolGRPC
+	default:
+		return appmeshv1beta2.PortProtocolTCP
+	}
+}
+
+// returns the port on the backing pods which receives traffic for the given Kubernetes service port.
+// falls back to the service port if the target port is a named port.
+func TargetPort(port *discoveryv1.DestinationSpec_KubeService_KubeServicePort) appmeshv1beta2.PortNumber {
+	if targetPort := port.GetTargetPortNumber(); targetPort != 0 {
+		return appmeshv1beta2.PortNumber(targetPort)
+	}
+	return appmeshv1beta2.PortNumber(port.GetPort())
+}
+
+// converts a proto Duration to an App Mesh Duration, using seconds when possible
+func Duration(d *duration.Duration) appmeshv1beta2.Duration {
+	goDuration := time.Duration(d.GetSeconds())*time.Second + time.Duration(d.GetNanos())
+	if goDuration%time.Second == 0 {
+		return appmeshv1beta2.Duration{
+			Unit:  appmeshv1beta2.DurationUnitS,
+			Value: int64(goDuration / time.Second),
+		}
+	}
+	return appmeshv1beta2.Duration{
+		Unit:  appmeshv1beta2.DurationUnitMS,
+		Value: int64(goDuration / time.Millisecond),
+	}
+}
+
+// returns the reference to the VirtualNode translated for the given Workload
+func VirtualNodeRef(workload *discoveryv1.Workload) *appmeshv1beta2.VirtualNodeReference {
+	controller := workload.Spec.GetKubernetes().GetController()
+	namespace := controller.GetNamespace()
+	return &appmeshv1beta2.VirtualNodeReference{
+		Name:      controller.GetName(),
+		Namespace: &namespace,
+	}
+}
+
+// returns the reference to the VirtualService translated for the given Kubernetes service
+func VirtualServiceRef(kubeService ezkube.ClusterResourceId) *appmeshv1beta2.VirtualServiceReference {
+	namespace := kubeService.GetNamespace()
+	return &appmeshv1beta2.VirtualServiceReference{
+		Name:      kubeService.GetName(),
+		Namespace: &namespace,
+	}
+}
+
+// returns a name for a child resource (e.g. a Route) of an App Mesh resource which is unique for the given parent policy
+func ChildName(parent ezkube.ResourceId, suffix string) string {
+	return strings.Join([]string{parent.GetName(), parent.GetNamespace(), suffix}, "-")
+}
@@ -0,0 +1,87 @@
+package canaryutils
+
+import (
+	"fmt"
+	"regexp"
+
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+)
+
+const cookieHeader = "cookie"
+
+// Return the percentage of traffic currently routed to the canary subset by the TrafficPolicy.
+// If the canary has not progressed for the current generation of the TrafficPolicy, the weight of its first step is returned.
+func CurrentWeight(trafficPolicy *networkingv1.TrafficPolicy) uint32 {
+	canaryStatus := trafficPolicy.Status.GetCanary()
+	if canaryStatus != nil && canaryStatus.GetObservedGeneration() == trafficPolicy.Generation {
+		return canaryStatus.GetWeight()
+	}
+	if steps := trafficPolicy.Spec.GetPolicy().GetCanary().GetSteps(); len(steps) > 0 {
+		return steps[0].GetWeight()
+	}
+	return 0
+}
+
+// Return true if requests of the canary cohort are routed to the canary subset, i.e. if the canary has not been rolled back.
+func CohortRouted(trafficPolicy *networkingv1.TrafficPolicy) bool {
+	canaryStatus := trafficPolicy.Status.GetCanary()
+	if canaryStatus == nil || canaryStatus.GetObservedGeneration() != trafficPolicy.Generation {
+		return true
+	}
+	return canaryStatus.GetPhase() != networkingv1.TrafficPolicyStatus_CanaryStatus_ROLLED_BACK
+}
+
+// Return the traffic shift which routes the given percentage of the traffic to the Destination to its canary subset,
+// and the remaining traffic to its stable subset.
+func TrafficShift(
+	canary *networkingv1.TrafficPolicySpec_Policy_Canary,
+	destination *discoveryv1.Destination,
+	weight uint32,
+) *networkingv1.TrafficPolicySpec_Policy_MultiDestination {
+	kubeServiceRef := destination.Spec.GetKubeService().GetRef()
+	kubeDestination := func(subset map[string]string, weight uint32) *networkingv1.WeightedDestination {
+		return &networkingv1.WeightedDestination{
+			Weight: weight,
+			DestinationType: &networkingv1.WeightedDestination_KubeService{
+				KubeService: &networkingv1.WeightedDestination_KubeDestination{
+					Name:        kubeServiceRef.GetName(),
+					Namespace:   kubeServiceRef.GetNamespace(),
+					ClusterName: kubeServiceRef.GetClusterName(),
+					Subset:      subset,
+				},
+			},
+		}
+	}
+
+	return &networkingv1.TrafficPolicySpec_Policy_MultiDestination{
+		Destinations: []*networkingv1.WeightedDestination{
+			kubeDestination(canary.GetStableSubset(), 100-weight),
+			kubeDestination(canary.GetCanarySubset(), weight),
+		},
+	}
+}
+
+// Return the header matchers which select the cohort of requests routed to the canary subset regardless of its weight.
+// A request belongs to the cohort if it matches any of the returned matchers.
+func CohortHeaderMatchers(canary *networkingv1.TrafficPolicySpec_Policy_Canary) []*networkingv1.HeaderMatcher {
+	var headerMatchers []*networkingv1.HeaderMatcher
+	headerMatchers = append(headerMatchers, canary.GetCohortHeaders()...)
+	for _, cookie := range canary.GetCohortCookies() {
+		headerMatchers = append(headerMatchers, cookieHeaderMatcher(cookie))
+	}
+	return headerMatchers
+}
+
+// cookies are matched by a regex on the cookie header, which contains all cookies of the request separated by semicolons
+func cookieHeaderMatcher(cookie *networkingv1.TrafficPolicySpec_Policy_Canary_CookieMatcher) *networkingv1.HeaderMatcher {
+	value := "[^;]*"
+	if cookie.GetValue() != "" {
+		value = regexp.QuoteMeta(cookie.GetValue())
+	}
+	return &networkingv1.HeaderMatcher{
+		Name:  cookieHeader,
+		Value: fmt.Sprintf(`^(.*?;\s*)?%s=%s(;.*)?$`, regexp.QuoteMeta(cookie.GetName()), value),
+		Regex: true,
+	}
+}
@@ -0,0 +1,170 @@
+package egressutils
+
+import (
+	"github.com/rotisserie/eris"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/selectorutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	"istio.io/istio/pkg/config/protocol"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	defaultHttpPort           = 80
+	defaultTlsPort            = 443
+	defaultTlsOriginationPort = 443
+)
+
+// An external host whose requests are routed through an egress gateway.
+type EgressHost struct {
+	Hostname string
+	Port     uint32
+	Protocol networkingv1.EgressGatewayPolicySpec_ExternalHost_Protocol
+
+	// The ExternalService Destination representing the host, nil if the host is declared directly on the EgressGatewayPolicy.
+	Destination *discoveryv1.Destination
+}
+
+// Return the port to which workloads send requests for the external host.
+func ExternalHostPort(externalHost *networkingv1.EgressGatewayPolicySpec_ExternalHost) uint32 {
+	if port := externalHost.GetPort(); port != 0 {
+		return port
+	}
+	if externalHost.GetProtocol() == networkingv1.EgressGatewayPolicySpec_ExternalHost_TLS {
+		return defaultTlsPort
+	}
+	return defaultHttpPort
+}
+
+// Return the port on which the external hosts accept TLS connections originated by the egress gateway.
+func TlsOriginationPort(tlsOrigination *networkingv1.EgressGatewayPolicySpec_TLSOrigination) uint32 {
+	if port := tlsOrigination.GetPort(); port != 0 {
+		return port
+	}
+	return defaultTlsOriginationPort
+}
+
+// Return the egress gateway of the Mesh whose workload labels contain the given labels.
+// If no labels are given, the first egress gateway of the Mesh is returned.
+// Returns nil if no such egress gateway exists.
+func GetEgressGateway(
+	mesh *discoveryv1.Mesh,
+	gatewayWorkloadLabels map[string]string,
+) *discoveryv1.MeshSpec_Istio_EgressGatewayInfo {
+	for _, egressGateway := range mesh.Spec.GetIstio().GetEgressGateways() {
+		if labels.SelectorFromSet(gatewayWorkloadLabels).Matches(labels.Set(egressGateway.GetWorkloadLabels())) {
+			return egressGateway
+		}
+	}
+	return nil
+}
+
+// Return true if the EgressGatewayPolicy selects the given ExternalService Destination.
+// Unlike other policies, EgressGatewayPolicies without a Destination selector do not select any Destinations.
+func SelectsDestination(
+	egressGatewayPolicy *networkingv1.EgressGatewayPolicy,
+	destination *discoveryv1.Destination,
+) bool {
+	if destination.Spec.GetExternalService() == nil || len(egressGatewayPolicy.Spec.GetDestinationSelector()) == 0 {
+		return false
+	}
+	return selectorutils.SelectorMatchesDestination(egressGatewayPolicy.Spec.GetDestinationSelector(), destination)
+}
+
+// Return true if the external hosts declared on the EgressGatewayPolicy are routed through the egress gateway of the given Mesh.
+func RoutesExternalHostsThroughMesh(
+	egressGatewayPolicy *networkingv1.EgressGatewayPolicy,
+	mesh *discoveryv1.Mesh,
+) bool {
+	if len(egressGatewayPolicy.Spec.GetExternalHosts()) == 0 || mesh.Spec.GetIstio() == nil {
+		return false
+	}
+	if meshRefs := egressGatewayPolicy.Spec.GetMeshes(); len(meshRefs) > 0 {
+		for _, meshRef := range meshRefs {
+			if ezkube.RefsMatch(meshRef, mesh) {
+				return true
+			}
+		}
+		return false
+	}
+	// if no meshes are specified, route through all Istio meshes with a matching egress gateway
+	return GetEgressGateway(mesh, egressGatewayPolicy.Spec.GetGatewayWorkloadLabels()) != nil
+}
+
+// Return the hosts of an ExternalService Destination which can be routed through an egress gateway.
+func ExternalServiceEgressHosts(destination *discoveryv1.Destination) ([]*EgressHost, error) {
+	externalService := destination.Spec.GetExternalService()
+	if len(externalService.GetHosts()) != 1 {
+		return nil, eris.Errorf("egress gateways can only route ExternalServices with exactly one host, found %v", externalService.GetHosts())
+	}
+	hostname := externalService.GetHosts()[0]
+
+	var hosts []*EgressHost
+	for _, port := range externalService.GetPorts() {
+		var hostProtocol networkingv1.EgressGatewayPolicySpec_ExternalHost_Protocol
+		switch instance := protocol.Parse(port.GetProtocol()); {
+		case instance.IsHTTP():
+			hostProtocol = networkingv1.EgressGatewayPolicySpec_ExternalHost_HTTP
+		case instance.IsTLS():
+			hostProtocol = networkingv1.EgressGatewayPolicySpec_ExternalHost_TLS
+		default:
+			continue
+		}
+		hosts = append(hosts, &EgressHost{
+			Hostname:    hostname,
+			Port:        port.GetNumber(),
+			Protocol:    hostProtocol,
+			Destination: destination,
+		})
+	}
+	if len(hosts) == 0 {
+		return nil, eris.Errorf("ExternalService %v has no HTTP or TLS ports", sets.Key(destination))
+	}
+	return hosts, nil
+}
+
+// Return the hosts whose requests are routed through the egress gateway of the given Mesh by the EgressGatewayPolicy,
+// i.e. the external hosts declared on the policy and the hosts of the accepted ExternalService Destinations in the Mesh.
+func GetEgressHosts(
+	egressGatewayPolicy *networkingv1.EgressGatewayPolicy,
+	mesh *discoveryv1.Mesh,
+	destinations discoveryv1sets.DestinationSet,
+) []*EgressHost {
+	var hosts []*EgressHost
+	if RoutesExternalHostsThroughMesh(egressGatewayPolicy, mesh) {
+		for _, externalHost := range egressGatewayPolicy.Spec.GetExternalHosts() {
+			hosts = append(hosts, &EgressHost{
+				Hostname: externalHost.GetHostname(),
+				Port:     ExternalHostPort(externalHost),
+				Protocol: externalHost.GetProtocol(),
+			})
+		}
+	}
+
+	for _, destination := range destinations.List() {
+		if !ezkube.RefsMatch(destination.Spec.GetMesh(), mesh) {
+			continue
+		}
+		if egressGatewayPolicy.Status.GetDestinations()[sets.Key(destination)].GetState() != commonv1.ApprovalState_ACCEPTED {
+			continue
+		}
+		destinationHosts, err := ExternalServiceEgressHosts(destination)
+		if err != nil {
+			// the Destination would not have been accepted
+			continue
+		}
+		for _, host := range destinationHosts {
+			// TLS is only originated for HTTP ports, so the TLS ports of the ExternalService are not routed
+			if egressGatewayPolicy.Spec.GetTlsOrigination() != nil && host.Protocol != networkingv1.EgressGatewayPolicySpec_ExternalHost_HTTP {
+				continue
+			}
+			hosts = append(hosts, host)
+		}
+	}
+
+	return hosts
+}
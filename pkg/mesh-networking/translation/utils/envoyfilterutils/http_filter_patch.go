@@ -0,0 +1,75 @@
+package envoyfilterutils
+
+import (
+	envoyhcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	gogotypes "github.com/gogo/protobuf/types"
+	golangproto "github.com/golang/protobuf/proto"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/protoutils"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+)
+
+/*
+	Return the patch which inserts the named HTTP filter into the inbound listeners of the EnvoyFilter's selected workloads,
+	adding it to the EnvoyFilter if it does not already exist.
+
+	The returned patch is stable across calls for the same filter name, so that its Patch field can be registered
+	by decorators to detect conflicting configuration.
+*/
+func GetOrAddInboundHttpFilterPatch(
+	envoyFilter *networkingv1alpha3spec.EnvoyFilter,
+	filterName string,
+) *networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch {
+	for _, configPatch := range envoyFilter.GetConfigPatches() {
+		if configPatch.GetApplyTo() != networkingv1alpha3spec.EnvoyFilter_HTTP_FILTER {
+			continue
+		}
+		if configPatch.GetPatch().GetValue().GetFields()["name"].GetStringValue() == filterName {
+			return configPatch
+		}
+	}
+
+	configPatch := &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch{
+		ApplyTo: networkingv1alpha3spec.EnvoyFilter_HTTP_FILTER,
+		Match: &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectMatch{
+			Context: networkingv1alpha3spec.EnvoyFilter_SIDECAR_INBOUND,
+			ObjectTypes: &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectMatch_Listener{
+				Listener: &networkingv1alpha3spec.EnvoyFilter_ListenerMatch{
+					FilterChain: &networkingv1alpha3spec.EnvoyFilter_ListenerMatch_FilterChainMatch{
+						Filter: &networkingv1alpha3spec.EnvoyFilter_ListenerMatch_FilterMatch{
+							Name: wellknown.HTTPConnectionManager,
+							SubFilter: &networkingv1alpha3spec.EnvoyFilter_ListenerMatch_SubFilterMatch{
+								Name: wellknown.Router,
+							},
+						},
+					},
+				},
+			},
+		},
+		Patch: &networkingv1alpha3spec.EnvoyFilter_Patch{
+			Operation: networkingv1alpha3spec.EnvoyFilter_Patch_INSERT_BEFORE,
+			Value: &gogotypes.Struct{
+				Fields: map[string]*gogotypes.Value{
+					"name": {Kind: &gogotypes.Value_StringValue{StringValue: filterName}},
+				},
+			},
+		},
+	}
+	envoyFilter.ConfigPatches = append(envoyFilter.ConfigPatches, configPatch)
+
+	return configPatch
+}
+
+// Build the value of an EnvoyFilter patch which inserts an HTTP filter with the given typed config.
+func MakeHttpFilterPatchValue(filterName string, typedConfig golangproto.Message) (*gogotypes.Struct, error) {
+	anyConfig, err := protoutils.MessageToAnyWithError(typedConfig)
+	if err != nil {
+		return nil, err
+	}
+	return protoutils.GolangMessageToGogoStruct(&envoyhcm.HttpFilter{
+		Name: filterName,
+		ConfigType: &envoyhcm.HttpFilter_TypedConfig{
+			TypedConfig: anyConfig,
+		},
+	})
+}
@@ -3,6 +3,7 @@ package hostutils
 import (
 	"fmt"
 
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
@@ -101,6 +102,22 @@ func (c *clusterDomainRegistry) GetDestinationFQDN(originatingCluster string, de
 	}
 }
 
+// Get the hostnames by which clients in the originating cluster address the given Destination,
+// i.e. the FQDN of a KubeService or the hosts of an ExternalService.
+func GetDestinationHostnames(
+	clusterDomains ClusterDomainRegistry,
+	originatingCluster string,
+	destination *discoveryv1.Destination,
+) []string {
+	switch destinationType := destination.Spec.GetType().(type) {
+	case *discoveryv1.DestinationSpec_KubeService_:
+		return []string{clusterDomains.GetDestinationFQDN(originatingCluster, destinationType.KubeService.GetRef())}
+	case *discoveryv1.DestinationSpec_ExternalService_:
+		return destinationType.ExternalService.GetHosts()
+	}
+	return nil
+}
+
 // Construct a federated FQDN for the given service, using the provided hostname suffix if provided, otherwise use default suffix.
 func BuildFederatedFQDN(serviceRef ezkube.ClusterResourceId, virtualMeshSpec *v1.VirtualMeshSpec) string {
 	return fmt.Sprintf(
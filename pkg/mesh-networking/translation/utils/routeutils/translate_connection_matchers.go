@@ -0,0 +1,80 @@
+package routeutils
+
+import (
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+)
+
+// TranslateTcpMatcher translates a TCP matcher for the given destination port to Istio.
+// The port should be that of the matcher if specified.
+func TranslateTcpMatcher(
+	sourceSelectors []*commonv1.WorkloadSelector,
+	port uint32,
+) []*networkingv1alpha3spec.L4MatchAttributes {
+	var translatedMatchers []*networkingv1alpha3spec.L4MatchAttributes
+	for _, source := range translateSourceSelectors(sourceSelectors) {
+		translatedMatchers = append(translatedMatchers, &networkingv1alpha3spec.L4MatchAttributes{
+			Port:            port,
+			SourceNamespace: source.namespace,
+			SourceLabels:    source.labels,
+		})
+	}
+	return translatedMatchers
+}
+
+// TranslateTlsMatcher translates a TLS matcher for the given destination port to Istio, matching the given SNI hosts
+// if the matcher does not specify any. The port should be that of the matcher if specified.
+func TranslateTlsMatcher(
+	tlsMatcher *v1.TlsMatcher,
+	sourceSelectors []*commonv1.WorkloadSelector,
+	port uint32,
+	defaultSniHosts []string,
+) []*networkingv1alpha3spec.TLSMatchAttributes {
+	sniHosts := tlsMatcher.GetSniHosts()
+	if len(sniHosts) == 0 {
+		sniHosts = defaultSniHosts
+	}
+
+	var translatedMatchers []*networkingv1alpha3spec.TLSMatchAttributes
+	for _, source := range translateSourceSelectors(sourceSelectors) {
+		translatedMatchers = append(translatedMatchers, &networkingv1alpha3spec.TLSMatchAttributes{
+			SniHosts:        sniHosts,
+			Port:            port,
+			SourceNamespace: source.namespace,
+			SourceLabels:    source.labels,
+		})
+	}
+	return translatedMatchers
+}
+
+// the source criteria of an Istio match
+type sourceMatch struct {
+	namespace string
+	labels    map[string]string
+}
+
+// generate source criteria for the WorkloadSelectors, one per namespace.
+// a single empty criteria is returned if the selectors do not constrain the source
+func translateSourceSelectors(sourceSelectors []*commonv1.WorkloadSelector) []sourceMatch {
+	var sources []sourceMatch
+	for _, sourceSelector := range sourceSelectors {
+		sourceWorkloadMatcher := sourceSelector.GetKubeWorkloadMatcher()
+		if len(sourceWorkloadMatcher.GetNamespaces()) > 0 {
+			for _, namespace := range sourceWorkloadMatcher.GetNamespaces() {
+				sources = append(sources, sourceMatch{
+					namespace: namespace,
+					labels:    sourceWorkloadMatcher.GetLabels(),
+				})
+			}
+		} else if len(sourceWorkloadMatcher.GetLabels()) > 0 {
+			sources = append(sources, sourceMatch{
+				labels: sourceWorkloadMatcher.GetLabels(),
+			})
+		}
+	}
+	if len(sources) == 0 {
+		sources = append(sources, sourceMatch{})
+	}
+	return sources
+}
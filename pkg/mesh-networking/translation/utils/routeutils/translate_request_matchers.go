@@ -1,11 +1,26 @@
 package routeutils
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rotisserie/eris"
 	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
 )
 
+// ValidateRequestMatchers returns an error if the request matchers specify conflicting criteria.
+func ValidateRequestMatchers(requestMatchers []*v1.HttpMatcher) error {
+	for i, matcher := range requestMatchers {
+		if matcher.GetGrpc() != nil && matcher.GetUri() != nil {
+			return eris.Errorf("HttpRequestMatchers[%d] cannot specify both a uri and a gRPC matcher", i)
+		}
+	}
+	return nil
+}
+
 // TranslateRequestMatchers translates request matchers to Istio. Only provide sourceNamespace and sourceLabels for translation of in-mesh VirtualServices.
 func TranslateRequestMatchers(
 	requestMatchers []*v1.HttpMatcher,
@@ -59,6 +74,10 @@ func TranslateRequestMatchers(
 			if matcher.GetMethod() != "" {
 				method = &networkingv1alpha3spec.StringMatch{MatchType: &networkingv1alpha3spec.StringMatch_Exact{Exact: matcher.GetMethod()}}
 			}
+			if grpcMatcher := matcher.GetGrpc(); grpcMatcher != nil {
+				uriMatcher = translateGrpcMatcherPath(grpcMatcher)
+				headerMatchers = addGrpcContentTypeMatcher(headerMatchers)
+			}
 			httpMatcher.QueryParams = translateRequestMatcherQueryParams(matcher.GetQueryParameters())
 			httpMatcher.Headers = headerMatchers
 			httpMatcher.WithoutHeaders = inverseHeaderMatchers
@@ -70,6 +89,28 @@ func TranslateRequestMatchers(
 	return translatedRequestMatchers
 }
 
+// AddHeaderMatcher returns a copy of the Istio match request which additionally requires the given header matcher to match.
+func AddHeaderMatcher(
+	matchRequest *networkingv1alpha3spec.HTTPMatchRequest,
+	headerMatcher *v1.HeaderMatcher,
+) *networkingv1alpha3spec.HTTPMatchRequest {
+	matchRequest = matchRequest.DeepCopy()
+	headerMatchers, inverseHeaderMatchers := translateRequestMatcherHeaders([]*v1.HeaderMatcher{headerMatcher})
+	for name, match := range headerMatchers {
+		if matchRequest.Headers == nil {
+			matchRequest.Headers = map[string]*networkingv1alpha3spec.StringMatch{}
+		}
+		matchRequest.Headers[name] = match
+	}
+	for name, match := range inverseHeaderMatchers {
+		if matchRequest.WithoutHeaders == nil {
+			matchRequest.WithoutHeaders = map[string]*networkingv1alpha3spec.StringMatch{}
+		}
+		matchRequest.WithoutHeaders[name] = match
+	}
+	return matchRequest
+}
+
 func translateRequestMatcherHeaders(matchers []*v1.HeaderMatcher) (
 	map[string]*networkingv1alpha3spec.StringMatch, map[string]*networkingv1alpha3spec.StringMatch,
 ) {
@@ -135,3 +176,35 @@ func translateRequestMatcherPathSpecifier(matcher *v1.HttpMatcher) *networkingv1
 	}
 	return nil
 }
+
+const grpcContentType = "application/grpc"
+
+// gRPC requests are sent to the path /<package>.<service>/<method>
+func translateGrpcMatcherPath(matcher *v1.GrpcMatcher) *networkingv1alpha3spec.StringMatch {
+	service, method := matcher.GetService(), matcher.GetMethod()
+	switch {
+	case service != "" && method != "":
+		return &networkingv1alpha3spec.StringMatch{MatchType: &networkingv1alpha3spec.StringMatch_Exact{Exact: fmt.Sprintf("/%s/%s", service, method)}}
+	case service != "":
+		return &networkingv1alpha3spec.StringMatch{MatchType: &networkingv1alpha3spec.StringMatch_Prefix{Prefix: fmt.Sprintf("/%s/", service)}}
+	case method != "":
+		return &networkingv1alpha3spec.StringMatch{MatchType: &networkingv1alpha3spec.StringMatch_Regex{Regex: "/[^/]+/" + regexp.QuoteMeta(method)}}
+	}
+	return nil
+}
+
+// match the content type of gRPC requests (e.g. application/grpc+proto), unless the user has specified a content type matcher
+func addGrpcContentTypeMatcher(headerMatchers map[string]*networkingv1alpha3spec.StringMatch) map[string]*networkingv1alpha3spec.StringMatch {
+	for name := range headerMatchers {
+		if strings.EqualFold(name, "content-type") {
+			return headerMatchers
+		}
+	}
+	if headerMatchers == nil {
+		headerMatchers = map[string]*networkingv1alpha3spec.StringMatch{}
+	}
+	headerMatchers["content-type"] = &networkingv1alpha3spec.StringMatch{
+		MatchType: &networkingv1alpha3spec.StringMatch_Prefix{Prefix: grpcContentType},
+	}
+	return headerMatchers
+}
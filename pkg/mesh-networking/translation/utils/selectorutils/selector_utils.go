@@ -98,7 +98,8 @@ func IdentityMatchesWorkload(selectors []*commonv1.IdentitySelector, workload *d
 
 func SelectorMatchesDestination(selectors []*commonv1.DestinationSelector, destination *discoveryv1.Destination) bool {
 	if len(selectors) == 0 {
-		return true
+		// ExternalServices must be selected explicitly by their ServiceEntry refs
+		return destination.Spec.GetExternalService() == nil
 	}
 
 	for _, selector := range selectors {
@@ -123,6 +124,17 @@ func SelectorMatchesDestination(selectors []*commonv1.DestinationSelector, desti
 				}
 			}
 		}
+		externalService := destination.Spec.GetExternalService()
+		if externalService != nil {
+			if externalServiceRefs := selector.ExternalServiceRefs; externalServiceRefs != nil {
+				if refsContain(
+					externalServiceRefs.ServiceEntries,
+					externalService.Ref,
+				) {
+					return true
+				}
+			}
+		}
 	}
 
 	return false
@@ -0,0 +1,86 @@
+package trafficpolicyutils
+
+import (
+	"reflect"
+
+	"github.com/golang/protobuf/proto"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// RequestMatchersEqual returns true if the TrafficPolicies apply to the same traffic, i.e. they have equivalent
+// source selectors and request matchers.
+func RequestMatchersEqual(tp1, tp2 *v1.TrafficPolicySpec) bool {
+	return workloadSelectorListsEqual(tp1.GetSourceSelector(), tp2.GetSourceSelector()) &&
+		httpRequestMatchersEqual(tp1.GetHttpRequestMatchers(), tp2.GetHttpRequestMatchers()) &&
+		tcpRequestMatchersEqual(tp1.GetTcpRequestMatchers(), tp2.GetTcpRequestMatchers()) &&
+		tlsRequestMatchersEqual(tp1.GetTlsRequestMatchers(), tp2.GetTlsRequestMatchers())
+}
+
+func httpRequestMatchersEqual(matchers1, matchers2 []*v1.HttpMatcher) bool {
+	if len(matchers1) != len(matchers2) {
+		return false
+	}
+	for i := range matchers1 {
+		if !proto.Equal(matchers1[i], matchers2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func tcpRequestMatchersEqual(matchers1, matchers2 []*v1.TcpMatcher) bool {
+	if len(matchers1) != len(matchers2) {
+		return false
+	}
+	for i := range matchers1 {
+		if !proto.Equal(matchers1[i], matchers2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func tlsRequestMatchersEqual(matchers1, matchers2 []*v1.TlsMatcher) bool {
+	if len(matchers1) != len(matchers2) {
+		return false
+	}
+	for i := range matchers1 {
+		if !proto.Equal(matchers1[i], matchers2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// return true if workload selectors' labels and namespaces are equivalent, ignore clusters
+func workloadSelectorsEqual(ws1, ws2 *commonv1.WorkloadSelector) bool {
+	return reflect.DeepEqual(ws1.GetKubeWorkloadMatcher().Labels, ws2.GetKubeWorkloadMatcher().Labels) &&
+		sets.NewString(ws1.GetKubeWorkloadMatcher().Namespaces...).Equal(sets.NewString(ws2.GetKubeWorkloadMatcher().Namespaces...))
+}
+
+// return true if two lists of WorkloadSelectors are semantically equivalent, abstracting away order
+func workloadSelectorListsEqual(wsList1, wsList2 []*commonv1.WorkloadSelector) bool {
+	if len(wsList1) != len(wsList2) {
+		return false
+	}
+	matchedWs2 := sets.NewInt()
+	for _, ws1 := range wsList1 {
+		var matched bool
+		for i, ws2 := range wsList2 {
+			if matchedWs2.Has(i) {
+				continue
+			}
+			if workloadSelectorsEqual(ws1, ws2) {
+				matchedWs2.Insert(i)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
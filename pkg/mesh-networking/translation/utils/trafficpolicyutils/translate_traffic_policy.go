@@ -1,6 +1,9 @@
 package trafficpolicyutils
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/gogo/protobuf/types"
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/rotisserie/eris"
@@ -42,16 +45,78 @@ func TranslateTimeout(
 	return gogoutils.DurationProtoToGogo(timeout)
 }
 
+// the Envoy HTTP and gRPC retry conditions which may be specified in a RetryPolicy
+var supportedRetryConditions = map[string]bool{
+	"5xx":                    true,
+	"gateway-error":          true,
+	"reset":                  true,
+	"connect-failure":        true,
+	"envoy-ratelimited":      true,
+	"retriable-4xx":          true,
+	"refused-stream":         true,
+	"retriable-status-codes": true,
+	"retriable-headers":      true,
+	"cancelled":              true,
+	"deadline-exceeded":      true,
+	"internal":               true,
+	"resource-exhausted":     true,
+	"unavailable":            true,
+}
+
+// the Envoy gRPC retry conditions for each retriable gRPC status code
+var grpcRetryConditions = map[v1.GrpcStatusCode]string{
+	v1.GrpcStatusCode_CANCELLED:          "cancelled",
+	v1.GrpcStatusCode_DEADLINE_EXCEEDED:  "deadline-exceeded",
+	v1.GrpcStatusCode_INTERNAL:           "internal",
+	v1.GrpcStatusCode_RESOURCE_EXHAUSTED: "resource-exhausted",
+	v1.GrpcStatusCode_UNAVAILABLE:        "unavailable",
+}
+
+// ValidateRetryConditions returns an error if the RetryPolicy specifies unknown retry conditions or invalid status codes.
+func ValidateRetryConditions(retries *v1.TrafficPolicySpec_Policy_RetryPolicy) error {
+	for _, condition := range retries.GetRetryOn() {
+		if !supportedRetryConditions[condition] {
+			return eris.Errorf("unsupported retry condition %q", condition)
+		}
+	}
+	for _, statusCode := range retries.GetRetriableStatusCodes() {
+		if statusCode < 100 || statusCode > 599 {
+			return eris.Errorf("invalid retriable status code %d", statusCode)
+		}
+	}
+	for _, grpcStatusCode := range retries.GetRetriableGrpcStatusCodes() {
+		if _, ok := grpcRetryConditions[grpcStatusCode]; !ok {
+			return eris.Errorf("gRPC status code %s is not retriable", grpcStatusCode)
+		}
+	}
+	return nil
+}
+
 func TranslateRetries(
 	retries *v1.TrafficPolicySpec_Policy_RetryPolicy,
-) *istiov1alpha3.HTTPRetry {
+) (*istiov1alpha3.HTTPRetry, error) {
 	if retries == nil {
-		return nil
+		return nil, nil
 	}
-	return &networkingv1alpha3spec.HTTPRetry{
-		Attempts:      retries.GetAttempts(),
-		PerTryTimeout: gogoutils.DurationProtoToGogo(retries.GetPerTryTimeout()),
+	if err := ValidateRetryConditions(retries); err != nil {
+		return nil, err
 	}
+
+	// Istio accepts retriable status codes alongside the retry conditions
+	retryOn := append([]string{}, retries.GetRetryOn()...)
+	for _, statusCode := range retries.GetRetriableStatusCodes() {
+		retryOn = append(retryOn, strconv.Itoa(int(statusCode)))
+	}
+	for _, grpcStatusCode := range retries.GetRetriableGrpcStatusCodes() {
+		retryOn = append(retryOn, grpcRetryConditions[grpcStatusCode])
+	}
+
+	return &networkingv1alpha3spec.HTTPRetry{
+		Attempts:              retries.GetAttempts(),
+		PerTryTimeout:         gogoutils.DurationProtoToGogo(retries.GetPerTryTimeout()),
+		RetryOn:               strings.Join(retryOn, ","),
+		RetryRemoteLocalities: gogoutils.BoolProtoToGogo(retries.GetRetryRemoteLocalities()),
+	}, nil
 }
 
 func TranslateFault(faultInjection *v1.TrafficPolicySpec_Policy_FaultInjection) (*networkingv1alpha3spec.HTTPFaultInjection, error) {
@@ -64,13 +129,13 @@ func TranslateFault(faultInjection *v1.TrafficPolicySpec_Policy_FaultInjection)
 	var translatedFaultInjection *networkingv1alpha3spec.HTTPFaultInjection
 	switch injectionType := faultInjection.GetFaultInjectionType().(type) {
 	case *v1.TrafficPolicySpec_Policy_FaultInjection_Abort_:
+		abort, err := translateAbort(injectionType.Abort)
+		if err != nil {
+			return nil, err
+		}
+		abort.Percentage = &networkingv1alpha3spec.Percent{Value: faultInjection.GetPercentage()}
 		translatedFaultInjection = &networkingv1alpha3spec.HTTPFaultInjection{
-			Abort: &networkingv1alpha3spec.HTTPFaultInjection_Abort{
-				ErrorType: &networkingv1alpha3spec.HTTPFaultInjection_Abort_HttpStatus{
-					HttpStatus: faultInjection.GetAbort().GetHttpStatus(),
-				},
-				Percentage: &networkingv1alpha3spec.Percent{Value: faultInjection.GetPercentage()},
-			},
+			Abort: abort,
 		}
 	case *v1.TrafficPolicySpec_Policy_FaultInjection_FixedDelay:
 		translatedFaultInjection = &networkingv1alpha3spec.HTTPFaultInjection{
@@ -87,6 +152,27 @@ func TranslateFault(faultInjection *v1.TrafficPolicySpec_Policy_FaultInjection)
 	return translatedFaultInjection, nil
 }
 
+// an abort specifies either an HTTP status or a gRPC status
+func translateAbort(
+	abort *v1.TrafficPolicySpec_Policy_FaultInjection_Abort,
+) (*networkingv1alpha3spec.HTTPFaultInjection_Abort, error) {
+	if abort.GetGrpcStatus() == v1.GrpcStatusCode_OK {
+		return &networkingv1alpha3spec.HTTPFaultInjection_Abort{
+			ErrorType: &networkingv1alpha3spec.HTTPFaultInjection_Abort_HttpStatus{
+				HttpStatus: abort.GetHttpStatus(),
+			},
+		}, nil
+	}
+	if abort.GetHttpStatus() != 0 {
+		return nil, eris.New("FaultInjection.Abort cannot specify both an HTTP status and a gRPC status.")
+	}
+	return &networkingv1alpha3spec.HTTPFaultInjection_Abort{
+		ErrorType: &networkingv1alpha3spec.HTTPFaultInjection_Abort_GrpcStatus{
+			GrpcStatus: abort.GetGrpcStatus().String(),
+		},
+	}, nil
+}
+
 func TranslateCorsPolicy(
 	corsPolicy *v1.TrafficPolicySpec_Policy_CorsPolicy,
 ) (*istiov1alpha3.CorsPolicy, error) {
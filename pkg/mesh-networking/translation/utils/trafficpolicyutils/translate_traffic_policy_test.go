@@ -73,6 +73,40 @@ var _ = Describe("FaultInjection", func() {
 		Expect(faultResult).To(Equal(expectedFaultInjection))
 	})
 
+	It("should set fault injection of type abort with a gRPC status", func() {
+		faultPolicy := &v1.TrafficPolicySpec_Policy_FaultInjection{
+			FaultInjectionType: &v1.TrafficPolicySpec_Policy_FaultInjection_Abort_{
+				Abort: &v1.TrafficPolicySpec_Policy_FaultInjection_Abort{
+					GrpcStatus: v1.GrpcStatusCode_UNAVAILABLE,
+				},
+			},
+			Percentage: 50,
+		}
+		expectedFaultInjection := &v1alpha3.HTTPFaultInjection{
+			Abort: &v1alpha3.HTTPFaultInjection_Abort{
+				ErrorType:  &v1alpha3.HTTPFaultInjection_Abort_GrpcStatus{GrpcStatus: "UNAVAILABLE"},
+				Percentage: &v1alpha3.Percent{Value: 50},
+			},
+		}
+		faultResult, err := TranslateFault(faultPolicy)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(faultResult).To(Equal(expectedFaultInjection))
+	})
+
+	It("should return error if fault injection abort specifies both an HTTP and a gRPC status", func() {
+		faultPolicy := &v1.TrafficPolicySpec_Policy_FaultInjection{
+			FaultInjectionType: &v1.TrafficPolicySpec_Policy_FaultInjection_Abort_{
+				Abort: &v1.TrafficPolicySpec_Policy_FaultInjection_Abort{
+					HttpStatus: 503,
+					GrpcStatus: v1.GrpcStatusCode_UNAVAILABLE,
+				},
+			},
+		}
+		faultResult, err := TranslateFault(faultPolicy)
+		Expect(err.Error()).To(ContainSubstring("cannot specify both an HTTP status and a gRPC status"))
+		Expect(faultResult).To(BeNil())
+	})
+
 	It("should set fault injection of type fixed delay", func() {
 		faultPolicy := &v1.TrafficPolicySpec_Policy_FaultInjection{
 			FaultInjectionType: &v1.TrafficPolicySpec_Policy_FaultInjection_FixedDelay{
@@ -350,10 +384,60 @@ var _ = Describe("Mirror", func() {
 				Attempts:      5,
 				PerTryTimeout: &types.Duration{Seconds: 2},
 			}
-			retriesResult := TranslateRetries(retriesPolicy)
+			retriesResult, err := TranslateRetries(retriesPolicy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retriesResult).To(Equal(expectedRetries))
+		})
+
+		It("should set retry conditions", func() {
+			retriesPolicy := &v1.TrafficPolicySpec_Policy_RetryPolicy{
+				Attempts:              3,
+				RetryOn:               []string{"connect-failure", "reset"},
+				RetriableStatusCodes:  []uint32{503},
+				RetryRemoteLocalities: &wrappers.BoolValue{Value: true},
+			}
+			expectedRetries := &v1alpha3.HTTPRetry{
+				Attempts:              3,
+				RetryOn:               "connect-failure,reset,503",
+				RetryRemoteLocalities: &types.BoolValue{Value: true},
+			}
+			retriesResult, err := TranslateRetries(retriesPolicy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retriesResult).To(Equal(expectedRetries))
+		})
+
+		It("should set retry conditions for retriable gRPC status codes", func() {
+			retriesPolicy := &v1.TrafficPolicySpec_Policy_RetryPolicy{
+				Attempts:                 3,
+				RetryOn:                  []string{"connect-failure"},
+				RetriableGrpcStatusCodes: []v1.GrpcStatusCode{v1.GrpcStatusCode_UNAVAILABLE, v1.GrpcStatusCode_RESOURCE_EXHAUSTED},
+			}
+			expectedRetries := &v1alpha3.HTTPRetry{
+				Attempts: 3,
+				RetryOn:  "connect-failure,unavailable,resource-exhausted",
+			}
+			retriesResult, err := TranslateRetries(retriesPolicy)
+			Expect(err).ToNot(HaveOccurred())
 			Expect(retriesResult).To(Equal(expectedRetries))
 		})
 
+		It("should return an error for unknown retry conditions", func() {
+			_, err := TranslateRetries(&v1.TrafficPolicySpec_Policy_RetryPolicy{
+				RetryOn: []string{"5xx", "never-on-post"},
+			})
+			Expect(err).To(HaveOccurred())
+
+			_, err = TranslateRetries(&v1.TrafficPolicySpec_Policy_RetryPolicy{
+				RetriableStatusCodes: []uint32{42},
+			})
+			Expect(err).To(HaveOccurred())
+
+			_, err = TranslateRetries(&v1.TrafficPolicySpec_Policy_RetryPolicy{
+				RetriableGrpcStatusCodes: []v1.GrpcStatusCode{v1.GrpcStatusCode_NOT_FOUND},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
 	})
 
 	var _ = Describe("Timeout", func() {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/solo-io/gloo-mesh/pkg/meshctl/commands/debug/metrics"
+	"github.com/solo-io/gloo-mesh/pkg/meshctl/commands/debug/replay"
 	"github.com/solo-io/gloo-mesh/pkg/meshctl/commands/debug/report"
 	"github.com/solo-io/gloo-mesh/pkg/meshctl/commands/debug/snapshot"
 	"github.com/solo-io/gloo-mesh/pkg/meshctl/utils"
@@ -20,6 +21,7 @@ func Command(ctx context.Context, globalFlags *utils.GlobalFlags) *cobra.Command
 		snapshot.Command(ctx, globalFlags),
 		metrics.Command(ctx, globalFlags),
 		report.Command(ctx, globalFlags),
+		replay.Command(ctx, globalFlags),
 	)
 
 	return cmd
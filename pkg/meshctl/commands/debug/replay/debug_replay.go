@@ -0,0 +1,123 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/apply"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/preview"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/replay"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation"
+	"github.com/solo-io/gloo-mesh/pkg/meshctl/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type options struct {
+	input        string
+	userSupplied string
+	output       string
+}
+
+func (o *options) addToFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.input, "input", "", "file containing the networking input snapshot, as printed by `meshctl debug snapshot networking input --json`")
+	flags.StringVar(&o.userSupplied, "user-supplied", "", "optional file containing the user-supplied mesh config snapshot, used to detect conflicts with existing config")
+	flags.StringVar(&o.output, "output", "", "optional file containing the recorded networking output snapshot, as printed by `meshctl debug snapshot networking output --json`. "+
+		"If set, the difference between the recorded and replayed outputs is printed instead of the replayed outputs.")
+}
+
+func Command(ctx context.Context, globalFlags *utils.GlobalFlags) *cobra.Command {
+	opts := &options{}
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replay networking validation and translation against a snapshot",
+		Long: `Replay networking validation and translation against a snapshot.
+
+The networking input snapshot is loaded from a file and translated locally, as the networking pod would,
+without connecting to any cluster. The translated outputs are printed as JSON, along with any status errors
+reported on TrafficPolicies, AccessPolicies and VirtualMeshes.
+If a recorded output snapshot is provided, only the resources that differ from it are printed.`,
+		Example: `  meshctl debug snapshot networking input --json > input.json
+  meshctl debug snapshot networking output --json > output.json
+  meshctl debug replay --input input.json --output output.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return debugReplay(ctx, cmd.OutOrStdout(), opts)
+		},
+	}
+	opts.addToFlags(cmd.Flags())
+	cmd.MarkFlagRequired("input")
+
+	cmd.SilenceUsage = true
+	return cmd
+}
+
+func debugReplay(ctx context.Context, out io.Writer, opts *options) error {
+	in, err := readLocalSnapshot(opts.input)
+	if err != nil {
+		return err
+	}
+	var userSupplied input.RemoteSnapshot
+	if opts.userSupplied != "" {
+		userSupplied, err = readRemoteSnapshot(opts.userSupplied)
+		if err != nil {
+			return err
+		}
+	}
+
+	outputs, err := replay.Replay(ctx, apply.NewApplier(translation.NewMeshTranslator(nil)), translation.NewMeshTranslator(nil), in, userSupplied)
+	if err != nil {
+		return err
+	}
+
+	if opts.output == "" {
+		byt, err := outputs.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(byt))
+	} else {
+		recordedByt, err := ioutil.ReadFile(opts.output)
+		if err != nil {
+			return err
+		}
+		recorded, err := replay.OutputsFromJSON(ctx, recordedByt)
+		if err != nil {
+			return err
+		}
+		changes := preview.DiffOutputs(recorded, outputs)
+		if len(changes) == 0 {
+			fmt.Fprintln(out, "Replayed outputs match the recorded outputs.")
+		}
+		if err := preview.PrintChanges(out, changes); err != nil {
+			return err
+		}
+	}
+
+	var policyErrors []preview.PolicyErrors
+	for _, errs := range preview.GetPolicyErrors(in) {
+		if len(errs.Errors) > 0 {
+			policyErrors = append(policyErrors, errs)
+		}
+	}
+	preview.PrintPolicyErrors(out, policyErrors)
+	return nil
+}
+
+func readLocalSnapshot(file string) (input.LocalSnapshot, error) {
+	byt, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return replay.LocalSnapshotFromJSON(byt)
+}
+
+func readRemoteSnapshot(file string) (input.RemoteSnapshot, error) {
+	byt, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return replay.RemoteSnapshotFromJSON(byt)
+}
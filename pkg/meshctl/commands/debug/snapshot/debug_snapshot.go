@@ -187,10 +187,11 @@ func debugSnapshot(ctx context.Context, opts *DebugSnapshotOpts, pods, types []s
 	storageClient := debugutils.NewFileStorageClient(fs)
 	for _, podName := range pods {
 		for _, snapshotType := range types {
-			fmt.Printf("%s snapshot for %s\n", snapshotType, podName)
+			// write progress to stderr, so that the snapshots printed to stdout can be redirected to a file and parsed
+			fmt.Fprintf(os.Stderr, "%s snapshot for %s\n", snapshotType, podName)
 			snapshot, snapshotErr := getSnapshot(ctx, opts, "", podName, snapshotType)
 			if snapshotErr != nil {
-				fmt.Println(snapshotErr.Error())
+				fmt.Fprintln(os.Stderr, snapshotErr.Error())
 				continue
 			}
 			fileName := fmt.Sprintf("%s-%s-snapshot.json", podName, snapshotType)
@@ -0,0 +1,21 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/solo-io/gloo-mesh/pkg/meshctl/commands/policy/preview"
+	"github.com/spf13/cobra"
+)
+
+func Command(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Inspect the effects of Gloo Mesh networking policies",
+	}
+
+	cmd.AddCommand(
+		preview.Command(ctx),
+	)
+
+	return cmd
+}
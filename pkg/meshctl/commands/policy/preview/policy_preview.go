@@ -0,0 +1,240 @@
+package preview
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/rotisserie/eris"
+	corev1clients "github.com/solo-io/external-apis/pkg/api/k8s/core/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/apply"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/preview"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/settingsutils"
+	"github.com/solo-io/gloo-mesh/pkg/meshctl/utils"
+	multiclusterv1alpha1 "github.com/solo-io/skv2/pkg/api/multicluster.solo.io/v1alpha1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func Command(ctx context.Context) *cobra.Command {
+	opts := &options{}
+	cmd := &cobra.Command{
+		Use:   "preview",
+		Short: "Preview the mesh config that would be generated for proposed TrafficPolicies, AccessPolicies and VirtualMeshes",
+		Long: `Preview the mesh config that would be generated for proposed TrafficPolicies, AccessPolicies and VirtualMeshes.
+
+The current state of the management cluster is translated locally with and without the proposed resources,
+and the added, changed and removed VirtualServices, DestinationRules, AuthorizationPolicies, RequestAuthentications and SMI resources are printed,
+along with any status errors that would be reported on the proposed resources.
+Nothing is written to the cluster.`,
+		Example: "  meshctl policy preview -f policy.yaml",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposal, err := readProposal(opts.file)
+			if err != nil {
+				return err
+			}
+			c, err := utils.BuildClient(opts.kubeconfig, opts.kubecontext)
+			if err != nil {
+				return err
+			}
+			result, err := previewProposal(ctx, c, opts.namespace, proposal)
+			if err != nil {
+				return err
+			}
+			return printResult(cmd.OutOrStdout(), result)
+		},
+	}
+	opts.addToFlags(cmd.Flags())
+	cmd.MarkFlagRequired("file")
+
+	cmd.SilenceUsage = true
+	return cmd
+}
+
+type options struct {
+	kubeconfig  string
+	kubecontext string
+	namespace   string
+	file        string
+}
+
+func (o *options) addToFlags(flags *pflag.FlagSet) {
+	utils.AddManagementKubeconfigFlags(&o.kubeconfig, &o.kubecontext, flags)
+	flags.StringVarP(&o.namespace, "namespace", "n", defaults.GetPodNamespace(), "namespace in which Gloo Mesh is installed")
+	flags.StringVarP(&o.file, "file", "f", "", "file containing the proposed TrafficPolicies, AccessPolicies and VirtualMeshes")
+}
+
+// read TrafficPolicies, AccessPolicies and VirtualMeshes from a (possibly multi-document) YAML file
+func readProposal(file string) (preview.Proposal, error) {
+	var proposal preview.Proposal
+
+	f, err := os.Open(file)
+	if err != nil {
+		return proposal, err
+	}
+	defer f.Close()
+
+	reader := k8syaml.NewYAMLReader(bufio.NewReader(f))
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return proposal, eris.Wrapf(err, "reading %s", file)
+		}
+		if strings.TrimSpace(string(doc)) == "" {
+			continue
+		}
+
+		var typeMeta metav1.TypeMeta
+		if err := yaml.Unmarshal(doc, &typeMeta); err != nil {
+			return proposal, eris.Wrapf(err, "parsing %s", file)
+		}
+		if typeMeta.APIVersion != networkingv1.SchemeGroupVersion.String() {
+			return proposal, eris.Errorf("unsupported apiVersion %s, only %s resources can be previewed", typeMeta.APIVersion, networkingv1.SchemeGroupVersion)
+		}
+
+		var obj client.Object
+		switch typeMeta.Kind {
+		case "TrafficPolicy":
+			trafficPolicy := &networkingv1.TrafficPolicy{}
+			proposal.TrafficPolicies = append(proposal.TrafficPolicies, trafficPolicy)
+			obj = trafficPolicy
+		case "AccessPolicy":
+			accessPolicy := &networkingv1.AccessPolicy{}
+			proposal.AccessPolicies = append(proposal.AccessPolicies, accessPolicy)
+			obj = accessPolicy
+		case "VirtualMesh":
+			virtualMesh := &networkingv1.VirtualMesh{}
+			proposal.VirtualMeshes = append(proposal.VirtualMeshes, virtualMesh)
+			obj = virtualMesh
+		default:
+			return proposal, eris.Errorf("unsupported kind %s, only TrafficPolicies, AccessPolicies and VirtualMeshes can be previewed", typeMeta.Kind)
+		}
+		if err := yaml.Unmarshal(doc, obj); err != nil {
+			return proposal, eris.Wrapf(err, "parsing %s", typeMeta.Kind)
+		}
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(metav1.NamespaceDefault)
+		}
+	}
+
+	return proposal, nil
+}
+
+func previewProposal(ctx context.Context, c client.Client, namespace string, proposal preview.Proposal) (*preview.Result, error) {
+	in, err := buildSnapshot(ctx, c, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := settingsv1.NewSettingsClient(c).GetSettings(ctx, client.ObjectKey{
+		Name:      defaults.DefaultSettingsName,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return nil, eris.Wrapf(err, "reading Gloo Mesh settings from namespace %s", namespace)
+	}
+	ctx = settingsutils.ContextWithSettings(ctx, settings)
+
+	return preview.NewPreviewer(apply.NewApplier(translation.NewMeshTranslator(nil)), translation.NewMeshTranslator(nil)).Preview(ctx, in, proposal)
+}
+
+// build the networking input snapshot from the management cluster
+func buildSnapshot(ctx context.Context, c client.Client, namespace string) (input.LocalSnapshot, error) {
+	builder := input.NewInputLocalSnapshotManualBuilder("meshctl-policy-preview")
+
+	trafficPolicies, err := networkingv1.NewTrafficPolicyClient(c).ListTrafficPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range trafficPolicies.Items {
+		builder.AddTrafficPolicies([]*networkingv1.TrafficPolicy{&trafficPolicies.Items[i]})
+	}
+
+	accessPolicies, err := networkingv1.NewAccessPolicyClient(c).ListAccessPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range accessPolicies.Items {
+		builder.AddAccessPolicies([]*networkingv1.AccessPolicy{&accessPolicies.Items[i]})
+	}
+
+	virtualMeshes, err := networkingv1.NewVirtualMeshClient(c).ListVirtualMesh(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range virtualMeshes.Items {
+		builder.AddVirtualMeshes([]*networkingv1.VirtualMesh{&virtualMeshes.Items[i]})
+	}
+
+	destinations, err := discoveryv1.NewDestinationClient(c).ListDestination(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range destinations.Items {
+		builder.AddDestinations([]*discoveryv1.Destination{&destinations.Items[i]})
+	}
+
+	workloads, err := discoveryv1.NewWorkloadClient(c).ListWorkload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range workloads.Items {
+		builder.AddWorkloads([]*discoveryv1.Workload{&workloads.Items[i]})
+	}
+
+	meshes, err := discoveryv1.NewMeshClient(c).ListMesh(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range meshes.Items {
+		builder.AddMeshes([]*discoveryv1.Mesh{&meshes.Items[i]})
+	}
+
+	// root CA secrets are read when translating VirtualMeshes
+	secrets, err := corev1clients.NewSecretClient(c).ListSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range secrets.Items {
+		builder.AddSecrets([]*corev1.Secret{&secrets.Items[i]})
+	}
+
+	// only look at kube clusters in the Gloo Mesh namespace, as the networking reconciler does
+	kubernetesClusters, err := multiclusterv1alpha1.NewKubernetesClusterClient(c).ListKubernetesCluster(ctx, client.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	for i := range kubernetesClusters.Items {
+		builder.AddKubernetesClusters([]*multiclusterv1alpha1.KubernetesCluster{&kubernetesClusters.Items[i]})
+	}
+
+	return builder.Build(), nil
+}
+
+func printResult(out io.Writer, result *preview.Result) error {
+	if len(result.Changes) == 0 {
+		fmt.Fprintln(out, "No changes to generated mesh config.")
+	}
+	if err := preview.PrintChanges(out, result.Changes); err != nil {
+		return err
+	}
+	preview.PrintPolicyErrors(out, result.PolicyErrors)
+	return nil
+}
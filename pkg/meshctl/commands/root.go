@@ -17,6 +17,7 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/meshctl/commands/initpluginmanager"
 	"github.com/solo-io/gloo-mesh/pkg/meshctl/commands/install"
 	"github.com/solo-io/gloo-mesh/pkg/meshctl/commands/mesh"
+	"github.com/solo-io/gloo-mesh/pkg/meshctl/commands/policy"
 	"github.com/solo-io/gloo-mesh/pkg/meshctl/commands/uninstall"
 	"github.com/solo-io/gloo-mesh/pkg/meshctl/commands/version"
 	"github.com/solo-io/gloo-mesh/pkg/meshctl/plugins"
@@ -56,6 +57,7 @@ func RootCommand(ctx context.Context) *cobra.Command {
 		debug.Command(ctx, globalFlags),
 		describe.Command(ctx),
 		mesh.Command(ctx),
+		policy.Command(ctx),
 		install.Command(ctx, globalFlags),
 		uninstall.Command(ctx, globalFlags),
 		check.Command(ctx),
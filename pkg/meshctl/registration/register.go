@@ -22,6 +22,8 @@ var gloomeshRbacRequirements = func() []rbacv1.PolicyRule {
 	policyRules = append(policyRules, io.LocalNetworkingOutputTypes.Snapshot.RbacPoliciesWrite()...)
 	policyRules = append(policyRules, io.IstioNetworkingOutputTypes.Snapshot.RbacPoliciesWrite()...)
 	policyRules = append(policyRules, io.SmiNetworkingOutputTypes.Snapshot.RbacPoliciesWrite()...)
+	policyRules = append(policyRules, io.ConsulNetworkingOutputTypes.Snapshot.RbacPoliciesWrite()...)
+	policyRules = append(policyRules, io.AppMeshNetworkingOutputTypes.Snapshot.RbacPoliciesWrite()...)
 	policyRules = append(policyRules, io.CertificateIssuerInputTypes.RbacPoliciesWatch()...)
 	policyRules = append(policyRules, io.CertificateIssuerInputTypes.RbacPoliciesUpdateStatus()...)
 	return policyRules